@@ -0,0 +1,41 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package main
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/hyperifyio/statelessdb/pkg/requests"
+	"github.com/hyperifyio/statelessdb/pkg/states"
+)
+
+const (
+	ComputePurpose = "compute" // ComputePurpose is authenticated with states from /api/v1 and /api/v1/events
+	EventsPurpose  = "events"  // EventsPurpose is the purpose of /api/v1/events, which encrypts states for ComputePurpose
+)
+
+// ComputeStateIdentity returns the resource identity of the state, e.g. the id
+// and the owner
+func ComputeStateIdentity(state *states.ComputeState) []byte {
+	identity := make([]byte, 0, 32)
+	identity = append(identity, state.Id[:]...)
+	return append(identity, state.Owner[:]...)
+}
+
+// ComputeRequestIdentity returns the resource identity from the request. It
+// returns nil if the request does not have a valid identity, which will not
+// match any state.
+func ComputeRequestIdentity(r *requests.ComputeRequest) []byte {
+	id, err := uuid.Parse(r.Id)
+	if err != nil {
+		return nil
+	}
+	owner, err := uuid.Parse(r.Owner)
+	if err != nil {
+		return nil
+	}
+	identity := make([]byte, 0, 32)
+	identity = append(identity, id[:]...)
+	return append(identity, owner[:]...)
+}
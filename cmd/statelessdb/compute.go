@@ -35,9 +35,9 @@ func ApiRequestHandler(bus events.EventBus[uuid.UUID, interface{}]) requests.Api
 	}
 }
 
-func NewComputeResponseDTO(bus events.EventBus[uuid.UUID, interface{}]) requests.CreateResponseFunc[*states.ComputeState] {
+func NewComputeResponseDTO() requests.CreateResponseFunc[*states.ComputeState] {
 	return func(state *states.ComputeState, private string) interface{} {
-		dto := dtos.NewComputeResponseDTO(
+		return dtos.NewComputeResponseDTO(
 			state.Id,
 			state.Owner,
 			state.Created,
@@ -45,7 +45,14 @@ func NewComputeResponseDTO(bus events.EventBus[uuid.UUID, interface{}]) requests
 			state.Public,
 			private,
 		)
+	}
+}
+
+// PublishComputeResponse publishes the response of POST /api/v1 to listeners
+// of the resource. It is called after the response has been signed, so the
+// listeners receive it as the client does.
+func PublishComputeResponse(bus events.EventBus[uuid.UUID, interface{}]) requests.ResponseHookFunc[*states.ComputeState] {
+	return func(state *states.ComputeState, dto interface{}) {
 		bus.Publish(events.NewEvent[uuid.UUID, interface{}](state.Id, dto, state.Updated))
-		return dto
 	}
 }
@@ -6,6 +6,7 @@ package main
 import (
 	"os"
 	"strconv"
+	"time"
 )
 
 func parseIntEnv(key string, defaultValue int) int {
@@ -28,6 +29,18 @@ func parseStringEnv(key string, defaultValue string) string {
 	return str
 }
 
+func parseDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	str := os.Getenv(key)
+	if str == "" {
+		return defaultValue
+	}
+	result, err := time.ParseDuration(str)
+	if err != nil {
+		return defaultValue
+	}
+	return result
+}
+
 func parseBooleanEnv(key string, defaultValue bool) bool {
 	str := os.Getenv(key)
 	if str == "" {
@@ -4,6 +4,7 @@
 package main
 
 import (
+	"context"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,13 +15,14 @@ import (
 	"github.com/hyperifyio/statelessdb/pkg/states"
 )
 
-// ApiEventHandler is called to implement GET /api/v1/events which implements an HTTP long polling end point
+// ApiEventHandler is called to implement GET /api/v1/events which implements an HTTP long polling end point.
+// It stops waiting for events when the context of the request is done, e.g. the client has gone away.
 func ApiEventHandler(
 	bus events.EventBus[uuid.UUID, interface{}],
 	timeoutTime,
 	eventExpirationTime,
 	intervalTime time.Duration,
-) requests.ApiRequestHandlerFunc[*states.ComputeState, *requests.ComputeRequest] {
+) requests.ApiContextRequestHandlerFunc[*states.ComputeState, *requests.ComputeRequest] {
 
 	manager := events.NewEventManager(
 		bus,
@@ -32,7 +34,7 @@ func ApiEventHandler(
 		EventTriggerRetryTime,
 	)
 
-	return func(r *requests.ComputeRequest, state *states.ComputeState) (*states.ComputeState, error) {
+	return func(ctx context.Context, r *requests.ComputeRequest, state *states.ComputeState) (*states.ComputeState, error) {
 
 		if state == nil {
 			return nil, ErrNoStateProvided
@@ -65,6 +67,9 @@ func ApiEventHandler(
 
 			case <-timeout:
 				break EventLoop
+
+			case <-ctx.Done():
+				return nil, ctx.Err()
 			}
 		}
 
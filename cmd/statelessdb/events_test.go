@@ -4,6 +4,7 @@
 package main_test
 
 import (
+	"context"
 	"errors"
 	"github.com/hyperifyio/statelessdb/pkg/helpers"
 	"sync"
@@ -44,7 +45,7 @@ func TestApiEventHandler_NilState(t *testing.T) {
 	}
 
 	// Call handler with nil state
-	_, err := handler(req, nil)
+	_, err := handler(context.Background(), req, nil)
 	assert.Error(t, err, "Expected error when state is nil")
 	assert.Equal(t, main.ErrNoStateProvided, err, "Expected ErrNoStateProvided")
 }
@@ -77,7 +78,7 @@ func (s *FailingComputeState) Initialize() error {
 //	}
 //
 //	// Call handler with failing state
-//	_, err := handler(req, state)
+//	_, err := handler(context.Background(), req, state)
 //	assert.Error(t, err, "Expected error during state initialization")
 //	assert.Equal(t, "initialization failed", err.Error(), "Expected initialization failure error")
 //}
@@ -121,7 +122,7 @@ func TestApiEventHandler_BufferedEvents(t *testing.T) {
 	}
 
 	// Call handler
-	updatedState, err := handler(req, state)
+	updatedState, err := handler(context.Background(), req, state)
 	assert.NoError(t, err, "Expected no error when processing buffered events")
 	assert.Equal(t, state.Id, updatedState.Id, "State ID should remain the same")
 	assert.Equal(t, 2, len(updatedState.Events()), "State should have 2 events")
@@ -175,7 +176,7 @@ func TestApiEventHandler_SubscribeAndTimeout(t *testing.T) {
 
 	// Call handler (timeout is set to 10 seconds)
 	start := time.Now()
-	updatedState, err := handler(req, state)
+	updatedState, err := handler(context.Background(), req, state)
 	duration := time.Since(start)
 
 	assert.NoError(t, err, "Expected no error when event is published before timeout")
@@ -216,7 +217,7 @@ func TestApiEventHandler_TimeoutWithoutEvents(t *testing.T) {
 
 	// Call handler (timeout is set to 10 seconds)
 	start := time.Now()
-	updatedState, err := handler(req, state)
+	updatedState, err := handler(context.Background(), req, state)
 	duration := time.Since(start)
 
 	assert.NoError(t, err, "Expected no error when timeout occurs without events")
@@ -225,6 +226,41 @@ func TestApiEventHandler_TimeoutWithoutEvents(t *testing.T) {
 	assert.Equal(t, 0, len(updatedState.Events()), "State should have no events")
 }
 
+func TestApiEventHandler_ClientDisconnected(t *testing.T) {
+	// Create main.ApiEventHandler with a timeout longer than the test
+	handler := main.ApiEventHandler(events.NewLocalEventBus[uuid.UUID, interface{}](localEventBufferSize), time.Minute, eventExpirationTime, eventCleanupIntervalTime)
+
+	// Create a ComputeState with no buffered events
+	state := states.NewComputeState(
+		uuid.New(),
+		uuid.New(),
+		time.Now().UnixMilli(),
+		time.Now().UnixMilli(),
+		nil,
+		nil,
+		nil,
+	)
+
+	// Create a ComputeRequest
+	req := &requests.ComputeRequest{
+		PrivateData: "dummy_private_data",
+	}
+
+	// Cancel the request like the HTTP server does when the client goes away
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(eventTimeoutTime / 10)
+		cancel()
+	}()
+
+	start := time.Now()
+	_, err := handler(ctx, req, state)
+	duration := time.Since(start)
+
+	assert.Equal(t, context.Canceled, err, "Expected context.Canceled when the client has gone away")
+	assert.True(t, duration < eventTimeoutTime, "Handler should stop waiting when the client has gone away")
+}
+
 func TestApiEventHandler_ConcurrentAccess(t *testing.T) {
 	// Initialize MockEventBus
 	//mockBus := mocks.NewMockEventBus[uuid.UUID, interface{}]()
@@ -270,7 +306,7 @@ func TestApiEventHandler_ConcurrentAccess(t *testing.T) {
 			}
 
 			// Call handler
-			_, err := handler(req, state)
+			_, err := handler(context.Background(), req, state)
 			if err != nil {
 				errCh <- err
 			}
@@ -0,0 +1,44 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hyperifyio/statelessdb/pkg/states"
+)
+
+// parseFieldClassesString parses a comma separated list of private property
+// names and their sensitivity classes, e.g. "ssn:pii,card:payment", used in
+// --field-classes argument
+func parseFieldClassesString(fieldClassesString string) (states.FieldClasses, error) {
+	classes := make(states.FieldClasses)
+	for _, item := range strings.Split(fieldClassesString, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		name, class, found := strings.Cut(item, ":")
+		name = strings.TrimSpace(name)
+		class = strings.TrimSpace(class)
+		if !found || name == "" || class == "" {
+			return nil, fmt.Errorf("parseFieldClassesString: expected name:class, got '%s'", item)
+		}
+		classes[name] = class
+	}
+	return classes, nil
+}
+
+// parseFieldAccessString parses a comma separated list of sensitivity
+// classes, used in --field-access argument
+func parseFieldAccessString(fieldAccessString string) []string {
+	var classes []string
+	for _, class := range strings.Split(fieldAccessString, ",") {
+		if class = strings.TrimSpace(class); class != "" {
+			classes = append(classes, class)
+		}
+	}
+	return classes
+}
@@ -36,10 +36,34 @@ func main() {
 	// Define flags
 	addr := flag.String("addr", "", "change default address to listen")
 	port := flag.Int("port", parseIntEnv("PORT", 3001), "change default port")
-	privateKeyString := flag.String("private-key", parseStringEnv("PRIVATE_KEY", ""), "set private key")
+	privateKeyString := flag.String("private-key", "", "set private key, or use PRIVATE_KEY environment variable")
+	previousPrivateKeysString := flag.String("previous-private-keys", parseStringEnv("PREVIOUS_PRIVATE_KEYS", ""), "set comma separated previous private keys accepted for decryption")
+	privateKeyFile := flag.String("private-key-file", parseStringEnv("PRIVATE_KEY_FILE", ""), "set file with hex encoded keys, the active key first")
+	privateKeyDir := flag.String("private-key-dir", parseStringEnv("PRIVATE_KEY_DIR", ""), "set directory with a hex encoded key in each file, the last file by name is the active key")
+	privateKeyCommand := flag.String("private-key-command", parseStringEnv("PRIVATE_KEY_COMMAND", ""), "set command which prints hex encoded keys, the active key first")
+	privateKeyReloadInterval := flag.Duration("private-key-reload-interval", parseDurationEnv("PRIVATE_KEY_RELOAD_INTERVAL", 10*time.Second), "set how often key files are checked for changes, or 0 to disable")
+	strictKeys := flag.Bool("strict-keys", parseBooleanEnv("STRICT_KEYS", false), "Refuse to start without a configured private key")
 	version := flag.Bool("version", false, "Show version information")
+	cipherSuiteString := flag.String("cipher-suite", parseStringEnv("CIPHER_SUITE", encodings.DefaultSuite.String()), "set cipher suite used for encryption")
+	formatString := flag.String("format", parseStringEnv("FORMAT", encodings.FormatJson.String()), "set serialization format for private data: json, gob, cbor, msgpack or protobuf")
+	compressionString := flag.String("compression", parseStringEnv("COMPRESSION", encodings.CompressionNone.String()), "set compression for private data: none, flate, zstd or snappy")
+	compressionThreshold := flag.Int("compression-threshold", parseIntEnv("COMPRESSION_THRESHOLD", encodings.DefaultCompressionThreshold), "set the size in bytes of the smallest private data which is compressed")
+	textEncodingString := flag.String("text-encoding", parseStringEnv("TEXT_ENCODING", encodings.DefaultTextEncoding.String()), "set text encoding for private data: base64, base64url, z3b or z3b-cookie")
+	paddingString := flag.String("padding", parseStringEnv("PADDING", "none"), "set padding for private data: none, pow2[:minimum], buckets:size,... or random:maximum")
+	privateTTL := flag.Duration("private-ttl", parseDurationEnv("PRIVATE_TTL", 0), "set how long private data is accepted after it was issued, e.g. 24h")
+	tenantKeys := flag.Bool("tenant-keys", parseBooleanEnv("TENANT_KEYS", false), "Encrypt private data with keys derived for the owner of the resource")
+	tenantKeyCacheSize := flag.Int("tenant-key-cache-size", parseIntEnv("TENANT_KEY_CACHE_SIZE", encodings.DefaultTenantKeyCacheSize), "set how many derived tenant keys are cached")
+	revokedTenantsString := flag.String("revoked-tenants", parseStringEnv("REVOKED_TENANTS", ""), "set comma separated owner UUIDs whose private data is no longer accepted")
+	fieldClassesString := flag.String("field-classes", parseStringEnv("FIELD_CLASSES", ""), "set comma separated private properties encrypted by sensitivity class, e.g. ssn:pii,card:payment")
+	fieldAccessString := flag.String("field-access", parseStringEnv("FIELD_ACCESS", ""), "set comma separated sensitivity classes readable by /api/v1")
+	signPublic := flag.Bool("sign-public", parseBooleanEnv("SIGN_PUBLIC", false), "Sign public data of resources and require the signature when the resource is sent back")
+	bindResourceIdentity := flag.Bool("bind-resource-identity", parseBooleanEnv("BIND_RESOURCE_IDENTITY", true), "Require requests to include the id and owner of the resource with the private data")
+	acceptLegacyPrivate := flag.Bool("accept-legacy-private", parseBooleanEnv("ACCEPT_LEGACY_PRIVATE", false), "Accept private data encrypted before envelopes, which is not bound to the route or the resource")
+	auditLog := flag.Bool("audit-log", parseBooleanEnv("AUDIT_LOG", false), "Log every request which creates or changes a resource")
+	maxBodySize := flag.Int("max-body-size", parseIntEnv("MAX_BODY_SIZE", apis.DefaultMaxBodySize), "set the largest accepted request body in bytes, including streams")
 	enablePprof := flag.Bool("pprof", parseBooleanEnv("ENABLE_PPROF", false), "Enable pprof for debugging")
 	initPrivateKey := flag.Bool("init-private-key", false, "Create a new private key and print it")
+	inspectPrivate := flag.String("inspect", "", "Print the envelope header of a private value without decrypting it")
 
 	// Parse flags
 	flag.Parse()
@@ -65,13 +89,46 @@ func main() {
 		return
 	}
 
-	// Handle --private-key
-	serverKey, err := parsePrivateKeyString(*privateKeyString)
+	// Handle --inspect
+	if *inspectPrivate != "" {
+		envelope, err := encodings.InspectEnvelope(*inspectPrivate)
+		if err != nil {
+			log.Errorf("Failed to inspect private value: %v", err)
+			os.Exit(1)
+		}
+		fmt.Printf("encoding: %s\n", encodings.DetectTextEncoding(*inspectPrivate))
+		fmt.Print(envelope)
+		return
+	}
+
+	// Handle --private-key, --private-key-file, --private-key-dir and --private-key-command
+	provider, err := newKeyProvider(KeySources{
+		PrivateKey:          *privateKeyString,
+		PreviousPrivateKeys: *previousPrivateKeysString,
+		File:                *privateKeyFile,
+		Directory:           *privateKeyDir,
+		Command:             *privateKeyCommand,
+		Strict:              *strictKeys,
+	})
 	if err != nil {
-		log.Errorf("Private key parsing failed: %v", err)
+		log.Errorf("Private key configuration failed: %v", err)
 		os.Exit(1)
 	}
 
+	// Handle --cipher-suite
+	suite, err := encodings.ParseSuite(*cipherSuiteString)
+	if err != nil {
+		log.Errorf("Cipher suite parsing failed: %v", err)
+		os.Exit(1)
+	}
+
+	reloader, err := encodings.NewKeyReloader(provider, suite)
+	if err != nil {
+		log.Errorf("Failed to initialize key ring: %v", err)
+		os.Exit(1)
+	}
+	keys := reloader.KeyRing()
+
 	// Define the server
 
 	newState := func() *states.ComputeState {
@@ -82,25 +139,140 @@ func main() {
 		return &requests.ComputeRequest{}
 	}
 
-	computeRequestManager, err := requests.NewJsonRequestManager[*states.ComputeState, *requests.ComputeRequest, *dtos.ComputeResponseDTO](
+	// Handle --format
+	format, err := encodings.ParseFormat(*formatString)
+	if err != nil {
+		log.Errorf("Format parsing failed: %v", err)
+		os.Exit(1)
+	}
+
+	// Handle --compression
+	compression, err := encodings.ParseCompression(*compressionString)
+	if err != nil {
+		log.Errorf("Compression parsing failed: %v", err)
+		os.Exit(1)
+	}
+
+	computeRequestManager, err := requests.NewRequestManager[*states.ComputeState, *requests.ComputeRequest, *dtos.ComputeResponseDTO](
 		"ComputeState",
-		serverKey,
+		keys,
 		newState,
 		newRequestDTO,
+		requests.WithFormat(format),
+		requests.WithCompression(compression),
+		requests.WithCompressionThreshold(*compressionThreshold),
 	)
 	if err != nil {
-		log.Errorf("Failed to initialize JSON request handler: %v", err)
+		log.Errorf("Failed to initialize %s request handler: %v", format, err)
+		os.Exit(1)
+	}
+
+	// Handle --padding
+	padding, err := encodings.ParsePadding(*paddingString)
+	if err != nil {
+		log.Errorf("Padding parsing failed: %v", err)
+		os.Exit(1)
+	}
+	computeRequestManager.Encryptor.WithPadding(padding)
+
+	// Handle --text-encoding
+	textEncoding, err := encodings.ParseTextEncoding(*textEncodingString)
+	if err != nil {
+		log.Errorf("Text encoding parsing failed: %v", err)
+		os.Exit(1)
+	}
+	if textEncoding == encodings.TextEncodingBinary {
+		log.Errorf("Text encoding %s cannot be used in JSON", textEncoding)
 		os.Exit(1)
 	}
+	computeRequestManager.Encryptor.WithTextEncoding(textEncoding)
+
+	// Handle --accept-legacy-private
+	computeRequestManager.Decryptor.WithLegacy(*acceptLegacyPrivate)
+
+	reloader.OnReload(func(keys *encodings.KeyRing) {
+		_ = computeRequestManager.Encryptor.InitializeKeyRing(keys)
+		_ = computeRequestManager.Decryptor.InitializeKeyRing(keys)
+	})
+
+	// Handle --tenant-keys
+	if *tenantKeys {
+		revokedTenants, err := parseRevokedTenantsString(*revokedTenantsString)
+		if err != nil {
+			log.Errorf("Revoked tenants parsing failed: %v", err)
+			os.Exit(1)
+		}
+		tenants := encodings.NewTenantKeys(keys, *tenantKeyCacheSize)
+		tenants.Revoke(revokedTenants...)
+		reloader.OnReload(tenants.SetMaster)
+		computeRequestManager.WithTenantKeys(tenants, ComputeStateTenant, ComputeRequestTenant)
+	}
+
+	// Handle --field-classes
+	fieldClasses, err := parseFieldClassesString(*fieldClassesString)
+	if err != nil {
+		log.Errorf("Field classes parsing failed: %v", err)
+		os.Exit(1)
+	}
+	if len(fieldClasses) > 0 {
+		fieldCipher := encodings.NewFieldCipher(keys)
+		reloader.OnReload(func(keys *encodings.KeyRing) {
+			_ = fieldCipher.InitializeKeyRing(keys)
+		})
+		computeRequestManager.WithFieldEncryption(fieldCipher, fieldClasses)
+	}
 
 	eventBus := events.NewLocalEventBus[uuid.UUID, interface{}](LocalEventBufferSize)
 
 	server := apis.NewServer()
+	server.SetMaxBodySize(int64(*maxBodySize))
 	if *enablePprof {
 		server.EnablePprof()
 	}
-	server.Handle("/api/v1", computeRequestManager.HandleWith(ApiRequestHandler(eventBus)).WithResponse(NewComputeResponseDTO(eventBus)).WithMethods("GET", "POST"))
-	server.Handle("/api/v1/events", computeRequestManager.HandleWith(ApiEventHandler(eventBus, eventTimeoutTime, eventExpirationTime, eventCleanupIntervalTime)).WithResponse(NewEventResponseDTO(eventBus)).WithMethods("GET", "POST"))
+	computeHandler := computeRequestManager.HandleWith(ApiRequestHandler(eventBus)).WithResponse(NewComputeResponseDTO()).AfterResponse(PublishComputeResponse(eventBus)).WithMethods("GET", "POST").WithPurpose(ComputePurpose)
+	eventHandler := computeRequestManager.HandleWithContext(ApiEventHandler(eventBus, eventTimeoutTime, eventExpirationTime, eventCleanupIntervalTime)).WithResponse(NewEventResponseDTO(eventBus)).WithMethods("GET", "POST").WithPurpose(EventsPurpose, ComputePurpose).WithResponsePurpose(ComputePurpose)
+
+	// Handle --audit-log
+	computeHandler.Use(RouteMiddlewares(ComputePurpose, *auditLog)...)
+	eventHandler.Use(RouteMiddlewares(EventsPurpose, *auditLog)...)
+
+	// Handle --private-ttl
+	if *privateTTL > 0 {
+		computeHandler.WithTTL(*privateTTL)
+		eventHandler.WithTTL(*privateTTL)
+	}
+
+	// Handle --field-access
+	computeHandler.WithFieldAccess(parseFieldAccessString(*fieldAccessString)...)
+
+	// Handle --sign-public
+	if *signPublic {
+		signer, err := encodings.NewPublicSigner(keys)
+		if err != nil {
+			log.Errorf("Failed to initialize public signer: %v", err)
+			os.Exit(1)
+		}
+		reloader.OnReload(func(keys *encodings.KeyRing) {
+			_ = signer.InitializeKeyRing(keys)
+		})
+		computeHandler.WithPublicSignature(signer)
+		eventHandler.WithPublicSignature(signer)
+	}
+
+	// Handle --bind-resource-identity
+	if *bindResourceIdentity {
+		computeHandler.WithResourceBinding(ComputeStateIdentity, ComputeRequestIdentity)
+		eventHandler.WithResourceBinding(ComputeStateIdentity, ComputeRequestIdentity)
+	}
+
+	// Handle reloading keys on SIGHUP and --private-key-reload-interval
+	reloadKeysOnSignal(reloader)
+	if *privateKeyReloadInterval > 0 {
+		go reloader.Watch(*privateKeyReloadInterval, nil)
+	}
+
+	server.Handle("/api/v1", computeHandler)
+	server.Handle("/api/v1/events", eventHandler)
 
 	// Start the server
 	log.Infof("Starting server at %s", listenTo)
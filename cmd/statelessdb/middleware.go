@@ -0,0 +1,26 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package main
+
+import (
+	"github.com/hyperifyio/statelessdb/pkg/requests"
+	"github.com/hyperifyio/statelessdb/pkg/states"
+)
+
+// ComputeMiddleware wraps the handlers of the routes
+type ComputeMiddleware = requests.Middleware[*states.ComputeState, *requests.ComputeRequest]
+
+// RouteMiddlewares returns the middlewares of a route. Panics in the handler
+// are recovered and the duration of the handler is measured on every route,
+// and requests are logged if auditLog is enabled.
+func RouteMiddlewares(route string, auditLog bool) []ComputeMiddleware {
+	middlewares := []ComputeMiddleware{
+		requests.RecoverMiddleware[*states.ComputeState, *requests.ComputeRequest](),
+		requests.MetricsMiddleware[*states.ComputeState, *requests.ComputeRequest](route),
+	}
+	if auditLog {
+		middlewares = append(middlewares, requests.AuditLogMiddleware[*states.ComputeState, *requests.ComputeRequest](route))
+	}
+	return middlewares
+}
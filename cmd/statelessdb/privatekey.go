@@ -6,23 +6,118 @@ package main
 import (
 	"encoding/hex"
 	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 
 	"github.com/hyperifyio/statelessdb/pkg/encodings"
 )
 
-// parsePrivateKeyString parses AES-256 key, used in --private-key argument
-func parsePrivateKeyString(privateKeyString string) ([]byte, error) {
-	if privateKeyString == "" {
+// KeySources are the configured sources of the private key
+type KeySources struct {
+	PrivateKey          string // PrivateKey is the hex encoded key from --private-key
+	PreviousPrivateKeys string // PreviousPrivateKeys are the comma separated keys from --previous-private-keys
+	File                string // File is the key file from --private-key-file
+	Directory           string // Directory is the key directory from --private-key-dir
+	Command             string // Command is the key command from --private-key-command
+	Strict              bool   // Strict refuses to generate a random key
+}
+
+// newKeyProvider returns the key provider for the configured source. Only one
+// source may be configured. Without a source a random key is generated,
+// unless strict mode is enabled. Previous keys are only accepted with the
+// private key, since the other sources list previous keys themselves.
+func newKeyProvider(sources KeySources) (encodings.KeyProvider, error) {
+	if sources.PreviousPrivateKeys != "" && (sources.File != "" || sources.Directory != "" || sources.Command != "") {
+		return nil, fmt.Errorf("newKeyProvider: previous private keys cannot be configured with a key file, key directory or key command, list them after the active key instead")
+	}
+	var providers []encodings.KeyProvider
+	if sources.PrivateKey != "" {
+		serverKey, err := parsePrivateKeyString(sources.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		previousKeys, err := parsePreviousPrivateKeysString(sources.PreviousPrivateKeys)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, encodings.NewStaticKeyProvider(serverKey, previousKeys...))
+	} else if os.Getenv("PRIVATE_KEY") != "" {
+		// The provider reads previous keys from the environment, not the flag
+		if sources.PreviousPrivateKeys != os.Getenv("PREVIOUS_PRIVATE_KEYS") {
+			return nil, fmt.Errorf("newKeyProvider: previous private keys must be set with PREVIOUS_PRIVATE_KEYS when the private key is set with PRIVATE_KEY")
+		}
+		providers = append(providers, encodings.NewEnvKeyProvider("PRIVATE_KEY", "PREVIOUS_PRIVATE_KEYS"))
+	}
+	if sources.File != "" {
+		providers = append(providers, encodings.NewFileKeyProvider(sources.File))
+	}
+	if sources.Directory != "" {
+		providers = append(providers, encodings.NewDirectoryKeyProvider(sources.Directory))
+	}
+	if sources.Command != "" {
+		// The command is run in a shell, so it may quote arguments and use pipes
+		providers = append(providers, encodings.NewCommandKeyProvider("sh", "-c", sources.Command))
+	}
+
+	switch len(providers) {
+	case 1:
+		return providers[0], nil
+	case 0:
+		if sources.Strict {
+			return nil, fmt.Errorf("newKeyProvider: no private key configured in strict mode")
+		}
 		key, err := encodings.GenerateKey(32) // AES-256
 		if err != nil {
-			return nil, fmt.Errorf("parsePrivateKeyString: failed to generate key: %v", err)
+			return nil, fmt.Errorf("newKeyProvider: failed to generate key: %v", err)
 		}
 		log.Warnf("Initialized with a random private key '%s'. You might want to make this persistent.", hex.EncodeToString(key))
-		return key, nil
+		return encodings.NewStaticKeyProvider(key), nil
+	default:
+		return nil, fmt.Errorf("newKeyProvider: only one of private key, key file, key directory or key command can be configured")
 	}
+}
+
+// reloadKeysOnSignal reloads the keys whenever the process receives SIGHUP
+func reloadKeysOnSignal(reloader *encodings.KeyReloader) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	go func() {
+		for range signals {
+			log.Infof("Received SIGHUP, reloading keys")
+			if err := reloader.Reload(); err != nil {
+				log.Errorf("Reloading keys failed: %v", err)
+			}
+		}
+	}()
+}
+
+// parsePrivateKeyString parses AES-256 key, used in --private-key argument
+func parsePrivateKeyString(privateKeyString string) ([]byte, error) {
 	serverKey, err := hex.DecodeString(privateKeyString)
 	if err != nil {
 		return nil, fmt.Errorf("parsePrivateKeyString: failed to decode private key: %v", err)
 	}
 	return serverKey, nil
 }
+
+// parsePreviousPrivateKeysString parses a comma separated list of previous
+// AES-256 keys, used in --previous-private-keys argument. Previous keys are
+// only accepted for decryption, so data encrypted before a key rotation keeps
+// working.
+func parsePreviousPrivateKeysString(previousKeysString string) ([][]byte, error) {
+	var keys [][]byte
+	for _, keyString := range strings.Split(previousKeysString, ",") {
+		keyString = strings.TrimSpace(keyString)
+		if keyString == "" {
+			continue
+		}
+		key, err := hex.DecodeString(keyString)
+		if err != nil {
+			return nil, fmt.Errorf("parsePreviousPrivateKeysString: failed to decode private key: %v", err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/hyperifyio/statelessdb/pkg/requests"
+	"github.com/hyperifyio/statelessdb/pkg/states"
+)
+
+// ComputeStateTenant returns the tenant of the state, e.g. the owner
+func ComputeStateTenant(state *states.ComputeState) []byte {
+	return state.Owner[:]
+}
+
+// ComputeRequestTenant returns the tenant of the request, e.g. the owner. It
+// returns nil if the request does not have a valid owner.
+func ComputeRequestTenant(r *requests.ComputeRequest) []byte {
+	owner, err := uuid.Parse(r.Owner)
+	if err != nil {
+		return nil
+	}
+	return owner[:]
+}
+
+// parseRevokedTenantsString parses a comma separated list of revoked tenant
+// UUIDs, used in --revoked-tenants argument
+func parseRevokedTenantsString(revokedTenantsString string) ([][]byte, error) {
+	var tenants [][]byte
+	for _, tenantString := range strings.Split(revokedTenantsString, ",") {
+		tenantString = strings.TrimSpace(tenantString)
+		if tenantString == "" {
+			continue
+		}
+		tenant, err := uuid.Parse(tenantString)
+		if err != nil {
+			return nil, fmt.Errorf("parseRevokedTenantsString: failed to parse tenant: %v", err)
+		}
+		tenants = append(tenants, tenant[:])
+	}
+	return tenants, nil
+}
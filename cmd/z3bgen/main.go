@@ -0,0 +1,276 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+// Command z3bgen generates the tables of a z3b encoding from an alphabet
+// spec. The spec is a JSON file which defines the name, the version byte,
+// the prefix character, the seed, the data characters and the set control
+// characters of the encoding. The same spec always generates the same
+// tables. A spec may list the sets of every group instead of a seed, e.g.
+// for StdEncoding, whose sets were created before z3bgen.
+//
+// It is used by go:generate in pkg/encodings/z3b:
+//
+//	go run ../../../cmd/z3bgen -spec alphabets/cookie.json -o cookieencoding.go
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	numSets    = 7   // Number of sets in an encoding group, like in z3b
+	totalBytes = 256 // Total number of byte values (0-255)
+)
+
+// Alphabet is the spec of a z3b encoding
+type Alphabet struct {
+	Name    string            `json:"name"`           // Name is the prefix of the generated identifiers, e.g. "Cookie" for CookieEncoding
+	Comment string            `json:"comment"`        // Comment continues the doc comment of the encoding after its name
+	Version byte              `json:"version"`        // Version is the version byte of the encoding
+	Prefix  string            `json:"prefix"`         // Prefix is the character which starts prefixed text, so the encoding can be told from the text
+	Seed    uint64            `json:"seed"`           // Seed is the seed of the random sets
+	Data    string            `json:"data"`           // Data contains the data characters
+	Control string            `json:"control"`        // Control contains the set control characters by the set change
+	Sets    [][numSets]string `json:"sets,omitempty"` // Sets contains hex encoded sets of every group, which are used instead of the seed
+}
+
+func main() {
+
+	specFile := flag.String("spec", "", "set the alphabet spec file")
+	outFile := flag.String("o", "", "set the output file, or use the standard output")
+	packageName := flag.String("package", "z3b", "set the package of the output file")
+	flag.Parse()
+
+	if *specFile == "" {
+		fmt.Fprintf(os.Stderr, "z3bgen: -spec is required\n")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	data, err := os.ReadFile(*specFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "z3bgen: failed to read the spec: %v\n", err)
+		os.Exit(1)
+	}
+
+	var alphabet Alphabet
+	if err := json.Unmarshal(data, &alphabet); err != nil {
+		fmt.Fprintf(os.Stderr, "z3bgen: failed to parse the spec: %v\n", err)
+		os.Exit(1)
+	}
+
+	source, err := Generate(*packageName, *specFile, alphabet)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "z3bgen: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *outFile == "" {
+		_, err = os.Stdout.Write(source)
+	} else {
+		err = os.WriteFile(*outFile, source, 0644)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "z3bgen: failed to write the tables: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// Generate returns the Go source of the encoding. The source names the spec
+// in the header of the generated file.
+func Generate(packageName, source string, alphabet Alphabet) ([]byte, error) {
+
+	if err := alphabet.Validate(); err != nil {
+		return nil, err
+	}
+
+	groups, err := alphabet.Groups()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by z3bgen from %s. DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&buf, "package %s\n\n", packageName)
+	fmt.Fprintf(&buf, "// %sEncodingVersion is the version byte of %sEncoding\n", alphabet.Name, alphabet.Name)
+	fmt.Fprintf(&buf, "const %sEncodingVersion = %d\n\n", alphabet.Name, alphabet.Version)
+	fmt.Fprintf(&buf, "// %sEncodingPrefix starts text prefixed with %sEncoding\n", alphabet.Name, alphabet.Name)
+	fmt.Fprintf(&buf, "const %sEncodingPrefix = %s\n\n", alphabet.Name, strconv.QuoteRune(rune(alphabet.Prefix[0])))
+	for _, line := range wrapComment(alphabet.Name+"Encoding "+alphabet.Comment, 77) {
+		fmt.Fprintf(&buf, "// %s\n", line)
+	}
+	fmt.Fprintf(&buf, "var %sEncoding = newEncoding(%sEncodingVersion, %sEncodingPrefix, %s, %s, [][numSets]string{\n",
+		alphabet.Name, alphabet.Name, alphabet.Name, strconv.Quote(alphabet.Data), strconv.Quote(alphabet.Control))
+	for g, sets := range groups {
+		fmt.Fprintf(&buf, "\n// Group %d '%c'\n{\n", g, (alphabet.Data + alphabet.Control)[g])
+		for _, set := range sets {
+			buf.WriteByte('"')
+			for _, b := range set {
+				fmt.Fprintf(&buf, "\\x%02x", b)
+			}
+			buf.WriteString("\",\n")
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("})\n")
+
+	return format.Source(buf.Bytes())
+}
+
+// Groups returns the sets of bytes for each encoding group, either from
+// the sets of the spec or generated from the seed
+func (a Alphabet) Groups() ([][numSets][]byte, error) {
+	if len(a.Sets) == 0 {
+		return GenerateGroups(a), nil
+	}
+	groups := make([][numSets][]byte, len(a.Sets))
+	for g := range a.Sets {
+		for set := 0; set < numSets; set++ {
+			decoded, err := hex.DecodeString(a.Sets[g][set])
+			if err != nil {
+				return nil, fmt.Errorf("group %d set %d: %v", g, set, err)
+			}
+			groups[g][set] = decoded
+		}
+	}
+	return groups, nil
+}
+
+// GenerateGroups returns the sets of bytes for each encoding group. The
+// sets of a group are filled from random permutations of all bytes, one
+// after another, so every byte is in at least one set and the sets differ
+// from each other. A byte which is in the set already is saved for the next
+// set. The bytes of a set are sorted.
+func GenerateGroups(alphabet Alphabet) [][numSets][]byte {
+	rng := rand.New(rand.NewPCG(alphabet.Seed, uint64(alphabet.Version)))
+	setSize := len(alphabet.Data)
+	groups := make([][numSets][]byte, len(alphabet.Data)+len(alphabet.Control))
+	for g := range groups {
+		var next []byte // next contains the bytes to add to sets
+		for set := 0; set < numSets; set++ {
+			var saved []byte
+			var contains [totalBytes]bool
+			groups[g][set] = make([]byte, 0, setSize)
+			for len(groups[g][set]) < setSize {
+				if len(next) == 0 {
+					for _, b := range rng.Perm(totalBytes) {
+						next = append(next, byte(b))
+					}
+				}
+				b := next[0]
+				next = next[1:]
+				if contains[b] {
+					saved = append(saved, b)
+					continue
+				}
+				contains[b] = true
+				groups[g][set] = append(groups[g][set], b)
+			}
+			next = append(saved, next...)
+			slices.Sort(groups[g][set])
+		}
+	}
+	return groups
+}
+
+// Validate returns an error if the alphabet cannot be used for a z3b
+// encoding
+func (a Alphabet) Validate() error {
+	if a.Name == "" || strings.ToUpper(a.Name[:1]) != a.Name[:1] {
+		return fmt.Errorf("name must start with an upper case letter: \"%s\"", a.Name)
+	}
+	if len(a.Control) != numSets-1 {
+		return fmt.Errorf("%d set control characters, %d required", len(a.Control), numSets-1)
+	}
+	if len(a.Data)*numSets < totalBytes || len(a.Data) > totalBytes {
+		return fmt.Errorf("%d data characters, from %d to %d required", len(a.Data), (totalBytes+numSets-1)/numSets, totalBytes)
+	}
+	if len(a.Prefix) != 1 {
+		return fmt.Errorf("prefix must be one character: \"%s\"", a.Prefix)
+	}
+	if c := a.Prefix[0]; c < 32 || c > 126 || c == '"' || c == '\\' {
+		return fmt.Errorf("invalid prefix: '%c'", c)
+	}
+	if err := a.validateSets(); err != nil {
+		return err
+	}
+	chars := a.Data + a.Control
+	for i := 0; i < len(chars); i++ {
+		c := chars[i]
+		if c < 32 || c > 126 || c == '"' || c == '\\' {
+			return fmt.Errorf("invalid character: '%c'", c)
+		}
+		if strings.IndexByte(chars[:i], c) >= 0 {
+			return fmt.Errorf("duplicate character: '%c'", c)
+		}
+	}
+	return nil
+}
+
+// validateSets returns an error if the sets of the spec do not have a set
+// of the size of the data characters for each set of each group, or if a
+// group does not contain every byte
+func (a Alphabet) validateSets() error {
+	if len(a.Sets) == 0 {
+		return nil
+	}
+	if a.Seed != 0 {
+		return fmt.Errorf("a spec with sets cannot have a seed")
+	}
+	if len(a.Sets) != len(a.Data)+len(a.Control) {
+		return fmt.Errorf("%d groups of sets, %d required", len(a.Sets), len(a.Data)+len(a.Control))
+	}
+	groups, err := a.Groups()
+	if err != nil {
+		return err
+	}
+	for g, sets := range groups {
+		var covered [totalBytes]bool
+		for set, values := range sets {
+			if len(values) != len(a.Data) {
+				return fmt.Errorf("group %d set %d has %d bytes, %d required", g, set, len(values), len(a.Data))
+			}
+			var contains [totalBytes]bool
+			for _, b := range values {
+				if contains[b] {
+					return fmt.Errorf("group %d set %d has byte 0x%02x twice", g, set, b)
+				}
+				contains[b] = true
+				covered[b] = true
+			}
+		}
+		for b, ok := range covered {
+			if !ok {
+				return fmt.Errorf("group %d does not contain byte 0x%02x", g, b)
+			}
+		}
+	}
+	return nil
+}
+
+// wrapComment splits text to lines of at most width characters
+func wrapComment(text string, width int) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		if line != "" && len(line)+1+len(word) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	return append(lines, line)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+const z3bDir = "../../pkg/encodings/z3b/"
+
+func readAlphabet(t *testing.T, file string) Alphabet {
+	data, err := os.ReadFile(z3bDir + file)
+	if err != nil {
+		t.Fatalf("Failed to read the spec: %v", err)
+	}
+	var alphabet Alphabet
+	if err := json.Unmarshal(data, &alphabet); err != nil {
+		t.Fatalf("Failed to parse the spec: %v", err)
+	}
+	return alphabet
+}
+
+// TestGenerate_UpToDate tests that the generated tables in z3b match their
+// specs, so go generate has been run
+func TestGenerate_UpToDate(t *testing.T) {
+	testCases := []struct {
+		spec   string
+		output string
+	}{
+		{"alphabets/std.json", "stdencoding.go"},
+		{"alphabets/cookie.json", "cookieencoding.go"},
+	}
+	for _, tc := range testCases {
+		source, err := Generate("z3b", tc.spec, readAlphabet(t, tc.spec))
+		if err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+		expected, err := os.ReadFile(z3bDir + tc.output)
+		if err != nil {
+			t.Fatalf("Failed to read the tables: %v", err)
+		}
+		if !bytes.Equal(source, expected) {
+			t.Errorf("%s is not up to date with %s, run go generate", tc.output, tc.spec)
+		}
+	}
+}
+
+func TestGenerateGroups(t *testing.T) {
+	alphabet := readAlphabet(t, "alphabets/cookie.json")
+	groups := GenerateGroups(alphabet)
+	if len(groups) != len(alphabet.Data)+len(alphabet.Control) {
+		t.Fatalf("Expected %d groups, got %d", len(alphabet.Data)+len(alphabet.Control), len(groups))
+	}
+	for g, sets := range groups {
+		var covered [totalBytes]bool
+		for s, set := range sets {
+			if len(set) != len(alphabet.Data) {
+				t.Fatalf("Group %d set %d has %d bytes, expected %d", g, s, len(set), len(alphabet.Data))
+			}
+			for i, b := range set {
+				if i > 0 && set[i-1] >= b {
+					t.Fatalf("Group %d set %d is not sorted or has byte 0x%02x twice", g, s, b)
+				}
+				covered[b] = true
+			}
+		}
+		for b, ok := range covered {
+			if !ok {
+				t.Fatalf("Group %d does not contain byte 0x%02x", g, b)
+			}
+		}
+	}
+
+	// Another seed generates other sets
+	alphabet.Seed++
+	if other := GenerateGroups(alphabet); bytes.Equal(other[0][0], groups[0][0]) {
+		t.Errorf("Different seeds generated the same sets")
+	}
+}
+
+func TestAlphabet_Validate(t *testing.T) {
+	valid := readAlphabet(t, "alphabets/cookie.json")
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+
+	testCases := []struct {
+		name   string
+		modify func(a *Alphabet)
+	}{
+		{"LowerCaseName", func(a *Alphabet) { a.Name = "cookie" }},
+		{"ShortControl", func(a *Alphabet) { a.Control = a.Control[1:] }},
+		{"ShortData", func(a *Alphabet) { a.Data = a.Data[:36] }},
+		{"Quote", func(a *Alphabet) { a.Data = a.Data[1:] + "\"" }},
+		{"Duplicate", func(a *Alphabet) { a.Data = a.Data[1:] + a.Control[:1] }},
+		{"NoPrefix", func(a *Alphabet) { a.Prefix = "" }},
+		{"LongPrefix", func(a *Alphabet) { a.Prefix = "~~" }},
+		{"QuotePrefix", func(a *Alphabet) { a.Prefix = "\"" }},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			alphabet := valid
+			tc.modify(&alphabet)
+			if err := alphabet.Validate(); err == nil {
+				t.Fatalf("Expected an error")
+			}
+		})
+	}
+}
+
+// TestAlphabet_Sets tests that the sets of a spec are used instead of the
+// seed, and that invalid sets are rejected
+func TestAlphabet_Sets(t *testing.T) {
+	std := readAlphabet(t, "alphabets/std.json")
+	if err := std.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	groups, err := std.Groups()
+	if err != nil {
+		t.Fatalf("Groups failed: %v", err)
+	}
+	if hex.EncodeToString(groups[1][2]) != std.Sets[1][2] {
+		t.Errorf("Groups should return the sets of the spec")
+	}
+
+	testCases := []struct {
+		name   string
+		modify func(a *Alphabet)
+	}{
+		{"Seed", func(a *Alphabet) { a.Seed = 1 }},
+		{"MissingGroup", func(a *Alphabet) { a.Sets = a.Sets[1:] }},
+		{"InvalidHex", func(a *Alphabet) { a.Sets[0][0] = "xx" + a.Sets[0][0][2:] }},
+		{"ShortSet", func(a *Alphabet) { a.Sets[0][0] = a.Sets[0][0][2:] }},
+		{"DuplicateByte", func(a *Alphabet) { a.Sets[0][0] = a.Sets[0][0][:len(a.Sets[0][0])-2] + a.Sets[0][0][:2] }},
+		{"MissingByte", func(a *Alphabet) {
+			// Byte 0x00 is replaced with a byte which is not in the set yet
+			for set := range a.Sets[0] {
+				values, _ := hex.DecodeString(a.Sets[0][set])
+				if i := bytes.IndexByte(values, 0); i >= 0 {
+					unused := byte(1)
+					for bytes.IndexByte(values, unused) >= 0 {
+						unused++
+					}
+					values[i] = unused
+				}
+				a.Sets[0][set] = hex.EncodeToString(values)
+			}
+		}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			alphabet := readAlphabet(t, "alphabets/std.json")
+			tc.modify(&alphabet)
+			if err := alphabet.Validate(); err == nil {
+				t.Fatalf("Expected an error")
+			}
+		})
+	}
+}
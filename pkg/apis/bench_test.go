@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -185,4 +186,49 @@ func BenchmarkHandleComputeStateRequest(b *testing.B) {
 
 	})
 
+	// With previous private data as an encrypted stream
+	b.Run("with_Stream", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+
+			public := make(map[string]interface{})
+			private := map[string]interface{}{"data": strings.Repeat("Large ", 100000)}
+			state := states.NewComputeState(uuid.New(), uuid.New(), now, now, public, private, nil)
+
+			var body bytes.Buffer
+			if err = encryptor.EncryptStream(&body, state, nil, encodings.Lifetime{}); err != nil {
+				b.Fatalf("Could not create stream: %v", err)
+			}
+
+			req, err := http.NewRequest("POST", "/api/v1", &body)
+			if err != nil {
+				b.Fatalf("Could not create request: %v", err)
+			}
+			req.Header.Set("Content-Type", apis.StreamContentType)
+
+			rr := httptest.NewRecorder()
+
+			requestManager, err := requests.NewJsonRequestManager[*states.ComputeState, *requests.ComputeRequest, *dtos.ComputeResponseDTO](
+				"ComputeState", serverKey, newState, newRequestDTO,
+			)
+			if err != nil {
+				b.Fatalf("Could not create request manager: %v", err)
+			}
+
+			requestResponseManager := requestManager.HandleWith(requestHandler).WithResponse(responseHandler)
+			handler := server.BuildHandler(requestResponseManager)
+
+			b.StartTimer()
+			handler(rr, req)
+			b.StopTimer()
+
+			response := rr.Result()
+			if response.StatusCode != 200 || response.Header.Get("Content-Type") != apis.StreamContentType {
+				b.Fatalf("Request failed with status %d %s", response.StatusCode, response.Status)
+			}
+
+		}
+
+	})
+
 }
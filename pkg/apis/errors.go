@@ -18,7 +18,14 @@ const (
 	DecryptionFailedError  = "decryption-failed"
 	BadPrivateBodyError    = "bad-private-body"
 	BadBodyError           = "bad-body"
+	BodyTooLargeError      = "body-too-large"
 	ComputeLogicError      = "compute-logic-error"
+	PrivateExpiredError    = "private-expired"
+	PrivateRevokedError    = "private-revoked"
+	InvalidSignatureError  = "invalid-signature"
+	PrivateSchemaError     = "private-schema-unsupported"
+	RequestTimeoutError    = "request-timeout"
+	RequestCanceledError   = "request-canceled"
 )
 
 func sendHttpError(w http.ResponseWriter, code string, status int) {
@@ -4,8 +4,11 @@
 package apis
 
 import (
+	"context"
+
 	"github.com/gorilla/mux"
 	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
 	"github.com/hyperifyio/statelessdb/pkg/metrics"
 	"github.com/hyperifyio/statelessdb/pkg/requests"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -15,10 +18,18 @@ import (
 	"net/http/pprof"
 )
 
+// StreamContentType is the content type of requests and responses which carry
+// the state as an encrypted stream
+const StreamContentType = "application/vnd.statelessdb.stream"
+
+// DefaultMaxBodySize is the default limit for the size of request bodies
+const DefaultMaxBodySize = 16 << 20
+
 type Server struct {
 	enablePprof bool
 	routes      map[string]requests.ResponseManager
 	fs          fs.FS
+	maxBodySize int64 // maxBodySize is the largest accepted request body, including streams
 }
 
 func NewServer() *Server {
@@ -26,6 +37,7 @@ func NewServer() *Server {
 		false,
 		make(map[string]requests.ResponseManager),
 		nil,
+		DefaultMaxBodySize,
 	}
 }
 
@@ -41,23 +53,57 @@ func (s *Server) EnableFrontend(f fs.FS) {
 	s.fs = f
 }
 
+// SetMaxBodySize sets the largest accepted request body in bytes. Larger
+// requests are rejected with 413 Request Entity Too Large.
+func (s *Server) SetMaxBodySize(size int64) {
+	s.maxBodySize = size
+}
+
 func (s *Server) BuildHandler(handler requests.ResponseManager) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
 		metrics.RecordHttpRequestMetric(r.URL.Path)
+		r.Body = http.MaxBytesReader(w, r.Body, s.maxBodySize)
 
-		// Read the request body
-		requestBody, err := io.ReadAll(r.Body)
+		// Encrypted streams are processed without reading the whole body
+		if streaming, ok := handler.(requests.StreamResponseManager); ok && r.Header.Get("Content-Type") == StreamContentType {
+			handleStream(w, r, streaming)
+			return
+		}
+
+		// Read the request body into a pooled buffer
+		requestBody, err := appendBody(encodings.GetByteSlice(int(min(max(r.ContentLength, 0), s.maxBodySize))+1), r.Body)
+		defer encodings.ReleaseByteSlice(requestBody)
+		if _, ok := err.(*http.MaxBytesError); ok {
+			log.Debugf("[Server.BuildHandler]: Body is larger than %d bytes", s.maxBodySize)
+			sendHttpError(w, BodyTooLargeError, http.StatusRequestEntityTooLarge)
+			return
+		}
 		if err != nil {
 			log.Errorf("[Server.BuildHandler]: Failed to read body: %v", err)
 			sendHttpError(w, BadBodyError, http.StatusBadRequest)
 			return
 		}
 
+		// The response is encrypted directly into a pooled buffer
+		if buffered, ok := handler.(requests.BufferedResponseManager); ok {
+			responseBody, err := buffered.ProcessBytesTo(r.Context(), encodings.GetByteSlice(2*len(requestBody)+512), requestBody)
+			defer encodings.ReleaseByteSlice(responseBody)
+			if err != nil {
+				sendProcessError(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if _, err := w.Write(responseBody); err != nil {
+				log.Errorf("[Server.BuildHandler]: writing: error: %v", err)
+				sendHttpError(w, WritingBodyFailedError, http.StatusInternalServerError)
+			}
+			return
+		}
+
 		//log.Debugf("[Server.BuildHandler]: Request body: %v", requestBody)
-		dto, err := handler.ProcessBytes(requestBody)
+		dto, err := handler.ProcessBytes(r.Context(), requestBody)
 		if err != nil {
-			log.Errorf("[Server.BuildHandler]: Failed to process body: %v", err)
-			sendHttpError(w, BadBodyError, http.StatusBadRequest)
+			sendProcessError(w, err)
 			return
 		}
 		//log.Debugf("[Server.BuildHandler]: Processed as dto: %v", dto)
@@ -86,6 +132,91 @@ func (s *Server) BuildHandler(handler requests.ResponseManager) func(w http.Resp
 	}
 }
 
+// appendBody reads the body until the end and appends it to dst
+func appendBody(dst []byte, body io.Reader) ([]byte, error) {
+	for {
+		if len(dst) == cap(dst) {
+			dst = append(dst, 0)[:len(dst)]
+		}
+		n, err := body.Read(dst[len(dst):cap(dst)])
+		dst = dst[:len(dst)+n]
+		if err == io.EOF {
+			return dst, nil
+		}
+		if err != nil {
+			return dst, err
+		}
+	}
+}
+
+// handleStream processes a state sent as an encrypted stream, and writes the
+// result as an encrypted stream
+func handleStream(w http.ResponseWriter, r *http.Request, handler requests.StreamResponseManager) {
+	w.Header().Set("Content-Type", StreamContentType)
+	out := &streamResponseWriter{w: w}
+	if err := handler.ProcessStream(r.Context(), r.Body, out); err != nil {
+		if out.written {
+			// The status has already been sent, so the client sees a truncated stream
+			log.Errorf("[handleStream]: Failed after writing: %v", err)
+			return
+		}
+		sendProcessError(w, err)
+	}
+}
+
+// streamResponseWriter tells if anything has been written to the response
+type streamResponseWriter struct {
+	w       io.Writer
+	written bool
+}
+
+func (s *streamResponseWriter) Write(p []byte) (int, error) {
+	s.written = true
+	return s.w.Write(p)
+}
+
+// sendProcessError sends the HTTP error for an error from processing a request
+func sendProcessError(w http.ResponseWriter, err error) {
+	if err == context.Canceled {
+		// The client has gone away, so nobody reads the response
+		log.Debugf("[sendProcessError]: Request was canceled")
+		metrics.RecordFailedOperationMetric(RequestCanceledError)
+		return
+	}
+	if err == context.DeadlineExceeded {
+		log.Debugf("[sendProcessError]: Request timed out")
+		sendHttpError(w, RequestTimeoutError, http.StatusServiceUnavailable)
+		return
+	}
+	if err == errors.ErrPrivateStateExpired {
+		log.Debugf("[sendProcessError]: Private state has expired")
+		sendHttpError(w, PrivateExpiredError, http.StatusGone)
+		return
+	}
+	if err == errors.ErrPrivateStateRevoked {
+		log.Debugf("[sendProcessError]: Private state tenant has been revoked")
+		sendHttpError(w, PrivateRevokedError, http.StatusForbidden)
+		return
+	}
+	if err == errors.ErrPrivateStateSchemaUnsupported || err == errors.ErrPrivateStreamSchemaUnsupported {
+		log.Debugf("[sendProcessError]: Private state schema version is not supported")
+		sendHttpError(w, PrivateSchemaError, http.StatusUnprocessableEntity)
+		return
+	}
+	if err == errors.ErrPublicSignatureMissing || err == errors.ErrPublicSignatureInvalid {
+		log.Debugf("[sendProcessError]: Public data signature is missing or invalid")
+		sendHttpError(w, InvalidSignatureError, http.StatusBadRequest)
+		return
+	}
+	if err == errors.ErrRequestEncodingError {
+		log.Errorf("[sendProcessError]: Failed to encode response")
+		sendHttpError(w, WritingBodyFailedError, http.StatusInternalServerError)
+		return
+	}
+	log.Errorf("[sendProcessError]: Failed to process body: %v", err)
+	sendHttpError(w, BadBodyError, http.StatusBadRequest)
+}
+
 func (s *Server) StartLocalServer(listen string) {
 
 	r := mux.NewRouter()
@@ -0,0 +1,66 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package apis_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/hyperifyio/statelessdb/pkg/apis"
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/requests"
+	"github.com/hyperifyio/statelessdb/pkg/states"
+)
+
+func TestServer_SetMaxBodySize(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Could not create server key: %v", err)
+	}
+	requestManager, err := requests.NewJsonRequestManager[*states.ComputeState, *requests.ComputeRequest, interface{}](
+		"ComputeState",
+		key,
+		func() *states.ComputeState { return &states.ComputeState{} },
+		func() *requests.ComputeRequest { return &requests.ComputeRequest{} },
+	)
+	if err != nil {
+		t.Fatalf("Could not create request manager: %v", err)
+	}
+	requestResponseManager := requestManager.HandleWith(func(r *requests.ComputeRequest, state *states.ComputeState) (*states.ComputeState, error) {
+		if state == nil {
+			state = states.NewComputeState(uuid.New(), uuid.New(), 0, 0, nil, nil, nil)
+		}
+		return state, nil
+	})
+
+	server := apis.NewServer()
+	server.SetMaxBodySize(64)
+	handler := server.BuildHandler(requestResponseManager)
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		status      int
+	}{
+		{"small", "application/json", `{}`, http.StatusOK},
+		{"too large", "application/json", `{"public":{"data":"` + strings.Repeat("x", 64) + `"}}`, http.StatusRequestEntityTooLarge},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/api/v1", bytes.NewBufferString(test.body))
+			req.Header.Set("Content-Type", test.contentType)
+			rr := httptest.NewRecorder()
+			handler(rr, req)
+			if rr.Code != test.status {
+				t.Errorf("Expected status %d, got %d: %s", test.status, rr.Code, rr.Body.String())
+			}
+		})
+	}
+}
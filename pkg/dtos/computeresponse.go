@@ -10,12 +10,13 @@ import (
 
 // ComputeResponseDTO struct defines the structure of the response DTO
 type ComputeResponseDTO struct {
-	Id      string                 `json:"id"`      // Id identifies the resource
-	Owner   string                 `json:"owner"`   // Owner is the owner of the resource
-	Created string                 `json:"created"` // Created is the time this resource was created
-	Updated string                 `json:"updated"` // Updated is the time this resource was updated last time
-	Public  map[string]interface{} `json:"public"`  // Public is public properties of the resource
-	Private string                 `json:"private"` // Private is the internal encrypted types.ComputeState
+	Id        string                 `json:"id"`                  // Id identifies the resource
+	Owner     string                 `json:"owner"`               // Owner is the owner of the resource
+	Created   string                 `json:"created"`             // Created is the time this resource was created
+	Updated   string                 `json:"updated"`             // Updated is the time this resource was updated last time
+	Public    map[string]interface{} `json:"public"`              // Public is public properties of the resource
+	Private   string                 `json:"private"`             // Private is the internal encrypted types.ComputeState
+	Signature string                 `json:"signature,omitempty"` // Signature is the signature of the public properties, if enabled
 }
 
 func NewComputeResponseDTO(
@@ -33,3 +34,13 @@ func NewComputeResponseDTO(
 		Private: private,
 	}
 }
+
+// SignedData returns the data which is signed for the public properties
+func (dto *ComputeResponseDTO) SignedData() []byte {
+	return NewPublicSignedData(dto.Id, dto.Owner, dto.Created, dto.Updated, dto.Public)
+}
+
+// SetSignature sets the signature of the public properties
+func (dto *ComputeResponseDTO) SetSignature(signature string) {
+	dto.Signature = signature
+}
@@ -29,9 +29,10 @@ func NewEventDTO(
 
 // EventListDTO struct defines DTO for event list
 type EventListDTO struct {
-	Created string      `json:"created"` // Created is the time when this event list was sent. You can use this to request more events after this time.
-	Payload []*EventDTO `json:"payload"` // Payload contains all events received
-	Private string      `json:"private"` // Private can be used to request next set of events. It contains information required to know when to
+	Created   string      `json:"created"`             // Created is the time when this event list was sent. You can use this to request more events after this time.
+	Payload   []*EventDTO `json:"payload"`             // Payload contains all events received
+	Private   string      `json:"private"`             // Private can be used to request next set of events. It contains information required to know when to
+	Signature string      `json:"signature,omitempty"` // Signature is the signature of the public properties of the resource, with Created as the updated time, if enabled
 }
 
 func NewEventListDTO(
@@ -45,3 +46,8 @@ func NewEventListDTO(
 		Private: private,
 	}
 }
+
+// SetSignature sets the signature of the public properties
+func (dto *EventListDTO) SetSignature(signature string) {
+	dto.Signature = signature
+}
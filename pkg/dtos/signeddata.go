@@ -0,0 +1,27 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package dtos
+
+import (
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/encodings/json"
+)
+
+// NewPublicSignedData returns the data which is signed for the public
+// properties of a resource. The public properties are JSON encoded with
+// sorted keys, so the same properties always have the same data. It returns
+// nil if the properties cannot be encoded.
+func NewPublicSignedData(id, owner, created, updated string, public map[string]interface{}) []byte {
+	encoded, err := json.Marshal(public)
+	if err != nil {
+		return nil
+	}
+	return encodings.NewAdditionalData([]byte(id), []byte(owner), []byte(created), []byte(updated), encoded)
+}
+
+// NewPublicSignedIdentity returns the identity of a resource as it is signed
+// with the public properties, so it can be compared to the decrypted state
+func NewPublicSignedIdentity(id, owner, created, updated string) []byte {
+	return encodings.NewAdditionalData([]byte(id), []byte(owner), []byte(created), []byte(updated))
+}
@@ -0,0 +1,24 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"encoding/binary"
+)
+
+// NewAdditionalData builds additional authenticated data from parts, e.g. a
+// purpose label and the identity of a resource. Each part is prefixed with
+// its length, so different parts never produce the same additional data.
+func NewAdditionalData(parts ...[]byte) []byte {
+	size := 0
+	for _, part := range parts {
+		size += binary.MaxVarintLen64 + len(part)
+	}
+	data := make([]byte, 0, size)
+	for _, part := range parts {
+		data = binary.AppendUvarint(data, uint64(len(part)))
+		data = append(data, part...)
+	}
+	return data
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/states"
+)
+
+func TestNewAdditionalData_IsUnambiguous(t *testing.T) {
+	a := encodings.NewAdditionalData([]byte("ab"), []byte("c"))
+	b := encodings.NewAdditionalData([]byte("a"), []byte("bc"))
+	if bytes.Equal(a, b) {
+		t.Errorf("Additional data for different parts should not be equal: %x", a)
+	}
+}
+
+func TestEncryptor_EncryptWithAdditionalData(t *testing.T) {
+	keys := newTestKeyRing(t, encodings.SuiteAES256GCM)
+	encryptor := encodings.NewEncryptor[*states.ComputeState](encodings.NewJsonSerializer[*states.ComputeState]("ComputeState"))
+	if err := encryptor.InitializeKeyRing(keys); err != nil {
+		t.Fatalf("Failed to initialize Encryptor: %v", err)
+	}
+	decryptor := encodings.NewDecryptor[*states.ComputeState](encodings.NewJsonUnserializer[*states.ComputeState]("ComputeState"))
+	if err := decryptor.InitializeKeyRing(keys); err != nil {
+		t.Fatalf("Failed to initialize Decryptor: %v", err)
+	}
+
+	data := states.NewComputeState(uuid.New(), uuid.New(), 0, 0, nil, nil, nil)
+	compute := encodings.NewAdditionalData([]byte("compute"), data.Id[:])
+	events := encodings.NewAdditionalData([]byte("events"), data.Id[:])
+	otherId := uuid.New()
+	other := encodings.NewAdditionalData([]byte("compute"), otherId[:])
+
+	ciphertext, err := encryptor.EncryptWithAdditionalData(data, compute)
+	if err != nil {
+		t.Fatalf("Encryption failed: %v", err)
+	}
+
+	decrypted := &states.ComputeState{}
+	if err = decryptor.DecryptWithAdditionalData(ciphertext, decrypted, compute); err != nil {
+		t.Fatalf("Decryption with the same additional data failed: %v", err)
+	}
+	if !decrypted.Equals(data) {
+		t.Errorf("Decrypted data does not match original.\nOriginal: %v\nDecrypted: %v", data, decrypted)
+	}
+
+	if err = decryptor.DecryptWithAdditionalData(ciphertext, &states.ComputeState{}, events, compute); err != nil {
+		t.Errorf("Decryption with one matching candidate failed: %v", err)
+	}
+
+	if err = decryptor.DecryptWithAdditionalData(ciphertext, &states.ComputeState{}, events); err == nil {
+		t.Errorf("Decryption with a different purpose should fail")
+	}
+
+	if err = decryptor.DecryptWithAdditionalData(ciphertext, &states.ComputeState{}, other); err == nil {
+		t.Errorf("Decryption with a different resource should fail")
+	}
+
+	if err = decryptor.Decrypt(ciphertext, &states.ComputeState{}); err == nil {
+		t.Errorf("Decryption without additional data should fail")
+	}
+}
+
+func TestDecryptor_DecryptWithAdditionalData_Unbound(t *testing.T) {
+	keys := newTestKeyRing(t, encodings.SuiteAES256GCM)
+	encryptor := encodings.NewEncryptor[*states.ComputeState](encodings.NewJsonSerializer[*states.ComputeState]("ComputeState"))
+	if err := encryptor.InitializeKeyRing(keys); err != nil {
+		t.Fatalf("Failed to initialize Encryptor: %v", err)
+	}
+	decryptor := encodings.NewDecryptor[*states.ComputeState](encodings.NewJsonUnserializer[*states.ComputeState]("ComputeState"))
+	if err := decryptor.InitializeKeyRing(keys); err != nil {
+		t.Fatalf("Failed to initialize Decryptor: %v", err)
+	}
+
+	data := states.NewComputeState(uuid.New(), uuid.New(), 0, 0, nil, nil, nil)
+	ciphertext, err := encryptor.Encrypt(data)
+	if err != nil {
+		t.Fatalf("Encryption failed: %v", err)
+	}
+
+	compute := encodings.NewAdditionalData([]byte("compute"), data.Id[:])
+	if err = decryptor.DecryptWithAdditionalData(ciphertext, &states.ComputeState{}, compute); err == nil {
+		t.Errorf("Decryption of unbound data with additional data should fail")
+	}
+}
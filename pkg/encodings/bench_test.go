@@ -8,6 +8,7 @@ import (
 
 	"bytes"
 	"encoding/gob"
+	"strings"
 
 	"github.com/google/uuid"
 
@@ -37,113 +38,180 @@ func BenchmarkEncryptorDecryptor(b *testing.B) {
 		b.Fatalf("Failed to generate key: %v", err)
 	}
 
-	///// GOB tests /////
+	for _, format := range encodings.Formats() {
+		b.Run(strings.ToUpper(format.String()), func(b *testing.B) {
 
-	b.Run("GOB", func(b *testing.B) {
-
-		gobSerializer := encodings.NewGobSerializer[*states.ComputeState](dtoName)
-		gobUnserializer := encodings.NewGobUnserializer[*states.ComputeState](dtoName)
-
-		gobEncryptor := encodings.NewEncryptor[*states.ComputeState](gobSerializer)
-		err = gobEncryptor.Initialize(key)
-		if err != nil {
-			b.Fatalf("Failed to initialize dobEncryptor: %v", err)
-		}
-
-		gobDecryptor := encodings.NewDecryptor[*states.ComputeState](gobUnserializer)
-		err = gobDecryptor.Initialize(key)
-		if err != nil {
-			b.Fatalf("Failed to initialize gobDecryptor: %v", err)
-		}
-
-		// Pre-encrypt the plaintext to use in decryption benchmark
-		gobCiphertext, err := gobEncryptor.Encrypt(dto)
-		if err != nil {
-			b.Fatalf("GOB encryption failed: %v", err)
-		}
-
-		b.Run("Encrypt_Encode", func(b *testing.B) {
-			b.StopTimer()
-			b.ResetTimer()
-			for i := 0; i < b.N; i++ {
-				b.StartTimer()
-				_, err := gobEncryptor.Encrypt(dto)
-				b.StopTimer()
-				if err != nil {
-					b.Fatalf("Encryption failed: %v", err)
-				}
+			serializer, err := encodings.NewFormatSerializer[*states.ComputeState](format, dtoName)
+			if err != nil {
+				b.Fatalf("Failed to create serializer: %v", err)
 			}
-		})
-
-		b.Run("Decrypt_Decode", func(b *testing.B) {
-			out := states.ComputeState{}
-			b.StopTimer()
-			b.ResetTimer()
-			for i := 0; i < b.N; i++ {
-				b.StartTimer()
-				err := gobDecryptor.Decrypt(gobCiphertext, &out)
-				b.StopTimer()
-				if err != nil {
-					b.Fatalf("Decryption failed: %v", err)
-				}
+			unserializer, err := encodings.NewFormatUnserializer[*states.ComputeState](format, dtoName)
+			if err != nil {
+				b.Fatalf("Failed to create unserializer: %v", err)
 			}
-		})
 
-	})
+			for _, suite := range encodings.Suites() {
+				b.Run(suite.String(), func(b *testing.B) {
+
+					keys, err := encodings.NewKeyRingWithSuite(suite, key)
+					if err != nil {
+						b.Fatalf("Failed to create key ring: %v", err)
+					}
+
+					encryptor := encodings.NewEncryptor[*states.ComputeState](serializer)
+					err = encryptor.InitializeKeyRing(keys)
+					if err != nil {
+						b.Fatalf("Failed to initialize encryptor: %v", err)
+					}
+
+					decryptor := encodings.NewDecryptor[*states.ComputeState](unserializer)
+					err = decryptor.InitializeKeyRing(keys)
+					if err != nil {
+						b.Fatalf("Failed to initialize decryptor: %v", err)
+					}
+
+					// Pre-encrypt the plaintext to use in decryption benchmark
+					ciphertext, err := encryptor.Encrypt(dto)
+					if err != nil {
+						b.Fatalf("%s encryption failed: %v", format, err)
+					}
+
+					b.Run("Encrypt_Encode", func(b *testing.B) {
+						b.StopTimer()
+						b.ResetTimer()
+						for i := 0; i < b.N; i++ {
+							b.StartTimer()
+							_, err := encryptor.Encrypt(dto)
+							b.StopTimer()
+							if err != nil {
+								b.Fatalf("Encryption failed: %v", err)
+							}
+						}
+					})
+
+					b.Run("Decrypt_Decode", func(b *testing.B) {
+						out := states.ComputeState{}
+						b.StopTimer()
+						b.ResetTimer()
+						for i := 0; i < b.N; i++ {
+							b.StartTimer()
+							err := decryptor.Decrypt(ciphertext, &out)
+							b.StopTimer()
+							if err != nil {
+								b.Fatalf("Decryption failed: %v", err)
+							}
+						}
+					})
+
+					b.Run("EncryptTo", func(b *testing.B) {
+						buf := encodings.GetByteSlice(len(ciphertext))
+						defer func() { encodings.ReleaseByteSlice(buf) }()
+						b.ReportAllocs()
+						b.ResetTimer()
+						for i := 0; i < b.N; i++ {
+							buf, err = encryptor.EncryptTo(buf[:0], dto, nil, encodings.Lifetime{})
+							if err != nil {
+								b.Fatalf("Encryption failed: %v", err)
+							}
+						}
+					})
+
+					b.Run("DecryptFrom", func(b *testing.B) {
+						out := states.ComputeState{}
+						src := []byte(ciphertext)
+						b.ReportAllocs()
+						b.ResetTimer()
+						for i := 0; i < b.N; i++ {
+							if _, err := decryptor.DecryptFrom(src, &out); err != nil {
+								b.Fatalf("Decryption failed: %v", err)
+							}
+						}
+					})
+
+				})
+			}
 
-	///// JSON tests /////
+		})
+	}
 
-	b.Run("JSON", func(b *testing.B) {
+}
 
-		jsonSerializer := encodings.NewJsonSerializer[*states.ComputeState](dtoName)
-		jsonUnserializer := encodings.NewJsonUnserializer[*states.ComputeState](dtoName)
+// BenchmarkCompression shows the size of the encrypted private data and the
+// time spent with each compression. The size is reported as private-bytes.
+func BenchmarkCompression(b *testing.B) {
 
-		jsonEncryptor := encodings.NewEncryptor[*states.ComputeState](jsonSerializer)
-		err = jsonEncryptor.Initialize(key)
-		if err != nil {
-			b.Fatalf("Failed to initialize jsonEncryptor: %v", err)
-		}
+	dtoName := "ComputeState"
+	dto := newLargeComputeState(50)
 
-		jsonDecryptor := encodings.NewDecryptor[*states.ComputeState](jsonUnserializer)
-		err = jsonDecryptor.Initialize(key)
-		if err != nil {
-			b.Fatalf("Failed to initialize jsonDecryptor: %v", err)
-		}
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		b.Fatalf("Failed to generate key: %v", err)
+	}
 
-		// Pre-encrypt the plaintext to use in decryption benchmark
-		jsonCiphertext, err := jsonEncryptor.Encrypt(dto)
-		if err != nil {
-			b.Fatalf("JSON encryption failed: %v", err)
-		}
+	for _, format := range encodings.Formats() {
+		b.Run(strings.ToUpper(format.String()), func(b *testing.B) {
 
-		b.Run("Encrypt_Encode", func(b *testing.B) {
-			b.StopTimer()
-			b.ResetTimer()
-			for i := 0; i < b.N; i++ {
-				b.StartTimer()
-				_, err := jsonEncryptor.Encrypt(dto)
-				b.StopTimer()
-				if err != nil {
-					b.Fatalf("Encryption failed: %v", err)
-				}
+			serializer, err := encodings.NewFormatSerializer[*states.ComputeState](format, dtoName)
+			if err != nil {
+				b.Fatalf("Failed to create serializer: %v", err)
+			}
+			unserializer, err := encodings.NewFormatUnserializer[*states.ComputeState](format, dtoName)
+			if err != nil {
+				b.Fatalf("Failed to create unserializer: %v", err)
 			}
-		})
 
-		b.Run("Decrypt_Decode", func(b *testing.B) {
-			out := states.ComputeState{}
-			b.StopTimer()
-			b.ResetTimer()
-			for i := 0; i < b.N; i++ {
-				b.StartTimer()
-				err := jsonDecryptor.Decrypt(jsonCiphertext, &out)
-				b.StopTimer()
-				if err != nil {
-					b.Fatalf("Decryption failed: %v", err)
-				}
+			for _, compression := range encodings.Compressions() {
+				b.Run(compression.String(), func(b *testing.B) {
+
+					encryptor := encodings.NewEncryptor[*states.ComputeState](encodings.NewCompressingSerializer[*states.ComputeState](serializer, compression, encodings.DefaultCompressionThreshold))
+					if err := encryptor.Initialize(key); err != nil {
+						b.Fatalf("Failed to initialize encryptor: %v", err)
+					}
+
+					decryptor := encodings.NewDecryptor[*states.ComputeState](encodings.NewCompressingUnserializer[*states.ComputeState](unserializer))
+					if err := decryptor.Initialize(key); err != nil {
+						b.Fatalf("Failed to initialize decryptor: %v", err)
+					}
+
+					// Pre-encrypt the plaintext to use in decryption benchmark
+					ciphertext, err := encryptor.Encrypt(dto)
+					if err != nil {
+						b.Fatalf("Encryption failed: %v", err)
+					}
+
+					b.Run("Encrypt_Encode", func(b *testing.B) {
+						b.StopTimer()
+						b.ResetTimer()
+						for i := 0; i < b.N; i++ {
+							b.StartTimer()
+							_, err := encryptor.Encrypt(dto)
+							b.StopTimer()
+							if err != nil {
+								b.Fatalf("Encryption failed: %v", err)
+							}
+						}
+						b.ReportMetric(float64(len(ciphertext)), "private-bytes")
+					})
+
+					b.Run("Decrypt_Decode", func(b *testing.B) {
+						out := states.ComputeState{}
+						b.StopTimer()
+						b.ResetTimer()
+						for i := 0; i < b.N; i++ {
+							b.StartTimer()
+							err := decryptor.Decrypt(ciphertext, &out)
+							b.StopTimer()
+							if err != nil {
+								b.Fatalf("Decryption failed: %v", err)
+							}
+						}
+						b.ReportMetric(float64(len(ciphertext)), "private-bytes")
+					})
+
+				})
 			}
 		})
-
-	})
+	}
 
 }
 
@@ -5,24 +5,26 @@ package encodings
 
 import (
 	"bytes"
+
 	"github.com/hyperifyio/statelessdb/pkg/types"
 )
 
-var bytesBufferPoolManager *types.MemoryPoolManager[*bytes.Buffer]
-
-func init() {
-	bytesBufferPoolManager = types.NewMemoryPoolManager[*bytes.Buffer](func(size int) func() *bytes.Buffer {
-		return func() *bytes.Buffer {
-			return new(bytes.Buffer)
-		}
-	})
-}
+// bytesBufferPoolManager pools buffers by size classes
+var bytesBufferPoolManager = types.NewMemoryPoolManager[*bytes.Buffer](
+	"encodings_buffers",
+	func(capacity int) *bytes.Buffer {
+		return bytes.NewBuffer(make([]byte, 0, capacity))
+	},
+	func(b *bytes.Buffer) int {
+		return b.Cap()
+	},
+)
 
 func getBytesBuffer() *bytes.Buffer {
-	return bytesBufferPoolManager.Pool(0).Get()
+	return bytesBufferPoolManager.Get(0)
 }
 
 func releaseBytesBuffer(s *bytes.Buffer) {
 	s.Reset()
-	bytesBufferPoolManager.Pool(0).Put(s)
+	bytesBufferPoolManager.Put(s)
 }
@@ -3,30 +3,31 @@
 
 package encodings
 
-//var byteSlicePoolManager *types.MemoryPoolManager[[]byte]
-//
-//func init () {
-//	byteSlicePoolManager = types.NewMemoryPoolManager[[]byte]( func(size int) func () []byte {
-//		return func () []byte {
-//			return make([]byte, 0, size)
-//		}
-//	})
-//}
-//
-//// There is no proof using []byte pool helps, so it is not used right now.
-//// Here for benchmarking purposes only.
-//// @deprecated
-//func getByteSlice (size, capacity int) []byte {
-//	p := capacity
-//	s := byteSlicePoolManager.Pool(p).Get()
-//	//if cap(s) < capacity {
-//	//	return make([]byte, size, capacity)
-//	//}
-//	s = s[:size]
-//	return s
-//}
-//
-//func releaseByteSlice (s []byte) {
-//	p := cap(s)
-//	byteSlicePoolManager.Pool(p).Put(s[:0])
-//}
+import (
+	"github.com/hyperifyio/statelessdb/pkg/types"
+)
+
+// byteSlicePoolManager pools byte slices by size classes
+var byteSlicePoolManager = types.NewMemoryPoolManager[[]byte](
+	"encodings_byte_slices",
+	func(capacity int) []byte {
+		return make([]byte, 0, capacity)
+	},
+	func(s []byte) int {
+		return cap(s)
+	},
+)
+
+// GetByteSlice returns an empty byte slice with at least the capacity from
+// a pool. It should be returned with ReleaseByteSlice when it is not used
+// anymore, e.g. after the output of Encryptor.EncryptTo has been written.
+func GetByteSlice(capacity int) []byte {
+	return byteSlicePoolManager.Get(capacity)
+}
+
+// ReleaseByteSlice returns a byte slice from GetByteSlice to the pool. The
+// slice may have been grown by appending to it. It must not be used after
+// it has been released.
+func ReleaseByteSlice(s []byte) {
+	byteSlicePoolManager.Put(s[:0])
+}
@@ -0,0 +1,21 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+)
+
+func TestGetByteSlice(t *testing.T) {
+	for _, capacity := range []int{0, 1, 255, 256, 257, 4000, 1 << 20, 1<<20 + 1} {
+		s := encodings.GetByteSlice(capacity)
+		if len(s) != 0 || cap(s) < capacity {
+			t.Errorf("GetByteSlice(%d) returned length %d and capacity %d", capacity, len(s), cap(s))
+		}
+		s = append(s, make([]byte, capacity+100)...)
+		encodings.ReleaseByteSlice(s)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// cborEncMode encodes maps with sorted keys, so the same data is always
+// encoded the same way
+var cborEncMode, _ = cbor.EncOptions{
+	Sort: cbor.SortCoreDeterministic,
+	Time: cbor.TimeRFC3339Nano,
+}.EncMode()
+
+var cborEncoderPoolState = sync.Pool{
+	New: func() interface{} {
+		buf := new(bytes.Buffer)
+		return &CborEncoderState{
+			buf,
+			cborEncMode.NewEncoder(buf),
+		}
+	},
+}
+
+func GetCborEncoderState() *CborEncoderState {
+	return cborEncoderPoolState.Get().(*CborEncoderState)
+}
+
+type CborEncoderState struct {
+	buffer  *bytes.Buffer
+	Encoder *cbor.Encoder
+}
+
+var _ SerializerState = &CborEncoderState{}
+
+func (e *CborEncoderState) Release() {
+	e.buffer.Reset()
+	cborEncoderPoolState.Put(e)
+}
+
+func (e *CborEncoderState) Bytes() []byte {
+	return e.buffer.Bytes()
+}
+
+// CborSerializer manages a pool of cbor.Encoder instances.
+type CborSerializer[T interface{}] struct {
+}
+
+var _ Serializer[string] = &CborSerializer[string]{}
+
+// NewCborSerializer initializes a new CborSerializer with a cbor.Encoder pool.
+func NewCborSerializer[T interface{}](name string) *CborSerializer[T] {
+	return &CborSerializer[T]{}
+}
+
+// Serialize serializes the given data using a reusable cbor.Encoder.
+// It returns the serialized bytes or an error.
+func (s *CborSerializer[T]) Serialize(data T) (SerializerState, error) {
+	state := GetCborEncoderState()
+	if err := state.Encoder.Encode(data); err != nil {
+		state.Release()
+		return nil, err
+	}
+	return state, nil
+}
+
+// Format returns FormatCbor
+func (s *CborSerializer[T]) Format() Format {
+	return FormatCbor
+}
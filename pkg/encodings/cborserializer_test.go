@@ -0,0 +1,50 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+// TestCborSerializer_Deterministic tests that maps are always encoded the
+// same way
+func TestCborSerializer_Deterministic(t *testing.T) {
+	serializer := encodings.NewCborSerializer[*SampleStruct]("SampleStruct")
+	data := &SampleStruct{
+		ID:   1,
+		Name: "Deterministic Test",
+		Details: map[string]string{
+			"a": "1", "b": "2", "c": "3", "d": "4", "e": "5", "f": "6",
+		},
+	}
+
+	first, err := serializer.Serialize(data)
+	if err != nil {
+		t.Fatalf("Failed to serialize data: %v", err)
+	}
+	defer first.Release()
+
+	for i := 0; i < 10; i++ {
+		state, err := serializer.Serialize(data)
+		if err != nil {
+			t.Fatalf("Failed to serialize data: %v", err)
+		}
+		if !bytes.Equal(state.Bytes(), first.Bytes()) {
+			t.Errorf("Expected the same encoding, got %x and %x", first.Bytes(), state.Bytes())
+		}
+		state.Release()
+	}
+}
+
+// TestCborUnserializer_InvalidData tests unserialization of invalid data
+func TestCborUnserializer_InvalidData(t *testing.T) {
+	unserializer := encodings.NewCborUnserializer[*SampleStruct]("SampleStruct")
+	if err := unserializer.Unserialize([]byte{0xff, 0x00, 0x01}, &SampleStruct{}); err != errors.ErrDecryptDecodingCborSerializationFailed {
+		t.Errorf("Expected ErrDecryptDecodingCborSerializationFailed, got %v", err)
+	}
+}
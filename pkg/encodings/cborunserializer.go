@@ -0,0 +1,56 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+// cborDecMode decodes maps inside interface{} values to map[string]interface{}
+// like the JSON decoder, instead of map[interface{}]interface{}
+var cborDecMode, _ = cbor.DecOptions{
+	DefaultMapType:   reflect.TypeOf(map[string]interface{}(nil)),
+	MaxNestedLevels:  64,
+	MaxArrayElements: MaxDecompressedSize,
+	MaxMapPairs:      MaxDecompressedSize,
+}.DecMode()
+
+// CborUnserializer decodes data serialized by CborSerializer.
+type CborUnserializer[T interface{}] struct {
+}
+
+var _ StreamUnserializer[string] = &CborUnserializer[string]{}
+
+// NewCborUnserializer initializes and returns a new CborUnserializer.
+func NewCborUnserializer[T interface{}](name string) *CborUnserializer[T] {
+	return &CborUnserializer[T]{}
+}
+
+// Unserialize decodes serialized data
+func (u *CborUnserializer[T]) Unserialize(serialized []byte, out T) error {
+	if err := cborDecMode.Unmarshal(serialized, out); err != nil {
+		log.Errorf("[CborUnserializer.Unserialize]: cbor decode failed: %v", err)
+		return errors.ErrDecryptDecodingCborSerializationFailed
+	}
+	return nil
+}
+
+// Format returns FormatCbor
+// UnserializeFrom decodes data while it is read from r
+func (u *CborUnserializer[T]) UnserializeFrom(r io.Reader, out T) error {
+	if err := cborDecMode.NewDecoder(r).Decode(out); err != nil {
+		log.Errorf("[CborUnserializer.UnserializeFrom]: cbor decode failed: %v", err)
+		return errors.ErrDecryptDecodingCborStreamFailed
+	}
+	return nil
+}
+
+func (u *CborUnserializer[T]) Format() Format {
+	return FormatCbor
+}
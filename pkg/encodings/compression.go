@@ -0,0 +1,302 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"bytes"
+	"compress/flate"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+// Compression is the compression algorithm recorded in the header byte in
+// front of the compressed data
+type Compression uint8
+
+const (
+	CompressionNone   Compression = 0 // CompressionNone is uncompressed data
+	CompressionFlate  Compression = 1 // CompressionFlate is DEFLATE, slow but compact
+	CompressionZstd   Compression = 2 // CompressionZstd is Zstandard, fast and compact
+	CompressionSnappy Compression = 3 // CompressionSnappy is Snappy, fastest but least compact
+)
+
+// Compressions returns all supported compression algorithms
+func Compressions() []Compression {
+	return []Compression{
+		CompressionNone,
+		CompressionFlate,
+		CompressionZstd,
+		CompressionSnappy,
+	}
+}
+
+// ParseCompression returns the compression by its name
+func ParseCompression(name string) (Compression, error) {
+	for _, compression := range Compressions() {
+		if compression.String() == name {
+			return compression, nil
+		}
+	}
+	return 0, errors.ErrUnknownCompression
+}
+
+func (c Compression) String() string {
+	switch c {
+	case CompressionNone:
+		return "none"
+	case CompressionFlate:
+		return "flate"
+	case CompressionZstd:
+		return "zstd"
+	case CompressionSnappy:
+		return "snappy"
+	default:
+		return "unknown"
+	}
+}
+
+var flateWriterPool = sync.Pool{
+	New: func() interface{} {
+		w, _ := flate.NewWriter(nil, flate.BestCompression)
+		return w
+	},
+}
+
+var flateReaderPool = sync.Pool{
+	New: func() interface{} {
+		return flate.NewReader(nil)
+	},
+}
+
+var zstdEncoder, _ = zstd.NewWriter(nil,
+	zstd.WithEncoderLevel(zstd.SpeedDefault),
+	zstd.WithEncoderConcurrency(1),
+	zstd.WithZeroFrames(true),
+)
+
+var zstdDecoder, _ = zstd.NewReader(nil,
+	zstd.WithDecoderConcurrency(0),
+	zstd.WithDecoderMaxMemory(MaxDecompressedSize),
+)
+
+// CompressedState is the serialized data with the compression header
+type CompressedState struct {
+	buffer *bytes.Buffer
+}
+
+var _ SerializerState = &CompressedState{}
+
+func (s *CompressedState) Release() {
+	releaseBytesBuffer(s.buffer)
+}
+
+func (s *CompressedState) Bytes() []byte {
+	return s.buffer.Bytes()
+}
+
+// CompressingSerializer compresses data from another serializer. The result
+// starts with a header byte which tells the compression. Data smaller than the
+// threshold, or data which does not get smaller, is stored uncompressed.
+type CompressingSerializer[T interface{}] struct {
+	serializer  Serializer[T]
+	compression Compression
+	threshold   int
+}
+
+var _ Serializer[string] = &CompressingSerializer[string]{}
+
+// NewCompressingSerializer creates a serializer which compresses data from
+// the serializer when it is at least threshold bytes
+func NewCompressingSerializer[T interface{}](serializer Serializer[T], compression Compression, threshold int) *CompressingSerializer[T] {
+	return &CompressingSerializer[T]{
+		serializer:  serializer,
+		compression: compression,
+		threshold:   threshold,
+	}
+}
+
+// Serialize serializes and compresses the data
+func (s *CompressingSerializer[T]) Serialize(data T) (SerializerState, error) {
+	state, err := s.serializer.Serialize(data)
+	if err != nil {
+		return nil, err
+	}
+	defer state.Release()
+	serialized := state.Bytes()
+
+	buf := getBytesBuffer()
+	if s.compression != CompressionNone && len(serialized) >= s.threshold {
+		buf.WriteByte(byte(s.compression))
+		if err = compress(buf, s.compression, serialized); err != nil {
+			releaseBytesBuffer(buf)
+			log.Errorf("[CompressingSerializer.Serialize]: %s: %v", s.compression, err)
+			return nil, errors.ErrCompressionFailed
+		}
+		if buf.Len() < 1+len(serialized) {
+			return &CompressedState{buf}, nil
+		}
+		buf.Reset()
+	}
+	buf.WriteByte(byte(CompressionNone))
+	buf.Write(serialized)
+	return &CompressedState{buf}, nil
+}
+
+// Format returns the format of the serializer
+func (s *CompressingSerializer[T]) Format() Format {
+	return formatOf(s.serializer)
+}
+
+// compress appends compressed data to the buffer
+func compress(buf *bytes.Buffer, compression Compression, data []byte) error {
+	switch compression {
+	case CompressionFlate:
+		w := flateWriterPool.Get().(*flate.Writer)
+		defer func() {
+			w.Reset(io.Discard)
+			flateWriterPool.Put(w)
+		}()
+		w.Reset(buf)
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+		return w.Close()
+	case CompressionZstd:
+		buf.Write(zstdEncoder.EncodeAll(data, buf.AvailableBuffer()))
+		return nil
+	case CompressionSnappy:
+		buf.Write(s2.EncodeSnappy(buf.AvailableBuffer()[:0], data))
+		return nil
+	default:
+		return errors.ErrUnsupportedCompression
+	}
+}
+
+// CompressingUnserializer decompresses data from CompressingSerializer before
+// passing it to another unserializer. Data which does not start with a known
+// compression header is rejected. The Decryptor skips it for envelopes
+// without EnvelopeFlagCompressed, so it can always be used, e.g. for data
+// serialized before compression was enabled.
+type CompressingUnserializer[T interface{}] struct {
+	unserializer Unserializer[T]
+}
+
+var _ VersionedUnserializer[string] = &CompressingUnserializer[string]{}
+
+// NewCompressingUnserializer creates an unserializer which decompresses data
+// for the unserializer
+func NewCompressingUnserializer[T interface{}](unserializer Unserializer[T]) *CompressingUnserializer[T] {
+	return &CompressingUnserializer[T]{unserializer: unserializer}
+}
+
+// Unserialize decompresses and unserializes data
+func (u *CompressingUnserializer[T]) Unserialize(serialized []byte, out T) error {
+	return u.decompressed(serialized, func(data []byte) error {
+		return u.unserializer.Unserialize(data, out)
+	})
+}
+
+// UnserializeVersion decompresses data and passes the schema version to the
+// unserializer, if it is a VersionedUnserializer
+func (u *CompressingUnserializer[T]) UnserializeVersion(serialized []byte, version uint32, out T) error {
+	return u.decompressed(serialized, func(data []byte) error {
+		return unserializeVersion(u.unserializer, data, version, out)
+	})
+}
+
+// Format returns the format of the unserializer
+func (u *CompressingUnserializer[T]) Format() Format {
+	return formatOf(u.unserializer)
+}
+
+// compressionFlags returns EnvelopeFlagCompressed if the serializer writes the
+// compression header
+func compressionFlags[T interface{}](serializer Serializer[T]) byte {
+	if _, ok := serializer.(*CompressingSerializer[T]); ok {
+		return EnvelopeFlagCompressed
+	}
+	return 0
+}
+
+// uncompressedOf returns the unserializer of a CompressingUnserializer if the
+// envelope flags say the data was not serialized with the compression header
+func uncompressedOf[T interface{}](unserializer Unserializer[T], flags byte) Unserializer[T] {
+	if compressing, ok := unserializer.(*CompressingUnserializer[T]); ok && flags&EnvelopeFlagCompressed == 0 {
+		return compressing.unserializer
+	}
+	return unserializer
+}
+
+// decompressed calls unserialize with decompressed data
+func (u *CompressingUnserializer[T]) decompressed(serialized []byte, unserialize func(data []byte) error) error {
+	if len(serialized) == 0 {
+		return unserialize(serialized)
+	}
+	compression := Compression(serialized[0])
+	switch compression {
+	case CompressionNone:
+		return unserialize(serialized[1:])
+	case CompressionFlate, CompressionZstd, CompressionSnappy:
+		buf := getBytesBuffer()
+		defer releaseBytesBuffer(buf)
+		if err := decompress(buf, compression, serialized[1:]); err != nil {
+			log.Errorf("[CompressingUnserializer.Unserialize]: %s: %v", compression, err)
+			return errors.ErrDecompressionFailed
+		}
+		return unserialize(buf.Bytes())
+	default:
+		log.Errorf("[CompressingUnserializer.Unserialize]: unknown compression header %d", serialized[0])
+		return errors.ErrUnknownCompression
+	}
+}
+
+// decompress appends decompressed data to the buffer. The decompressed size is
+// limited to MaxDecompressedSize.
+func decompress(buf *bytes.Buffer, compression Compression, data []byte) error {
+	switch compression {
+	case CompressionFlate:
+		r := flateReaderPool.Get().(io.ReadCloser)
+		defer flateReaderPool.Put(r)
+		if err := r.(flate.Resetter).Reset(bytes.NewReader(data), nil); err != nil {
+			return err
+		}
+		n, err := buf.ReadFrom(io.LimitReader(r, MaxDecompressedSize+1))
+		if err != nil {
+			return err
+		}
+		if n > MaxDecompressedSize {
+			return errors.ErrFlateDecompressedSizeExceeded
+		}
+		return nil
+	case CompressionZstd:
+		decoded, err := zstdDecoder.DecodeAll(data, buf.AvailableBuffer())
+		if err != nil {
+			return err
+		}
+		buf.Write(decoded)
+		return nil
+	case CompressionSnappy:
+		size, err := s2.DecodedLen(data)
+		if err != nil {
+			return err
+		}
+		if size > MaxDecompressedSize {
+			return errors.ErrSnappyDecompressedSizeExceeded
+		}
+		buf.Grow(size)
+		decoded, err := s2.Decode(buf.AvailableBuffer()[:size], data)
+		if err != nil {
+			return err
+		}
+		buf.Write(decoded)
+		return nil
+	default:
+		return errors.ErrUnsupportedDecompression
+	}
+}
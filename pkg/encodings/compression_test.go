@@ -0,0 +1,97 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+	"github.com/hyperifyio/statelessdb/pkg/states"
+)
+
+// newLargeComputeState creates a state with large public and private maps
+func newLargeComputeState(size int) *states.ComputeState {
+	public := make(map[string]interface{}, size)
+	private := make(map[string]interface{}, size)
+	for i := 0; i < size; i++ {
+		public[fmt.Sprintf("public-property-%d", i)] = fmt.Sprintf("public value number %d", i)
+		private[fmt.Sprintf("private-property-%d", i)] = fmt.Sprintf("private value number %d", i)
+	}
+	return states.NewComputeState(uuid.New(), uuid.New(), 1000, 2000, public, private, nil)
+}
+
+func TestCompressingSerializer_RoundTrip(t *testing.T) {
+	for _, compression := range encodings.Compressions() {
+		t.Run(compression.String(), func(t *testing.T) {
+			serializer := encodings.NewCompressingSerializer[*states.ComputeState](encodings.NewJsonSerializer[*states.ComputeState]("ComputeState"), compression, encodings.DefaultCompressionThreshold)
+			unserializer := encodings.NewCompressingUnserializer[*states.ComputeState](encodings.NewJsonUnserializer[*states.ComputeState]("ComputeState"))
+
+			data := newLargeComputeState(50)
+			state, err := serializer.Serialize(data)
+			if err != nil {
+				t.Fatalf("Serialize failed: %v", err)
+			}
+			defer state.Release()
+
+			serialized := state.Bytes()
+			if serialized[0] != byte(compression) {
+				t.Errorf("Expected header %d, got %d", compression, serialized[0])
+			}
+
+			decoded := &states.ComputeState{}
+			if err = unserializer.Unserialize(serialized, decoded); err != nil {
+				t.Fatalf("Unserialize failed: %v", err)
+			}
+			if !decoded.Equals(data) {
+				t.Errorf("Decoded data does not match original.\nOriginal: %v\nDecoded: %v", data, decoded)
+			}
+		})
+	}
+}
+
+func TestCompressingSerializer_BelowThreshold(t *testing.T) {
+	serializer := encodings.NewCompressingSerializer[*states.ComputeState](encodings.NewJsonSerializer[*states.ComputeState]("ComputeState"), encodings.CompressionZstd, 1<<20)
+
+	state, err := serializer.Serialize(newLargeComputeState(10))
+	if err != nil {
+		t.Fatalf("Serialize failed: %v", err)
+	}
+	defer state.Release()
+
+	if header := state.Bytes()[0]; header != byte(encodings.CompressionNone) {
+		t.Errorf("Data below threshold should not be compressed, got header %d", header)
+	}
+}
+
+func TestCompressingUnserializer_Uncompressed(t *testing.T) {
+	serializer := encodings.NewJsonSerializer[*states.ComputeState]("ComputeState")
+	unserializer := encodings.NewCompressingUnserializer[*states.ComputeState](encodings.NewJsonUnserializer[*states.ComputeState]("ComputeState"))
+
+	data := newLargeComputeState(10)
+	state, err := serializer.Serialize(data)
+	if err != nil {
+		t.Fatalf("Serialize failed: %v", err)
+	}
+	defer state.Release()
+
+	// The Decryptor skips the unserializer for data without a header, so an
+	// unknown header is an error rather than uncompressed data
+	if err = unserializer.Unserialize(state.Bytes(), &states.ComputeState{}); err != errors.ErrUnknownCompression {
+		t.Errorf("Expected error %v for data without a header, got %v", errors.ErrUnknownCompression, err)
+	}
+}
+
+func TestCompressingUnserializer_InvalidData(t *testing.T) {
+	unserializer := encodings.NewCompressingUnserializer[*states.ComputeState](encodings.NewJsonUnserializer[*states.ComputeState]("ComputeState"))
+	for _, compression := range []encodings.Compression{encodings.CompressionFlate, encodings.CompressionZstd, encodings.CompressionSnappy} {
+		data := []byte{byte(compression), 0xff, 0xfe, 0xfd, 0xfc, 0xfb}
+		if err := unserializer.Unserialize(data, &states.ComputeState{}); err != errors.ErrDecompressionFailed {
+			t.Errorf("%s: expected error %v, got %v", compression, errors.ErrDecompressionFailed, err)
+		}
+	}
+}
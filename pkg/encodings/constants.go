@@ -3,10 +3,37 @@
 
 package encodings
 
+import "math"
+
 const (
 	MinimumKeySizeAES256                   = 32 // AES-256
 	DefaultDecryptDataBufferCapacity       = 1024
 	DefaultDecryptSerializedBufferCapacity = 1024
 	DefaultEncryptBufferCapacity           = 1024
 	ByteBufferPoolCapacityFactor           = 512
+	KeyIDSize                              = 4    // Size of the key ID in the envelope
+	EnvelopeMagic                          = 0xDB // First byte of envelopes since version 4
+	EnvelopeVersion4                       = 4    // Envelope with a magic byte, a version, a cipher suite, a serialization format, flags and a key ID
+	EnvelopeHeaderSizeV4                   = 5 + KeyIDSize
+	EnvelopeMaxOverhead                    = 24 + 16 // Largest nonce and tag of the cipher suites
+	EnvelopeFlagLifetime                   = 1 << 0  // Plaintext starts with the lifetime
+	EnvelopeFlagPadding                    = 1 << 1  // Plaintext ends with padding
+	EnvelopeFlagSchema                     = 1 << 2  // Plaintext has the schema version after the lifetime
+	EnvelopeFlagDeterministic              = 1 << 3  // Nonce is derived from the plaintext
+	EnvelopeFlagCompressed                 = 1 << 4  // Serialized data starts with the compression header
+	EnvelopeKnownFlags                     = EnvelopeFlagLifetime | EnvelopeFlagPadding | EnvelopeFlagSchema | EnvelopeFlagDeterministic | EnvelopeFlagCompressed
+	DefaultCompressionThreshold            = 128     // Serialized data smaller than this is not compressed
+	MaxDecompressedSize                    = 1 << 24 // Limit for decompressed data
+	LifetimeSize                           = 16      // Size of the lifetime in the plaintext
+	DefaultTenantKeyCacheSize              = 1024    // Number of tenant key rings cached by default
+	SchemaVersionSize                      = 4       // Size of the schema version in the plaintext
+	SchemaVersionUnversioned               = 1       // Schema version of data sealed without one
+	StreamVersion1                         = 5       // Stream with a magic byte, a version, a cipher suite, a serialization format, flags, a chunk size, a key ID and a salt
+	StreamSaltSize                         = 32      // Size of the salt in the stream header
+	StreamHeaderSize                       = 6 + KeyIDSize + StreamSaltSize
+	StreamDefaultChunkSizeLog2             = 16             // Chunks of 64 KiB
+	StreamMinChunkSizeLog2                 = 10             // Smallest accepted chunk size
+	StreamMaxChunkSizeLog2                 = 16             // Largest accepted chunk size, so a client cannot make the server allocate large chunks
+	StreamMaxChunks                        = math.MaxUint32 // Limit for the counter in the nonce
+	StreamKnownFlags                       = EnvelopeFlagLifetime | EnvelopeFlagSchema | EnvelopeFlagCompressed
 )
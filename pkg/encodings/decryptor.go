@@ -4,17 +4,19 @@
 package encodings
 
 import (
-	"crypto/aes"
-	"crypto/cipher"
-	"encoding/base64"
+	"crypto/hmac"
+	"io"
+	"sync/atomic"
+
 	"github.com/hyperifyio/statelessdb/pkg/errors"
 )
 
 // Decryptor helps with providing memory for encryption
 type Decryptor[T interface{}] struct {
-	unserializer Unserializer[T]
-	block        cipher.Block
-	gcm          cipher.AEAD
+	unserializer  Unserializer[T]
+	unserializers map[Format]Unserializer[T] // unserializers contains unserializers for other formats by the format
+	keys          atomic.Pointer[KeyRing]    // keys is swapped when the keys are reloaded
+	rejectLegacy  bool                       // rejectLegacy is true if data encrypted without an envelope is not accepted
 }
 
 // NewDecryptor creates a new encryptor
@@ -23,64 +25,299 @@ func NewDecryptor[T interface{}](unserializer Unserializer[T]) *Decryptor[T] {
 	return &Decryptor[T]{unserializer: unserializer}
 }
 
-// Initialize initializes internal memory
+// Initialize initializes internal memory with a single key
 func (e *Decryptor[T]) Initialize(key []byte) error {
-	var err error
 	if len(key) < MinimumKeySizeAES256 {
 		log.Errorf("[Decryptor.Initialize] key size %d less than minimum %d", len(key), MinimumKeySizeAES256)
 		return errors.ErrDecryptorInitializeFailedKeySizeLessThanMinimum
 	}
-	e.block, err = aes.NewCipher(key)
+	keys, err := NewKeyRing(key)
 	if err != nil {
-		log.Errorf("[Decryptor.Initialize]: NewCipher: %v", err)
-		return errors.ErrDecryptorInitializeFailedNewCipher
+		log.Errorf("[Decryptor.Initialize]: NewKeyRing: %v", err)
+		return errors.ErrDecryptorInitializeFailedKeyRing
 	}
-	e.gcm, err = cipher.NewGCM(e.block)
-	if err != nil {
-		log.Errorf("[Decryptor.Initialize]: NewGCM: %v", err)
-		return errors.ErrDecryptorInitializeFailedNewGCM
+	return e.InitializeKeyRing(keys)
+}
+
+// InitializeKeyRing initializes the decryptor to accept any key of a key ring.
+// It may be called again to swap the keys, e.g. from KeyReloader.OnReload.
+func (e *Decryptor[T]) InitializeKeyRing(keys *KeyRing) error {
+	if keys == nil {
+		return errors.ErrDecryptorInitializeFailedNoKeyRing
 	}
+	e.keys.Store(keys)
 	return nil
 }
 
-// Decrypt decrypts encrypted Base64 encoded string using AES256 to a plaintext
-// string.
+// WithUnserializer adds an unserializer for data which the envelope says was
+// serialized in another format, e.g. before the format was changed
+func (e *Decryptor[T]) WithUnserializer(format Format, unserializer Unserializer[T]) *Decryptor[T] {
+	if e.unserializers == nil {
+		e.unserializers = make(map[Format]Unserializer[T])
+	}
+	e.unserializers[format] = unserializer
+	return e
+}
+
+// WithLegacy configures whether data encrypted without an envelope, before
+// envelopes were introduced, is accepted. Such data is accepted by default,
+// but it is not authenticated with additional data, a lifetime or anything
+// else from the envelope, so it should be rejected once it has been migrated.
+func (e *Decryptor[T]) WithLegacy(accept bool) *Decryptor[T] {
+	e.rejectLegacy = !accept
+	return e
+}
+
+// Decrypt decrypts an encrypted envelope in any text encoding, e.g. Base64, to
+// the output. The suite and the key are selected by the envelope. Data encrypted
+// without an envelope is tried with every key in the ring. If the
+// unserializer is a VersionedUnserializer, it is given the schema version
+// sealed with the data.
 func (e *Decryptor[T]) Decrypt(encryptedData string, out T) error {
-	var err error
-
-	//// Decode base62 (good but REALLY slow!)
-	//data, err := base62.DecodeString(encryptedData)
-
-	// Decode base64 (FAST!)
-	data, err := base64.StdEncoding.DecodeString(encryptedData)
-
-	//// Decode ascii85 (not nice with JSON!)
-	//maxDecodedLen := len(encryptedData) * 4 / 5
-	//decoded := make([]byte, maxDecodedLen)
-	//n, _, err := ascii85.Decode(decoded, []byte(encryptedData), true)
-	//if err != nil {
-	//	log.Errorf("[Decryptor.Decrypt]: base64: DecodeString: %v", err)
-	//	return errors.ErrDecryptBase64StringFailed
-	//}
-	//data := decoded[:n]
-
-	// Decrypt
-	nonceSize := e.gcm.NonceSize()
-	if len(data) < nonceSize {
-		log.Errorf("[Decryptor.Decrypt]: data length %d is less than nonce size %d", len(data), nonceSize)
-		return errors.ErrDecryptDataLengthLessThanNonceSize
+	return e.DecryptWithAdditionalData(encryptedData, out)
+}
+
+// DecryptWithAdditionalData decrypts like Decrypt, but the data must have been
+// encrypted with one of the candidates as the additional authenticated data.
+// Data encrypted without an envelope had no additional data, and is accepted
+// as before unless legacy data is rejected with WithLegacy.
+func (e *Decryptor[T]) DecryptWithAdditionalData(encryptedData string, out T, candidates ...[]byte) error {
+	_, err := e.DecryptWithLifetime(encryptedData, out, candidates...)
+	return err
+}
+
+// DecryptWithLifetime decrypts like DecryptWithAdditionalData, and returns the
+// lifetime sealed inside the envelope. The lifetime is zero if the data was
+// encrypted without one. It is up to the caller to decide if the data is
+// still fresh enough.
+func (e *Decryptor[T]) DecryptWithLifetime(encryptedData string, out T, candidates ...[]byte) (Lifetime, error) {
+	return e.DecryptWithKeyRing(e.keys.Load(), encryptedData, out, candidates...)
+}
+
+// DecryptWithKeyRing decrypts like DecryptWithLifetime, but accepts only the
+// keys of another key ring, e.g. the key ring of a tenant from TenantKeys.
+func (e *Decryptor[T]) DecryptWithKeyRing(keys *KeyRing, encryptedData string, out T, candidates ...[]byte) (Lifetime, error) {
+	data, _, err := decodeText(encryptedData)
+	if err != nil {
+		log.Errorf("[Decryptor.Decrypt]: decoding text: %v", err)
+		return Lifetime{}, errors.ErrDecryptTextDecodingFailed
 	}
-	nonce := data[:nonceSize]
-	ciphertextBytes := data[nonceSize:]
+	return e.decryptEnvelope(keys, data, out, candidates)
+}
 
-	serialized, err := e.gcm.Open(nil, nonce, ciphertextBytes, nil)
+// DecryptFrom decrypts like DecryptWithLifetime, but from text as bytes, e.g.
+// a request body, instead of a string. Memory needed while decrypting is
+// taken from pools, so the unserializer must not keep references to the
+// bytes it is given.
+func (e *Decryptor[T]) DecryptFrom(src []byte, out T, candidates ...[]byte) (Lifetime, error) {
+	return e.DecryptFromWithKeyRing(e.keys.Load(), src, out, candidates...)
+}
+
+// DecryptFromWithKeyRing decrypts like DecryptFrom, but accepts only the keys
+// of another key ring, e.g. the key ring of a tenant from TenantKeys.
+func (e *Decryptor[T]) DecryptFromWithKeyRing(keys *KeyRing, src []byte, out T, candidates ...[]byte) (Lifetime, error) {
+	data, _, err := appendDecodedText(GetByteSlice(len(src)), src)
+	defer ReleaseByteSlice(data)
 	if err != nil {
-		log.Errorf("[Decryptor.Decrypt]: gcm.Open: %v", err)
-		return errors.ErrDecryptFailed
+		log.Errorf("[Decryptor.DecryptFrom]: decoding text: %v", err)
+		return Lifetime{}, errors.ErrDecryptFromTextDecodingFailed
 	}
-	if err = e.unserializer.Unserialize(serialized, out); err != nil {
+	return e.decryptEnvelope(keys, data, out, candidates)
+}
+
+// decryptEnvelope decrypts an envelope to the output
+func (e *Decryptor[T]) decryptEnvelope(keys *KeyRing, data []byte, out T, candidates [][]byte) (Lifetime, error) {
+	buf := GetByteSlice(len(data))
+	plaintext, envelope, err := open(buf, keys, data, candidates, !e.rejectLegacy)
+	if err != nil {
+		ReleaseByteSlice(buf)
+		return Lifetime{}, err
+	}
+	defer ReleaseByteSlice(plaintext)
+	flags := envelope.Flags
+
+	if flags&EnvelopeFlagPadding != 0 {
+		var ok bool
+		if plaintext, ok = removePadding(plaintext); !ok {
+			log.Errorf("[Decryptor.Decrypt]: plaintext does not end with valid padding")
+			return Lifetime{}, errors.ErrDecryptPaddingFailed
+		}
+	}
+
+	var lifetime Lifetime
+	serialized := plaintext
+	if flags&EnvelopeFlagLifetime != 0 {
+		var ok bool
+		if lifetime, serialized, ok = parseLifetime(plaintext); !ok {
+			log.Errorf("[Decryptor.Decrypt]: plaintext length %d is less than lifetime size", len(plaintext))
+			return Lifetime{}, errors.ErrDecryptLifetimeFailed
+		}
+	}
+
+	version := uint32(SchemaVersionUnversioned)
+	if flags&EnvelopeFlagSchema != 0 {
+		var ok bool
+		if version, serialized, ok = parseSchemaVersion(serialized); !ok {
+			log.Errorf("[Decryptor.Decrypt]: plaintext length %d is less than schema version size", len(serialized))
+			return Lifetime{}, errors.ErrDecryptSchemaVersionFailed
+		}
+	}
+
+	unserializer, ok := e.unserializerOf(envelope.Format)
+	if !ok {
+		log.Errorf("[Decryptor.Decrypt]: no unserializer for format %s", envelope.Format)
+		return Lifetime{}, errors.ErrDecryptFormatMismatch
+	}
+	unserializer = uncompressedOf(unserializer, flags)
+
+	if err = unserializeVersion(unserializer, serialized, version, out); err != nil {
+		if err == errors.ErrDecryptSchemaUpcastFailed {
+			return Lifetime{}, err
+		}
 		log.Errorf("[Decryptor.Decrypt]: decoding serialized data failed: %v", err)
-		return errors.ErrDecryptDecodingSerializationFailed
+		return Lifetime{}, errors.ErrDecryptDecodingSerializationFailed
 	}
-	return nil
+	return lifetime, nil
+}
+
+// DecryptStream decrypts a stream written by Encryptor.EncryptStream from r,
+// and returns the lifetime sealed with the data. If the unserializer is a
+// StreamUnserializer, the data is decoded while it is decrypted. Otherwise,
+// e.g. when the data must be decompressed or upcast, the plaintext is read
+// first.
+func (e *Decryptor[T]) DecryptStream(r io.Reader, out T, candidates ...[]byte) (Lifetime, error) {
+	stream, err := NewStreamReader(r, e.keys.Load(), candidates...)
+	if err != nil {
+		return Lifetime{}, err
+	}
+	flags := stream.Envelope().Flags
+
+	var lifetime Lifetime
+	if flags&EnvelopeFlagLifetime != 0 {
+		buf := make([]byte, LifetimeSize)
+		if _, err := io.ReadFull(stream, buf); err != nil {
+			log.Errorf("[Decryptor.DecryptStream]: reading lifetime: %v", err)
+			return Lifetime{}, errors.ErrDecryptStreamLifetimeFailed
+		}
+		lifetime, _, _ = parseLifetime(buf)
+	}
+
+	version := uint32(SchemaVersionUnversioned)
+	if flags&EnvelopeFlagSchema != 0 {
+		buf := make([]byte, SchemaVersionSize)
+		if _, err := io.ReadFull(stream, buf); err != nil {
+			log.Errorf("[Decryptor.DecryptStream]: reading schema version: %v", err)
+			return Lifetime{}, errors.ErrDecryptStreamSchemaVersionFailed
+		}
+		version, _, _ = parseSchemaVersion(buf)
+	}
+
+	unserializer, ok := e.unserializerOf(stream.Envelope().Format)
+	if !ok {
+		log.Errorf("[Decryptor.DecryptStream]: no unserializer for format %s", stream.Envelope().Format)
+		return Lifetime{}, errors.ErrDecryptStreamFormatMismatch
+	}
+	unserializer = uncompressedOf(unserializer, flags)
+
+	if streaming, ok := unserializer.(StreamUnserializer[T]); ok && flags&EnvelopeFlagSchema == 0 {
+		err = streaming.UnserializeFrom(stream, out)
+	} else {
+		var serialized []byte
+		if serialized, err = io.ReadAll(stream); err == nil {
+			err = unserializeVersion(unserializer, serialized, version, out)
+		}
+	}
+	if err == errors.ErrDecryptSchemaUpcastFailed {
+		return Lifetime{}, err
+	}
+	if err != nil {
+		log.Errorf("[Decryptor.DecryptStream]: decoding stream failed: %v", err)
+		return Lifetime{}, errors.ErrDecryptStreamDecodingFailed
+	}
+
+	// The rest of the stream is read, so a truncated stream is not accepted
+	if _, err := io.Copy(io.Discard, stream); err != nil {
+		log.Errorf("[Decryptor.DecryptStream]: reading the end of the stream: %v", err)
+		return Lifetime{}, errors.ErrDecryptStreamTrailingFailed
+	}
+	return lifetime, nil
+}
+
+// unserializerOf returns the unserializer for the format recorded in the
+// envelope. Data without a recorded format is passed to the default
+// unserializer.
+func (e *Decryptor[T]) unserializerOf(format Format) (Unserializer[T], bool) {
+	if format == 0 {
+		return e.unserializer, true
+	}
+	if unserializer, exists := e.unserializers[format]; exists {
+		return unserializer, true
+	}
+	if own := formatOf(e.unserializer); own == 0 || own == format {
+		return e.unserializer, true
+	}
+	return nil, false
+}
+
+// open decrypts the envelope, appends the plaintext to dst and returns it
+// and the envelope. If legacy is true, data encrypted before envelopes is
+// accepted too, and the envelope is zero for it.
+func open(dst []byte, keys *KeyRing, data []byte, candidates [][]byte, legacy bool) ([]byte, Envelope, error) {
+	e, err := ParseEnvelope(data)
+	if err == nil {
+		if key, exists := keys.key(e.KeyID); exists {
+			if aead, ok := key.aead(e.Suite); ok {
+				if len(candidates) == 0 {
+					candidates = [][]byte{nil}
+				}
+				aad := GetByteSlice(len(e.Header) + 64)
+				defer func() { ReleaseByteSlice(aad) }()
+				for _, additionalData := range candidates {
+					aad = append(append(aad[:0], e.Header...), additionalData...)
+					plaintext, err := aead.Open(dst, e.Nonce, e.Ciphertext, aad)
+					if err != nil {
+						continue
+					}
+					if e.Flags&EnvelopeFlagDeterministic != 0 && !hmac.Equal(e.Nonce, syntheticNonce(key.nonceKey, len(e.Nonce), e.Header, additionalData, plaintext[len(dst):])) {
+						log.Errorf("[Decryptor.open]: deterministic nonce does not match the plaintext")
+						return nil, e, errors.ErrDecryptDeterministicNonceMismatch
+					}
+					if key != keys.active {
+						log.Debugf("[Decryptor.open]: decrypted with previous key %08x", e.KeyID)
+					}
+					return plaintext, e, nil
+				}
+			}
+		}
+	}
+	if !legacy {
+		if err == nil {
+			log.Errorf("[Decryptor.open]: no key could open the envelope")
+			return nil, e, errors.ErrDecryptFailed
+		}
+		log.Errorf("[Decryptor.open]: data is not an envelope and legacy data is not accepted")
+		return nil, Envelope{}, errors.ErrDecryptLegacyRejected
+	}
+	serialized, err := openLegacy(dst, keys, data)
+	return serialized, Envelope{}, err
+}
+
+// openLegacy decrypts data which was encrypted before envelopes, e.g. it is
+// only the nonce and the ciphertext using AES-256-GCM. Such data does not tell
+// which key was used, so every key in the ring is tried.
+func openLegacy(dst []byte, keys *KeyRing, data []byte) ([]byte, error) {
+	nonceSize := keys.active.aeads[SuiteAES256GCM].NonceSize()
+	if len(data) < nonceSize {
+		log.Errorf("[Decryptor.openLegacy]: data length %d is less than nonce size %d", len(data), nonceSize)
+		return nil, errors.ErrDecryptDataLengthLessThanNonceSize
+	}
+	nonce := data[:nonceSize]
+	ciphertextBytes := data[nonceSize:]
+	for _, key := range keys.order {
+		if serialized, err := key.aeads[SuiteAES256GCM].Open(dst, nonce, ciphertextBytes, nil); err == nil {
+			return serialized, nil
+		}
+	}
+	log.Errorf("[Decryptor.openLegacy]: no key could open the data")
+	return nil, errors.ErrDecryptFailed
 }
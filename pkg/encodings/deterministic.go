@@ -0,0 +1,41 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+const nonceKeyContext = "statelessdb deterministic nonce key v1"
+
+// Deterministic encryption derives the nonce from the plaintext instead of
+// generating it randomly, like SIV modes do, so the same plaintext always
+// produces the same envelope with the same key and additional data. The
+// nonce is a truncated HMAC-SHA256 under a MAC key derived from the key:
+//
+//	nonce = HMAC(MAC key, header | length of additional data (4 bytes, big endian) | additional data | plaintext)
+//
+// The envelope has EnvelopeFlagDeterministic, and the Decryptor checks that
+// the nonce matches the plaintext. Deterministic envelopes tell whether two
+// plaintexts are equal, which is the point, so they should only be used when
+// that is acceptable.
+
+// deriveNonceKey derives the MAC key of deterministic nonces from a key
+func deriveNonceKey(key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(nonceKeyContext))
+	return mac.Sum(nil)
+}
+
+// syntheticNonce returns the deterministic nonce for the plaintext
+func syntheticNonce(nonceKey []byte, size int, header, additionalData, plaintext []byte) []byte {
+	mac := hmac.New(sha256.New, nonceKey)
+	mac.Write(header)
+	mac.Write(binary.BigEndian.AppendUint32(nil, uint32(len(additionalData))))
+	mac.Write(additionalData)
+	mac.Write(plaintext)
+	return mac.Sum(nil)[:size]
+}
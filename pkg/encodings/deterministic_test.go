@@ -0,0 +1,107 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+func TestEncryptor_Deterministic(t *testing.T) {
+	for _, suite := range encodings.Suites() {
+		t.Run(suite.String(), func(t *testing.T) {
+			keys := newTestKeyRing(t, suite)
+			encryptor := encodings.NewEncryptor[*SampleStruct](encodings.NewJsonSerializer[*SampleStruct]("SampleStruct")).WithDeterministic(true)
+			if err := encryptor.InitializeKeyRing(keys); err != nil {
+				t.Fatalf("Failed to initialize Encryptor: %v", err)
+			}
+			decryptor := encodings.NewDecryptor[*SampleStruct](encodings.NewJsonUnserializer[*SampleStruct]("SampleStruct"))
+			if err := decryptor.InitializeKeyRing(keys); err != nil {
+				t.Fatalf("Failed to initialize Decryptor: %v", err)
+			}
+
+			data := &SampleStruct{ID: 1, Name: "Alice", Numbers: []int{1, 2, 3}}
+			first, err := encryptor.EncryptWithAdditionalData(data, []byte("purpose"))
+			if suite == encodings.SuiteAES256GCMSIV {
+				// The cipher chooses its own nonce, so it cannot be synthetic
+				if err != errors.ErrEncryptDeterministicUnsupportedSuite {
+					t.Errorf("Expected error %v, got %v", errors.ErrEncryptDeterministicUnsupportedSuite, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Encryption failed: %v", err)
+			}
+			second, err := encryptor.EncryptWithAdditionalData(&SampleStruct{ID: 1, Name: "Alice", Numbers: []int{1, 2, 3}}, []byte("purpose"))
+			if err != nil {
+				t.Fatalf("Encryption failed: %v", err)
+			}
+			if first != second {
+				t.Errorf("Identical inputs should produce identical outputs")
+			}
+
+			envelope, err := encodings.InspectEnvelope(first)
+			if err != nil {
+				t.Fatalf("Inspecting envelope failed: %v", err)
+			}
+			if envelope.Flags&encodings.EnvelopeFlagDeterministic == 0 {
+				t.Errorf("Expected the deterministic flag, got flags %08b", envelope.Flags)
+			}
+
+			out := &SampleStruct{}
+			if err := decryptor.DecryptWithAdditionalData(first, out, []byte("purpose")); err != nil {
+				t.Fatalf("Decryption failed: %v", err)
+			}
+			if !out.Equals(data) {
+				t.Errorf("Decrypted data does not match, got %+v", out)
+			}
+		})
+	}
+}
+
+func TestEncryptor_DeterministicDistinctInputs(t *testing.T) {
+	keys := newTestKeyRing(t, encodings.SuiteAES256GCM)
+	otherKeys := newTestKeyRing(t, encodings.SuiteAES256GCM)
+	encryptor := encodings.NewEncryptor[*SampleStruct](encodings.NewJsonSerializer[*SampleStruct]("SampleStruct")).WithDeterministic(true)
+	if err := encryptor.InitializeKeyRing(keys); err != nil {
+		t.Fatalf("Failed to initialize Encryptor: %v", err)
+	}
+
+	seen := make(map[string]string)
+	add := func(name, encrypted string) {
+		if other, exists := seen[encrypted]; exists {
+			t.Errorf("%s and %s produced identical outputs", name, other)
+		}
+		seen[encrypted] = name
+	}
+	for i := 0; i < 100; i++ {
+		encrypted, err := encryptor.EncryptWithAdditionalData(&SampleStruct{ID: i, Name: "Alice"}, []byte("purpose"))
+		if err != nil {
+			t.Fatalf("Encryption failed: %v", err)
+		}
+		add(fmt.Sprintf("ID %d", i), encrypted)
+	}
+
+	data := &SampleStruct{ID: 0, Name: "Alice"}
+	encrypted, err := encryptor.EncryptWithAdditionalData(data, []byte("other purpose"))
+	if err != nil {
+		t.Fatalf("Encryption failed: %v", err)
+	}
+	add("other additional data", encrypted)
+
+	encrypted, err = encryptor.EncryptWithKeyRing(otherKeys, data, []byte("purpose"), encodings.Lifetime{})
+	if err != nil {
+		t.Fatalf("Encryption failed: %v", err)
+	}
+	add("other key", encrypted)
+
+	encrypted, err = encodings.NewEncryptor[*SampleStruct](encodings.NewJsonSerializer[*SampleStruct]("SampleStruct")).EncryptWithKeyRing(keys, data, []byte("purpose"), encodings.Lifetime{})
+	if err != nil {
+		t.Fatalf("Encryption failed: %v", err)
+	}
+	add("randomized", encrypted)
+}
@@ -4,92 +4,244 @@
 package encodings
 
 import (
-	"bytes"
-	"crypto/aes"
-	"crypto/cipher"
-	"crypto/rand"
-	"encoding/base64"
 	"io"
+	"sync/atomic"
 
 	"github.com/hyperifyio/statelessdb/pkg/errors"
 )
 
 // Encryptor helps with providing memory for encryption
 type Encryptor[T interface{}] struct {
-	serializer Serializer[T]
-	block      cipher.Block
-	gcm        cipher.AEAD
-	buf        bytes.Buffer
+	serializer    Serializer[T]
+	keys          atomic.Pointer[KeyRing] // keys is swapped when the keys are reloaded
+	padding       Padding
+	schema        *SchemaRegistry
+	text          TextEncoding
+	deterministic bool // deterministic derives nonces from the plaintext
 }
 
 // NewEncryptor creates a new encryptor using a serializer
 func NewEncryptor[T interface{}](serializer Serializer[T]) *Encryptor[T] {
-	return &Encryptor[T]{serializer: serializer}
+	return &Encryptor[T]{serializer: serializer, text: DefaultTextEncoding}
 }
 
-// Initialize initializes internal memory
+// Initialize initializes internal memory with a single key
 func (e *Encryptor[T]) Initialize(key []byte) error {
-	var err error
 	if len(key) < MinimumKeySizeAES256 {
 		log.Errorf("[Encryptor.Initialize]: Key size %d less than minimum %d", len(key), MinimumKeySizeAES256)
 		return errors.ErrEncryptorInitializeFailedKeySizeLessThanMinimum
 	}
-	e.block, err = aes.NewCipher(key)
+	keys, err := NewKeyRing(key)
 	if err != nil {
-		log.Errorf("[Encryptor.Initialize]: NewCipher: %v", err)
-		return errors.ErrEncryptorInitializeFailedNewCipher
+		log.Errorf("[Encryptor.Initialize]: NewKeyRing: %v", err)
+		return errors.ErrEncryptorInitializeFailedKeyRing
 	}
-	e.gcm, err = cipher.NewGCM(e.block)
-	if err != nil {
-		log.Errorf("[Encryptor.Initialize]: NewGCM: %v", err)
-		return errors.ErrEncryptorInitializeFailedNewGCM
+	return e.InitializeKeyRing(keys)
+}
+
+// InitializeKeyRing initializes the encryptor to use the active key of a key
+// ring. It may be called again to swap the keys, e.g. from
+// KeyReloader.OnReload.
+func (e *Encryptor[T]) InitializeKeyRing(keys *KeyRing) error {
+	if keys == nil {
+		return errors.ErrEncryptorInitializeFailedNoKeyRing
 	}
+	e.keys.Store(keys)
 	return nil
 }
 
-// Encrypt encrypts plaintext string using AES.
+// WithPadding configures a padding policy, so that the length of the encrypted
+// data does not tell the exact length of the data. Nil disables padding.
+func (e *Encryptor[T]) WithPadding(padding Padding) *Encryptor[T] {
+	e.padding = padding
+	return e
+}
+
+// WithSchema seals the current schema version of the registry with the data,
+// so that Decryptor can upcast the data after the schema has changed. Nil
+// disables schema versions.
+func (e *Encryptor[T]) WithSchema(schema *SchemaRegistry) *Encryptor[T] {
+	e.schema = schema
+	return e
+}
+
+// WithTextEncoding sets the text encoding of the encrypted envelope. Defaults
+// to Base64. Decryptor detects the encoding, so it can be changed at any time.
+func (e *Encryptor[T]) WithTextEncoding(text TextEncoding) *Encryptor[T] {
+	e.text = text
+	return e
+}
+
+// TextEncoding returns the text encoding of the encrypted envelope
+func (e *Encryptor[T]) TextEncoding() TextEncoding {
+	return e.text
+}
+
+// WithDeterministic enables deterministic encryption, so the same data with
+// the same key and additional data always produces the same envelope, e.g.
+// for deduplication or cache keys in untrusted storage. The nonce is derived
+// from the plaintext like in SIV modes. The envelope then tells whether two
+// states are equal, so this is not the default. The serializer must produce
+// the same bytes for equal data, e.g. JSON or CBOR, and a lifetime or
+// RandomPadding makes the envelopes differ. Streams are always randomized.
+func (e *Encryptor[T]) WithDeterministic(deterministic bool) *Encryptor[T] {
+	e.deterministic = deterministic
+	return e
+}
+
+// Encrypt encrypts plaintext string using the suite and the active key of the
+// key ring.
 //   - key should be at least 32 bytes.
-//   - nonce should be at least 12 bytes.
 //
-// Returns Base64 encoded encrypted string.
+// Returns the encrypted envelope in the text encoding, Base64 by default.
 func (e *Encryptor[T]) Encrypt(data T) (string, error) {
+	return e.EncryptWithAdditionalData(data, nil)
+}
+
+// EncryptWithAdditionalData encrypts like Encrypt, but also authenticates
+// additional data, e.g. the identity of the resource. The same additional data
+// must be given to Decryptor.DecryptWithAdditionalData.
+func (e *Encryptor[T]) EncryptWithAdditionalData(data T, additionalData []byte) (string, error) {
+	return e.EncryptWithLifetime(data, additionalData, Lifetime{})
+}
+
+// EncryptWithLifetime encrypts like EncryptWithAdditionalData, and also seals
+// the lifetime inside the envelope unless it is zero. The lifetime is returned
+// by Decryptor.DecryptWithLifetime.
+func (e *Encryptor[T]) EncryptWithLifetime(data T, additionalData []byte, lifetime Lifetime) (string, error) {
+	return e.EncryptWithKeyRing(e.keys.Load(), data, additionalData, lifetime)
+}
+
+// EncryptWithKeyRing encrypts like EncryptWithLifetime, but uses the active key
+// of another key ring, e.g. the key ring of a tenant from TenantKeys.
+func (e *Encryptor[T]) EncryptWithKeyRing(keys *KeyRing, data T, additionalData []byte, lifetime Lifetime) (string, error) {
+	envelope, err := e.seal(keys, data, additionalData, lifetime)
+	if err != nil {
+		return "", err
+	}
+	defer ReleaseByteSlice(envelope)
+
+	text, err := e.text.AppendEncode(GetByteSlice(e.text.encodedLen(len(envelope))), envelope)
+	defer ReleaseByteSlice(text)
+	if err != nil {
+		log.Errorf("[Encrypt]: %s encoding failed: %v", e.text, err)
+		return "", errors.ErrEncryptorTextEncodingFailed
+	}
+	return string(text), nil
+}
+
+// EncryptTo encrypts like EncryptWithLifetime, but appends the text to dst
+// instead of allocating a string, e.g. to a byte slice from GetByteSlice.
+// Memory needed while encrypting is taken from pools, so encrypting does not
+// allocate if dst has enough capacity.
+func (e *Encryptor[T]) EncryptTo(dst []byte, data T, additionalData []byte, lifetime Lifetime) ([]byte, error) {
+	return e.EncryptToWithKeyRing(e.keys.Load(), dst, data, additionalData, lifetime)
+}
+
+// EncryptToWithKeyRing encrypts like EncryptTo, but uses the active key of
+// another key ring, e.g. the key ring of a tenant from TenantKeys.
+func (e *Encryptor[T]) EncryptToWithKeyRing(keys *KeyRing, dst []byte, data T, additionalData []byte, lifetime Lifetime) ([]byte, error) {
+	envelope, err := e.seal(keys, data, additionalData, lifetime)
+	if err != nil {
+		return dst, err
+	}
+	defer ReleaseByteSlice(envelope)
+
+	text, err := e.text.AppendEncode(dst, envelope)
+	if err != nil {
+		log.Errorf("[Encrypt]: %s encoding failed: %v", e.text, err)
+		return dst, errors.ErrEncryptorTextEncodingFailed
+	}
+	return text, nil
+}
+
+// seal serializes and encrypts data into an envelope, which must be
+// released with ReleaseByteSlice
+func (e *Encryptor[T]) seal(keys *KeyRing, data T, additionalData []byte, lifetime Lifetime) ([]byte, error) {
 	var err error
 
 	state, err := e.serializer.Serialize(data)
+	if err != nil {
+		log.Errorf("[Encrypt]: GobSerializer failed: %v", err)
+		return nil, errors.ErrEncryptorFailedToSerializeData
+	}
 	defer state.Release()
 
-	serialized := state.Bytes()
+	flags := compressionFlags(e.serializer)
+	if e.deterministic {
+		flags |= EnvelopeFlagDeterministic
+	}
+	plaintext := state.Bytes()
+	if !lifetime.IsZero() || e.padding != nil || e.schema != nil {
+		size := len(plaintext)
+		if !lifetime.IsZero() {
+			flags |= EnvelopeFlagLifetime
+			size += LifetimeSize
+		}
+		if e.schema != nil {
+			flags |= EnvelopeFlagSchema
+			size += SchemaVersionSize
+		}
+		paddedSize := size
+		if e.padding != nil {
+			flags |= EnvelopeFlagPadding
+			paddedSize = max(e.padding.PaddedSize(size+1), size+1)
+		}
+		buf := GetByteSlice(paddedSize)
+		if flags&EnvelopeFlagLifetime != 0 {
+			buf = appendLifetime(buf, lifetime)
+		}
+		if flags&EnvelopeFlagSchema != 0 {
+			buf = appendSchemaVersion(buf, e.schema.Version())
+		}
+		buf = append(buf, plaintext...)
+		if flags&EnvelopeFlagPadding != 0 {
+			buf = appendPadding(buf, paddedSize)
+		}
+		defer ReleaseByteSlice(buf)
+		plaintext = buf
+	}
 
+	envelope := GetByteSlice(EnvelopeHeaderSizeV4 + EnvelopeMaxOverhead + len(plaintext))
+	sealed, err := seal(envelope, keys, formatOf(e.serializer), flags, plaintext, additionalData)
 	if err != nil {
-		log.Errorf("[Encrypt]: GobSerializer failed: %v", err)
-		return "", errors.ErrEncryptorFailedToSerializeData
-	}
-	nonce := make([]byte, e.gcm.NonceSize())
-	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
-		log.Errorf("[Encrypt]: Nonce generation failed: %v", err)
-		return "", errors.ErrEncryptorFailedToInitializeNonce
-	}
-	ciphertext := e.gcm.Seal(nonce, nonce, serialized, nil)
-
-	// Handle base64
-	return base64.StdEncoding.EncodeToString(ciphertext), nil
-
-	//// Encode ascii85
-	//var buf bytes.Buffer
-	//encoder := ascii85.NewEncoder(&buf)
-	//_, err = encoder.Write(ciphertext)
-	//if err != nil {
-	//	log.Errorf("[Encrypt]: Ascii85 encoding failed: %v", err)
-	//	return "", errors.ErrEncryptorAscii85EncodingFailed
-	//}
-	//err = encoder.Close()
-	//if err != nil {
-	//	log.Errorf("[Encrypt]: Ascii85 encoder close failed: %v", err)
-	//	return "", errors.ErrEncryptorAscii85EncoderCloseFailed
-	//}
-	//return buf.String(), nil
-
-	//// Handle Base62
-	//return base62.EncodeToString(ciphertext), nil
+		ReleaseByteSlice(envelope)
+		return nil, err
+	}
+	return sealed, nil
+}
+
+// EncryptStream encrypts data as a stream to w using the active key of the
+// key ring, so the ciphertext is never held in memory as a whole. The lifetime
+// is sealed with the data unless it is zero. Padding is not used for streams.
+func (e *Encryptor[T]) EncryptStream(w io.Writer, data T, additionalData []byte, lifetime Lifetime) error {
+	state, err := e.serializer.Serialize(data)
+	if err != nil {
+		log.Errorf("[EncryptStream]: Serializer failed: %v", err)
+		return errors.ErrEncryptorStreamSerializeFailed
+	}
+	defer state.Release()
 
+	flags := compressionFlags(e.serializer)
+	var prefix []byte
+	if !lifetime.IsZero() {
+		flags |= EnvelopeFlagLifetime
+		prefix = appendLifetime(prefix, lifetime)
+	}
+	if e.schema != nil {
+		flags |= EnvelopeFlagSchema
+		prefix = appendSchemaVersion(prefix, e.schema.Version())
+	}
+
+	stream, err := NewStreamWriter(w, e.keys.Load(), formatOf(e.serializer), flags, additionalData)
+	if err != nil {
+		return err
+	}
+	if _, err = stream.Write(prefix); err != nil {
+		return err
+	}
+	if _, err = stream.Write(state.Bytes()); err != nil {
+		return err
+	}
+	return stream.Close()
 }
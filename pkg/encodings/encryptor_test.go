@@ -4,6 +4,7 @@
 package encodings_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -109,3 +110,69 @@ func TestEncryptorEncryptNonceUniqueness(t *testing.T) {
 		t.Errorf("Ciphertexts should differ due to unique nonces")
 	}
 }
+
+func TestEncryptor_EncryptTo(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	data := &SampleStruct{ID: 1, Name: "Alice", Numbers: []int{1, 2, 3}}
+
+	for _, text := range encodings.TextEncodings() {
+		t.Run(text.String(), func(t *testing.T) {
+			encryptor := encodings.NewEncryptor[*SampleStruct](encodings.NewJsonSerializer[*SampleStruct]("SampleStruct")).WithTextEncoding(text)
+			if err := encryptor.Initialize(key); err != nil {
+				t.Fatalf("Failed to initialize Encryptor: %v", err)
+			}
+			decryptor := encodings.NewDecryptor[*SampleStruct](encodings.NewJsonUnserializer[*SampleStruct]("SampleStruct"))
+			if err := decryptor.Initialize(key); err != nil {
+				t.Fatalf("Failed to initialize Decryptor: %v", err)
+			}
+
+			buf, err := encryptor.EncryptTo(append(encodings.GetByteSlice(0), "private="...), data, []byte("purpose"), encodings.NewLifetime(1000, 60000))
+			if err != nil {
+				t.Fatalf("EncryptTo failed: %v", err)
+			}
+			defer encodings.ReleaseByteSlice(buf)
+			if !strings.HasPrefix(string(buf), "private=") {
+				t.Fatalf("EncryptTo did not append to dst")
+			}
+			encrypted := buf[len("private="):]
+
+			out := &SampleStruct{}
+			lifetime, err := decryptor.DecryptFrom(encrypted, out, []byte("purpose"))
+			if err != nil {
+				t.Fatalf("DecryptFrom failed: %v", err)
+			}
+			if !out.Equals(data) {
+				t.Errorf("Decrypted data does not match, got %+v", out)
+			}
+			if lifetime != encodings.NewLifetime(1000, 60000) {
+				t.Errorf("Expected lifetime %+v, got %+v", encodings.NewLifetime(1000, 60000), lifetime)
+			}
+
+			// The text is the same as from EncryptWithLifetime
+			out = &SampleStruct{}
+			if _, err := decryptor.DecryptWithLifetime(string(encrypted), out, []byte("purpose")); err != nil || !out.Equals(data) {
+				t.Errorf("DecryptWithLifetime failed: %v", err)
+			}
+			if _, err := decryptor.DecryptFrom(encrypted, out, []byte("other")); err == nil {
+				t.Errorf("DecryptFrom should fail with other additional data")
+			}
+		})
+	}
+}
+
+func TestDecryptor_DecryptFromInvalidText(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	decryptor := encodings.NewDecryptor[*SampleStruct](encodings.NewJsonUnserializer[*SampleStruct]("SampleStruct"))
+	if err := decryptor.Initialize(key); err != nil {
+		t.Fatalf("Failed to initialize Decryptor: %v", err)
+	}
+	if _, err := decryptor.DecryptFrom([]byte("not*base64"), &SampleStruct{}); err != errors.ErrDecryptFromTextDecodingFailed {
+		t.Errorf("Expected error %v, got %v", errors.ErrDecryptFromTextDecodingFailed, err)
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+// The encrypted private data is an envelope:
+//
+//	magic (1 byte, 0xDB) | version (1 byte) | suite (1 byte) | format (1 byte) | flags (1 byte) | key ID (4 bytes, big endian) | nonce | ciphertext
+//
+// The header in front of the nonce is authenticated as additional data, followed
+// by optional additional data from the caller, so neither can be changed
+// without breaking the ciphertext. The size of the nonce depends on the suite.
+// The format is the serialization format of the data, or zero if it is not
+// known. The flags tell which optional sections are sealed in the plaintext
+// around the serialized data:
+//
+//	lifetime (16 bytes, if EnvelopeFlagLifetime) | schema version (4 bytes, big endian, if EnvelopeFlagSchema) | serialized data | padding (if EnvelopeFlagPadding)
+//
+// Compression is recorded by CompressingSerializer in front of the serialized
+// data, and the envelope has EnvelopeFlagCompressed when it is there. Data encrypted before envelopes were introduced is only
+// nonce | ciphertext, and is still accepted by the Decryptor.
+
+// Envelope is a parsed envelope. Parsing does not decrypt anything, so the
+// header is not authenticated until the envelope has been opened.
+type Envelope struct {
+	Version    byte   // Version is the version of the envelope
+	Suite      Suite  // Suite is the cipher suite
+	Format     Format // Format is the serialization format, or zero if not recorded
+	Flags      byte   // Flags tell which optional sections are in the plaintext
+	KeyID      uint32 // KeyID is the ID of the key in the key ring
+	Header     []byte // Header is the header which is authenticated as additional data
+	Nonce      []byte // Nonce is the nonce of the suite
+	Ciphertext []byte // Ciphertext is the encrypted plaintext and the tag
+}
+
+// ParseEnvelope parses an envelope without decrypting it
+func ParseEnvelope(data []byte) (Envelope, error) {
+	var e Envelope
+	if len(data) == 0 {
+		return e, errors.ErrEnvelopeTooShort
+	}
+	var size int
+	switch data[0] {
+	case EnvelopeMagic:
+		if len(data) < EnvelopeHeaderSizeV4 {
+			return e, errors.ErrEnvelopeTooShort
+		}
+		if data[1] != EnvelopeVersion4 {
+			return e, errors.ErrEnvelopeUnknownVersion
+		}
+		size = EnvelopeHeaderSizeV4
+		e.Version = EnvelopeVersion4
+		e.Suite = Suite(data[2])
+		e.Format = Format(data[3])
+		e.Flags = data[4]
+		e.KeyID = binary.BigEndian.Uint32(data[5:size])
+	default:
+		return e, errors.ErrEnvelopeUnknownVersion
+	}
+	if e.Flags&^EnvelopeKnownFlags != 0 {
+		return e, errors.ErrEnvelopeUnknownFlags
+	}
+	nonceSize := e.Suite.NonceSize()
+	if nonceSize == 0 {
+		return e, errors.ErrEnvelopeUnsupportedSuite
+	}
+	if len(data) < size+nonceSize {
+		return e, errors.ErrEnvelopeTooShort
+	}
+	e.Header = data[:size:size]
+	e.Nonce = data[size : size+nonceSize]
+	e.Ciphertext = data[size+nonceSize:]
+	return e, nil
+}
+
+// InspectEnvelope parses the envelope of a private value in any text encoding
+// without decrypting it, e.g. to find out which key or suite was used
+func InspectEnvelope(private string) (Envelope, error) {
+	data, _, err := decodeText(private)
+	if err != nil {
+		log.Errorf("[InspectEnvelope]: decoding text: %v", err)
+		return Envelope{}, errors.ErrEnvelopeTextDecodingFailed
+	}
+	return ParseEnvelope(data)
+}
+
+// String returns the header as text, one field per line
+func (e Envelope) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "version: %d\n", e.Version)
+	fmt.Fprintf(&b, "suite: %s\n", e.Suite)
+	if e.Format == 0 {
+		fmt.Fprintf(&b, "format: not recorded\n")
+	} else {
+		fmt.Fprintf(&b, "format: %s\n", e.Format)
+	}
+	fmt.Fprintf(&b, "flags: %s\n", envelopeFlagsString(e.Flags))
+	fmt.Fprintf(&b, "key: %08x\n", e.KeyID)
+	fmt.Fprintf(&b, "nonce: %d bytes\n", len(e.Nonce))
+	fmt.Fprintf(&b, "ciphertext: %d bytes\n", len(e.Ciphertext))
+	return b.String()
+}
+
+// envelopeFlagsString returns names of the flags
+func envelopeFlagsString(flags byte) string {
+	var names []string
+	if flags&EnvelopeFlagLifetime != 0 {
+		names = append(names, "lifetime")
+	}
+	if flags&EnvelopeFlagSchema != 0 {
+		names = append(names, "schema")
+	}
+	if flags&EnvelopeFlagPadding != 0 {
+		names = append(names, "padding")
+	}
+	if flags&EnvelopeFlagDeterministic != 0 {
+		names = append(names, "deterministic")
+	}
+	if flags&EnvelopeFlagCompressed != 0 {
+		names = append(names, "compressed")
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ", ")
+}
+
+// appendEnvelopeHeader appends an envelope header for the suite, the format
+// and the key to dst
+func appendEnvelopeHeader(dst []byte, suite Suite, format Format, flags byte, keyID uint32) []byte {
+	dst = append(dst, EnvelopeMagic, EnvelopeVersion4, byte(suite), byte(format), flags)
+	return binary.BigEndian.AppendUint32(dst, keyID)
+}
+
+// seal encrypts the plaintext into an envelope using the suite and the active
+// key of the key ring, and appends the envelope to dst. The nonce is random,
+// unless the flags have EnvelopeFlagDeterministic.
+func seal(dst []byte, keys *KeyRing, format Format, flags byte, plaintext, additionalData []byte) ([]byte, error) {
+	key := keys.active
+	aead := key.aeads[keys.suite]
+	nonceSize := aead.NonceSize()
+	start := len(dst)
+	prefixSize := EnvelopeHeaderSizeV4 + nonceSize
+	dst = slices.Grow(dst, prefixSize+len(plaintext)+aead.Overhead())
+	dst = appendEnvelopeHeader(dst, keys.suite, format, flags, key.id)
+	header := dst[start:len(dst):len(dst)]
+	aad := header
+	if len(additionalData) > 0 {
+		aad = append(append(GetByteSlice(len(header)+len(additionalData)), header...), additionalData...)
+		defer ReleaseByteSlice(aad)
+	}
+
+	// The cipher appends the nonce it chose after the header
+	if sealer, ok := aead.(nonceSealer); ok {
+		if flags&EnvelopeFlagDeterministic != 0 {
+			log.Errorf("[seal]: %s does not support deterministic encryption", keys.suite)
+			return nil, errors.ErrEncryptDeterministicUnsupportedSuite
+		}
+		return sealer.sealWithNonce(dst, plaintext, aad)
+	}
+
+	dst = dst[:start+prefixSize]
+	nonce := dst[start+EnvelopeHeaderSizeV4:]
+	if flags&EnvelopeFlagDeterministic != 0 {
+		copy(nonce, syntheticNonce(key.nonceKey, nonceSize, header, additionalData, plaintext))
+	} else if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		log.Errorf("[seal]: Nonce generation failed: %v", err)
+		return nil, errors.ErrEncryptorFailedToInitializeNonce
+	}
+	return aead.Seal(dst, nonce, plaintext, aad), nil
+}
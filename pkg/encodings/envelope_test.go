@@ -0,0 +1,270 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+// updateGolden rewrites golden files, e.g. go test ./pkg/encodings -run Golden -update
+var updateGolden = flag.Bool("update", false, "update golden files")
+
+// goldenKey is the key of the envelopes in testdata/envelopes
+const goldenKey = "a1ee74883d70fa9c4b5c9e5856ca58f99b26176be805d20d9c43fc4dbf880b91"
+
+// goldenEnvelopes are the envelopes which must stay readable. Legacy data is
+// built by hand, since it is not written anymore.
+var goldenEnvelopes = []struct {
+	name    string
+	current bool // current is true if the envelope is written by the Encryptor
+	create  func(t *testing.T, key []byte, serialized []byte) []byte
+}{
+	{"legacy", false, sealLegacyEnvelope},
+	{"v4-aes-256-gcm", true, sealCurrentEnvelope(encodings.SuiteAES256GCM, nil)},
+	{"v4-chacha20-poly1305", true, sealCurrentEnvelope(encodings.SuiteChaCha20Poly1305, nil)},
+	{"v4-xchacha20-poly1305", true, sealCurrentEnvelope(encodings.SuiteXChaCha20Poly1305, nil)},
+	{"v4-aes-256-gcm-siv", true, sealCurrentEnvelope(encodings.SuiteAES256GCMSIV, nil)},
+	{"v4-flags", true, sealCurrentEnvelope(encodings.SuiteAES256GCM, encodings.NewSchemaRegistry(2))},
+}
+
+func newGoldenStruct() *SampleStruct {
+	return &SampleStruct{
+		ID:      1,
+		Name:    "Golden",
+		Numbers: []int{1, 2, 3},
+		Details: map[string]string{"key": "value"},
+	}
+}
+
+// TestEnvelope_Golden tests that envelopes in testdata/envelopes can still be
+// parsed and decrypted, and that the header of new envelopes has not changed
+func TestEnvelope_Golden(t *testing.T) {
+	key, err := hex.DecodeString(goldenKey)
+	if err != nil {
+		t.Fatalf("Failed to decode key: %v", err)
+	}
+
+	for _, golden := range goldenEnvelopes {
+		t.Run(golden.name, func(t *testing.T) {
+			path := filepath.Join("testdata", "envelopes", golden.name+".golden")
+			if *updateGolden {
+				writeGoldenEnvelope(t, path, golden.create(t, key, serializeGoldenStruct(t)))
+			}
+
+			content, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("Failed to read golden file: %v", err)
+			}
+			private, expected, _ := strings.Cut(string(content), "\n")
+			private = strings.TrimPrefix(private, "private: ")
+
+			data, err := base64.StdEncoding.DecodeString(private)
+			if err != nil {
+				t.Fatalf("Failed to decode private value: %v", err)
+			}
+			if golden.name != "legacy" {
+				envelope, err := encodings.ParseEnvelope(data)
+				if err != nil {
+					t.Fatalf("ParseEnvelope failed: %v", err)
+				}
+				if envelope.String() != expected {
+					t.Errorf("Expected header:\n%s\ngot:\n%s", expected, envelope.String())
+				}
+				if golden.current {
+					created, err := encodings.ParseEnvelope(golden.create(t, key, serializeGoldenStruct(t)))
+					if err != nil {
+						t.Fatalf("ParseEnvelope failed: %v", err)
+					}
+					if !bytes.Equal(created.Header, envelope.Header) {
+						t.Errorf("Header of new envelopes has changed from %x to %x", envelope.Header, created.Header)
+					}
+				}
+			}
+
+			schema := encodings.NewSchemaRegistry(2).Register(1, func(data []byte) ([]byte, error) {
+				return data, nil
+			})
+			decryptor := encodings.NewDecryptor[*SampleStruct](encodings.NewUpcastingUnserializer[*SampleStruct](encodings.NewJsonUnserializer[*SampleStruct]("SampleStruct"), schema))
+			if err := decryptor.Initialize(key); err != nil {
+				t.Fatalf("Failed to initialize decryptor: %v", err)
+			}
+			decoded := &SampleStruct{}
+			if err := decryptor.Decrypt(private, decoded); err != nil {
+				t.Fatalf("Decrypt failed: %v", err)
+			}
+			if !decoded.Equals(newGoldenStruct()) {
+				t.Errorf("Expected %v, got %v", newGoldenStruct(), decoded)
+			}
+		})
+	}
+}
+
+func TestParseEnvelope_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{"empty", nil, errors.ErrEnvelopeTooShort},
+		{"short header", []byte{encodings.EnvelopeMagic, encodings.EnvelopeVersion4, 1}, errors.ErrEnvelopeTooShort},
+		{"unknown version", []byte{encodings.EnvelopeMagic, 99, 1, 1, 0, 0, 0, 0, 0}, errors.ErrEnvelopeUnknownVersion},
+		{"unknown first byte", []byte{99, 1, 2, 3}, errors.ErrEnvelopeUnknownVersion},
+		{"unknown flags", []byte{encodings.EnvelopeMagic, encodings.EnvelopeVersion4, 1, 1, 0x80, 0, 0, 0, 0}, errors.ErrEnvelopeUnknownFlags},
+		{"unknown suite", []byte{encodings.EnvelopeMagic, encodings.EnvelopeVersion4, 99, 1, 0, 0, 0, 0, 0}, errors.ErrEnvelopeUnsupportedSuite},
+		{"short nonce", []byte{encodings.EnvelopeMagic, encodings.EnvelopeVersion4, 1, 1, 0, 0, 0, 0, 0, 1, 2}, errors.ErrEnvelopeTooShort},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := encodings.ParseEnvelope(test.data); err != test.err {
+				t.Errorf("Expected %v, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestInspectEnvelope(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	encryptor := encodings.NewEncryptor[*SampleStruct](encodings.NewCborSerializer[*SampleStruct]("SampleStruct"))
+	if err := encryptor.Initialize(key); err != nil {
+		t.Fatalf("Failed to initialize encryptor: %v", err)
+	}
+	private, err := encryptor.EncryptWithLifetime(newGoldenStruct(), nil, encodings.NewLifetime(1000, 0))
+	if err != nil {
+		t.Fatalf("Encrypt failed: %v", err)
+	}
+
+	envelope, err := encodings.InspectEnvelope(private)
+	if err != nil {
+		t.Fatalf("InspectEnvelope failed: %v", err)
+	}
+	if envelope.Format != encodings.FormatCbor || envelope.Suite != encodings.DefaultSuite || envelope.Flags != encodings.EnvelopeFlagLifetime || envelope.KeyID != encodings.KeyID(key) {
+		t.Errorf("Unexpected envelope:\n%s", envelope)
+	}
+
+	if _, err := encodings.InspectEnvelope("not base64!"); err != errors.ErrEnvelopeTextDecodingFailed {
+		t.Errorf("Expected ErrEnvelopeTextDecodingFailed, got %v", err)
+	}
+}
+
+// TestDecryptor_SelectsUnserializerByFormat tests that the format recorded in
+// the envelope selects the unserializer
+func TestDecryptor_SelectsUnserializerByFormat(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	encryptor := encodings.NewEncryptor[*SampleStruct](encodings.NewMsgpackSerializer[*SampleStruct]("SampleStruct"))
+	if err := encryptor.Initialize(key); err != nil {
+		t.Fatalf("Failed to initialize encryptor: %v", err)
+	}
+	private, err := encryptor.Encrypt(newGoldenStruct())
+	if err != nil {
+		t.Fatalf("Encrypt failed: %v", err)
+	}
+
+	decryptor := encodings.NewDecryptor[*SampleStruct](encodings.NewJsonUnserializer[*SampleStruct]("SampleStruct"))
+	if err := decryptor.Initialize(key); err != nil {
+		t.Fatalf("Failed to initialize decryptor: %v", err)
+	}
+	if err := decryptor.Decrypt(private, &SampleStruct{}); err != errors.ErrDecryptFormatMismatch {
+		t.Errorf("Expected ErrDecryptFormatMismatch, got %v", err)
+	}
+
+	decryptor.WithUnserializer(encodings.FormatMsgpack, encodings.NewMsgpackUnserializer[*SampleStruct]("SampleStruct"))
+	decoded := &SampleStruct{}
+	if err := decryptor.Decrypt(private, decoded); err != nil {
+		t.Fatalf("Decrypt failed: %v", err)
+	}
+	if !decoded.Equals(newGoldenStruct()) {
+		t.Errorf("Expected %v, got %v", newGoldenStruct(), decoded)
+	}
+}
+
+func serializeGoldenStruct(t *testing.T) []byte {
+	state, err := encodings.NewJsonSerializer[*SampleStruct]("SampleStruct").Serialize(newGoldenStruct())
+	if err != nil {
+		t.Fatalf("Serialize failed: %v", err)
+	}
+	defer state.Release()
+	return bytes.Clone(state.Bytes())
+}
+
+func writeGoldenEnvelope(t *testing.T, path string, data []byte) {
+	content := "private: " + base64.StdEncoding.EncodeToString(data) + "\n"
+	if envelope, err := encodings.ParseEnvelope(data); err == nil {
+		content += envelope.String()
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write golden file: %v", err)
+	}
+}
+
+// newGoldenGCM returns AES-256-GCM, which uses the key as it is
+func newGoldenGCM(t *testing.T, key []byte) cipher.AEAD {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatalf("NewCipher failed: %v", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatalf("NewGCM failed: %v", err)
+	}
+	return aead
+}
+
+// sealLegacyEnvelope seals data like before envelopes, e.g. nonce | ciphertext.
+// The first byte of the nonce is zero, so it cannot be mistaken for a header.
+func sealLegacyEnvelope(t *testing.T, key []byte, serialized []byte) []byte {
+	aead := newGoldenGCM(t, key)
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce[1:]); err != nil {
+		t.Fatalf("Nonce generation failed: %v", err)
+	}
+	return aead.Seal(nonce, nonce, serialized, nil)
+}
+
+// sealCurrentEnvelope seals data with the Encryptor
+func sealCurrentEnvelope(suite encodings.Suite, schema *encodings.SchemaRegistry) func(t *testing.T, key []byte, serialized []byte) []byte {
+	return func(t *testing.T, key []byte, serialized []byte) []byte {
+		keys, err := encodings.NewKeyRingWithSuite(suite, key)
+		if err != nil {
+			t.Fatalf("Failed to create key ring: %v", err)
+		}
+		encryptor := encodings.NewEncryptor[*SampleStruct](encodings.NewJsonSerializer[*SampleStruct]("SampleStruct")).WithSchema(schema)
+		if schema != nil {
+			encryptor.WithPadding(encodings.NewPowerOfTwoPadding(0))
+		}
+		if err := encryptor.InitializeKeyRing(keys); err != nil {
+			t.Fatalf("Failed to initialize encryptor: %v", err)
+		}
+		private, err := encryptor.EncryptWithLifetime(newGoldenStruct(), nil, encodings.Lifetime{})
+		if err != nil {
+			t.Fatalf("Encrypt failed: %v", err)
+		}
+		data, err := base64.StdEncoding.DecodeString(private)
+		if err != nil {
+			t.Fatalf("Failed to decode private value: %v", err)
+		}
+		return data
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+// NewGCMSIV exposes the AES-256-GCM-SIV cipher for tests
+var NewGCMSIV = newGCMSIV
@@ -0,0 +1,103 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"encoding/base64"
+	"sync"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+const fieldKeyContext = "statelessdb field key v1 "
+
+// FieldCipher encrypts individual fields under a sub-key of their sensitivity
+// class, e.g. "pii" or "payment". Sub-keys are derived from every key of the
+// master key ring using HKDF, so key rotation works the same way for fields.
+// It is safe for concurrent use.
+type FieldCipher struct {
+	mu      sync.Mutex
+	master  *KeyRing
+	classes map[string]*KeyRing // classes contains derived key rings by the class
+}
+
+// NewFieldCipher creates a field cipher which derives sub-keys from the
+// master key ring
+func NewFieldCipher(master *KeyRing) *FieldCipher {
+	return &FieldCipher{
+		master:  master,
+		classes: make(map[string]*KeyRing),
+	}
+}
+
+// InitializeKeyRing swaps the master key ring, e.g. from
+// KeyReloader.OnReload
+func (c *FieldCipher) InitializeKeyRing(master *KeyRing) error {
+	if master == nil {
+		return errors.ErrFieldCipherNoKeyRing
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.master = master
+	clear(c.classes)
+	return nil
+}
+
+// Seal encrypts the plaintext of a field under the sub-key of the class. The
+// additional data, e.g. the name of the field and the identity of the
+// resource, must be given to Open.
+func (c *FieldCipher) Seal(class string, plaintext, additionalData []byte) (string, error) {
+	keys, err := c.keyRing(class)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := seal(nil, keys, FormatJson, 0, plaintext, additionalData)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// Open decrypts a field which was encrypted with Seal using the same class
+// and additional data. Fields are always in an envelope, so data which fails
+// authentication is never tried as data encrypted before envelopes.
+func (c *FieldCipher) Open(class string, sealed string, additionalData []byte) ([]byte, error) {
+	keys, err := c.keyRing(class)
+	if err != nil {
+		return nil, err
+	}
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		log.Errorf("[FieldCipher.Open]: base64: DecodeString: %v", err)
+		return nil, errors.ErrFieldCipherBase64Failed
+	}
+	if e, err := ParseEnvelope(data); err != nil || e.Version != EnvelopeVersion4 || e.Flags != 0 {
+		log.Errorf("[FieldCipher.Open]: %s: not a field envelope", class)
+		return nil, errors.ErrFieldCipherInvalidEnvelope
+	}
+	plaintext, _, err := open(nil, keys, data, [][]byte{additionalData}, false)
+	if err != nil {
+		log.Errorf("[FieldCipher.Open]: %s: %v", class, err)
+		return nil, errors.ErrFieldCipherOpenFailed
+	}
+	return plaintext, nil
+}
+
+// keyRing returns the key ring of the class
+func (c *FieldCipher) keyRing(class string) (*KeyRing, error) {
+	if class == "" {
+		return nil, errors.ErrFieldCipherNoClass
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if keys, exists := c.classes[class]; exists {
+		return keys, nil
+	}
+	keys, err := c.master.derive([]byte(fieldKeyContext + class))
+	if err != nil {
+		return nil, err
+	}
+	c.classes[class] = keys
+	return keys, nil
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+func TestFieldCipher_SealOpen(t *testing.T) {
+	fields := encodings.NewFieldCipher(newTestKeyRing(t, encodings.SuiteAES256GCM))
+	aad := encodings.NewAdditionalData([]byte("ssn"))
+
+	sealed, err := fields.Seal("pii", []byte(`"123-45-6789"`), aad)
+	if err != nil {
+		t.Fatalf("Seal failed: %v", err)
+	}
+
+	plaintext, err := fields.Open("pii", sealed, aad)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	if string(plaintext) != `"123-45-6789"` {
+		t.Errorf("Expected the original value, got %s", plaintext)
+	}
+
+	if _, err = fields.Open("payment", sealed, aad); err != errors.ErrFieldCipherOpenFailed {
+		t.Errorf("Opening with the key of another class should fail with %v, got %v", errors.ErrFieldCipherOpenFailed, err)
+	}
+	if _, err = fields.Open("pii", sealed, encodings.NewAdditionalData([]byte("card"))); err != errors.ErrFieldCipherOpenFailed {
+		t.Errorf("Opening with other additional data should fail with %v, got %v", errors.ErrFieldCipherOpenFailed, err)
+	}
+	if _, err = encodings.NewFieldCipher(newTestKeyRing(t, encodings.SuiteAES256GCM)).Open("pii", sealed, aad); err != errors.ErrFieldCipherOpenFailed {
+		t.Errorf("Opening with another master key should fail with %v, got %v", errors.ErrFieldCipherOpenFailed, err)
+	}
+	if _, err = fields.Seal("", nil, aad); err != errors.ErrFieldCipherNoClass {
+		t.Errorf("Expected error %v, got %v", errors.ErrFieldCipherNoClass, err)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+// Format is the serialization format of private data. Values are stable, so
+// they may be recorded next to the data.
+type Format uint8
+
+const (
+	FormatJson     Format = 1 // FormatJson is JSON, the default
+	FormatGob      Format = 2 // FormatGob is Go's gob, only readable from Go
+	FormatCbor     Format = 3 // FormatCbor is CBOR (RFC 8949), compact and self-describing
+	FormatMsgpack  Format = 4 // FormatMsgpack is MessagePack, compact and fast
+	FormatProtobuf Format = 5 // FormatProtobuf is Protocol Buffers, only for generated message types
+)
+
+// Formatted is implemented by serializers and unserializers which know their
+// serialization format, so the format can be recorded in the envelope
+type Formatted interface {
+	Format() Format
+}
+
+// formatOf returns the format of a serializer or an unserializer, or zero if
+// it is not known
+func formatOf(v interface{}) Format {
+	if formatted, ok := v.(Formatted); ok {
+		return formatted.Format()
+	}
+	return 0
+}
+
+// Formats returns all supported serialization formats
+func Formats() []Format {
+	return []Format{
+		FormatJson,
+		FormatGob,
+		FormatCbor,
+		FormatMsgpack,
+		FormatProtobuf,
+	}
+}
+
+// ParseFormat returns the serialization format by its name
+func ParseFormat(name string) (Format, error) {
+	for _, format := range Formats() {
+		if format.String() == name {
+			return format, nil
+		}
+	}
+	return 0, errors.ErrUnknownFormat
+}
+
+func (f Format) String() string {
+	switch f {
+	case FormatJson:
+		return "json"
+	case FormatGob:
+		return "gob"
+	case FormatCbor:
+		return "cbor"
+	case FormatMsgpack:
+		return "msgpack"
+	case FormatProtobuf:
+		return "protobuf"
+	default:
+		return "unknown"
+	}
+}
+
+// NewFormatSerializer returns a serializer for the format
+func NewFormatSerializer[T interface{}](format Format, name string) (Serializer[T], error) {
+	switch format {
+	case FormatJson:
+		return NewJsonSerializer[T](name), nil
+	case FormatGob:
+		return NewGobSerializer[T](name), nil
+	case FormatCbor:
+		return NewCborSerializer[T](name), nil
+	case FormatMsgpack:
+		return NewMsgpackSerializer[T](name), nil
+	case FormatProtobuf:
+		return NewProtobufSerializer[T](name), nil
+	default:
+		return nil, errors.ErrUnsupportedFormat
+	}
+}
+
+// NewFormatUnserializer returns an unserializer for the format
+func NewFormatUnserializer[T interface{}](format Format, name string) (Unserializer[T], error) {
+	switch format {
+	case FormatJson:
+		return NewJsonUnserializer[T](name), nil
+	case FormatGob:
+		return NewGobUnserializer[T](name), nil
+	case FormatCbor:
+		return NewCborUnserializer[T](name), nil
+	case FormatMsgpack:
+		return NewMsgpackUnserializer[T](name), nil
+	case FormatProtobuf:
+		return NewProtobufUnserializer[T](name), nil
+	default:
+		return nil, errors.ErrUnsupportedFormat
+	}
+}
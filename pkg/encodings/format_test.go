@@ -0,0 +1,206 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+	"github.com/hyperifyio/statelessdb/pkg/states"
+)
+
+func TestParseFormat(t *testing.T) {
+	for _, format := range encodings.Formats() {
+		parsed, err := encodings.ParseFormat(format.String())
+		if err != nil {
+			t.Fatalf("ParseFormat(%q) failed: %v", format.String(), err)
+		}
+		if parsed != format {
+			t.Errorf("Expected %v, got %v", format, parsed)
+		}
+	}
+	if _, err := encodings.ParseFormat("xml"); err != errors.ErrUnknownFormat {
+		t.Errorf("Expected ErrUnknownFormat, got %v", err)
+	}
+}
+
+func TestNewFormatSerializer_Unsupported(t *testing.T) {
+	if _, err := encodings.NewFormatSerializer[*SampleStruct](encodings.Format(0), "SampleStruct"); err != errors.ErrUnsupportedFormat {
+		t.Errorf("Expected ErrUnsupportedFormat, got %v", err)
+	}
+	if _, err := encodings.NewFormatUnserializer[*SampleStruct](encodings.Format(0), "SampleStruct"); err != errors.ErrUnsupportedFormat {
+		t.Errorf("Expected ErrUnsupportedFormat, got %v", err)
+	}
+}
+
+// structFormats returns formats which can serialize any struct, e.g.
+// SampleStruct
+func structFormats() []encodings.Format {
+	var formats []encodings.Format
+	for _, format := range encodings.Formats() {
+		if format != encodings.FormatProtobuf {
+			formats = append(formats, format)
+		}
+	}
+	return formats
+}
+
+// TestFormats_RoundTripStruct tests serialization of a struct in every format
+func TestFormats_RoundTripStruct(t *testing.T) {
+	for _, format := range structFormats() {
+		t.Run(format.String(), func(t *testing.T) {
+			serializer, unserializer := newFormatSerializers[*SampleStruct](t, format, "SampleStruct")
+
+			data := &SampleStruct{
+				ID:      1,
+				Name:    "Round Trip Test",
+				Numbers: []int{4, 5, 6},
+				Details: map[string]string{
+					"info1": "data1",
+					"info2": "data2",
+				},
+			}
+
+			state, err := serializer.Serialize(data)
+			if err != nil {
+				t.Fatalf("Failed to serialize data: %v", err)
+			}
+			defer state.Release()
+
+			decoded := &SampleStruct{}
+			if err := unserializer.Unserialize(state.Bytes(), decoded); err != nil {
+				t.Fatalf("Failed to unserialize data: %v", err)
+			}
+			if !decoded.Equals(data) {
+				t.Errorf("Decoded data does not match original.\nOriginal: %v\nDecoded: %v", data, decoded)
+			}
+		})
+	}
+}
+
+// TestFormats_RoundTripComputeState tests serialization of a state with
+// UUIDs and private properties in every format
+func TestFormats_RoundTripComputeState(t *testing.T) {
+	for _, format := range encodings.Formats() {
+		t.Run(format.String(), func(t *testing.T) {
+			serializer, unserializer := newFormatSerializers[*states.ComputeState](t, format, "ComputeState")
+
+			data := newLargeComputeState(10)
+
+			state, err := serializer.Serialize(data)
+			if err != nil {
+				t.Fatalf("Failed to serialize data: %v", err)
+			}
+			defer state.Release()
+
+			decoded := &states.ComputeState{}
+			if err := unserializer.Unserialize(state.Bytes(), decoded); err != nil {
+				t.Fatalf("Failed to unserialize data: %v", err)
+			}
+			if !decoded.Equals(data) {
+				t.Errorf("Decoded data does not match original.\nOriginal: %v\nDecoded: %v", data, decoded)
+			}
+		})
+	}
+}
+
+// TestFormats_EncryptDecrypt tests encryption of states in every format
+func TestFormats_EncryptDecrypt(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	for _, format := range encodings.Formats() {
+		t.Run(format.String(), func(t *testing.T) {
+			serializer, unserializer := newFormatSerializers[*states.ComputeState](t, format, "ComputeState")
+
+			encryptor := encodings.NewEncryptor[*states.ComputeState](serializer)
+			if err := encryptor.Initialize(key); err != nil {
+				t.Fatalf("Failed to initialize encryptor: %v", err)
+			}
+			decryptor := encodings.NewDecryptor[*states.ComputeState](unserializer)
+			if err := decryptor.Initialize(key); err != nil {
+				t.Fatalf("Failed to initialize decryptor: %v", err)
+			}
+
+			data := newLargeComputeState(10)
+			ciphertext, err := encryptor.Encrypt(data)
+			if err != nil {
+				t.Fatalf("Encrypt failed: %v", err)
+			}
+
+			decoded := &states.ComputeState{}
+			if err := decryptor.Decrypt(ciphertext, decoded); err != nil {
+				t.Fatalf("Decrypt failed: %v", err)
+			}
+			if !decoded.Equals(data) {
+				t.Errorf("Decrypted data does not match original.\nOriginal: %v\nDecrypted: %v", data, decoded)
+			}
+		})
+	}
+}
+
+// TestFormats_Concurrency tests pooled encoder and decoder states under
+// concurrent access
+func TestFormats_Concurrency(t *testing.T) {
+	for _, format := range structFormats() {
+		t.Run(format.String(), func(t *testing.T) {
+			serializer, unserializer := newFormatSerializers[*SampleStruct](t, format, "SampleStruct")
+
+			wg := sync.WaitGroup{}
+			numGoroutines := 50
+			numIterations := 50
+
+			for i := 0; i < numGoroutines; i++ {
+				wg.Add(1)
+				go func(id int) {
+					defer wg.Done()
+					for j := 0; j < numIterations; j++ {
+						data := &SampleStruct{
+							ID:      id,
+							Name:    "Concurrent Test",
+							Numbers: []int{j, j + 1, j + 2},
+							Details: map[string]string{
+								"iteration": fmt.Sprintf("%d", j),
+							},
+						}
+						state, err := serializer.Serialize(data)
+						if err != nil {
+							t.Errorf("Failed to serialize data in goroutine %d: %v", id, err)
+							return
+						}
+						decoded := &SampleStruct{}
+						err = unserializer.Unserialize(state.Bytes(), decoded)
+						state.Release()
+						if err != nil {
+							t.Errorf("Failed to unserialize data in goroutine %d: %v", id, err)
+							return
+						}
+						if !decoded.Equals(data) {
+							t.Errorf("Decoded data does not match original in goroutine %d: %v vs %v", id, decoded, data)
+						}
+					}
+				}(i)
+			}
+
+			wg.Wait()
+		})
+	}
+}
+
+// newFormatSerializers returns the serializer and unserializer of the format
+func newFormatSerializers[T interface{}](t *testing.T, format encodings.Format, name string) (encodings.Serializer[T], encodings.Unserializer[T]) {
+	serializer, err := encodings.NewFormatSerializer[T](format, name)
+	if err != nil {
+		t.Fatalf("NewFormatSerializer failed: %v", err)
+	}
+	unserializer, err := encodings.NewFormatUnserializer[T](format, name)
+	if err != nil {
+		t.Fatalf("NewFormatUnserializer failed: %v", err)
+	}
+	return serializer, unserializer
+}
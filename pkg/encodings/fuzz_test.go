@@ -0,0 +1,51 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+)
+
+// FuzzDecryptor_Decrypt tests that decrypting any text does not panic, and
+// that Decrypt and DecryptFrom agree on it. The seed corpus in
+// testdata/fuzz/FuzzDecryptor_Decrypt contains the golden envelopes in each
+// text encoding, and truncated and corrupted copies of them.
+func FuzzDecryptor_Decrypt(f *testing.F) {
+	key, err := hex.DecodeString(goldenKey)
+	if err != nil {
+		f.Fatalf("Failed to decode key: %v", err)
+	}
+
+	schema := encodings.NewSchemaRegistry(2).Register(1, func(data []byte) ([]byte, error) {
+		return data, nil
+	})
+	decryptor := encodings.NewDecryptor[*SampleStruct](encodings.NewUpcastingUnserializer[*SampleStruct](encodings.NewJsonUnserializer[*SampleStruct]("SampleStruct"), schema))
+	if err := decryptor.Initialize(key); err != nil {
+		f.Fatalf("Failed to initialize decryptor: %v", err)
+	}
+
+	f.Fuzz(func(t *testing.T, text string) {
+		decoded := &SampleStruct{}
+		lifetime, err := decryptor.DecryptWithLifetime(text, decoded)
+
+		decodedFrom := &SampleStruct{}
+		lifetimeFrom, errFrom := decryptor.DecryptFrom([]byte(text), decodedFrom)
+
+		if (err == nil) != (errFrom == nil) {
+			t.Fatalf("Decrypt returned %v, but DecryptFrom returned %v", err, errFrom)
+		}
+		if err != nil {
+			return
+		}
+		if lifetime != lifetimeFrom {
+			t.Errorf("Decrypt returned lifetime %v, but DecryptFrom returned %v", lifetime, lifetimeFrom)
+		}
+		if !decoded.Equals(decodedFrom) {
+			t.Errorf("Decrypt returned %v, but DecryptFrom returned %v", decoded, decodedFrom)
+		}
+	})
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"crypto/cipher"
+
+	"github.com/google/tink/go/aead/subtle"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+// gcmSIVTagSize is the tag size of AES-GCM-SIV
+const gcmSIVTagSize = 16
+
+// nonceSealer is implemented by ciphers which choose a random nonce when
+// sealing, so they cannot be given a nonce, e.g. a synthetic nonce or the
+// nonce of a chunk in a stream
+type nonceSealer interface {
+	cipher.AEAD
+	// sealWithNonce appends a random nonce and the sealed plaintext to dst
+	sealWithNonce(dst, plaintext, additionalData []byte) ([]byte, error)
+}
+
+// gcmSIV is AES-256-GCM-SIV from RFC 8452 as implemented by Tink. It is nonce
+// misuse resistant: repeating a nonce only reveals whether the same plaintext
+// was encrypted twice with the same additional data. Tink always chooses the
+// nonce itself, so the cipher only seals with sealWithNonce.
+type gcmSIV struct {
+	aead *subtle.AESGCMSIV
+}
+
+var _ nonceSealer = &gcmSIV{}
+
+// newGCMSIV creates AES-256-GCM-SIV cipher
+func newGCMSIV(key []byte) (cipher.AEAD, error) {
+	if len(key) != MinimumKeySizeAES256 {
+		return nil, errors.ErrGCMSIVInvalidKeySize
+	}
+	aead, err := subtle.NewAESGCMSIV(key)
+	if err != nil {
+		return nil, err
+	}
+	return &gcmSIV{aead: aead}, nil
+}
+
+func (g *gcmSIV) NonceSize() int {
+	return subtle.AESGCMSIVNonceSize
+}
+
+func (g *gcmSIV) Overhead() int {
+	return gcmSIVTagSize
+}
+
+// Seal panics, since Tink does not accept a nonce from the caller. Use
+// sealWithNonce instead.
+func (g *gcmSIV) Seal(dst, nonce, plaintext, additionalData []byte) []byte {
+	panic("encodings: AES-256-GCM-SIV chooses its own nonce")
+}
+
+func (g *gcmSIV) sealWithNonce(dst, plaintext, additionalData []byte) ([]byte, error) {
+	sealed, err := g.aead.Encrypt(plaintext, additionalData)
+	if err != nil {
+		log.Errorf("[gcmSIV.sealWithNonce]: %v", err)
+		return nil, errors.ErrGCMSIVSealFailed
+	}
+	return append(dst, sealed...), nil
+}
+
+// Open decrypts the ciphertext sealed with the nonce, which Tink expects to
+// be given in front of the ciphertext
+func (g *gcmSIV) Open(dst, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	if len(nonce) != subtle.AESGCMSIVNonceSize {
+		return nil, errors.ErrGCMSIVOpenFailed
+	}
+	sealed := append(append(GetByteSlice(len(nonce)+len(ciphertext)), nonce...), ciphertext...)
+	defer ReleaseByteSlice(sealed)
+	plaintext, err := g.aead.Decrypt(sealed, additionalData)
+	if err != nil {
+		return nil, errors.ErrGCMSIVOpenFailed
+	}
+	return append(dst, plaintext...), nil
+}
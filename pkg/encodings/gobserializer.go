@@ -60,3 +60,8 @@ func (s *GobSerializer[T]) Serialize(data T) (SerializerState, error) {
 	}
 	return state, nil
 }
+
+// Format returns FormatGob
+func (s *GobSerializer[T]) Format() Format {
+	return FormatGob
+}
@@ -59,3 +59,8 @@ func (dp *GobUnserializer[T]) Unserialize(serialized []byte, out T) error {
 	}
 	return nil
 }
+
+// Format returns FormatGob
+func (dp *GobUnserializer[T]) Format() Format {
+	return FormatGob
+}
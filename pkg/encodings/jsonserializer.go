@@ -61,3 +61,8 @@ func (s *JsonSerializer[T]) Serialize(data T) (SerializerState, error) {
 	}
 	return state, nil
 }
+
+// Format returns FormatJson
+func (s *JsonSerializer[T]) Format() Format {
+	return FormatJson
+}
@@ -5,6 +5,7 @@ package encodings
 
 import (
 	"bytes"
+	"io"
 	"sync"
 
 	json "github.com/hyperifyio/statelessdb/pkg/encodings/json"
@@ -39,7 +40,7 @@ func (e *JsonDecoderState) Release() {
 type JsonUnserializer[T interface{}] struct {
 }
 
-var _ Unserializer[string] = &JsonUnserializer[string]{}
+var _ StreamUnserializer[string] = &JsonUnserializer[string]{}
 
 // NewJsonUnserializer initializes and returns a new JsonUnserializer.
 func NewJsonUnserializer[T interface{}](name string) *JsonUnserializer[T] {
@@ -49,12 +50,28 @@ func NewJsonUnserializer[T interface{}](name string) *JsonUnserializer[T] {
 // Unserialize decodes serialized data
 func (dp *JsonUnserializer[T]) Unserialize(serialized []byte, out T) error {
 	state := GetJsonDecoderState()
-	defer state.Release()
 	buf := state.buffer
 	buf.Write(serialized)
 	if err := state.Decoder.Decode(out); err != nil {
+		// The decoder keeps the error, so it is not returned to the pool
 		log.Errorf("[JsonUnserializer.Unserialize]: json decode failed: %v", err)
 		return errors.ErrDecryptDecodingJsonSerializationFailed
 	}
+	state.Release()
 	return nil
 }
+
+// UnserializeFrom decodes data while it is read from r
+func (dp *JsonUnserializer[T]) UnserializeFrom(r io.Reader, out T) error {
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(out); err != nil {
+		log.Errorf("[JsonUnserializer.UnserializeFrom]: json decode failed: %v", err)
+		return errors.ErrDecryptDecodingJsonStreamFailed
+	}
+	return nil
+}
+
+// Format returns FormatJson
+func (dp *JsonUnserializer[T]) Format() Format {
+	return FormatJson
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+// KeyProvider loads the keys of a key ring from a backend, e.g. an
+// environment variable, a file or an external command. Keys are loaded again
+// when the keys are reloaded.
+type KeyProvider interface {
+
+	// LoadKeys returns the active key used for encryption and previous keys
+	// which are still accepted for decryption
+	LoadKeys() (active []byte, previous [][]byte, err error)
+}
+
+// WatchedKeyProvider is a KeyProvider which loads the keys from files, so the
+// keys can be reloaded when the files change
+type WatchedKeyProvider interface {
+	KeyProvider
+
+	// WatchedPaths returns the paths which should be watched for changes
+	WatchedPaths() []string
+}
+
+// StaticKeyProvider provides keys which were given at startup
+type StaticKeyProvider struct {
+	active   []byte
+	previous [][]byte
+}
+
+var _ KeyProvider = &StaticKeyProvider{}
+
+// NewStaticKeyProvider creates a provider for keys which never change
+func NewStaticKeyProvider(active []byte, previous ...[]byte) *StaticKeyProvider {
+	return &StaticKeyProvider{active: active, previous: previous}
+}
+
+func (p *StaticKeyProvider) LoadKeys() ([]byte, [][]byte, error) {
+	return p.active, p.previous, nil
+}
+
+// EnvKeyProvider reads a hex encoded active key and comma separated previous
+// keys from environment variables. The environment of a running process is
+// not changed from outside, so reloading the keys returns the same keys.
+type EnvKeyProvider struct {
+	activeName   string
+	previousName string
+}
+
+var _ KeyProvider = &EnvKeyProvider{}
+
+// NewEnvKeyProvider creates a provider which reads keys from the environment
+// variables. The name of previous keys may be empty.
+func NewEnvKeyProvider(activeName, previousName string) *EnvKeyProvider {
+	return &EnvKeyProvider{activeName: activeName, previousName: previousName}
+}
+
+func (p *EnvKeyProvider) LoadKeys() ([]byte, [][]byte, error) {
+	keys := []string{os.Getenv(p.activeName)}
+	if p.previousName != "" {
+		keys = append(keys, strings.Split(os.Getenv(p.previousName), ",")...)
+	}
+	return parseHexKeys(keys)
+}
+
+// FileKeyProvider reads hex encoded keys from a file, one key per line. The
+// first key is the active key and the rest are previous keys. Empty lines and
+// lines starting with # are ignored.
+type FileKeyProvider struct {
+	path string
+}
+
+var _ WatchedKeyProvider = &FileKeyProvider{}
+
+// NewFileKeyProvider creates a provider which reads keys from a file
+func NewFileKeyProvider(path string) *FileKeyProvider {
+	return &FileKeyProvider{path: path}
+}
+
+func (p *FileKeyProvider) LoadKeys() ([]byte, [][]byte, error) {
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		log.Errorf("[FileKeyProvider.LoadKeys]: %v", err)
+		return nil, nil, errors.ErrKeyProviderReadFileFailed
+	}
+	return parseHexKeys(splitKeyLines(data))
+}
+
+func (p *FileKeyProvider) WatchedPaths() []string {
+	return []string{p.path}
+}
+
+// DirectoryKeyProvider reads hex encoded keys from files in a directory, one
+// key per file. Files are sorted by name and the last one is the active key,
+// so a new key is added by writing a file which sorts last, e.g. named by the
+// date. Files starting with a dot are ignored.
+type DirectoryKeyProvider struct {
+	path string
+}
+
+var _ WatchedKeyProvider = &DirectoryKeyProvider{}
+
+// NewDirectoryKeyProvider creates a provider which reads keys from a
+// directory
+func NewDirectoryKeyProvider(path string) *DirectoryKeyProvider {
+	return &DirectoryKeyProvider{path: path}
+}
+
+func (p *DirectoryKeyProvider) LoadKeys() ([]byte, [][]byte, error) {
+	names, err := p.names()
+	if err != nil {
+		return nil, nil, err
+	}
+	keys := make([]string, 0, len(names))
+	for i := len(names) - 1; i >= 0; i-- {
+		data, err := os.ReadFile(filepath.Join(p.path, names[i]))
+		if err != nil {
+			log.Errorf("[DirectoryKeyProvider.LoadKeys]: %v", err)
+			return nil, nil, errors.ErrKeyProviderReadDirectoryFailed
+		}
+		keys = append(keys, string(bytes.TrimSpace(data)))
+	}
+	return parseHexKeys(keys)
+}
+
+func (p *DirectoryKeyProvider) WatchedPaths() []string {
+	paths := []string{p.path}
+	if names, err := p.names(); err == nil {
+		for _, name := range names {
+			paths = append(paths, filepath.Join(p.path, name))
+		}
+	}
+	return paths
+}
+
+// names returns names of the key files in the directory, sorted
+func (p *DirectoryKeyProvider) names() ([]string, error) {
+	entries, err := os.ReadDir(p.path)
+	if err != nil {
+		log.Errorf("[DirectoryKeyProvider.names]: %v", err)
+		return nil, errors.ErrKeyProviderReadDirectoryFailed
+	}
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+// CommandKeyProvider runs an external command, e.g. a secret manager client,
+// which prints hex encoded keys one per line like the file of
+// FileKeyProvider
+type CommandKeyProvider struct {
+	name string
+	args []string
+}
+
+var _ KeyProvider = &CommandKeyProvider{}
+
+// NewCommandKeyProvider creates a provider which reads keys from the output
+// of a command. The command is not run in a shell.
+func NewCommandKeyProvider(name string, args ...string) *CommandKeyProvider {
+	return &CommandKeyProvider{name: name, args: args}
+}
+
+func (p *CommandKeyProvider) LoadKeys() ([]byte, [][]byte, error) {
+	cmd := exec.Command(p.name, p.args...)
+	cmd.Stderr = os.Stderr
+	output, err := cmd.Output()
+	if err != nil {
+		log.Errorf("[CommandKeyProvider.LoadKeys]: %s: %v", p.name, err)
+		return nil, nil, errors.ErrKeyProviderCommandFailed
+	}
+	return parseHexKeys(splitKeyLines(output))
+}
+
+// splitKeyLines returns the lines of data, ignoring empty lines and comments
+func splitKeyLines(data []byte) []string {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// parseHexKeys decodes hex encoded keys. The first non-empty key is the
+// active key.
+func parseHexKeys(keyStrings []string) ([]byte, [][]byte, error) {
+	var keys [][]byte
+	for _, keyString := range keyStrings {
+		keyString = strings.TrimSpace(keyString)
+		if keyString == "" {
+			continue
+		}
+		key, err := hex.DecodeString(keyString)
+		if err != nil {
+			log.Errorf("[parseHexKeys]: %v", err)
+			return nil, nil, errors.ErrKeyProviderInvalidHex
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, nil, errors.ErrKeyProviderNoKeys
+	}
+	return keys[0], keys[1:], nil
+}
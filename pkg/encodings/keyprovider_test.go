@@ -0,0 +1,170 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+func newTestHexKeys(t *testing.T, count int) ([][]byte, []string) {
+	keys := make([][]byte, count)
+	hexKeys := make([]string, count)
+	for i := range keys {
+		key, err := encodings.GenerateKey(32)
+		if err != nil {
+			t.Fatalf("Failed to generate key: %v", err)
+		}
+		keys[i] = key
+		hexKeys[i] = hex.EncodeToString(key)
+	}
+	return keys, hexKeys
+}
+
+func assertLoadedKeys(t *testing.T, provider encodings.KeyProvider, expected [][]byte) {
+	t.Helper()
+	active, previous, err := provider.LoadKeys()
+	if err != nil {
+		t.Fatalf("LoadKeys failed: %v", err)
+	}
+	if !bytes.Equal(active, expected[0]) {
+		t.Errorf("Expected active key %x, got %x", expected[0], active)
+	}
+	if len(previous) != len(expected)-1 {
+		t.Fatalf("Expected %d previous keys, got %d", len(expected)-1, len(previous))
+	}
+	for i, key := range previous {
+		if !bytes.Equal(key, expected[i+1]) {
+			t.Errorf("Expected previous key %x, got %x", expected[i+1], key)
+		}
+	}
+}
+
+func TestEnvKeyProvider(t *testing.T) {
+	keys, hexKeys := newTestHexKeys(t, 3)
+	t.Setenv("TEST_PRIVATE_KEY", hexKeys[0])
+	t.Setenv("TEST_PREVIOUS_PRIVATE_KEYS", hexKeys[1]+", "+hexKeys[2])
+	assertLoadedKeys(t, encodings.NewEnvKeyProvider("TEST_PRIVATE_KEY", "TEST_PREVIOUS_PRIVATE_KEYS"), keys)
+
+	t.Setenv("TEST_PRIVATE_KEY", "")
+	if _, _, err := encodings.NewEnvKeyProvider("TEST_PRIVATE_KEY", "").LoadKeys(); err != errors.ErrKeyProviderNoKeys {
+		t.Errorf("Expected error %v, got %v", errors.ErrKeyProviderNoKeys, err)
+	}
+
+	t.Setenv("TEST_PRIVATE_KEY", "not hex")
+	if _, _, err := encodings.NewEnvKeyProvider("TEST_PRIVATE_KEY", "").LoadKeys(); err != errors.ErrKeyProviderInvalidHex {
+		t.Errorf("Expected error %v, got %v", errors.ErrKeyProviderInvalidHex, err)
+	}
+}
+
+func TestFileKeyProvider(t *testing.T) {
+	keys, hexKeys := newTestHexKeys(t, 2)
+	path := filepath.Join(t.TempDir(), "keys")
+	content := "# Active key\n" + hexKeys[0] + "\n\n# Previous keys\n" + hexKeys[1] + "\n"
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to write key file: %v", err)
+	}
+	assertLoadedKeys(t, encodings.NewFileKeyProvider(path), keys)
+
+	if _, _, err := encodings.NewFileKeyProvider(path + ".missing").LoadKeys(); err != errors.ErrKeyProviderReadFileFailed {
+		t.Errorf("Expected error %v, got %v", errors.ErrKeyProviderReadFileFailed, err)
+	}
+}
+
+func TestDirectoryKeyProvider(t *testing.T) {
+	keys, hexKeys := newTestHexKeys(t, 3)
+	dir := t.TempDir()
+	for i, name := range []string{"2024-03.key", "2024-02.key", "2024-01.key"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(hexKeys[i]+"\n"), 0600); err != nil {
+			t.Fatalf("Failed to write key file: %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, ".hidden"), []byte("not hex"), 0600); err != nil {
+		t.Fatalf("Failed to write hidden file: %v", err)
+	}
+	assertLoadedKeys(t, encodings.NewDirectoryKeyProvider(dir), keys)
+}
+
+func TestCommandKeyProvider(t *testing.T) {
+	echo, err := exec.LookPath("echo")
+	if err != nil {
+		t.Skip("echo is not available")
+	}
+	keys, hexKeys := newTestHexKeys(t, 1)
+	assertLoadedKeys(t, encodings.NewCommandKeyProvider(echo, hexKeys[0]), keys)
+
+	if _, _, err = encodings.NewCommandKeyProvider(filepath.Join(t.TempDir(), "missing")).LoadKeys(); err != errors.ErrKeyProviderCommandFailed {
+		t.Errorf("Expected error %v, got %v", errors.ErrKeyProviderCommandFailed, err)
+	}
+}
+
+func TestKeyReloader(t *testing.T) {
+	keys, hexKeys := newTestHexKeys(t, 2)
+	path := filepath.Join(t.TempDir(), "keys")
+	if err := os.WriteFile(path, []byte(hexKeys[0]), 0600); err != nil {
+		t.Fatalf("Failed to write key file: %v", err)
+	}
+
+	reloader, err := encodings.NewKeyReloader(encodings.NewFileKeyProvider(path), encodings.DefaultSuite)
+	if err != nil {
+		t.Fatalf("NewKeyReloader failed: %v", err)
+	}
+	if reloader.KeyRing().ActiveKeyID() != encodings.KeyID(keys[0]) {
+		t.Errorf("Expected active key %08x, got %08x", encodings.KeyID(keys[0]), reloader.KeyRing().ActiveKeyID())
+	}
+
+	var reloaded *encodings.KeyRing
+	reloader.OnReload(func(keys *encodings.KeyRing) {
+		reloaded = keys
+	})
+
+	if err = reloader.Reload(); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	if reloaded != nil {
+		t.Errorf("Listener should not be called when keys have not changed")
+	}
+
+	if err = os.WriteFile(path, []byte(hexKeys[1]+"\n"+hexKeys[0]), 0600); err != nil {
+		t.Fatalf("Failed to write key file: %v", err)
+	}
+	later := time.Now().Add(time.Second)
+	if err = os.Chtimes(path, later, later); err != nil {
+		t.Fatalf("Failed to change the modification time: %v", err)
+	}
+	if !reloader.Changed() {
+		t.Errorf("Key file change should be detected")
+	}
+
+	if err = reloader.Reload(); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	if reloaded == nil || reloaded.ActiveKeyID() != encodings.KeyID(keys[1]) || !reloaded.Has(encodings.KeyID(keys[0])) {
+		t.Errorf("Listener should be called with the new keys")
+	}
+	if reloader.Changed() {
+		t.Errorf("Keys should not be changed after reload")
+	}
+
+	if err = os.WriteFile(path, []byte("not hex"), 0600); err != nil {
+		t.Fatalf("Failed to write key file: %v", err)
+	}
+	if err = reloader.Reload(); err != errors.ErrKeyReloaderLoadFailed {
+		t.Errorf("Expected error %v, got %v", errors.ErrKeyReloaderLoadFailed, err)
+	}
+	if reloader.KeyRing() != reloaded {
+		t.Errorf("Previous keys should be kept when reloading fails")
+	}
+	if !reloader.Changed() {
+		t.Errorf("Key file change should still be detected after reloading fails")
+	}
+}
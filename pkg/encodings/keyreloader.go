@@ -0,0 +1,140 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"maps"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+// KeyReloader creates the key ring from a KeyProvider, and creates it again
+// when the keys are reloaded. Listeners, e.g. Encryptor.InitializeKeyRing, are
+// called with the new key ring, so keys can be rotated without a restart.
+type KeyReloader struct {
+	provider KeyProvider
+	suite    Suite
+
+	mu        sync.Mutex
+	keys      *KeyRing              // keys is the current key ring
+	listeners []func(keys *KeyRing) // listeners are called when the key ring changes
+	modTimes  map[string]time.Time  // modTimes contains modification times of watched paths
+}
+
+// NewKeyReloader creates a reloader and loads the keys. The suite is used for
+// encryption.
+func NewKeyReloader(provider KeyProvider, suite Suite) (*KeyReloader, error) {
+	r := &KeyReloader{
+		provider: provider,
+		suite:    suite,
+	}
+	r.modTimes = r.readModTimes()
+	keys, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+	r.keys = keys
+	return r, nil
+}
+
+// KeyRing returns the current key ring
+func (r *KeyReloader) KeyRing() *KeyRing {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.keys
+}
+
+// OnReload adds a listener which is called with the new key ring after the
+// keys have changed
+func (r *KeyReloader) OnReload(listener func(keys *KeyRing)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.listeners = append(r.listeners, listener)
+}
+
+// Reload loads the keys again. If loading fails, the previous key ring is
+// kept, and Changed keeps reporting the change so loading is tried again.
+// Listeners are only called if the keys have changed.
+func (r *KeyReloader) Reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	modTimes := r.readModTimes()
+	keys, err := r.load()
+	if err != nil {
+		return err
+	}
+	r.modTimes = modTimes
+	if slices.Equal(keys.KeyIDs(), r.keys.KeyIDs()) {
+		log.Debugf("[KeyReloader.Reload]: Keys have not changed")
+		return nil
+	}
+	log.Infof("[KeyReloader.Reload]: Reloaded keys, active key is %08x", keys.ActiveKeyID())
+	r.keys = keys
+	for _, listener := range r.listeners {
+		listener(keys)
+	}
+	return nil
+}
+
+// Changed returns true if any file watched by the provider has changed since
+// the keys were loaded. It is always false unless the provider is a
+// WatchedKeyProvider.
+func (r *KeyReloader) Changed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return !maps.EqualFunc(r.modTimes, r.readModTimes(), time.Time.Equal)
+}
+
+// Watch reloads the keys whenever Changed reports a change, checking every
+// interval until stop is closed
+func (r *KeyReloader) Watch(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if r.Changed() {
+				if err := r.Reload(); err != nil {
+					log.Errorf("[KeyReloader.Watch]: Reloading keys failed: %v", err)
+				}
+			}
+		}
+	}
+}
+
+// load creates a key ring from the keys of the provider
+func (r *KeyReloader) load() (*KeyRing, error) {
+	active, previous, err := r.provider.LoadKeys()
+	if err != nil {
+		log.Errorf("[KeyReloader.load]: Loading keys failed: %v", err)
+		return nil, errors.ErrKeyReloaderLoadFailed
+	}
+	keys, err := NewKeyRingWithSuite(r.suite, active, previous...)
+	if err != nil {
+		log.Errorf("[KeyReloader.load]: NewKeyRing: %v", err)
+		return nil, errors.ErrKeyReloaderKeyRingFailed
+	}
+	return keys, nil
+}
+
+// readModTimes returns modification times of the watched paths
+func (r *KeyReloader) readModTimes() map[string]time.Time {
+	watched, ok := r.provider.(WatchedKeyProvider)
+	if !ok {
+		return nil
+	}
+	modTimes := make(map[string]time.Time)
+	for _, path := range watched.WatchedPaths() {
+		if info, err := os.Stat(path); err == nil {
+			modTimes[path] = info.ModTime()
+		}
+	}
+	return modTimes
+}
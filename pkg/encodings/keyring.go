@@ -0,0 +1,178 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+const keyIDContext = "statelessdb key id v1"
+
+// KeyID returns the identifier which is recorded in the envelope for a key.
+// It is a truncated SHA-256 fingerprint, so the same key has the same ID on
+// every server without any extra configuration.
+func KeyID(key []byte) uint32 {
+	h := sha256.New()
+	h.Write([]byte(keyIDContext))
+	h.Write(key)
+	sum := h.Sum(nil)
+	return binary.BigEndian.Uint32(sum[:KeyIDSize])
+}
+
+// ringKey is a single key in the KeyRing
+type ringKey struct {
+	id       uint32                  // id is the identifier recorded in the envelope
+	secret   []byte                  // secret is the key, used to derive keys for tenants
+	nonceKey []byte                  // nonceKey is the MAC key of deterministic nonces
+	aeads    [suiteCount]cipher.AEAD // aeads contains the cipher of every suite for this key
+}
+
+// aead returns the cipher of a suite for this key
+func (k *ringKey) aead(suite Suite) (cipher.AEAD, bool) {
+	if !suite.IsValid() {
+		return nil, false
+	}
+	return k.aeads[suite], true
+}
+
+// KeyRing holds the active key used for encryption and older keys which are
+// still accepted for decryption. It is immutable after creation and safe to
+// share between an Encryptor and a Decryptor.
+type KeyRing struct {
+	suite  Suite               // suite is the cipher suite used for encryption
+	active *ringKey            // active is the key used for encryption
+	keys   map[uint32]*ringKey // keys contains all keys by their ID
+	order  []*ringKey          // order contains all keys, the active key first
+}
+
+// NewKeyRing creates a key ring where the active key is used for encryption
+// with the default suite and the previous keys are only accepted for
+// decryption.
+func NewKeyRing(active []byte, previous ...[]byte) (*KeyRing, error) {
+	return NewKeyRingWithSuite(DefaultSuite, active, previous...)
+}
+
+// NewKeyRingWithSuite creates a key ring which encrypts using the suite.
+// Data encrypted with any supported suite is accepted for decryption, since
+// the suite is recorded in the envelope.
+func NewKeyRingWithSuite(suite Suite, active []byte, previous ...[]byte) (*KeyRing, error) {
+	if !suite.IsValid() {
+		log.Errorf("[KeyRing.NewKeyRingWithSuite]: Unsupported suite %d", suite)
+		return nil, errors.ErrKeyRingUnsupportedSuite
+	}
+	r := &KeyRing{
+		suite: suite,
+		keys:  make(map[uint32]*ringKey, 1+len(previous)),
+		order: make([]*ringKey, 0, 1+len(previous)),
+	}
+	if err := r.add(active); err != nil {
+		return nil, err
+	}
+	r.active = r.order[0]
+	for _, key := range previous {
+		if err := r.add(key); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// add creates the cipher for a key and adds it to the ring
+func (r *KeyRing) add(key []byte) error {
+	if len(key) < MinimumKeySizeAES256 {
+		log.Errorf("[KeyRing.add]: Key size %d less than minimum %d", len(key), MinimumKeySizeAES256)
+		return errors.ErrKeyRingKeySizeLessThanMinimum
+	}
+	id := KeyID(key)
+	if _, exists := r.keys[id]; exists {
+		log.Errorf("[KeyRing.add]: Key %08x was already added", id)
+		return errors.ErrKeyRingDuplicateKey
+	}
+	k, err := newRingKey(id, key)
+	if err != nil {
+		return err
+	}
+	r.keys[id] = k
+	r.order = append(r.order, k)
+	return nil
+}
+
+// newRingKey creates the cipher of every suite for a key
+func newRingKey(id uint32, key []byte) (*ringKey, error) {
+	k := &ringKey{id: id, secret: bytes.Clone(key), nonceKey: deriveNonceKey(key)}
+	for _, suite := range Suites() {
+		aead, err := suite.newAEAD(key)
+		if err != nil {
+			log.Errorf("[KeyRing.newRingKey]: Creating %s: %v", suite, err)
+			return nil, errors.ErrKeyRingNewAEAD
+		}
+		k.aeads[suite] = aead
+	}
+	return k, nil
+}
+
+// derive creates a key ring where every key is derived from the key in this
+// ring using HKDF-SHA256 with the info. Derived keys keep the IDs of the keys
+// they were derived from, so the envelope does not tell which info was used.
+func (r *KeyRing) derive(info []byte) (*KeyRing, error) {
+	derived := &KeyRing{
+		suite: r.suite,
+		keys:  make(map[uint32]*ringKey, len(r.order)),
+		order: make([]*ringKey, 0, len(r.order)),
+	}
+	for _, k := range r.order {
+		secret := make([]byte, len(k.secret))
+		if _, err := io.ReadFull(hkdf.New(sha256.New, k.secret, nil, info), secret); err != nil {
+			log.Errorf("[KeyRing.derive]: HKDF: %v", err)
+			return nil, errors.ErrKeyRingDeriveFailed
+		}
+		dk, err := newRingKey(k.id, secret)
+		if err != nil {
+			return nil, err
+		}
+		derived.keys[dk.id] = dk
+		derived.order = append(derived.order, dk)
+	}
+	derived.active = derived.order[0]
+	return derived, nil
+}
+
+// Suite returns the cipher suite used for encryption
+func (r *KeyRing) Suite() Suite {
+	return r.suite
+}
+
+// ActiveKeyID returns the ID of the key used for encryption
+func (r *KeyRing) ActiveKeyID() uint32 {
+	return r.active.id
+}
+
+// KeyIDs returns IDs of all keys in the ring, the active key first
+func (r *KeyRing) KeyIDs() []uint32 {
+	ids := make([]uint32, len(r.order))
+	for i, k := range r.order {
+		ids[i] = k.id
+	}
+	return ids
+}
+
+// Has returns true if the ring contains a key with this ID
+func (r *KeyRing) Has(id uint32) bool {
+	_, exists := r.keys[id]
+	return exists
+}
+
+// key returns a key by its ID
+func (r *KeyRing) key(id uint32) (*ringKey, bool) {
+	k, exists := r.keys[id]
+	return k, exists
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+	"github.com/hyperifyio/statelessdb/pkg/states"
+)
+
+// newTestKeyRing returns a key ring of the suite with a new key
+func newTestKeyRing(t *testing.T, suite encodings.Suite) *encodings.KeyRing {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	keys, err := encodings.NewKeyRingWithSuite(suite, key)
+	if err != nil {
+		t.Fatalf("Failed to create key ring: %v", err)
+	}
+	return keys
+}
+
+func TestNewKeyRing(t *testing.T) {
+	key1, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key1: %v", err)
+	}
+	key2, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key2: %v", err)
+	}
+
+	keys, err := encodings.NewKeyRing(key1, key2)
+	if err != nil {
+		t.Fatalf("NewKeyRing failed: %v", err)
+	}
+
+	if keys.ActiveKeyID() != encodings.KeyID(key1) {
+		t.Errorf("Expected active key %08x, got %08x", encodings.KeyID(key1), keys.ActiveKeyID())
+	}
+
+	ids := keys.KeyIDs()
+	if len(ids) != 2 || ids[0] != encodings.KeyID(key1) || ids[1] != encodings.KeyID(key2) {
+		t.Errorf("Unexpected key IDs: %v", ids)
+	}
+
+	if !keys.Has(encodings.KeyID(key2)) {
+		t.Errorf("Key ring should have the previous key")
+	}
+}
+
+func TestNewKeyRing_InvalidKeySize(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	if _, err = encodings.NewKeyRing([]byte("shortkey")); err != errors.ErrKeyRingKeySizeLessThanMinimum {
+		t.Errorf("Expected error %v, got %v", errors.ErrKeyRingKeySizeLessThanMinimum, err)
+	}
+
+	if _, err = encodings.NewKeyRing(key, []byte("shortkey")); err != errors.ErrKeyRingKeySizeLessThanMinimum {
+		t.Errorf("Expected error %v, got %v", errors.ErrKeyRingKeySizeLessThanMinimum, err)
+	}
+}
+
+func TestNewKeyRing_DuplicateKey(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	if _, err = encodings.NewKeyRing(key, key); err != errors.ErrKeyRingDuplicateKey {
+		t.Errorf("Expected error %v, got %v", errors.ErrKeyRingDuplicateKey, err)
+	}
+}
+
+func TestKeyID_IsStable(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	if encodings.KeyID(key) != encodings.KeyID(append([]byte(nil), key...)) {
+		t.Errorf("Key ID should only depend on the key")
+	}
+}
+
+// TestDecryptor_KeyRotation tests that data encrypted with a previous key is
+// still accepted after the key was rotated.
+func TestDecryptor_KeyRotation(t *testing.T) {
+	oldKey, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate oldKey: %v", err)
+	}
+	newKey, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate newKey: %v", err)
+	}
+
+	serializer := encodings.NewGobSerializer[*states.ComputeState]("ComputeState")
+	unserializer := encodings.NewGobUnserializer[*states.ComputeState]("ComputeState")
+
+	oldEncryptor := encodings.NewEncryptor[*states.ComputeState](serializer)
+	if err = oldEncryptor.Initialize(oldKey); err != nil {
+		t.Fatalf("Failed to initialize Encryptor with oldKey: %v", err)
+	}
+
+	data := states.NewComputeState(uuid.New(), uuid.New(), 0, 0, nil, nil, nil)
+	ciphertext, err := oldEncryptor.Encrypt(data)
+	if err != nil {
+		t.Fatalf("Encryption failed: %v", err)
+	}
+
+	// Rotate: the new key is active and the old key is still accepted
+	keys, err := encodings.NewKeyRing(newKey, oldKey)
+	if err != nil {
+		t.Fatalf("NewKeyRing failed: %v", err)
+	}
+
+	decryptor := encodings.NewDecryptor[*states.ComputeState](unserializer)
+	if err = decryptor.InitializeKeyRing(keys); err != nil {
+		t.Fatalf("Failed to initialize Decryptor: %v", err)
+	}
+
+	decrypted := &states.ComputeState{}
+	if err = decryptor.Decrypt(ciphertext, decrypted); err != nil {
+		t.Fatalf("Decrypt with previous key failed: %v", err)
+	}
+	if !decrypted.Equals(data) {
+		t.Errorf("Decrypted data does not match original.\nOriginal: %v\nDecrypted: %v", data, decrypted)
+	}
+
+	// Re-encrypting uses the new key, which is not accepted by the old ring
+	newEncryptor := encodings.NewEncryptor[*states.ComputeState](serializer)
+	if err = newEncryptor.InitializeKeyRing(keys); err != nil {
+		t.Fatalf("Failed to initialize Encryptor: %v", err)
+	}
+	reencrypted, err := newEncryptor.Encrypt(decrypted)
+	if err != nil {
+		t.Fatalf("Re-encryption failed: %v", err)
+	}
+
+	oldDecryptor := encodings.NewDecryptor[*states.ComputeState](unserializer)
+	if err = oldDecryptor.Initialize(oldKey); err != nil {
+		t.Fatalf("Failed to initialize Decryptor with oldKey: %v", err)
+	}
+	if err = oldDecryptor.Decrypt(reencrypted, &states.ComputeState{}); err == nil {
+		t.Errorf("Re-encrypted data should not be accepted with only the old key")
+	}
+}
+
+// TestDecryptor_LegacyData tests that data encrypted before envelopes were
+// introduced, e.g. base64(nonce|ciphertext), can still be decrypted unless
+// it is rejected.
+func TestDecryptor_LegacyData(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	previousKey, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate previousKey: %v", err)
+	}
+
+	serializer := encodings.NewJsonSerializer[*states.ComputeState]("ComputeState")
+	unserializer := encodings.NewJsonUnserializer[*states.ComputeState]("ComputeState")
+
+	data := states.NewComputeState(uuid.New(), uuid.New(), 0, 0, nil, nil, nil)
+	state, err := serializer.Serialize(data)
+	if err != nil {
+		t.Fatalf("Serialize failed: %v", err)
+	}
+	defer state.Release()
+
+	block, err := aes.NewCipher(previousKey)
+	if err != nil {
+		t.Fatalf("NewCipher failed: %v", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatalf("NewGCM failed: %v", err)
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		t.Fatalf("Nonce generation failed: %v", err)
+	}
+	legacy := base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, state.Bytes(), nil))
+
+	keys, err := encodings.NewKeyRing(key, previousKey)
+	if err != nil {
+		t.Fatalf("NewKeyRing failed: %v", err)
+	}
+	decryptor := encodings.NewDecryptor[*states.ComputeState](unserializer)
+	if err = decryptor.InitializeKeyRing(keys); err != nil {
+		t.Fatalf("Failed to initialize Decryptor: %v", err)
+	}
+
+	decrypted := &states.ComputeState{}
+	if err = decryptor.Decrypt(legacy, decrypted); err != nil {
+		t.Fatalf("Decrypt of legacy data failed: %v", err)
+	}
+	if !decrypted.Equals(data) {
+		t.Errorf("Decrypted data does not match original.\nOriginal: %v\nDecrypted: %v", data, decrypted)
+	}
+	// Legacy data is not accepted once it has been rejected, but envelopes are
+	decryptor.WithLegacy(false)
+	if err = decryptor.Decrypt(legacy, &states.ComputeState{}); err == nil {
+		t.Errorf("Legacy data should not be accepted after WithLegacy(false)")
+	}
+	encryptor := encodings.NewEncryptor[*states.ComputeState](serializer)
+	if err = encryptor.InitializeKeyRing(keys); err != nil {
+		t.Fatalf("Failed to initialize Encryptor: %v", err)
+	}
+	encrypted, err := encryptor.Encrypt(data)
+	if err != nil {
+		t.Fatalf("Encrypt failed: %v", err)
+	}
+	if err = decryptor.Decrypt(encrypted, &states.ComputeState{}); err != nil {
+		t.Errorf("Envelope should be accepted after WithLegacy(false): %v", err)
+	}
+}
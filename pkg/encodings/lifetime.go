@@ -0,0 +1,53 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"encoding/binary"
+)
+
+// Lifetime contains timestamps sealed inside the encrypted envelope, as Unix
+// milliseconds like other times in states. Zero means the time is not set.
+type Lifetime struct {
+	IssuedAt  int64 // IssuedAt is the time when the data was encrypted
+	ExpiresAt int64 // ExpiresAt is the time when the data is no longer accepted
+}
+
+// NewLifetime creates a lifetime issued now which expires after ttl
+// milliseconds. If ttl is zero, the lifetime does not expire.
+func NewLifetime(now, ttl int64) Lifetime {
+	if ttl <= 0 {
+		return Lifetime{IssuedAt: now}
+	}
+	return Lifetime{IssuedAt: now, ExpiresAt: now + ttl}
+}
+
+// IsZero returns true if no time is set
+func (l Lifetime) IsZero() bool {
+	return l.IssuedAt == 0 && l.ExpiresAt == 0
+}
+
+// IsExpired returns true if the lifetime has an expiration time which is not
+// after now
+func (l Lifetime) IsExpired(now int64) bool {
+	return l.ExpiresAt != 0 && now >= l.ExpiresAt
+}
+
+// appendLifetime appends the lifetime to dst
+func appendLifetime(dst []byte, l Lifetime) []byte {
+	dst = binary.BigEndian.AppendUint64(dst, uint64(l.IssuedAt))
+	return binary.BigEndian.AppendUint64(dst, uint64(l.ExpiresAt))
+}
+
+// parseLifetime parses the lifetime from the start of data and returns the
+// rest of the data
+func parseLifetime(data []byte) (Lifetime, []byte, bool) {
+	if len(data) < LifetimeSize {
+		return Lifetime{}, nil, false
+	}
+	return Lifetime{
+		IssuedAt:  int64(binary.BigEndian.Uint64(data[:8])),
+		ExpiresAt: int64(binary.BigEndian.Uint64(data[8:LifetimeSize])),
+	}, data[LifetimeSize:], true
+}
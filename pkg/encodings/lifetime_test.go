@@ -0,0 +1,77 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/states"
+)
+
+func TestNewLifetime(t *testing.T) {
+	lifetime := encodings.NewLifetime(1000, 500)
+	if lifetime.IssuedAt != 1000 || lifetime.ExpiresAt != 1500 {
+		t.Errorf("Unexpected lifetime: %+v", lifetime)
+	}
+	if lifetime.IsExpired(1499) {
+		t.Errorf("Lifetime should not be expired before ExpiresAt")
+	}
+	if !lifetime.IsExpired(1500) {
+		t.Errorf("Lifetime should be expired at ExpiresAt")
+	}
+
+	lifetime = encodings.NewLifetime(1000, 0)
+	if lifetime.ExpiresAt != 0 || lifetime.IsExpired(1<<62) {
+		t.Errorf("Lifetime without TTL should never expire: %+v", lifetime)
+	}
+}
+
+func TestEncryptor_EncryptWithLifetime(t *testing.T) {
+	keys := newTestKeyRing(t, encodings.SuiteAES256GCM)
+	encryptor := encodings.NewEncryptor[*states.ComputeState](encodings.NewJsonSerializer[*states.ComputeState]("ComputeState"))
+	if err := encryptor.InitializeKeyRing(keys); err != nil {
+		t.Fatalf("Failed to initialize Encryptor: %v", err)
+	}
+	decryptor := encodings.NewDecryptor[*states.ComputeState](encodings.NewJsonUnserializer[*states.ComputeState]("ComputeState"))
+	if err := decryptor.InitializeKeyRing(keys); err != nil {
+		t.Fatalf("Failed to initialize Decryptor: %v", err)
+	}
+
+	data := states.NewComputeState(uuid.New(), uuid.New(), 0, 0, nil, nil, nil)
+	lifetime := encodings.NewLifetime(states.NewTimeNow(), 60000)
+	additionalData := encodings.NewAdditionalData([]byte("compute"))
+
+	ciphertext, err := encryptor.EncryptWithLifetime(data, additionalData, lifetime)
+	if err != nil {
+		t.Fatalf("Encryption failed: %v", err)
+	}
+
+	decrypted := &states.ComputeState{}
+	decryptedLifetime, err := decryptor.DecryptWithLifetime(ciphertext, decrypted, additionalData)
+	if err != nil {
+		t.Fatalf("Decryption failed: %v", err)
+	}
+	if decryptedLifetime != lifetime {
+		t.Errorf("Expected lifetime %+v, got %+v", lifetime, decryptedLifetime)
+	}
+	if !decrypted.Equals(data) {
+		t.Errorf("Decrypted data does not match original.\nOriginal: %v\nDecrypted: %v", data, decrypted)
+	}
+
+	// Data without a lifetime has a zero lifetime
+	ciphertext, err = encryptor.Encrypt(data)
+	if err != nil {
+		t.Fatalf("Encryption failed: %v", err)
+	}
+	decryptedLifetime, err = decryptor.DecryptWithLifetime(ciphertext, &states.ComputeState{})
+	if err != nil {
+		t.Fatalf("Decryption failed: %v", err)
+	}
+	if !decryptedLifetime.IsZero() {
+		t.Errorf("Expected zero lifetime, got %+v", decryptedLifetime)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+var msgpackEncoderPoolState = sync.Pool{
+	New: func() interface{} {
+		buf := new(bytes.Buffer)
+		encoder := msgpack.NewEncoder(buf)
+		encoder.SetCustomStructTag("json")
+		encoder.SetSortMapKeys(true)
+		return &MsgpackEncoderState{
+			buf,
+			encoder,
+		}
+	},
+}
+
+func GetMsgpackEncoderState() *MsgpackEncoderState {
+	return msgpackEncoderPoolState.Get().(*MsgpackEncoderState)
+}
+
+type MsgpackEncoderState struct {
+	buffer  *bytes.Buffer
+	Encoder *msgpack.Encoder
+}
+
+var _ SerializerState = &MsgpackEncoderState{}
+
+func (e *MsgpackEncoderState) Release() {
+	e.buffer.Reset()
+	msgpackEncoderPoolState.Put(e)
+}
+
+func (e *MsgpackEncoderState) Bytes() []byte {
+	return e.buffer.Bytes()
+}
+
+// MsgpackSerializer manages a pool of msgpack.Encoder instances. Struct
+// fields are named by their json tags, so the same structs work with both.
+type MsgpackSerializer[T interface{}] struct {
+}
+
+var _ Serializer[string] = &MsgpackSerializer[string]{}
+
+// NewMsgpackSerializer initializes a new MsgpackSerializer with a
+// msgpack.Encoder pool.
+func NewMsgpackSerializer[T interface{}](name string) *MsgpackSerializer[T] {
+	return &MsgpackSerializer[T]{}
+}
+
+// Serialize serializes the given data using a reusable msgpack.Encoder.
+// It returns the serialized bytes or an error.
+func (s *MsgpackSerializer[T]) Serialize(data T) (SerializerState, error) {
+	state := GetMsgpackEncoderState()
+	if err := state.Encoder.Encode(data); err != nil {
+		state.Release()
+		return nil, err
+	}
+	return state, nil
+}
+
+// Format returns FormatMsgpack
+func (s *MsgpackSerializer[T]) Format() Format {
+	return FormatMsgpack
+}
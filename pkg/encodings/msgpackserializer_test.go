@@ -0,0 +1,44 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+	"github.com/hyperifyio/statelessdb/pkg/states"
+)
+
+// TestMsgpackSerializer_JsonFieldNames tests that fields are named by their
+// json tags, so the same names are used in every format
+func TestMsgpackSerializer_JsonFieldNames(t *testing.T) {
+	serializer := encodings.NewMsgpackSerializer[*states.ComputeState]("ComputeState")
+	unserializer := encodings.NewMsgpackUnserializer[*map[string]interface{}]("map")
+
+	data := newLargeComputeState(1)
+	state, err := serializer.Serialize(data)
+	if err != nil {
+		t.Fatalf("Failed to serialize data: %v", err)
+	}
+	defer state.Release()
+
+	decoded := map[string]interface{}{}
+	if err := unserializer.Unserialize(state.Bytes(), &decoded); err != nil {
+		t.Fatalf("Failed to unserialize data: %v", err)
+	}
+	for _, name := range []string{"id", "owner", "private"} {
+		if _, exists := decoded[name]; !exists {
+			t.Errorf("Expected field %q, got %v", name, decoded)
+		}
+	}
+}
+
+// TestMsgpackUnserializer_InvalidData tests unserialization of invalid data
+func TestMsgpackUnserializer_InvalidData(t *testing.T) {
+	unserializer := encodings.NewMsgpackUnserializer[*SampleStruct]("SampleStruct")
+	if err := unserializer.Unserialize([]byte{0xc1}, &SampleStruct{}); err != errors.ErrDecryptDecodingMsgpackSerializationFailed {
+		t.Errorf("Expected ErrDecryptDecodingMsgpackSerializationFailed, got %v", err)
+	}
+}
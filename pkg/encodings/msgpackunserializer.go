@@ -0,0 +1,81 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+var msgpackDecoderPoolState = sync.Pool{
+	New: func() interface{} {
+		reader := bytes.NewReader(nil)
+		decoder := msgpack.NewDecoder(reader)
+		decoder.SetCustomStructTag("json")
+		return &MsgpackDecoderState{
+			reader,
+			decoder,
+		}
+	},
+}
+
+func GetMsgpackDecoderState() *MsgpackDecoderState {
+	return msgpackDecoderPoolState.Get().(*MsgpackDecoderState)
+}
+
+type MsgpackDecoderState struct {
+	reader  *bytes.Reader
+	Decoder *msgpack.Decoder
+}
+
+func (e *MsgpackDecoderState) Release() {
+	e.reader.Reset(nil)
+	e.Decoder.ResetReader(e.reader)
+	msgpackDecoderPoolState.Put(e)
+}
+
+// MsgpackUnserializer manages a pool of reusable msgpack.Decoder instances.
+type MsgpackUnserializer[T interface{}] struct {
+}
+
+var _ StreamUnserializer[string] = &MsgpackUnserializer[string]{}
+
+// NewMsgpackUnserializer initializes and returns a new MsgpackUnserializer.
+func NewMsgpackUnserializer[T interface{}](name string) *MsgpackUnserializer[T] {
+	return &MsgpackUnserializer[T]{}
+}
+
+// Unserialize decodes serialized data
+func (u *MsgpackUnserializer[T]) Unserialize(serialized []byte, out T) error {
+	state := GetMsgpackDecoderState()
+	defer state.Release()
+	state.reader.Reset(serialized)
+	state.Decoder.ResetReader(state.reader)
+	if err := state.Decoder.Decode(out); err != nil {
+		log.Errorf("[MsgpackUnserializer.Unserialize]: msgpack decode failed: %v", err)
+		return errors.ErrDecryptDecodingMsgpackSerializationFailed
+	}
+	return nil
+}
+
+// Format returns FormatMsgpack
+// UnserializeFrom decodes data while it is read from r
+func (u *MsgpackUnserializer[T]) UnserializeFrom(r io.Reader, out T) error {
+	decoder := msgpack.NewDecoder(r)
+	decoder.SetCustomStructTag("json")
+	if err := decoder.Decode(out); err != nil {
+		log.Errorf("[MsgpackUnserializer.UnserializeFrom]: msgpack decode failed: %v", err)
+		return errors.ErrDecryptDecodingMsgpackStreamFailed
+	}
+	return nil
+}
+
+func (u *MsgpackUnserializer[T]) Format() Format {
+	return FormatMsgpack
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"math/bits"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+// Padding decides how much the plaintext is padded before encryption, so the
+// length of the encrypted data does not tell the exact length of the data.
+//
+// The padding is a 0x80 byte followed by zero bytes, so it can be removed
+// without knowing the policy which was used.
+type Padding interface {
+
+	// PaddedSize returns the size of the plaintext after padding. The size
+	// includes at least one byte for the padding.
+	PaddedSize(size int) int
+}
+
+// PowerOfTwoPadding pads to the next power of two, but at least to the
+// minimum size
+type PowerOfTwoPadding struct {
+	minimum int
+}
+
+var _ Padding = &PowerOfTwoPadding{}
+
+// NewPowerOfTwoPadding creates a padding to the next power of two
+func NewPowerOfTwoPadding(minimum int) *PowerOfTwoPadding {
+	return &PowerOfTwoPadding{minimum: minimum}
+}
+
+func (p *PowerOfTwoPadding) PaddedSize(size int) int {
+	if size < p.minimum {
+		size = p.minimum
+	}
+	if size <= 1 {
+		return 1
+	}
+	return 1 << bits.Len(uint(size-1))
+}
+
+// BucketPadding pads to the smallest bucket which fits the data. Data larger
+// than the largest bucket is padded to a multiple of the largest bucket.
+type BucketPadding struct {
+	buckets []int
+}
+
+var _ Padding = &BucketPadding{}
+
+// NewBucketPadding creates a padding to the configured bucket sizes
+func NewBucketPadding(buckets ...int) (*BucketPadding, error) {
+	if len(buckets) == 0 {
+		return nil, errors.ErrPaddingNoBuckets
+	}
+	sorted := slices.Clone(buckets)
+	slices.Sort(sorted)
+	if sorted[0] <= 0 {
+		return nil, errors.ErrPaddingInvalidBucket
+	}
+	return &BucketPadding{buckets: slices.Compact(sorted)}, nil
+}
+
+func (p *BucketPadding) PaddedSize(size int) int {
+	for _, bucket := range p.buckets {
+		if size <= bucket {
+			return bucket
+		}
+	}
+	largest := p.buckets[len(p.buckets)-1]
+	return (size + largest - 1) / largest * largest
+}
+
+// RandomPadding pads with a random amount of bytes up to the maximum
+type RandomPadding struct {
+	maximum int
+}
+
+var _ Padding = &RandomPadding{}
+
+// NewRandomPadding creates a padding of random size
+func NewRandomPadding(maximum int) *RandomPadding {
+	return &RandomPadding{maximum: maximum}
+}
+
+func (p *RandomPadding) PaddedSize(size int) int {
+	if p.maximum <= 0 {
+		return size
+	}
+	return size + rand.IntN(p.maximum+1)
+}
+
+// ParsePadding parses a padding policy:
+//   - "none" or empty for no padding
+//   - "pow2" or "pow2:minimum" for PowerOfTwoPadding
+//   - "buckets:size,size,..." for BucketPadding
+//   - "random:maximum" for RandomPadding
+//
+// It returns nil for no padding.
+func ParsePadding(policy string) (Padding, error) {
+	name, arg, _ := strings.Cut(policy, ":")
+	switch name {
+	case "", "none":
+		return nil, nil
+	case "pow2":
+		minimum := 0
+		if arg != "" {
+			var err error
+			if minimum, err = strconv.Atoi(arg); err != nil {
+				return nil, errors.ErrPaddingInvalidMinimum
+			}
+		}
+		return NewPowerOfTwoPadding(minimum), nil
+	case "buckets":
+		var buckets []int
+		for _, item := range strings.Split(arg, ",") {
+			bucket, err := strconv.Atoi(strings.TrimSpace(item))
+			if err != nil {
+				return nil, errors.ErrPaddingInvalidBucketSize
+			}
+			buckets = append(buckets, bucket)
+		}
+		return NewBucketPadding(buckets...)
+	case "random":
+		maximum, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, errors.ErrPaddingInvalidMaximum
+		}
+		return NewRandomPadding(maximum), nil
+	default:
+		return nil, errors.ErrUnknownPadding
+	}
+}
+
+// appendPadding appends the padding marker and zeros to dst, so that the size
+// of dst becomes the padded size
+func appendPadding(dst []byte, paddedSize int) []byte {
+	dst = append(dst, 0x80)
+	for len(dst) < paddedSize {
+		dst = append(dst, 0)
+	}
+	return dst
+}
+
+// removePadding returns data without the padding
+func removePadding(data []byte) ([]byte, bool) {
+	i := len(data) - 1
+	for i >= 0 && data[i] == 0 {
+		i--
+	}
+	if i < 0 || data[i] != 0x80 {
+		return nil, false
+	}
+	return data[:i], true
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+	"github.com/hyperifyio/statelessdb/pkg/states"
+)
+
+func TestPowerOfTwoPadding(t *testing.T) {
+	padding := encodings.NewPowerOfTwoPadding(64)
+	tests := []struct{ size, expected int }{
+		{1, 64},
+		{64, 64},
+		{65, 128},
+		{1000, 1024},
+		{1024, 1024},
+	}
+	for _, tt := range tests {
+		if got := padding.PaddedSize(tt.size); got != tt.expected {
+			t.Errorf("PaddedSize(%d): expected %d, got %d", tt.size, tt.expected, got)
+		}
+	}
+}
+
+func TestBucketPadding(t *testing.T) {
+	padding, err := encodings.NewBucketPadding(1024, 256)
+	if err != nil {
+		t.Fatalf("NewBucketPadding failed: %v", err)
+	}
+	tests := []struct{ size, expected int }{
+		{1, 256},
+		{256, 256},
+		{257, 1024},
+		{1025, 2048},
+		{3000, 3072},
+	}
+	for _, tt := range tests {
+		if got := padding.PaddedSize(tt.size); got != tt.expected {
+			t.Errorf("PaddedSize(%d): expected %d, got %d", tt.size, tt.expected, got)
+		}
+	}
+
+	if _, err = encodings.NewBucketPadding(); err != errors.ErrPaddingNoBuckets {
+		t.Errorf("Expected error %v, got %v", errors.ErrPaddingNoBuckets, err)
+	}
+	if _, err = encodings.NewBucketPadding(0, 256); err != errors.ErrPaddingInvalidBucket {
+		t.Errorf("Expected error %v, got %v", errors.ErrPaddingInvalidBucket, err)
+	}
+}
+
+func TestRandomPadding(t *testing.T) {
+	padding := encodings.NewRandomPadding(16)
+	for i := 0; i < 100; i++ {
+		if got := padding.PaddedSize(100); got < 100 || got > 116 {
+			t.Fatalf("PaddedSize(100): expected 100-116, got %d", got)
+		}
+	}
+}
+
+func TestParsePadding(t *testing.T) {
+	tests := []struct {
+		policy string
+		isNil  bool
+		err    error
+	}{
+		{"", true, nil},
+		{"none", true, nil},
+		{"pow2", false, nil},
+		{"pow2:256", false, nil},
+		{"buckets:256,1024,4096", false, nil},
+		{"random:128", false, nil},
+		{"pow2:x", true, errors.ErrPaddingInvalidMinimum},
+		{"buckets:256,x", true, errors.ErrPaddingInvalidBucketSize},
+		{"random:", true, errors.ErrPaddingInvalidMaximum},
+		{"zeros", true, errors.ErrUnknownPadding},
+	}
+	for _, tt := range tests {
+		padding, err := encodings.ParsePadding(tt.policy)
+		if err != tt.err {
+			t.Errorf("ParsePadding(%q): expected error %v, got %v", tt.policy, tt.err, err)
+		}
+		if (padding == nil) != tt.isNil {
+			t.Errorf("ParsePadding(%q): unexpected padding %v", tt.policy, padding)
+		}
+	}
+}
+
+// TestEncryptor_WithPadding tests that states of different sizes have the same
+// length when padded into the same bucket, and that the padding is removed.
+func TestEncryptor_WithPadding(t *testing.T) {
+	keys := newTestKeyRing(t, encodings.SuiteAES256GCM)
+	encryptor := encodings.NewEncryptor[*states.ComputeState](encodings.NewJsonSerializer[*states.ComputeState]("ComputeState"))
+	if err := encryptor.InitializeKeyRing(keys); err != nil {
+		t.Fatalf("Failed to initialize Encryptor: %v", err)
+	}
+	decryptor := encodings.NewDecryptor[*states.ComputeState](encodings.NewJsonUnserializer[*states.ComputeState]("ComputeState"))
+	if err := decryptor.InitializeKeyRing(keys); err != nil {
+		t.Fatalf("Failed to initialize Decryptor: %v", err)
+	}
+	padding, err := encodings.NewBucketPadding(4096)
+	if err != nil {
+		t.Fatalf("NewBucketPadding failed: %v", err)
+	}
+	encryptor.WithPadding(padding)
+
+	var lengths []int
+	for _, size := range []int{0, 5, 20} {
+		data := newLargeComputeState(size)
+		ciphertext, err := encryptor.EncryptWithLifetime(data, nil, encodings.NewLifetime(1000, 0))
+		if err != nil {
+			t.Fatalf("Encryption failed: %v", err)
+		}
+		decoded, err := base64.StdEncoding.DecodeString(ciphertext)
+		if err != nil {
+			t.Fatalf("Base64 decoding failed: %v", err)
+		}
+		lengths = append(lengths, len(decoded))
+
+		decrypted := &states.ComputeState{}
+		lifetime, err := decryptor.DecryptWithLifetime(ciphertext, decrypted)
+		if err != nil {
+			t.Fatalf("Decryption failed: %v", err)
+		}
+		if lifetime.IssuedAt != 1000 {
+			t.Errorf("Expected lifetime issued at 1000, got %+v", lifetime)
+		}
+		if !decrypted.Equals(data) {
+			t.Errorf("Decrypted data does not match original.\nOriginal: %v\nDecrypted: %v", data, decrypted)
+		}
+	}
+
+	for _, length := range lengths[1:] {
+		if length != lengths[0] {
+			t.Errorf("Padded lengths should be equal: %v", lengths)
+		}
+	}
+}
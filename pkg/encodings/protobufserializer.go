@@ -0,0 +1,102 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+// protobufMarshalOptions encodes maps with sorted keys, so the same message
+// is always encoded the same way by the same binary
+var protobufMarshalOptions = proto.MarshalOptions{
+	Deterministic: true,
+}
+
+var protobufEncoderPoolState = sync.Pool{
+	New: func() interface{} {
+		return &ProtobufEncoderState{}
+	},
+}
+
+func GetProtobufEncoderState() *ProtobufEncoderState {
+	return protobufEncoderPoolState.Get().(*ProtobufEncoderState)
+}
+
+type ProtobufEncoderState struct {
+	buffer []byte
+}
+
+var _ SerializerState = &ProtobufEncoderState{}
+
+func (e *ProtobufEncoderState) Release() {
+	e.buffer = e.buffer[:0]
+	protobufEncoderPoolState.Put(e)
+}
+
+func (e *ProtobufEncoderState) Bytes() []byte {
+	return e.buffer
+}
+
+// ProtobufState is implemented by states which are not generated protobuf
+// messages, but can be converted to one, e.g. states.ComputeState
+type ProtobufState interface {
+
+	// ToProto returns the state as a protobuf message
+	ToProto() (proto.Message, error)
+
+	// NewProto returns an empty protobuf message for FromProto
+	NewProto() proto.Message
+
+	// FromProto sets the state from a message returned by NewProto
+	FromProto(message proto.Message) error
+}
+
+// ProtobufSerializer serializes generated protobuf messages, e.g.
+// statespb.ComputeState, or states implementing ProtobufState. This is
+// checked when data is serialized.
+type ProtobufSerializer[T interface{}] struct {
+}
+
+var _ Serializer[string] = &ProtobufSerializer[string]{}
+
+// NewProtobufSerializer initializes a new ProtobufSerializer.
+func NewProtobufSerializer[T interface{}](name string) *ProtobufSerializer[T] {
+	return &ProtobufSerializer[T]{}
+}
+
+// Serialize serializes the given message into a reusable buffer.
+// It returns the serialized bytes or an error.
+func (s *ProtobufSerializer[T]) Serialize(data T) (SerializerState, error) {
+	var message proto.Message
+	switch value := any(data).(type) {
+	case proto.Message:
+		message = value
+	case ProtobufState:
+		converted, err := value.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		message = converted
+	default:
+		log.Errorf("[ProtobufSerializer.Serialize]: %T is not a protobuf message", data)
+		return nil, errors.ErrProtobufSerializeNotMessage
+	}
+	state := GetProtobufEncoderState()
+	buffer, err := protobufMarshalOptions.MarshalAppend(state.buffer[:0], message)
+	if err != nil {
+		state.Release()
+		return nil, err
+	}
+	state.buffer = buffer
+	return state, nil
+}
+
+// Format returns FormatProtobuf
+func (s *ProtobufSerializer[T]) Format() Format {
+	return FormatProtobuf
+}
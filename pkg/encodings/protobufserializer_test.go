@@ -0,0 +1,134 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+	"github.com/hyperifyio/statelessdb/pkg/states/statespb"
+)
+
+func newTestProtobufState(t *testing.T) *statespb.ComputeState {
+	id, owner := uuid.New(), uuid.New()
+	private, err := structpb.NewStruct(map[string]interface{}{
+		"name":  "private",
+		"count": 3,
+	})
+	if err != nil {
+		t.Fatalf("NewStruct failed: %v", err)
+	}
+	return &statespb.ComputeState{
+		Id:      id[:],
+		Owner:   owner[:],
+		Created: 1000,
+		Updated: 2000,
+		Private: private,
+		Sealed: map[string]*statespb.SealedField{
+			"ssn": {Class: "pii", Data: "sealed"},
+		},
+	}
+}
+
+// TestProtobufSerializer_RoundTrip tests serialization of a generated message
+func TestProtobufSerializer_RoundTrip(t *testing.T) {
+	serializer := encodings.NewProtobufSerializer[*statespb.ComputeState]("ComputeState")
+	unserializer := encodings.NewProtobufUnserializer[*statespb.ComputeState]("ComputeState")
+
+	data := newTestProtobufState(t)
+	state, err := serializer.Serialize(data)
+	if err != nil {
+		t.Fatalf("Failed to serialize data: %v", err)
+	}
+	defer state.Release()
+
+	decoded := &statespb.ComputeState{}
+	if err := unserializer.Unserialize(state.Bytes(), decoded); err != nil {
+		t.Fatalf("Failed to unserialize data: %v", err)
+	}
+	if !proto.Equal(decoded, data) {
+		t.Errorf("Decoded data does not match original.\nOriginal: %v\nDecoded: %v", data, decoded)
+	}
+}
+
+// TestProtobufSerializer_Deterministic tests that maps are always encoded the
+// same way
+func TestProtobufSerializer_Deterministic(t *testing.T) {
+	serializer := encodings.NewProtobufSerializer[*statespb.ComputeState]("ComputeState")
+
+	data := newTestProtobufState(t)
+	first, err := serializer.Serialize(data)
+	if err != nil {
+		t.Fatalf("Failed to serialize data: %v", err)
+	}
+	defer first.Release()
+
+	for i := 0; i < 10; i++ {
+		state, err := serializer.Serialize(data)
+		if err != nil {
+			t.Fatalf("Failed to serialize data: %v", err)
+		}
+		if !bytes.Equal(state.Bytes(), first.Bytes()) {
+			t.Errorf("Expected the same encoding, got %x and %x", first.Bytes(), state.Bytes())
+		}
+		state.Release()
+	}
+}
+
+// TestProtobufUnserializer_UnknownFields tests that fields added by a newer
+// version of the message are accepted and kept
+func TestProtobufUnserializer_UnknownFields(t *testing.T) {
+	serializer := encodings.NewProtobufSerializer[*statespb.ComputeState]("ComputeState")
+	unserializer := encodings.NewProtobufUnserializer[*statespb.ComputeState]("ComputeState")
+
+	data := newTestProtobufState(t)
+	serialized, err := proto.Marshal(data)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	serialized = protowire.AppendTag(serialized, 100, protowire.BytesType)
+	serialized = protowire.AppendString(serialized, "added in a newer version")
+
+	decoded := &statespb.ComputeState{}
+	if err := unserializer.Unserialize(serialized, decoded); err != nil {
+		t.Fatalf("Unknown fields should be accepted: %v", err)
+	}
+	if decoded.GetCreated() != data.GetCreated() {
+		t.Errorf("Expected created %d, got %d", data.GetCreated(), decoded.GetCreated())
+	}
+
+	state, err := serializer.Serialize(decoded)
+	if err != nil {
+		t.Fatalf("Failed to serialize data: %v", err)
+	}
+	defer state.Release()
+	if !bytes.Contains(state.Bytes(), []byte("added in a newer version")) {
+		t.Errorf("Unknown fields should be kept when serialized again")
+	}
+}
+
+func TestProtobufSerializer_NotMessage(t *testing.T) {
+	serializer := encodings.NewProtobufSerializer[*SampleStruct]("SampleStruct")
+	if _, err := serializer.Serialize(&SampleStruct{}); err != errors.ErrProtobufSerializeNotMessage {
+		t.Errorf("Expected ErrProtobufSerializeNotMessage, got %v", err)
+	}
+	unserializer := encodings.NewProtobufUnserializer[*SampleStruct]("SampleStruct")
+	if err := unserializer.Unserialize([]byte{}, &SampleStruct{}); err != errors.ErrProtobufUnserializeNotMessage {
+		t.Errorf("Expected ErrProtobufUnserializeNotMessage, got %v", err)
+	}
+}
+
+func TestProtobufUnserializer_InvalidData(t *testing.T) {
+	unserializer := encodings.NewProtobufUnserializer[*statespb.ComputeState]("ComputeState")
+	if err := unserializer.Unserialize([]byte{0x0a, 0xff}, &statespb.ComputeState{}); err != errors.ErrDecryptDecodingProtobufSerializationFailed {
+		t.Errorf("Expected ErrDecryptDecodingProtobufSerializationFailed, got %v", err)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+// ProtobufUnserializer decodes generated protobuf messages, or states
+// implementing ProtobufState. Unknown fields of generated messages,
+// e.g. from a newer version of the message, are kept, so they are not lost
+// when the message is serialized again.
+type ProtobufUnserializer[T interface{}] struct {
+}
+
+var _ Unserializer[string] = &ProtobufUnserializer[string]{}
+
+// NewProtobufUnserializer initializes and returns a new ProtobufUnserializer.
+func NewProtobufUnserializer[T interface{}](name string) *ProtobufUnserializer[T] {
+	return &ProtobufUnserializer[T]{}
+}
+
+// Unserialize decodes serialized data
+func (u *ProtobufUnserializer[T]) Unserialize(serialized []byte, out T) error {
+	switch value := any(out).(type) {
+	case proto.Message:
+		return unmarshalProtobuf(serialized, value)
+	case ProtobufState:
+		message := value.NewProto()
+		if err := unmarshalProtobuf(serialized, message); err != nil {
+			return err
+		}
+		return value.FromProto(message)
+	default:
+		log.Errorf("[ProtobufUnserializer.Unserialize]: %T is not a protobuf message", out)
+		return errors.ErrProtobufUnserializeNotMessage
+	}
+}
+
+// unmarshalProtobuf decodes serialized data into the message
+func unmarshalProtobuf(serialized []byte, message proto.Message) error {
+	if err := proto.Unmarshal(serialized, message); err != nil {
+		log.Errorf("[ProtobufUnserializer.Unserialize]: protobuf decode failed: %v", err)
+		return errors.ErrDecryptDecodingProtobufSerializationFailed
+	}
+	return nil
+}
+
+// Format returns FormatProtobuf
+func (u *ProtobufUnserializer[T]) Format() Format {
+	return FormatProtobuf
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"sync"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+const publicKeyContext = "statelessdb public mac v1"
+
+// PublicSigner signs public data with HMAC-SHA256, so that the server can
+// later tell whether it produced the data. The key is derived from every key
+// of the master key ring using HKDF, and the signature records the ID of the
+// key, so signatures made with a previous key are still accepted. It is safe
+// for concurrent use.
+type PublicSigner struct {
+	mu   sync.Mutex
+	keys *KeyRing // keys contains the derived signing keys
+}
+
+// NewPublicSigner creates a signer with keys derived from the master key ring
+func NewPublicSigner(master *KeyRing) (*PublicSigner, error) {
+	s := &PublicSigner{}
+	if err := s.InitializeKeyRing(master); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// InitializeKeyRing derives the signing keys from the master key ring. It may
+// be called again to swap the keys, e.g. from KeyReloader.OnReload.
+func (s *PublicSigner) InitializeKeyRing(master *KeyRing) error {
+	if master == nil {
+		return errors.ErrPublicSignerNoKeyRing
+	}
+	keys, err := master.derive([]byte(publicKeyContext))
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+	return nil
+}
+
+// Sign returns the Base64 encoded signature of the data using the active key
+func (s *PublicSigner) Sign(data []byte) string {
+	s.mu.Lock()
+	key := s.keys.active
+	s.mu.Unlock()
+	signature := make([]byte, KeyIDSize, KeyIDSize+sha256.Size)
+	binary.BigEndian.PutUint32(signature, key.id)
+	return base64.StdEncoding.EncodeToString(appendPublicMAC(signature, key.secret, data))
+}
+
+// Verify returns true if the signature of the data was made by Sign with any
+// key of the key ring
+func (s *PublicSigner) Verify(data []byte, signature string) bool {
+	decoded, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || len(decoded) != KeyIDSize+sha256.Size {
+		return false
+	}
+	s.mu.Lock()
+	key, exists := s.keys.key(binary.BigEndian.Uint32(decoded[:KeyIDSize]))
+	s.mu.Unlock()
+	if !exists {
+		return false
+	}
+	expected := appendPublicMAC(nil, key.secret, data)
+	return hmac.Equal(expected, decoded[KeyIDSize:])
+}
+
+// appendPublicMAC appends HMAC-SHA256 of the data to dst
+func appendPublicMAC(dst, secret, data []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(data)
+	return mac.Sum(dst)
+}
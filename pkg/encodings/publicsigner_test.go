@@ -0,0 +1,71 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+)
+
+func TestPublicSigner(t *testing.T) {
+	oldKey, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	newKey, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	oldKeys, err := encodings.NewKeyRing(oldKey)
+	if err != nil {
+		t.Fatalf("NewKeyRing failed: %v", err)
+	}
+	rotatedKeys, err := encodings.NewKeyRing(newKey, oldKey)
+	if err != nil {
+		t.Fatalf("NewKeyRing failed: %v", err)
+	}
+
+	signer, err := encodings.NewPublicSigner(oldKeys)
+	if err != nil {
+		t.Fatalf("NewPublicSigner failed: %v", err)
+	}
+	data := []byte("public data")
+	signature := signer.Sign(data)
+
+	if !signer.Verify(data, signature) {
+		t.Errorf("Signature should be valid")
+	}
+	if signer.Verify([]byte("public datA"), signature) {
+		t.Errorf("Signature of changed data should be invalid")
+	}
+	if signer.Verify(data, "not base64!") || signer.Verify(data, "") {
+		t.Errorf("Malformed signature should be invalid")
+	}
+
+	if err = signer.InitializeKeyRing(rotatedKeys); err != nil {
+		t.Fatalf("InitializeKeyRing failed: %v", err)
+	}
+	if !signer.Verify(data, signature) {
+		t.Errorf("Signature with a previous key should be valid")
+	}
+	if signer.Sign(data) == signature {
+		t.Errorf("New signatures should use the active key")
+	}
+
+	otherSigner, err := encodings.NewPublicSigner(rotatedKeys)
+	if err != nil {
+		t.Fatalf("NewPublicSigner failed: %v", err)
+	}
+	newKeys, err := encodings.NewKeyRing(newKey)
+	if err != nil {
+		t.Fatalf("NewKeyRing failed: %v", err)
+	}
+	if err = otherSigner.InitializeKeyRing(newKeys); err != nil {
+		t.Fatalf("InitializeKeyRing failed: %v", err)
+	}
+	if otherSigner.Verify(data, signature) {
+		t.Errorf("Signature with a removed key should be invalid")
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings/json"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+// Upcaster migrates serialized data of one schema version to the next
+// version, e.g. by renaming a property. It must not modify serialized.
+type Upcaster func(serialized []byte) ([]byte, error)
+
+// SchemaRegistry knows the current schema version of a state type, and the
+// upcasters which migrate serialized data of older versions step by step,
+// e.g. v1 to v2 and v2 to v3. The Encryptor seals the current version with
+// the data, and UpcastingUnserializer runs the upcasters before the data is
+// unserialized. Data sealed without a version is SchemaVersionUnversioned.
+// It is safe for concurrent use.
+type SchemaRegistry struct {
+	current uint32
+
+	mu        sync.RWMutex
+	upcasters map[uint32]Upcaster // upcasters contains upcasters by the version they migrate from
+}
+
+// NewSchemaRegistry creates a registry for the current schema version
+func NewSchemaRegistry(current uint32) *SchemaRegistry {
+	return &SchemaRegistry{
+		current:   current,
+		upcasters: make(map[uint32]Upcaster),
+	}
+}
+
+// Register adds an upcaster which migrates data from the version to the next
+// version
+func (r *SchemaRegistry) Register(from uint32, upcaster Upcaster) *SchemaRegistry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.upcasters[from] = upcaster
+	return r
+}
+
+// Version returns the current schema version
+func (r *SchemaRegistry) Version() uint32 {
+	return r.current
+}
+
+// Upcast migrates serialized data of the version to the current version. Data
+// of a newer version than the current one, e.g. after a rollback, is not
+// accepted, since it could not be decoded without losing data.
+func (r *SchemaRegistry) Upcast(version uint32, serialized []byte) ([]byte, error) {
+	if version > r.current {
+		return nil, errors.ErrSchemaVersionTooNew
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for ; version < r.current; version++ {
+		upcaster, exists := r.upcasters[version]
+		if !exists {
+			log.Errorf("[SchemaRegistry.Upcast]: no upcaster from version %d", version)
+			return nil, errors.ErrSchemaUpcasterMissing
+		}
+		upcast, err := upcaster(serialized)
+		if err != nil {
+			log.Errorf("[SchemaRegistry.Upcast]: upcasting from version %d failed: %v", version, err)
+			return nil, errors.ErrSchemaUpcasterFailed
+		}
+		serialized = upcast
+	}
+	return serialized, nil
+}
+
+// JsonUpcaster creates an upcaster for JSON serialized data, which migrates
+// the decoded properties in place
+func JsonUpcaster(migrate func(properties map[string]interface{}) error) Upcaster {
+	return func(serialized []byte) ([]byte, error) {
+		var properties map[string]interface{}
+		if err := json.Unmarshal(serialized, &properties); err != nil {
+			return nil, err
+		}
+		if err := migrate(properties); err != nil {
+			return nil, err
+		}
+		return json.Marshal(properties)
+	}
+}
+
+// UpcastingUnserializer upcasts data of older schema versions with the
+// registry before passing it to another unserializer
+type UpcastingUnserializer[T interface{}] struct {
+	unserializer Unserializer[T]
+	schema       *SchemaRegistry
+}
+
+var _ VersionedUnserializer[string] = &UpcastingUnserializer[string]{}
+
+// NewUpcastingUnserializer creates an unserializer which upcasts data for the
+// unserializer
+func NewUpcastingUnserializer[T interface{}](unserializer Unserializer[T], schema *SchemaRegistry) *UpcastingUnserializer[T] {
+	return &UpcastingUnserializer[T]{unserializer: unserializer, schema: schema}
+}
+
+// Unserialize unserializes data of the current schema version
+func (u *UpcastingUnserializer[T]) Unserialize(serialized []byte, out T) error {
+	return u.unserializer.Unserialize(serialized, out)
+}
+
+// UnserializeVersion upcasts data of the version to the current version and
+// unserializes it
+func (u *UpcastingUnserializer[T]) UnserializeVersion(serialized []byte, version uint32, out T) error {
+	upcast, err := u.schema.Upcast(version, serialized)
+	if err != nil {
+		log.Errorf("[UpcastingUnserializer.UnserializeVersion]: version %d: %v", version, err)
+		return errors.ErrDecryptSchemaUpcastFailed
+	}
+	return u.unserializer.Unserialize(upcast, out)
+}
+
+// Format returns the format of the unserializer
+func (u *UpcastingUnserializer[T]) Format() Format {
+	return formatOf(u.unserializer)
+}
+
+// appendSchemaVersion appends the schema version to dst
+func appendSchemaVersion(dst []byte, version uint32) []byte {
+	return binary.BigEndian.AppendUint32(dst, version)
+}
+
+// parseSchemaVersion parses the schema version from the start of data and
+// returns the rest of the data
+func parseSchemaVersion(data []byte) (uint32, []byte, bool) {
+	if len(data) < SchemaVersionSize {
+		return 0, nil, false
+	}
+	return binary.BigEndian.Uint32(data[:SchemaVersionSize]), data[SchemaVersionSize:], true
+}
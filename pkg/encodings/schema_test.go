@@ -0,0 +1,198 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+// sampleStructV1 is SampleStruct before Name was split from Title
+type sampleStructV1 struct {
+	ID    int
+	Title string
+}
+
+// sampleStructV2 is SampleStruct before Numbers were added
+type sampleStructV2 struct {
+	ID   int
+	Name string
+}
+
+// newSampleSchema returns a registry for SampleStruct at version 3
+func newSampleSchema() *encodings.SchemaRegistry {
+	return encodings.NewSchemaRegistry(3).
+		Register(1, encodings.JsonUpcaster(func(properties map[string]interface{}) error {
+			properties["Name"] = properties["Title"]
+			delete(properties, "Title")
+			return nil
+		})).
+		Register(2, encodings.JsonUpcaster(func(properties map[string]interface{}) error {
+			properties["Numbers"] = []interface{}{1, 2, 3}
+			return nil
+		}))
+}
+
+func encryptWithSchema[T interface{}](t *testing.T, key []byte, schema *encodings.SchemaRegistry, data T) string {
+	encryptor := encodings.NewEncryptor[T](encodings.NewJsonSerializer[T]("")).WithSchema(schema)
+	if err := encryptor.Initialize(key); err != nil {
+		t.Fatalf("Failed to initialize encryptor: %v", err)
+	}
+	encrypted, err := encryptor.Encrypt(data)
+	if err != nil {
+		t.Fatalf("Encrypt failed: %v", err)
+	}
+	return encrypted
+}
+
+func TestSchemaRegistry_Upcast(t *testing.T) {
+	schema := encodings.NewSchemaRegistry(3).
+		Register(1, func(serialized []byte) ([]byte, error) { return append([]byte("v2:"), serialized...), nil }).
+		Register(2, func(serialized []byte) ([]byte, error) { return append([]byte("v3:"), serialized...), nil })
+
+	tests := []struct {
+		version  uint32
+		expected string
+		err      error
+	}{
+		{1, "v3:v2:data", nil},
+		{2, "v3:data", nil},
+		{3, "data", nil},
+		{4, "", errors.ErrSchemaVersionTooNew},
+		{0, "", errors.ErrSchemaUpcasterMissing},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("v%d", test.version), func(t *testing.T) {
+			upcast, err := schema.Upcast(test.version, []byte("data"))
+			if err != test.err {
+				t.Fatalf("Expected error %v, got %v", test.err, err)
+			}
+			if string(upcast) != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, upcast)
+			}
+		})
+	}
+}
+
+func TestSchemaRegistry_UpcasterFailed(t *testing.T) {
+	schema := encodings.NewSchemaRegistry(2).Register(1, func(serialized []byte) ([]byte, error) {
+		return nil, fmt.Errorf("broken")
+	})
+	if _, err := schema.Upcast(1, []byte("data")); err != errors.ErrSchemaUpcasterFailed {
+		t.Errorf("Expected ErrSchemaUpcasterFailed, got %v", err)
+	}
+}
+
+// TestDecryptor_UpcastsOldVersions tests that data of every older version is
+// upcast to the current version
+func TestDecryptor_UpcastsOldVersions(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	schema := newSampleSchema()
+	decryptor := encodings.NewDecryptor[*SampleStruct](encodings.NewUpcastingUnserializer[*SampleStruct](encodings.NewJsonUnserializer[*SampleStruct](""), schema))
+	if err := decryptor.Initialize(key); err != nil {
+		t.Fatalf("Failed to initialize decryptor: %v", err)
+	}
+
+	encrypted := map[string]string{
+		"unversioned": encryptWithSchema(t, key, nil, &sampleStructV1{ID: 1, Title: "Test"}),
+		"v1":          encryptWithSchema(t, key, encodings.NewSchemaRegistry(1), &sampleStructV1{ID: 1, Title: "Test"}),
+		"v2":          encryptWithSchema(t, key, encodings.NewSchemaRegistry(2), &sampleStructV2{ID: 1, Name: "Test"}),
+		"v3":          encryptWithSchema(t, key, schema, &SampleStruct{ID: 1, Name: "Test", Numbers: []int{1, 2, 3}}),
+	}
+	expected := &SampleStruct{ID: 1, Name: "Test", Numbers: []int{1, 2, 3}}
+
+	for name, data := range encrypted {
+		t.Run(name, func(t *testing.T) {
+			decoded := &SampleStruct{}
+			if err := decryptor.Decrypt(data, decoded); err != nil {
+				t.Fatalf("Decrypt failed: %v", err)
+			}
+			if !decoded.Equals(expected) {
+				t.Errorf("Expected %v, got %v", expected, decoded)
+			}
+		})
+	}
+}
+
+// TestDecryptor_UpcastsCompressedData tests that data is decompressed before
+// it is upcast
+func TestDecryptor_UpcastsCompressedData(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	encryptor := encodings.NewEncryptor[*sampleStructV1](encodings.NewCompressingSerializer[*sampleStructV1](encodings.NewJsonSerializer[*sampleStructV1](""), encodings.CompressionZstd, 0)).WithSchema(encodings.NewSchemaRegistry(1))
+	if err := encryptor.Initialize(key); err != nil {
+		t.Fatalf("Failed to initialize encryptor: %v", err)
+	}
+	encrypted, err := encryptor.Encrypt(&sampleStructV1{ID: 1, Title: "Compressed"})
+	if err != nil {
+		t.Fatalf("Encrypt failed: %v", err)
+	}
+
+	decryptor := encodings.NewDecryptor[*SampleStruct](encodings.NewCompressingUnserializer[*SampleStruct](encodings.NewUpcastingUnserializer[*SampleStruct](encodings.NewJsonUnserializer[*SampleStruct](""), newSampleSchema())))
+	if err := decryptor.Initialize(key); err != nil {
+		t.Fatalf("Failed to initialize decryptor: %v", err)
+	}
+	decoded := &SampleStruct{}
+	if err := decryptor.Decrypt(encrypted, decoded); err != nil {
+		t.Fatalf("Decrypt failed: %v", err)
+	}
+	if decoded.Name != "Compressed" || len(decoded.Numbers) != 3 {
+		t.Errorf("Expected upcast data, got %v", decoded)
+	}
+}
+
+func TestDecryptor_SchemaUpcastFailed(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	decryptor := encodings.NewDecryptor[*SampleStruct](encodings.NewUpcastingUnserializer[*SampleStruct](encodings.NewJsonUnserializer[*SampleStruct](""), encodings.NewSchemaRegistry(2)))
+	if err := decryptor.Initialize(key); err != nil {
+		t.Fatalf("Failed to initialize decryptor: %v", err)
+	}
+
+	tests := map[string]string{
+		"missing upcaster": encryptWithSchema(t, key, encodings.NewSchemaRegistry(1), &sampleStructV1{ID: 1}),
+		"newer version":    encryptWithSchema(t, key, encodings.NewSchemaRegistry(3), &SampleStruct{ID: 1}),
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := decryptor.Decrypt(data, &SampleStruct{}); err != errors.ErrDecryptSchemaUpcastFailed {
+				t.Errorf("Expected ErrDecryptSchemaUpcastFailed, got %v", err)
+			}
+		})
+	}
+}
+
+// TestDecryptor_IgnoresSchemaWithoutRegistry tests that versioned data is
+// still accepted by an unserializer which does not know about versions
+func TestDecryptor_IgnoresSchemaWithoutRegistry(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	data := &SampleStruct{ID: 1, Name: "Test"}
+	encrypted := encryptWithSchema(t, key, encodings.NewSchemaRegistry(5), data)
+
+	decoded := &SampleStruct{}
+	decryptor := encodings.NewDecryptor[*SampleStruct](encodings.NewJsonUnserializer[*SampleStruct](""))
+	if err := decryptor.Initialize(key); err != nil {
+		t.Fatalf("Failed to initialize decryptor: %v", err)
+	}
+	if err := decryptor.Decrypt(encrypted, decoded); err != nil {
+		t.Fatalf("Decrypt failed: %v", err)
+	}
+	if !decoded.Equals(data) {
+		t.Errorf("Expected %v, got %v", data, decoded)
+	}
+}
@@ -0,0 +1,339 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"bufio"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+const streamKeyContext = "statelessdb stream key v1"
+
+// Large states can be encrypted as a stream using the STREAM construction,
+// so neither side has to hold the whole ciphertext in memory:
+//
+//	magic (1 byte, 0xDB) | version (1 byte, 5) | suite (1 byte) | format (1 byte) | flags (1 byte) | chunk size (1 byte, log2) | key ID (4 bytes, big endian) | salt (32 bytes) | chunk | chunk | ...
+//
+// Every stream is encrypted with its own key, derived from the key in the key
+// ring and the random salt using HKDF-SHA256. The plaintext is split into
+// chunks of the chunk size, and each chunk is sealed separately with the
+// nonce:
+//
+//	zeros | counter (4 bytes, big endian) | last (1 byte, 1 for the last chunk)
+//
+// The counter stops chunks from being reordered and the last flag stops the
+// stream from being truncated. The header and the additional data from the
+// caller are authenticated with every chunk. Only the last chunk may be
+// shorter than the chunk size, and it may be empty. The flags and the
+// plaintext sections are the same as in envelopes, except that streams are
+// never padded.
+
+// streamHeader is the header of a stream
+type streamHeader struct {
+	envelope  Envelope // envelope contains the fields of the header
+	chunkSize int      // chunkSize is the size of plaintext chunks
+	salt      []byte   // salt is used to derive the key of the stream
+}
+
+// appendStreamHeader appends a stream header to dst
+func appendStreamHeader(dst []byte, suite Suite, format Format, flags byte, chunkSizeLog2 byte, keyID uint32, salt []byte) []byte {
+	dst = append(dst, EnvelopeMagic, StreamVersion1, byte(suite), byte(format), flags, chunkSizeLog2)
+	dst = binary.BigEndian.AppendUint32(dst, keyID)
+	return append(dst, salt...)
+}
+
+// parseStreamHeader parses the header of a stream
+func parseStreamHeader(header []byte) (streamHeader, error) {
+	var h streamHeader
+	if header[0] != EnvelopeMagic || header[1] != StreamVersion1 {
+		return h, errors.ErrStreamUnknownVersion
+	}
+	h.envelope = Envelope{
+		Version: StreamVersion1,
+		Suite:   Suite(header[2]),
+		Format:  Format(header[3]),
+		Flags:   header[4],
+		KeyID:   binary.BigEndian.Uint32(header[6:10]),
+		Header:  header,
+	}
+	if h.envelope.Flags&^StreamKnownFlags != 0 {
+		return h, errors.ErrStreamUnknownFlags
+	}
+	if !h.envelope.Suite.IsValid() {
+		return h, errors.ErrStreamUnsupportedSuite
+	}
+	if header[5] < StreamMinChunkSizeLog2 || header[5] > StreamMaxChunkSizeLog2 {
+		return h, errors.ErrStreamInvalidChunkSize
+	}
+	h.chunkSize = 1 << header[5]
+	h.salt = header[10:StreamHeaderSize]
+	return h, nil
+}
+
+// newStreamAEAD creates the cipher of a stream from the secret of a key and
+// the salt of the stream
+func newStreamAEAD(suite Suite, secret, salt []byte) (cipher.AEAD, error) {
+	if suite.choosesNonce() {
+		log.Errorf("[newStreamAEAD]: %s cannot be used with streams", suite)
+		return nil, errors.ErrStreamUnsupportedSuite
+	}
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, []byte(streamKeyContext)), key); err != nil {
+		log.Errorf("[newStreamAEAD]: HKDF: %v", err)
+		return nil, errors.ErrStreamKeyDerivationFailed
+	}
+	return suite.newAEAD(key)
+}
+
+// streamSuite returns the suite used for streams when the key ring uses the
+// suite. The nonce of every chunk is made of its counter, so a suite whose
+// cipher chooses its own nonce is replaced with AES-256-GCM. Nonces do not
+// repeat anyway, since every stream has its own key.
+func streamSuite(suite Suite) Suite {
+	if suite.choosesNonce() {
+		return SuiteAES256GCM
+	}
+	return suite
+}
+
+// streamNonce sets the counter and the last flag of the nonce of a chunk
+func streamNonce(nonce []byte, counter uint32, last bool) []byte {
+	size := len(nonce)
+	binary.BigEndian.PutUint32(nonce[size-5:size-1], counter)
+	nonce[size-1] = 0
+	if last {
+		nonce[size-1] = 1
+	}
+	return nonce
+}
+
+// StreamWriter encrypts everything written to it as a stream. Close must be
+// called to seal the last chunk. It is not safe for concurrent use.
+type StreamWriter struct {
+	w              io.Writer
+	aead           cipher.AEAD
+	additionalData []byte // additionalData is the header followed by the additional data from the caller
+	nonce          []byte
+	counter        uint32
+	buf            []byte // buf contains the plaintext of the current chunk, and has room for the tag
+	chunkSize      int
+	err            error // err is the first error, which is returned by every later call
+}
+
+// NewStreamWriter writes the header of a stream to w, and returns a writer
+// which encrypts the plaintext with the active key of the key ring. The same
+// additional data must be given to NewStreamReader.
+func NewStreamWriter(w io.Writer, keys *KeyRing, format Format, flags byte, additionalData []byte) (*StreamWriter, error) {
+	if flags&^StreamKnownFlags != 0 {
+		return nil, errors.ErrStreamWriterUnsupportedFlags
+	}
+	salt := make([]byte, StreamSaltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		log.Errorf("[NewStreamWriter]: Salt generation failed: %v", err)
+		return nil, errors.ErrStreamWriterSaltFailed
+	}
+	key := keys.active
+	suite := streamSuite(keys.suite)
+	aead, err := newStreamAEAD(suite, key.secret, salt)
+	if err != nil {
+		return nil, err
+	}
+	header := appendStreamHeader(make([]byte, 0, StreamHeaderSize+len(additionalData)), suite, format, flags, StreamDefaultChunkSizeLog2, key.id, salt)
+	if _, err := w.Write(header); err != nil {
+		log.Errorf("[NewStreamWriter]: Writing header failed: %v", err)
+		return nil, errors.ErrStreamWriterHeaderFailed
+	}
+	chunkSize := 1 << StreamDefaultChunkSizeLog2
+	return &StreamWriter{
+		w:              w,
+		aead:           aead,
+		additionalData: append(header, additionalData...),
+		nonce:          make([]byte, aead.NonceSize()),
+		buf:            make([]byte, 0, chunkSize+aead.Overhead()),
+		chunkSize:      chunkSize,
+	}, nil
+}
+
+// Write encrypts p. A chunk is sealed only when it is full and more data
+// follows, since the last chunk must be sealed by Close.
+func (s *StreamWriter) Write(p []byte) (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+	written := 0
+	for len(p) > 0 {
+		if len(s.buf) == s.chunkSize {
+			if err := s.sealChunk(false); err != nil {
+				return written, err
+			}
+		}
+		n := min(s.chunkSize-len(s.buf), len(p))
+		s.buf = append(s.buf, p[:n]...)
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+// Close seals the last chunk. It does not close the underlying writer.
+func (s *StreamWriter) Close() error {
+	if s.err != nil {
+		return s.err
+	}
+	if err := s.sealChunk(true); err != nil {
+		return err
+	}
+	s.err = errors.ErrStreamWriterClosed
+	return nil
+}
+
+// sealChunk seals and writes the buffered chunk
+func (s *StreamWriter) sealChunk(last bool) error {
+	if s.counter == StreamMaxChunks {
+		s.err = errors.ErrStreamWriterTooLong
+		return s.err
+	}
+	sealed := s.aead.Seal(s.buf[:0], streamNonce(s.nonce, s.counter, last), s.buf, s.additionalData)
+	if _, err := s.w.Write(sealed); err != nil {
+		log.Errorf("[StreamWriter.sealChunk]: Writing chunk %d failed: %v", s.counter, err)
+		s.err = errors.ErrStreamWriterWriteFailed
+		return s.err
+	}
+	s.counter++
+	s.buf = s.buf[:0]
+	return nil
+}
+
+// StreamReader decrypts a stream written by StreamWriter. Plaintext is only
+// returned after its chunk has been authenticated, and io.EOF only after the
+// last chunk. It is not safe for concurrent use.
+type StreamReader struct {
+	r              *bufio.Reader
+	header         streamHeader
+	aead           cipher.AEAD
+	additionalData []byte // additionalData is the header followed by the accepted additional data
+	candidates     [][]byte
+	nonce          []byte
+	counter        uint32
+	chunk          []byte // chunk contains the ciphertext of the current chunk
+	plaintext      []byte // plaintext contains the unread plaintext of the current chunk
+	done           bool   // done is true after the last chunk
+	err            error  // err is the first error, which is returned by every later call
+}
+
+// NewStreamReader reads the header of a stream from r, and returns a reader
+// which decrypts it with the key of the key ring recorded in the header. The
+// stream must have been written with one of the candidates as the additional
+// data.
+func NewStreamReader(r io.Reader, keys *KeyRing, candidates ...[]byte) (*StreamReader, error) {
+	buffered := bufio.NewReader(r)
+	data := make([]byte, StreamHeaderSize)
+	if _, err := io.ReadFull(buffered, data); err != nil {
+		log.Errorf("[NewStreamReader]: Reading header failed: %v", err)
+		return nil, errors.ErrStreamTooShort
+	}
+	header, err := parseStreamHeader(data)
+	if err != nil {
+		return nil, err
+	}
+	key, exists := keys.key(header.envelope.KeyID)
+	if !exists {
+		log.Errorf("[NewStreamReader]: unknown key %08x", header.envelope.KeyID)
+		return nil, errors.ErrStreamUnknownKey
+	}
+	aead, err := newStreamAEAD(header.envelope.Suite, key.secret, header.salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) == 0 {
+		candidates = [][]byte{nil}
+	}
+	return &StreamReader{
+		r:          buffered,
+		header:     header,
+		aead:       aead,
+		candidates: candidates,
+		nonce:      make([]byte, aead.NonceSize()),
+		chunk:      make([]byte, header.chunkSize+aead.Overhead()),
+	}, nil
+}
+
+// Envelope returns the fields of the stream header
+func (s *StreamReader) Envelope() Envelope {
+	return s.header.envelope
+}
+
+// Read decrypts the next chunk when the plaintext of the current chunk has
+// been read
+func (s *StreamReader) Read(p []byte) (int, error) {
+	for len(s.plaintext) == 0 {
+		if s.err != nil {
+			return 0, s.err
+		}
+		if s.done {
+			return 0, io.EOF
+		}
+		s.err = s.openChunk()
+	}
+	n := copy(p, s.plaintext)
+	s.plaintext = s.plaintext[n:]
+	return n, nil
+}
+
+// openChunk reads and authenticates the next chunk. A chunk is the last one if
+// it is shorter than a full chunk or if the stream ends after it.
+func (s *StreamReader) openChunk() error {
+	n, err := io.ReadFull(s.r, s.chunk)
+	last := false
+	switch err {
+	case nil:
+		if _, err := s.r.Peek(1); err == io.EOF {
+			last = true
+		}
+	case io.EOF, io.ErrUnexpectedEOF:
+		last = true
+	default:
+		log.Errorf("[StreamReader.openChunk]: Reading chunk %d failed: %v", s.counter, err)
+		return errors.ErrStreamReadFailed
+	}
+	if s.counter == StreamMaxChunks {
+		return errors.ErrStreamReaderTooLong
+	}
+	nonce := streamNonce(s.nonce, s.counter, last)
+	if s.additionalData == nil {
+		// The additional data is selected by the first chunk. Failed attempts
+		// clear the output, so the chunk is not decrypted in place.
+		for _, candidate := range s.candidates {
+			additionalData := append(s.header.envelope.Header[:StreamHeaderSize:StreamHeaderSize], candidate...)
+			if plaintext, err := s.aead.Open(nil, nonce, s.chunk[:n], additionalData); err == nil {
+				s.additionalData = additionalData
+				return s.opened(plaintext, last)
+			}
+		}
+		log.Errorf("[StreamReader.openChunk]: first chunk failed authentication")
+		return errors.ErrStreamFirstChunkFailed
+	}
+	plaintext, err := s.aead.Open(s.chunk[:0], nonce, s.chunk[:n], s.additionalData)
+	if err != nil {
+		log.Errorf("[StreamReader.openChunk]: chunk %d failed authentication, last %v", s.counter, last)
+		return errors.ErrStreamAuthenticationFailed
+	}
+	return s.opened(plaintext, last)
+}
+
+// opened makes the plaintext of an authenticated chunk readable
+func (s *StreamReader) opened(plaintext []byte, last bool) error {
+	s.plaintext = plaintext
+	s.done = last
+	s.counter++
+	return nil
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+const streamChunkSize = 1 << encodings.StreamDefaultChunkSizeLog2
+
+// writeStream encrypts the plaintext as a stream, writing it in small pieces
+func writeStream(t *testing.T, keys *encodings.KeyRing, plaintext, additionalData []byte) []byte {
+	var buf bytes.Buffer
+	stream, err := encodings.NewStreamWriter(&buf, keys, encodings.FormatJson, 0, additionalData)
+	if err != nil {
+		t.Fatalf("NewStreamWriter failed: %v", err)
+	}
+	for data := plaintext; len(data) > 0; {
+		n := min(len(data), 1000)
+		if _, err := stream.Write(data[:n]); err != nil {
+			t.Fatalf("Write failed: %v", err)
+		}
+		data = data[n:]
+	}
+	if err := stream.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func readStream(keys *encodings.KeyRing, data []byte, candidates ...[]byte) ([]byte, error) {
+	stream, err := encodings.NewStreamReader(bytes.NewReader(data), keys, candidates...)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(stream)
+}
+
+func TestStream_RoundTrip(t *testing.T) {
+	sizes := []int{0, 1, streamChunkSize - 1, streamChunkSize, streamChunkSize + 1, 3*streamChunkSize + 7}
+	for _, suite := range encodings.Suites() {
+		keys := newTestKeyRing(t, suite)
+		for _, size := range sizes {
+			t.Run(fmt.Sprintf("%s/%d", suite, size), func(t *testing.T) {
+				plaintext := make([]byte, size)
+				if _, err := rand.Read(plaintext); err != nil {
+					t.Fatalf("Failed to generate plaintext: %v", err)
+				}
+				data := writeStream(t, keys, plaintext, []byte("purpose"))
+
+				chunks := max(1, (size+streamChunkSize-1)/streamChunkSize)
+				if expected := encodings.StreamHeaderSize + size + chunks*16; len(data) != expected {
+					t.Errorf("Expected %d bytes, got %d", expected, len(data))
+				}
+
+				decrypted, err := readStream(keys, data, []byte("other"), []byte("purpose"))
+				if err != nil {
+					t.Fatalf("Reading stream failed: %v", err)
+				}
+				if !bytes.Equal(decrypted, plaintext) {
+					t.Errorf("Decrypted plaintext does not match")
+				}
+			})
+		}
+	}
+}
+
+// TestStream_Tampered tests that truncated, reordered and changed streams are
+// rejected
+func TestStream_Tampered(t *testing.T) {
+	keys := newTestKeyRing(t, encodings.DefaultSuite)
+	plaintext := bytes.Repeat([]byte("0123456789"), 3*streamChunkSize/10+10)
+	data := writeStream(t, keys, plaintext, nil)
+	sealedChunk := streamChunkSize + 16
+	chunk := func(i int) []byte {
+		start := encodings.StreamHeaderSize + i*sealedChunk
+		return data[start:min(start+sealedChunk, len(data))]
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{"truncated header", data[:encodings.StreamHeaderSize-1], errors.ErrStreamTooShort},
+		{"without chunks", data[:encodings.StreamHeaderSize], errors.ErrStreamFirstChunkFailed},
+		{"truncated at chunk", data[:encodings.StreamHeaderSize+2*sealedChunk], errors.ErrStreamAuthenticationFailed},
+		{"truncated inside chunk", data[:len(data)-1], errors.ErrStreamAuthenticationFailed},
+		{"trailing data", append(bytes.Clone(data), 0), errors.ErrStreamAuthenticationFailed},
+		{"reordered", slicesConcat(data[:encodings.StreamHeaderSize], chunk(1), chunk(0), chunk(2), chunk(3)), errors.ErrStreamFirstChunkFailed},
+		{"changed suite", slicesConcat([]byte{0xDB, encodings.StreamVersion1, byte(encodings.SuiteChaCha20Poly1305)}, data[3:]), errors.ErrStreamFirstChunkFailed},
+		{"changed flags", slicesConcat(data[:4], []byte{encodings.EnvelopeFlagLifetime}, data[5:]), errors.ErrStreamFirstChunkFailed},
+		{"unknown flags", slicesConcat(data[:4], []byte{0x80}, data[5:]), errors.ErrStreamUnknownFlags},
+		{"invalid chunk size", slicesConcat(data[:5], []byte{40}, data[6:]), errors.ErrStreamInvalidChunkSize},
+		{"too large chunk size", slicesConcat(data[:5], []byte{encodings.StreamMaxChunkSizeLog2 + 1}, data[6:]), errors.ErrStreamInvalidChunkSize},
+		{"envelope", slicesConcat([]byte{0xDB, encodings.EnvelopeVersion4}, data[2:]), errors.ErrStreamUnknownVersion},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := readStream(keys, test.data); err != test.err {
+				t.Errorf("Expected %v, got %v", test.err, err)
+			}
+		})
+	}
+
+	if _, err := readStream(keys, data, []byte("purpose")); err != errors.ErrStreamFirstChunkFailed {
+		t.Errorf("Expected ErrStreamFirstChunkFailed with other additional data, got %v", err)
+	}
+	if _, err := readStream(newTestKeyRing(t, encodings.DefaultSuite), data); err != errors.ErrStreamUnknownKey {
+		t.Errorf("Expected ErrStreamUnknownKey, got %v", err)
+	}
+}
+
+func TestStreamWriter_Closed(t *testing.T) {
+	stream, err := encodings.NewStreamWriter(io.Discard, newTestKeyRing(t, encodings.DefaultSuite), encodings.FormatJson, 0, nil)
+	if err != nil {
+		t.Fatalf("NewStreamWriter failed: %v", err)
+	}
+	if err := stream.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if _, err := stream.Write([]byte("data")); err != errors.ErrStreamWriterClosed {
+		t.Errorf("Expected ErrStreamWriterClosed, got %v", err)
+	}
+	if _, err := encodings.NewStreamWriter(io.Discard, newTestKeyRing(t, encodings.DefaultSuite), encodings.FormatJson, encodings.EnvelopeFlagPadding, nil); err != errors.ErrStreamWriterUnsupportedFlags {
+		t.Errorf("Expected ErrStreamWriterUnsupportedFlags, got %v", err)
+	}
+}
+
+// TestEncryptor_EncryptStream tests streams of states in every format, with
+// and without compression, which is decoded from the whole plaintext
+func TestEncryptor_EncryptStream(t *testing.T) {
+	keys := newTestKeyRing(t, encodings.DefaultSuite)
+	data := &SampleStruct{
+		ID:      1,
+		Name:    strings.Repeat("Large ", 50000),
+		Numbers: []int{1, 2, 3},
+		Details: map[string]string{"key": "value"},
+	}
+	lifetime := encodings.NewLifetime(1000, 5000)
+
+	for _, format := range structFormats() {
+		for _, compression := range []encodings.Compression{encodings.CompressionNone, encodings.CompressionZstd} {
+			t.Run(fmt.Sprintf("%s/%s", format, compression), func(t *testing.T) {
+				serializer, unserializer := newFormatSerializers[*SampleStruct](t, format, "SampleStruct")
+				if compression != encodings.CompressionNone {
+					serializer = encodings.NewCompressingSerializer[*SampleStruct](serializer, compression, encodings.DefaultCompressionThreshold)
+					unserializer = encodings.NewCompressingUnserializer[*SampleStruct](unserializer)
+				}
+				encryptor := encodings.NewEncryptor[*SampleStruct](serializer)
+				if err := encryptor.InitializeKeyRing(keys); err != nil {
+					t.Fatalf("Failed to initialize encryptor: %v", err)
+				}
+				decryptor := encodings.NewDecryptor[*SampleStruct](unserializer)
+				if err := decryptor.InitializeKeyRing(keys); err != nil {
+					t.Fatalf("Failed to initialize decryptor: %v", err)
+				}
+
+				var buf bytes.Buffer
+				if err := encryptor.EncryptStream(&buf, data, []byte("purpose"), lifetime); err != nil {
+					t.Fatalf("EncryptStream failed: %v", err)
+				}
+				decoded := &SampleStruct{}
+				decodedLifetime, err := decryptor.DecryptStream(&buf, decoded, []byte("purpose"))
+				if err != nil {
+					t.Fatalf("DecryptStream failed: %v", err)
+				}
+				if decodedLifetime != lifetime {
+					t.Errorf("Expected lifetime %v, got %v", lifetime, decodedLifetime)
+				}
+				if !decoded.Equals(data) {
+					t.Errorf("Decoded data does not match")
+				}
+			})
+		}
+	}
+}
+
+func TestDecryptor_DecryptStreamTruncated(t *testing.T) {
+	keys := newTestKeyRing(t, encodings.DefaultSuite)
+	encryptor := encodings.NewEncryptor[*SampleStruct](encodings.NewJsonSerializer[*SampleStruct]("SampleStruct"))
+	if err := encryptor.InitializeKeyRing(keys); err != nil {
+		t.Fatalf("Failed to initialize encryptor: %v", err)
+	}
+	decryptor := encodings.NewDecryptor[*SampleStruct](encodings.NewJsonUnserializer[*SampleStruct]("SampleStruct"))
+	if err := decryptor.InitializeKeyRing(keys); err != nil {
+		t.Fatalf("Failed to initialize decryptor: %v", err)
+	}
+
+	// The JSON value ends in the first chunk, and the other chunks only have
+	// spaces, which the decoder may not need
+	var buf bytes.Buffer
+	data := &SampleStruct{ID: 1, Name: "Truncated"}
+	stream, err := encodings.NewStreamWriter(&buf, keys, encodings.FormatJson, 0, nil)
+	if err != nil {
+		t.Fatalf("NewStreamWriter failed: %v", err)
+	}
+	if _, err := stream.Write([]byte(`{"id":1,"name":"Truncated"}`)); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if _, err := stream.Write(bytes.Repeat([]byte(" "), 2*streamChunkSize)); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if err := stream.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	truncated := buf.Bytes()[:encodings.StreamHeaderSize+streamChunkSize+16]
+
+	decoded := &SampleStruct{}
+	if _, err := decryptor.DecryptStream(bytes.NewReader(buf.Bytes()), decoded); err != nil || decoded.Name != data.Name {
+		t.Fatalf("DecryptStream failed: %v", err)
+	}
+	if _, err := decryptor.DecryptStream(bytes.NewReader(truncated), &SampleStruct{}); err != errors.ErrDecryptStreamTrailingFailed && err != errors.ErrDecryptStreamDecodingFailed {
+		t.Errorf("Expected the truncated stream to be rejected, got %v", err)
+	}
+}
+
+func slicesConcat(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+
+	"golang.org/x/crypto/chacha20poly1305"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+// Suite is the AEAD cipher suite which is recorded in the envelope
+type Suite uint8
+
+const (
+	SuiteAES256GCM         Suite = 1 // SuiteAES256GCM is AES-256-GCM with 96-bit random nonces
+	SuiteChaCha20Poly1305  Suite = 2 // SuiteChaCha20Poly1305 is ChaCha20-Poly1305 with 96-bit random nonces
+	SuiteXChaCha20Poly1305 Suite = 3 // SuiteXChaCha20Poly1305 is XChaCha20-Poly1305 with 192-bit random nonces
+	SuiteAES256GCMSIV      Suite = 4 // SuiteAES256GCMSIV is nonce misuse resistant AES-256-GCM-SIV with 96-bit random nonces
+	DefaultSuite                 = SuiteAES256GCM
+)
+
+// suiteCount is the size of tables indexed by a suite
+const suiteCount = int(SuiteAES256GCMSIV) + 1
+
+// suiteKeyContext separates keys derived for different suites
+const suiteKeyContext = "statelessdb suite key v1 "
+
+// Suites returns all supported cipher suites
+func Suites() []Suite {
+	return []Suite{
+		SuiteAES256GCM,
+		SuiteChaCha20Poly1305,
+		SuiteXChaCha20Poly1305,
+		SuiteAES256GCMSIV,
+	}
+}
+
+// ParseSuite returns the suite by its name
+func ParseSuite(name string) (Suite, error) {
+	for _, suite := range Suites() {
+		if suite.String() == name {
+			return suite, nil
+		}
+	}
+	return 0, errors.ErrUnknownSuite
+}
+
+// IsValid returns true if the suite is supported
+func (s Suite) IsValid() bool {
+	return s >= SuiteAES256GCM && s <= SuiteAES256GCMSIV
+}
+
+// choosesNonce returns true if the cipher of the suite chooses a random nonce
+// when sealing. Such a suite cannot be used for deterministic encryption or
+// streams, which need to give the nonce.
+func (s Suite) choosesNonce() bool {
+	return s == SuiteAES256GCMSIV
+}
+
+func (s Suite) String() string {
+	switch s {
+	case SuiteAES256GCM:
+		return "aes-256-gcm"
+	case SuiteChaCha20Poly1305:
+		return "chacha20-poly1305"
+	case SuiteXChaCha20Poly1305:
+		return "xchacha20-poly1305"
+	case SuiteAES256GCMSIV:
+		return "aes-256-gcm-siv"
+	default:
+		return "unknown"
+	}
+}
+
+// NonceSize returns the size of the nonce of the suite in bytes, or zero if
+// the suite is not supported
+func (s Suite) NonceSize() int {
+	switch s {
+	case SuiteAES256GCM, SuiteChaCha20Poly1305, SuiteAES256GCMSIV:
+		return 12
+	case SuiteXChaCha20Poly1305:
+		return chacha20poly1305.NonceSizeX
+	default:
+		return 0
+	}
+}
+
+// newAEAD creates the cipher of the suite for a key. AES-256-GCM uses the key
+// as it is, since it was the only suite before suites were recorded in the
+// envelope. Other suites use a key derived from it, so the same key is never
+// used directly with two different algorithms.
+func (s Suite) newAEAD(key []byte) (cipher.AEAD, error) {
+	switch s {
+	case SuiteAES256GCM:
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		return cipher.NewGCM(block)
+	case SuiteChaCha20Poly1305:
+		return chacha20poly1305.New(s.deriveKey(key))
+	case SuiteXChaCha20Poly1305:
+		return chacha20poly1305.NewX(s.deriveKey(key))
+	case SuiteAES256GCMSIV:
+		return newGCMSIV(s.deriveKey(key))
+	default:
+		return nil, errors.ErrUnsupportedSuite
+	}
+}
+
+// deriveKey derives a 256-bit key for the suite
+func (s Suite) deriveKey(key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(suiteKeyContext))
+	mac.Write([]byte(s.String()))
+	return mac.Sum(nil)
+}
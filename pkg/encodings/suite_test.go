@@ -0,0 +1,126 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+	"github.com/hyperifyio/statelessdb/pkg/states"
+)
+
+func TestParseSuite(t *testing.T) {
+	for _, suite := range encodings.Suites() {
+		parsed, err := encodings.ParseSuite(suite.String())
+		if err != nil {
+			t.Fatalf("ParseSuite(%q) failed: %v", suite.String(), err)
+		}
+		if parsed != suite {
+			t.Errorf("Expected suite %v, got %v", suite, parsed)
+		}
+	}
+	if _, err := encodings.ParseSuite("rot13"); err != errors.ErrUnknownSuite {
+		t.Errorf("Expected error %v, got %v", errors.ErrUnknownSuite, err)
+	}
+}
+
+func TestNewKeyRingWithSuite_Unsupported(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	if _, err = encodings.NewKeyRingWithSuite(encodings.Suite(0), key); err != errors.ErrKeyRingUnsupportedSuite {
+		t.Errorf("Expected error %v, got %v", errors.ErrKeyRingUnsupportedSuite, err)
+	}
+}
+
+// TestSuites_EncryptDecrypt tests that data encrypted with any suite can be
+// decrypted, even if the decryptor would encrypt with another suite.
+func TestSuites_EncryptDecrypt(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	serializer := encodings.NewJsonSerializer[*states.ComputeState]("ComputeState")
+	unserializer := encodings.NewJsonUnserializer[*states.ComputeState]("ComputeState")
+
+	decryptor := encodings.NewDecryptor[*states.ComputeState](unserializer)
+	if err = decryptor.Initialize(key); err != nil {
+		t.Fatalf("Failed to initialize Decryptor: %v", err)
+	}
+
+	for _, suite := range encodings.Suites() {
+		t.Run(suite.String(), func(t *testing.T) {
+			keys, err := encodings.NewKeyRingWithSuite(suite, key)
+			if err != nil {
+				t.Fatalf("NewKeyRingWithSuite failed: %v", err)
+			}
+			if keys.Suite() != suite {
+				t.Errorf("Expected suite %v, got %v", suite, keys.Suite())
+			}
+
+			encryptor := encodings.NewEncryptor[*states.ComputeState](serializer)
+			if err = encryptor.InitializeKeyRing(keys); err != nil {
+				t.Fatalf("Failed to initialize Encryptor: %v", err)
+			}
+
+			data := states.NewComputeState(uuid.New(), uuid.New(), 0, 0, nil, nil, nil)
+			ciphertext, err := encryptor.Encrypt(data)
+			if err != nil {
+				t.Fatalf("Encryption failed: %v", err)
+			}
+
+			decrypted := &states.ComputeState{}
+			if err = decryptor.Decrypt(ciphertext, decrypted); err != nil {
+				t.Fatalf("Decryption failed: %v", err)
+			}
+			if !decrypted.Equals(data) {
+				t.Errorf("Decrypted data does not match original.\nOriginal: %v\nDecrypted: %v", data, decrypted)
+			}
+		})
+	}
+}
+
+// TestGCMSIV_Vectors tests that AES-256-GCM-SIV opens the test vectors from
+// RFC 8452, appendix C.2. The cipher chooses its own nonce when sealing, so
+// the vectors can only be opened.
+func TestGCMSIV_Vectors(t *testing.T) {
+	tests := []struct {
+		plaintext  string
+		ciphertext string
+	}{
+		{"", "07f5f4169bbf55a8400cd47ea6fd400f"},
+		{"0100000000000000", "c2ef328e5c71c83b843122130f7364b761e0b97427e3df28"},
+	}
+
+	key, _ := hex.DecodeString("0100000000000000000000000000000000000000000000000000000000000000")
+	nonce, _ := hex.DecodeString("030000000000000000000000")
+	aead, err := encodings.NewGCMSIV(key)
+	if err != nil {
+		t.Fatalf("NewGCMSIV failed: %v", err)
+	}
+
+	for _, tt := range tests {
+		plaintext, _ := hex.DecodeString(tt.plaintext)
+		ciphertext, _ := hex.DecodeString(tt.ciphertext)
+		opened, err := aead.Open(nil, nonce, ciphertext, nil)
+		if err != nil {
+			t.Fatalf("Open(%s) failed: %v", tt.ciphertext, err)
+		}
+		if !bytes.Equal(opened, plaintext) {
+			t.Errorf("Open(%s): expected %s, got %x", tt.ciphertext, tt.plaintext, opened)
+		}
+
+		ciphertext[0] ^= 1
+		if _, err = aead.Open(nil, nonce, ciphertext, nil); err == nil {
+			t.Errorf("Open should fail for modified ciphertext")
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+const tenantKeyContext = "statelessdb tenant key v1 "
+
+// tenantEntry is a cached key ring of a tenant
+type tenantEntry struct {
+	tenant string
+	keys   *KeyRing
+}
+
+// tenantDerivation is a key ring of a tenant which is being derived. Other
+// requests for the tenant wait for it instead of deriving the keys again.
+type tenantDerivation struct {
+	done chan struct{} // done is closed when keys and err are set
+	keys *KeyRing
+	err  error
+}
+
+// TenantKeys derives a key ring for each tenant, e.g. the owner of the
+// resource, from the master key ring using HKDF. Derived key rings are cached
+// in a bounded LRU cache. Revoked tenants do not get keys anymore, so their
+// encrypted data can no longer be decrypted. It is safe for concurrent use.
+type TenantKeys struct {
+	master   *KeyRing
+	capacity int
+
+	mu      sync.Mutex
+	cache   map[string]*list.Element     // cache contains tenantEntry elements by the tenant
+	lru     *list.List                   // lru contains the cached entries, the most recently used first
+	pending map[string]*tenantDerivation // pending contains the key rings being derived by the tenant
+	revoked map[string]struct{}          // revoked contains revoked tenants
+}
+
+// NewTenantKeys creates tenant keys derived from the master key ring, which
+// caches at most capacity key rings
+func NewTenantKeys(master *KeyRing, capacity int) *TenantKeys {
+	if capacity <= 0 {
+		capacity = DefaultTenantKeyCacheSize
+	}
+	return &TenantKeys{
+		master:   master,
+		capacity: capacity,
+		cache:    make(map[string]*list.Element, capacity),
+		lru:      list.New(),
+		pending:  make(map[string]*tenantDerivation),
+		revoked:  make(map[string]struct{}),
+	}
+}
+
+// KeyRing returns the key ring of the tenant. Keys of the tenant are derived
+// from every key in the master key ring, so key rotation of the master key
+// works the same way for tenants. The keys are derived without holding the
+// lock, and concurrent requests for the same tenant share one derivation.
+func (t *TenantKeys) KeyRing(tenant []byte) (*KeyRing, error) {
+	if len(tenant) == 0 {
+		return nil, errors.ErrTenantKeysNoTenant
+	}
+	name := string(tenant)
+
+	t.mu.Lock()
+	if _, revoked := t.revoked[name]; revoked {
+		t.mu.Unlock()
+		return nil, errors.ErrTenantKeysRevoked
+	}
+	if element, exists := t.cache[name]; exists {
+		t.lru.MoveToFront(element)
+		t.mu.Unlock()
+		return element.Value.(*tenantEntry).keys, nil
+	}
+	if derivation, exists := t.pending[name]; exists {
+		t.mu.Unlock()
+		<-derivation.done
+		return derivation.keys, derivation.err
+	}
+	derivation := &tenantDerivation{done: make(chan struct{})}
+	t.pending[name] = derivation
+	master := t.master
+	t.mu.Unlock()
+
+	info := make([]byte, 0, len(tenantKeyContext)+len(tenant))
+	info = append(info, tenantKeyContext...)
+	keys, err := master.derive(append(info, tenant...))
+
+	t.mu.Lock()
+	delete(t.pending, name)
+	if _, revoked := t.revoked[name]; revoked {
+		keys, err = nil, errors.ErrTenantKeysRevoked
+	} else if err == nil && master == t.master {
+		t.publish(name, keys)
+	}
+	t.mu.Unlock()
+
+	derivation.keys, derivation.err = keys, err
+	close(derivation.done)
+	return keys, err
+}
+
+// publish adds the key ring of the tenant to the cache and drops the least
+// recently used key rings over the capacity. The lock must be held.
+func (t *TenantKeys) publish(name string, keys *KeyRing) {
+	t.cache[name] = t.lru.PushFront(&tenantEntry{tenant: name, keys: keys})
+	for t.lru.Len() > t.capacity {
+		oldest := t.lru.Back()
+		t.lru.Remove(oldest)
+		delete(t.cache, oldest.Value.(*tenantEntry).tenant)
+	}
+}
+
+// SetMaster swaps the master key ring, e.g. from KeyReloader.OnReload. Cached
+// key rings derived from the previous master key ring are dropped, and key
+// rings which are being derived from it are not cached.
+func (t *TenantKeys) SetMaster(master *KeyRing) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.master = master
+	clear(t.cache)
+	t.lru.Init()
+}
+
+// Revoke revokes tenants, so their data cannot be encrypted or decrypted
+// anymore. Since the keys are never stored, this crypto-shreds the data of
+// the tenants as long as the revocation is kept.
+func (t *TenantKeys) Revoke(tenants ...[]byte) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, tenant := range tenants {
+		name := string(tenant)
+		t.revoked[name] = struct{}{}
+		if element, exists := t.cache[name]; exists {
+			t.lru.Remove(element)
+			delete(t.cache, name)
+		}
+	}
+}
+
+// IsRevoked returns true if the tenant has been revoked
+func (t *TenantKeys) IsRevoked(tenant []byte) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, revoked := t.revoked[string(tenant)]
+	return revoked
+}
+
+// Len returns the number of cached key rings
+func (t *TenantKeys) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.lru.Len()
+}
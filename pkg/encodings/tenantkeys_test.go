@@ -0,0 +1,134 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+	"github.com/hyperifyio/statelessdb/pkg/states"
+)
+
+func TestTenantKeys_KeyRing(t *testing.T) {
+	master := newTestKeyRing(t, encodings.SuiteAES256GCM)
+	tenants := encodings.NewTenantKeys(master, 10)
+	tenant1 := uuid.New()
+	tenant2 := uuid.New()
+
+	keys1, err := tenants.KeyRing(tenant1[:])
+	if err != nil {
+		t.Fatalf("KeyRing failed: %v", err)
+	}
+	keys2, err := tenants.KeyRing(tenant2[:])
+	if err != nil {
+		t.Fatalf("KeyRing failed: %v", err)
+	}
+	if keys1.ActiveKeyID() != master.ActiveKeyID() {
+		t.Errorf("Expected derived key ID %08x, got %08x", master.ActiveKeyID(), keys1.ActiveKeyID())
+	}
+
+	cached, err := tenants.KeyRing(tenant1[:])
+	if err != nil {
+		t.Fatalf("KeyRing failed: %v", err)
+	}
+	if cached != keys1 {
+		t.Errorf("Expected the cached key ring")
+	}
+
+	encryptor := encodings.NewEncryptor[*states.ComputeState](encodings.NewJsonSerializer[*states.ComputeState]("ComputeState"))
+	if err = encryptor.InitializeKeyRing(master); err != nil {
+		t.Fatalf("Failed to initialize Encryptor: %v", err)
+	}
+	decryptor := encodings.NewDecryptor[*states.ComputeState](encodings.NewJsonUnserializer[*states.ComputeState]("ComputeState"))
+	if err = decryptor.InitializeKeyRing(master); err != nil {
+		t.Fatalf("Failed to initialize Decryptor: %v", err)
+	}
+
+	data := states.NewComputeState(uuid.New(), tenant1, 0, 0, nil, nil, nil)
+	ciphertext, err := encryptor.EncryptWithKeyRing(keys1, data, nil, encodings.Lifetime{})
+	if err != nil {
+		t.Fatalf("Encryption failed: %v", err)
+	}
+
+	decrypted := &states.ComputeState{}
+	if _, err = decryptor.DecryptWithKeyRing(keys1, ciphertext, decrypted); err != nil {
+		t.Fatalf("Decryption with the tenant keys failed: %v", err)
+	}
+	if !decrypted.Equals(data) {
+		t.Errorf("Decrypted data does not match original.\nOriginal: %v\nDecrypted: %v", data, decrypted)
+	}
+
+	if _, err = decryptor.DecryptWithKeyRing(keys2, ciphertext, &states.ComputeState{}); err == nil {
+		t.Errorf("Decryption with keys of another tenant should fail")
+	}
+	if err = decryptor.Decrypt(ciphertext, &states.ComputeState{}); err == nil {
+		t.Errorf("Decryption with the master keys should fail")
+	}
+}
+
+func TestTenantKeys_Capacity(t *testing.T) {
+	tenants := encodings.NewTenantKeys(newTestKeyRing(t, encodings.SuiteAES256GCM), 2)
+	for i := 0; i < 5; i++ {
+		tenant := uuid.New()
+		if _, err := tenants.KeyRing(tenant[:]); err != nil {
+			t.Fatalf("KeyRing failed: %v", err)
+		}
+	}
+	if tenants.Len() != 2 {
+		t.Errorf("Expected 2 cached key rings, got %d", tenants.Len())
+	}
+}
+
+// TestTenantKeys_Concurrent tests that concurrent requests for a tenant get
+// the same key ring
+func TestTenantKeys_Concurrent(t *testing.T) {
+	tenants := encodings.NewTenantKeys(newTestKeyRing(t, encodings.SuiteAES256GCM), 10)
+	tenant := uuid.New()
+
+	results := make([]*encodings.KeyRing, 20)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			keys, err := tenants.KeyRing(tenant[:])
+			if err != nil {
+				t.Errorf("KeyRing failed: %v", err)
+			}
+			results[i] = keys
+		}(i)
+	}
+	wg.Wait()
+	for i, keys := range results {
+		if keys != results[0] {
+			t.Fatalf("Request %d got another key ring", i)
+		}
+	}
+}
+
+func TestTenantKeys_Revoke(t *testing.T) {
+	tenants := encodings.NewTenantKeys(newTestKeyRing(t, encodings.SuiteAES256GCM), 10)
+	tenant := uuid.New()
+	if _, err := tenants.KeyRing(tenant[:]); err != nil {
+		t.Fatalf("KeyRing failed: %v", err)
+	}
+
+	tenants.Revoke(tenant[:])
+	if !tenants.IsRevoked(tenant[:]) {
+		t.Errorf("Tenant should be revoked")
+	}
+	if tenants.Len() != 0 {
+		t.Errorf("Revoked tenant should be removed from the cache")
+	}
+	if _, err := tenants.KeyRing(tenant[:]); err != errors.ErrTenantKeysRevoked {
+		t.Errorf("Expected error %v, got %v", errors.ErrTenantKeysRevoked, err)
+	}
+	if _, err := tenants.KeyRing(nil); err != errors.ErrTenantKeysNoTenant {
+		t.Errorf("Expected error %v, got %v", errors.ErrTenantKeysNoTenant, err)
+	}
+}
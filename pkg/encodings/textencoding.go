@@ -0,0 +1,192 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings/z3b"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+// TextEncoding is the text encoding of the encrypted envelope, e.g. the
+// private value of a resource. The encoding can be told from the text itself,
+// so values in any encoding are accepted when decrypting.
+type TextEncoding uint8
+
+const (
+	TextEncodingBase64    TextEncoding = 1 // TextEncodingBase64 is standard Base64 with padding, the default
+	TextEncodingBase64URL TextEncoding = 2 // TextEncodingBase64URL is URL-safe Base64 without padding, e.g. for cookies and query strings
+	TextEncodingZ3b       TextEncoding = 3 // TextEncodingZ3b is z3b, which never needs escaping in JSON strings
+	TextEncodingBinary    TextEncoding = 4 // TextEncodingBinary is the envelope as it is, only for binary protocols
+	TextEncodingZ3bCookie TextEncoding = 5 // TextEncodingZ3bCookie is z3b with the alphabet for cookies and URL query parameters
+	DefaultTextEncoding                = TextEncodingBase64
+)
+
+// The z3b alphabet of the text is told from the prefix character which
+// starts the text. The prefixes are not part of either Base64 alphabet, so
+// z3b can be told apart from Base64.
+const (
+	TextEncodingZ3bPrefix       = z3b.StdEncodingPrefix    // TextEncodingZ3bPrefix starts text in TextEncodingZ3b
+	TextEncodingZ3bCookiePrefix = z3b.CookieEncodingPrefix // TextEncodingZ3bCookiePrefix starts text in TextEncodingZ3bCookie
+)
+
+// TextEncodings returns all supported text encodings
+func TextEncodings() []TextEncoding {
+	return []TextEncoding{
+		TextEncodingBase64,
+		TextEncodingBase64URL,
+		TextEncodingZ3b,
+		TextEncodingBinary,
+		TextEncodingZ3bCookie,
+	}
+}
+
+// ParseTextEncoding returns the text encoding by its name
+func ParseTextEncoding(name string) (TextEncoding, error) {
+	for _, encoding := range TextEncodings() {
+		if encoding.String() == name {
+			return encoding, nil
+		}
+	}
+	return 0, errors.ErrUnknownTextEncoding
+}
+
+func (t TextEncoding) String() string {
+	switch t {
+	case TextEncodingBase64:
+		return "base64"
+	case TextEncodingBase64URL:
+		return "base64url"
+	case TextEncodingZ3b:
+		return "z3b"
+	case TextEncodingBinary:
+		return "binary"
+	case TextEncodingZ3bCookie:
+		return "z3b-cookie"
+	default:
+		return "unknown"
+	}
+}
+
+// EncodeToString encodes an envelope as text
+func (t TextEncoding) EncodeToString(data []byte) (string, error) {
+	switch t {
+	case TextEncodingBase64:
+		return base64.StdEncoding.EncodeToString(data), nil
+	case TextEncodingBase64URL:
+		return base64.RawURLEncoding.EncodeToString(data), nil
+	case TextEncodingZ3b, TextEncodingZ3bCookie:
+		encoded, err := t.AppendEncode(make([]byte, 0, t.encodedLen(len(data))), data)
+		if err != nil {
+			return "", err
+		}
+		return string(encoded), nil
+	case TextEncodingBinary:
+		return string(data), nil
+	default:
+		return "", fmt.Errorf("unknown text encoding %d", t)
+	}
+}
+
+// AppendEncode encodes an envelope as text and appends it to dst
+func (t TextEncoding) AppendEncode(dst, data []byte) ([]byte, error) {
+	switch t {
+	case TextEncodingBase64:
+		return base64.StdEncoding.AppendEncode(dst, data), nil
+	case TextEncodingBase64URL:
+		return base64.RawURLEncoding.AppendEncode(dst, data), nil
+	case TextEncodingZ3b:
+		return z3b.StdEncoding.AppendEncodePrefixed(dst, data)
+	case TextEncodingZ3bCookie:
+		return z3b.CookieEncoding.AppendEncodePrefixed(dst, data)
+	case TextEncodingBinary:
+		return append(dst, data...), nil
+	default:
+		return dst, fmt.Errorf("unknown text encoding %d", t)
+	}
+}
+
+// encodedLen returns the length of the text for an envelope of n bytes, or
+// an estimate of it
+func (t TextEncoding) encodedLen(n int) int {
+	switch t {
+	case TextEncodingBase64:
+		return base64.StdEncoding.EncodedLen(n)
+	case TextEncodingBase64URL:
+		return base64.RawURLEncoding.EncodedLen(n)
+	case TextEncodingZ3b, TextEncodingZ3bCookie:
+		return 1 + z3b.EncodedLen(n)
+	default:
+		return n
+	}
+}
+
+// DetectTextEncoding returns the text encoding of an encoded envelope. Binary
+// envelopes start with EnvelopeMagic, which is not printable, and z3b starts
+// with the prefix of its alphabet. Text in the common part of both Base64
+// alphabets decodes the same way with either, and is reported as
+// TextEncodingBase64.
+func DetectTextEncoding(text string) TextEncoding {
+	return detectTextEncoding(text)
+}
+
+// detectTextEncoding implements DetectTextEncoding for text as a string or
+// as bytes
+func detectTextEncoding[S string | []byte](text S) TextEncoding {
+	switch {
+	case len(text) > 0 && text[0] == EnvelopeMagic:
+		return TextEncodingBinary
+	case len(text) > 0 && text[0] == TextEncodingZ3bPrefix:
+		return TextEncodingZ3b
+	case len(text) > 0 && text[0] == TextEncodingZ3bCookiePrefix:
+		return TextEncodingZ3bCookie
+	case len(text)%4 != 0:
+		return TextEncodingBase64URL
+	}
+	for i := 0; i < len(text); i++ {
+		if text[i] == '-' || text[i] == '_' {
+			return TextEncodingBase64URL
+		}
+	}
+	return TextEncodingBase64
+}
+
+// decodeText decodes an envelope from text in any text encoding
+func decodeText(text string) ([]byte, TextEncoding, error) {
+	encoding := detectTextEncoding(text)
+	switch encoding {
+	case TextEncodingBase64:
+		data, err := base64.StdEncoding.DecodeString(text)
+		return data, encoding, err
+	case TextEncodingBase64URL:
+		data, err := base64.RawURLEncoding.DecodeString(text)
+		return data, encoding, err
+	case TextEncodingZ3b, TextEncodingZ3bCookie:
+		data, err := z3b.AppendDecodePrefixed(nil, []byte(text))
+		return data, encoding, err
+	default:
+		return []byte(text), encoding, nil
+	}
+}
+
+// appendDecodedText decodes an envelope from text in any text encoding, and
+// appends it to dst
+func appendDecodedText(dst, text []byte) ([]byte, TextEncoding, error) {
+	encoding := detectTextEncoding(text)
+	switch encoding {
+	case TextEncodingBase64:
+		data, err := base64.StdEncoding.AppendDecode(dst, text)
+		return data, encoding, err
+	case TextEncodingBase64URL:
+		data, err := base64.RawURLEncoding.AppendDecode(dst, text)
+		return data, encoding, err
+	case TextEncodingZ3b, TextEncodingZ3bCookie:
+		data, err := z3b.AppendDecodePrefixed(dst, text)
+		return data, encoding, err
+	default:
+		return append(dst, text...), encoding, nil
+	}
+}
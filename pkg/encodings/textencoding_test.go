@@ -0,0 +1,88 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package encodings_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+func TestParseTextEncoding(t *testing.T) {
+	for _, encoding := range encodings.TextEncodings() {
+		parsed, err := encodings.ParseTextEncoding(encoding.String())
+		if err != nil {
+			t.Fatalf("ParseTextEncoding(%q) failed: %v", encoding.String(), err)
+		}
+		if parsed != encoding {
+			t.Errorf("Expected %v, got %v", encoding, parsed)
+		}
+	}
+	if _, err := encodings.ParseTextEncoding("hex"); err != errors.ErrUnknownTextEncoding {
+		t.Errorf("Expected ErrUnknownTextEncoding, got %v", err)
+	}
+}
+
+// TestTextEncodings_RoundTrip tests that values in every text encoding are
+// detected and decrypted by a Decryptor without configuration
+func TestTextEncodings_RoundTrip(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	decryptor := encodings.NewDecryptor[*SampleStruct](encodings.NewJsonUnserializer[*SampleStruct]("SampleStruct"))
+	if err := decryptor.Initialize(key); err != nil {
+		t.Fatalf("Failed to initialize decryptor: %v", err)
+	}
+
+	for _, encoding := range encodings.TextEncodings() {
+		t.Run(encoding.String(), func(t *testing.T) {
+			encryptor := encodings.NewEncryptor[*SampleStruct](encodings.NewJsonSerializer[*SampleStruct]("SampleStruct")).WithTextEncoding(encoding)
+			if err := encryptor.Initialize(key); err != nil {
+				t.Fatalf("Failed to initialize encryptor: %v", err)
+			}
+
+			// Many values, so every kind of Base64 padding and z3b set is seen
+			for i := 0; i < 50; i++ {
+				data := &SampleStruct{ID: i, Name: strings.Repeat("x", i)}
+				private, err := encryptor.Encrypt(data)
+				if err != nil {
+					t.Fatalf("Encrypt failed: %v", err)
+				}
+				if detected := encodings.DetectTextEncoding(private); detected != encoding && !(encoding == encodings.TextEncodingBase64URL && detected == encodings.TextEncodingBase64) {
+					t.Errorf("Expected %v to be detected, got %v", encoding, detected)
+				}
+				if encoding == encodings.TextEncodingBase64URL && strings.ContainsAny(private, "+/=") {
+					t.Errorf("URL-safe value contains unsafe characters: %q", private)
+				}
+
+				decoded := &SampleStruct{}
+				if err := decryptor.Decrypt(private, decoded); err != nil {
+					t.Fatalf("Decrypt failed: %v", err)
+				}
+				if !decoded.Equals(data) {
+					t.Errorf("Expected %v, got %v", data, decoded)
+				}
+			}
+		})
+	}
+}
+
+func TestDecryptor_InvalidText(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	decryptor := encodings.NewDecryptor[*SampleStruct](encodings.NewJsonUnserializer[*SampleStruct]("SampleStruct"))
+	if err := decryptor.Initialize(key); err != nil {
+		t.Fatalf("Failed to initialize decryptor: %v", err)
+	}
+	for _, text := range []string{"not base64!", "~x", "!x", "YWJj=="} {
+		if err := decryptor.Decrypt(text, &SampleStruct{}); err != errors.ErrDecryptTextDecodingFailed {
+			t.Errorf("Expected ErrDecryptTextDecodingFailed for %q, got %v", text, err)
+		}
+	}
+}
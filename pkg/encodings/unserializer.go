@@ -3,8 +3,34 @@
 
 package encodings
 
+import "io"
+
 type Unserializer[T interface{}] interface {
 	Unserialize(serialized []byte, out T) error
 }
 
 type NewUnserializer[T interface{}] func(name string) Unserializer[T]
+
+// VersionedUnserializer is an Unserializer which is also told the schema
+// version sealed with the data, so data of older versions can be upcast, e.g.
+// UpcastingUnserializer
+type VersionedUnserializer[T interface{}] interface {
+	Unserializer[T]
+	UnserializeVersion(serialized []byte, version uint32, out T) error
+}
+
+// StreamUnserializer is an Unserializer which can also decode data while it
+// is read, e.g. from Decryptor.DecryptStream
+type StreamUnserializer[T interface{}] interface {
+	Unserializer[T]
+	UnserializeFrom(r io.Reader, out T) error
+}
+
+// unserializeVersion unserializes data with the schema version if the
+// unserializer is a VersionedUnserializer, and ignores the version otherwise
+func unserializeVersion[T interface{}](unserializer Unserializer[T], serialized []byte, version uint32, out T) error {
+	if versioned, ok := unserializer.(VersionedUnserializer[T]); ok {
+		return versioned.UnserializeVersion(serialized, version, out)
+	}
+	return unserializer.Unserialize(serialized, out)
+}
@@ -102,6 +102,39 @@ func BenchmarkDecodeZ3b_Large(b *testing.B) {
 	}
 }
 
+func BenchmarkEncodeZ3bParallel_Large(b *testing.B) {
+	data := generateRandomBytes(1024 * 1024) // 1 MB
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := z3b.EncodeParallel(data)
+		if err != nil {
+			b.Fatalf("EncodeParallel failed: %v", err)
+		}
+	}
+}
+
+func BenchmarkEncodeZ3bLegacy_Small(b *testing.B) {
+	data := generateRandomBytes(32)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := z3b.LegacyEncode(data)
+		if err != nil {
+			b.Fatalf("LegacyEncode failed: %v", err)
+		}
+	}
+}
+
+func BenchmarkEncodeZ3bLegacy_Medium(b *testing.B) {
+	data := generateRandomBytes(1024) // 1 KB
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := z3b.LegacyEncode(data)
+		if err != nil {
+			b.Fatalf("LegacyEncode failed: %v", err)
+		}
+	}
+}
+
 func BenchmarkEncodeBase64_Small(b *testing.B) {
 	data := generateRandomBytes(32)
 	b.ResetTimer()
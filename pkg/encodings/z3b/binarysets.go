@@ -1,10027 +0,0 @@
-// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
-// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
-//go:build disabled
-// +build disabled
-
-package z3b
-
-// Binary Sets: Each set maps to a unique range of byte values.
-var (
-	binaryGroups = [numGroups][numSets][setSize]byte{
-
-		// Group 0
-		{
-			{
-				// Plain text range
-				0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-			},
-			{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 1
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 2
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 3
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 4
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 5
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 6
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 7
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 8
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 9
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 10
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 11
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 12
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 13
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 14
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 15
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 16
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 17
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 18
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 19
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 20
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 21
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 22
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 23
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 24
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 25
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 26
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 27
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 28
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 29
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 30
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 31
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 32
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 33
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 34
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 35
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 36
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 37
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 38
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 39
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 40
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 41
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 42
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 43
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 44
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 45
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 46
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 47
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 48
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 49
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 50
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 51
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 52
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 53
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 54
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 55
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 56
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 57
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 58
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 59
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 60
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 61
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 62
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 63
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 64
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 65
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 66
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 67
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 68
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 69
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 70
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 71
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 72
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 73
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 74
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 75
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 76
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 77
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 78
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 79
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 80
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 81
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 82
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 83
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 84
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 85
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 86
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 87
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 88
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 89
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 90
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 91
-		[numSets][setSize]byte{
-			[setSize]byte{
-				//// Plain text range
-				//0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
-				//0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37,
-				//0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
-				//0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
-				//0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
-				//0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57,
-				//0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
-				//0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67,
-				//0x68, 0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F,
-				//0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				//0x76, 0x77, 0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D,
-
-				// Random range
-				0x09, 0x0d, 0x0e, 0x10, 0x12, 0x13, 0x20, 0x21,
-				0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f,
-				0x42, 0x43, 0x44, 0x46, 0x47, 0x51, 0x53, 0x54,
-				0x5b, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x63, 0x66,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x71, 0x73, 0x75, 0x78,
-				0x81, 0x89, 0x8f, 0x94, 0x97, 0xa1, 0xa7, 0xa8,
-				0xa9, 0xaa, 0xaf, 0xb1, 0xba, 0xbe, 0xc2, 0xc4,
-				0xc5, 0xca, 0xcf, 0xd0, 0xd5, 0xd6, 0xdb, 0xdc,
-				0xdf, 0xe4, 0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xef,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x02, 0x04, 0x05, 0x08, 0x0a, 0x0d, 0x0e, 0x0f,
-				0x12, 0x13, 0x14, 0x19, 0x1a, 0x1b, 0x20, 0x25,
-				0x26, 0x2d, 0x31, 0x36, 0x3d, 0x40, 0x43, 0x45,
-				0x47, 0x4c, 0x4f, 0x5d, 0x62, 0x63, 0x66, 0x69,
-				0x6b, 0x6c, 0x71, 0x75, 0x78, 0x7c, 0x81, 0x82,
-				0x84, 0x85, 0x87, 0x8d, 0x93, 0x96, 0x97, 0x9b,
-				0x9c, 0x9d, 0x9e, 0xa0, 0xa6, 0xa8, 0xa9, 0xaa,
-				0xac, 0xb6, 0xb7, 0xbd, 0xc0, 0xc1, 0xc6, 0xca,
-				0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xd6, 0xd8, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe6, 0xe8, 0xee, 0xf0, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xf8, 0xfc, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x07, 0x09, 0x0c, 0x11, 0x17, 0x22, 0x23,
-				0x28, 0x2c, 0x32, 0x38, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x44, 0x46, 0x48, 0x49, 0x4a, 0x4b, 0x4e, 0x51,
-				0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x5e,
-				0x60, 0x61, 0x64, 0x65, 0x67, 0x68, 0x6e, 0x73,
-				0x77, 0x79, 0x7a, 0x7d, 0x80, 0x83, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x90, 0x98, 0x9a, 0xa1, 0xa4, 0xa5,
-				0xa7, 0xaf, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8, 0xb9,
-				0xba, 0xbb, 0xbe, 0xc3, 0xc4, 0xc9, 0xce, 0xd3,
-				0xd5, 0xd9, 0xda, 0xdc, 0xe3, 0xe9, 0xea, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xf6, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x03, 0x06, 0x0b, 0x10, 0x15, 0x16, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x21, 0x24, 0x27, 0x29,
-				0x2a, 0x2b, 0x2e, 0x2f, 0x30, 0x33, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x41, 0x42, 0x46, 0x4d, 0x50,
-				0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5f, 0x6a, 0x6d,
-				0x6f, 0x70, 0x72, 0x74, 0x76, 0x7b, 0x7e, 0x7f,
-				0x86, 0x88, 0x89, 0x8c, 0x91, 0x92, 0x94, 0x95,
-				0x99, 0x9f, 0xa2, 0xa3, 0xab, 0xad, 0xae, 0xb2,
-				0xb3, 0xbc, 0xbf, 0xc2, 0xc5, 0xc7, 0xc8, 0xcb,
-				0xcd, 0xcf, 0xd7, 0xdb, 0xdd, 0xe1, 0xe2, 0xe5,
-				0xe7, 0xec, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			[setSize]byte{
-				0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0f, 0x17, 0x18,
-				0x1a, 0x1c, 0x20, 0x21, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x3a, 0x3d, 0x3f, 0x43, 0x44, 0x47, 0x48,
-				0x4c, 0x4d, 0x51, 0x52, 0x5b, 0x61, 0x63, 0x67,
-				0x6d, 0x6e, 0x76, 0x79, 0x7c, 0x7d, 0x81, 0x82,
-				0x86, 0x88, 0x93, 0x96, 0x98, 0x99, 0x9a, 0x9c,
-				0x9d, 0xa5, 0xa8, 0xac, 0xae, 0xb2, 0xb4, 0xb8,
-				0xbd, 0xbe, 0xbf, 0xc1, 0xc3, 0xc8, 0xcb, 0xcd,
-				0xcf, 0xd0, 0xd1, 0xd3, 0xd5, 0xd6, 0xda, 0xdb,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe7, 0xe8, 0xea, 0xeb,
-				0xef, 0xf0, 0xf2, 0xf8, 0xf9, 0xff,
-			},
-			[setSize]byte{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0d, 0x10,
-				0x14, 0x15, 0x1b, 0x1f, 0x22, 0x28, 0x2a, 0x2d,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x38, 0x39, 0x3b,
-				0x3e, 0x40, 0x45, 0x49, 0x53, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x7b, 0x84,
-				0x87, 0x89, 0x8a, 0x8d, 0x90, 0x91, 0x95, 0xa0,
-				0xa2, 0xa9, 0xaa, 0xab, 0xad, 0xb0, 0xb5, 0xb6,
-				0xb9, 0xbc, 0xc2, 0xc7, 0xcc, 0xd2, 0xd4, 0xd9,
-				0xdf, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf6, 0xfd, 0xfe,
-			},
-			[setSize]byte{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x13, 0x16, 0x19,
-				0x1d, 0x1e, 0x23, 0x24, 0x29, 0x2b, 0x2c, 0x2f,
-				0x35, 0x37, 0x3c, 0x41, 0x42, 0x46, 0x4a, 0x4b,
-				0x4e, 0x4f, 0x50, 0x57, 0x58, 0x59, 0x5a, 0x5c,
-				0x5e, 0x60, 0x69, 0x6a, 0x6c, 0x75, 0x77, 0x78,
-				0x7a, 0x7e, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x92, 0x94, 0x97, 0x9b, 0x9e,
-				0x9f, 0xa1, 0xa3, 0xa4, 0xa6, 0xa7, 0xaf, 0xb1,
-				0xb3, 0xb7, 0xba, 0xbb, 0xc0, 0xc4, 0xc5, 0xc6,
-				0xc9, 0xca, 0xce, 0xd7, 0xd8, 0xdc, 0xe9, 0xec,
-				0xed, 0xf3, 0xf7, 0xfa, 0xfb, 0xfc,
-			},
-		},
-	}
-)
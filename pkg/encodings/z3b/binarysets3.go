@@ -1,8751 +0,0 @@
-// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
-// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
-//go:build disabled
-// +build disabled
-
-package z3b
-
-var (
-	binaryGroups = [numGroups][numSets][setSize]byte{
-
-		// Group 0
-		{
-			{
-				0x03, 0x03, 0x04, 0x05, 0x07, 0x08, 0x09, 0x0e,
-				0x0f, 0x10, 0x1c, 0x1d, 0x20, 0x20, 0x20, 0x22,
-				0x25, 0x26, 0x27, 0x2a, 0x2b, 0x2d, 0x2e, 0x33,
-				0x34, 0x35, 0x35, 0x36, 0x38, 0x39, 0x3f, 0x43,
-				0x44, 0x45, 0x49, 0x49, 0x4c, 0x4f, 0x59, 0x5d,
-				0x62, 0x63, 0x64, 0x65, 0x66, 0x6c, 0x6e, 0x6f,
-				0x75, 0x77, 0x7c, 0x82, 0x82, 0x83, 0x85, 0x8a,
-				0x92, 0x93, 0x93, 0x94, 0x97, 0xaa, 0xad, 0xaf,
-				0xb1, 0xb8, 0xb9, 0xbb, 0xbd, 0xc4, 0xc7, 0xca,
-				0xcc, 0xcf, 0xd3, 0xd5, 0xd6, 0xdd, 0xe1, 0xe2,
-				0xea, 0xec, 0xee, 0xee, 0xf5, 0xf7,
-			},
-			{
-				0x02, 0x07, 0x0c, 0x11, 0x15, 0x16, 0x17, 0x18,
-				0x19, 0x19, 0x23, 0x25, 0x2e, 0x2f, 0x33, 0x34,
-				0x34, 0x36, 0x3b, 0x3e, 0x3f, 0x42, 0x42, 0x42,
-				0x45, 0x4a, 0x4b, 0x4d, 0x4f, 0x50, 0x53, 0x53,
-				0x59, 0x5c, 0x60, 0x61, 0x63, 0x67, 0x6a, 0x6b,
-				0x6e, 0x73, 0x76, 0x78, 0x78, 0x7b, 0x7e, 0x81,
-				0x84, 0x86, 0x89, 0x8c, 0x8d, 0x8e, 0x90, 0x90,
-				0x96, 0x99, 0x9a, 0x9b, 0x9e, 0xa6, 0xa8, 0xac,
-				0xb4, 0xb5, 0xb7, 0xba, 0xbc, 0xbe, 0xc7, 0xca,
-				0xcb, 0xd1, 0xd8, 0xd8, 0xd9, 0xda, 0xde, 0xdf,
-				0xe4, 0xe6, 0xeb, 0xf2, 0xf9, 0xfc,
-			},
-			{
-				0x01, 0x04, 0x06, 0x06, 0x0c, 0x12, 0x13, 0x14,
-				0x18, 0x1c, 0x1c, 0x1f, 0x23, 0x24, 0x26, 0x28,
-				0x29, 0x2b, 0x30, 0x31, 0x32, 0x38, 0x3e, 0x41,
-				0x41, 0x46, 0x48, 0x4a, 0x4c, 0x4e, 0x56, 0x5f,
-				0x61, 0x69, 0x69, 0x6a, 0x6c, 0x74, 0x75, 0x78,
-				0x80, 0x83, 0x88, 0x89, 0x8b, 0x96, 0x98, 0x9a,
-				0x9a, 0x9d, 0x9d, 0xa1, 0xa2, 0xa4, 0xa7, 0xa7,
-				0xa9, 0xac, 0xae, 0xaf, 0xb9, 0xba, 0xbc, 0xbd,
-				0xc0, 0xc5, 0xc8, 0xcc, 0xcd, 0xd4, 0xd7, 0xda,
-				0xdc, 0xe0, 0xe0, 0xe1, 0xe2, 0xe5, 0xeb, 0xf0,
-				0xf0, 0xf3, 0xf7, 0xf9, 0xfa, 0xfb,
-			},
-			{
-				0x01, 0x0a, 0x0a, 0x0b, 0x0e, 0x13, 0x15, 0x16,
-				0x1a, 0x1d, 0x1e, 0x21, 0x27, 0x29, 0x2b, 0x2c,
-				0x2c, 0x3f, 0x43, 0x45, 0x4d, 0x50, 0x51, 0x51,
-				0x57, 0x5a, 0x5b, 0x5c, 0x62, 0x63, 0x64, 0x66,
-				0x66, 0x67, 0x6b, 0x70, 0x71, 0x72, 0x7b, 0x80,
-				0x80, 0x81, 0x89, 0x9b, 0x9e, 0x9f, 0x9f, 0xa2,
-				0xa5, 0xa5, 0xab, 0xad, 0xae, 0xb0, 0xb3, 0xb5,
-				0xb6, 0xb8, 0xb8, 0xc1, 0xc1, 0xc2, 0xc3, 0xc4,
-				0xc6, 0xc7, 0xc8, 0xcc, 0xd0, 0xd0, 0xd2, 0xd5,
-				0xdb, 0xdc, 0xe3, 0xe7, 0xe9, 0xed, 0xf1, 0xf4,
-				0xf4, 0xf9, 0xfd, 0xfe, 0xff, 0xff,
-			},
-			{
-				0x00, 0x0d, 0x0f, 0x10, 0x16, 0x17, 0x1b, 0x1e,
-				0x23, 0x24, 0x26, 0x2d, 0x32, 0x37, 0x3b, 0x3c,
-				0x44, 0x46, 0x48, 0x49, 0x4e, 0x52, 0x53, 0x54,
-				0x55, 0x56, 0x57, 0x58, 0x5b, 0x5e, 0x5f, 0x65,
-				0x67, 0x68, 0x68, 0x6e, 0x6f, 0x70, 0x77, 0x7a,
-				0x7a, 0x7b, 0x7d, 0x7f, 0x84, 0x86, 0x87, 0x8a,
-				0x8b, 0x8c, 0x8d, 0x8e, 0x8f, 0x90, 0x91, 0x95,
-				0x97, 0x97, 0x9c, 0x9c, 0xa1, 0xa3, 0xa7, 0xaa,
-				0xb1, 0xb7, 0xbf, 0xc6, 0xc9, 0xce, 0xd2, 0xd6,
-				0xdb, 0xde, 0xdf, 0xe4, 0xe5, 0xe6, 0xea, 0xed,
-				0xef, 0xef, 0xf2, 0xf7, 0xfc, 0xfd,
-			},
-			{
-				0x02, 0x05, 0x0b, 0x17, 0x1e, 0x21, 0x28, 0x29,
-				0x2c, 0x2f, 0x33, 0x37, 0x3c, 0x3d, 0x40, 0x40,
-				0x4c, 0x4e, 0x51, 0x52, 0x55, 0x5c, 0x5d, 0x5e,
-				0x60, 0x6b, 0x6d, 0x6d, 0x70, 0x72, 0x76, 0x79,
-				0x7c, 0x7d, 0x7e, 0x81, 0x85, 0x85, 0x87, 0x8e,
-				0x8f, 0x8f, 0x94, 0x95, 0x98, 0x99, 0x9f, 0xa1,
-				0xa3, 0xa9, 0xab, 0xb2, 0xb3, 0xb4, 0xb6, 0xbe,
-				0xbf, 0xc0, 0xc3, 0xcd, 0xce, 0xd0, 0xd3, 0xd5,
-				0xd7, 0xdb, 0xdd, 0xe4, 0xe5, 0xe7, 0xe8, 0xe8,
-				0xea, 0xeb, 0xec, 0xf3, 0xf3, 0xf4, 0xf5, 0xf6,
-				0xf6, 0xf8, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			{
-				0x00, 0x05, 0x08, 0x08, 0x09, 0x0d, 0x0d, 0x11,
-				0x12, 0x12, 0x14, 0x1a, 0x1a, 0x1b, 0x1d, 0x1f,
-				0x22, 0x24, 0x2a, 0x30, 0x30, 0x31, 0x31, 0x39,
-				0x3a, 0x3a, 0x3d, 0x3e, 0x46, 0x47, 0x47, 0x4a,
-				0x4b, 0x54, 0x56, 0x58, 0x5a, 0x5b, 0x5e, 0x68,
-				0x6c, 0x71, 0x73, 0x74, 0x79, 0x7e, 0x7f, 0x88,
-				0x8d, 0x91, 0x92, 0x95, 0x9d, 0xa0, 0xa0, 0xa4,
-				0xa6, 0xa8, 0xac, 0xb0, 0xb0, 0xb1, 0xb2, 0xb2,
-				0xbb, 0xc2, 0xc3, 0xc4, 0xc5, 0xc9, 0xc9, 0xcb,
-				0xcd, 0xcf, 0xd1, 0xd3, 0xd4, 0xd9, 0xd9, 0xdf,
-				0xe2, 0xe3, 0xe9, 0xf1, 0xf2, 0xf8,
-			},
-		},
-
-		// Group 1
-		{
-			{
-				0x08, 0x08, 0x0f, 0x12, 0x14, 0x19, 0x1c, 0x1f,
-				0x20, 0x22, 0x25, 0x28, 0x2b, 0x2d, 0x2e, 0x30,
-				0x33, 0x36, 0x42, 0x4a, 0x4b, 0x4f, 0x53, 0x56,
-				0x65, 0x65, 0x66, 0x66, 0x67, 0x69, 0x6a, 0x6b,
-				0x6f, 0x74, 0x79, 0x7b, 0x7e, 0x81, 0x82, 0x89,
-				0x8f, 0x92, 0x93, 0x94, 0x99, 0x9c, 0x9e, 0xa0,
-				0xa1, 0xa8, 0xa8, 0xa8, 0xa9, 0xac, 0xb1, 0xb2,
-				0xb3, 0xb6, 0xb7, 0xbf, 0xc2, 0xc7, 0xc7, 0xcc,
-				0xcd, 0xcf, 0xdd, 0xde, 0xdf, 0xe1, 0xe5, 0xea,
-				0xea, 0xee, 0xf0, 0xf4, 0xf5, 0xf6, 0xf7, 0xfa,
-				0xfa, 0xfb, 0xfd, 0xfd, 0xfe, 0xfe,
-			},
-			{
-				0x07, 0x09, 0x0a, 0x0c, 0x16, 0x24, 0x25, 0x27,
-				0x29, 0x2f, 0x38, 0x3b, 0x3c, 0x41, 0x41, 0x43,
-				0x48, 0x4b, 0x52, 0x57, 0x5a, 0x5b, 0x5d, 0x5d,
-				0x64, 0x65, 0x66, 0x68, 0x69, 0x6a, 0x6b, 0x6c,
-				0x6d, 0x6f, 0x71, 0x71, 0x72, 0x75, 0x75, 0x77,
-				0x7d, 0x7e, 0x81, 0x86, 0x89, 0x89, 0x8c, 0x8c,
-				0x92, 0x95, 0xa0, 0xa5, 0xa5, 0xa6, 0xa7, 0xab,
-				0xad, 0xaf, 0xba, 0xba, 0xbc, 0xbd, 0xbe, 0xc0,
-				0xc0, 0xc3, 0xc8, 0xc8, 0xcc, 0xd1, 0xd2, 0xd3,
-				0xd4, 0xd7, 0xda, 0xda, 0xdb, 0xe2, 0xe6, 0xe6,
-				0xe7, 0xeb, 0xf7, 0xf8, 0xf9, 0xfd,
-			},
-			{
-				0x00, 0x02, 0x04, 0x05, 0x0d, 0x0d, 0x0e, 0x11,
-				0x13, 0x13, 0x15, 0x1d, 0x1f, 0x1f, 0x21, 0x2c,
-				0x2e, 0x32, 0x37, 0x3f, 0x3f, 0x45, 0x47, 0x49,
-				0x4d, 0x51, 0x52, 0x55, 0x57, 0x58, 0x5c, 0x5f,
-				0x63, 0x6d, 0x70, 0x71, 0x72, 0x74, 0x76, 0x7a,
-				0x7c, 0x7f, 0x83, 0x8b, 0x8d, 0x90, 0x91, 0x92,
-				0x95, 0x97, 0x9a, 0x9b, 0x9e, 0xa3, 0xa7, 0xaa,
-				0xae, 0xb1, 0xb5, 0xbd, 0xbf, 0xc6, 0xc7, 0xc9,
-				0xce, 0xcf, 0xd0, 0xd2, 0xd2, 0xd5, 0xd6, 0xdc,
-				0xdf, 0xe3, 0xe3, 0xe4, 0xe5, 0xe7, 0xe8, 0xe8,
-				0xe9, 0xed, 0xf0, 0xf3, 0xf8, 0xfc,
-			},
-			{
-				0x01, 0x02, 0x03, 0x06, 0x07, 0x0b, 0x0d, 0x12,
-				0x18, 0x1b, 0x1e, 0x24, 0x26, 0x2a, 0x2b, 0x30,
-				0x33, 0x34, 0x35, 0x3a, 0x3c, 0x42, 0x42, 0x48,
-				0x4c, 0x4e, 0x54, 0x58, 0x59, 0x5b, 0x5f, 0x62,
-				0x62, 0x63, 0x64, 0x70, 0x73, 0x77, 0x7c, 0x7d,
-				0x7e, 0x7f, 0x80, 0x82, 0x8a, 0x8a, 0x8d, 0x8e,
-				0x8f, 0x96, 0x98, 0x99, 0x9b, 0x9c, 0x9d, 0xa3,
-				0xa4, 0xa6, 0xa6, 0xaa, 0xb1, 0xb5, 0xb7, 0xb9,
-				0xc1, 0xc1, 0xc5, 0xc5, 0xcb, 0xce, 0xd3, 0xd3,
-				0xd5, 0xd6, 0xd7, 0xd8, 0xd9, 0xdb, 0xec, 0xed,
-				0xf2, 0xf2, 0xf6, 0xf6, 0xfa, 0xfb,
-			},
-			{
-				0x03, 0x03, 0x0a, 0x0b, 0x0c, 0x11, 0x16, 0x18,
-				0x19, 0x1a, 0x1d, 0x21, 0x22, 0x23, 0x2a, 0x2b,
-				0x2c, 0x2d, 0x2d, 0x2f, 0x32, 0x35, 0x36, 0x38,
-				0x3b, 0x3c, 0x3d, 0x40, 0x41, 0x43, 0x44, 0x45,
-				0x45, 0x46, 0x47, 0x49, 0x4c, 0x4d, 0x54, 0x55,
-				0x56, 0x5e, 0x61, 0x68, 0x69, 0x6e, 0x73, 0x74,
-				0x78, 0x7a, 0x82, 0x84, 0x8a, 0x8c, 0x94, 0x94,
-				0xa2, 0xa7, 0xab, 0xac, 0xae, 0xb0, 0xb2, 0xb4,
-				0xb6, 0xb8, 0xb9, 0xbb, 0xbe, 0xc9, 0xcb, 0xd1,
-				0xd4, 0xd9, 0xdb, 0xde, 0xe0, 0xe0, 0xe4, 0xe8,
-				0xe9, 0xea, 0xec, 0xee, 0xef, 0xf0,
-			},
-			{
-				0x00, 0x02, 0x04, 0x04, 0x06, 0x07, 0x0c, 0x0f,
-				0x10, 0x11, 0x12, 0x14, 0x16, 0x17, 0x1c, 0x20,
-				0x26, 0x27, 0x29, 0x31, 0x32, 0x34, 0x37, 0x3a,
-				0x3e, 0x46, 0x47, 0x4a, 0x50, 0x53, 0x54, 0x59,
-				0x60, 0x61, 0x61, 0x62, 0x64, 0x6d, 0x6e, 0x75,
-				0x76, 0x77, 0x78, 0x7b, 0x7b, 0x80, 0x85, 0x86,
-				0x87, 0x87, 0x8e, 0x93, 0x93, 0x96, 0x98, 0x9a,
-				0x9b, 0x9d, 0xa0, 0xa1, 0xa2, 0xa4, 0xa9, 0xad,
-				0xaf, 0xb3, 0xb4, 0xb8, 0xbb, 0xbc, 0xbf, 0xc3,
-				0xc6, 0xca, 0xcd, 0xd5, 0xd9, 0xe2, 0xe4, 0xe6,
-				0xee, 0xf1, 0xf2, 0xf3, 0xf5, 0xfc,
-			},
-			{
-				0x01, 0x05, 0x06, 0x09, 0x0a, 0x0e, 0x10, 0x10,
-				0x14, 0x15, 0x17, 0x1a, 0x1b, 0x1d, 0x1e, 0x23,
-				0x28, 0x31, 0x35, 0x39, 0x39, 0x3b, 0x3d, 0x3e,
-				0x3f, 0x40, 0x44, 0x4d, 0x4e, 0x4f, 0x50, 0x51,
-				0x53, 0x55, 0x58, 0x59, 0x5a, 0x5c, 0x5e, 0x60,
-				0x67, 0x67, 0x6c, 0x78, 0x79, 0x83, 0x84, 0x85,
-				0x88, 0x88, 0x8b, 0x8b, 0x8f, 0x90, 0x91, 0x95,
-				0x97, 0x99, 0x9e, 0x9f, 0x9f, 0xb0, 0xb2, 0xb5,
-				0xc0, 0xc2, 0xc4, 0xc4, 0xc6, 0xca, 0xcd, 0xd0,
-				0xd8, 0xdc, 0xdd, 0xe1, 0xe3, 0xe7, 0xeb, 0xef,
-				0xf1, 0xf4, 0xf9, 0xfb, 0xff, 0xff,
-			},
-		},
-
-		// Group 2
-		{
-			{
-				0x01, 0x01, 0x06, 0x0a, 0x0e, 0x11, 0x14, 0x1a,
-				0x1c, 0x1e, 0x20, 0x23, 0x26, 0x2a, 0x2c, 0x2c,
-				0x2d, 0x2e, 0x32, 0x3d, 0x41, 0x43, 0x44, 0x45,
-				0x45, 0x52, 0x53, 0x59, 0x5e, 0x61, 0x63, 0x65,
-				0x70, 0x71, 0x72, 0x73, 0x76, 0x7e, 0x81, 0x86,
-				0x88, 0x89, 0x94, 0x95, 0x95, 0x97, 0x99, 0x9d,
-				0xa8, 0xaa, 0xab, 0xb1, 0xb3, 0xb9, 0xbb, 0xbd,
-				0xbf, 0xc2, 0xc3, 0xc4, 0xc4, 0xc6, 0xc8, 0xca,
-				0xcd, 0xcf, 0xd4, 0xd6, 0xd6, 0xdb, 0xdd, 0xe1,
-				0xe3, 0xe6, 0xea, 0xed, 0xee, 0xf0, 0xf1, 0xf4,
-				0xf5, 0xf7, 0xf8, 0xfa, 0xfb, 0xfc,
-			},
-			{
-				0x02, 0x03, 0x0b, 0x12, 0x15, 0x15, 0x17, 0x19,
-				0x1a, 0x1a, 0x26, 0x27, 0x27, 0x30, 0x31, 0x34,
-				0x34, 0x36, 0x39, 0x3a, 0x40, 0x45, 0x47, 0x48,
-				0x4a, 0x4c, 0x4c, 0x4f, 0x51, 0x51, 0x52, 0x55,
-				0x57, 0x59, 0x5c, 0x5d, 0x5e, 0x60, 0x64, 0x65,
-				0x66, 0x66, 0x68, 0x69, 0x6e, 0x77, 0x77, 0x79,
-				0x79, 0x7c, 0x7c, 0x81, 0x82, 0x85, 0x87, 0x88,
-				0x8b, 0x8e, 0x93, 0x9a, 0x9e, 0xa3, 0xa6, 0xa6,
-				0xac, 0xac, 0xb5, 0xb7, 0xb7, 0xb7, 0xb8, 0xbe,
-				0xc5, 0xc7, 0xc9, 0xd2, 0xd9, 0xd9, 0xda, 0xe5,
-				0xe7, 0xf2, 0xf4, 0xf9, 0xfa, 0xfc,
-			},
-			{
-				0x08, 0x09, 0x0b, 0x10, 0x11, 0x19, 0x1b, 0x1d,
-				0x20, 0x27, 0x29, 0x2e, 0x32, 0x3c, 0x3d, 0x3f,
-				0x42, 0x42, 0x4d, 0x4e, 0x4f, 0x50, 0x50, 0x55,
-				0x5a, 0x5c, 0x5e, 0x5f, 0x60, 0x60, 0x63, 0x69,
-				0x69, 0x6c, 0x71, 0x73, 0x78, 0x7b, 0x7d, 0x7d,
-				0x7f, 0x7f, 0x80, 0x83, 0x83, 0x84, 0x8c, 0x8d,
-				0x90, 0x92, 0x93, 0x97, 0x98, 0x9b, 0x9c, 0x9d,
-				0xa2, 0xa7, 0xa9, 0xa9, 0xb2, 0xb2, 0xb4, 0xb4,
-				0xc1, 0xc2, 0xc8, 0xca, 0xd0, 0xd4, 0xd7, 0xda,
-				0xdb, 0xde, 0xdf, 0xe7, 0xeb, 0xec, 0xed, 0xf0,
-				0xf1, 0xf4, 0xf7, 0xfd, 0xfe, 0xff,
-			},
-			{
-				0x06, 0x07, 0x0a, 0x0b, 0x0c, 0x16, 0x18, 0x1d,
-				0x20, 0x22, 0x22, 0x24, 0x25, 0x2b, 0x2b, 0x2d,
-				0x2f, 0x33, 0x33, 0x35, 0x36, 0x37, 0x38, 0x3a,
-				0x41, 0x41, 0x44, 0x47, 0x49, 0x56, 0x56, 0x5a,
-				0x61, 0x62, 0x6b, 0x6d, 0x77, 0x78, 0x78, 0x7a,
-				0x80, 0x85, 0x86, 0x89, 0x8a, 0x8a, 0x8b, 0x8c,
-				0x8d, 0x92, 0x9b, 0xa0, 0xa4, 0xa4, 0xa5, 0xaa,
-				0xab, 0xac, 0xb0, 0xb6, 0xbb, 0xbc, 0xcb, 0xcb,
-				0xcc, 0xcd, 0xce, 0xcf, 0xd5, 0xd6, 0xdd, 0xde,
-				0xe0, 0xe0, 0xe2, 0xe4, 0xe6, 0xe8, 0xe9, 0xef,
-				0xf3, 0xf6, 0xf6, 0xfe, 0xfe, 0xff,
-			},
-			{
-				0x00, 0x02, 0x05, 0x06, 0x07, 0x0c, 0x0d, 0x10,
-				0x13, 0x14, 0x16, 0x1e, 0x1f, 0x21, 0x25, 0x2a,
-				0x2c, 0x2f, 0x2f, 0x31, 0x35, 0x38, 0x3a, 0x3b,
-				0x3e, 0x3e, 0x43, 0x46, 0x46, 0x46, 0x4a, 0x51,
-				0x52, 0x57, 0x58, 0x58, 0x58, 0x5b, 0x67, 0x6c,
-				0x6d, 0x6e, 0x6f, 0x6f, 0x6f, 0x71, 0x74, 0x75,
-				0x75, 0x76, 0x7a, 0x84, 0x8a, 0x8f, 0x8f, 0x91,
-				0x91, 0x96, 0x98, 0xa1, 0xa3, 0xa5, 0xa7, 0xb3,
-				0xb6, 0xbc, 0xbe, 0xc0, 0xc0, 0xc6, 0xc8, 0xca,
-				0xcc, 0xd2, 0xd7, 0xd8, 0xdb, 0xdc, 0xdf, 0xe7,
-				0xed, 0xef, 0xf3, 0xf7, 0xf8, 0xfd,
-			},
-			{
-				0x00, 0x01, 0x02, 0x03, 0x03, 0x0e, 0x10, 0x16,
-				0x18, 0x1b, 0x1c, 0x1f, 0x21, 0x24, 0x28, 0x29,
-				0x30, 0x35, 0x39, 0x39, 0x3c, 0x3f, 0x40, 0x44,
-				0x49, 0x4e, 0x4f, 0x53, 0x56, 0x5b, 0x5f, 0x62,
-				0x66, 0x67, 0x68, 0x72, 0x75, 0x79, 0x7a, 0x7b,
-				0x7d, 0x7e, 0x82, 0x83, 0x88, 0x8e, 0x90, 0x92,
-				0x99, 0x9a, 0x9c, 0x9e, 0x9f, 0xa8, 0xad, 0xad,
-				0xad, 0xae, 0xae, 0xaf, 0xaf, 0xb1, 0xb3, 0xb9,
-				0xba, 0xbd, 0xbd, 0xc1, 0xc4, 0xc5, 0xc6, 0xc7,
-				0xd1, 0xd1, 0xd3, 0xd3, 0xd4, 0xd8, 0xdc, 0xe1,
-				0xe4, 0xec, 0xee, 0xf2, 0xf9, 0xfb,
-			},
-			{
-				0x04, 0x04, 0x05, 0x08, 0x09, 0x0a, 0x0d, 0x0f,
-				0x0f, 0x11, 0x12, 0x13, 0x14, 0x17, 0x17, 0x18,
-				0x1b, 0x1f, 0x23, 0x28, 0x2e, 0x32, 0x36, 0x37,
-				0x38, 0x3b, 0x3d, 0x3f, 0x40, 0x48, 0x4b, 0x4b,
-				0x4d, 0x4e, 0x54, 0x54, 0x5d, 0x5d, 0x5f, 0x64,
-				0x64, 0x6a, 0x6a, 0x6b, 0x70, 0x74, 0x76, 0x87,
-				0x87, 0x8c, 0x8d, 0x94, 0x96, 0x9b, 0x9f, 0xa0,
-				0xa1, 0xa2, 0xa6, 0xae, 0xb0, 0xb0, 0xb5, 0xb8,
-				0xba, 0xbf, 0xc3, 0xc9, 0xce, 0xd0, 0xd5, 0xd5,
-				0xd8, 0xdd, 0xdf, 0xe1, 0xe2, 0xe3, 0xe5, 0xe8,
-				0xe9, 0xea, 0xeb, 0xef, 0xf5, 0xfc,
-			},
-		},
-
-		// Group 3
-		{
-			{
-				0x01, 0x02, 0x02, 0x03, 0x06, 0x07, 0x0b, 0x0c,
-				0x10, 0x13, 0x16, 0x19, 0x19, 0x20, 0x21, 0x22,
-				0x27, 0x28, 0x29, 0x2b, 0x2c, 0x2e, 0x30, 0x31,
-				0x32, 0x33, 0x3d, 0x3f, 0x44, 0x46, 0x4f, 0x53,
-				0x5a, 0x5b, 0x5c, 0x5d, 0x5d, 0x5e, 0x64, 0x68,
-				0x6b, 0x6e, 0x6f, 0x72, 0x73, 0x73, 0x78, 0x7c,
-				0x82, 0x88, 0x8e, 0x95, 0x98, 0x9b, 0x9c, 0x9c,
-				0x9d, 0x9e, 0x9f, 0xa3, 0xa9, 0xa9, 0xaa, 0xad,
-				0xb5, 0xb7, 0xb7, 0xb8, 0xc3, 0xc8, 0xc8, 0xcb,
-				0xcc, 0xd2, 0xd7, 0xd8, 0xdc, 0xdd, 0xe0, 0xe2,
-				0xe6, 0xf0, 0xf7, 0xf7, 0xfd, 0xff,
-			},
-			{
-				0x05, 0x07, 0x08, 0x09, 0x12, 0x13, 0x14, 0x14,
-				0x14, 0x16, 0x18, 0x1e, 0x20, 0x23, 0x24, 0x26,
-				0x28, 0x2a, 0x2b, 0x2c, 0x2e, 0x35, 0x46, 0x47,
-				0x47, 0x48, 0x4a, 0x4f, 0x52, 0x60, 0x63, 0x63,
-				0x65, 0x67, 0x69, 0x69, 0x6a, 0x6a, 0x6c, 0x6d,
-				0x7d, 0x83, 0x84, 0x86, 0x86, 0x87, 0x88, 0x89,
-				0x8f, 0x90, 0x98, 0x98, 0xa0, 0xa1, 0xac, 0xb0,
-				0xb5, 0xbb, 0xbc, 0xbd, 0xbe, 0xbf, 0xc4, 0xc8,
-				0xca, 0xcb, 0xcc, 0xcd, 0xd1, 0xd3, 0xd4, 0xd7,
-				0xe3, 0xe3, 0xe9, 0xe9, 0xea, 0xed, 0xef, 0xef,
-				0xf0, 0xf1, 0xf3, 0xf6, 0xfe, 0xfe,
-			},
-			{
-				0x00, 0x05, 0x0b, 0x13, 0x17, 0x1a, 0x1b, 0x1d,
-				0x1f, 0x25, 0x28, 0x29, 0x2d, 0x2f, 0x33, 0x34,
-				0x38, 0x3c, 0x3f, 0x42, 0x4a, 0x52, 0x55, 0x57,
-				0x59, 0x5b, 0x5f, 0x60, 0x64, 0x64, 0x66, 0x70,
-				0x70, 0x71, 0x71, 0x75, 0x77, 0x79, 0x7b, 0x7f,
-				0x89, 0x8a, 0x8b, 0x8c, 0x91, 0x92, 0x9f, 0xa2,
-				0xa4, 0xa5, 0xa6, 0xa9, 0xac, 0xad, 0xae, 0xb2,
-				0xb3, 0xb4, 0xb7, 0xb9, 0xbc, 0xbe, 0xc0, 0xc5,
-				0xcd, 0xd3, 0xd6, 0xd8, 0xda, 0xdb, 0xdc, 0xdc,
-				0xdd, 0xe4, 0xe5, 0xf0, 0xf1, 0xf5, 0xf5, 0xf7,
-				0xfb, 0xfb, 0xfd, 0xfd, 0xfe, 0xff,
-			},
-			{
-				0x00, 0x00, 0x08, 0x15, 0x1a, 0x1c, 0x1e, 0x22,
-				0x26, 0x2e, 0x32, 0x34, 0x39, 0x3a, 0x3c, 0x41,
-				0x44, 0x47, 0x48, 0x4c, 0x4c, 0x4d, 0x4e, 0x50,
-				0x51, 0x54, 0x55, 0x56, 0x57, 0x59, 0x5a, 0x5c,
-				0x60, 0x61, 0x62, 0x6e, 0x6e, 0x74, 0x75, 0x76,
-				0x7a, 0x7a, 0x7b, 0x7c, 0x7e, 0x7f, 0x82, 0x83,
-				0x87, 0x8a, 0x8a, 0x8b, 0x8e, 0x90, 0x92, 0x93,
-				0x97, 0x9d, 0xa0, 0xa1, 0xa3, 0xac, 0xb0, 0xb2,
-				0xb6, 0xb6, 0xbb, 0xc1, 0xc2, 0xc3, 0xc6, 0xc7,
-				0xd0, 0xd2, 0xde, 0xe2, 0xe6, 0xe7, 0xe7, 0xe9,
-				0xeb, 0xed, 0xee, 0xf3, 0xf8, 0xfc,
-			},
-			{
-				0x03, 0x04, 0x05, 0x06, 0x0b, 0x0e, 0x0f, 0x0f,
-				0x10, 0x11, 0x11, 0x16, 0x17, 0x18, 0x1c, 0x1e,
-				0x23, 0x24, 0x25, 0x27, 0x2a, 0x2f, 0x30, 0x34,
-				0x37, 0x38, 0x39, 0x3a, 0x3d, 0x3e, 0x3e, 0x41,
-				0x41, 0x49, 0x4e, 0x4f, 0x50, 0x50, 0x54, 0x58,
-				0x5d, 0x61, 0x65, 0x66, 0x67, 0x72, 0x74, 0x80,
-				0x81, 0x8c, 0x90, 0x91, 0x94, 0x97, 0x9c, 0x9e,
-				0x9f, 0xa6, 0xa7, 0xa8, 0xad, 0xaf, 0xb2, 0xb3,
-				0xb4, 0xb8, 0xba, 0xbe, 0xc1, 0xc2, 0xc6, 0xc6,
-				0xcc, 0xd0, 0xd1, 0xd1, 0xd5, 0xd6, 0xdb, 0xdd,
-				0xde, 0xdf, 0xe1, 0xf4, 0xf8, 0xfa,
-			},
-			{
-				0x04, 0x07, 0x09, 0x0a, 0x0a, 0x0c, 0x0d, 0x0d,
-				0x0e, 0x12, 0x1f, 0x1f, 0x21, 0x26, 0x30, 0x31,
-				0x33, 0x35, 0x36, 0x36, 0x43, 0x45, 0x49, 0x4b,
-				0x4d, 0x51, 0x5b, 0x5f, 0x62, 0x6b, 0x6d, 0x6f,
-				0x6f, 0x74, 0x75, 0x80, 0x82, 0x83, 0x84, 0x8d,
-				0x8d, 0x8f, 0x93, 0x95, 0x9e, 0xa2, 0xa2, 0xa4,
-				0xa5, 0xa7, 0xa7, 0xaa, 0xae, 0xaf, 0xb0, 0xb1,
-				0xb9, 0xba, 0xbc, 0xbf, 0xc0, 0xc3, 0xc4, 0xc7,
-				0xc9, 0xcd, 0xce, 0xcf, 0xcf, 0xd5, 0xd8, 0xd9,
-				0xd9, 0xda, 0xda, 0xe8, 0xea, 0xec, 0xec, 0xee,
-				0xf2, 0xf2, 0xf3, 0xf4, 0xf9, 0xfc,
-			},
-			{
-				0x01, 0x0a, 0x0e, 0x0f, 0x11, 0x12, 0x15, 0x1a,
-				0x1b, 0x1d, 0x27, 0x2c, 0x2d, 0x2d, 0x37, 0x3a,
-				0x3b, 0x3b, 0x3c, 0x3f, 0x40, 0x40, 0x42, 0x43,
-				0x45, 0x46, 0x4b, 0x4c, 0x53, 0x55, 0x56, 0x58,
-				0x59, 0x5e, 0x62, 0x68, 0x6a, 0x6b, 0x6c, 0x76,
-				0x77, 0x77, 0x78, 0x79, 0x7c, 0x7d, 0x7e, 0x81,
-				0x85, 0x85, 0x87, 0x94, 0x96, 0x96, 0x99, 0x99,
-				0x9a, 0x9a, 0x9b, 0xa5, 0xa8, 0xab, 0xab, 0xaf,
-				0xb1, 0xb6, 0xbd, 0xc5, 0xc9, 0xca, 0xce, 0xd4,
-				0xdf, 0xe0, 0xe1, 0xe2, 0xe4, 0xe4, 0xe5, 0xe8,
-				0xeb, 0xf4, 0xf6, 0xf9, 0xfa, 0xfc,
-			},
-		},
-
-		// Group 4
-		{
-			{
-				0x01, 0x05, 0x0c, 0x11, 0x12, 0x1a, 0x1c, 0x1f,
-				0x22, 0x22, 0x26, 0x26, 0x27, 0x27, 0x28, 0x2c,
-				0x2e, 0x2f, 0x30, 0x33, 0x33, 0x3c, 0x3d, 0x42,
-				0x45, 0x45, 0x48, 0x4a, 0x4e, 0x52, 0x53, 0x54,
-				0x55, 0x56, 0x57, 0x5c, 0x60, 0x63, 0x66, 0x66,
-				0x71, 0x74, 0x75, 0x7e, 0x80, 0x84, 0x8a, 0x8b,
-				0x91, 0x92, 0x94, 0x9a, 0x9b, 0x9c, 0x9d, 0x9f,
-				0xa2, 0xa6, 0xa9, 0xaa, 0xac, 0xaf, 0xb0, 0xb2,
-				0xb3, 0xb8, 0xc0, 0xc1, 0xc5, 0xc8, 0xc9, 0xca,
-				0xcb, 0xcc, 0xd5, 0xdb, 0xdb, 0xdb, 0xe2, 0xe5,
-				0xee, 0xf3, 0xf4, 0xf6, 0xfb, 0xfb,
-			},
-			{
-				0x03, 0x09, 0x0d, 0x0d, 0x0e, 0x0f, 0x10, 0x13,
-				0x15, 0x16, 0x19, 0x1b, 0x1c, 0x2d, 0x31, 0x32,
-				0x35, 0x39, 0x3b, 0x40, 0x43, 0x44, 0x45, 0x47,
-				0x48, 0x4a, 0x4e, 0x52, 0x54, 0x56, 0x58, 0x59,
-				0x59, 0x5b, 0x5e, 0x62, 0x64, 0x67, 0x6c, 0x71,
-				0x77, 0x7e, 0x80, 0x87, 0x89, 0x8d, 0x8e, 0x99,
-				0x9f, 0xa7, 0xa9, 0xaa, 0xac, 0xad, 0xae, 0xb4,
-				0xba, 0xbc, 0xc3, 0xc4, 0xc7, 0xcc, 0xcd, 0xcd,
-				0xce, 0xce, 0xcf, 0xd2, 0xd2, 0xd4, 0xd6, 0xd7,
-				0xd8, 0xde, 0xe1, 0xe5, 0xe6, 0xe8, 0xeb, 0xec,
-				0xf0, 0xf1, 0xf6, 0xf7, 0xf9, 0xff,
-			},
-			{
-				0x08, 0x0b, 0x0e, 0x0e, 0x10, 0x12, 0x16, 0x18,
-				0x1b, 0x1c, 0x1d, 0x20, 0x21, 0x25, 0x25, 0x2f,
-				0x35, 0x36, 0x38, 0x39, 0x3a, 0x3d, 0x3e, 0x42,
-				0x46, 0x49, 0x49, 0x4b, 0x4d, 0x4e, 0x4f, 0x4f,
-				0x50, 0x51, 0x61, 0x62, 0x62, 0x64, 0x6b, 0x6c,
-				0x6d, 0x72, 0x76, 0x78, 0x7c, 0x7f, 0x85, 0x86,
-				0x8c, 0x8f, 0x92, 0x97, 0x9d, 0x9e, 0x9f, 0xa0,
-				0xa1, 0xa1, 0xa1, 0xa6, 0xb0, 0xb1, 0xb6, 0xb7,
-				0xb8, 0xb8, 0xbb, 0xbc, 0xbf, 0xc0, 0xc2, 0xc3,
-				0xd0, 0xd3, 0xd6, 0xd7, 0xdc, 0xdd, 0xe1, 0xe3,
-				0xe6, 0xe9, 0xea, 0xf2, 0xf2, 0xf3,
-			},
-			{
-				0x03, 0x05, 0x14, 0x14, 0x15, 0x18, 0x1a, 0x1b,
-				0x1d, 0x1e, 0x23, 0x23, 0x25, 0x2a, 0x2c, 0x2e,
-				0x35, 0x37, 0x37, 0x3a, 0x3f, 0x43, 0x44, 0x4c,
-				0x50, 0x5a, 0x5d, 0x61, 0x63, 0x66, 0x6b, 0x6d,
-				0x6f, 0x70, 0x73, 0x74, 0x75, 0x79, 0x7f, 0x84,
-				0x85, 0x87, 0x8b, 0x91, 0x93, 0x96, 0x98, 0x9a,
-				0x9c, 0x9c, 0x9e, 0xa0, 0xa0, 0xad, 0xb7, 0xb9,
-				0xc4, 0xc6, 0xc8, 0xcb, 0xd1, 0xd2, 0xd5, 0xd7,
-				0xd9, 0xd9, 0xdd, 0xe2, 0xe4, 0xe4, 0xea, 0xeb,
-				0xed, 0xf0, 0xf1, 0xf5, 0xf7, 0xf7, 0xf8, 0xf8,
-				0xf9, 0xfa, 0xfa, 0xfb, 0xfc, 0xff,
-			},
-			{
-				0x02, 0x04, 0x06, 0x07, 0x18, 0x1e, 0x29, 0x29,
-				0x2c, 0x2d, 0x30, 0x34, 0x3b, 0x3c, 0x3f, 0x41,
-				0x46, 0x47, 0x49, 0x4c, 0x53, 0x56, 0x57, 0x67,
-				0x6a, 0x6b, 0x6e, 0x6f, 0x73, 0x74, 0x75, 0x76,
-				0x7b, 0x7f, 0x82, 0x83, 0x83, 0x88, 0x88, 0x89,
-				0x8a, 0x8f, 0x90, 0x91, 0x92, 0x93, 0x94, 0x95,
-				0x96, 0x97, 0xa3, 0xa4, 0xa7, 0xa7, 0xa8, 0xaa,
-				0xab, 0xaf, 0xb4, 0xb6, 0xbb, 0xbd, 0xbd, 0xbf,
-				0xc2, 0xc6, 0xcf, 0xd3, 0xd4, 0xd8, 0xda, 0xdc,
-				0xdf, 0xdf, 0xe0, 0xe3, 0xe7, 0xe8, 0xe9, 0xec,
-				0xee, 0xee, 0xef, 0xf5, 0xf8, 0xfa,
-			},
-			{
-				0x02, 0x05, 0x06, 0x07, 0x0a, 0x0a, 0x0b, 0x0c,
-				0x0f, 0x11, 0x13, 0x17, 0x17, 0x19, 0x1e, 0x27,
-				0x29, 0x2b, 0x2b, 0x31, 0x32, 0x36, 0x38, 0x3f,
-				0x40, 0x41, 0x42, 0x4d, 0x51, 0x58, 0x59, 0x5a,
-				0x5c, 0x5d, 0x5f, 0x5f, 0x65, 0x68, 0x69, 0x69,
-				0x70, 0x77, 0x78, 0x7a, 0x7c, 0x82, 0x85, 0x86,
-				0x87, 0x88, 0x8c, 0x8d, 0x8e, 0x90, 0x96, 0x9a,
-				0x9b, 0xa4, 0xa8, 0xad, 0xb1, 0xb2, 0xb3, 0xb3,
-				0xb5, 0xb5, 0xb9, 0xbb, 0xbc, 0xbe, 0xbe, 0xc2,
-				0xc7, 0xc9, 0xca, 0xcf, 0xd0, 0xe4, 0xef, 0xef,
-				0xf4, 0xf5, 0xf6, 0xfd, 0xfe, 0xfe,
-			},
-			{
-				0x00, 0x00, 0x01, 0x04, 0x07, 0x08, 0x09, 0x0f,
-				0x19, 0x1f, 0x20, 0x21, 0x24, 0x24, 0x28, 0x2a,
-				0x2b, 0x34, 0x39, 0x3d, 0x3e, 0x44, 0x47, 0x4b,
-				0x4f, 0x55, 0x58, 0x5b, 0x5c, 0x5d, 0x5e, 0x60,
-				0x64, 0x65, 0x68, 0x69, 0x6a, 0x6e, 0x70, 0x72,
-				0x73, 0x79, 0x7a, 0x7b, 0x7b, 0x7d, 0x7d, 0x80,
-				0x81, 0x81, 0x83, 0x89, 0x8d, 0x8e, 0x95, 0x95,
-				0x98, 0x99, 0x99, 0x9e, 0xa2, 0xa3, 0xa5, 0xa5,
-				0xab, 0xac, 0xae, 0xb6, 0xb9, 0xba, 0xbf, 0xc1,
-				0xc5, 0xc8, 0xd1, 0xd5, 0xda, 0xde, 0xe0, 0xe1,
-				0xe7, 0xed, 0xf2, 0xf3, 0xfc, 0xfd,
-			},
-		},
-
-		// Group 5
-		{
-			{
-				0x04, 0x08, 0x0f, 0x11, 0x1c, 0x25, 0x27, 0x2d,
-				0x2e, 0x33, 0x35, 0x3c, 0x40, 0x43, 0x44, 0x44,
-				0x49, 0x4a, 0x4e, 0x4f, 0x51, 0x58, 0x5b, 0x5c,
-				0x62, 0x63, 0x64, 0x65, 0x68, 0x6c, 0x71, 0x73,
-				0x74, 0x76, 0x78, 0x78, 0x7a, 0x7c, 0x85, 0x89,
-				0x8b, 0x8c, 0x91, 0x94, 0x96, 0x97, 0x9c, 0x9e,
-				0x9e, 0xa0, 0xa3, 0xa5, 0xa6, 0xa7, 0xab, 0xb0,
-				0xb3, 0xb4, 0xb5, 0xb5, 0xb7, 0xc0, 0xc3, 0xc4,
-				0xc4, 0xc5, 0xc9, 0xc9, 0xce, 0xd1, 0xd4, 0xd5,
-				0xd7, 0xd9, 0xdc, 0xde, 0xe2, 0xe3, 0xed, 0xf1,
-				0xf2, 0xf3, 0xf4, 0xf8, 0xfc, 0xff,
-			},
-			{
-				0x01, 0x01, 0x03, 0x10, 0x11, 0x14, 0x14, 0x15,
-				0x16, 0x1d, 0x1e, 0x21, 0x24, 0x2b, 0x30, 0x32,
-				0x32, 0x37, 0x37, 0x38, 0x39, 0x3c, 0x40, 0x41,
-				0x45, 0x4b, 0x52, 0x55, 0x59, 0x5a, 0x5e, 0x60,
-				0x61, 0x66, 0x6a, 0x6a, 0x6b, 0x6f, 0x79, 0x7c,
-				0x7f, 0x80, 0x81, 0x81, 0x82, 0x83, 0x83, 0x89,
-				0x8d, 0x8d, 0x90, 0x90, 0x92, 0x95, 0x99, 0x99,
-				0xa4, 0xa6, 0xa9, 0xaa, 0xaa, 0xac, 0xac, 0xae,
-				0xb1, 0xb2, 0xbc, 0xc0, 0xc4, 0xc8, 0xcc, 0xcc,
-				0xd0, 0xd4, 0xe1, 0xe3, 0xe6, 0xea, 0xed, 0xf2,
-				0xf4, 0xf8, 0xf9, 0xf9, 0xfb, 0xfd,
-			},
-			{
-				0x02, 0x04, 0x09, 0x0b, 0x0c, 0x0c, 0x0e, 0x15,
-				0x19, 0x1b, 0x20, 0x21, 0x25, 0x2e, 0x36, 0x38,
-				0x3a, 0x3b, 0x3b, 0x3f, 0x42, 0x48, 0x48, 0x4b,
-				0x4c, 0x4d, 0x4e, 0x53, 0x54, 0x56, 0x56, 0x57,
-				0x5b, 0x60, 0x66, 0x68, 0x69, 0x77, 0x77, 0x7d,
-				0x7d, 0x7e, 0x7e, 0x85, 0x88, 0x98, 0x9a, 0x9a,
-				0x9b, 0x9e, 0xa0, 0xa2, 0xa6, 0xa9, 0xaa, 0xb0,
-				0xb0, 0xb9, 0xb9, 0xbb, 0xbc, 0xbd, 0xbd, 0xbe,
-				0xc2, 0xc8, 0xc9, 0xd0, 0xd4, 0xd5, 0xd8, 0xd9,
-				0xdb, 0xe1, 0xe2, 0xe4, 0xe5, 0xe7, 0xeb, 0xf3,
-				0xf7, 0xf8, 0xf9, 0xfb, 0xfc, 0xff,
-			},
-			{
-				0x00, 0x03, 0x05, 0x0a, 0x0b, 0x0d, 0x0d, 0x10,
-				0x12, 0x14, 0x16, 0x17, 0x18, 0x19, 0x1c, 0x1d,
-				0x23, 0x24, 0x24, 0x26, 0x29, 0x2f, 0x31, 0x34,
-				0x35, 0x39, 0x3a, 0x3b, 0x3d, 0x3e, 0x40, 0x42,
-				0x45, 0x47, 0x4a, 0x52, 0x54, 0x58, 0x59, 0x5a,
-				0x5d, 0x5f, 0x69, 0x69, 0x6c, 0x75, 0x75, 0x7a,
-				0x7f, 0x81, 0x86, 0x8e, 0x8e, 0x95, 0x99, 0x9c,
-				0x9f, 0xa1, 0xa7, 0xab, 0xad, 0xb1, 0xb2, 0xb3,
-				0xb4, 0xba, 0xbc, 0xbf, 0xc3, 0xc7, 0xca, 0xce,
-				0xd3, 0xd6, 0xd9, 0xda, 0xe0, 0xe4, 0xe8, 0xe9,
-				0xee, 0xf0, 0xf5, 0xf5, 0xf7, 0xfb,
-			},
-			{
-				0x02, 0x06, 0x0e, 0x17, 0x1f, 0x20, 0x22, 0x23,
-				0x27, 0x2b, 0x2d, 0x2e, 0x31, 0x3c, 0x3e, 0x3f,
-				0x43, 0x45, 0x4a, 0x4c, 0x4d, 0x51, 0x5c, 0x63,
-				0x64, 0x67, 0x6e, 0x6e, 0x6f, 0x70, 0x70, 0x73,
-				0x79, 0x85, 0x86, 0x89, 0x8a, 0x8a, 0x8f, 0x92,
-				0x96, 0x97, 0x97, 0x9d, 0x9f, 0xa1, 0xa2, 0xa2,
-				0xa3, 0xa4, 0xa7, 0xab, 0xac, 0xad, 0xae, 0xb6,
-				0xb8, 0xb8, 0xb9, 0xba, 0xbd, 0xc1, 0xc2, 0xc7,
-				0xca, 0xcb, 0xcb, 0xcf, 0xd1, 0xd7, 0xda, 0xda,
-				0xe0, 0xe0, 0xe4, 0xe6, 0xe7, 0xe9, 0xec, 0xec,
-				0xee, 0xef, 0xf0, 0xf6, 0xfd, 0xfe,
-			},
-			{
-				0x05, 0x07, 0x0a, 0x0a, 0x0f, 0x13, 0x16, 0x1a,
-				0x1a, 0x25, 0x26, 0x27, 0x28, 0x29, 0x2a, 0x2d,
-				0x2f, 0x30, 0x32, 0x34, 0x36, 0x39, 0x3d, 0x41,
-				0x44, 0x46, 0x46, 0x4f, 0x50, 0x53, 0x53, 0x55,
-				0x5d, 0x5e, 0x5e, 0x5f, 0x62, 0x65, 0x68, 0x6c,
-				0x6d, 0x6d, 0x6f, 0x71, 0x71, 0x72, 0x72, 0x74,
-				0x7d, 0x80, 0x82, 0x86, 0x88, 0x93, 0x94, 0x9d,
-				0xa1, 0xa3, 0xa5, 0xa8, 0xaf, 0xb4, 0xb7, 0xb8,
-				0xba, 0xbb, 0xc1, 0xc3, 0xc5, 0xcc, 0xce, 0xd3,
-				0xd7, 0xdb, 0xdc, 0xdd, 0xde, 0xde, 0xdf, 0xdf,
-				0xe3, 0xe5, 0xea, 0xee, 0xf1, 0xfa,
-			},
-			{
-				0x00, 0x06, 0x07, 0x08, 0x09, 0x12, 0x12, 0x13,
-				0x18, 0x1b, 0x1e, 0x1f, 0x22, 0x28, 0x2a, 0x2c,
-				0x2c, 0x2f, 0x33, 0x33, 0x34, 0x37, 0x47, 0x47,
-				0x48, 0x49, 0x50, 0x57, 0x5f, 0x61, 0x63, 0x65,
-				0x67, 0x67, 0x6b, 0x76, 0x7b, 0x7b, 0x82, 0x84,
-				0x84, 0x87, 0x87, 0x88, 0x8b, 0x8c, 0x8e, 0x8f,
-				0x91, 0x93, 0x93, 0x94, 0x95, 0x98, 0x9b, 0xa5,
-				0xa8, 0xaf, 0xb2, 0xb5, 0xb6, 0xbe, 0xbf, 0xbf,
-				0xc0, 0xc6, 0xc6, 0xcd, 0xcd, 0xcf, 0xcf, 0xd2,
-				0xd2, 0xd2, 0xd6, 0xd6, 0xd8, 0xdd, 0xdf, 0xe8,
-				0xeb, 0xef, 0xf6, 0xfa, 0xfe, 0xff,
-			},
-		},
-
-		// Group 6
-		{
-			{
-				0x02, 0x03, 0x09, 0x0a, 0x0d, 0x10, 0x12, 0x1a,
-				0x1a, 0x1d, 0x20, 0x21, 0x22, 0x24, 0x26, 0x26,
-				0x28, 0x2b, 0x32, 0x33, 0x37, 0x3a, 0x3b, 0x3c,
-				0x3c, 0x3e, 0x3f, 0x4a, 0x4d, 0x50, 0x50, 0x53,
-				0x59, 0x5a, 0x5d, 0x60, 0x62, 0x64, 0x6a, 0x6c,
-				0x6f, 0x7e, 0x7e, 0x80, 0x81, 0x83, 0x85, 0x8a,
-				0x8e, 0x8f, 0x8f, 0x94, 0x95, 0x98, 0x99, 0x9e,
-				0xa7, 0xb0, 0xb7, 0xbe, 0xbf, 0xc3, 0xc6, 0xcd,
-				0xcf, 0xd1, 0xd5, 0xd5, 0xd5, 0xd6, 0xd8, 0xdb,
-				0xdc, 0xdc, 0xdf, 0xe2, 0xe6, 0xe8, 0xee, 0xf0,
-				0xf2, 0xf3, 0xf5, 0xf8, 0xfc, 0xff,
-			},
-			{
-				0x00, 0x00, 0x06, 0x07, 0x09, 0x0c, 0x0c, 0x0d,
-				0x0e, 0x13, 0x14, 0x18, 0x18, 0x1c, 0x1d, 0x1f,
-				0x20, 0x21, 0x23, 0x34, 0x36, 0x3d, 0x42, 0x43,
-				0x44, 0x44, 0x46, 0x4b, 0x4e, 0x4e, 0x55, 0x57,
-				0x5a, 0x5b, 0x5e, 0x60, 0x66, 0x66, 0x6b, 0x6b,
-				0x72, 0x73, 0x76, 0x77, 0x7a, 0x80, 0x84, 0x84,
-				0x85, 0x87, 0x89, 0x9a, 0x9d, 0x9e, 0x9f, 0x9f,
-				0xa1, 0xa2, 0xaa, 0xad, 0xb1, 0xb2, 0xb2, 0xb4,
-				0xbb, 0xc2, 0xc2, 0xc4, 0xc9, 0xcb, 0xd0, 0xd2,
-				0xd7, 0xd9, 0xdd, 0xe1, 0xe2, 0xe3, 0xe4, 0xf3,
-				0xf4, 0xf6, 0xf6, 0xf7, 0xf9, 0xfc,
-			},
-			{
-				0x01, 0x05, 0x06, 0x0a, 0x16, 0x16, 0x17, 0x1b,
-				0x1c, 0x24, 0x29, 0x2a, 0x2e, 0x2e, 0x30, 0x34,
-				0x35, 0x38, 0x38, 0x3a, 0x3c, 0x40, 0x41, 0x46,
-				0x4a, 0x4f, 0x54, 0x55, 0x57, 0x5c, 0x5c, 0x64,
-				0x67, 0x68, 0x70, 0x71, 0x72, 0x73, 0x74, 0x75,
-				0x7a, 0x7b, 0x7f, 0x82, 0x83, 0x89, 0x92, 0x93,
-				0x95, 0x97, 0x98, 0x9a, 0x9e, 0x9f, 0xa3, 0xa3,
-				0xa4, 0xa7, 0xa8, 0xab, 0xbd, 0xbe, 0xbf, 0xc1,
-				0xc1, 0xc2, 0xc3, 0xc4, 0xc6, 0xc7, 0xce, 0xcf,
-				0xd2, 0xda, 0xde, 0xdf, 0xe2, 0xe7, 0xe9, 0xe9,
-				0xed, 0xf1, 0xf7, 0xf9, 0xfd, 0xff,
-			},
-			{
-				0x00, 0x04, 0x0b, 0x0b, 0x0d, 0x0f, 0x10, 0x11,
-				0x14, 0x19, 0x25, 0x29, 0x2c, 0x2d, 0x2f, 0x35,
-				0x42, 0x48, 0x4c, 0x4e, 0x52, 0x54, 0x54, 0x56,
-				0x57, 0x58, 0x61, 0x68, 0x69, 0x69, 0x6c, 0x6f,
-				0x70, 0x71, 0x72, 0x76, 0x77, 0x78, 0x7b, 0x7f,
-				0x87, 0x8b, 0x8c, 0x8c, 0x8e, 0x91, 0x92, 0x97,
-				0x98, 0x9c, 0x9c, 0xa0, 0xa5, 0xa5, 0xa7, 0xad,
-				0xb0, 0xb2, 0xb3, 0xb8, 0xb9, 0xba, 0xbb, 0xbb,
-				0xbd, 0xc4, 0xc5, 0xca, 0xca, 0xcb, 0xd3, 0xd4,
-				0xd6, 0xd9, 0xdb, 0xde, 0xe3, 0xe8, 0xeb, 0xec,
-				0xec, 0xf1, 0xfb, 0xfb, 0xfc, 0xfe,
-			},
-			{
-				0x01, 0x08, 0x0a, 0x0f, 0x16, 0x17, 0x19, 0x1b,
-				0x1e, 0x1f, 0x22, 0x2b, 0x2c, 0x2d, 0x2e, 0x30,
-				0x31, 0x32, 0x37, 0x38, 0x39, 0x3d, 0x3d, 0x3f,
-				0x43, 0x47, 0x4d, 0x4d, 0x4f, 0x56, 0x58, 0x59,
-				0x5b, 0x5f, 0x60, 0x62, 0x67, 0x68, 0x6c, 0x6d,
-				0x74, 0x76, 0x78, 0x79, 0x7c, 0x7d, 0x83, 0x84,
-				0x86, 0x88, 0x90, 0x91, 0x94, 0xa0, 0xa2, 0xa5,
-				0xa6, 0xa6, 0xa9, 0xaa, 0xaa, 0xaf, 0xaf, 0xb4,
-				0xbe, 0xc0, 0xc0, 0xc9, 0xcc, 0xcc, 0xcd, 0xce,
-				0xd0, 0xd8, 0xda, 0xdb, 0xe0, 0xe1, 0xe3, 0xea,
-				0xed, 0xee, 0xf2, 0xf5, 0xfa, 0xfd,
-			},
-			{
-				0x04, 0x05, 0x05, 0x08, 0x0e, 0x11, 0x12, 0x13,
-				0x13, 0x1c, 0x1d, 0x20, 0x23, 0x24, 0x28, 0x2f,
-				0x32, 0x33, 0x33, 0x34, 0x36, 0x39, 0x3e, 0x40,
-				0x43, 0x44, 0x45, 0x48, 0x49, 0x4c, 0x51, 0x51,
-				0x52, 0x5d, 0x5e, 0x5f, 0x61, 0x63, 0x65, 0x6a,
-				0x6e, 0x71, 0x75, 0x79, 0x7d, 0x82, 0x86, 0x8b,
-				0x8d, 0x8d, 0x96, 0x96, 0x99, 0x9b, 0x9d, 0xa1,
-				0xa3, 0xac, 0xb3, 0xb5, 0xb6, 0xb6, 0xb7, 0xba,
-				0xba, 0xbc, 0xbd, 0xc5, 0xca, 0xcb, 0xce, 0xd1,
-				0xd6, 0xd8, 0xda, 0xdd, 0xde, 0xe6, 0xe7, 0xeb,
-				0xef, 0xef, 0xf3, 0xf4, 0xf9, 0xfe,
-			},
-			{
-				0x02, 0x03, 0x07, 0x12, 0x14, 0x15, 0x15, 0x19,
-				0x1e, 0x1f, 0x21, 0x25, 0x27, 0x27, 0x29, 0x2a,
-				0x2f, 0x31, 0x3b, 0x41, 0x45, 0x46, 0x47, 0x48,
-				0x49, 0x4b, 0x53, 0x58, 0x63, 0x65, 0x69, 0x6d,
-				0x6e, 0x7c, 0x7d, 0x80, 0x81, 0x87, 0x88, 0x89,
-				0x8a, 0x90, 0x93, 0x97, 0x9a, 0x9b, 0x9d, 0xa2,
-				0xa4, 0xa8, 0xa9, 0xa9, 0xab, 0xac, 0xae, 0xae,
-				0xaf, 0xb1, 0xb5, 0xb8, 0xb9, 0xbc, 0xbc, 0xc7,
-				0xc8, 0xc8, 0xc8, 0xd2, 0xd3, 0xd4, 0xd7, 0xdd,
-				0xe0, 0xe4, 0xe4, 0xe5, 0xe5, 0xe8, 0xea, 0xef,
-				0xf0, 0xf5, 0xf8, 0xfa, 0xfa, 0xfb,
-			},
-		},
-
-		// Group 7
-		{
-			{
-				0x00, 0x04, 0x05, 0x06, 0x09, 0x0a, 0x0c, 0x0e,
-				0x19, 0x1e, 0x23, 0x2a, 0x2b, 0x2c, 0x2e, 0x30,
-				0x31, 0x32, 0x34, 0x37, 0x39, 0x3d, 0x40, 0x44,
-				0x49, 0x4f, 0x51, 0x54, 0x55, 0x58, 0x5c, 0x5f,
-				0x61, 0x62, 0x62, 0x64, 0x67, 0x69, 0x6d, 0x70,
-				0x72, 0x75, 0x7a, 0x7d, 0x82, 0x88, 0x90, 0x93,
-				0x94, 0x96, 0x9a, 0x9d, 0x9f, 0xa4, 0xa9, 0xaa,
-				0xac, 0xb1, 0xb2, 0xb5, 0xb6, 0xb8, 0xbb, 0xbd,
-				0xbe, 0xbf, 0xc1, 0xc4, 0xc9, 0xca, 0xcf, 0xcf,
-				0xd3, 0xd3, 0xd4, 0xd5, 0xd8, 0xd9, 0xe5, 0xe5,
-				0xe6, 0xe8, 0xea, 0xeb, 0xf5, 0xfe,
-			},
-			{
-				0x01, 0x02, 0x05, 0x08, 0x09, 0x0a, 0x0b, 0x0c,
-				0x0e, 0x12, 0x15, 0x1c, 0x1c, 0x1f, 0x20, 0x24,
-				0x24, 0x27, 0x29, 0x2a, 0x2c, 0x2d, 0x39, 0x3d,
-				0x43, 0x46, 0x48, 0x4b, 0x50, 0x51, 0x52, 0x55,
-				0x56, 0x57, 0x59, 0x5a, 0x5e, 0x68, 0x69, 0x6a,
-				0x6e, 0x72, 0x73, 0x76, 0x77, 0x79, 0x79, 0x7b,
-				0x7d, 0x7f, 0x80, 0x83, 0x87, 0x89, 0x8c, 0x8d,
-				0x90, 0x97, 0x98, 0xa2, 0xae, 0xb3, 0xb4, 0xb9,
-				0xba, 0xca, 0xcc, 0xcd, 0xce, 0xd0, 0xd2, 0xd4,
-				0xd6, 0xde, 0xe4, 0xee, 0xf0, 0xf1, 0xf1, 0xf5,
-				0xf6, 0xf6, 0xf8, 0xf9, 0xfb, 0xff,
-			},
-			{
-				0x0c, 0x12, 0x15, 0x16, 0x18, 0x19, 0x1a, 0x21,
-				0x24, 0x2b, 0x30, 0x36, 0x3b, 0x3c, 0x3d, 0x41,
-				0x42, 0x42, 0x43, 0x45, 0x47, 0x48, 0x49, 0x4a,
-				0x4d, 0x50, 0x53, 0x5e, 0x63, 0x65, 0x68, 0x71,
-				0x73, 0x74, 0x74, 0x75, 0x76, 0x78, 0x78, 0x7e,
-				0x7e, 0x82, 0x84, 0x85, 0x89, 0x8b, 0x8c, 0x8c,
-				0x91, 0x92, 0x93, 0x95, 0x95, 0x9b, 0x9c, 0xa5,
-				0xa5, 0xa6, 0xab, 0xab, 0xb3, 0xb5, 0xb7, 0xb8,
-				0xbe, 0xc2, 0xc3, 0xc6, 0xc8, 0xc9, 0xd4, 0xd8,
-				0xda, 0xde, 0xdf, 0xe0, 0xe0, 0xe2, 0xe2, 0xe2,
-				0xe3, 0xe7, 0xe9, 0xf7, 0xfa, 0xfe,
-			},
-			{
-				0x03, 0x0e, 0x13, 0x14, 0x17, 0x18, 0x18, 0x1d,
-				0x1e, 0x1f, 0x22, 0x26, 0x2b, 0x2d, 0x2e, 0x34,
-				0x36, 0x38, 0x3a, 0x3e, 0x3f, 0x44, 0x49, 0x4a,
-				0x4a, 0x4b, 0x4c, 0x4c, 0x4e, 0x57, 0x57, 0x5b,
-				0x5d, 0x5f, 0x60, 0x61, 0x63, 0x66, 0x66, 0x6a,
-				0x6d, 0x6e, 0x6f, 0x6f, 0x70, 0x74, 0x7b, 0x80,
-				0x83, 0x85, 0x87, 0x8a, 0x8a, 0x8b, 0x8e, 0x8e,
-				0x91, 0x97, 0x9d, 0xa7, 0xa8, 0xb3, 0xb5, 0xb7,
-				0xba, 0xc0, 0xc1, 0xc5, 0xc6, 0xc7, 0xc9, 0xcb,
-				0xd2, 0xd6, 0xd7, 0xd7, 0xda, 0xdb, 0xdf, 0xe1,
-				0xec, 0xf3, 0xf4, 0xf8, 0xf9, 0xfd,
-			},
-			{
-				0x00, 0x02, 0x04, 0x06, 0x08, 0x0a, 0x0b, 0x0f,
-				0x11, 0x14, 0x16, 0x1a, 0x1b, 0x1e, 0x20, 0x26,
-				0x27, 0x27, 0x2f, 0x2f, 0x31, 0x33, 0x35, 0x38,
-				0x39, 0x3a, 0x3b, 0x41, 0x41, 0x45, 0x47, 0x4d,
-				0x4e, 0x51, 0x53, 0x56, 0x59, 0x5b, 0x60, 0x64,
-				0x65, 0x67, 0x6c, 0x6f, 0x70, 0x72, 0x76, 0x84,
-				0x88, 0x8f, 0x96, 0x9e, 0xa0, 0xa1, 0xa9, 0xaa,
-				0xad, 0xaf, 0xb0, 0xb4, 0xb6, 0xbb, 0xbc, 0xc2,
-				0xc3, 0xc5, 0xc7, 0xce, 0xdd, 0xe4, 0xe5, 0xe7,
-				0xe7, 0xe9, 0xea, 0xed, 0xee, 0xef, 0xf2, 0xf2,
-				0xf3, 0xf4, 0xf5, 0xf8, 0xfc, 0xfe,
-			},
-			{
-				0x01, 0x01, 0x02, 0x07, 0x07, 0x0b, 0x0d, 0x10,
-				0x13, 0x16, 0x17, 0x1b, 0x1b, 0x25, 0x28, 0x35,
-				0x37, 0x3a, 0x3c, 0x3e, 0x40, 0x43, 0x44, 0x4b,
-				0x50, 0x52, 0x55, 0x58, 0x5e, 0x65, 0x6c, 0x6c,
-				0x73, 0x7a, 0x7c, 0x7d, 0x80, 0x81, 0x81, 0x86,
-				0x8f, 0x98, 0x99, 0x99, 0x99, 0x9a, 0x9f, 0xa0,
-				0xa1, 0xa3, 0xa6, 0xac, 0xae, 0xb0, 0xb1, 0xb1,
-				0xb4, 0xbc, 0xbf, 0xc6, 0xc8, 0xcc, 0xcc, 0xcd,
-				0xcd, 0xcf, 0xd1, 0xd5, 0xd8, 0xd9, 0xdc, 0xe1,
-				0xe4, 0xe6, 0xe8, 0xeb, 0xec, 0xef, 0xef, 0xf0,
-				0xf2, 0xfa, 0xfb, 0xfc, 0xfd, 0xff,
-			},
-			{
-				0x03, 0x05, 0x09, 0x0d, 0x0f, 0x10, 0x11, 0x1d,
-				0x1d, 0x21, 0x22, 0x23, 0x25, 0x28, 0x29, 0x29,
-				0x32, 0x33, 0x35, 0x3e, 0x3f, 0x3f, 0x45, 0x46,
-				0x48, 0x4f, 0x54, 0x5a, 0x5b, 0x5c, 0x5d, 0x67,
-				0x6b, 0x6b, 0x71, 0x77, 0x79, 0x7a, 0x7b, 0x7c,
-				0x7f, 0x7f, 0x81, 0x82, 0x85, 0x86, 0x88, 0x8d,
-				0x92, 0x92, 0x94, 0x9b, 0x9c, 0x9e, 0x9f, 0xa2,
-				0xa3, 0xa4, 0xa7, 0xa8, 0xad, 0xae, 0xaf, 0xb0,
-				0xb2, 0xb6, 0xb9, 0xbd, 0xc0, 0xc2, 0xc3, 0xc4,
-				0xc4, 0xcb, 0xce, 0xd0, 0xd1, 0xdb, 0xdc, 0xdd,
-				0xdd, 0xe3, 0xea, 0xed, 0xf7, 0xfc,
-			},
-		},
-
-		// Group 8
-		{
-			{
-				0x03, 0x05, 0x0a, 0x0a, 0x0b, 0x0e, 0x0f, 0x10,
-				0x11, 0x1b, 0x1f, 0x24, 0x26, 0x28, 0x34, 0x36,
-				0x41, 0x48, 0x50, 0x52, 0x53, 0x56, 0x56, 0x57,
-				0x5b, 0x5d, 0x68, 0x6c, 0x6e, 0x70, 0x70, 0x76,
-				0x7a, 0x7c, 0x7d, 0x7e, 0x7f, 0x80, 0x81, 0x8e,
-				0x8f, 0x95, 0x96, 0x98, 0x98, 0x9c, 0x9e, 0xa3,
-				0xa4, 0xa8, 0xa8, 0xa9, 0xaa, 0xac, 0xad, 0xaf,
-				0xb1, 0xb3, 0xb5, 0xba, 0xbd, 0xc4, 0xc5, 0xc7,
-				0xcd, 0xce, 0xce, 0xd2, 0xd5, 0xd7, 0xd9, 0xd9,
-				0xdc, 0xe1, 0xe2, 0xe6, 0xe7, 0xe9, 0xea, 0xec,
-				0xf3, 0xf7, 0xf8, 0xf9, 0xfe, 0xff,
-			},
-			{
-				0x0b, 0x0d, 0x14, 0x16, 0x18, 0x19, 0x1b, 0x1f,
-				0x24, 0x28, 0x2b, 0x2c, 0x2d, 0x2f, 0x30, 0x31,
-				0x33, 0x33, 0x35, 0x39, 0x3c, 0x3e, 0x3f, 0x41,
-				0x43, 0x44, 0x45, 0x4b, 0x4d, 0x4e, 0x50, 0x51,
-				0x52, 0x53, 0x54, 0x54, 0x59, 0x59, 0x5a, 0x5b,
-				0x5e, 0x5f, 0x61, 0x66, 0x69, 0x6a, 0x6f, 0x72,
-				0x73, 0x74, 0x77, 0x7f, 0x80, 0x84, 0x88, 0x8a,
-				0x8c, 0x8d, 0x93, 0x99, 0x9d, 0x9f, 0xb7, 0xb7,
-				0xb7, 0xbf, 0xc0, 0xc8, 0xd2, 0xdd, 0xde, 0xdf,
-				0xe6, 0xe8, 0xeb, 0xed, 0xee, 0xef, 0xf0, 0xf2,
-				0xf3, 0xf6, 0xf6, 0xf7, 0xfc, 0xff,
-			},
-			{
-				0x00, 0x02, 0x02, 0x0e, 0x10, 0x13, 0x17, 0x1a,
-				0x1d, 0x1d, 0x20, 0x27, 0x2b, 0x2e, 0x34, 0x34,
-				0x35, 0x35, 0x38, 0x3e, 0x40, 0x42, 0x42, 0x44,
-				0x45, 0x46, 0x47, 0x47, 0x48, 0x49, 0x5b, 0x5c,
-				0x5f, 0x61, 0x64, 0x64, 0x65, 0x72, 0x73, 0x76,
-				0x76, 0x80, 0x82, 0x88, 0x92, 0x93, 0x94, 0x99,
-				0x9b, 0x9c, 0x9e, 0xa2, 0xa5, 0xa9, 0xab, 0xac,
-				0xae, 0xb0, 0xb3, 0xb4, 0xb9, 0xba, 0xbc, 0xbf,
-				0xc2, 0xc3, 0xc6, 0xc7, 0xc8, 0xc9, 0xd7, 0xd9,
-				0xdb, 0xdd, 0xde, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6,
-				0xe9, 0xef, 0xf0, 0xf1, 0xf8, 0xf9,
-			},
-			{
-				0x01, 0x01, 0x04, 0x09, 0x0d, 0x15, 0x16, 0x17,
-				0x18, 0x1e, 0x23, 0x2d, 0x2d, 0x2e, 0x37, 0x38,
-				0x39, 0x3d, 0x3f, 0x41, 0x45, 0x47, 0x4b, 0x4c,
-				0x53, 0x55, 0x57, 0x5c, 0x63, 0x65, 0x66, 0x6b,
-				0x6c, 0x6d, 0x77, 0x7c, 0x83, 0x89, 0x8a, 0x8b,
-				0x8e, 0x8f, 0x91, 0x92, 0x95, 0x9a, 0x9d, 0xa4,
-				0xa5, 0xa5, 0xa6, 0xaa, 0xaf, 0xb2, 0xb3, 0xb4,
-				0xb5, 0xb9, 0xbc, 0xbd, 0xbe, 0xbe, 0xc4, 0xca,
-				0xcb, 0xcc, 0xd1, 0xd1, 0xd3, 0xd4, 0xda, 0xdb,
-				0xdd, 0xe0, 0xe0, 0xe4, 0xec, 0xed, 0xee, 0xee,
-				0xef, 0xf4, 0xfa, 0xfb, 0xfc, 0xfe,
-			},
-			{
-				0x07, 0x0c, 0x0d, 0x12, 0x1b, 0x22, 0x22, 0x25,
-				0x27, 0x29, 0x29, 0x2c, 0x3a, 0x3c, 0x40, 0x48,
-				0x4a, 0x4a, 0x4a, 0x4c, 0x4d, 0x4e, 0x4f, 0x51,
-				0x55, 0x55, 0x5a, 0x5c, 0x5d, 0x67, 0x6b, 0x6c,
-				0x71, 0x74, 0x75, 0x75, 0x78, 0x78, 0x79, 0x7a,
-				0x7b, 0x7d, 0x83, 0x84, 0x85, 0x86, 0x8a, 0x8d,
-				0x97, 0x97, 0x9a, 0xa0, 0xa1, 0xa3, 0xa6, 0xa6,
-				0xa7, 0xa7, 0xac, 0xb1, 0xb5, 0xb6, 0xb8, 0xb9,
-				0xba, 0xc0, 0xc1, 0xc5, 0xc9, 0xc9, 0xcb, 0xcd,
-				0xcf, 0xcf, 0xd3, 0xd4, 0xd4, 0xd7, 0xd8, 0xd8,
-				0xdb, 0xdf, 0xeb, 0xf2, 0xf5, 0xf8,
-			},
-			{
-				0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x0a,
-				0x11, 0x12, 0x14, 0x15, 0x1a, 0x1c, 0x23, 0x26,
-				0x27, 0x2a, 0x31, 0x32, 0x33, 0x36, 0x39, 0x3c,
-				0x3d, 0x42, 0x44, 0x49, 0x4b, 0x4f, 0x4f, 0x58,
-				0x5e, 0x60, 0x60, 0x62, 0x63, 0x63, 0x64, 0x69,
-				0x69, 0x6b, 0x6d, 0x6d, 0x6e, 0x71, 0x74, 0x7a,
-				0x7b, 0x7e, 0x81, 0x82, 0x84, 0x87, 0x8d, 0x90,
-				0x91, 0x96, 0x9b, 0x9c, 0xa1, 0xa2, 0xa4, 0xa7,
-				0xaf, 0xb6, 0xb8, 0xbb, 0xc2, 0xc6, 0xcb, 0xcc,
-				0xd5, 0xda, 0xdc, 0xe1, 0xe2, 0xe3, 0xe5, 0xe7,
-				0xe8, 0xea, 0xf2, 0xfb, 0xfd, 0xfd,
-			},
-			{
-				0x04, 0x06, 0x08, 0x08, 0x09, 0x0c, 0x0f, 0x13,
-				0x19, 0x1a, 0x1c, 0x1e, 0x20, 0x21, 0x21, 0x22,
-				0x25, 0x28, 0x29, 0x2a, 0x2e, 0x2f, 0x30, 0x32,
-				0x32, 0x37, 0x3a, 0x3b, 0x3b, 0x43, 0x46, 0x50,
-				0x52, 0x58, 0x62, 0x65, 0x67, 0x68, 0x6a, 0x6f,
-				0x79, 0x7e, 0x7f, 0x83, 0x85, 0x86, 0x87, 0x89,
-				0x8b, 0x8c, 0x90, 0x91, 0x93, 0x94, 0x9a, 0x9f,
-				0xa0, 0xa8, 0xa9, 0xaa, 0xab, 0xad, 0xae, 0xb0,
-				0xb2, 0xb2, 0xb6, 0xb8, 0xbb, 0xbc, 0xc1, 0xc3,
-				0xc4, 0xc8, 0xca, 0xd0, 0xd0, 0xd6, 0xd6, 0xec,
-				0xf0, 0xf1, 0xf4, 0xf5, 0xf6, 0xfa,
-			},
-		},
-
-		// Group 9
-		{
-			{
-				0x07, 0x0b, 0x0c, 0x10, 0x10, 0x11, 0x12, 0x14,
-				0x15, 0x17, 0x19, 0x1d, 0x30, 0x33, 0x34, 0x39,
-				0x39, 0x3a, 0x3b, 0x3d, 0x44, 0x46, 0x49, 0x4b,
-				0x50, 0x51, 0x54, 0x58, 0x59, 0x5a, 0x5d, 0x65,
-				0x6c, 0x6d, 0x6f, 0x72, 0x73, 0x74, 0x75, 0x7b,
-				0x7d, 0x7e, 0x7e, 0x7f, 0x7f, 0x81, 0x82, 0x85,
-				0x86, 0x88, 0x8b, 0x90, 0x91, 0x93, 0x94, 0x95,
-				0x97, 0x9a, 0x9d, 0x9e, 0xa6, 0xad, 0xad, 0xb0,
-				0xb1, 0xb3, 0xb6, 0xb8, 0xb8, 0xbb, 0xbd, 0xbd,
-				0xc0, 0xc3, 0xc6, 0xc8, 0xcf, 0xdd, 0xdf, 0xe2,
-				0xe4, 0xe6, 0xeb, 0xf2, 0xf7, 0xfa,
-			},
-			{
-				0x00, 0x01, 0x02, 0x05, 0x0c, 0x0d, 0x0d, 0x13,
-				0x15, 0x1a, 0x1f, 0x21, 0x22, 0x24, 0x25, 0x29,
-				0x2a, 0x2f, 0x31, 0x35, 0x35, 0x37, 0x3e, 0x40,
-				0x41, 0x41, 0x48, 0x4d, 0x57, 0x5d, 0x5f, 0x61,
-				0x6a, 0x6c, 0x6f, 0x71, 0x76, 0x76, 0x77, 0x7c,
-				0x80, 0x83, 0x84, 0x8d, 0x8e, 0x8e, 0x8f, 0x94,
-				0x9b, 0x9c, 0xa0, 0xa2, 0xa3, 0xa5, 0xa9, 0xac,
-				0xaf, 0xb0, 0xb2, 0xbb, 0xbc, 0xbe, 0xbe, 0xbf,
-				0xc0, 0xc0, 0xc5, 0xc5, 0xc7, 0xc9, 0xca, 0xcb,
-				0xd2, 0xd6, 0xd8, 0xdc, 0xde, 0xe2, 0xe4, 0xe7,
-				0xe9, 0xea, 0xf0, 0xf6, 0xf8, 0xfc,
-			},
-			{
-				0x00, 0x03, 0x06, 0x08, 0x09, 0x09, 0x0b, 0x0e,
-				0x0f, 0x11, 0x14, 0x1a, 0x1b, 0x29, 0x2b, 0x2b,
-				0x30, 0x38, 0x3c, 0x3f, 0x45, 0x47, 0x49, 0x4a,
-				0x52, 0x55, 0x56, 0x57, 0x58, 0x5c, 0x62, 0x63,
-				0x64, 0x66, 0x67, 0x6b, 0x6e, 0x71, 0x79, 0x87,
-				0x89, 0x89, 0x8b, 0x8c, 0x93, 0x96, 0x96, 0x98,
-				0x98, 0x98, 0x9c, 0xa1, 0xa1, 0xa3, 0xa4, 0xa4,
-				0xa9, 0xb3, 0xb4, 0xbf, 0xc2, 0xc2, 0xc7, 0xcf,
-				0xd1, 0xd1, 0xd3, 0xd5, 0xd9, 0xda, 0xde, 0xe1,
-				0xe3, 0xe3, 0xe5, 0xe8, 0xec, 0xec, 0xec, 0xef,
-				0xef, 0xf3, 0xf4, 0xf4, 0xf5, 0xfe,
-			},
-			{
-				0x03, 0x04, 0x04, 0x06, 0x0b, 0x0c, 0x0f, 0x0f,
-				0x16, 0x17, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x20,
-				0x22, 0x28, 0x2e, 0x2f, 0x32, 0x34, 0x35, 0x36,
-				0x37, 0x3b, 0x3f, 0x43, 0x44, 0x48, 0x4e, 0x4f,
-				0x50, 0x52, 0x69, 0x69, 0x6a, 0x6c, 0x6e, 0x73,
-				0x75, 0x78, 0x7a, 0x7b, 0x80, 0x83, 0x85, 0x87,
-				0x8b, 0x92, 0x97, 0x9d, 0x9f, 0xa2, 0xa5, 0xa7,
-				0xae, 0xae, 0xb2, 0xb5, 0xb7, 0xb9, 0xbd, 0xc3,
-				0xc5, 0xc9, 0xcb, 0xd3, 0xd4, 0xd5, 0xd7, 0xd8,
-				0xd9, 0xda, 0xe0, 0xe0, 0xe2, 0xe6, 0xea, 0xed,
-				0xed, 0xef, 0xf9, 0xf9, 0xfd, 0xfe,
-			},
-			{
-				0x0a, 0x0d, 0x10, 0x13, 0x14, 0x18, 0x1b, 0x1d,
-				0x1e, 0x1f, 0x20, 0x23, 0x29, 0x2c, 0x2d, 0x2e,
-				0x33, 0x3c, 0x3e, 0x42, 0x4b, 0x4c, 0x4d, 0x4f,
-				0x55, 0x56, 0x5a, 0x5b, 0x5c, 0x60, 0x63, 0x64,
-				0x67, 0x6d, 0x6e, 0x70, 0x72, 0x72, 0x75, 0x79,
-				0x7a, 0x7f, 0x81, 0x87, 0x88, 0x8c, 0x8d, 0x92,
-				0x96, 0x9e, 0x9f, 0xa6, 0xa7, 0xab, 0xaf, 0xb6,
-				0xb7, 0xb9, 0xb9, 0xba, 0xc1, 0xc4, 0xca, 0xcd,
-				0xce, 0xd2, 0xd4, 0xd7, 0xdd, 0xdd, 0xdf, 0xe5,
-				0xe6, 0xe9, 0xee, 0xf0, 0xf2, 0xf2, 0xf3, 0xf5,
-				0xf7, 0xf9, 0xfa, 0xfb, 0xfc, 0xff,
-			},
-			{
-				0x00, 0x02, 0x02, 0x05, 0x06, 0x07, 0x08, 0x09,
-				0x0e, 0x12, 0x13, 0x19, 0x1f, 0x23, 0x26, 0x26,
-				0x27, 0x28, 0x2c, 0x2d, 0x2d, 0x2e, 0x31, 0x33,
-				0x3d, 0x40, 0x42, 0x43, 0x45, 0x46, 0x47, 0x4a,
-				0x4d, 0x51, 0x52, 0x59, 0x59, 0x5c, 0x5d, 0x5e,
-				0x5e, 0x60, 0x68, 0x6b, 0x74, 0x77, 0x7a, 0x7d,
-				0x82, 0x8d, 0x8f, 0x95, 0x9a, 0xa0, 0xa1, 0xa4,
-				0xa8, 0xaa, 0xac, 0xb0, 0xb1, 0xb4, 0xba, 0xc1,
-				0xc2, 0xc4, 0xc8, 0xcc, 0xcc, 0xcd, 0xce, 0xd6,
-				0xdb, 0xdc, 0xe1, 0xe3, 0xe7, 0xed, 0xee, 0xf1,
-				0xf1, 0xf6, 0xfc, 0xfd, 0xfd, 0xff,
-			},
-			{
-				0x01, 0x03, 0x04, 0x0a, 0x0a, 0x16, 0x16, 0x18,
-				0x18, 0x1c, 0x1e, 0x21, 0x24, 0x25, 0x25, 0x27,
-				0x2a, 0x2a, 0x32, 0x36, 0x38, 0x3a, 0x41, 0x46,
-				0x4c, 0x4c, 0x4e, 0x53, 0x53, 0x54, 0x5a, 0x5b,
-				0x5f, 0x61, 0x62, 0x65, 0x66, 0x68, 0x70, 0x78,
-				0x7c, 0x82, 0x84, 0x86, 0x89, 0x8a, 0x8a, 0x90,
-				0x91, 0x94, 0x97, 0x99, 0x99, 0x9b, 0x9e, 0xa0,
-				0xa5, 0xa8, 0xa9, 0xaa, 0xab, 0xb5, 0xba, 0xbc,
-				0xbe, 0xc4, 0xc6, 0xc8, 0xcb, 0xce, 0xd0, 0xd0,
-				0xd5, 0xd7, 0xd8, 0xdb, 0xde, 0xe4, 0xe7, 0xe8,
-				0xeb, 0xeb, 0xf5, 0xf8, 0xfb, 0xfe,
-			},
-		},
-
-		// Group 10
-		{
-			{
-				0x04, 0x06, 0x06, 0x0a, 0x0b, 0x0d, 0x15, 0x16,
-				0x19, 0x1d, 0x28, 0x2b, 0x2e, 0x2f, 0x32, 0x34,
-				0x35, 0x39, 0x39, 0x3b, 0x3c, 0x3d, 0x3f, 0x43,
-				0x4a, 0x4c, 0x51, 0x61, 0x63, 0x66, 0x68, 0x69,
-				0x6a, 0x6b, 0x6e, 0x7d, 0x7e, 0x7e, 0x81, 0x82,
-				0x84, 0x86, 0x8a, 0x8b, 0x8b, 0x93, 0x96, 0x9a,
-				0x9f, 0xa3, 0xa4, 0xa6, 0xa6, 0xaf, 0xb4, 0xb6,
-				0xb7, 0xb8, 0xba, 0xbd, 0xc0, 0xc0, 0xc2, 0xc6,
-				0xc8, 0xca, 0xcb, 0xcd, 0xd6, 0xd6, 0xd7, 0xd8,
-				0xd8, 0xdf, 0xe0, 0xe2, 0xe7, 0xe8, 0xe8, 0xe9,
-				0xed, 0xf1, 0xf3, 0xf6, 0xf8, 0xfa,
-			},
-			{
-				0x07, 0x08, 0x09, 0x09, 0x0c, 0x0e, 0x0f, 0x14,
-				0x15, 0x1a, 0x1d, 0x20, 0x21, 0x24, 0x25, 0x29,
-				0x2a, 0x2c, 0x35, 0x36, 0x3c, 0x3e, 0x40, 0x40,
-				0x42, 0x48, 0x4a, 0x51, 0x62, 0x63, 0x69, 0x6a,
-				0x6e, 0x71, 0x72, 0x73, 0x74, 0x76, 0x78, 0x7a,
-				0x7c, 0x80, 0x88, 0x8c, 0x8c, 0x8d, 0x8e, 0x8f,
-				0x8f, 0x92, 0x92, 0x94, 0x95, 0x9b, 0x9e, 0xa4,
-				0xa5, 0xa7, 0xa7, 0xab, 0xae, 0xb3, 0xb5, 0xb8,
-				0xbb, 0xbd, 0xbe, 0xc2, 0xc9, 0xd1, 0xd5, 0xdb,
-				0xdc, 0xdd, 0xe6, 0xe9, 0xe9, 0xea, 0xf0, 0xf0,
-				0xf3, 0xf5, 0xf7, 0xf9, 0xf9, 0xfe,
-			},
-			{
-				0x02, 0x02, 0x03, 0x12, 0x13, 0x13, 0x13, 0x16,
-				0x16, 0x1c, 0x1e, 0x21, 0x22, 0x26, 0x28, 0x2b,
-				0x2f, 0x32, 0x34, 0x36, 0x39, 0x3f, 0x42, 0x45,
-				0x45, 0x4c, 0x50, 0x53, 0x58, 0x59, 0x5a, 0x60,
-				0x60, 0x61, 0x63, 0x65, 0x67, 0x69, 0x6f, 0x70,
-				0x72, 0x73, 0x74, 0x76, 0x77, 0x7c, 0x7f, 0x84,
-				0x93, 0x95, 0x9a, 0x9d, 0x9e, 0xa3, 0xa6, 0xa8,
-				0xac, 0xad, 0xb0, 0xb2, 0xb5, 0xb9, 0xbb, 0xbf,
-				0xc3, 0xc4, 0xc5, 0xcc, 0xce, 0xcf, 0xd2, 0xd4,
-				0xd5, 0xd8, 0xe2, 0xe3, 0xe3, 0xe5, 0xe8, 0xeb,
-				0xf1, 0xf2, 0xfb, 0xfd, 0xfe, 0xff,
-			},
-			{
-				0x00, 0x01, 0x05, 0x0f, 0x10, 0x14, 0x17, 0x18,
-				0x1a, 0x1f, 0x1f, 0x21, 0x23, 0x23, 0x27, 0x2a,
-				0x2a, 0x2d, 0x31, 0x31, 0x3c, 0x43, 0x44, 0x46,
-				0x47, 0x48, 0x4d, 0x52, 0x56, 0x57, 0x5b, 0x5c,
-				0x5d, 0x5e, 0x5f, 0x62, 0x67, 0x6b, 0x6c, 0x6d,
-				0x6d, 0x75, 0x7d, 0x7f, 0x85, 0x86, 0x8d, 0x8e,
-				0x8f, 0x91, 0x9c, 0x9d, 0x9f, 0xa1, 0xa1, 0xa8,
-				0xa9, 0xaf, 0xb1, 0xb3, 0xb3, 0xb5, 0xb7, 0xc1,
-				0xc1, 0xc2, 0xc4, 0xc4, 0xc5, 0xc6, 0xce, 0xd0,
-				0xd0, 0xd0, 0xd3, 0xdc, 0xde, 0xde, 0xe0, 0xe5,
-				0xec, 0xee, 0xee, 0xee, 0xf4, 0xf6,
-			},
-			{
-				0x04, 0x0d, 0x10, 0x12, 0x14, 0x19, 0x1a, 0x1c,
-				0x1d, 0x22, 0x24, 0x26, 0x27, 0x27, 0x2e, 0x2e,
-				0x30, 0x34, 0x36, 0x3a, 0x3a, 0x3e, 0x41, 0x42,
-				0x47, 0x49, 0x4a, 0x4b, 0x4e, 0x4f, 0x52, 0x54,
-				0x56, 0x57, 0x5b, 0x5c, 0x62, 0x64, 0x64, 0x65,
-				0x6d, 0x71, 0x74, 0x77, 0x79, 0x79, 0x7e, 0x7f,
-				0x80, 0x82, 0x84, 0x87, 0x96, 0x98, 0x9b, 0xa0,
-				0xaa, 0xaa, 0xab, 0xad, 0xaf, 0xb4, 0xb9, 0xbc,
-				0xbc, 0xbe, 0xc7, 0xc9, 0xca, 0xcb, 0xcd, 0xcf,
-				0xcf, 0xd2, 0xd4, 0xd7, 0xd9, 0xe1, 0xe6, 0xf2,
-				0xf7, 0xf8, 0xfa, 0xfc, 0xfd, 0xff,
-			},
-			{
-				0x00, 0x00, 0x03, 0x05, 0x06, 0x0a, 0x0b, 0x0b,
-				0x17, 0x1b, 0x1b, 0x1c, 0x1e, 0x25, 0x33, 0x38,
-				0x3a, 0x3b, 0x3d, 0x46, 0x49, 0x4e, 0x55, 0x57,
-				0x58, 0x59, 0x5e, 0x5f, 0x66, 0x6c, 0x71, 0x78,
-				0x7b, 0x7c, 0x81, 0x83, 0x88, 0x89, 0x89, 0x90,
-				0x90, 0x91, 0x91, 0x97, 0x98, 0x99, 0x99, 0x9a,
-				0x9e, 0xa1, 0xa2, 0xa2, 0xa5, 0xa9, 0xb0, 0xb1,
-				0xb2, 0xb6, 0xb9, 0xba, 0xbb, 0xc5, 0xc7, 0xcc,
-				0xcd, 0xd1, 0xd2, 0xd3, 0xdb, 0xdf, 0xe0, 0xe1,
-				0xe1, 0xe3, 0xe6, 0xec, 0xed, 0xef, 0xf3, 0xf5,
-				0xf5, 0xf7, 0xfa, 0xfb, 0xfc, 0xff,
-			},
-			{
-				0x01, 0x05, 0x07, 0x08, 0x0c, 0x0e, 0x11, 0x11,
-				0x18, 0x1f, 0x20, 0x25, 0x26, 0x29, 0x29, 0x2c,
-				0x2c, 0x2d, 0x30, 0x30, 0x33, 0x35, 0x37, 0x37,
-				0x38, 0x3e, 0x41, 0x44, 0x44, 0x4b, 0x4d, 0x4d,
-				0x4f, 0x50, 0x53, 0x54, 0x55, 0x58, 0x5a, 0x5d,
-				0x5e, 0x61, 0x66, 0x67, 0x68, 0x6b, 0x6f, 0x70,
-				0x75, 0x75, 0x7a, 0x7b, 0x83, 0x85, 0x87, 0x8a,
-				0x94, 0x97, 0x9b, 0x9c, 0xa0, 0xa9, 0xab, 0xac,
-				0xae, 0xb2, 0xbd, 0xbf, 0xc3, 0xc8, 0xc8, 0xd9,
-				0xda, 0xda, 0xda, 0xdd, 0xdf, 0xe4, 0xe4, 0xe7,
-				0xea, 0xeb, 0xef, 0xf4, 0xf9, 0xfb,
-			},
-		},
-
-		// Group 11
-		{
-			{
-				0x00, 0x05, 0x06, 0x06, 0x09, 0x0e, 0x11, 0x14,
-				0x16, 0x19, 0x19, 0x19, 0x1a, 0x1d, 0x21, 0x22,
-				0x2d, 0x37, 0x38, 0x44, 0x46, 0x4b, 0x4b, 0x4f,
-				0x50, 0x51, 0x55, 0x56, 0x58, 0x62, 0x63, 0x64,
-				0x65, 0x67, 0x68, 0x69, 0x69, 0x6b, 0x79, 0x7a,
-				0x7f, 0x83, 0x86, 0x87, 0x8d, 0x8f, 0x92, 0x96,
-				0x98, 0x99, 0x9b, 0x9c, 0xa1, 0xa4, 0xa7, 0xab,
-				0xac, 0xaf, 0xb4, 0xb8, 0xbb, 0xbb, 0xbd, 0xc1,
-				0xc4, 0xcb, 0xce, 0xd0, 0xd1, 0xd4, 0xd6, 0xda,
-				0xda, 0xdd, 0xdf, 0xe0, 0xe7, 0xe8, 0xeb, 0xec,
-				0xef, 0xf1, 0xf1, 0xf2, 0xf3, 0xf4,
-			},
-			{
-				0x01, 0x03, 0x07, 0x0a, 0x0b, 0x0f, 0x10, 0x12,
-				0x13, 0x13, 0x14, 0x23, 0x25, 0x26, 0x27, 0x2e,
-				0x30, 0x30, 0x3a, 0x3b, 0x40, 0x42, 0x42, 0x44,
-				0x46, 0x4a, 0x4c, 0x4e, 0x54, 0x55, 0x58, 0x59,
-				0x5d, 0x5e, 0x5f, 0x62, 0x64, 0x72, 0x74, 0x76,
-				0x76, 0x78, 0x7e, 0x82, 0x83, 0x84, 0x89, 0x89,
-				0x8a, 0x93, 0x97, 0x98, 0x9f, 0xa3, 0xad, 0xad,
-				0xae, 0xae, 0xb0, 0xb0, 0xb2, 0xb3, 0xb6, 0xb7,
-				0xbd, 0xbd, 0xbe, 0xc5, 0xc6, 0xc7, 0xc7, 0xca,
-				0xd2, 0xd2, 0xd6, 0xd9, 0xdb, 0xde, 0xe0, 0xe2,
-				0xe4, 0xe6, 0xe9, 0xf5, 0xf8, 0xfd,
-			},
-			{
-				0x02, 0x09, 0x15, 0x15, 0x17, 0x1a, 0x1d, 0x1e,
-				0x20, 0x20, 0x24, 0x2a, 0x2b, 0x2c, 0x2e, 0x31,
-				0x35, 0x36, 0x38, 0x3f, 0x40, 0x41, 0x43, 0x4d,
-				0x51, 0x52, 0x52, 0x54, 0x58, 0x5a, 0x5c, 0x60,
-				0x61, 0x61, 0x6e, 0x6f, 0x72, 0x76, 0x77, 0x7b,
-				0x7b, 0x7b, 0x7d, 0x7d, 0x80, 0x8a, 0x8e, 0x93,
-				0x96, 0x97, 0x99, 0x99, 0x9a, 0x9a, 0x9d, 0x9f,
-				0xa0, 0xa2, 0xa4, 0xa6, 0xaa, 0xaf, 0xb1, 0xb9,
-				0xbc, 0xc0, 0xc4, 0xca, 0xcc, 0xcf, 0xd1, 0xd3,
-				0xd5, 0xd5, 0xd9, 0xe6, 0xe8, 0xed, 0xee, 0xef,
-				0xf0, 0xf7, 0xf8, 0xf8, 0xfb, 0xff,
-			},
-			{
-				0x01, 0x04, 0x0d, 0x12, 0x1b, 0x1c, 0x1f, 0x20,
-				0x21, 0x25, 0x29, 0x2c, 0x31, 0x32, 0x33, 0x34,
-				0x35, 0x37, 0x38, 0x39, 0x3e, 0x3e, 0x45, 0x4a,
-				0x4f, 0x5c, 0x5d, 0x60, 0x64, 0x67, 0x6a, 0x6a,
-				0x6b, 0x6c, 0x71, 0x73, 0x7c, 0x7d, 0x81, 0x83,
-				0x85, 0x86, 0x88, 0x89, 0x8b, 0x8b, 0x8e, 0x95,
-				0x9e, 0xa5, 0xa5, 0xa6, 0xa8, 0xa9, 0xab, 0xb1,
-				0xb2, 0xb4, 0xb9, 0xbb, 0xc0, 0xc2, 0xc3, 0xc4,
-				0xc8, 0xc9, 0xce, 0xcf, 0xd0, 0xda, 0xdb, 0xde,
-				0xe0, 0xe3, 0xe4, 0xe7, 0xe9, 0xec, 0xed, 0xee,
-				0xee, 0xf3, 0xf6, 0xfc, 0xfe, 0xfe,
-			},
-			{
-				0x00, 0x02, 0x03, 0x04, 0x06, 0x10, 0x1b, 0x1f,
-				0x22, 0x23, 0x24, 0x26, 0x28, 0x29, 0x2a, 0x2b,
-				0x31, 0x36, 0x37, 0x3a, 0x3d, 0x3d, 0x3e, 0x3f,
-				0x40, 0x41, 0x45, 0x46, 0x47, 0x49, 0x4d, 0x53,
-				0x53, 0x57, 0x5a, 0x5b, 0x5b, 0x63, 0x65, 0x66,
-				0x67, 0x6d, 0x70, 0x70, 0x73, 0x74, 0x77, 0x78,
-				0x79, 0x80, 0x81, 0x85, 0x87, 0x8c, 0x8d, 0x8f,
-				0x91, 0x94, 0x95, 0x9b, 0xa0, 0xa9, 0xaa, 0xad,
-				0xae, 0xaf, 0xb5, 0xba, 0xbf, 0xc3, 0xc8, 0xcb,
-				0xcd, 0xce, 0xdc, 0xe4, 0xed, 0xf3, 0xf6, 0xf7,
-				0xf9, 0xfa, 0xfb, 0xfd, 0xff, 0xff,
-			},
-			{
-				0x03, 0x07, 0x07, 0x08, 0x0a, 0x0b, 0x0d, 0x0f,
-				0x17, 0x18, 0x18, 0x1b, 0x1e, 0x21, 0x27, 0x29,
-				0x2c, 0x39, 0x3b, 0x3c, 0x3c, 0x3f, 0x47, 0x48,
-				0x4b, 0x4c, 0x4d, 0x4e, 0x4f, 0x52, 0x54, 0x56,
-				0x57, 0x57, 0x5d, 0x5e, 0x5e, 0x66, 0x68, 0x6c,
-				0x6f, 0x71, 0x74, 0x7c, 0x7f, 0x84, 0x85, 0x88,
-				0x90, 0x9b, 0x9d, 0x9d, 0xa2, 0xa4, 0xa7, 0xa8,
-				0xa9, 0xac, 0xb8, 0xba, 0xbe, 0xbf, 0xc0, 0xc5,
-				0xc6, 0xc7, 0xc8, 0xcb, 0xcc, 0xcd, 0xd3, 0xd4,
-				0xd7, 0xd7, 0xd8, 0xd8, 0xe1, 0xe5, 0xe5, 0xe8,
-				0xea, 0xea, 0xeb, 0xef, 0xf0, 0xfa,
-			},
-			{
-				0x04, 0x05, 0x08, 0x08, 0x0c, 0x0c, 0x0e, 0x0f,
-				0x11, 0x16, 0x1c, 0x25, 0x26, 0x28, 0x2d, 0x2d,
-				0x2f, 0x2f, 0x32, 0x33, 0x34, 0x36, 0x3c, 0x41,
-				0x43, 0x48, 0x49, 0x4a, 0x50, 0x56, 0x59, 0x5f,
-				0x62, 0x66, 0x6b, 0x6d, 0x6e, 0x75, 0x75, 0x79,
-				0x7a, 0x7e, 0x82, 0x8a, 0x8c, 0x8c, 0x8f, 0x90,
-				0x90, 0x91, 0x92, 0x92, 0x94, 0x96, 0x97, 0x9a,
-				0x9c, 0x9e, 0xa1, 0xa3, 0xb0, 0xb3, 0xb5, 0xb6,
-				0xb7, 0xbc, 0xc1, 0xc2, 0xc5, 0xc9, 0xcc, 0xdc,
-				0xdd, 0xdf, 0xe1, 0xe2, 0xe3, 0xf2, 0xf2, 0xf4,
-				0xf5, 0xf7, 0xf9, 0xfa, 0xfc, 0xfe,
-			},
-		},
-
-		// Group 12
-		{
-			{
-				0x05, 0x06, 0x0a, 0x0f, 0x10, 0x13, 0x16, 0x18,
-				0x1a, 0x1a, 0x1b, 0x21, 0x25, 0x26, 0x29, 0x2c,
-				0x2e, 0x38, 0x39, 0x41, 0x46, 0x46, 0x49, 0x49,
-				0x4a, 0x4c, 0x4f, 0x50, 0x52, 0x56, 0x59, 0x60,
-				0x62, 0x64, 0x67, 0x68, 0x71, 0x73, 0x75, 0x7a,
-				0x7b, 0x7c, 0x80, 0x8a, 0x8c, 0x8e, 0x8f, 0x91,
-				0x93, 0x96, 0x97, 0x9a, 0x9c, 0x9c, 0x9d, 0x9f,
-				0xa0, 0xaa, 0xad, 0xb3, 0xb6, 0xbb, 0xbd, 0xc0,
-				0xc1, 0xc3, 0xc5, 0xc8, 0xd2, 0xd4, 0xd4, 0xd5,
-				0xd6, 0xda, 0xe4, 0xe6, 0xe6, 0xea, 0xed, 0xf1,
-				0xf5, 0xf7, 0xfa, 0xfb, 0xfc, 0xff,
-			},
-			{
-				0x01, 0x02, 0x03, 0x08, 0x0a, 0x0b, 0x0d, 0x0e,
-				0x0f, 0x11, 0x14, 0x17, 0x1e, 0x22, 0x22, 0x29,
-				0x2a, 0x2e, 0x2f, 0x2f, 0x31, 0x31, 0x33, 0x34,
-				0x34, 0x38, 0x3b, 0x4a, 0x4b, 0x4e, 0x52, 0x53,
-				0x54, 0x55, 0x57, 0x58, 0x5f, 0x5f, 0x60, 0x64,
-				0x66, 0x70, 0x71, 0x73, 0x7c, 0x7f, 0x85, 0x89,
-				0x90, 0x90, 0x95, 0x9b, 0x9b, 0xa1, 0xa4, 0xa4,
-				0xa5, 0xa7, 0xaf, 0xb0, 0xb2, 0xb4, 0xb4, 0xb5,
-				0xb7, 0xb8, 0xb8, 0xba, 0xbc, 0xc0, 0xce, 0xcf,
-				0xd0, 0xd2, 0xd8, 0xd9, 0xdc, 0xe6, 0xe8, 0xec,
-				0xf0, 0xf9, 0xfa, 0xfd, 0xfe, 0xff,
-			},
-			{
-				0x08, 0x09, 0x10, 0x13, 0x1b, 0x22, 0x23, 0x25,
-				0x25, 0x29, 0x2c, 0x32, 0x35, 0x39, 0x3a, 0x3b,
-				0x3c, 0x3f, 0x41, 0x42, 0x45, 0x50, 0x51, 0x51,
-				0x56, 0x57, 0x5a, 0x5a, 0x5c, 0x62, 0x69, 0x6a,
-				0x6b, 0x70, 0x72, 0x72, 0x75, 0x78, 0x7b, 0x7e,
-				0x7e, 0x82, 0x86, 0x87, 0x87, 0x8f, 0x94, 0x95,
-				0x9a, 0x9c, 0x9e, 0xa2, 0xa3, 0xa4, 0xa8, 0xac,
-				0xac, 0xae, 0xb3, 0xb9, 0xbd, 0xbe, 0xbf, 0xc2,
-				0xc3, 0xc4, 0xc6, 0xc6, 0xc9, 0xd0, 0xd6, 0xd8,
-				0xd9, 0xde, 0xdf, 0xe1, 0xe9, 0xeb, 0xec, 0xee,
-				0xf2, 0xf3, 0xf4, 0xf6, 0xf7, 0xfb,
-			},
-			{
-				0x00, 0x0d, 0x12, 0x12, 0x14, 0x1f, 0x1f, 0x27,
-				0x28, 0x2a, 0x2b, 0x31, 0x32, 0x37, 0x42, 0x44,
-				0x47, 0x4b, 0x52, 0x59, 0x5c, 0x5e, 0x63, 0x63,
-				0x64, 0x65, 0x67, 0x68, 0x6a, 0x6c, 0x6f, 0x74,
-				0x79, 0x7d, 0x82, 0x82, 0x83, 0x86, 0x88, 0x8a,
-				0x8b, 0x8d, 0x94, 0x99, 0x9d, 0xa1, 0xa2, 0xa3,
-				0xa3, 0xa6, 0xa7, 0xa8, 0xa9, 0xb1, 0xb2, 0xb5,
-				0xb6, 0xba, 0xbb, 0xbc, 0xbe, 0xbe, 0xc2, 0xcc,
-				0xcd, 0xd6, 0xd7, 0xda, 0xdb, 0xdc, 0xdd, 0xe0,
-				0xe1, 0xe1, 0xe2, 0xe3, 0xe7, 0xe9, 0xeb, 0xef,
-				0xf5, 0xf6, 0xf8, 0xfb, 0xfd, 0xfe,
-			},
-			{
-				0x03, 0x06, 0x07, 0x07, 0x09, 0x0b, 0x0e, 0x15,
-				0x16, 0x17, 0x19, 0x1c, 0x26, 0x27, 0x2d, 0x2d,
-				0x30, 0x30, 0x33, 0x37, 0x39, 0x3a, 0x3a, 0x3b,
-				0x3d, 0x3f, 0x44, 0x4e, 0x4f, 0x4f, 0x53, 0x55,
-				0x5b, 0x5d, 0x61, 0x63, 0x6a, 0x6d, 0x6e, 0x73,
-				0x74, 0x76, 0x76, 0x7b, 0x7d, 0x7f, 0x83, 0x91,
-				0x92, 0x92, 0x92, 0x93, 0x95, 0x98, 0x98, 0x9a,
-				0x9e, 0xaa, 0xab, 0xad, 0xaf, 0xb0, 0xb7, 0xc2,
-				0xc6, 0xc7, 0xca, 0xca, 0xd3, 0xd3, 0xdb, 0xe3,
-				0xe4, 0xe5, 0xe5, 0xe8, 0xea, 0xec, 0xed, 0xef,
-				0xf1, 0xf2, 0xf4, 0xf7, 0xf9, 0xfc,
-			},
-			{
-				0x01, 0x02, 0x04, 0x05, 0x0b, 0x0c, 0x11, 0x11,
-				0x15, 0x18, 0x1a, 0x1d, 0x20, 0x21, 0x23, 0x24,
-				0x28, 0x28, 0x2b, 0x2b, 0x35, 0x36, 0x36, 0x36,
-				0x3c, 0x3e, 0x3f, 0x40, 0x43, 0x45, 0x47, 0x48,
-				0x4c, 0x4d, 0x4d, 0x54, 0x57, 0x58, 0x5b, 0x65,
-				0x66, 0x69, 0x6d, 0x6f, 0x75, 0x76, 0x77, 0x78,
-				0x7d, 0x7f, 0x81, 0x83, 0x89, 0x8c, 0x8d, 0x8e,
-				0x91, 0x96, 0x99, 0x9f, 0xa0, 0xa5, 0xa5, 0xa9,
-				0xac, 0xc1, 0xc4, 0xc7, 0xc8, 0xcb, 0xcd, 0xce,
-				0xcf, 0xd7, 0xdd, 0xdf, 0xdf, 0xe0, 0xe2, 0xe7,
-				0xe9, 0xee, 0xf3, 0xf5, 0xf8, 0xfd,
-			},
-			{
-				0x00, 0x02, 0x03, 0x04, 0x0c, 0x0e, 0x0f, 0x12,
-				0x19, 0x1c, 0x1d, 0x1e, 0x1e, 0x1f, 0x20, 0x21,
-				0x24, 0x27, 0x2f, 0x30, 0x3d, 0x3e, 0x40, 0x41,
-				0x43, 0x43, 0x45, 0x46, 0x48, 0x53, 0x54, 0x59,
-				0x5d, 0x5e, 0x5f, 0x61, 0x61, 0x6b, 0x6c, 0x6e,
-				0x77, 0x79, 0x7a, 0x80, 0x81, 0x84, 0x84, 0x85,
-				0x88, 0x8a, 0x8b, 0x8f, 0x93, 0x97, 0x97, 0x9d,
-				0xa6, 0xa6, 0xa9, 0xab, 0xad, 0xae, 0xaf, 0xb1,
-				0xb9, 0xba, 0xbb, 0xbf, 0xc3, 0xc5, 0xc5, 0xc9,
-				0xcb, 0xcc, 0xcc, 0xcd, 0xd1, 0xd1, 0xd2, 0xd5,
-				0xdb, 0xdd, 0xde, 0xf0, 0xf1, 0xf2,
-			},
-		},
-
-		// Group 13
-		{
-			{
-				0x00, 0x04, 0x06, 0x08, 0x08, 0x0f, 0x13, 0x13,
-				0x15, 0x1a, 0x1e, 0x1f, 0x24, 0x24, 0x28, 0x2c,
-				0x2d, 0x2e, 0x32, 0x39, 0x46, 0x50, 0x53, 0x57,
-				0x59, 0x5a, 0x5c, 0x61, 0x61, 0x62, 0x64, 0x64,
-				0x68, 0x6c, 0x6d, 0x6e, 0x73, 0x74, 0x75, 0x77,
-				0x7a, 0x7c, 0x7d, 0x7e, 0x84, 0x85, 0x86, 0x89,
-				0x90, 0x91, 0x96, 0x99, 0x9b, 0x9f, 0xa0, 0xa4,
-				0xa5, 0xa7, 0xae, 0xb6, 0xb6, 0xbd, 0xbe, 0xbf,
-				0xc5, 0xc9, 0xcc, 0xcd, 0xce, 0xce, 0xd2, 0xd3,
-				0xe1, 0xe1, 0xe5, 0xea, 0xec, 0xed, 0xf2, 0xf3,
-				0xf9, 0xfb, 0xfc, 0xfd, 0xfe, 0xff,
-			},
-			{
-				0x00, 0x02, 0x06, 0x09, 0x0a, 0x0e, 0x10, 0x10,
-				0x19, 0x1d, 0x1e, 0x27, 0x2b, 0x2f, 0x2f, 0x33,
-				0x36, 0x3c, 0x42, 0x44, 0x49, 0x4b, 0x4d, 0x4f,
-				0x51, 0x51, 0x52, 0x55, 0x63, 0x67, 0x67, 0x67,
-				0x6a, 0x6d, 0x6e, 0x6f, 0x72, 0x80, 0x81, 0x82,
-				0x83, 0x83, 0x8f, 0x90, 0x96, 0x97, 0x97, 0x98,
-				0xa3, 0xa9, 0xaa, 0xae, 0xb1, 0xb2, 0xb3, 0xb8,
-				0xb9, 0xbc, 0xbe, 0xbf, 0xc6, 0xc7, 0xca, 0xcb,
-				0xcc, 0xce, 0xd3, 0xd7, 0xd9, 0xd9, 0xdc, 0xde,
-				0xdf, 0xe3, 0xe3, 0xe4, 0xe5, 0xe9, 0xe9, 0xeb,
-				0xf0, 0xf2, 0xf4, 0xf7, 0xf9, 0xfa,
-			},
-			{
-				0x01, 0x03, 0x05, 0x0a, 0x12, 0x12, 0x14, 0x15,
-				0x18, 0x1c, 0x1d, 0x1e, 0x28, 0x28, 0x2a, 0x2a,
-				0x33, 0x35, 0x36, 0x3e, 0x41, 0x44, 0x48, 0x4a,
-				0x4b, 0x4d, 0x50, 0x52, 0x54, 0x56, 0x58, 0x58,
-				0x5c, 0x5f, 0x60, 0x65, 0x69, 0x6f, 0x74, 0x79,
-				0x7b, 0x7e, 0x81, 0x84, 0x87, 0x89, 0x8a, 0x91,
-				0x91, 0x92, 0x9d, 0x9e, 0xa5, 0xa6, 0xa6, 0xa7,
-				0xaa, 0xab, 0xad, 0xb0, 0xb0, 0xb1, 0xb3, 0xb5,
-				0xbb, 0xbc, 0xbd, 0xc3, 0xc3, 0xc4, 0xc8, 0xc8,
-				0xca, 0xcc, 0xcd, 0xd2, 0xdb, 0xdd, 0xde, 0xdf,
-				0xe4, 0xe8, 0xec, 0xef, 0xf0, 0xfe,
-			},
-			{
-				0x01, 0x04, 0x05, 0x09, 0x0e, 0x0f, 0x11, 0x14,
-				0x18, 0x19, 0x1f, 0x20, 0x20, 0x25, 0x2e, 0x30,
-				0x30, 0x31, 0x37, 0x39, 0x39, 0x3e, 0x43, 0x47,
-				0x4c, 0x4c, 0x4d, 0x4e, 0x4f, 0x57, 0x5a, 0x5d,
-				0x5e, 0x5f, 0x60, 0x63, 0x65, 0x73, 0x78, 0x79,
-				0x81, 0x8a, 0x8b, 0x8c, 0x8d, 0x90, 0x92, 0x94,
-				0x95, 0x95, 0x97, 0x9c, 0xa3, 0xa9, 0xaa, 0xb8,
-				0xba, 0xbb, 0xc1, 0xc2, 0xc2, 0xc5, 0xc6, 0xcb,
-				0xd2, 0xd5, 0xd6, 0xd8, 0xda, 0xdc, 0xde, 0xdf,
-				0xe2, 0xe3, 0xe5, 0xe7, 0xe8, 0xe8, 0xeb, 0xef,
-				0xf6, 0xf7, 0xf8, 0xfa, 0xfd, 0xfd,
-			},
-			{
-				0x08, 0x0b, 0x0b, 0x0d, 0x16, 0x18, 0x1c, 0x25,
-				0x27, 0x29, 0x2b, 0x2d, 0x32, 0x34, 0x36, 0x37,
-				0x37, 0x3a, 0x3b, 0x3c, 0x3d, 0x3e, 0x3f, 0x41,
-				0x42, 0x45, 0x46, 0x58, 0x5b, 0x5c, 0x5d, 0x66,
-				0x70, 0x70, 0x71, 0x72, 0x76, 0x76, 0x77, 0x7d,
-				0x83, 0x86, 0x88, 0x8b, 0x8e, 0x8e, 0x8f, 0x93,
-				0x94, 0x95, 0x99, 0x9a, 0x9c, 0x9e, 0xa1, 0xa1,
-				0xa2, 0xa8, 0xab, 0xaf, 0xb4, 0xb5, 0xb7, 0xb7,
-				0xbb, 0xc0, 0xc1, 0xc2, 0xc4, 0xc9, 0xc9, 0xcf,
-				0xd5, 0xd5, 0xd7, 0xda, 0xdb, 0xdd, 0xe0, 0xe2,
-				0xe6, 0xf1, 0xf4, 0xf8, 0xfb, 0xff,
-			},
-			{
-				0x02, 0x03, 0x04, 0x05, 0x07, 0x0c, 0x0c, 0x0f,
-				0x11, 0x16, 0x17, 0x17, 0x1f, 0x21, 0x21, 0x22,
-				0x23, 0x23, 0x27, 0x2c, 0x2c, 0x31, 0x34, 0x38,
-				0x38, 0x3a, 0x3a, 0x3f, 0x40, 0x40, 0x42, 0x43,
-				0x45, 0x45, 0x48, 0x48, 0x49, 0x49, 0x4a, 0x53,
-				0x5b, 0x62, 0x66, 0x68, 0x6a, 0x6b, 0x6c, 0x75,
-				0x76, 0x78, 0x7a, 0x7a, 0x7b, 0x7b, 0x85, 0x88,
-				0x89, 0x8d, 0x93, 0x98, 0x98, 0x9b, 0x9b, 0x9f,
-				0xa0, 0xa4, 0xa5, 0xad, 0xaf, 0xb0, 0xb3, 0xb4,
-				0xb9, 0xba, 0xc0, 0xd1, 0xd1, 0xd6, 0xe0, 0xe6,
-				0xe7, 0xee, 0xf5, 0xf7, 0xf9, 0xfe,
-			},
-			{
-				0x07, 0x0b, 0x0c, 0x0d, 0x10, 0x11, 0x12, 0x14,
-				0x1a, 0x1a, 0x1b, 0x1b, 0x1c, 0x22, 0x26, 0x26,
-				0x29, 0x31, 0x35, 0x3b, 0x3d, 0x41, 0x47, 0x4e,
-				0x4f, 0x52, 0x54, 0x55, 0x56, 0x57, 0x59, 0x5e,
-				0x61, 0x65, 0x68, 0x69, 0x6b, 0x71, 0x74, 0x75,
-				0x77, 0x7c, 0x7f, 0x7f, 0x80, 0x80, 0x82, 0x85,
-				0x87, 0x8c, 0x9a, 0x9c, 0x9d, 0x9f, 0xa2, 0xa8,
-				0xa9, 0xac, 0xac, 0xb2, 0xb7, 0xbe, 0xc7, 0xc8,
-				0xca, 0xcf, 0xd0, 0xd0, 0xd1, 0xd3, 0xd4, 0xd4,
-				0xd6, 0xd7, 0xd8, 0xe4, 0xe6, 0xea, 0xed, 0xee,
-				0xf1, 0xf3, 0xf5, 0xf6, 0xf8, 0xfc,
-			},
-		},
-
-		// Group 14
-		{
-			{
-				0x03, 0x08, 0x0b, 0x0c, 0x10, 0x11, 0x14, 0x16,
-				0x17, 0x19, 0x1b, 0x1e, 0x20, 0x22, 0x26, 0x29,
-				0x2f, 0x30, 0x35, 0x36, 0x3d, 0x3d, 0x3d, 0x3e,
-				0x4a, 0x4d, 0x4e, 0x4f, 0x4f, 0x52, 0x5a, 0x5b,
-				0x5f, 0x63, 0x68, 0x6c, 0x75, 0x78, 0x7a, 0x7e,
-				0x81, 0x81, 0x87, 0x89, 0x97, 0x98, 0x99, 0x9b,
-				0x9c, 0x9c, 0x9e, 0xa5, 0xa8, 0xa9, 0xaa, 0xac,
-				0xac, 0xb7, 0xb8, 0xb8, 0xb9, 0xba, 0xbc, 0xc0,
-				0xc1, 0xc2, 0xc3, 0xc9, 0xcb, 0xce, 0xcf, 0xd0,
-				0xd2, 0xdd, 0xe0, 0xe4, 0xe5, 0xe9, 0xea, 0xed,
-				0xf0, 0xf4, 0xf7, 0xf8, 0xfb, 0xff,
-			},
-			{
-				0x00, 0x01, 0x03, 0x07, 0x09, 0x0b, 0x0c, 0x0e,
-				0x0e, 0x11, 0x13, 0x16, 0x1a, 0x1b, 0x1e, 0x22,
-				0x24, 0x25, 0x36, 0x3c, 0x3f, 0x40, 0x41, 0x41,
-				0x46, 0x4b, 0x4b, 0x4c, 0x50, 0x51, 0x56, 0x56,
-				0x5a, 0x5d, 0x60, 0x61, 0x61, 0x67, 0x69, 0x6a,
-				0x6c, 0x72, 0x77, 0x79, 0x7d, 0x7f, 0x7f, 0x82,
-				0x8d, 0x8e, 0x93, 0x95, 0x96, 0x99, 0x9a, 0x9d,
-				0xa2, 0xa4, 0xa7, 0xa8, 0xa9, 0xab, 0xad, 0xb0,
-				0xb4, 0xb5, 0xb7, 0xbb, 0xbc, 0xbc, 0xbd, 0xc1,
-				0xc3, 0xc8, 0xcb, 0xcb, 0xd4, 0xe2, 0xee, 0xef,
-				0xf1, 0xf6, 0xf8, 0xfc, 0xfd, 0xfe,
-			},
-			{
-				0x00, 0x02, 0x03, 0x10, 0x12, 0x19, 0x21, 0x2d,
-				0x2f, 0x2f, 0x31, 0x35, 0x37, 0x38, 0x39, 0x40,
-				0x42, 0x42, 0x43, 0x45, 0x45, 0x48, 0x49, 0x49,
-				0x4a, 0x4d, 0x4e, 0x51, 0x52, 0x54, 0x55, 0x56,
-				0x5d, 0x5f, 0x62, 0x64, 0x65, 0x66, 0x67, 0x6d,
-				0x73, 0x73, 0x74, 0x74, 0x76, 0x78, 0x7b, 0x7b,
-				0x7c, 0x7d, 0x82, 0x83, 0x84, 0x84, 0x87, 0x89,
-				0x92, 0x97, 0x98, 0x9a, 0x9b, 0x9e, 0x9f, 0xa1,
-				0xa4, 0xa7, 0xb1, 0xb2, 0xbb, 0xbe, 0xc2, 0xd3,
-				0xd9, 0xdc, 0xdd, 0xde, 0xe1, 0xe1, 0xe3, 0xe7,
-				0xea, 0xed, 0xee, 0xf0, 0xf1, 0xf2,
-			},
-			{
-				0x02, 0x05, 0x08, 0x0a, 0x12, 0x18, 0x19, 0x1a,
-				0x1c, 0x23, 0x23, 0x25, 0x27, 0x28, 0x28, 0x2e,
-				0x31, 0x32, 0x34, 0x36, 0x3a, 0x45, 0x47, 0x47,
-				0x49, 0x4a, 0x55, 0x59, 0x66, 0x68, 0x6a, 0x6f,
-				0x70, 0x71, 0x76, 0x79, 0x7a, 0x7b, 0x80, 0x80,
-				0x86, 0x86, 0x90, 0x90, 0x91, 0x93, 0x96, 0x9a,
-				0x9e, 0xa3, 0xa3, 0xa5, 0xa6, 0xad, 0xb3, 0xb6,
-				0xbd, 0xbe, 0xc4, 0xc5, 0xc6, 0xc8, 0xc9, 0xca,
-				0xce, 0xd3, 0xd3, 0xd9, 0xda, 0xde, 0xe1, 0xe3,
-				0xe4, 0xe6, 0xe8, 0xe9, 0xec, 0xf3, 0xf4, 0xf7,
-				0xf9, 0xfa, 0xfb, 0xfc, 0xfd, 0xfe,
-			},
-			{
-				0x04, 0x0d, 0x0f, 0x0f, 0x13, 0x15, 0x15, 0x18,
-				0x1b, 0x1f, 0x23, 0x26, 0x2b, 0x2c, 0x30, 0x33,
-				0x34, 0x37, 0x38, 0x3e, 0x47, 0x4b, 0x4c, 0x4d,
-				0x4f, 0x53, 0x58, 0x59, 0x5e, 0x5f, 0x63, 0x64,
-				0x6b, 0x6e, 0x6f, 0x72, 0x77, 0x7c, 0x8a, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x90, 0x94, 0x9c, 0x9f, 0xa0,
-				0xa0, 0xa4, 0xaa, 0xab, 0xad, 0xae, 0xae, 0xb1,
-				0xb6, 0xb9, 0xbf, 0xc0, 0xc0, 0xc4, 0xc7, 0xca,
-				0xcd, 0xd1, 0xd2, 0xd4, 0xd5, 0xd6, 0xd6, 0xd7,
-				0xd7, 0xda, 0xdf, 0xe2, 0xe2, 0xe4, 0xe5, 0xe6,
-				0xe8, 0xeb, 0xeb, 0xef, 0xff, 0xff,
-			},
-			{
-				0x01, 0x04, 0x05, 0x06, 0x08, 0x17, 0x1c, 0x1c,
-				0x1d, 0x1d, 0x1f, 0x20, 0x21, 0x22, 0x29, 0x2a,
-				0x2a, 0x2a, 0x2c, 0x2d, 0x32, 0x3b, 0x41, 0x43,
-				0x44, 0x44, 0x50, 0x54, 0x57, 0x5d, 0x60, 0x60,
-				0x6a, 0x6d, 0x6d, 0x75, 0x76, 0x7c, 0x7e, 0x82,
-				0x85, 0x88, 0x8a, 0x8d, 0x8d, 0x91, 0x92, 0x94,
-				0x97, 0xa0, 0xa1, 0xa1, 0xa3, 0xa6, 0xaa, 0xae,
-				0xaf, 0xb0, 0xb1, 0xb3, 0xb5, 0xb8, 0xba, 0xc5,
-				0xc6, 0xc8, 0xcd, 0xcf, 0xd1, 0xd5, 0xd8, 0xd8,
-				0xdb, 0xdc, 0xdd, 0xde, 0xe7, 0xf3, 0xf3, 0xf5,
-				0xf5, 0xf6, 0xf9, 0xfa, 0xfc, 0xfd,
-			},
-			{
-				0x00, 0x02, 0x06, 0x07, 0x09, 0x0a, 0x0d, 0x13,
-				0x14, 0x1a, 0x24, 0x24, 0x27, 0x2b, 0x2e, 0x33,
-				0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3f, 0x46, 0x48,
-				0x52, 0x53, 0x54, 0x57, 0x58, 0x5b, 0x5b, 0x5c,
-				0x5c, 0x5e, 0x5e, 0x62, 0x65, 0x66, 0x69, 0x6b,
-				0x6b, 0x6e, 0x70, 0x71, 0x77, 0x7a, 0x7f, 0x83,
-				0x85, 0x88, 0x8b, 0x8c, 0x8f, 0x92, 0x95, 0x9d,
-				0xa2, 0xa9, 0xab, 0xaf, 0xb2, 0xb3, 0xb4, 0xb5,
-				0xb6, 0xbf, 0xc7, 0xca, 0xcc, 0xcc, 0xcd, 0xce,
-				0xd0, 0xd5, 0xdb, 0xdc, 0xdf, 0xe0, 0xec, 0xf0,
-				0xf2, 0xf2, 0xf5, 0xf6, 0xf8, 0xfe,
-			},
-		},
-
-		// Group 15
-		{
-			{
-				0x05, 0x09, 0x09, 0x0a, 0x11, 0x13, 0x14, 0x16,
-				0x18, 0x1e, 0x20, 0x20, 0x22, 0x23, 0x25, 0x29,
-				0x2b, 0x2e, 0x31, 0x33, 0x3b, 0x41, 0x43, 0x48,
-				0x4a, 0x4c, 0x4d, 0x4e, 0x50, 0x52, 0x54, 0x54,
-				0x55, 0x59, 0x5f, 0x60, 0x68, 0x69, 0x6e, 0x72,
-				0x74, 0x76, 0x77, 0x79, 0x82, 0x8e, 0x8e, 0x90,
-				0x91, 0x93, 0x94, 0x96, 0xa0, 0xa1, 0xa3, 0xa7,
-				0xad, 0xb2, 0xb4, 0xb5, 0xc1, 0xc8, 0xc9, 0xc9,
-				0xca, 0xcb, 0xd0, 0xd4, 0xd7, 0xd8, 0xdb, 0xdd,
-				0xde, 0xde, 0xdf, 0xe1, 0xe6, 0xe6, 0xe9, 0xea,
-				0xed, 0xf7, 0xf8, 0xfb, 0xfc, 0xff,
-			},
-			{
-				0x08, 0x0b, 0x0d, 0x0d, 0x0f, 0x19, 0x21, 0x22,
-				0x24, 0x2a, 0x2c, 0x2d, 0x2f, 0x35, 0x37, 0x37,
-				0x3a, 0x3f, 0x46, 0x48, 0x4b, 0x4f, 0x56, 0x56,
-				0x5b, 0x5f, 0x64, 0x65, 0x73, 0x75, 0x75, 0x77,
-				0x7b, 0x7c, 0x7e, 0x7f, 0x81, 0x83, 0x86, 0x87,
-				0x89, 0x8b, 0x8f, 0x95, 0x97, 0x98, 0x9a, 0x9b,
-				0xa0, 0xa2, 0xa3, 0xa7, 0xa9, 0xad, 0xb3, 0xb5,
-				0xb6, 0xbb, 0xbe, 0xbf, 0xc4, 0xc5, 0xc8, 0xcb,
-				0xcc, 0xcf, 0xd5, 0xd7, 0xdb, 0xdc, 0xdd, 0xe1,
-				0xe2, 0xe5, 0xe7, 0xea, 0xec, 0xec, 0xed, 0xef,
-				0xf0, 0xf1, 0xf9, 0xfb, 0xfd, 0xff,
-			},
-			{
-				0x03, 0x03, 0x06, 0x08, 0x0a, 0x0b, 0x0d, 0x0e,
-				0x0e, 0x11, 0x12, 0x16, 0x18, 0x1a, 0x1a, 0x1b,
-				0x1d, 0x1f, 0x24, 0x25, 0x2b, 0x31, 0x31, 0x34,
-				0x36, 0x3e, 0x3f, 0x40, 0x40, 0x44, 0x47, 0x47,
-				0x47, 0x49, 0x4c, 0x4d, 0x4f, 0x4f, 0x52, 0x53,
-				0x5a, 0x5b, 0x5e, 0x67, 0x6e, 0x72, 0x74, 0x7a,
-				0x7c, 0x7e, 0x85, 0x88, 0x8c, 0x8d, 0x8d, 0x92,
-				0x94, 0x9b, 0x9d, 0xa5, 0xac, 0xb3, 0xb8, 0xbf,
-				0xc0, 0xc3, 0xc5, 0xc7, 0xcc, 0xcd, 0xce, 0xcf,
-				0xcf, 0xd1, 0xd9, 0xe0, 0xe1, 0xe4, 0xe5, 0xe6,
-				0xeb, 0xee, 0xef, 0xf0, 0xf8, 0xfa,
-			},
-			{
-				0x00, 0x06, 0x07, 0x12, 0x16, 0x1f, 0x24, 0x28,
-				0x28, 0x28, 0x29, 0x32, 0x34, 0x34, 0x39, 0x3a,
-				0x41, 0x43, 0x4a, 0x4e, 0x50, 0x51, 0x58, 0x58,
-				0x5b, 0x5c, 0x62, 0x62, 0x64, 0x66, 0x66, 0x69,
-				0x6a, 0x6b, 0x6d, 0x6f, 0x70, 0x7f, 0x85, 0x88,
-				0x89, 0x8a, 0x8c, 0x91, 0x97, 0x9d, 0x9e, 0x9f,
-				0x9f, 0x9f, 0xa1, 0xa2, 0xa2, 0xa3, 0xa4, 0xa6,
-				0xa6, 0xa8, 0xaa, 0xae, 0xae, 0xaf, 0xb4, 0xb6,
-				0xba, 0xbb, 0xbd, 0xbe, 0xc2, 0xc3, 0xc4, 0xc7,
-				0xce, 0xd2, 0xd3, 0xd8, 0xde, 0xdf, 0xe0, 0xe7,
-				0xeb, 0xed, 0xf3, 0xf4, 0xf7, 0xf9,
-			},
-			{
-				0x00, 0x00, 0x01, 0x02, 0x04, 0x04, 0x0f, 0x10,
-				0x14, 0x15, 0x17, 0x17, 0x19, 0x1b, 0x1c, 0x1d,
-				0x22, 0x26, 0x27, 0x27, 0x2a, 0x2c, 0x30, 0x36,
-				0x39, 0x3a, 0x3e, 0x3f, 0x45, 0x46, 0x49, 0x51,
-				0x54, 0x5c, 0x5d, 0x5e, 0x60, 0x61, 0x63, 0x6c,
-				0x70, 0x74, 0x76, 0x76, 0x78, 0x78, 0x7d, 0x7e,
-				0x81, 0x81, 0x87, 0x90, 0x98, 0x99, 0x99, 0x9c,
-				0xa4, 0xa8, 0xa8, 0xab, 0xab, 0xae, 0xaf, 0xb0,
-				0xb0, 0xb1, 0xb2, 0xb2, 0xb4, 0xb9, 0xb9, 0xd3,
-				0xd5, 0xd6, 0xd6, 0xe2, 0xe3, 0xe8, 0xe8, 0xee,
-				0xf1, 0xf4, 0xf5, 0xf7, 0xfc, 0xfe,
-			},
-			{
-				0x02, 0x03, 0x07, 0x07, 0x0c, 0x0c, 0x13, 0x1b,
-				0x1c, 0x1e, 0x1f, 0x21, 0x23, 0x2e, 0x2f, 0x33,
-				0x35, 0x36, 0x38, 0x39, 0x3c, 0x3d, 0x3d, 0x3e,
-				0x40, 0x42, 0x45, 0x4b, 0x4b, 0x4d, 0x51, 0x53,
-				0x55, 0x59, 0x5a, 0x5c, 0x5d, 0x60, 0x61, 0x65,
-				0x67, 0x6a, 0x6b, 0x6d, 0x6f, 0x73, 0x82, 0x84,
-				0x87, 0x8b, 0x92, 0x95, 0x9b, 0x9c, 0x9d, 0xa5,
-				0xa5, 0xa6, 0xa9, 0xac, 0xad, 0xb1, 0xb6, 0xb7,
-				0xb7, 0xba, 0xbc, 0xbd, 0xbe, 0xc1, 0xc6, 0xca,
-				0xcd, 0xd0, 0xd1, 0xd2, 0xd4, 0xd7, 0xda, 0xdc,
-				0xe3, 0xe9, 0xeb, 0xec, 0xfa, 0xfd,
-			},
-			{
-				0x01, 0x05, 0x10, 0x15, 0x15, 0x17, 0x1a, 0x26,
-				0x29, 0x2a, 0x2b, 0x2d, 0x30, 0x32, 0x32, 0x33,
-				0x35, 0x38, 0x3b, 0x3c, 0x41, 0x42, 0x44, 0x45,
-				0x49, 0x57, 0x57, 0x63, 0x68, 0x6c, 0x6c, 0x71,
-				0x71, 0x72, 0x79, 0x7a, 0x7b, 0x7d, 0x7d, 0x80,
-				0x80, 0x80, 0x83, 0x84, 0x86, 0x88, 0x8a, 0x8b,
-				0x8c, 0x8f, 0x93, 0x95, 0x96, 0x9a, 0x9a, 0x9c,
-				0x9e, 0xa0, 0xa4, 0xaa, 0xac, 0xaf, 0xb3, 0xb8,
-				0xbc, 0xc0, 0xc1, 0xc2, 0xc2, 0xc3, 0xc6, 0xcb,
-				0xcc, 0xce, 0xd9, 0xda, 0xe4, 0xef, 0xf2, 0xf2,
-				0xf3, 0xf5, 0xf6, 0xf6, 0xfa, 0xfe,
-			},
-		},
-
-		// Group 16
-		{
-			{
-				0x03, 0x04, 0x07, 0x0b, 0x13, 0x14, 0x18, 0x19,
-				0x1a, 0x20, 0x20, 0x2f, 0x35, 0x36, 0x39, 0x3b,
-				0x3d, 0x3f, 0x40, 0x45, 0x46, 0x47, 0x52, 0x53,
-				0x55, 0x58, 0x5c, 0x64, 0x67, 0x6a, 0x6d, 0x6d,
-				0x6e, 0x70, 0x73, 0x75, 0x76, 0x7d, 0x7e, 0x7f,
-				0x84, 0x87, 0x8b, 0x90, 0x91, 0x91, 0x93, 0x98,
-				0x9a, 0x9f, 0xa7, 0xab, 0xb0, 0xb3, 0xb8, 0xb8,
-				0xb9, 0xbb, 0xbc, 0xbf, 0xc4, 0xc4, 0xc5, 0xc9,
-				0xcb, 0xcf, 0xd0, 0xd6, 0xd9, 0xde, 0xde, 0xe1,
-				0xe7, 0xe9, 0xec, 0xed, 0xee, 0xf0, 0xf2, 0xf4,
-				0xf6, 0xf7, 0xf8, 0xf9, 0xfa, 0xfb,
-			},
-			{
-				0x01, 0x03, 0x06, 0x0e, 0x0f, 0x10, 0x11, 0x13,
-				0x1d, 0x1d, 0x21, 0x28, 0x2a, 0x2a, 0x30, 0x31,
-				0x31, 0x33, 0x33, 0x34, 0x36, 0x39, 0x3a, 0x3a,
-				0x3e, 0x42, 0x43, 0x45, 0x4e, 0x51, 0x51, 0x57,
-				0x5e, 0x65, 0x66, 0x68, 0x68, 0x70, 0x74, 0x7b,
-				0x7c, 0x7e, 0x7f, 0x80, 0x81, 0x82, 0x85, 0x87,
-				0x88, 0x8a, 0x8b, 0x8e, 0x8f, 0x94, 0x95, 0x95,
-				0x98, 0x9b, 0x9f, 0xa3, 0xa4, 0xaf, 0xb0, 0xb3,
-				0xb7, 0xbc, 0xc6, 0xc8, 0xcd, 0xd1, 0xd2, 0xd3,
-				0xdd, 0xdd, 0xe0, 0xe0, 0xe2, 0xe3, 0xe4, 0xe6,
-				0xe6, 0xea, 0xef, 0xf4, 0xf7, 0xfc,
-			},
-			{
-				0x02, 0x05, 0x10, 0x11, 0x12, 0x19, 0x1e, 0x1e,
-				0x26, 0x27, 0x29, 0x2b, 0x2c, 0x2d, 0x2e, 0x2e,
-				0x37, 0x3c, 0x40, 0x48, 0x4b, 0x4e, 0x4f, 0x53,
-				0x54, 0x56, 0x57, 0x5d, 0x5d, 0x5f, 0x62, 0x62,
-				0x66, 0x69, 0x6a, 0x6a, 0x6b, 0x6b, 0x6c, 0x6c,
-				0x6e, 0x72, 0x74, 0x75, 0x78, 0x79, 0x79, 0x80,
-				0x85, 0x86, 0x87, 0x8d, 0x90, 0x92, 0x94, 0x9d,
-				0x9e, 0xa1, 0xa6, 0xad, 0xad, 0xb2, 0xb5, 0xb6,
-				0xba, 0xc0, 0xc1, 0xc1, 0xc2, 0xc6, 0xc7, 0xd4,
-				0xd7, 0xd8, 0xd8, 0xe5, 0xea, 0xeb, 0xf0, 0xf1,
-				0xf5, 0xf5, 0xfb, 0xfd, 0xfd, 0xff,
-			},
-			{
-				0x04, 0x04, 0x07, 0x08, 0x09, 0x09, 0x0a, 0x0c,
-				0x0c, 0x0d, 0x15, 0x15, 0x16, 0x1b, 0x1c, 0x1f,
-				0x20, 0x23, 0x24, 0x26, 0x2c, 0x2c, 0x2f, 0x32,
-				0x32, 0x34, 0x35, 0x3d, 0x41, 0x43, 0x45, 0x4a,
-				0x4f, 0x4f, 0x50, 0x50, 0x51, 0x55, 0x59, 0x5a,
-				0x5b, 0x61, 0x63, 0x65, 0x71, 0x75, 0x76, 0x7a,
-				0x7a, 0x7e, 0x80, 0x81, 0x82, 0x97, 0x9b, 0xa2,
-				0xa4, 0xa5, 0xa6, 0xa8, 0xa9, 0xab, 0xb4, 0xb6,
-				0xb7, 0xb8, 0xc0, 0xcd, 0xce, 0xd0, 0xd2, 0xd4,
-				0xd5, 0xda, 0xdb, 0xdc, 0xe2, 0xe3, 0xe4, 0xe5,
-				0xe8, 0xe8, 0xe9, 0xec, 0xf1, 0xfc,
-			},
-			{
-				0x01, 0x06, 0x09, 0x0d, 0x0f, 0x12, 0x13, 0x14,
-				0x17, 0x17, 0x18, 0x1c, 0x22, 0x22, 0x23, 0x25,
-				0x2d, 0x2d, 0x37, 0x3e, 0x41, 0x41, 0x42, 0x48,
-				0x4c, 0x4c, 0x4d, 0x54, 0x55, 0x56, 0x59, 0x5a,
-				0x5a, 0x60, 0x60, 0x61, 0x62, 0x64, 0x64, 0x6f,
-				0x70, 0x71, 0x77, 0x78, 0x7a, 0x7b, 0x82, 0x83,
-				0x83, 0x84, 0x86, 0x88, 0x8a, 0x8c, 0x96, 0x97,
-				0x99, 0x9a, 0x9c, 0x9f, 0xa5, 0xa8, 0xa9, 0xb4,
-				0xbf, 0xc2, 0xc3, 0xc5, 0xc7, 0xc9, 0xca, 0xca,
-				0xcb, 0xcc, 0xcd, 0xd1, 0xd7, 0xd8, 0xd9, 0xda,
-				0xe0, 0xf3, 0xf7, 0xf9, 0xfa, 0xfe,
-			},
-			{
-				0x00, 0x02, 0x08, 0x0a, 0x0a, 0x0e, 0x16, 0x18,
-				0x1a, 0x1b, 0x22, 0x25, 0x25, 0x27, 0x28, 0x29,
-				0x2a, 0x33, 0x3c, 0x44, 0x44, 0x46, 0x49, 0x49,
-				0x4d, 0x50, 0x52, 0x58, 0x58, 0x5b, 0x5c, 0x5e,
-				0x65, 0x66, 0x69, 0x6f, 0x6f, 0x73, 0x77, 0x7c,
-				0x7d, 0x7d, 0x88, 0x89, 0x8c, 0x90, 0x93, 0x97,
-				0x99, 0x9e, 0xa0, 0xa1, 0xa2, 0xa3, 0xa7, 0xa7,
-				0xac, 0xac, 0xaf, 0xaf, 0xb2, 0xb3, 0xb9, 0xb9,
-				0xbd, 0xbe, 0xc1, 0xc3, 0xc6, 0xca, 0xce, 0xd3,
-				0xd4, 0xd6, 0xda, 0xdb, 0xdc, 0xdf, 0xe4, 0xe7,
-				0xed, 0xee, 0xef, 0xf2, 0xf8, 0xfd,
-			},
-			{
-				0x00, 0x01, 0x02, 0x03, 0x05, 0x07, 0x0b, 0x0c,
-				0x10, 0x1a, 0x1d, 0x1f, 0x21, 0x24, 0x24, 0x2b,
-				0x30, 0x34, 0x37, 0x38, 0x38, 0x3a, 0x3b, 0x3c,
-				0x3f, 0x47, 0x49, 0x4a, 0x4b, 0x4e, 0x57, 0x59,
-				0x5e, 0x5f, 0x63, 0x67, 0x6b, 0x72, 0x73, 0x81,
-				0x89, 0x8c, 0x8d, 0x8e, 0x8f, 0x92, 0x93, 0x96,
-				0x98, 0x9a, 0x9c, 0x9d, 0xa0, 0xa2, 0xaa, 0xaa,
-				0xae, 0xae, 0xb1, 0xb1, 0xb5, 0xba, 0xbb, 0xbd,
-				0xbe, 0xc0, 0xc8, 0xcb, 0xcc, 0xce, 0xcf, 0xd1,
-				0xd5, 0xdd, 0xdf, 0xdf, 0xe1, 0xe6, 0xeb, 0xf1,
-				0xf3, 0xf6, 0xf9, 0xfc, 0xfe, 0xff,
-			},
-		},
-
-		// Group 17
-		{
-			{
-				0x01, 0x02, 0x04, 0x06, 0x0a, 0x0e, 0x12, 0x12,
-				0x15, 0x17, 0x19, 0x1c, 0x1d, 0x1f, 0x21, 0x22,
-				0x24, 0x27, 0x28, 0x29, 0x2e, 0x31, 0x33, 0x38,
-				0x3a, 0x3b, 0x3d, 0x41, 0x42, 0x44, 0x47, 0x51,
-				0x57, 0x5f, 0x5f, 0x64, 0x65, 0x69, 0x6c, 0x6f,
-				0x71, 0x71, 0x74, 0x76, 0x7c, 0x7c, 0x7e, 0x82,
-				0x83, 0x8c, 0x8e, 0x8f, 0x94, 0x98, 0x9c, 0xa8,
-				0xa9, 0xad, 0xb0, 0xb2, 0xb4, 0xb7, 0xb9, 0xba,
-				0xbe, 0xc0, 0xc1, 0xc7, 0xc8, 0xc9, 0xd0, 0xd4,
-				0xd5, 0xd8, 0xe0, 0xe2, 0xe4, 0xe6, 0xe7, 0xf0,
-				0xf1, 0xf9, 0xfc, 0xfc, 0xfe, 0xff,
-			},
-			{
-				0x00, 0x05, 0x06, 0x07, 0x0d, 0x0d, 0x0e, 0x18,
-				0x19, 0x1a, 0x1d, 0x1e, 0x22, 0x22, 0x25, 0x27,
-				0x28, 0x2a, 0x2d, 0x31, 0x36, 0x3b, 0x3f, 0x45,
-				0x4c, 0x4c, 0x4d, 0x50, 0x54, 0x54, 0x56, 0x56,
-				0x66, 0x6c, 0x70, 0x74, 0x78, 0x7b, 0x7e, 0x80,
-				0x81, 0x84, 0x85, 0x8a, 0x8d, 0x8e, 0x8f, 0x90,
-				0x96, 0x97, 0x9b, 0x9d, 0xa4, 0xa6, 0xa7, 0xa8,
-				0xa9, 0xb3, 0xb5, 0xba, 0xbc, 0xbf, 0xc2, 0xc3,
-				0xc7, 0xc8, 0xce, 0xcf, 0xd1, 0xd2, 0xd6, 0xd7,
-				0xdc, 0xdf, 0xdf, 0xe2, 0xe2, 0xe3, 0xe3, 0xe4,
-				0xe7, 0xea, 0xf0, 0xf1, 0xf7, 0xfb,
-			},
-			{
-				0x00, 0x01, 0x03, 0x04, 0x07, 0x0a, 0x0b, 0x0f,
-				0x12, 0x15, 0x16, 0x17, 0x18, 0x1a, 0x1c, 0x1f,
-				0x23, 0x2e, 0x31, 0x35, 0x3c, 0x40, 0x42, 0x44,
-				0x49, 0x4e, 0x4f, 0x50, 0x53, 0x55, 0x56, 0x58,
-				0x5a, 0x5b, 0x5c, 0x5e, 0x60, 0x60, 0x61, 0x6a,
-				0x6f, 0x70, 0x77, 0x7a, 0x7d, 0x7e, 0x7f, 0x80,
-				0x82, 0x87, 0x8b, 0x8b, 0x90, 0x92, 0x9a, 0x9d,
-				0xa0, 0xa2, 0xa3, 0xa9, 0xaa, 0xac, 0xae, 0xaf,
-				0xb5, 0xbb, 0xc0, 0xc2, 0xc4, 0xc8, 0xca, 0xd2,
-				0xd3, 0xd3, 0xde, 0xde, 0xe1, 0xe8, 0xeb, 0xec,
-				0xef, 0xf5, 0xf8, 0xf8, 0xfb, 0xfd,
-			},
-			{
-				0x00, 0x02, 0x08, 0x09, 0x0e, 0x0f, 0x11, 0x11,
-				0x13, 0x14, 0x19, 0x1c, 0x1f, 0x24, 0x25, 0x32,
-				0x34, 0x36, 0x36, 0x37, 0x38, 0x3b, 0x3e, 0x3f,
-				0x42, 0x46, 0x46, 0x46, 0x49, 0x4a, 0x4a, 0x4b,
-				0x50, 0x51, 0x52, 0x53, 0x59, 0x5b, 0x5c, 0x5e,
-				0x61, 0x64, 0x69, 0x6a, 0x6b, 0x6e, 0x79, 0x7b,
-				0x81, 0x83, 0x86, 0x87, 0x88, 0x88, 0x89, 0x8f,
-				0x93, 0x95, 0x9b, 0x9d, 0x9e, 0xa2, 0xa4, 0xa5,
-				0xaa, 0xab, 0xad, 0xb1, 0xb2, 0xb7, 0xbc, 0xbc,
-				0xbf, 0xd2, 0xd8, 0xe1, 0xe5, 0xe9, 0xea, 0xeb,
-				0xec, 0xf2, 0xf4, 0xf4, 0xfc, 0xfe,
-			},
-			{
-				0x01, 0x02, 0x03, 0x07, 0x0b, 0x0b, 0x10, 0x10,
-				0x13, 0x14, 0x16, 0x17, 0x1a, 0x1b, 0x39, 0x40,
-				0x43, 0x48, 0x4d, 0x4f, 0x52, 0x58, 0x5b, 0x5f,
-				0x61, 0x62, 0x63, 0x67, 0x68, 0x6b, 0x6d, 0x71,
-				0x73, 0x74, 0x7d, 0x7f, 0x80, 0x8c, 0x95, 0x9e,
-				0x9f, 0xa3, 0xa4, 0xaa, 0xac, 0xb0, 0xb1, 0xb2,
-				0xb3, 0xb6, 0xb8, 0xba, 0xbb, 0xbb, 0xbd, 0xbd,
-				0xbe, 0xc1, 0xc2, 0xc5, 0xc5, 0xc6, 0xc9, 0xcb,
-				0xcb, 0xcd, 0xcf, 0xd4, 0xd6, 0xd9, 0xdb, 0xdb,
-				0xdc, 0xdd, 0xde, 0xe4, 0xe5, 0xe6, 0xe8, 0xed,
-				0xf0, 0xf4, 0xf6, 0xf9, 0xfa, 0xff,
-			},
-			{
-				0x03, 0x0c, 0x0d, 0x10, 0x1b, 0x1e, 0x21, 0x23,
-				0x29, 0x2a, 0x2b, 0x2c, 0x2c, 0x2d, 0x2e, 0x2f,
-				0x30, 0x30, 0x38, 0x3c, 0x3e, 0x45, 0x48, 0x4c,
-				0x55, 0x59, 0x5a, 0x5d, 0x5d, 0x62, 0x65, 0x66,
-				0x67, 0x67, 0x68, 0x6d, 0x76, 0x78, 0x78, 0x7a,
-				0x84, 0x85, 0x86, 0x8a, 0x91, 0x91, 0x92, 0x94,
-				0x97, 0x98, 0x99, 0x99, 0x9e, 0x9f, 0xa0, 0xa5,
-				0xa7, 0xa8, 0xae, 0xaf, 0xb0, 0xb5, 0xb6, 0xb8,
-				0xc1, 0xc5, 0xc6, 0xca, 0xcc, 0xcf, 0xd0, 0xd9,
-				0xda, 0xdd, 0xe6, 0xe9, 0xea, 0xee, 0xee, 0xf1,
-				0xf3, 0xf5, 0xf6, 0xf7, 0xf9, 0xfa,
-			},
-			{
-				0x05, 0x05, 0x08, 0x09, 0x0c, 0x20, 0x20, 0x26,
-				0x26, 0x2b, 0x2f, 0x32, 0x33, 0x34, 0x35, 0x35,
-				0x37, 0x39, 0x3a, 0x3d, 0x41, 0x43, 0x47, 0x4b,
-				0x4e, 0x51, 0x54, 0x57, 0x5a, 0x63, 0x68, 0x6a,
-				0x6b, 0x6e, 0x6e, 0x6f, 0x72, 0x72, 0x73, 0x75,
-				0x75, 0x77, 0x79, 0x7c, 0x83, 0x86, 0x89, 0x8a,
-				0x8d, 0x92, 0x93, 0x95, 0x96, 0x9a, 0x9a, 0x9c,
-				0x9f, 0xa1, 0xa1, 0xa3, 0xa6, 0xab, 0xad, 0xb4,
-				0xb7, 0xb9, 0xc3, 0xc4, 0xcc, 0xcd, 0xce, 0xd1,
-				0xd3, 0xd5, 0xd7, 0xd8, 0xda, 0xe0, 0xe1, 0xeb,
-				0xed, 0xef, 0xf2, 0xf3, 0xfd, 0xfe,
-			},
-		},
-
-		// Group 18
-		{
-			{
-				0x00, 0x06, 0x0a, 0x0c, 0x0e, 0x14, 0x21, 0x21,
-				0x25, 0x25, 0x26, 0x29, 0x2a, 0x2b, 0x2c, 0x30,
-				0x35, 0x36, 0x37, 0x37, 0x39, 0x46, 0x50, 0x52,
-				0x52, 0x54, 0x57, 0x59, 0x5a, 0x63, 0x64, 0x65,
-				0x66, 0x68, 0x69, 0x6c, 0x6d, 0x6d, 0x6d, 0x6e,
-				0x72, 0x73, 0x74, 0x7d, 0x80, 0x81, 0x81, 0x82,
-				0x86, 0x89, 0x8a, 0x8c, 0x8c, 0x8d, 0x8f, 0x91,
-				0x92, 0x94, 0x9a, 0x9c, 0x9d, 0xa3, 0xa6, 0xa8,
-				0xaf, 0xb1, 0xb7, 0xbe, 0xbf, 0xbf, 0xc0, 0xc5,
-				0xc6, 0xc9, 0xca, 0xca, 0xce, 0xcf, 0xd4, 0xd5,
-				0xdb, 0xdf, 0xe1, 0xeb, 0xec, 0xf8,
-			},
-			{
-				0x00, 0x02, 0x03, 0x03, 0x05, 0x0a, 0x0d, 0x11,
-				0x16, 0x17, 0x19, 0x19, 0x1d, 0x1e, 0x22, 0x28,
-				0x28, 0x2c, 0x2c, 0x2e, 0x31, 0x32, 0x32, 0x33,
-				0x37, 0x3a, 0x46, 0x4f, 0x51, 0x52, 0x55, 0x56,
-				0x5f, 0x60, 0x61, 0x66, 0x6b, 0x76, 0x7b, 0x7c,
-				0x7e, 0x7f, 0x84, 0x85, 0x87, 0x90, 0x92, 0x93,
-				0x94, 0x96, 0x97, 0x99, 0x9d, 0xa5, 0xa9, 0xab,
-				0xab, 0xaf, 0xb3, 0xb3, 0xb9, 0xbc, 0xc1, 0xc1,
-				0xc2, 0xc8, 0xce, 0xd1, 0xd1, 0xd3, 0xda, 0xda,
-				0xdb, 0xdd, 0xe0, 0xe5, 0xe9, 0xed, 0xf0, 0xf4,
-				0xf6, 0xf6, 0xf9, 0xfb, 0xfc, 0xfd,
-			},
-			{
-				0x04, 0x04, 0x05, 0x06, 0x08, 0x08, 0x0b, 0x0f,
-				0x10, 0x13, 0x14, 0x15, 0x19, 0x1b, 0x1f, 0x22,
-				0x23, 0x26, 0x29, 0x2b, 0x30, 0x34, 0x38, 0x38,
-				0x39, 0x3a, 0x3e, 0x3f, 0x41, 0x43, 0x44, 0x44,
-				0x49, 0x4b, 0x4d, 0x4e, 0x50, 0x56, 0x58, 0x63,
-				0x67, 0x6a, 0x6c, 0x72, 0x74, 0x78, 0x79, 0x83,
-				0x84, 0x85, 0x87, 0x88, 0x8a, 0x8e, 0x90, 0x97,
-				0x99, 0x9a, 0xa1, 0xa3, 0xaa, 0xab, 0xad, 0xb0,
-				0xb6, 0xb8, 0xba, 0xba, 0xbb, 0xc2, 0xc6, 0xd0,
-				0xd2, 0xd6, 0xd7, 0xdc, 0xe1, 0xe5, 0xe6, 0xe6,
-				0xea, 0xeb, 0xf8, 0xfe, 0xff, 0xff,
-			},
-			{
-				0x06, 0x07, 0x09, 0x0b, 0x0d, 0x16, 0x1e, 0x1f,
-				0x1f, 0x23, 0x24, 0x24, 0x2e, 0x2f, 0x30, 0x31,
-				0x34, 0x35, 0x35, 0x38, 0x3c, 0x3c, 0x3d, 0x47,
-				0x49, 0x4d, 0x51, 0x53, 0x55, 0x57, 0x5a, 0x5b,
-				0x5d, 0x5d, 0x5e, 0x62, 0x64, 0x65, 0x6f, 0x75,
-				0x75, 0x78, 0x78, 0x7d, 0x7e, 0x84, 0x86, 0x8a,
-				0x8b, 0x8d, 0x8f, 0x9a, 0x9b, 0xa1, 0xa2, 0xa6,
-				0xa8, 0xa9, 0xb2, 0xb8, 0xba, 0xbc, 0xbd, 0xc7,
-				0xc8, 0xc9, 0xcb, 0xcc, 0xd0, 0xd3, 0xd4, 0xd5,
-				0xdd, 0xe1, 0xe7, 0xe8, 0xe8, 0xec, 0xec, 0xef,
-				0xef, 0xf3, 0xf5, 0xf5, 0xfc, 0xfe,
-			},
-			{
-				0x01, 0x03, 0x07, 0x0d, 0x12, 0x13, 0x15, 0x17,
-				0x18, 0x18, 0x1a, 0x1c, 0x20, 0x20, 0x26, 0x28,
-				0x2b, 0x2d, 0x2d, 0x31, 0x32, 0x36, 0x3c, 0x3d,
-				0x3d, 0x3e, 0x3f, 0x40, 0x42, 0x4b, 0x50, 0x53,
-				0x55, 0x5c, 0x5c, 0x5f, 0x67, 0x68, 0x69, 0x6a,
-				0x6b, 0x70, 0x71, 0x71, 0x71, 0x76, 0x7a, 0x7a,
-				0x7c, 0x7e, 0x83, 0x85, 0x8e, 0x93, 0x95, 0x96,
-				0x9e, 0x9f, 0x9f, 0xa0, 0xa4, 0xa6, 0xa7, 0xac,
-				0xae, 0xae, 0xb1, 0xb4, 0xb6, 0xc3, 0xc3, 0xc7,
-				0xcc, 0xcf, 0xd2, 0xd7, 0xdc, 0xde, 0xe3, 0xe4,
-				0xea, 0xee, 0xee, 0xf0, 0xf1, 0xfd,
-			},
-			{
-				0x0c, 0x0e, 0x11, 0x12, 0x1b, 0x1c, 0x1d, 0x20,
-				0x27, 0x2d, 0x39, 0x40, 0x43, 0x45, 0x47, 0x48,
-				0x48, 0x4a, 0x54, 0x58, 0x59, 0x5b, 0x5c, 0x60,
-				0x61, 0x6e, 0x6f, 0x70, 0x76, 0x77, 0x79, 0x80,
-				0x88, 0x88, 0x89, 0x8b, 0x91, 0x94, 0x95, 0x98,
-				0x99, 0x9b, 0x9e, 0xa0, 0xa4, 0xa5, 0xa7, 0xac,
-				0xad, 0xad, 0xaf, 0xb4, 0xb6, 0xb7, 0xb7, 0xbd,
-				0xbe, 0xc0, 0xc4, 0xc4, 0xca, 0xcb, 0xcd, 0xd6,
-				0xd7, 0xd9, 0xde, 0xdf, 0xe0, 0xe2, 0xe2, 0xe4,
-				0xe5, 0xe7, 0xe8, 0xe9, 0xed, 0xef, 0xf0, 0xf2,
-				0xf7, 0xf9, 0xfa, 0xfb, 0xfd, 0xff,
-			},
-			{
-				0x01, 0x02, 0x09, 0x0c, 0x0f, 0x10, 0x16, 0x17,
-				0x1a, 0x1b, 0x1e, 0x27, 0x27, 0x2a, 0x2e, 0x2f,
-				0x33, 0x3a, 0x3b, 0x3b, 0x3f, 0x40, 0x41, 0x42,
-				0x45, 0x46, 0x4a, 0x4c, 0x4c, 0x4e, 0x4f, 0x58,
-				0x5a, 0x5e, 0x60, 0x62, 0x64, 0x6b, 0x72, 0x73,
-				0x77, 0x77, 0x7b, 0x7f, 0x82, 0x86, 0x89, 0x98,
-				0x9c, 0xa1, 0xa2, 0xa2, 0xaa, 0xac, 0xae, 0xb0,
-				0xb2, 0xb5, 0xb5, 0xb9, 0xbb, 0xbb, 0xbf, 0xc2,
-				0xc5, 0xc5, 0xcc, 0xcd, 0xce, 0xcf, 0xd0, 0xd8,
-				0xd8, 0xd9, 0xe0, 0xe3, 0xed, 0xf1, 0xf1, 0xf2,
-				0xf3, 0xf4, 0xf6, 0xf7, 0xfa, 0xfe,
-			},
-		},
-
-		// Group 19
-		{
-			{
-				0x04, 0x09, 0x0b, 0x1a, 0x1b, 0x1c, 0x1f, 0x20,
-				0x24, 0x24, 0x27, 0x27, 0x29, 0x2a, 0x2a, 0x2c,
-				0x32, 0x36, 0x3a, 0x3b, 0x3c, 0x44, 0x44, 0x47,
-				0x48, 0x49, 0x4c, 0x50, 0x54, 0x59, 0x5f, 0x61,
-				0x63, 0x64, 0x65, 0x6c, 0x6e, 0x73, 0x74, 0x75,
-				0x79, 0x79, 0x7a, 0x7d, 0x7e, 0x81, 0x81, 0x89,
-				0x8a, 0x8c, 0x8c, 0x8c, 0x8e, 0x90, 0x91, 0x93,
-				0x95, 0x99, 0x9a, 0x9b, 0x9c, 0xa1, 0xa8, 0xad,
-				0xae, 0xb1, 0xb2, 0xbd, 0xbd, 0xbe, 0xc1, 0xc1,
-				0xc4, 0xc7, 0xcb, 0xcc, 0xd0, 0xdc, 0xe5, 0xea,
-				0xed, 0xf2, 0xf3, 0xf4, 0xfc, 0xfe,
-			},
-			{
-				0x00, 0x03, 0x03, 0x04, 0x06, 0x06, 0x07, 0x07,
-				0x08, 0x0d, 0x0e, 0x0f, 0x13, 0x13, 0x15, 0x19,
-				0x1a, 0x1a, 0x1f, 0x20, 0x26, 0x29, 0x2d, 0x2e,
-				0x2f, 0x32, 0x39, 0x3d, 0x3f, 0x40, 0x42, 0x45,
-				0x48, 0x49, 0x4a, 0x4e, 0x4e, 0x52, 0x53, 0x56,
-				0x5b, 0x61, 0x67, 0x68, 0x6a, 0x72, 0x75, 0x7c,
-				0x7f, 0x81, 0x82, 0x8f, 0x91, 0x92, 0x99, 0x9b,
-				0xa0, 0xa1, 0xa2, 0xa4, 0xa5, 0xa7, 0xac, 0xad,
-				0xaf, 0xb0, 0xb2, 0xb4, 0xba, 0xc3, 0xc5, 0xc6,
-				0xc6, 0xd0, 0xd1, 0xd6, 0xd6, 0xd9, 0xe4, 0xe8,
-				0xee, 0xef, 0xf7, 0xf7, 0xf9, 0xfe,
-			},
-			{
-				0x00, 0x01, 0x02, 0x05, 0x08, 0x0a, 0x0e, 0x0f,
-				0x11, 0x11, 0x14, 0x16, 0x17, 0x1c, 0x1e, 0x23,
-				0x30, 0x31, 0x33, 0x34, 0x39, 0x3b, 0x3d, 0x40,
-				0x43, 0x45, 0x47, 0x49, 0x4d, 0x4d, 0x51, 0x57,
-				0x5e, 0x60, 0x64, 0x65, 0x67, 0x69, 0x6c, 0x6d,
-				0x6f, 0x71, 0x73, 0x7c, 0x86, 0x87, 0x88, 0x88,
-				0x89, 0x8f, 0x92, 0x95, 0x96, 0x9d, 0xa2, 0xa8,
-				0xab, 0xae, 0xb0, 0xb9, 0xbf, 0xc0, 0xc0, 0xc2,
-				0xc7, 0xc9, 0xcd, 0xd2, 0xd3, 0xd5, 0xd9, 0xda,
-				0xdc, 0xdf, 0xe5, 0xe6, 0xe7, 0xe9, 0xec, 0xee,
-				0xf1, 0xf3, 0xf4, 0xf5, 0xf6, 0xf9,
-			},
-			{
-				0x04, 0x07, 0x0b, 0x0b, 0x0d, 0x1d, 0x22, 0x26,
-				0x2a, 0x2b, 0x2e, 0x37, 0x39, 0x3a, 0x3c, 0x3e,
-				0x41, 0x42, 0x47, 0x4b, 0x59, 0x5c, 0x60, 0x60,
-				0x62, 0x66, 0x67, 0x69, 0x6b, 0x6b, 0x6d, 0x6e,
-				0x73, 0x76, 0x7a, 0x7c, 0x83, 0x84, 0x8d, 0x95,
-				0x9c, 0x9d, 0x9e, 0x9f, 0x9f, 0xa0, 0xa3, 0xa6,
-				0xa6, 0xac, 0xac, 0xb2, 0xb6, 0xb7, 0xb8, 0xb8,
-				0xb8, 0xbc, 0xc4, 0xc5, 0xc8, 0xc8, 0xca, 0xcb,
-				0xcb, 0xcd, 0xcf, 0xd1, 0xd2, 0xd3, 0xd4, 0xd8,
-				0xd9, 0xdb, 0xdb, 0xde, 0xe3, 0xe4, 0xe6, 0xea,
-				0xec, 0xef, 0xf0, 0xf1, 0xf8, 0xfc,
-			},
-			{
-				0x06, 0x0c, 0x0c, 0x0f, 0x13, 0x14, 0x19, 0x1d,
-				0x20, 0x28, 0x2f, 0x30, 0x37, 0x38, 0x3e, 0x3f,
-				0x43, 0x46, 0x4a, 0x4d, 0x4f, 0x50, 0x51, 0x52,
-				0x54, 0x56, 0x57, 0x59, 0x5b, 0x5d, 0x5f, 0x62,
-				0x68, 0x6a, 0x6f, 0x72, 0x74, 0x74, 0x7b, 0x82,
-				0x84, 0x85, 0x86, 0x8a, 0x8d, 0x90, 0x93, 0x94,
-				0x97, 0x9a, 0x9e, 0xa5, 0xa5, 0xa7, 0xaa, 0xaa,
-				0xb3, 0xb5, 0xb5, 0xb6, 0xb7, 0xbb, 0xbc, 0xbe,
-				0xc3, 0xc9, 0xce, 0xd4, 0xd7, 0xdd, 0xe0, 0xe0,
-				0xe2, 0xe8, 0xe9, 0xeb, 0xec, 0xf2, 0xf4, 0xf5,
-				0xf6, 0xf8, 0xfa, 0xfb, 0xfb, 0xfc,
-			},
-			{
-				0x00, 0x02, 0x05, 0x09, 0x0d, 0x10, 0x10, 0x11,
-				0x12, 0x15, 0x16, 0x17, 0x18, 0x1b, 0x21, 0x21,
-				0x22, 0x25, 0x28, 0x2b, 0x2d, 0x33, 0x35, 0x36,
-				0x36, 0x37, 0x41, 0x46, 0x4c, 0x4f, 0x55, 0x5a,
-				0x5b, 0x5c, 0x66, 0x68, 0x71, 0x71, 0x76, 0x77,
-				0x7b, 0x7d, 0x7f, 0x80, 0x80, 0x83, 0x85, 0x8b,
-				0x8b, 0x96, 0x98, 0xa3, 0xa9, 0xa9, 0xa9, 0xaa,
-				0xb0, 0xb1, 0xb1, 0xbd, 0xbf, 0xc2, 0xc4, 0xc8,
-				0xcc, 0xce, 0xcf, 0xcf, 0xd2, 0xd3, 0xd6, 0xd8,
-				0xda, 0xde, 0xe0, 0xe2, 0xe9, 0xeb, 0xed, 0xf7,
-				0xf8, 0xfa, 0xfd, 0xfe, 0xff, 0xff,
-			},
-			{
-				0x01, 0x05, 0x0a, 0x12, 0x14, 0x18, 0x1e, 0x23,
-				0x25, 0x26, 0x2b, 0x2c, 0x31, 0x32, 0x34, 0x35,
-				0x38, 0x41, 0x46, 0x48, 0x4b, 0x53, 0x55, 0x56,
-				0x58, 0x58, 0x5a, 0x5c, 0x5d, 0x5e, 0x61, 0x63,
-				0x66, 0x6b, 0x70, 0x70, 0x77, 0x78, 0x78, 0x7e,
-				0x87, 0x87, 0x89, 0x8e, 0x8e, 0x90, 0x91, 0x94,
-				0x97, 0x97, 0x98, 0x98, 0x99, 0x9b, 0x9d, 0x9f,
-				0xa4, 0xa4, 0xa7, 0xab, 0xaf, 0xb3, 0xb4, 0xb4,
-				0xb9, 0xba, 0xbb, 0xbb, 0xc0, 0xca, 0xd1, 0xd5,
-				0xd7, 0xd7, 0xdd, 0xdf, 0xe1, 0xe1, 0xe3, 0xe7,
-				0xee, 0xf0, 0xf0, 0xfa, 0xfd, 0xff,
-			},
-		},
-
-		// Group 20
-		{
-			{
-				0x02, 0x07, 0x08, 0x0b, 0x16, 0x17, 0x1a, 0x1b,
-				0x1b, 0x1d, 0x24, 0x24, 0x25, 0x29, 0x2a, 0x2a,
-				0x33, 0x35, 0x39, 0x3a, 0x3c, 0x3c, 0x41, 0x42,
-				0x42, 0x44, 0x44, 0x46, 0x47, 0x48, 0x49, 0x4c,
-				0x4d, 0x50, 0x53, 0x53, 0x54, 0x56, 0x5d, 0x63,
-				0x65, 0x69, 0x6d, 0x6f, 0x76, 0x7b, 0x7f, 0x8e,
-				0x92, 0x96, 0x99, 0x9a, 0x9a, 0x9c, 0x9f, 0xa0,
-				0xa3, 0xa4, 0xa7, 0xa9, 0xac, 0xad, 0xb1, 0xb1,
-				0xb9, 0xbb, 0xbd, 0xc1, 0xc5, 0xc7, 0xc8, 0xc9,
-				0xca, 0xd4, 0xd7, 0xd8, 0xdb, 0xe0, 0xe3, 0xe6,
-				0xe6, 0xe8, 0xf3, 0xf7, 0xf7, 0xfc,
-			},
-			{
-				0x03, 0x06, 0x0a, 0x0d, 0x0d, 0x0e, 0x13, 0x14,
-				0x1e, 0x1f, 0x23, 0x2b, 0x2b, 0x2f, 0x34, 0x34,
-				0x36, 0x37, 0x38, 0x39, 0x46, 0x48, 0x5e, 0x5e,
-				0x5f, 0x61, 0x65, 0x68, 0x6d, 0x6f, 0x70, 0x76,
-				0x7a, 0x7a, 0x7f, 0x82, 0x83, 0x86, 0x87, 0x8a,
-				0x90, 0x91, 0x94, 0x97, 0x97, 0x98, 0x9e, 0xa2,
-				0xa5, 0xa7, 0xaa, 0xae, 0xaf, 0xb0, 0xb2, 0xb2,
-				0xb4, 0xb4, 0xb6, 0xb9, 0xba, 0xbb, 0xbf, 0xc0,
-				0xc0, 0xc0, 0xc4, 0xcc, 0xcd, 0xd0, 0xd0, 0xd2,
-				0xd2, 0xd7, 0xd7, 0xdd, 0xe0, 0xe3, 0xe3, 0xe9,
-				0xea, 0xf2, 0xf4, 0xf8, 0xf8, 0xfb,
-			},
-			{
-				0x00, 0x01, 0x05, 0x06, 0x09, 0x0c, 0x0f, 0x0f,
-				0x10, 0x10, 0x11, 0x13, 0x14, 0x15, 0x18, 0x18,
-				0x20, 0x24, 0x26, 0x2c, 0x31, 0x38, 0x3d, 0x3e,
-				0x3f, 0x40, 0x43, 0x43, 0x4b, 0x4b, 0x50, 0x57,
-				0x58, 0x5d, 0x5f, 0x63, 0x66, 0x6a, 0x71, 0x72,
-				0x72, 0x74, 0x78, 0x7e, 0x82, 0x84, 0x87, 0x88,
-				0x8a, 0x8b, 0x8d, 0x93, 0x94, 0x99, 0x9d, 0x9e,
-				0x9f, 0xa3, 0xab, 0xad, 0xb5, 0xb6, 0xbc, 0xc6,
-				0xc9, 0xcb, 0xcc, 0xcf, 0xd2, 0xd6, 0xd8, 0xda,
-				0xdf, 0xe4, 0xeb, 0xef, 0xef, 0xf0, 0xf2, 0xf3,
-				0xf3, 0xf8, 0xf9, 0xfa, 0xfd, 0xff,
-			},
-			{
-				0x04, 0x06, 0x09, 0x0b, 0x0b, 0x0c, 0x0e, 0x16,
-				0x19, 0x19, 0x19, 0x1c, 0x1e, 0x20, 0x25, 0x27,
-				0x28, 0x28, 0x2f, 0x34, 0x37, 0x3b, 0x40, 0x46,
-				0x4c, 0x4d, 0x4f, 0x51, 0x52, 0x54, 0x56, 0x57,
-				0x59, 0x5a, 0x5a, 0x5c, 0x67, 0x6b, 0x6c, 0x70,
-				0x70, 0x71, 0x73, 0x75, 0x77, 0x79, 0x7a, 0x7d,
-				0x85, 0x85, 0x8b, 0x8c, 0x8d, 0x8e, 0x90, 0x91,
-				0x92, 0x95, 0xa0, 0xa1, 0xa2, 0xa6, 0xae, 0xaf,
-				0xb3, 0xb7, 0xc1, 0xc6, 0xc7, 0xca, 0xcb, 0xcf,
-				0xd1, 0xd3, 0xda, 0xdb, 0xde, 0xe1, 0xe5, 0xec,
-				0xed, 0xef, 0xf0, 0xf6, 0xfe, 0xff,
-			},
-			{
-				0x00, 0x03, 0x05, 0x0c, 0x11, 0x15, 0x17, 0x1c,
-				0x1c, 0x1f, 0x21, 0x21, 0x22, 0x27, 0x2c, 0x2d,
-				0x2e, 0x30, 0x32, 0x32, 0x3a, 0x3c, 0x3d, 0x3e,
-				0x40, 0x45, 0x4a, 0x4a, 0x4d, 0x4e, 0x50, 0x52,
-				0x57, 0x58, 0x5b, 0x5c, 0x5f, 0x60, 0x61, 0x64,
-				0x64, 0x66, 0x6a, 0x71, 0x73, 0x73, 0x74, 0x77,
-				0x7c, 0x7d, 0x80, 0x81, 0x83, 0x86, 0x88, 0x8c,
-				0x8f, 0x95, 0x96, 0x9c, 0xa1, 0xa5, 0xa6, 0xb3,
-				0xb7, 0xb8, 0xba, 0xbc, 0xc1, 0xc3, 0xc4, 0xc8,
-				0xcd, 0xce, 0xd6, 0xd9, 0xe2, 0xe7, 0xe9, 0xeb,
-				0xed, 0xed, 0xf4, 0xf5, 0xfa, 0xfe,
-			},
-			{
-				0x00, 0x07, 0x0e, 0x11, 0x12, 0x1a, 0x1a, 0x1b,
-				0x1e, 0x26, 0x2d, 0x2e, 0x2e, 0x30, 0x35, 0x35,
-				0x3a, 0x3b, 0x3f, 0x3f, 0x48, 0x4e, 0x51, 0x51,
-				0x52, 0x54, 0x55, 0x55, 0x59, 0x62, 0x64, 0x66,
-				0x67, 0x6b, 0x6c, 0x6d, 0x6e, 0x74, 0x75, 0x79,
-				0x7e, 0x80, 0x81, 0x84, 0x87, 0x8b, 0x8f, 0x93,
-				0x9b, 0xa0, 0xa1, 0xa4, 0xa7, 0xa8, 0xa9, 0xb0,
-				0xb5, 0xb5, 0xb7, 0xb9, 0xbe, 0xbf, 0xc2, 0xc3,
-				0xc7, 0xce, 0xd1, 0xd5, 0xd5, 0xdc, 0xdd, 0xdd,
-				0xde, 0xe1, 0xe2, 0xe7, 0xe8, 0xec, 0xee, 0xee,
-				0xf1, 0xf7, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-			{
-				0x01, 0x02, 0x03, 0x04, 0x05, 0x07, 0x08, 0x0a,
-				0x0f, 0x12, 0x13, 0x1d, 0x20, 0x22, 0x23, 0x27,
-				0x29, 0x2b, 0x30, 0x31, 0x32, 0x33, 0x36, 0x39,
-				0x3b, 0x41, 0x43, 0x45, 0x47, 0x49, 0x4a, 0x4f,
-				0x59, 0x5a, 0x5b, 0x60, 0x62, 0x68, 0x69, 0x6c,
-				0x6e, 0x72, 0x78, 0x7b, 0x7c, 0x80, 0x81, 0x89,
-				0x89, 0x91, 0x97, 0x98, 0x9b, 0x9c, 0x9d, 0xa5,
-				0xa8, 0xa9, 0xaa, 0xab, 0xac, 0xad, 0xb6, 0xb8,
-				0xbd, 0xbe, 0xc2, 0xc5, 0xc9, 0xd1, 0xd3, 0xd4,
-				0xd5, 0xd9, 0xdb, 0xdc, 0xde, 0xdf, 0xe4, 0xe5,
-				0xea, 0xee, 0xf1, 0xf5, 0xf6, 0xfc,
-			},
-		},
-
-		// Group 21
-		{
-			{
-				0x00, 0x03, 0x07, 0x08, 0x0d, 0x0e, 0x13, 0x14,
-				0x18, 0x1b, 0x22, 0x24, 0x24, 0x2b, 0x2b, 0x2c,
-				0x2e, 0x2e, 0x2e, 0x39, 0x3e, 0x43, 0x45, 0x48,
-				0x48, 0x4b, 0x4c, 0x52, 0x54, 0x55, 0x59, 0x60,
-				0x67, 0x67, 0x68, 0x68, 0x6a, 0x6c, 0x73, 0x73,
-				0x76, 0x76, 0x77, 0x79, 0x7a, 0x7c, 0x80, 0x81,
-				0x83, 0x84, 0x86, 0x89, 0x8c, 0x93, 0x98, 0x9b,
-				0x9f, 0xa2, 0xa4, 0xa6, 0xa7, 0xa7, 0xa8, 0xa9,
-				0xa9, 0xab, 0xad, 0xb0, 0xb6, 0xba, 0xbd, 0xc1,
-				0xc4, 0xc8, 0xc8, 0xcc, 0xcd, 0xcd, 0xd8, 0xd9,
-				0xda, 0xe2, 0xeb, 0xf3, 0xf7, 0xff,
-			},
-			{
-				0x01, 0x02, 0x0a, 0x0f, 0x10, 0x11, 0x14, 0x19,
-				0x1a, 0x1f, 0x21, 0x21, 0x24, 0x26, 0x27, 0x32,
-				0x32, 0x34, 0x35, 0x3b, 0x3b, 0x3b, 0x3e, 0x41,
-				0x42, 0x46, 0x47, 0x48, 0x4d, 0x4f, 0x54, 0x5a,
-				0x5a, 0x5e, 0x5f, 0x60, 0x6e, 0x6e, 0x6f, 0x72,
-				0x75, 0x77, 0x7b, 0x7e, 0x7e, 0x81, 0x81, 0x82,
-				0x82, 0x87, 0x87, 0x8b, 0x8d, 0x92, 0x95, 0x97,
-				0x9b, 0x9e, 0xa4, 0xa5, 0xac, 0xad, 0xb4, 0xb8,
-				0xba, 0xbb, 0xbc, 0xc5, 0xce, 0xcf, 0xd3, 0xd6,
-				0xd9, 0xde, 0xdf, 0xe1, 0xec, 0xed, 0xef, 0xf1,
-				0xf3, 0xf3, 0xf6, 0xf6, 0xfa, 0xfb,
-			},
-			{
-				0x03, 0x04, 0x05, 0x06, 0x0d, 0x15, 0x16, 0x17,
-				0x19, 0x23, 0x26, 0x27, 0x28, 0x29, 0x2a, 0x2c,
-				0x2d, 0x31, 0x33, 0x34, 0x36, 0x3a, 0x46, 0x4a,
-				0x51, 0x52, 0x57, 0x58, 0x59, 0x5c, 0x62, 0x63,
-				0x68, 0x69, 0x6f, 0x70, 0x74, 0x75, 0x77, 0x78,
-				0x7c, 0x7e, 0x80, 0x84, 0x85, 0x88, 0x8a, 0x8b,
-				0x8d, 0x90, 0x90, 0x91, 0x91, 0x94, 0x96, 0x97,
-				0x98, 0x9a, 0xa0, 0xaa, 0xad, 0xae, 0xb1, 0xb8,
-				0xb9, 0xbc, 0xbe, 0xc2, 0xc5, 0xc6, 0xc6, 0xca,
-				0xcb, 0xcf, 0xd0, 0xd1, 0xd2, 0xd3, 0xd4, 0xd5,
-				0xdc, 0xe0, 0xe8, 0xf2, 0xf8, 0xf9,
-			},
-			{
-				0x0f, 0x12, 0x17, 0x19, 0x1c, 0x1d, 0x1e, 0x1f,
-				0x22, 0x26, 0x29, 0x2d, 0x2f, 0x34, 0x36, 0x38,
-				0x3a, 0x3c, 0x3c, 0x3d, 0x40, 0x41, 0x47, 0x4d,
-				0x4e, 0x4e, 0x50, 0x50, 0x51, 0x53, 0x59, 0x61,
-				0x63, 0x65, 0x67, 0x69, 0x6c, 0x72, 0x74, 0x78,
-				0x85, 0x93, 0x99, 0x9c, 0x9d, 0xa1, 0xa4, 0xab,
-				0xae, 0xb1, 0xb3, 0xb4, 0xb7, 0xb9, 0xbc, 0xbd,
-				0xc9, 0xc9, 0xcb, 0xce, 0xcf, 0xd0, 0xd1, 0xd9,
-				0xda, 0xdb, 0xdd, 0xe4, 0xe5, 0xe8, 0xe9, 0xea,
-				0xee, 0xee, 0xef, 0xf0, 0xf1, 0xf2, 0xf5, 0xf8,
-				0xf9, 0xfa, 0xfb, 0xfd, 0xfd, 0xfe,
-			},
-			{
-				0x00, 0x02, 0x05, 0x06, 0x0b, 0x0d, 0x0f, 0x10,
-				0x11, 0x16, 0x18, 0x1b, 0x1c, 0x1c, 0x1d, 0x1f,
-				0x22, 0x23, 0x25, 0x2a, 0x2c, 0x2d, 0x2f, 0x31,
-				0x35, 0x37, 0x38, 0x3d, 0x42, 0x43, 0x44, 0x45,
-				0x47, 0x49, 0x4d, 0x56, 0x56, 0x5d, 0x62, 0x66,
-				0x6b, 0x6d, 0x6d, 0x6d, 0x79, 0x7f, 0x86, 0x89,
-				0x8c, 0x92, 0x93, 0x95, 0x9c, 0x9d, 0x9f, 0xa0,
-				0xa0, 0xa1, 0xa3, 0xa3, 0xa9, 0xae, 0xaf, 0xb0,
-				0xb2, 0xb3, 0xb4, 0xb5, 0xbe, 0xbf, 0xc3, 0xc8,
-				0xce, 0xd1, 0xd2, 0xdc, 0xdf, 0xe5, 0xe6, 0xe7,
-				0xe9, 0xeb, 0xec, 0xed, 0xf5, 0xf7,
-			},
-			{
-				0x04, 0x05, 0x09, 0x0a, 0x0c, 0x0c, 0x0e, 0x0e,
-				0x15, 0x1a, 0x20, 0x21, 0x25, 0x30, 0x33, 0x37,
-				0x39, 0x3c, 0x3f, 0x3f, 0x40, 0x41, 0x44, 0x44,
-				0x46, 0x49, 0x4c, 0x4f, 0x53, 0x55, 0x57, 0x5a,
-				0x5b, 0x5c, 0x5d, 0x5d, 0x5f, 0x60, 0x61, 0x64,
-				0x64, 0x65, 0x66, 0x6a, 0x6b, 0x6f, 0x70, 0x71,
-				0x71, 0x79, 0x7d, 0x8e, 0x8f, 0x8f, 0x91, 0x98,
-				0x99, 0x9c, 0x9e, 0xa1, 0xa2, 0xa5, 0xa8, 0xa8,
-				0xaa, 0xac, 0xb2, 0xb5, 0xb7, 0xbb, 0xbf, 0xc2,
-				0xc3, 0xc4, 0xc7, 0xc7, 0xd5, 0xd6, 0xdb, 0xe0,
-				0xe3, 0xf0, 0xf4, 0xf5, 0xfc, 0xfe,
-			},
-			{
-				0x01, 0x01, 0x04, 0x07, 0x08, 0x09, 0x0b, 0x0c,
-				0x12, 0x13, 0x16, 0x17, 0x18, 0x1b, 0x1e, 0x20,
-				0x25, 0x28, 0x29, 0x30, 0x37, 0x4a, 0x4b, 0x4c,
-				0x53, 0x58, 0x5b, 0x5e, 0x62, 0x6b, 0x74, 0x7a,
-				0x7b, 0x7d, 0x7f, 0x80, 0x83, 0x86, 0x87, 0x88,
-				0x8a, 0x8e, 0x8e, 0x90, 0x94, 0x95, 0x96, 0x96,
-				0x9a, 0x9b, 0x9d, 0x9f, 0xa3, 0xa5, 0xa6, 0xaf,
-				0xb1, 0xb6, 0xb9, 0xba, 0xbd, 0xc0, 0xc0, 0xc1,
-				0xc3, 0xc5, 0xca, 0xcc, 0xd0, 0xd4, 0xd7, 0xd7,
-				0xd8, 0xdc, 0xdd, 0xde, 0xe1, 0xe2, 0xe3, 0xe4,
-				0xe6, 0xe7, 0xea, 0xf4, 0xfc, 0xff,
-			},
-		},
-
-		// Group 22
-		{
-			{
-				0x02, 0x04, 0x09, 0x0e, 0x0e, 0x13, 0x18, 0x1a,
-				0x1b, 0x1b, 0x20, 0x22, 0x23, 0x25, 0x2a, 0x2b,
-				0x36, 0x38, 0x39, 0x3d, 0x3d, 0x3f, 0x43, 0x47,
-				0x47, 0x49, 0x4a, 0x4c, 0x4d, 0x51, 0x51, 0x55,
-				0x57, 0x58, 0x5c, 0x63, 0x6a, 0x6a, 0x6c, 0x6d,
-				0x74, 0x76, 0x78, 0x79, 0x79, 0x7b, 0x7e, 0x81,
-				0x86, 0x87, 0x89, 0x8d, 0x8f, 0x91, 0x93, 0x97,
-				0xa1, 0xa1, 0xa4, 0xa7, 0xa8, 0xab, 0xac, 0xad,
-				0xb3, 0xb4, 0xb6, 0xbc, 0xc0, 0xc4, 0xc5, 0xca,
-				0xcd, 0xcf, 0xd3, 0xd7, 0xdf, 0xe4, 0xe4, 0xe8,
-				0xef, 0xf1, 0xf7, 0xf8, 0xfb, 0xfd,
-			},
-			{
-				0x07, 0x11, 0x12, 0x1c, 0x1c, 0x1e, 0x1f, 0x26,
-				0x2a, 0x2a, 0x2c, 0x2d, 0x30, 0x31, 0x32, 0x34,
-				0x3e, 0x3f, 0x40, 0x41, 0x43, 0x44, 0x47, 0x48,
-				0x4a, 0x50, 0x54, 0x56, 0x5f, 0x63, 0x64, 0x64,
-				0x65, 0x69, 0x6c, 0x6d, 0x70, 0x71, 0x73, 0x7c,
-				0x81, 0x82, 0x83, 0x85, 0x86, 0x86, 0x88, 0x88,
-				0x8b, 0x8e, 0x92, 0x94, 0x98, 0x9b, 0x9f, 0xa0,
-				0xaa, 0xae, 0xb4, 0xb7, 0xb8, 0xb8, 0xbb, 0xc1,
-				0xc2, 0xc2, 0xc3, 0xc3, 0xc3, 0xc4, 0xc4, 0xc5,
-				0xc8, 0xcd, 0xd2, 0xd6, 0xde, 0xe4, 0xe7, 0xf4,
-				0xf4, 0xf7, 0xf8, 0xf9, 0xfe, 0xff,
-			},
-			{
-				0x03, 0x03, 0x0b, 0x0c, 0x0c, 0x0d, 0x10, 0x13,
-				0x19, 0x19, 0x1c, 0x1f, 0x29, 0x2b, 0x2d, 0x3b,
-				0x3f, 0x40, 0x41, 0x42, 0x45, 0x45, 0x48, 0x4b,
-				0x4d, 0x4e, 0x50, 0x53, 0x56, 0x58, 0x5c, 0x62,
-				0x64, 0x65, 0x67, 0x69, 0x6b, 0x6f, 0x73, 0x75,
-				0x7b, 0x7b, 0x7c, 0x7e, 0x87, 0x88, 0x89, 0x8d,
-				0x8e, 0x90, 0x9c, 0x9c, 0x9c, 0x9d, 0x9d, 0xa6,
-				0xa6, 0xa8, 0xa9, 0xaa, 0xaf, 0xb1, 0xb2, 0xb3,
-				0xb7, 0xb7, 0xbd, 0xbe, 0xc7, 0xcb, 0xcc, 0xce,
-				0xd3, 0xd3, 0xd6, 0xd7, 0xdb, 0xdc, 0xea, 0xeb,
-				0xf0, 0xf2, 0xf2, 0xf6, 0xfe, 0xfe,
-			},
-			{
-				0x01, 0x01, 0x05, 0x06, 0x09, 0x0d, 0x0f, 0x14,
-				0x1d, 0x22, 0x27, 0x28, 0x2e, 0x2e, 0x34, 0x35,
-				0x37, 0x38, 0x3a, 0x3b, 0x3c, 0x43, 0x46, 0x48,
-				0x4e, 0x4f, 0x57, 0x5a, 0x5b, 0x5b, 0x60, 0x61,
-				0x62, 0x62, 0x67, 0x68, 0x71, 0x75, 0x75, 0x76,
-				0x77, 0x79, 0x7f, 0x82, 0x84, 0x8f, 0x93, 0x94,
-				0x95, 0x95, 0x97, 0x9a, 0x9a, 0x9b, 0xa1, 0xa4,
-				0xa5, 0xac, 0xae, 0xaf, 0xaf, 0xb0, 0xb1, 0xb5,
-				0xb9, 0xba, 0xbf, 0xc1, 0xc8, 0xca, 0xcb, 0xcb,
-				0xcf, 0xd4, 0xd4, 0xda, 0xdd, 0xe0, 0xe1, 0xe5,
-				0xe9, 0xea, 0xeb, 0xf2, 0xf9, 0xfa,
-			},
-			{
-				0x00, 0x00, 0x02, 0x04, 0x05, 0x06, 0x0b, 0x0d,
-				0x10, 0x10, 0x12, 0x14, 0x16, 0x18, 0x1a, 0x1e,
-				0x20, 0x21, 0x21, 0x23, 0x29, 0x2f, 0x32, 0x36,
-				0x39, 0x3a, 0x3e, 0x42, 0x44, 0x49, 0x55, 0x5d,
-				0x61, 0x6b, 0x6f, 0x72, 0x73, 0x74, 0x77, 0x7a,
-				0x7f, 0x8b, 0x8b, 0x8c, 0x90, 0x91, 0x96, 0x9e,
-				0xa0, 0xa3, 0xb0, 0xb5, 0xba, 0xbc, 0xbd, 0xbd,
-				0xc1, 0xc5, 0xc6, 0xc7, 0xc8, 0xcc, 0xd0, 0xd0,
-				0xd1, 0xd5, 0xd8, 0xd9, 0xda, 0xdd, 0xde, 0xe1,
-				0xe3, 0xe5, 0xe7, 0xea, 0xec, 0xed, 0xef, 0xf4,
-				0xf5, 0xf8, 0xf9, 0xfa, 0xfc, 0xfd,
-			},
-			{
-				0x07, 0x08, 0x08, 0x0a, 0x0c, 0x0e, 0x11, 0x11,
-				0x15, 0x17, 0x19, 0x1d, 0x24, 0x24, 0x25, 0x26,
-				0x28, 0x2c, 0x2e, 0x30, 0x34, 0x35, 0x37, 0x38,
-				0x3a, 0x42, 0x4c, 0x4f, 0x52, 0x53, 0x54, 0x5b,
-				0x5d, 0x5e, 0x61, 0x66, 0x67, 0x68, 0x69, 0x72,
-				0x78, 0x7a, 0x7d, 0x7d, 0x7f, 0x80, 0x84, 0x8a,
-				0x8d, 0x8e, 0x92, 0x92, 0x98, 0xa2, 0xa9, 0xad,
-				0xb0, 0xb2, 0xb8, 0xb9, 0xb9, 0xba, 0xbb, 0xbc,
-				0xbf, 0xc9, 0xc9, 0xd7, 0xd9, 0xda, 0xdb, 0xe0,
-				0xe0, 0xe2, 0xe5, 0xe6, 0xe7, 0xe8, 0xe9, 0xec,
-				0xee, 0xef, 0xf0, 0xf3, 0xf6, 0xff,
-			},
-			{
-				0x01, 0x02, 0x0a, 0x0f, 0x13, 0x15, 0x16, 0x17,
-				0x17, 0x1e, 0x25, 0x26, 0x27, 0x28, 0x2f, 0x31,
-				0x33, 0x33, 0x36, 0x37, 0x3b, 0x3c, 0x46, 0x4b,
-				0x4b, 0x52, 0x53, 0x58, 0x59, 0x59, 0x5a, 0x5e,
-				0x5f, 0x60, 0x66, 0x6e, 0x6e, 0x6f, 0x70, 0x72,
-				0x74, 0x7d, 0x80, 0x80, 0x82, 0x83, 0x85, 0x8a,
-				0x8c, 0x93, 0x96, 0x99, 0x99, 0x9e, 0x9f, 0xa2,
-				0xa3, 0xa5, 0xa6, 0xa7, 0xaa, 0xab, 0xb2, 0xb6,
-				0xbe, 0xc0, 0xc6, 0xce, 0xd1, 0xd2, 0xd5, 0xd8,
-				0xdc, 0xdf, 0xe2, 0xe3, 0xe6, 0xed, 0xee, 0xf1,
-				0xf3, 0xf5, 0xf5, 0xfb, 0xfc, 0xfc,
-			},
-		},
-
-		// Group 23
-		{
-			{
-				0x03, 0x06, 0x0b, 0x0c, 0x0e, 0x10, 0x13, 0x14,
-				0x1d, 0x2d, 0x30, 0x36, 0x3b, 0x3c, 0x3c, 0x40,
-				0x42, 0x43, 0x4f, 0x51, 0x56, 0x58, 0x5c, 0x5d,
-				0x60, 0x62, 0x65, 0x67, 0x68, 0x6b, 0x71, 0x79,
-				0x7d, 0x7e, 0x7e, 0x80, 0x89, 0x8b, 0x8e, 0x90,
-				0x93, 0x97, 0x98, 0x9f, 0xa0, 0xa3, 0xa7, 0xa8,
-				0xab, 0xac, 0xae, 0xae, 0xb2, 0xb2, 0xb3, 0xb4,
-				0xb4, 0xb7, 0xbc, 0xbf, 0xc0, 0xc3, 0xc3, 0xc5,
-				0xc6, 0xc8, 0xc9, 0xd0, 0xd0, 0xd2, 0xdd, 0xe0,
-				0xe4, 0xe9, 0xeb, 0xec, 0xee, 0xf0, 0xf1, 0xf3,
-				0xf4, 0xf6, 0xfa, 0xfd, 0xfd, 0xff,
-			},
-			{
-				0x03, 0x07, 0x07, 0x08, 0x09, 0x1c, 0x1c, 0x1d,
-				0x1e, 0x20, 0x30, 0x31, 0x35, 0x39, 0x3b, 0x3d,
-				0x3e, 0x3f, 0x40, 0x42, 0x44, 0x45, 0x46, 0x47,
-				0x49, 0x4d, 0x4f, 0x57, 0x59, 0x5f, 0x62, 0x63,
-				0x64, 0x71, 0x74, 0x77, 0x78, 0x7b, 0x7c, 0x7f,
-				0x85, 0x89, 0x8d, 0x8e, 0x8f, 0x91, 0x9e, 0xa1,
-				0xa3, 0xa4, 0xab, 0xac, 0xaf, 0xb7, 0xb9, 0xba,
-				0xbb, 0xbf, 0xc0, 0xc4, 0xc5, 0xc6, 0xcb, 0xcb,
-				0xce, 0xcf, 0xd1, 0xd7, 0xd9, 0xda, 0xdd, 0xde,
-				0xdf, 0xe8, 0xeb, 0xed, 0xee, 0xf1, 0xf2, 0xf7,
-				0xf8, 0xf9, 0xfa, 0xfb, 0xfc, 0xff,
-			},
-			{
-				0x01, 0x04, 0x04, 0x09, 0x0a, 0x0b, 0x0f, 0x18,
-				0x19, 0x1f, 0x23, 0x24, 0x26, 0x2b, 0x2d, 0x2f,
-				0x33, 0x34, 0x34, 0x38, 0x38, 0x39, 0x3e, 0x43,
-				0x45, 0x49, 0x4e, 0x52, 0x57, 0x58, 0x5e, 0x61,
-				0x61, 0x69, 0x69, 0x6a, 0x6b, 0x70, 0x70, 0x73,
-				0x74, 0x76, 0x77, 0x7a, 0x81, 0x84, 0x8c, 0x8c,
-				0x93, 0x98, 0x99, 0x9b, 0x9c, 0x9d, 0x9f, 0xa4,
-				0xa5, 0xa6, 0xa9, 0xab, 0xb0, 0xb1, 0xb6, 0xb8,
-				0xbd, 0xbd, 0xbe, 0xc2, 0xca, 0xd1, 0xd6, 0xd8,
-				0xdc, 0xde, 0xe0, 0xe1, 0xe2, 0xe3, 0xe4, 0xe8,
-				0xec, 0xf1, 0xf5, 0xf6, 0xf8, 0xfc,
-			},
-			{
-				0x02, 0x05, 0x0b, 0x0d, 0x0e, 0x0f, 0x10, 0x11,
-				0x12, 0x15, 0x19, 0x1b, 0x1b, 0x21, 0x24, 0x25,
-				0x2a, 0x2d, 0x3d, 0x41, 0x48, 0x48, 0x4a, 0x4b,
-				0x4c, 0x4e, 0x53, 0x57, 0x59, 0x5b, 0x5d, 0x5f,
-				0x5f, 0x64, 0x65, 0x69, 0x6c, 0x6f, 0x76, 0x79,
-				0x7a, 0x80, 0x82, 0x87, 0x8b, 0x8c, 0x8d, 0x8e,
-				0x90, 0x92, 0x94, 0x95, 0x96, 0x9b, 0x9d, 0xa0,
-				0xa5, 0xa6, 0xa8, 0xa9, 0xaa, 0xad, 0xad, 0xbb,
-				0xbd, 0xc1, 0xc5, 0xc9, 0xcc, 0xcc, 0xcd, 0xd2,
-				0xd3, 0xd5, 0xd7, 0xd9, 0xda, 0xdb, 0xdd, 0xe6,
-				0xe7, 0xea, 0xea, 0xec, 0xf8, 0xf9,
-			},
-			{
-				0x00, 0x00, 0x09, 0x0c, 0x12, 0x13, 0x14, 0x16,
-				0x17, 0x18, 0x1a, 0x1e, 0x1f, 0x21, 0x29, 0x2b,
-				0x2c, 0x2e, 0x32, 0x36, 0x37, 0x38, 0x3f, 0x44,
-				0x48, 0x4b, 0x50, 0x52, 0x56, 0x5b, 0x5c, 0x5e,
-				0x60, 0x61, 0x66, 0x66, 0x66, 0x67, 0x6d, 0x72,
-				0x78, 0x7b, 0x7d, 0x81, 0x81, 0x83, 0x84, 0x86,
-				0x88, 0x91, 0x95, 0x99, 0x9a, 0x9d, 0xa1, 0xa2,
-				0xa5, 0xa7, 0xa8, 0xb5, 0xb8, 0xc0, 0xc1, 0xc2,
-				0xc3, 0xcb, 0xce, 0xcf, 0xd2, 0xd4, 0xd4, 0xdb,
-				0xdf, 0xe5, 0xe6, 0xe6, 0xe7, 0xeb, 0xed, 0xef,
-				0xef, 0xf2, 0xf3, 0xfb, 0xfd, 0xfe,
-			},
-			{
-				0x01, 0x02, 0x03, 0x04, 0x05, 0x07, 0x08, 0x0a,
-				0x0c, 0x10, 0x15, 0x17, 0x1e, 0x22, 0x23, 0x26,
-				0x27, 0x28, 0x2a, 0x2b, 0x2e, 0x30, 0x31, 0x32,
-				0x34, 0x37, 0x3a, 0x41, 0x44, 0x47, 0x4a, 0x4a,
-				0x4c, 0x4d, 0x50, 0x52, 0x54, 0x54, 0x55, 0x55,
-				0x5a, 0x5a, 0x5a, 0x63, 0x68, 0x6c, 0x6d, 0x6e,
-				0x6e, 0x72, 0x75, 0x79, 0x7c, 0x7e, 0x82, 0x86,
-				0x87, 0x88, 0x89, 0x8a, 0x91, 0x97, 0x9a, 0x9c,
-				0xa2, 0xaf, 0xb0, 0xb1, 0xb3, 0xb5, 0xb6, 0xb9,
-				0xc7, 0xca, 0xcd, 0xd1, 0xd5, 0xd6, 0xe1, 0xe3,
-				0xe7, 0xee, 0xf2, 0xf4, 0xf7, 0xfe,
-			},
-			{
-				0x06, 0x0d, 0x11, 0x16, 0x17, 0x19, 0x1a, 0x20,
-				0x22, 0x24, 0x25, 0x27, 0x27, 0x28, 0x29, 0x2c,
-				0x2f, 0x32, 0x33, 0x33, 0x35, 0x3a, 0x3e, 0x42,
-				0x45, 0x46, 0x4f, 0x51, 0x53, 0x55, 0x58, 0x60,
-				0x62, 0x63, 0x64, 0x68, 0x6a, 0x6f, 0x72, 0x73,
-				0x75, 0x7f, 0x83, 0x84, 0x85, 0x8a, 0x8f, 0x92,
-				0x92, 0x94, 0x96, 0x96, 0x97, 0x9e, 0xa2, 0xa3,
-				0xa9, 0xaa, 0xad, 0xb0, 0xb2, 0xb4, 0xba, 0xbc,
-				0xbc, 0xbe, 0xc4, 0xc6, 0xc7, 0xc8, 0xc9, 0xcf,
-				0xd3, 0xd8, 0xdc, 0xdf, 0xe0, 0xe1, 0xe2, 0xe4,
-				0xe5, 0xe8, 0xe9, 0xf0, 0xf5, 0xf6,
-			},
-		},
-
-		// Group 24
-		{
-			{
-				0x02, 0x02, 0x03, 0x06, 0x07, 0x08, 0x12, 0x16,
-				0x19, 0x19, 0x1b, 0x1d, 0x21, 0x22, 0x27, 0x27,
-				0x2b, 0x2c, 0x2e, 0x2e, 0x34, 0x37, 0x3c, 0x42,
-				0x4e, 0x51, 0x53, 0x58, 0x59, 0x5e, 0x62, 0x6a,
-				0x6c, 0x6c, 0x6e, 0x71, 0x77, 0x7b, 0x84, 0x86,
-				0x86, 0x86, 0x87, 0x88, 0x89, 0x8b, 0x8f, 0x92,
-				0x93, 0x94, 0x98, 0x9b, 0xa8, 0xac, 0xad, 0xb0,
-				0xb3, 0xb5, 0xb6, 0xbc, 0xbd, 0xc1, 0xc2, 0xc5,
-				0xc8, 0xcb, 0xcf, 0xd1, 0xd2, 0xd9, 0xd9, 0xda,
-				0xdb, 0xe0, 0xe3, 0xe6, 0xe7, 0xe9, 0xec, 0xee,
-				0xee, 0xf3, 0xf4, 0xf4, 0xfc, 0xfd,
-			},
-			{
-				0x00, 0x01, 0x03, 0x0a, 0x0b, 0x0e, 0x15, 0x20,
-				0x24, 0x26, 0x29, 0x2b, 0x2c, 0x2d, 0x2f, 0x32,
-				0x34, 0x37, 0x3d, 0x3d, 0x40, 0x43, 0x43, 0x48,
-				0x4c, 0x4d, 0x50, 0x52, 0x55, 0x56, 0x5d, 0x60,
-				0x64, 0x6d, 0x72, 0x73, 0x75, 0x78, 0x79, 0x7a,
-				0x7a, 0x7c, 0x7d, 0x7e, 0x7f, 0x80, 0x84, 0x85,
-				0x85, 0x8a, 0x8a, 0x90, 0x93, 0x98, 0x99, 0x9d,
-				0x9e, 0xa5, 0xa7, 0xb0, 0xb0, 0xb8, 0xb8, 0xb9,
-				0xc2, 0xc3, 0xc7, 0xca, 0xcc, 0xd6, 0xd7, 0xd9,
-				0xdc, 0xdd, 0xdf, 0xe0, 0xe6, 0xe7, 0xe9, 0xea,
-				0xec, 0xef, 0xf3, 0xf6, 0xf7, 0xfa,
-			},
-			{
-				0x02, 0x04, 0x0c, 0x0d, 0x13, 0x13, 0x18, 0x19,
-				0x1c, 0x1c, 0x23, 0x24, 0x2a, 0x2d, 0x31, 0x31,
-				0x33, 0x39, 0x3c, 0x47, 0x4a, 0x4e, 0x50, 0x52,
-				0x57, 0x58, 0x5a, 0x5c, 0x5d, 0x5f, 0x5f, 0x64,
-				0x66, 0x6a, 0x6d, 0x70, 0x71, 0x7b, 0x7e, 0x82,
-				0x83, 0x85, 0x88, 0x8b, 0x8c, 0x8d, 0x92, 0x94,
-				0x94, 0x95, 0x96, 0x97, 0x99, 0x9c, 0x9d, 0x9f,
-				0xa0, 0xa2, 0xa4, 0xa6, 0xa7, 0xaa, 0xab, 0xae,
-				0xaf, 0xb4, 0xb7, 0xbf, 0xc0, 0xc8, 0xca, 0xd4,
-				0xd5, 0xd8, 0xdb, 0xdd, 0xea, 0xf1, 0xf2, 0xf4,
-				0xf5, 0xf7, 0xf8, 0xf8, 0xfb, 0xff,
-			},
-			{
-				0x00, 0x01, 0x05, 0x07, 0x08, 0x0a, 0x0a, 0x0b,
-				0x0c, 0x0f, 0x14, 0x15, 0x17, 0x17, 0x1a, 0x1c,
-				0x21, 0x22, 0x27, 0x30, 0x33, 0x34, 0x38, 0x3a,
-				0x3a, 0x40, 0x42, 0x43, 0x46, 0x4b, 0x4b, 0x4f,
-				0x52, 0x55, 0x56, 0x57, 0x5b, 0x5c, 0x5f, 0x60,
-				0x60, 0x61, 0x63, 0x67, 0x6b, 0x6c, 0x6e, 0x6f,
-				0x70, 0x72, 0x73, 0x77, 0x83, 0x89, 0x8c, 0x8d,
-				0x8f, 0x92, 0x95, 0x97, 0x97, 0x9a, 0xa3, 0xa3,
-				0xa6, 0xa7, 0xaa, 0xab, 0xb1, 0xb5, 0xc1, 0xce,
-				0xd4, 0xd6, 0xda, 0xdc, 0xde, 0xdf, 0xe1, 0xe2,
-				0xe3, 0xe4, 0xe8, 0xf1, 0xf2, 0xfc,
-			},
-			{
-				0x00, 0x04, 0x09, 0x0d, 0x0f, 0x11, 0x16, 0x18,
-				0x1b, 0x1f, 0x21, 0x25, 0x26, 0x2a, 0x2f, 0x32,
-				0x33, 0x35, 0x35, 0x36, 0x36, 0x39, 0x3e, 0x3f,
-				0x41, 0x45, 0x46, 0x47, 0x49, 0x4b, 0x4d, 0x4f,
-				0x5a, 0x5c, 0x5e, 0x61, 0x75, 0x79, 0x7c, 0x7e,
-				0x81, 0x81, 0x82, 0x87, 0x8f, 0x91, 0x9a, 0x9a,
-				0x9b, 0x9e, 0x9f, 0x9f, 0xa2, 0xa9, 0xa9, 0xac,
-				0xae, 0xb1, 0xb2, 0xb4, 0xb6, 0xb7, 0xba, 0xbb,
-				0xbd, 0xcc, 0xcd, 0xce, 0xd0, 0xd2, 0xd3, 0xd7,
-				0xde, 0xdf, 0xe5, 0xea, 0xeb, 0xeb, 0xed, 0xf0,
-				0xf5, 0xf6, 0xf8, 0xfe, 0xfe, 0xff,
-			},
-			{
-				0x06, 0x09, 0x0e, 0x10, 0x10, 0x11, 0x14, 0x1a,
-				0x1a, 0x1e, 0x1f, 0x20, 0x22, 0x23, 0x25, 0x28,
-				0x28, 0x29, 0x2f, 0x35, 0x38, 0x39, 0x3b, 0x3e,
-				0x40, 0x41, 0x44, 0x45, 0x48, 0x49, 0x4a, 0x4c,
-				0x51, 0x53, 0x57, 0x59, 0x59, 0x5b, 0x61, 0x65,
-				0x69, 0x6b, 0x6f, 0x74, 0x74, 0x78, 0x7a, 0x7d,
-				0x80, 0x8e, 0x90, 0x95, 0x96, 0x9b, 0x9c, 0x9c,
-				0xa1, 0xa1, 0xa5, 0xa8, 0xad, 0xae, 0xaf, 0xb3,
-				0xb5, 0xb9, 0xbb, 0xbe, 0xbe, 0xbf, 0xc4, 0xc4,
-				0xc6, 0xc9, 0xcc, 0xcd, 0xd4, 0xd5, 0xe1, 0xe6,
-				0xe8, 0xef, 0xf0, 0xf5, 0xfb, 0xfd,
-			},
-			{
-				0x04, 0x05, 0x07, 0x12, 0x13, 0x16, 0x18, 0x1d,
-				0x1e, 0x20, 0x23, 0x25, 0x2c, 0x2d, 0x2e, 0x30,
-				0x32, 0x3b, 0x3c, 0x3f, 0x42, 0x44, 0x4d, 0x4f,
-				0x54, 0x54, 0x58, 0x5a, 0x62, 0x63, 0x65, 0x66,
-				0x67, 0x68, 0x68, 0x68, 0x69, 0x6d, 0x74, 0x76,
-				0x76, 0x7f, 0x83, 0x8a, 0x8c, 0x8e, 0x91, 0x9e,
-				0xa0, 0xa4, 0xa9, 0xab, 0xac, 0xaf, 0xb2, 0xba,
-				0xbc, 0xc0, 0xc3, 0xc5, 0xc6, 0xc7, 0xc7, 0xc9,
-				0xcb, 0xcf, 0xd0, 0xd1, 0xd1, 0xd3, 0xd8, 0xe0,
-				0xe1, 0xe2, 0xe4, 0xe5, 0xeb, 0xec, 0xed, 0xef,
-				0xf3, 0xf6, 0xf9, 0xf9, 0xfa, 0xfb,
-			},
-		},
-
-		// Group 25
-		{
-			{
-				0x00, 0x04, 0x05, 0x05, 0x09, 0x09, 0x0b, 0x0e,
-				0x13, 0x18, 0x18, 0x1a, 0x1a, 0x1e, 0x21, 0x22,
-				0x23, 0x27, 0x29, 0x2b, 0x2b, 0x30, 0x31, 0x35,
-				0x36, 0x39, 0x3a, 0x42, 0x43, 0x48, 0x4e, 0x52,
-				0x5d, 0x5e, 0x5f, 0x5f, 0x61, 0x64, 0x64, 0x69,
-				0x69, 0x6a, 0x6b, 0x6c, 0x6f, 0x72, 0x80, 0x82,
-				0x83, 0x88, 0x88, 0x8a, 0x8c, 0x8d, 0x91, 0x91,
-				0x96, 0xa0, 0xa2, 0xa8, 0xa9, 0xa9, 0xb1, 0xb1,
-				0xb3, 0xb5, 0xb5, 0xb7, 0xbe, 0xc0, 0xc3, 0xc9,
-				0xcd, 0xce, 0xcf, 0xd1, 0xd2, 0xd8, 0xd9, 0xdf,
-				0xe0, 0xeb, 0xec, 0xf0, 0xff, 0xff,
-			},
-			{
-				0x02, 0x06, 0x0e, 0x0f, 0x14, 0x17, 0x1c, 0x20,
-				0x24, 0x25, 0x2d, 0x2e, 0x2e, 0x30, 0x32, 0x34,
-				0x36, 0x39, 0x46, 0x47, 0x4b, 0x4e, 0x4f, 0x54,
-				0x56, 0x58, 0x59, 0x63, 0x65, 0x66, 0x68, 0x6e,
-				0x72, 0x75, 0x76, 0x76, 0x77, 0x78, 0x78, 0x7b,
-				0x7b, 0x7f, 0x8b, 0x8c, 0x8f, 0x94, 0x95, 0x9b,
-				0x9c, 0x9d, 0x9d, 0x9f, 0xa0, 0xa3, 0xa7, 0xa8,
-				0xaa, 0xb0, 0xb0, 0xba, 0xbb, 0xbb, 0xbd, 0xc6,
-				0xc9, 0xcb, 0xce, 0xcf, 0xd3, 0xd7, 0xd8, 0xda,
-				0xdd, 0xe0, 0xe2, 0xe4, 0xe6, 0xea, 0xeb, 0xed,
-				0xee, 0xef, 0xf1, 0xf8, 0xfd, 0xfe,
-			},
-			{
-				0x01, 0x04, 0x07, 0x0a, 0x0c, 0x0d, 0x11, 0x12,
-				0x14, 0x15, 0x15, 0x16, 0x20, 0x21, 0x21, 0x22,
-				0x25, 0x26, 0x2c, 0x2c, 0x2f, 0x32, 0x33, 0x38,
-				0x39, 0x3a, 0x3c, 0x3f, 0x40, 0x46, 0x48, 0x49,
-				0x4a, 0x4d, 0x50, 0x60, 0x62, 0x63, 0x68, 0x6d,
-				0x6e, 0x70, 0x71, 0x74, 0x78, 0x7a, 0x7b, 0x7d,
-				0x7e, 0x7f, 0x81, 0x83, 0x84, 0x86, 0x86, 0x89,
-				0x95, 0x98, 0x99, 0x9b, 0x9c, 0x9e, 0xab, 0xad,
-				0xb5, 0xb6, 0xba, 0xc2, 0xc4, 0xcc, 0xd3, 0xd4,
-				0xdb, 0xdb, 0xe1, 0xe3, 0xe3, 0xe7, 0xed, 0xee,
-				0xf2, 0xf5, 0xf6, 0xf7, 0xf9, 0xf9,
-			},
-			{
-				0x00, 0x01, 0x08, 0x0c, 0x0d, 0x13, 0x16, 0x1c,
-				0x24, 0x26, 0x27, 0x2a, 0x31, 0x33, 0x35, 0x37,
-				0x38, 0x3c, 0x42, 0x49, 0x4a, 0x4d, 0x4f, 0x51,
-				0x51, 0x53, 0x55, 0x57, 0x58, 0x59, 0x5b, 0x5b,
-				0x67, 0x6c, 0x6d, 0x73, 0x74, 0x74, 0x75, 0x77,
-				0x79, 0x7e, 0x7f, 0x85, 0x87, 0x8a, 0x8f, 0x92,
-				0x94, 0x97, 0xa6, 0xa6, 0xac, 0xb4, 0xb4, 0xb9,
-				0xbc, 0xbe, 0xc2, 0xc2, 0xc5, 0xc6, 0xc7, 0xc8,
-				0xca, 0xcc, 0xd2, 0xd2, 0xd5, 0xd9, 0xdc, 0xdd,
-				0xde, 0xde, 0xe2, 0xe4, 0xe5, 0xe8, 0xe8, 0xe9,
-				0xe9, 0xf1, 0xf5, 0xf7, 0xfa, 0xfb,
-			},
-			{
-				0x02, 0x07, 0x08, 0x0b, 0x0d, 0x14, 0x17, 0x19,
-				0x19, 0x1b, 0x1c, 0x1e, 0x1f, 0x1f, 0x27, 0x29,
-				0x2d, 0x32, 0x33, 0x37, 0x3b, 0x3e, 0x40, 0x44,
-				0x44, 0x47, 0x4c, 0x50, 0x52, 0x54, 0x57, 0x5a,
-				0x5d, 0x5e, 0x5f, 0x61, 0x67, 0x73, 0x75, 0x7c,
-				0x7e, 0x81, 0x81, 0x84, 0x8b, 0x8d, 0x90, 0x93,
-				0x9a, 0x9d, 0x9e, 0x9f, 0xa0, 0xa1, 0xac, 0xad,
-				0xae, 0xae, 0xb7, 0xb8, 0xb9, 0xbc, 0xbd, 0xbf,
-				0xc3, 0xc7, 0xc9, 0xcb, 0xce, 0xd0, 0xd4, 0xd7,
-				0xde, 0xdf, 0xe1, 0xe5, 0xe7, 0xf3, 0xf4, 0xf4,
-				0xf6, 0xf8, 0xfa, 0xfb, 0xfc, 0xfe,
-			},
-			{
-				0x03, 0x05, 0x06, 0x0a, 0x10, 0x11, 0x12, 0x15,
-				0x16, 0x18, 0x1a, 0x1d, 0x23, 0x24, 0x28, 0x29,
-				0x2d, 0x2f, 0x34, 0x36, 0x3d, 0x3e, 0x45, 0x46,
-				0x4e, 0x52, 0x53, 0x55, 0x5b, 0x5c, 0x64, 0x68,
-				0x6a, 0x6f, 0x70, 0x7c, 0x7d, 0x80, 0x87, 0x87,
-				0x8a, 0x8b, 0x8c, 0x8e, 0x8e, 0x8f, 0x90, 0x92,
-				0x93, 0x93, 0x96, 0x97, 0x98, 0x9a, 0xa4, 0xa7,
-				0xaa, 0xab, 0xab, 0xac, 0xaf, 0xb2, 0xb2, 0xb7,
-				0xbc, 0xbf, 0xc0, 0xc4, 0xc4, 0xc5, 0xc8, 0xcd,
-				0xd1, 0xd3, 0xd5, 0xd5, 0xda, 0xda, 0xe6, 0xe9,
-				0xec, 0xef, 0xf2, 0xf3, 0xf4, 0xfc,
-			},
-			{
-				0x03, 0x04, 0x07, 0x0a, 0x0f, 0x10, 0x13, 0x17,
-				0x1b, 0x1d, 0x28, 0x2a, 0x2e, 0x2f, 0x31, 0x3b,
-				0x3d, 0x3f, 0x41, 0x41, 0x42, 0x43, 0x45, 0x4b,
-				0x4c, 0x54, 0x56, 0x5a, 0x5c, 0x60, 0x62, 0x65,
-				0x66, 0x67, 0x6b, 0x71, 0x76, 0x79, 0x7a, 0x82,
-				0x85, 0x89, 0x94, 0x99, 0xa1, 0xa2, 0xa3, 0xa4,
-				0xa4, 0xa5, 0xa5, 0xa6, 0xa8, 0xaf, 0xb0, 0xb2,
-				0xb3, 0xb4, 0xb6, 0xb8, 0xba, 0xc1, 0xc1, 0xc3,
-				0xc5, 0xca, 0xcb, 0xd0, 0xd0, 0xd1, 0xd4, 0xd6,
-				0xd6, 0xdc, 0xe4, 0xea, 0xec, 0xef, 0xf0, 0xf3,
-				0xf8, 0xf9, 0xfb, 0xfc, 0xfd, 0xfe,
-			},
-		},
-
-		// Group 26
-		{
-			{
-				0x00, 0x05, 0x0c, 0x0e, 0x0e, 0x11, 0x13, 0x16,
-				0x21, 0x22, 0x27, 0x28, 0x2c, 0x2e, 0x2e, 0x2f,
-				0x2f, 0x31, 0x33, 0x37, 0x3c, 0x3e, 0x44, 0x45,
-				0x46, 0x49, 0x4a, 0x4b, 0x4c, 0x4f, 0x51, 0x51,
-				0x56, 0x57, 0x58, 0x58, 0x5a, 0x5e, 0x5e, 0x5f,
-				0x60, 0x63, 0x65, 0x6a, 0x6c, 0x6d, 0x6e, 0x70,
-				0x72, 0x75, 0x7d, 0x7f, 0x80, 0x87, 0x8a, 0x8e,
-				0x8f, 0x90, 0x93, 0xa5, 0xa8, 0xa9, 0xa9, 0xb2,
-				0xb4, 0xb4, 0xb8, 0xc2, 0xd0, 0xd6, 0xd7, 0xdd,
-				0xde, 0xdf, 0xdf, 0xe0, 0xe1, 0xe2, 0xe3, 0xec,
-				0xef, 0xf0, 0xf0, 0xf5, 0xf6, 0xf9,
-			},
-			{
-				0x04, 0x09, 0x0a, 0x0b, 0x10, 0x11, 0x11, 0x12,
-				0x15, 0x19, 0x19, 0x1e, 0x29, 0x35, 0x36, 0x37,
-				0x38, 0x38, 0x3a, 0x3c, 0x40, 0x40, 0x40, 0x41,
-				0x41, 0x42, 0x43, 0x47, 0x4a, 0x4e, 0x65, 0x65,
-				0x66, 0x67, 0x69, 0x6b, 0x74, 0x79, 0x7a, 0x7f,
-				0x80, 0x83, 0x84, 0x8c, 0x94, 0x96, 0x97, 0x97,
-				0x9c, 0xa3, 0xa4, 0xa6, 0xaa, 0xaa, 0xb0, 0xb1,
-				0xb5, 0xb7, 0xb9, 0xbc, 0xbe, 0xbf, 0xbf, 0xc1,
-				0xc2, 0xc3, 0xc9, 0xcb, 0xcc, 0xcc, 0xcd, 0xcf,
-				0xd2, 0xd3, 0xdd, 0xe8, 0xe9, 0xea, 0xeb, 0xec,
-				0xf1, 0xf2, 0xf6, 0xfb, 0xfc, 0xfd,
-			},
-			{
-				0x01, 0x02, 0x05, 0x07, 0x08, 0x08, 0x17, 0x18,
-				0x1c, 0x1d, 0x1e, 0x1f, 0x20, 0x22, 0x23, 0x2a,
-				0x2b, 0x2d, 0x3d, 0x41, 0x45, 0x49, 0x50, 0x52,
-				0x56, 0x58, 0x5b, 0x5b, 0x60, 0x67, 0x6a, 0x6b,
-				0x6b, 0x6c, 0x6e, 0x70, 0x71, 0x7b, 0x83, 0x85,
-				0x86, 0x88, 0x89, 0x8a, 0x8c, 0x90, 0x94, 0x95,
-				0x99, 0x9e, 0x9f, 0xa7, 0xaa, 0xab, 0xae, 0xb1,
-				0xb3, 0xb6, 0xb8, 0xbb, 0xbd, 0xc0, 0xc3, 0xc4,
-				0xca, 0xcb, 0xd0, 0xd1, 0xd2, 0xd9, 0xd9, 0xdb,
-				0xdc, 0xe3, 0xe6, 0xe9, 0xeb, 0xed, 0xee, 0xee,
-				0xef, 0xf2, 0xf8, 0xf9, 0xfc, 0xff,
-			},
-			{
-				0x01, 0x03, 0x09, 0x0a, 0x10, 0x12, 0x16, 0x18,
-				0x1b, 0x1d, 0x21, 0x23, 0x2d, 0x31, 0x32, 0x32,
-				0x34, 0x35, 0x37, 0x3a, 0x3a, 0x3c, 0x3d, 0x3d,
-				0x3e, 0x43, 0x46, 0x48, 0x4d, 0x50, 0x51, 0x52,
-				0x54, 0x54, 0x59, 0x5b, 0x5c, 0x5d, 0x62, 0x68,
-				0x6f, 0x6f, 0x72, 0x73, 0x77, 0x78, 0x78, 0x7a,
-				0x7d, 0x7f, 0x82, 0x82, 0x85, 0x86, 0x88, 0x95,
-				0x96, 0x9b, 0x9e, 0x9f, 0xa0, 0xa0, 0xa8, 0xab,
-				0xad, 0xae, 0xb2, 0xb6, 0xba, 0xbd, 0xbe, 0xc0,
-				0xc6, 0xca, 0xd1, 0xdb, 0xe1, 0xe4, 0xe5, 0xe6,
-				0xec, 0xef, 0xf5, 0xfb, 0xfd, 0xfe,
-			},
-			{
-				0x03, 0x04, 0x06, 0x07, 0x09, 0x0b, 0x0c, 0x0c,
-				0x0d, 0x14, 0x15, 0x17, 0x18, 0x19, 0x1a, 0x1a,
-				0x1b, 0x1c, 0x1d, 0x1f, 0x21, 0x24, 0x24, 0x28,
-				0x29, 0x2b, 0x2e, 0x30, 0x30, 0x39, 0x3b, 0x3e,
-				0x48, 0x4c, 0x5d, 0x61, 0x68, 0x69, 0x78, 0x7b,
-				0x7e, 0x82, 0x8d, 0x8d, 0x92, 0x93, 0x93, 0x94,
-				0x98, 0x9a, 0x9d, 0x9d, 0x9f, 0xa1, 0xa2, 0xa5,
-				0xac, 0xad, 0xae, 0xb0, 0xb5, 0xb9, 0xbc, 0xbf,
-				0xc8, 0xcb, 0xce, 0xd4, 0xd5, 0xd6, 0xd8, 0xda,
-				0xdc, 0xe0, 0xe1, 0xe2, 0xe5, 0xe7, 0xe7, 0xe8,
-				0xea, 0xed, 0xf3, 0xf7, 0xf7, 0xfc,
-			},
-			{
-				0x02, 0x06, 0x0b, 0x0d, 0x13, 0x14, 0x17, 0x1b,
-				0x1c, 0x23, 0x25, 0x26, 0x27, 0x29, 0x2a, 0x2c,
-				0x30, 0x31, 0x36, 0x38, 0x39, 0x3f, 0x3f, 0x44,
-				0x47, 0x4b, 0x4c, 0x4d, 0x4f, 0x53, 0x53, 0x54,
-				0x57, 0x5c, 0x5c, 0x61, 0x63, 0x6d, 0x6e, 0x71,
-				0x74, 0x75, 0x76, 0x76, 0x7c, 0x83, 0x84, 0x86,
-				0x87, 0x8a, 0x8b, 0x8c, 0x8e, 0x91, 0x96, 0x98,
-				0x99, 0x9b, 0x9c, 0xa3, 0xa4, 0xa6, 0xaf, 0xaf,
-				0xb4, 0xb7, 0xba, 0xc4, 0xc6, 0xc7, 0xca, 0xcd,
-				0xcf, 0xd0, 0xd4, 0xd7, 0xe5, 0xe9, 0xf3, 0xf4,
-				0xf6, 0xf7, 0xfa, 0xfb, 0xff, 0xff,
-			},
-			{
-				0x00, 0x0d, 0x0f, 0x0f, 0x14, 0x16, 0x1e, 0x1f,
-				0x20, 0x24, 0x25, 0x26, 0x28, 0x33, 0x34, 0x35,
-				0x36, 0x39, 0x3b, 0x42, 0x44, 0x46, 0x4b, 0x4e,
-				0x55, 0x55, 0x55, 0x59, 0x5a, 0x5d, 0x5f, 0x62,
-				0x64, 0x64, 0x66, 0x6a, 0x6d, 0x73, 0x77, 0x79,
-				0x7a, 0x7b, 0x7c, 0x7e, 0x81, 0x81, 0x89, 0x8b,
-				0x8f, 0x90, 0x91, 0x92, 0x98, 0x9a, 0xa1, 0xa2,
-				0xa7, 0xac, 0xb3, 0xba, 0xbb, 0xbe, 0xc1, 0xc1,
-				0xc2, 0xc5, 0xc5, 0xc7, 0xc8, 0xc9, 0xcc, 0xce,
-				0xd3, 0xd5, 0xd5, 0xd6, 0xd8, 0xda, 0xde, 0xe4,
-				0xf1, 0xf4, 0xf8, 0xf9, 0xfa, 0xfe,
-			},
-		},
-
-		// Group 27
-		{
-			{
-				0x02, 0x04, 0x05, 0x05, 0x08, 0x09, 0x0b, 0x0d,
-				0x11, 0x14, 0x1b, 0x1e, 0x1e, 0x1f, 0x23, 0x26,
-				0x2c, 0x2f, 0x2f, 0x35, 0x39, 0x39, 0x3a, 0x3d,
-				0x45, 0x47, 0x51, 0x57, 0x58, 0x5a, 0x5e, 0x63,
-				0x64, 0x65, 0x6c, 0x6c, 0x6d, 0x6e, 0x70, 0x70,
-				0x73, 0x80, 0x85, 0x89, 0x8e, 0x8f, 0x9c, 0x9d,
-				0x9d, 0x9f, 0xa2, 0xab, 0xad, 0xae, 0xaf, 0xb3,
-				0xb5, 0xb7, 0xb8, 0xb9, 0xbb, 0xbb, 0xbc, 0xbf,
-				0xc6, 0xc7, 0xce, 0xcf, 0xd3, 0xd7, 0xdb, 0xdf,
-				0xe4, 0xe5, 0xe6, 0xe6, 0xe7, 0xec, 0xef, 0xf3,
-				0xf7, 0xf9, 0xfb, 0xfc, 0xfd, 0xfe,
-			},
-			{
-				0x00, 0x0a, 0x0a, 0x10, 0x12, 0x13, 0x13, 0x15,
-				0x22, 0x23, 0x25, 0x27, 0x2c, 0x2d, 0x2e, 0x2e,
-				0x31, 0x34, 0x34, 0x35, 0x36, 0x3c, 0x3e, 0x44,
-				0x46, 0x56, 0x59, 0x59, 0x5f, 0x61, 0x62, 0x72,
-				0x77, 0x78, 0x79, 0x7a, 0x7d, 0x7e, 0x7f, 0x80,
-				0x84, 0x86, 0x86, 0x8f, 0x90, 0x90, 0x92, 0x95,
-				0x97, 0x98, 0xa0, 0xa1, 0xa7, 0xaa, 0xad, 0xb0,
-				0xb2, 0xb3, 0xb6, 0xc0, 0xc4, 0xc9, 0xce, 0xd0,
-				0xd4, 0xd7, 0xd9, 0xd9, 0xdc, 0xdf, 0xe0, 0xe1,
-				0xe1, 0xe3, 0xe4, 0xe8, 0xea, 0xed, 0xee, 0xf1,
-				0xf4, 0xf8, 0xfa, 0xfa, 0xfe, 0xfe,
-			},
-			{
-				0x0e, 0x16, 0x18, 0x1d, 0x1d, 0x1f, 0x20, 0x25,
-				0x27, 0x29, 0x2a, 0x30, 0x32, 0x32, 0x33, 0x3d,
-				0x42, 0x42, 0x43, 0x49, 0x4e, 0x4f, 0x50, 0x54,
-				0x55, 0x55, 0x5c, 0x61, 0x66, 0x67, 0x69, 0x6a,
-				0x6a, 0x7b, 0x7d, 0x7e, 0x7f, 0x81, 0x82, 0x83,
-				0x85, 0x8a, 0x8a, 0x8b, 0x8c, 0x8e, 0x92, 0x96,
-				0x97, 0x97, 0x98, 0x9a, 0xa6, 0xa8, 0xa8, 0xac,
-				0xb0, 0xb1, 0xb2, 0xb5, 0xb9, 0xba, 0xbd, 0xbe,
-				0xbe, 0xc0, 0xc1, 0xc2, 0xc3, 0xc7, 0xc8, 0xcf,
-				0xd0, 0xd1, 0xd5, 0xdc, 0xde, 0xe3, 0xe4, 0xea,
-				0xec, 0xed, 0xf2, 0xf8, 0xfc, 0xff,
-			},
-			{
-				0x01, 0x02, 0x04, 0x09, 0x0b, 0x0c, 0x0c, 0x0d,
-				0x0f, 0x15, 0x16, 0x16, 0x19, 0x1b, 0x1b, 0x1c,
-				0x20, 0x24, 0x24, 0x26, 0x28, 0x29, 0x2a, 0x31,
-				0x36, 0x38, 0x3b, 0x3c, 0x3f, 0x42, 0x44, 0x53,
-				0x58, 0x5a, 0x5f, 0x63, 0x67, 0x6b, 0x6f, 0x70,
-				0x74, 0x75, 0x7a, 0x86, 0x87, 0x91, 0x91, 0x93,
-				0x99, 0x99, 0x9a, 0x9e, 0x9e, 0xa3, 0xa5, 0xa6,
-				0xa6, 0xa8, 0xaf, 0xb0, 0xb8, 0xc0, 0xc5, 0xc7,
-				0xc8, 0xcb, 0xcc, 0xcd, 0xd4, 0xd6, 0xd7, 0xd9,
-				0xdb, 0xdd, 0xe0, 0xe2, 0xe5, 0xe9, 0xee, 0xf0,
-				0xf3, 0xf7, 0xf7, 0xf9, 0xfd, 0xff,
-			},
-			{
-				0x00, 0x00, 0x03, 0x07, 0x07, 0x08, 0x0b, 0x0c,
-				0x10, 0x17, 0x1a, 0x1c, 0x23, 0x25, 0x2a, 0x2b,
-				0x37, 0x3b, 0x40, 0x40, 0x41, 0x43, 0x44, 0x45,
-				0x48, 0x49, 0x49, 0x4a, 0x4a, 0x4c, 0x4c, 0x4d,
-				0x4d, 0x51, 0x55, 0x5b, 0x5c, 0x5d, 0x5f, 0x63,
-				0x67, 0x68, 0x68, 0x6a, 0x6d, 0x6e, 0x72, 0x73,
-				0x78, 0x79, 0x7b, 0x7b, 0x82, 0x82, 0x85, 0x8c,
-				0x8d, 0x95, 0x96, 0x9b, 0xa0, 0xa1, 0xa4, 0xa7,
-				0xb2, 0xb6, 0xbc, 0xbe, 0xc3, 0xc4, 0xc9, 0xcb,
-				0xcd, 0xd1, 0xd5, 0xd8, 0xda, 0xdc, 0xe2, 0xe2,
-				0xf4, 0xf5, 0xf6, 0xf6, 0xfb, 0xfc,
-			},
-			{
-				0x02, 0x06, 0x06, 0x0e, 0x0f, 0x11, 0x12, 0x12,
-				0x21, 0x21, 0x22, 0x26, 0x28, 0x2d, 0x2f, 0x36,
-				0x38, 0x3a, 0x3e, 0x3f, 0x41, 0x45, 0x48, 0x4b,
-				0x4e, 0x52, 0x54, 0x56, 0x57, 0x5b, 0x5e, 0x60,
-				0x60, 0x62, 0x65, 0x68, 0x69, 0x69, 0x6e, 0x71,
-				0x75, 0x76, 0x76, 0x77, 0x79, 0x7c, 0x80, 0x81,
-				0x84, 0x84, 0x87, 0x88, 0x89, 0x8f, 0x94, 0x94,
-				0x96, 0x9b, 0x9c, 0xa3, 0xa4, 0xa5, 0xa9, 0xad,
-				0xb1, 0xb4, 0xbd, 0xbf, 0xc1, 0xc5, 0xca, 0xd0,
-				0xd2, 0xd2, 0xd3, 0xd6, 0xd8, 0xd8, 0xdd, 0xe6,
-				0xe9, 0xeb, 0xeb, 0xee, 0xf2, 0xf5,
-			},
-			{
-				0x01, 0x03, 0x04, 0x05, 0x06, 0x08, 0x0f, 0x10,
-				0x13, 0x14, 0x17, 0x18, 0x19, 0x1a, 0x24, 0x2b,
-				0x30, 0x30, 0x33, 0x37, 0x37, 0x40, 0x46, 0x47,
-				0x4b, 0x4f, 0x50, 0x52, 0x53, 0x58, 0x5d, 0x64,
-				0x66, 0x6b, 0x6c, 0x6f, 0x71, 0x73, 0x74, 0x76,
-				0x7c, 0x7e, 0x81, 0x83, 0x88, 0x8b, 0x8d, 0x93,
-				0x95, 0x98, 0x99, 0x9a, 0x9c, 0x9f, 0xa2, 0xa5,
-				0xa9, 0xaa, 0xab, 0xac, 0xae, 0xb4, 0xb5, 0xb7,
-				0xba, 0xbf, 0xc1, 0xc2, 0xc6, 0xca, 0xcc, 0xcd,
-				0xce, 0xda, 0xdb, 0xde, 0xde, 0xe0, 0xe7, 0xe8,
-				0xec, 0xef, 0xf0, 0xf1, 0xf8, 0xff,
-			},
-		},
-
-		// Group 28
-		{
-			{
-				0x06, 0x0b, 0x12, 0x1a, 0x1c, 0x20, 0x20, 0x2c,
-				0x32, 0x34, 0x36, 0x38, 0x39, 0x3b, 0x3c, 0x41,
-				0x43, 0x47, 0x48, 0x4b, 0x4e, 0x4f, 0x51, 0x53,
-				0x54, 0x5a, 0x5b, 0x5d, 0x66, 0x6f, 0x6f, 0x71,
-				0x72, 0x73, 0x74, 0x79, 0x7a, 0x7e, 0x86, 0x92,
-				0x93, 0x93, 0x95, 0x95, 0x96, 0x97, 0x98, 0x9a,
-				0x9a, 0x9b, 0x9c, 0xa0, 0xa3, 0xa6, 0xa8, 0xa8,
-				0xa9, 0xac, 0xad, 0xae, 0xaf, 0xb0, 0xb3, 0xba,
-				0xbd, 0xbe, 0xc0, 0xc7, 0xd3, 0xd8, 0xdb, 0xdc,
-				0xde, 0xdf, 0xe0, 0xe3, 0xe5, 0xe7, 0xec, 0xed,
-				0xef, 0xf1, 0xf6, 0xf8, 0xfb, 0xfe,
-			},
-			{
-				0x00, 0x01, 0x02, 0x08, 0x0c, 0x0d, 0x0f, 0x13,
-				0x14, 0x15, 0x1c, 0x1d, 0x29, 0x2d, 0x2f, 0x35,
-				0x3a, 0x3c, 0x3e, 0x44, 0x48, 0x4c, 0x54, 0x54,
-				0x56, 0x57, 0x5a, 0x5f, 0x61, 0x63, 0x64, 0x65,
-				0x68, 0x69, 0x6d, 0x6e, 0x70, 0x72, 0x76, 0x76,
-				0x77, 0x78, 0x7d, 0x80, 0x82, 0x89, 0x8a, 0x8f,
-				0x8f, 0x98, 0x98, 0x9b, 0x9c, 0xa1, 0xa3, 0xa4,
-				0xa5, 0xa6, 0xa7, 0xaa, 0xb8, 0xbc, 0xbd, 0xc1,
-				0xc2, 0xc2, 0xc4, 0xc8, 0xc8, 0xca, 0xce, 0xcf,
-				0xd7, 0xd9, 0xdb, 0xdd, 0xdd, 0xdd, 0xe1, 0xe6,
-				0xe6, 0xf0, 0xf4, 0xf7, 0xfc, 0xfe,
-			},
-			{
-				0x03, 0x05, 0x05, 0x06, 0x0a, 0x0c, 0x16, 0x1a,
-				0x1d, 0x1e, 0x25, 0x28, 0x28, 0x31, 0x32, 0x36,
-				0x39, 0x3b, 0x3d, 0x40, 0x43, 0x46, 0x47, 0x4d,
-				0x50, 0x51, 0x53, 0x56, 0x59, 0x61, 0x62, 0x63,
-				0x67, 0x68, 0x6b, 0x6b, 0x6c, 0x70, 0x75, 0x77,
-				0x7e, 0x83, 0x89, 0x8a, 0x96, 0x99, 0x9c, 0x9f,
-				0xa6, 0xac, 0xae, 0xb4, 0xb6, 0xbb, 0xbf, 0xbf,
-				0xc5, 0xc6, 0xcb, 0xcb, 0xcd, 0xce, 0xcf, 0xd0,
-				0xd1, 0xd3, 0xd5, 0xd9, 0xda, 0xdc, 0xe2, 0xe3,
-				0xe9, 0xeb, 0xee, 0xf0, 0xf3, 0xf4, 0xf5, 0xf5,
-				0xf6, 0xf8, 0xf9, 0xfa, 0xff, 0xff,
-			},
-			{
-				0x01, 0x07, 0x08, 0x10, 0x11, 0x15, 0x17, 0x18,
-				0x19, 0x1a, 0x1f, 0x1f, 0x22, 0x22, 0x23, 0x23,
-				0x24, 0x26, 0x26, 0x26, 0x2c, 0x2e, 0x30, 0x42,
-				0x45, 0x46, 0x4c, 0x4e, 0x53, 0x55, 0x55, 0x57,
-				0x58, 0x69, 0x72, 0x73, 0x74, 0x7b, 0x7b, 0x80,
-				0x81, 0x81, 0x84, 0x87, 0x88, 0x8b, 0x8b, 0x8c,
-				0x8e, 0x94, 0x97, 0xa0, 0xa1, 0xa2, 0xa4, 0xaf,
-				0xb4, 0xb5, 0xb7, 0xb7, 0xb8, 0xb9, 0xba, 0xc2,
-				0xc3, 0xca, 0xca, 0xcc, 0xcd, 0xd2, 0xd4, 0xd4,
-				0xd5, 0xd5, 0xda, 0xe1, 0xe4, 0xea, 0xeb, 0xec,
-				0xed, 0xf2, 0xf8, 0xf9, 0xfa, 0xfb,
-			},
-			{
-				0x02, 0x03, 0x04, 0x06, 0x0e, 0x0e, 0x12, 0x13,
-				0x17, 0x1b, 0x27, 0x2d, 0x2f, 0x30, 0x31, 0x33,
-				0x33, 0x34, 0x35, 0x36, 0x37, 0x37, 0x3a, 0x3d,
-				0x41, 0x42, 0x44, 0x45, 0x46, 0x49, 0x4a, 0x4d,
-				0x52, 0x57, 0x5d, 0x5e, 0x60, 0x60, 0x68, 0x6d,
-				0x6f, 0x71, 0x75, 0x78, 0x7c, 0x7c, 0x7d, 0x7f,
-				0x7f, 0x84, 0x85, 0x8a, 0x8d, 0x91, 0x95, 0x9b,
-				0x9e, 0xa1, 0xa9, 0xab, 0xab, 0xad, 0xb0, 0xb1,
-				0xb2, 0xb2, 0xb5, 0xba, 0xbb, 0xbc, 0xbe, 0xc4,
-				0xc9, 0xcb, 0xce, 0xd1, 0xd7, 0xd9, 0xde, 0xe0,
-				0xe5, 0xe8, 0xee, 0xf1, 0xf6, 0xfc,
-			},
-			{
-				0x0d, 0x0d, 0x11, 0x12, 0x14, 0x18, 0x20, 0x21,
-				0x21, 0x23, 0x25, 0x27, 0x29, 0x2a, 0x2b, 0x2e,
-				0x3e, 0x3e, 0x3f, 0x40, 0x42, 0x43, 0x49, 0x50,
-				0x58, 0x59, 0x5a, 0x5c, 0x5d, 0x5f, 0x60, 0x64,
-				0x66, 0x67, 0x6a, 0x6c, 0x6e, 0x76, 0x7d, 0x83,
-				0x84, 0x85, 0x86, 0x87, 0x8e, 0x8e, 0x90, 0x91,
-				0x97, 0x9a, 0x9d, 0x9e, 0x9f, 0xa5, 0xa8, 0xa9,
-				0xaa, 0xae, 0xb1, 0xb2, 0xb3, 0xb6, 0xc0, 0xc0,
-				0xc7, 0xc8, 0xcc, 0xd0, 0xd2, 0xd3, 0xd8, 0xe1,
-				0xe2, 0xe5, 0xe6, 0xe9, 0xea, 0xeb, 0xef, 0xf2,
-				0xf3, 0xf5, 0xf7, 0xfd, 0xfd, 0xfd,
-			},
-			{
-				0x00, 0x04, 0x07, 0x07, 0x09, 0x09, 0x0a, 0x0b,
-				0x0b, 0x0f, 0x10, 0x11, 0x16, 0x18, 0x19, 0x1b,
-				0x1e, 0x21, 0x24, 0x25, 0x27, 0x2a, 0x2a, 0x2b,
-				0x35, 0x38, 0x3f, 0x47, 0x4a, 0x4b, 0x4d, 0x4f,
-				0x52, 0x5b, 0x5c, 0x5e, 0x62, 0x63, 0x65, 0x67,
-				0x6a, 0x6e, 0x79, 0x7a, 0x7f, 0x81, 0x82, 0x88,
-				0x8c, 0x8c, 0x8d, 0x90, 0x92, 0x94, 0x96, 0x99,
-				0x9d, 0xa2, 0xa7, 0xaa, 0xac, 0xb4, 0xb9, 0xbd,
-				0xc1, 0xc3, 0xc5, 0xc6, 0xc9, 0xc9, 0xcc, 0xcd,
-				0xd1, 0xd6, 0xd6, 0xdf, 0xdf, 0xe3, 0xe4, 0xe4,
-				0xe7, 0xe8, 0xed, 0xef, 0xf7, 0xfa,
-			},
-		},
-
-		// Group 29
-		{
-			{
-				0x04, 0x07, 0x13, 0x18, 0x18, 0x1b, 0x1c, 0x24,
-				0x27, 0x28, 0x2f, 0x2f, 0x30, 0x33, 0x35, 0x36,
-				0x36, 0x37, 0x43, 0x43, 0x46, 0x4b, 0x4f, 0x51,
-				0x56, 0x57, 0x5b, 0x61, 0x62, 0x64, 0x65, 0x68,
-				0x6a, 0x6e, 0x71, 0x73, 0x73, 0x75, 0x7c, 0x7d,
-				0x83, 0x87, 0x88, 0x88, 0x8b, 0x8b, 0x8e, 0x8f,
-				0x93, 0x99, 0xa3, 0xa5, 0xa7, 0xa8, 0xa9, 0xa9,
-				0xaa, 0xab, 0xb0, 0xb2, 0xb3, 0xba, 0xc6, 0xc8,
-				0xc9, 0xcb, 0xcb, 0xcf, 0xd6, 0xdb, 0xdc, 0xdf,
-				0xe0, 0xe0, 0xe3, 0xe6, 0xe7, 0xed, 0xee, 0xf1,
-				0xf6, 0xf7, 0xfa, 0xfb, 0xfd, 0xff,
-			},
-			{
-				0x01, 0x02, 0x05, 0x06, 0x06, 0x08, 0x0a, 0x0a,
-				0x0c, 0x10, 0x12, 0x15, 0x16, 0x17, 0x1b, 0x1b,
-				0x1c, 0x21, 0x31, 0x3c, 0x3e, 0x3f, 0x4c, 0x4d,
-				0x4f, 0x54, 0x57, 0x59, 0x5a, 0x5a, 0x5f, 0x60,
-				0x66, 0x68, 0x68, 0x6a, 0x6b, 0x6b, 0x6b, 0x6f,
-				0x7e, 0x89, 0x8a, 0x8d, 0x8e, 0x90, 0x92, 0x95,
-				0x98, 0x9b, 0xa0, 0xa6, 0xa6, 0xab, 0xac, 0xad,
-				0xae, 0xb0, 0xb3, 0xb5, 0xbd, 0xbd, 0xbe, 0xc0,
-				0xc2, 0xc3, 0xc4, 0xd0, 0xd0, 0xd1, 0xd8, 0xd9,
-				0xdd, 0xde, 0xdf, 0xdf, 0xe0, 0xe1, 0xe4, 0xe6,
-				0xf0, 0xf3, 0xf6, 0xf7, 0xf9, 0xfc,
-			},
-			{
-				0x00, 0x02, 0x03, 0x04, 0x05, 0x0e, 0x10, 0x14,
-				0x15, 0x17, 0x1d, 0x1f, 0x20, 0x22, 0x23, 0x26,
-				0x26, 0x30, 0x31, 0x32, 0x34, 0x35, 0x3b, 0x43,
-				0x44, 0x45, 0x48, 0x4a, 0x4d, 0x4d, 0x4e, 0x52,
-				0x52, 0x53, 0x55, 0x5d, 0x5e, 0x60, 0x63, 0x69,
-				0x70, 0x71, 0x74, 0x75, 0x76, 0x77, 0x78, 0x81,
-				0x84, 0x85, 0x86, 0x96, 0x97, 0x99, 0x9b, 0x9c,
-				0x9d, 0xa1, 0xa2, 0xa3, 0xa4, 0xa4, 0xa5, 0xa8,
-				0xaf, 0xb3, 0xbf, 0xc0, 0xc1, 0xc3, 0xc4, 0xca,
-				0xce, 0xd1, 0xd5, 0xdc, 0xdd, 0xe1, 0xe5, 0xe5,
-				0xe7, 0xe9, 0xeb, 0xee, 0xef, 0xf4,
-			},
-			{
-				0x00, 0x04, 0x07, 0x09, 0x0b, 0x0c, 0x0d, 0x0d,
-				0x12, 0x19, 0x1a, 0x1e, 0x1e, 0x23, 0x25, 0x28,
-				0x2b, 0x2b, 0x2d, 0x31, 0x34, 0x37, 0x39, 0x3d,
-				0x3f, 0x40, 0x42, 0x42, 0x44, 0x45, 0x4e, 0x4f,
-				0x50, 0x55, 0x56, 0x58, 0x60, 0x6c, 0x6d, 0x6e,
-				0x77, 0x79, 0x7b, 0x7b, 0x7d, 0x83, 0x83, 0x84,
-				0x85, 0x86, 0x8a, 0x8c, 0x8d, 0x91, 0x91, 0x94,
-				0x95, 0x98, 0x9a, 0x9e, 0x9f, 0xa7, 0xa9, 0xaf,
-				0xb1, 0xb4, 0xb6, 0xb8, 0xb8, 0xbf, 0xc1, 0xc5,
-				0xc7, 0xcd, 0xcf, 0xd6, 0xda, 0xe3, 0xef, 0xf3,
-				0xf4, 0xf8, 0xf9, 0xf9, 0xfb, 0xfe,
-			},
-			{
-				0x08, 0x09, 0x0b, 0x0f, 0x11, 0x14, 0x14, 0x1d,
-				0x20, 0x21, 0x22, 0x24, 0x24, 0x25, 0x28, 0x29,
-				0x2a, 0x2d, 0x2e, 0x33, 0x34, 0x38, 0x3a, 0x3b,
-				0x3e, 0x40, 0x44, 0x47, 0x47, 0x4c, 0x50, 0x51,
-				0x58, 0x5a, 0x5e, 0x5f, 0x61, 0x65, 0x66, 0x67,
-				0x67, 0x69, 0x6c, 0x6d, 0x72, 0x73, 0x7c, 0x7e,
-				0x80, 0x84, 0x87, 0x8f, 0x90, 0x93, 0x93, 0x94,
-				0x97, 0x9d, 0xa2, 0xb5, 0xb7, 0xb7, 0xb9, 0xbb,
-				0xbb, 0xc8, 0xcc, 0xcc, 0xcd, 0xd1, 0xd2, 0xd2,
-				0xd6, 0xd7, 0xd7, 0xdd, 0xde, 0xe2, 0xe2, 0xeb,
-				0xec, 0xef, 0xf0, 0xf8, 0xfc, 0xfd,
-			},
-			{
-				0x03, 0x0f, 0x10, 0x11, 0x16, 0x18, 0x1f, 0x27,
-				0x29, 0x2c, 0x2d, 0x2e, 0x2f, 0x32, 0x35, 0x38,
-				0x3a, 0x3e, 0x41, 0x47, 0x48, 0x49, 0x4a, 0x4b,
-				0x51, 0x52, 0x53, 0x54, 0x57, 0x5c, 0x5e, 0x62,
-				0x66, 0x6f, 0x70, 0x71, 0x72, 0x75, 0x76, 0x77,
-				0x7a, 0x7a, 0x7f, 0x7f, 0x80, 0x85, 0x86, 0x8b,
-				0x8c, 0x8d, 0x90, 0x92, 0x95, 0x9f, 0xaa, 0xad,
-				0xb1, 0xb2, 0xb6, 0xb9, 0xba, 0xbc, 0xbc, 0xbf,
-				0xc2, 0xc5, 0xc9, 0xce, 0xcf, 0xd3, 0xd4, 0xd5,
-				0xda, 0xdb, 0xe8, 0xe9, 0xec, 0xf1, 0xf2, 0xf2,
-				0xf5, 0xf7, 0xfa, 0xfc, 0xfe, 0xff,
-			},
-			{
-				0x01, 0x08, 0x09, 0x0b, 0x0e, 0x11, 0x13, 0x13,
-				0x19, 0x1a, 0x1e, 0x1f, 0x2a, 0x2c, 0x33, 0x39,
-				0x3c, 0x3c, 0x3d, 0x40, 0x41, 0x42, 0x46, 0x48,
-				0x49, 0x4b, 0x53, 0x59, 0x5b, 0x5c, 0x5d, 0x63,
-				0x64, 0x64, 0x69, 0x74, 0x76, 0x78, 0x79, 0x81,
-				0x82, 0x82, 0x88, 0x89, 0x94, 0x96, 0x96, 0x9a,
-				0x9c, 0x9e, 0xa0, 0xa1, 0xa4, 0xac, 0xae, 0xaf,
-				0xb1, 0xb2, 0xb4, 0xb5, 0xb6, 0xbb, 0xbe, 0xc3,
-				0xc6, 0xc7, 0xca, 0xcd, 0xce, 0xd3, 0xd4, 0xd4,
-				0xd8, 0xd8, 0xd9, 0xda, 0xdc, 0xe4, 0xe8, 0xea,
-				0xea, 0xed, 0xf1, 0xf2, 0xf5, 0xf5,
-			},
-		},
-
-		// Group 30
-		{
-			{
-				0x02, 0x03, 0x05, 0x0a, 0x12, 0x14, 0x14, 0x17,
-				0x17, 0x22, 0x24, 0x25, 0x25, 0x29, 0x30, 0x33,
-				0x37, 0x3a, 0x3b, 0x40, 0x42, 0x43, 0x43, 0x47,
-				0x48, 0x49, 0x4b, 0x4b, 0x4e, 0x50, 0x57, 0x5f,
-				0x61, 0x64, 0x64, 0x64, 0x65, 0x6d, 0x6f, 0x6f,
-				0x73, 0x76, 0x78, 0x7f, 0x81, 0x82, 0x85, 0x88,
-				0x88, 0x8f, 0x91, 0x92, 0x92, 0x93, 0x95, 0x96,
-				0x99, 0x9b, 0xa3, 0xa4, 0xad, 0xb1, 0xb2, 0xb2,
-				0xb4, 0xb5, 0xb7, 0xb9, 0xba, 0xbf, 0xc2, 0xc3,
-				0xca, 0xd0, 0xd6, 0xd7, 0xdd, 0xe0, 0xe8, 0xea,
-				0xef, 0xf1, 0xf6, 0xfa, 0xfc, 0xfc,
-			},
-			{
-				0x00, 0x00, 0x02, 0x04, 0x07, 0x08, 0x09, 0x14,
-				0x15, 0x16, 0x16, 0x19, 0x1a, 0x20, 0x20, 0x23,
-				0x2a, 0x2d, 0x2d, 0x2e, 0x31, 0x35, 0x36, 0x3c,
-				0x3e, 0x42, 0x43, 0x44, 0x47, 0x53, 0x56, 0x5c,
-				0x5e, 0x61, 0x66, 0x68, 0x68, 0x6d, 0x6f, 0x74,
-				0x7c, 0x80, 0x81, 0x82, 0x84, 0x84, 0x8f, 0x90,
-				0x93, 0x93, 0x94, 0x99, 0x9b, 0x9d, 0x9e, 0xa5,
-				0xa6, 0xa6, 0xa8, 0xaa, 0xaf, 0xb1, 0xb6, 0xbc,
-				0xbc, 0xbd, 0xbe, 0xbf, 0xc1, 0xc4, 0xc5, 0xc6,
-				0xcf, 0xd4, 0xe2, 0xe6, 0xe7, 0xe7, 0xe8, 0xeb,
-				0xee, 0xf8, 0xf8, 0xf9, 0xfa, 0xfe,
-			},
-			{
-				0x00, 0x04, 0x05, 0x0a, 0x0e, 0x10, 0x12, 0x15,
-				0x15, 0x19, 0x1c, 0x1d, 0x21, 0x22, 0x24, 0x28,
-				0x2e, 0x2e, 0x39, 0x3b, 0x3c, 0x3d, 0x3e, 0x40,
-				0x41, 0x48, 0x4d, 0x4e, 0x4f, 0x50, 0x56, 0x57,
-				0x58, 0x5f, 0x67, 0x67, 0x69, 0x6c, 0x70, 0x70,
-				0x72, 0x7b, 0x7d, 0x7e, 0x86, 0x87, 0x89, 0x8c,
-				0x8d, 0x8e, 0x95, 0x9a, 0x9c, 0x9c, 0x9c, 0xa0,
-				0xa1, 0xa2, 0xa8, 0xa9, 0xae, 0xc3, 0xc5, 0xcd,
-				0xd0, 0xd3, 0xd4, 0xd8, 0xda, 0xdb, 0xdc, 0xdc,
-				0xde, 0xdf, 0xe4, 0xe5, 0xe6, 0xe7, 0xed, 0xf2,
-				0xf6, 0xf7, 0xf8, 0xf9, 0xfb, 0xfb,
-			},
-			{
-				0x01, 0x06, 0x07, 0x08, 0x0c, 0x0d, 0x0d, 0x10,
-				0x13, 0x1a, 0x1f, 0x1f, 0x21, 0x27, 0x2c, 0x33,
-				0x34, 0x34, 0x36, 0x3b, 0x46, 0x49, 0x4d, 0x4f,
-				0x52, 0x53, 0x54, 0x5c, 0x5e, 0x5f, 0x60, 0x60,
-				0x62, 0x66, 0x71, 0x74, 0x76, 0x7b, 0x7c, 0x7f,
-				0x83, 0x89, 0x8a, 0x8b, 0x8d, 0x8d, 0x91, 0x95,
-				0x98, 0x98, 0x99, 0x9a, 0x9e, 0xa0, 0xa5, 0xa7,
-				0xad, 0xb0, 0xb5, 0xc4, 0xc5, 0xc9, 0xca, 0xcb,
-				0xcc, 0xce, 0xd5, 0xd6, 0xd9, 0xdb, 0xdd, 0xde,
-				0xe8, 0xe9, 0xe9, 0xeb, 0xec, 0xed, 0xee, 0xf2,
-				0xf3, 0xf4, 0xf5, 0xf7, 0xfd, 0xff,
-			},
-			{
-				0x01, 0x09, 0x0b, 0x0c, 0x13, 0x1b, 0x1d, 0x1e,
-				0x28, 0x29, 0x2b, 0x32, 0x32, 0x37, 0x38, 0x39,
-				0x41, 0x42, 0x47, 0x48, 0x4a, 0x4c, 0x4c, 0x4d,
-				0x4e, 0x51, 0x52, 0x59, 0x5a, 0x5b, 0x5d, 0x5d,
-				0x62, 0x63, 0x63, 0x69, 0x6a, 0x6a, 0x6b, 0x70,
-				0x73, 0x74, 0x75, 0x79, 0x79, 0x7c, 0x80, 0x81,
-				0x85, 0x86, 0x91, 0x96, 0x97, 0x9e, 0x9f, 0xa2,
-				0xa7, 0xaa, 0xab, 0xac, 0xad, 0xaf, 0xb0, 0xb6,
-				0xb7, 0xba, 0xbd, 0xc0, 0xc2, 0xc6, 0xc7, 0xc9,
-				0xce, 0xcf, 0xd3, 0xda, 0xdf, 0xe0, 0xe1, 0xe4,
-				0xeb, 0xec, 0xed, 0xee, 0xef, 0xfe,
-			},
-			{
-				0x06, 0x0c, 0x0e, 0x0f, 0x10, 0x11, 0x18, 0x1e,
-				0x1f, 0x26, 0x27, 0x29, 0x2a, 0x2b, 0x2c, 0x2c,
-				0x2f, 0x2f, 0x34, 0x35, 0x38, 0x39, 0x3f, 0x3f,
-				0x44, 0x45, 0x4a, 0x51, 0x54, 0x55, 0x55, 0x58,
-				0x5a, 0x5a, 0x65, 0x66, 0x69, 0x6b, 0x6e, 0x71,
-				0x73, 0x7b, 0x89, 0x8a, 0x8b, 0x8f, 0x90, 0x94,
-				0x97, 0x9b, 0x9f, 0xa5, 0xa6, 0xa9, 0xab, 0xac,
-				0xb2, 0xb3, 0xb4, 0xb9, 0xbb, 0xbf, 0xc1, 0xc2,
-				0xc6, 0xcd, 0xcf, 0xd0, 0xd1, 0xd2, 0xd5, 0xd6,
-				0xd7, 0xd8, 0xdd, 0xde, 0xe1, 0xe3, 0xe3, 0xe5,
-				0xe5, 0xf0, 0xf0, 0xf1, 0xf4, 0xfd,
-			},
-			{
-				0x03, 0x08, 0x0b, 0x0f, 0x11, 0x12, 0x16, 0x18,
-				0x1b, 0x1c, 0x1c, 0x1d, 0x20, 0x23, 0x24, 0x26,
-				0x2b, 0x2f, 0x30, 0x31, 0x37, 0x3a, 0x3d, 0x45,
-				0x45, 0x46, 0x57, 0x59, 0x5b, 0x5b, 0x61, 0x62,
-				0x6c, 0x6e, 0x72, 0x75, 0x77, 0x77, 0x78, 0x7a,
-				0x7a, 0x7a, 0x7d, 0x7e, 0x83, 0x86, 0x87, 0x8c,
-				0x8e, 0x90, 0x96, 0x9d, 0xa0, 0xa1, 0xa3, 0xa4,
-				0xa9, 0xaa, 0xae, 0xae, 0xb0, 0xb3, 0xb4, 0xb8,
-				0xb8, 0xba, 0xbb, 0xbe, 0xc0, 0xc7, 0xc8, 0xc8,
-				0xcb, 0xcc, 0xd1, 0xd2, 0xd9, 0xe2, 0xe3, 0xe4,
-				0xea, 0xf1, 0xf3, 0xf5, 0xfe, 0xff,
-			},
-		},
-
-		// Group 31
-		{
-			{
-				0x01, 0x04, 0x05, 0x08, 0x09, 0x0c, 0x13, 0x14,
-				0x15, 0x16, 0x17, 0x1b, 0x1b, 0x1d, 0x1f, 0x1f,
-				0x20, 0x21, 0x26, 0x27, 0x29, 0x2b, 0x2c, 0x35,
-				0x37, 0x38, 0x3c, 0x3e, 0x43, 0x46, 0x47, 0x49,
-				0x4c, 0x4e, 0x4f, 0x54, 0x5a, 0x5c, 0x61, 0x63,
-				0x63, 0x68, 0x6c, 0x6d, 0x71, 0x76, 0x79, 0x7a,
-				0x7b, 0x81, 0x83, 0x84, 0x86, 0x8a, 0x8b, 0x8c,
-				0x8f, 0x96, 0x96, 0x9c, 0x9d, 0x9e, 0xa3, 0xa8,
-				0xae, 0xb2, 0xb5, 0xb6, 0xc4, 0xc8, 0xcc, 0xd1,
-				0xd9, 0xda, 0xde, 0xe1, 0xe2, 0xe3, 0xe4, 0xe7,
-				0xea, 0xee, 0xf0, 0xf2, 0xf3, 0xf6,
-			},
-			{
-				0x00, 0x02, 0x05, 0x0e, 0x0f, 0x0f, 0x12, 0x16,
-				0x1b, 0x20, 0x21, 0x27, 0x2b, 0x2f, 0x39, 0x3a,
-				0x3c, 0x41, 0x41, 0x48, 0x4a, 0x4b, 0x50, 0x55,
-				0x56, 0x59, 0x5d, 0x5e, 0x5e, 0x60, 0x67, 0x6b,
-				0x6b, 0x6c, 0x6d, 0x6e, 0x78, 0x79, 0x7b, 0x7e,
-				0x7f, 0x80, 0x84, 0x88, 0x8f, 0x92, 0x93, 0xa7,
-				0xa9, 0xac, 0xb1, 0xb6, 0xb8, 0xba, 0xbb, 0xc0,
-				0xc1, 0xc2, 0xc8, 0xc8, 0xcb, 0xce, 0xcf, 0xd1,
-				0xd2, 0xd4, 0xd5, 0xd6, 0xda, 0xde, 0xe0, 0xe5,
-				0xe9, 0xeb, 0xed, 0xed, 0xee, 0xef, 0xf4, 0xf4,
-				0xf5, 0xf8, 0xfa, 0xfc, 0xfd, 0xfd,
-			},
-			{
-				0x05, 0x08, 0x0a, 0x0b, 0x10, 0x18, 0x19, 0x1a,
-				0x1e, 0x20, 0x28, 0x2b, 0x2e, 0x2e, 0x38, 0x3d,
-				0x3f, 0x40, 0x45, 0x47, 0x4c, 0x50, 0x50, 0x52,
-				0x53, 0x53, 0x53, 0x54, 0x55, 0x57, 0x5d, 0x62,
-				0x64, 0x66, 0x6f, 0x74, 0x75, 0x78, 0x7a, 0x7c,
-				0x7d, 0x80, 0x82, 0x82, 0x8a, 0x8c, 0x8d, 0x94,
-				0x99, 0xa0, 0xa0, 0xa1, 0xa5, 0xa7, 0xa9, 0xab,
-				0xac, 0xb4, 0xb7, 0xb9, 0xb9, 0xbb, 0xbc, 0xc0,
-				0xc3, 0xc6, 0xc9, 0xca, 0xcd, 0xd2, 0xd4, 0xd4,
-				0xd5, 0xdd, 0xe3, 0xe6, 0xe8, 0xe9, 0xea, 0xed,
-				0xf0, 0xf3, 0xfb, 0xfc, 0xfe, 0xff,
-			},
-			{
-				0x06, 0x09, 0x0c, 0x0c, 0x11, 0x12, 0x13, 0x15,
-				0x18, 0x19, 0x1a, 0x1e, 0x22, 0x24, 0x25, 0x25,
-				0x30, 0x30, 0x31, 0x36, 0x37, 0x3d, 0x3f, 0x41,
-				0x42, 0x42, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x51,
-				0x52, 0x59, 0x5d, 0x5f, 0x60, 0x61, 0x62, 0x63,
-				0x68, 0x69, 0x6a, 0x6b, 0x6d, 0x70, 0x70, 0x73,
-				0x7c, 0x7d, 0x7f, 0x83, 0x86, 0x88, 0x8d, 0x90,
-				0x90, 0x91, 0x9a, 0x9b, 0x9b, 0x9d, 0x9e, 0x9e,
-				0xa6, 0xa8, 0xb3, 0xb7, 0xba, 0xbe, 0xc2, 0xc7,
-				0xcd, 0xcf, 0xcf, 0xd3, 0xd3, 0xda, 0xdf, 0xe0,
-				0xe6, 0xeb, 0xec, 0xf2, 0xf9, 0xfb,
-			},
-			{
-				0x03, 0x06, 0x07, 0x09, 0x0a, 0x14, 0x15, 0x1c,
-				0x1f, 0x22, 0x22, 0x23, 0x24, 0x26, 0x27, 0x2a,
-				0x2c, 0x33, 0x34, 0x34, 0x36, 0x39, 0x3a, 0x3a,
-				0x3b, 0x3e, 0x43, 0x48, 0x4a, 0x4b, 0x4e, 0x56,
-				0x58, 0x59, 0x62, 0x65, 0x6a, 0x71, 0x72, 0x72,
-				0x73, 0x7d, 0x84, 0x85, 0x85, 0x87, 0x89, 0x8e,
-				0x8e, 0x93, 0x97, 0x98, 0x99, 0x9f, 0x9f, 0xa1,
-				0xa2, 0xa3, 0xa5, 0xa5, 0xaa, 0xab, 0xae, 0xaf,
-				0xb0, 0xb1, 0xb4, 0xb5, 0xbd, 0xbd, 0xbe, 0xbf,
-				0xc5, 0xca, 0xcb, 0xcc, 0xd0, 0xd6, 0xd7, 0xd9,
-				0xe1, 0xf3, 0xf5, 0xf6, 0xf9, 0xff,
-			},
-			{
-				0x00, 0x03, 0x04, 0x07, 0x0a, 0x0b, 0x0d, 0x10,
-				0x11, 0x12, 0x16, 0x18, 0x1c, 0x1d, 0x29, 0x2e,
-				0x31, 0x31, 0x33, 0x36, 0x39, 0x3e, 0x40, 0x4b,
-				0x4d, 0x4f, 0x51, 0x52, 0x54, 0x55, 0x57, 0x58,
-				0x5a, 0x5c, 0x5e, 0x5f, 0x60, 0x66, 0x67, 0x6a,
-				0x74, 0x75, 0x76, 0x81, 0x81, 0x82, 0x88, 0x89,
-				0x92, 0x94, 0x95, 0x95, 0x98, 0xa2, 0xa6, 0xaa,
-				0xad, 0xad, 0xaf, 0xb0, 0xb2, 0xb3, 0xbc, 0xbf,
-				0xc0, 0xc1, 0xc3, 0xc5, 0xc6, 0xca, 0xce, 0xd2,
-				0xdb, 0xdc, 0xdc, 0xe7, 0xe8, 0xe9, 0xec, 0xef,
-				0xf5, 0xf7, 0xf8, 0xfd, 0xfe, 0xff,
-			},
-			{
-				0x01, 0x02, 0x07, 0x0b, 0x0d, 0x0e, 0x0f, 0x13,
-				0x17, 0x19, 0x1e, 0x23, 0x28, 0x2a, 0x2d, 0x2d,
-				0x2f, 0x32, 0x32, 0x33, 0x34, 0x35, 0x3b, 0x3c,
-				0x44, 0x48, 0x49, 0x4c, 0x4d, 0x4d, 0x5b, 0x5b,
-				0x64, 0x65, 0x69, 0x6e, 0x6e, 0x6f, 0x77, 0x77,
-				0x7e, 0x86, 0x87, 0x87, 0x8b, 0x8b, 0x8d, 0x91,
-				0x91, 0x97, 0x99, 0x9a, 0x9b, 0x9c, 0x9f, 0xa0,
-				0xa4, 0xa4, 0xaa, 0xae, 0xb8, 0xb8, 0xc3, 0xc4,
-				0xc7, 0xc9, 0xcc, 0xd0, 0xd5, 0xd7, 0xd8, 0xd8,
-				0xd8, 0xdb, 0xdd, 0xdf, 0xe2, 0xe4, 0xe5, 0xe6,
-				0xef, 0xf1, 0xf1, 0xf6, 0xf7, 0xfa,
-			},
-		},
-
-		// Group 32
-		{
-			{
-				0x04, 0x06, 0x0b, 0x11, 0x11, 0x12, 0x15, 0x19,
-				0x1a, 0x1b, 0x1d, 0x1e, 0x1e, 0x1f, 0x1f, 0x20,
-				0x23, 0x26, 0x27, 0x2b, 0x2b, 0x2d, 0x32, 0x3a,
-				0x45, 0x47, 0x49, 0x4a, 0x53, 0x56, 0x60, 0x61,
-				0x62, 0x63, 0x66, 0x66, 0x6a, 0x6a, 0x6c, 0x73,
-				0x76, 0x78, 0x7d, 0x7e, 0x81, 0x83, 0x86, 0x8b,
-				0x8d, 0x8d, 0x8e, 0x9a, 0x9e, 0x9f, 0xa5, 0xa7,
-				0xa9, 0xac, 0xad, 0xad, 0xb0, 0xb3, 0xb6, 0xba,
-				0xbe, 0xc7, 0xcc, 0xcf, 0xd4, 0xd5, 0xd6, 0xd8,
-				0xd9, 0xde, 0xe1, 0xe1, 0xe7, 0xeb, 0xec, 0xf4,
-				0xf5, 0xf9, 0xfc, 0xfc, 0xfd, 0xff,
-			},
-			{
-				0x00, 0x01, 0x0c, 0x0d, 0x10, 0x12, 0x17, 0x19,
-				0x1c, 0x1c, 0x27, 0x28, 0x2e, 0x30, 0x35, 0x3d,
-				0x3f, 0x41, 0x46, 0x4a, 0x4c, 0x4c, 0x4d, 0x50,
-				0x53, 0x5a, 0x5a, 0x5b, 0x5c, 0x5e, 0x5f, 0x61,
-				0x62, 0x65, 0x68, 0x6b, 0x6e, 0x71, 0x77, 0x79,
-				0x7a, 0x7d, 0x7f, 0x80, 0x88, 0x8a, 0x8c, 0x8f,
-				0x8f, 0x93, 0x94, 0x99, 0x9d, 0xa0, 0xa1, 0xa4,
-				0xa5, 0xa9, 0xab, 0xb4, 0xb8, 0xb9, 0xba, 0xbc,
-				0xbf, 0xc2, 0xc9, 0xcd, 0xce, 0xd2, 0xd3, 0xd5,
-				0xd7, 0xda, 0xda, 0xdf, 0xe6, 0xe9, 0xec, 0xed,
-				0xee, 0xef, 0xf1, 0xf6, 0xfa, 0xfa,
-			},
-			{
-				0x08, 0x0a, 0x10, 0x12, 0x22, 0x27, 0x28, 0x2c,
-				0x2e, 0x2f, 0x31, 0x35, 0x37, 0x3a, 0x3b, 0x3c,
-				0x3d, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48, 0x4a,
-				0x4d, 0x4d, 0x4f, 0x53, 0x54, 0x54, 0x5b, 0x67,
-				0x69, 0x6c, 0x6e, 0x74, 0x76, 0x77, 0x7a, 0x7b,
-				0x7c, 0x7f, 0x88, 0x89, 0x90, 0x95, 0x96, 0x9a,
-				0x9f, 0xa0, 0xa2, 0xa4, 0xa4, 0xa8, 0xb1, 0xb4,
-				0xb5, 0xb7, 0xb7, 0xc0, 0xc1, 0xc2, 0xc3, 0xc3,
-				0xc4, 0xc8, 0xc9, 0xca, 0xd3, 0xd5, 0xda, 0xdb,
-				0xdc, 0xe0, 0xe3, 0xe8, 0xea, 0xed, 0xee, 0xf1,
-				0xf2, 0xf4, 0xf5, 0xf7, 0xf9, 0xfe,
-			},
-			{
-				0x01, 0x02, 0x06, 0x07, 0x0a, 0x0e, 0x18, 0x1b,
-				0x24, 0x26, 0x2a, 0x2a, 0x30, 0x31, 0x32, 0x39,
-				0x40, 0x43, 0x47, 0x4e, 0x52, 0x55, 0x58, 0x5c,
-				0x5d, 0x62, 0x64, 0x67, 0x69, 0x6b, 0x70, 0x72,
-				0x78, 0x79, 0x7b, 0x82, 0x83, 0x85, 0x87, 0x89,
-				0x8c, 0x8c, 0x8d, 0x92, 0x93, 0x93, 0x96, 0x99,
-				0x9a, 0x9b, 0x9b, 0x9c, 0x9c, 0xa0, 0xa1, 0xa2,
-				0xa3, 0xa6, 0xa6, 0xa7, 0xab, 0xac, 0xae, 0xaf,
-				0xb1, 0xbb, 0xbe, 0xc6, 0xcc, 0xd4, 0xd7, 0xd8,
-				0xd9, 0xd9, 0xdb, 0xdc, 0xe2, 0xe4, 0xe6, 0xea,
-				0xee, 0xef, 0xf0, 0xf3, 0xf3, 0xf7,
-			},
-			{
-				0x03, 0x03, 0x05, 0x09, 0x0d, 0x0e, 0x0f, 0x10,
-				0x13, 0x15, 0x16, 0x18, 0x1b, 0x22, 0x23, 0x2c,
-				0x31, 0x32, 0x33, 0x33, 0x34, 0x34, 0x36, 0x3b,
-				0x3e, 0x4c, 0x51, 0x51, 0x54, 0x55, 0x55, 0x57,
-				0x5b, 0x5d, 0x5e, 0x61, 0x64, 0x65, 0x68, 0x6d,
-				0x6e, 0x72, 0x73, 0x7a, 0x7b, 0x80, 0x85, 0x86,
-				0x88, 0x8a, 0x8b, 0x8e, 0x8f, 0x92, 0x94, 0x95,
-				0x97, 0x98, 0x98, 0x9e, 0x9f, 0xa5, 0xa8, 0xaa,
-				0xae, 0xb3, 0xbb, 0xc4, 0xc5, 0xc9, 0xcb, 0xce,
-				0xce, 0xd0, 0xd1, 0xd4, 0xd7, 0xde, 0xe0, 0xe4,
-				0xe5, 0xeb, 0xec, 0xf5, 0xf6, 0xfe,
-			},
-			{
-				0x05, 0x07, 0x08, 0x09, 0x0c, 0x0f, 0x13, 0x14,
-				0x14, 0x15, 0x17, 0x1a, 0x1a, 0x20, 0x21, 0x21,
-				0x24, 0x25, 0x29, 0x2d, 0x2e, 0x2f, 0x30, 0x36,
-				0x38, 0x3c, 0x3e, 0x41, 0x42, 0x43, 0x44, 0x4b,
-				0x4f, 0x52, 0x57, 0x59, 0x59, 0x5f, 0x60, 0x6b,
-				0x6f, 0x73, 0x74, 0x75, 0x75, 0x7c, 0x81, 0x82,
-				0x84, 0x87, 0x8b, 0x8e, 0x90, 0x91, 0x91, 0x97,
-				0xa3, 0xaa, 0xb2, 0xb2, 0xb5, 0xb6, 0xbd, 0xc0,
-				0xc5, 0xc7, 0xd0, 0xd2, 0xd6, 0xd6, 0xdf, 0xe2,
-				0xe2, 0xe3, 0xe3, 0xe5, 0xe7, 0xe9, 0xea, 0xf0,
-				0xf1, 0xf7, 0xf8, 0xf8, 0xfb, 0xfd,
-			},
-			{
-				0x00, 0x02, 0x04, 0x04, 0x0b, 0x14, 0x16, 0x18,
-				0x1d, 0x1e, 0x25, 0x29, 0x2c, 0x33, 0x35, 0x37,
-				0x38, 0x39, 0x3b, 0x3d, 0x3f, 0x40, 0x42, 0x45,
-				0x46, 0x48, 0x49, 0x4b, 0x4e, 0x50, 0x52, 0x56,
-				0x58, 0x63, 0x6c, 0x6d, 0x6f, 0x70, 0x71, 0x76,
-				0x7e, 0x84, 0x85, 0x91, 0x94, 0x96, 0x9c, 0x9d,
-				0xaa, 0xad, 0xaf, 0xb0, 0xb0, 0xb6, 0xb8, 0xb9,
-				0xbc, 0xbd, 0xbf, 0xc0, 0xc1, 0xc1, 0xc3, 0xc6,
-				0xc8, 0xca, 0xcb, 0xcd, 0xcf, 0xd1, 0xd1, 0xd2,
-				0xdd, 0xdd, 0xe4, 0xe8, 0xeb, 0xed, 0xf2, 0xf3,
-				0xf4, 0xf9, 0xfb, 0xfc, 0xfd, 0xff,
-			},
-		},
-
-		// Group 33
-		{
-			{
-				0x01, 0x04, 0x05, 0x05, 0x09, 0x0f, 0x0f, 0x14,
-				0x14, 0x15, 0x17, 0x1b, 0x1e, 0x22, 0x23, 0x25,
-				0x29, 0x29, 0x2d, 0x2f, 0x30, 0x32, 0x35, 0x37,
-				0x3a, 0x3d, 0x40, 0x42, 0x46, 0x4a, 0x4b, 0x52,
-				0x53, 0x55, 0x56, 0x57, 0x59, 0x5a, 0x5c, 0x5d,
-				0x5f, 0x62, 0x67, 0x6b, 0x6f, 0x73, 0x78, 0x83,
-				0x86, 0x86, 0x8a, 0x8e, 0x8e, 0x8f, 0x91, 0x98,
-				0xa1, 0xa1, 0xa4, 0xa7, 0xa9, 0xab, 0xad, 0xb2,
-				0xb5, 0xb6, 0xbb, 0xc3, 0xc5, 0xc5, 0xc6, 0xc9,
-				0xd1, 0xd3, 0xd3, 0xd8, 0xdb, 0xdd, 0xe1, 0xeb,
-				0xf0, 0xf4, 0xf5, 0xf8, 0xf9, 0xfc,
-			},
-			{
-				0x06, 0x06, 0x06, 0x07, 0x08, 0x10, 0x13, 0x15,
-				0x1b, 0x1d, 0x1f, 0x23, 0x2a, 0x30, 0x30, 0x31,
-				0x31, 0x36, 0x3a, 0x3a, 0x3c, 0x44, 0x45, 0x47,
-				0x4e, 0x4f, 0x51, 0x54, 0x54, 0x55, 0x58, 0x58,
-				0x5b, 0x63, 0x63, 0x64, 0x67, 0x68, 0x6a, 0x6c,
-				0x70, 0x73, 0x73, 0x75, 0x76, 0x77, 0x78, 0x7c,
-				0x80, 0x80, 0x82, 0x89, 0x8c, 0x8d, 0x97, 0x99,
-				0x9a, 0xa2, 0xa3, 0xa5, 0xad, 0xae, 0xb0, 0xb1,
-				0xb2, 0xb4, 0xb8, 0xbb, 0xbe, 0xca, 0xcd, 0xcf,
-				0xd7, 0xd9, 0xdc, 0xdd, 0xde, 0xe3, 0xe8, 0xee,
-				0xef, 0xf3, 0xf4, 0xf5, 0xfc, 0xfe,
-			},
-			{
-				0x01, 0x02, 0x04, 0x0c, 0x0d, 0x12, 0x16, 0x17,
-				0x18, 0x18, 0x1a, 0x1c, 0x1e, 0x20, 0x26, 0x28,
-				0x2a, 0x2b, 0x2e, 0x32, 0x33, 0x33, 0x35, 0x38,
-				0x3b, 0x3d, 0x42, 0x4b, 0x52, 0x5c, 0x61, 0x66,
-				0x68, 0x68, 0x6c, 0x71, 0x72, 0x74, 0x75, 0x78,
-				0x7a, 0x7b, 0x85, 0x87, 0x88, 0x90, 0x91, 0x94,
-				0x9a, 0x9d, 0x9f, 0xa0, 0xa4, 0xab, 0xac, 0xb3,
-				0xb3, 0xb8, 0xba, 0xbd, 0xbf, 0xc0, 0xc0, 0xc1,
-				0xc2, 0xc3, 0xc7, 0xc9, 0xcc, 0xd0, 0xd1, 0xd2,
-				0xda, 0xdc, 0xe4, 0xee, 0xef, 0xf3, 0xf6, 0xf7,
-				0xf9, 0xfa, 0xfb, 0xfd, 0xff, 0xff,
-			},
-			{
-				0x00, 0x07, 0x09, 0x0b, 0x0d, 0x0d, 0x0e, 0x11,
-				0x13, 0x13, 0x1a, 0x1d, 0x22, 0x24, 0x25, 0x27,
-				0x2c, 0x34, 0x3e, 0x44, 0x47, 0x48, 0x49, 0x4c,
-				0x4c, 0x5d, 0x5e, 0x5e, 0x60, 0x67, 0x6b, 0x6e,
-				0x6e, 0x71, 0x72, 0x74, 0x7b, 0x7d, 0x7f, 0x82,
-				0x83, 0x85, 0x89, 0x89, 0x92, 0x93, 0x95, 0x96,
-				0x9c, 0x9c, 0x9d, 0x9d, 0x9f, 0xa0, 0xa7, 0xa9,
-				0xaa, 0xaa, 0xb0, 0xb1, 0xb6, 0xbc, 0xc2, 0xc3,
-				0xc8, 0xd2, 0xd4, 0xd4, 0xd5, 0xd5, 0xd6, 0xd7,
-				0xdc, 0xde, 0xe1, 0xe2, 0xe2, 0xe3, 0xe5, 0xe5,
-				0xe7, 0xed, 0xef, 0xf4, 0xfa, 0xfa,
-			},
-			{
-				0x03, 0x08, 0x0a, 0x11, 0x19, 0x19, 0x21, 0x22,
-				0x24, 0x29, 0x2c, 0x34, 0x36, 0x37, 0x38, 0x3b,
-				0x3d, 0x3e, 0x3f, 0x40, 0x41, 0x42, 0x44, 0x46,
-				0x49, 0x4a, 0x4e, 0x4f, 0x50, 0x51, 0x53, 0x57,
-				0x60, 0x62, 0x65, 0x66, 0x69, 0x6d, 0x6e, 0x6f,
-				0x79, 0x79, 0x7b, 0x7c, 0x7d, 0x7e, 0x7f, 0x84,
-				0x84, 0x87, 0x88, 0x8a, 0x8b, 0x8c, 0x8d, 0x94,
-				0x98, 0x9f, 0xa0, 0xa6, 0xa6, 0xac, 0xae, 0xbc,
-				0xc1, 0xc4, 0xcb, 0xd0, 0xd5, 0xda, 0xda, 0xdb,
-				0xe0, 0xe4, 0xe5, 0xe6, 0xe8, 0xe9, 0xec, 0xec,
-				0xed, 0xf1, 0xf1, 0xf6, 0xf8, 0xfd,
-			},
-			{
-				0x00, 0x0a, 0x0c, 0x14, 0x16, 0x17, 0x20, 0x21,
-				0x26, 0x27, 0x28, 0x2d, 0x32, 0x39, 0x3b, 0x3f,
-				0x41, 0x43, 0x43, 0x45, 0x48, 0x4d, 0x50, 0x52,
-				0x57, 0x59, 0x59, 0x5a, 0x5b, 0x5e, 0x62, 0x63,
-				0x65, 0x69, 0x6a, 0x6b, 0x6d, 0x70, 0x72, 0x74,
-				0x76, 0x79, 0x7a, 0x7e, 0x83, 0x8b, 0x8c, 0x8f,
-				0x90, 0x92, 0x93, 0x95, 0x96, 0x96, 0x98, 0x9a,
-				0x9b, 0x9e, 0xa3, 0xa5, 0xa8, 0xac, 0xb2, 0xb3,
-				0xb4, 0xb7, 0xb9, 0xbd, 0xbe, 0xc6, 0xca, 0xcb,
-				0xce, 0xd0, 0xd8, 0xd9, 0xdf, 0xe0, 0xe4, 0xe9,
-				0xea, 0xf1, 0xf2, 0xf3, 0xfb, 0xfb,
-			},
-			{
-				0x01, 0x02, 0x03, 0x03, 0x09, 0x0b, 0x0e, 0x10,
-				0x12, 0x12, 0x15, 0x1c, 0x1f, 0x24, 0x2b, 0x2e,
-				0x2f, 0x33, 0x36, 0x37, 0x39, 0x39, 0x3c, 0x3e,
-				0x46, 0x4c, 0x4d, 0x4f, 0x53, 0x56, 0x5b, 0x5d,
-				0x5f, 0x61, 0x64, 0x65, 0x66, 0x77, 0x77, 0x7a,
-				0x7e, 0x81, 0x81, 0x81, 0x90, 0x91, 0x97, 0x99,
-				0x9b, 0x9b, 0x9e, 0xa2, 0xa8, 0xaf, 0xaf, 0xb5,
-				0xb7, 0xb9, 0xba, 0xbd, 0xbf, 0xc4, 0xc7, 0xc8,
-				0xcc, 0xcd, 0xce, 0xcf, 0xcf, 0xd6, 0xde, 0xdf,
-				0xe1, 0xe6, 0xe7, 0xe8, 0xe9, 0xea, 0xea, 0xeb,
-				0xf0, 0xf2, 0xf7, 0xf8, 0xfe, 0xff,
-			},
-		},
-
-		// Group 34
-		{
-			{
-				0x01, 0x04, 0x10, 0x11, 0x17, 0x1d, 0x1e, 0x21,
-				0x23, 0x26, 0x28, 0x2d, 0x2d, 0x30, 0x32, 0x37,
-				0x38, 0x3d, 0x42, 0x45, 0x4d, 0x4f, 0x55, 0x55,
-				0x59, 0x59, 0x5d, 0x60, 0x61, 0x62, 0x67, 0x67,
-				0x6c, 0x6d, 0x70, 0x73, 0x77, 0x7b, 0x7d, 0x8d,
-				0x8f, 0x92, 0x92, 0x94, 0x94, 0x95, 0x97, 0x99,
-				0x9e, 0xa0, 0xa1, 0xa2, 0xa2, 0xa7, 0xab, 0xac,
-				0xaf, 0xb0, 0xb1, 0xb1, 0xb2, 0xb3, 0xb4, 0xb5,
-				0xbc, 0xc1, 0xc5, 0xc7, 0xc9, 0xca, 0xcb, 0xcc,
-				0xcd, 0xcf, 0xd0, 0xd5, 0xd9, 0xda, 0xdb, 0xde,
-				0xe7, 0xe9, 0xf2, 0xf8, 0xfb, 0xff,
-			},
-			{
-				0x03, 0x0a, 0x0e, 0x1a, 0x1d, 0x1e, 0x23, 0x23,
-				0x24, 0x27, 0x29, 0x29, 0x2c, 0x2e, 0x33, 0x35,
-				0x3e, 0x3e, 0x3e, 0x42, 0x43, 0x45, 0x49, 0x49,
-				0x4b, 0x4d, 0x4e, 0x50, 0x53, 0x57, 0x57, 0x5a,
-				0x5c, 0x5d, 0x5e, 0x5e, 0x62, 0x65, 0x67, 0x68,
-				0x6c, 0x71, 0x74, 0x79, 0x7a, 0x86, 0x87, 0x8c,
-				0x8e, 0x8f, 0x90, 0x91, 0x91, 0x97, 0xa3, 0xa4,
-				0xa6, 0xa7, 0xa8, 0xa9, 0xaf, 0xb5, 0xb8, 0xbc,
-				0xbf, 0xc0, 0xc8, 0xce, 0xcf, 0xd2, 0xdb, 0xdc,
-				0xdd, 0xe4, 0xe5, 0xe7, 0xe8, 0xe9, 0xec, 0xee,
-				0xf2, 0xf5, 0xf6, 0xf8, 0xfd, 0xff,
-			},
-			{
-				0x00, 0x04, 0x05, 0x06, 0x06, 0x07, 0x0a, 0x0d,
-				0x13, 0x17, 0x18, 0x19, 0x19, 0x1a, 0x1b, 0x1c,
-				0x20, 0x22, 0x25, 0x2b, 0x2f, 0x31, 0x35, 0x37,
-				0x3b, 0x3c, 0x40, 0x44, 0x44, 0x47, 0x54, 0x55,
-				0x63, 0x63, 0x66, 0x69, 0x6a, 0x6b, 0x75, 0x77,
-				0x77, 0x88, 0x89, 0x8b, 0x9a, 0x9c, 0x9f, 0xa4,
-				0xa5, 0xa6, 0xa8, 0xac, 0xae, 0xaf, 0xb3, 0xb4,
-				0xb6, 0xb7, 0xba, 0xbb, 0xbb, 0xbf, 0xc0, 0xc2,
-				0xc5, 0xc6, 0xc8, 0xcd, 0xd0, 0xd2, 0xd3, 0xd6,
-				0xd7, 0xd9, 0xd9, 0xda, 0xda, 0xe0, 0xe1, 0xe5,
-				0xea, 0xec, 0xed, 0xf7, 0xfa, 0xfc,
-			},
-			{
-				0x02, 0x02, 0x06, 0x07, 0x09, 0x0c, 0x11, 0x14,
-				0x14, 0x16, 0x16, 0x18, 0x19, 0x1f, 0x21, 0x24,
-				0x25, 0x27, 0x27, 0x28, 0x28, 0x2e, 0x2f, 0x31,
-				0x32, 0x36, 0x38, 0x3b, 0x3f, 0x40, 0x41, 0x42,
-				0x43, 0x47, 0x4d, 0x4e, 0x50, 0x52, 0x54, 0x56,
-				0x56, 0x5c, 0x62, 0x64, 0x66, 0x71, 0x74, 0x78,
-				0x7d, 0x81, 0x82, 0x83, 0x85, 0x89, 0x8a, 0x8c,
-				0x8d, 0x9b, 0x9b, 0x9d, 0xa5, 0xa9, 0xad, 0xad,
-				0xb9, 0xbd, 0xbe, 0xc2, 0xc2, 0xc3, 0xc7, 0xcb,
-				0xd4, 0xd5, 0xd7, 0xdd, 0xdf, 0xe3, 0xe5, 0xed,
-				0xef, 0xf0, 0xf1, 0xf7, 0xf9, 0xfb,
-			},
-			{
-				0x01, 0x03, 0x05, 0x09, 0x0e, 0x12, 0x14, 0x1b,
-				0x1c, 0x1f, 0x20, 0x21, 0x26, 0x2a, 0x2b, 0x2c,
-				0x2f, 0x31, 0x33, 0x34, 0x36, 0x39, 0x39, 0x3a,
-				0x3a, 0x3c, 0x40, 0x44, 0x47, 0x4c, 0x4c, 0x4f,
-				0x50, 0x53, 0x58, 0x59, 0x5b, 0x60, 0x60, 0x61,
-				0x69, 0x6a, 0x6b, 0x6c, 0x72, 0x73, 0x74, 0x75,
-				0x76, 0x79, 0x7c, 0x7d, 0x7f, 0x80, 0x81, 0x84,
-				0x86, 0x89, 0x8b, 0x8c, 0x8e, 0x93, 0x99, 0x9a,
-				0x9e, 0x9f, 0xa0, 0xa4, 0xb2, 0xb3, 0xb6, 0xb9,
-				0xbe, 0xc3, 0xc8, 0xc9, 0xc9, 0xca, 0xce, 0xd8,
-				0xdc, 0xe0, 0xe4, 0xed, 0xf6, 0xfb,
-			},
-			{
-				0x04, 0x08, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0f,
-				0x11, 0x12, 0x22, 0x33, 0x34, 0x41, 0x46, 0x4a,
-				0x4a, 0x4e, 0x51, 0x51, 0x53, 0x58, 0x5a, 0x5e,
-				0x65, 0x68, 0x6b, 0x6d, 0x6e, 0x6f, 0x72, 0x76,
-				0x78, 0x79, 0x7a, 0x7b, 0x7c, 0x7e, 0x80, 0x82,
-				0x85, 0x8e, 0x96, 0x98, 0x9c, 0x9c, 0x9d, 0xa1,
-				0xa2, 0xa9, 0xaa, 0xab, 0xae, 0xb0, 0xb2, 0xb6,
-				0xb7, 0xbc, 0xbf, 0xc1, 0xd1, 0xd6, 0xd8, 0xdb,
-				0xde, 0xe2, 0xe3, 0xe6, 0xe6, 0xe8, 0xe9, 0xea,
-				0xeb, 0xeb, 0xef, 0xf0, 0xf0, 0xf3, 0xf4, 0xf4,
-				0xf5, 0xfa, 0xfc, 0xfe, 0xfe, 0xff,
-			},
-			{
-				0x00, 0x01, 0x0b, 0x0c, 0x0e, 0x0f, 0x10, 0x13,
-				0x15, 0x15, 0x25, 0x2a, 0x2a, 0x30, 0x38, 0x3d,
-				0x3f, 0x43, 0x46, 0x48, 0x48, 0x49, 0x4b, 0x51,
-				0x52, 0x54, 0x58, 0x5b, 0x5f, 0x5f, 0x61, 0x63,
-				0x64, 0x6a, 0x6e, 0x6f, 0x70, 0x78, 0x7e, 0x7f,
-				0x81, 0x83, 0x84, 0x86, 0x87, 0x87, 0x88, 0x8a,
-				0x8d, 0x90, 0x93, 0x94, 0x95, 0x96, 0x98, 0xa3,
-				0xa3, 0xa7, 0xaa, 0xaa, 0xb5, 0xb8, 0xba, 0xbd,
-				0xbe, 0xc0, 0xc4, 0xc4, 0xc6, 0xcc, 0xd1, 0xd3,
-				0xd4, 0xd8, 0xdf, 0xe1, 0xe2, 0xee, 0xf1, 0xf1,
-				0xf3, 0xf4, 0xf5, 0xf6, 0xf9, 0xfd,
-			},
-		},
-
-		// Group 35
-		{
-			{
-				0x01, 0x07, 0x08, 0x08, 0x0b, 0x11, 0x12, 0x13,
-				0x19, 0x1d, 0x20, 0x21, 0x21, 0x22, 0x24, 0x25,
-				0x28, 0x2b, 0x2e, 0x31, 0x35, 0x36, 0x3b, 0x3b,
-				0x3c, 0x3d, 0x3e, 0x40, 0x4a, 0x52, 0x5c, 0x63,
-				0x64, 0x6e, 0x75, 0x7a, 0x7b, 0x87, 0x88, 0x89,
-				0x8a, 0x8f, 0x9b, 0x9c, 0x9d, 0xa1, 0xa2, 0xa3,
-				0xa4, 0xa9, 0xab, 0xac, 0xb1, 0xb2, 0xb6, 0xb7,
-				0xb8, 0xbb, 0xbb, 0xbc, 0xbe, 0xc3, 0xc4, 0xc6,
-				0xc6, 0xca, 0xcb, 0xce, 0xd3, 0xd3, 0xd7, 0xd8,
-				0xd9, 0xda, 0xde, 0xe3, 0xea, 0xea, 0xef, 0xef,
-				0xf1, 0xf2, 0xf4, 0xf5, 0xf8, 0xfb,
-			},
-			{
-				0x00, 0x08, 0x0a, 0x0c, 0x0e, 0x15, 0x17, 0x1a,
-				0x1f, 0x25, 0x26, 0x26, 0x28, 0x2b, 0x2d, 0x2e,
-				0x33, 0x37, 0x37, 0x38, 0x38, 0x39, 0x3f, 0x42,
-				0x45, 0x48, 0x49, 0x4b, 0x4b, 0x53, 0x59, 0x5b,
-				0x5c, 0x5d, 0x61, 0x67, 0x68, 0x6a, 0x6f, 0x73,
-				0x74, 0x75, 0x78, 0x80, 0x81, 0x83, 0x88, 0x89,
-				0x8a, 0x8d, 0x8d, 0x8f, 0x92, 0x95, 0x9a, 0x9a,
-				0xa0, 0xa5, 0xa6, 0xa6, 0xa7, 0xa7, 0xa9, 0xac,
-				0xad, 0xb3, 0xb4, 0xb5, 0xba, 0xc2, 0xc3, 0xc9,
-				0xd1, 0xd2, 0xd4, 0xd5, 0xd9, 0xdb, 0xe3, 0xe5,
-				0xe7, 0xec, 0xf7, 0xf9, 0xfd, 0xff,
-			},
-			{
-				0x00, 0x03, 0x07, 0x0d, 0x0d, 0x0f, 0x0f, 0x13,
-				0x15, 0x1d, 0x27, 0x29, 0x2c, 0x2f, 0x30, 0x34,
-				0x35, 0x36, 0x3b, 0x3f, 0x41, 0x42, 0x47, 0x48,
-				0x4c, 0x50, 0x53, 0x54, 0x55, 0x5d, 0x60, 0x61,
-				0x65, 0x6c, 0x7c, 0x7d, 0x7e, 0x84, 0x89, 0x8c,
-				0x90, 0x94, 0x96, 0x99, 0x9b, 0x9f, 0xa4, 0xa4,
-				0xa6, 0xa8, 0xb0, 0xb1, 0xb2, 0xb7, 0xb8, 0xb9,
-				0xba, 0xbd, 0xc5, 0xc5, 0xca, 0xcc, 0xcc, 0xcf,
-				0xd4, 0xd6, 0xd6, 0xd8, 0xd9, 0xdc, 0xdd, 0xdf,
-				0xe2, 0xe4, 0xe8, 0xe9, 0xeb, 0xec, 0xee, 0xf6,
-				0xf7, 0xf8, 0xf8, 0xfb, 0xfe, 0xff,
-			},
-			{
-				0x01, 0x01, 0x02, 0x04, 0x05, 0x0b, 0x12, 0x14,
-				0x1a, 0x1b, 0x1c, 0x1e, 0x1e, 0x24, 0x28, 0x2a,
-				0x2a, 0x2c, 0x2f, 0x30, 0x31, 0x43, 0x43, 0x4a,
-				0x4c, 0x4d, 0x4e, 0x4f, 0x51, 0x52, 0x53, 0x58,
-				0x5c, 0x62, 0x62, 0x65, 0x67, 0x68, 0x6b, 0x71,
-				0x71, 0x76, 0x76, 0x79, 0x79, 0x7a, 0x7b, 0x7f,
-				0x80, 0x82, 0x85, 0x86, 0x8e, 0x90, 0x94, 0x95,
-				0x97, 0x99, 0x9c, 0x9e, 0x9f, 0xa1, 0xaa, 0xae,
-				0xb2, 0xb3, 0xb5, 0xb5, 0xb8, 0xb9, 0xbe, 0xbf,
-				0xbf, 0xc1, 0xc4, 0xc7, 0xc9, 0xcc, 0xcd, 0xd1,
-				0xe0, 0xe1, 0xe4, 0xf1, 0xf3, 0xfe,
-			},
-			{
-				0x06, 0x10, 0x12, 0x15, 0x16, 0x16, 0x17, 0x17,
-				0x19, 0x1b, 0x1c, 0x1c, 0x1f, 0x24, 0x32, 0x34,
-				0x3a, 0x3a, 0x3d, 0x41, 0x41, 0x4a, 0x4e, 0x4e,
-				0x51, 0x54, 0x5f, 0x5f, 0x60, 0x60, 0x63, 0x69,
-				0x6a, 0x6c, 0x6d, 0x6f, 0x70, 0x72, 0x72, 0x73,
-				0x7c, 0x7f, 0x84, 0x8b, 0x91, 0x91, 0x93, 0x94,
-				0x98, 0x9d, 0x9e, 0xa0, 0xaa, 0xaa, 0xab, 0xb0,
-				0xb3, 0xbd, 0xbe, 0xc1, 0xc2, 0xc7, 0xc7, 0xcb,
-				0xd1, 0xd2, 0xd7, 0xda, 0xdc, 0xdd, 0xdf, 0xe0,
-				0xe2, 0xe4, 0xe5, 0xe6, 0xe6, 0xeb, 0xed, 0xf1,
-				0xf3, 0xf5, 0xf6, 0xf9, 0xfc, 0xfc,
-			},
-			{
-				0x02, 0x03, 0x05, 0x09, 0x0a, 0x0e, 0x0f, 0x10,
-				0x11, 0x18, 0x20, 0x29, 0x2a, 0x31, 0x33, 0x36,
-				0x3d, 0x3e, 0x40, 0x44, 0x45, 0x46, 0x47, 0x48,
-				0x49, 0x50, 0x55, 0x57, 0x5a, 0x5e, 0x64, 0x64,
-				0x66, 0x69, 0x69, 0x6b, 0x6d, 0x70, 0x77, 0x78,
-				0x7b, 0x7d, 0x7e, 0x81, 0x82, 0x83, 0x84, 0x85,
-				0x86, 0x8b, 0x8c, 0x8c, 0x8e, 0x91, 0x95, 0x98,
-				0x99, 0x9a, 0x9f, 0xa2, 0xa3, 0xad, 0xaf, 0xaf,
-				0xb4, 0xba, 0xbb, 0xbc, 0xc0, 0xc1, 0xce, 0xcf,
-				0xd5, 0xd6, 0xda, 0xdd, 0xe1, 0xe8, 0xed, 0xef,
-				0xf0, 0xf0, 0xf2, 0xf4, 0xfa, 0xfa,
-			},
-			{
-				0x04, 0x06, 0x07, 0x09, 0x0c, 0x10, 0x13, 0x14,
-				0x14, 0x18, 0x1a, 0x1b, 0x21, 0x22, 0x23, 0x23,
-				0x25, 0x27, 0x2b, 0x2d, 0x32, 0x33, 0x39, 0x3c,
-				0x44, 0x46, 0x4d, 0x4f, 0x56, 0x56, 0x57, 0x58,
-				0x59, 0x59, 0x5a, 0x5b, 0x5d, 0x5e, 0x61, 0x62,
-				0x63, 0x66, 0x6a, 0x6c, 0x6e, 0x71, 0x74, 0x77,
-				0x7e, 0x87, 0x8a, 0x8d, 0x92, 0x93, 0x96, 0x97,
-				0x9c, 0xa5, 0xa7, 0xa8, 0xac, 0xae, 0xae, 0xaf,
-				0xb6, 0xc0, 0xc2, 0xc6, 0xc8, 0xc8, 0xcd, 0xcf,
-				0xd0, 0xd0, 0xd7, 0xdb, 0xdc, 0xde, 0xe7, 0xe8,
-				0xe9, 0xed, 0xee, 0xf7, 0xfd, 0xfe,
-			},
-		},
-
-		// Group 36
-		{
-			{
-				0x01, 0x02, 0x05, 0x06, 0x0a, 0x12, 0x14, 0x19,
-				0x24, 0x31, 0x32, 0x32, 0x36, 0x3f, 0x40, 0x41,
-				0x43, 0x47, 0x47, 0x4f, 0x53, 0x54, 0x55, 0x59,
-				0x59, 0x59, 0x5a, 0x5c, 0x60, 0x67, 0x68, 0x6b,
-				0x6e, 0x76, 0x77, 0x7c, 0x84, 0x85, 0x91, 0x94,
-				0x97, 0x9a, 0x9b, 0x9d, 0x9e, 0xa1, 0xa4, 0xa5,
-				0xae, 0xaf, 0xb7, 0xbb, 0xc0, 0xc2, 0xc3, 0xc5,
-				0xc6, 0xc7, 0xc8, 0xc9, 0xcb, 0xce, 0xce, 0xd0,
-				0xd0, 0xd2, 0xd5, 0xd5, 0xd7, 0xd9, 0xda, 0xdc,
-				0xdd, 0xdd, 0xe1, 0xe3, 0xe3, 0xe4, 0xe9, 0xea,
-				0xee, 0xf0, 0xf7, 0xfc, 0xfd, 0xff,
-			},
-			{
-				0x01, 0x03, 0x08, 0x16, 0x1c, 0x1d, 0x1f, 0x22,
-				0x28, 0x2e, 0x2f, 0x2f, 0x30, 0x31, 0x33, 0x36,
-				0x39, 0x3d, 0x42, 0x43, 0x44, 0x4b, 0x52, 0x53,
-				0x54, 0x58, 0x5d, 0x5e, 0x60, 0x63, 0x63, 0x65,
-				0x65, 0x66, 0x6a, 0x73, 0x76, 0x79, 0x7b, 0x7d,
-				0x82, 0x82, 0x87, 0x87, 0x8a, 0x8a, 0x8b, 0x90,
-				0x94, 0x96, 0x97, 0xa6, 0xa9, 0xac, 0xb2, 0xb9,
-				0xbe, 0xbf, 0xca, 0xd1, 0xd3, 0xd3, 0xd4, 0xd4,
-				0xd6, 0xd7, 0xd8, 0xd8, 0xd9, 0xde, 0xdf, 0xe3,
-				0xe5, 0xe7, 0xeb, 0xec, 0xef, 0xf1, 0xf2, 0xf3,
-				0xf5, 0xf8, 0xf9, 0xfa, 0xfb, 0xff,
-			},
-			{
-				0x07, 0x09, 0x0c, 0x0e, 0x0f, 0x11, 0x13, 0x18,
-				0x19, 0x1a, 0x22, 0x23, 0x24, 0x26, 0x27, 0x28,
-				0x2c, 0x2e, 0x35, 0x38, 0x42, 0x43, 0x4c, 0x4e,
-				0x4f, 0x56, 0x56, 0x57, 0x5a, 0x5b, 0x5e, 0x61,
-				0x69, 0x6f, 0x71, 0x72, 0x74, 0x75, 0x7c, 0x7e,
-				0x7f, 0x81, 0x85, 0x88, 0x88, 0x8b, 0x8b, 0x8f,
-				0x8f, 0x90, 0x91, 0x95, 0x95, 0x97, 0x98, 0x9b,
-				0xa0, 0xa2, 0xa3, 0xaa, 0xab, 0xad, 0xae, 0xb0,
-				0xb9, 0xbd, 0xc0, 0xc1, 0xc4, 0xc6, 0xca, 0xcc,
-				0xd5, 0xda, 0xdc, 0xdd, 0xe1, 0xe2, 0xe8, 0xed,
-				0xf0, 0xf7, 0xfc, 0xfe, 0xfe, 0xfe,
-			},
-			{
-				0x00, 0x02, 0x04, 0x09, 0x0e, 0x12, 0x13, 0x14,
-				0x17, 0x1b, 0x1c, 0x1c, 0x1e, 0x1e, 0x23, 0x25,
-				0x27, 0x29, 0x29, 0x2a, 0x2b, 0x2b, 0x2c, 0x2d,
-				0x30, 0x32, 0x34, 0x35, 0x36, 0x37, 0x44, 0x46,
-				0x4a, 0x4b, 0x4e, 0x50, 0x51, 0x62, 0x64, 0x64,
-				0x6f, 0x70, 0x75, 0x7b, 0x7c, 0x81, 0x86, 0x89,
-				0x8c, 0x8c, 0x93, 0x99, 0x9e, 0xa0, 0xa7, 0xa8,
-				0xac, 0xae, 0xb1, 0xb3, 0xb4, 0xb4, 0xb5, 0xb8,
-				0xbb, 0xc1, 0xc2, 0xcb, 0xcc, 0xcf, 0xcf, 0xd4,
-				0xd6, 0xd7, 0xdb, 0xe1, 0xe2, 0xe4, 0xe6, 0xec,
-				0xf2, 0xf4, 0xf4, 0xf6, 0xf9, 0xfb,
-			},
-			{
-				0x00, 0x04, 0x0a, 0x0c, 0x0d, 0x0f, 0x11, 0x15,
-				0x15, 0x17, 0x19, 0x25, 0x26, 0x27, 0x33, 0x37,
-				0x38, 0x3e, 0x3f, 0x40, 0x45, 0x47, 0x4a, 0x4d,
-				0x4e, 0x51, 0x55, 0x5f, 0x61, 0x66, 0x67, 0x69,
-				0x6a, 0x6b, 0x6c, 0x6d, 0x71, 0x73, 0x77, 0x7f,
-				0x80, 0x80, 0x83, 0x83, 0x86, 0x87, 0x88, 0x94,
-				0x96, 0x98, 0x9c, 0x9c, 0xa0, 0xa1, 0xa2, 0xa3,
-				0xaa, 0xac, 0xad, 0xaf, 0xb1, 0xb5, 0xb6, 0xb7,
-				0xb7, 0xba, 0xba, 0xbf, 0xc1, 0xc3, 0xc7, 0xca,
-				0xcd, 0xd2, 0xd8, 0xdb, 0xe0, 0xe5, 0xe6, 0xe7,
-				0xe9, 0xea, 0xed, 0xee, 0xf7, 0xfa,
-			},
-			{
-				0x01, 0x03, 0x03, 0x05, 0x08, 0x0b, 0x0e, 0x12,
-				0x16, 0x17, 0x18, 0x1a, 0x1f, 0x20, 0x20, 0x21,
-				0x30, 0x34, 0x39, 0x3a, 0x3b, 0x3c, 0x3e, 0x3e,
-				0x41, 0x44, 0x45, 0x46, 0x48, 0x48, 0x49, 0x4f,
-				0x50, 0x51, 0x52, 0x58, 0x5d, 0x5f, 0x68, 0x6c,
-				0x70, 0x74, 0x78, 0x78, 0x7a, 0x7d, 0x7d, 0x7e,
-				0x84, 0x89, 0x89, 0x8d, 0x8d, 0x8e, 0x8f, 0x93,
-				0x99, 0x9a, 0x9d, 0x9f, 0xa2, 0xa4, 0xa5, 0xa6,
-				0xa8, 0xaa, 0xaf, 0xb3, 0xb8, 0xbc, 0xbc, 0xbe,
-				0xbf, 0xc2, 0xc5, 0xc6, 0xc8, 0xc9, 0xde, 0xeb,
-				0xf1, 0xf3, 0xf8, 0xf9, 0xfb, 0xfc,
-			},
-			{
-				0x02, 0x06, 0x07, 0x0b, 0x0d, 0x0f, 0x10, 0x10,
-				0x11, 0x1a, 0x1b, 0x1d, 0x20, 0x21, 0x24, 0x26,
-				0x2a, 0x2d, 0x33, 0x3a, 0x3b, 0x3c, 0x3d, 0x48,
-				0x49, 0x4c, 0x4d, 0x57, 0x5a, 0x5b, 0x5c, 0x62,
-				0x62, 0x65, 0x68, 0x6a, 0x6b, 0x6d, 0x6e, 0x70,
-				0x72, 0x73, 0x74, 0x79, 0x7a, 0x7b, 0x7e, 0x84,
-				0x85, 0x86, 0x8c, 0x8e, 0x90, 0x92, 0x92, 0x9b,
-				0x9d, 0x9f, 0xa7, 0xa8, 0xa9, 0xab, 0xad, 0xb0,
-				0xb2, 0xb6, 0xbd, 0xc4, 0xc8, 0xcc, 0xcd, 0xd0,
-				0xd1, 0xda, 0xdf, 0xe0, 0xe4, 0xe5, 0xe7, 0xe8,
-				0xec, 0xef, 0xf5, 0xf6, 0xfa, 0xfd,
-			},
-		},
-
-		// Group 37
-		{
-			{
-				0x02, 0x06, 0x0a, 0x0c, 0x0f, 0x10, 0x16, 0x18,
-				0x19, 0x1a, 0x22, 0x2b, 0x2c, 0x30, 0x33, 0x35,
-				0x35, 0x39, 0x3a, 0x3c, 0x43, 0x46, 0x49, 0x4d,
-				0x4f, 0x53, 0x54, 0x54, 0x57, 0x59, 0x59, 0x5b,
-				0x5d, 0x62, 0x63, 0x63, 0x64, 0x65, 0x65, 0x68,
-				0x6a, 0x6b, 0x73, 0x76, 0x77, 0x78, 0x7b, 0x7d,
-				0x81, 0x85, 0x8b, 0x92, 0x93, 0x96, 0x9c, 0xad,
-				0xaf, 0xb0, 0xb6, 0xbe, 0xc2, 0xc5, 0xc7, 0xc7,
-				0xc8, 0xc8, 0xca, 0xca, 0xce, 0xd1, 0xd2, 0xdb,
-				0xdc, 0xdc, 0xdd, 0xe2, 0xe2, 0xe2, 0xe3, 0xea,
-				0xea, 0xed, 0xf0, 0xf1, 0xf8, 0xf9,
-			},
-			{
-				0x01, 0x02, 0x04, 0x08, 0x08, 0x0b, 0x14, 0x17,
-				0x1c, 0x1d, 0x21, 0x23, 0x27, 0x2b, 0x2e, 0x41,
-				0x55, 0x58, 0x5b, 0x60, 0x61, 0x69, 0x6a, 0x6b,
-				0x6c, 0x6d, 0x70, 0x73, 0x75, 0x79, 0x7e, 0x80,
-				0x81, 0x81, 0x83, 0x86, 0x87, 0x88, 0x91, 0x91,
-				0x94, 0x97, 0x98, 0x99, 0x9a, 0x9a, 0x9b, 0x9c,
-				0x9c, 0xa0, 0xa1, 0xa7, 0xa9, 0xae, 0xb5, 0xb5,
-				0xb8, 0xb9, 0xbc, 0xbf, 0xc0, 0xc1, 0xc4, 0xcc,
-				0xcf, 0xd2, 0xd3, 0xd5, 0xd7, 0xe0, 0xe8, 0xea,
-				0xeb, 0xeb, 0xec, 0xed, 0xee, 0xef, 0xf0, 0xf2,
-				0xf3, 0xf5, 0xf7, 0xfd, 0xfe, 0xfe,
-			},
-			{
-				0x01, 0x02, 0x0a, 0x0b, 0x0d, 0x17, 0x19, 0x1d,
-				0x1e, 0x24, 0x25, 0x29, 0x2c, 0x2e, 0x2f, 0x32,
-				0x33, 0x3a, 0x3f, 0x42, 0x43, 0x45, 0x47, 0x49,
-				0x4a, 0x4b, 0x52, 0x53, 0x55, 0x5a, 0x5b, 0x61,
-				0x67, 0x6a, 0x6d, 0x6e, 0x6f, 0x6f, 0x71, 0x72,
-				0x75, 0x77, 0x7b, 0x7d, 0x83, 0x8a, 0x8a, 0x8d,
-				0x8e, 0x8f, 0x9b, 0x9d, 0xa1, 0xa2, 0xa3, 0xa7,
-				0xa8, 0xa8, 0xaa, 0xab, 0xab, 0xb0, 0xb1, 0xb1,
-				0xb3, 0xb4, 0xb4, 0xb9, 0xba, 0xbb, 0xc1, 0xc3,
-				0xc6, 0xcc, 0xd0, 0xd4, 0xd5, 0xd9, 0xda, 0xe1,
-				0xe1, 0xe5, 0xf0, 0xf4, 0xf6, 0xfa,
-			},
-			{
-				0x03, 0x04, 0x05, 0x06, 0x0d, 0x11, 0x12, 0x13,
-				0x13, 0x14, 0x1c, 0x1d, 0x21, 0x22, 0x24, 0x28,
-				0x2e, 0x31, 0x34, 0x36, 0x38, 0x3c, 0x3d, 0x42,
-				0x46, 0x48, 0x4c, 0x4c, 0x4e, 0x4f, 0x51, 0x57,
-				0x58, 0x59, 0x5c, 0x64, 0x66, 0x67, 0x67, 0x69,
-				0x6b, 0x6c, 0x72, 0x74, 0x79, 0x7b, 0x7e, 0x82,
-				0x8c, 0x8c, 0x8d, 0x90, 0x92, 0x95, 0x96, 0x97,
-				0x99, 0x9b, 0x9f, 0xa0, 0xa2, 0xa3, 0xa4, 0xa5,
-				0xad, 0xaf, 0xb2, 0xb6, 0xb6, 0xb7, 0xbd, 0xbd,
-				0xc1, 0xcb, 0xd0, 0xd1, 0xdf, 0xdf, 0xe4, 0xe8,
-				0xeb, 0xf1, 0xf7, 0xf9, 0xf9, 0xfb,
-			},
-			{
-				0x01, 0x07, 0x09, 0x0c, 0x0e, 0x15, 0x18, 0x1a,
-				0x1b, 0x1f, 0x20, 0x20, 0x22, 0x26, 0x26, 0x28,
-				0x28, 0x2a, 0x2a, 0x2d, 0x30, 0x32, 0x3b, 0x3b,
-				0x3e, 0x41, 0x46, 0x49, 0x50, 0x51, 0x52, 0x55,
-				0x56, 0x56, 0x5c, 0x5c, 0x5d, 0x5d, 0x5e, 0x5e,
-				0x5f, 0x71, 0x72, 0x73, 0x74, 0x77, 0x79, 0x7c,
-				0x80, 0x85, 0x87, 0x89, 0x8a, 0x91, 0x95, 0x97,
-				0x9d, 0xa1, 0xaa, 0xae, 0xb8, 0xb8, 0xbb, 0xbd,
-				0xbe, 0xc4, 0xc5, 0xce, 0xd7, 0xd8, 0xd9, 0xda,
-				0xdb, 0xde, 0xe3, 0xe4, 0xe9, 0xe9, 0xee, 0xef,
-				0xf1, 0xf3, 0xfa, 0xfd, 0xfd, 0xff,
-			},
-			{
-				0x00, 0x00, 0x03, 0x05, 0x0e, 0x0e, 0x0f, 0x11,
-				0x12, 0x12, 0x15, 0x16, 0x1a, 0x1b, 0x1b, 0x1f,
-				0x21, 0x29, 0x2b, 0x2f, 0x31, 0x32, 0x33, 0x34,
-				0x37, 0x37, 0x39, 0x3b, 0x3e, 0x3f, 0x40, 0x40,
-				0x44, 0x44, 0x48, 0x4a, 0x4b, 0x50, 0x57, 0x5a,
-				0x5e, 0x61, 0x78, 0x78, 0x7f, 0x7f, 0x82, 0x84,
-				0x86, 0x88, 0x89, 0x8e, 0x93, 0x94, 0x94, 0x98,
-				0x9f, 0xa2, 0xa4, 0xa5, 0xa6, 0xac, 0xb3, 0xba,
-				0xbb, 0xc0, 0xc3, 0xc5, 0xca, 0xcd, 0xcd, 0xcf,
-				0xd4, 0xd7, 0xd8, 0xdd, 0xde, 0xe0, 0xe0, 0xe5,
-				0xe7, 0xf4, 0xf5, 0xf6, 0xfc, 0xff,
-			},
-			{
-				0x03, 0x05, 0x07, 0x09, 0x09, 0x0b, 0x10, 0x10,
-				0x11, 0x14, 0x15, 0x1e, 0x23, 0x25, 0x27, 0x2d,
-				0x35, 0x36, 0x38, 0x3c, 0x3d, 0x44, 0x45, 0x47,
-				0x47, 0x48, 0x4d, 0x4e, 0x50, 0x58, 0x5a, 0x5f,
-				0x60, 0x60, 0x62, 0x66, 0x66, 0x68, 0x69, 0x6e,
-				0x70, 0x75, 0x76, 0x7a, 0x7a, 0x7c, 0x84, 0x88,
-				0x8b, 0x8f, 0x90, 0x9e, 0x9e, 0xa6, 0xa9, 0xac,
-				0xac, 0xae, 0xb2, 0xb7, 0xbc, 0xbf, 0xc2, 0xc6,
-				0xc9, 0xc9, 0xcb, 0xcb, 0xcc, 0xd2, 0xd3, 0xd6,
-				0xd6, 0xda, 0xdc, 0xdd, 0xe6, 0xe6, 0xe7, 0xe8,
-				0xec, 0xf2, 0xf5, 0xf8, 0xfb, 0xfc,
-			},
-		},
-
-		// Group 38
-		{
-			{
-				0x04, 0x05, 0x09, 0x0d, 0x0e, 0x10, 0x12, 0x14,
-				0x24, 0x25, 0x2a, 0x2f, 0x32, 0x34, 0x37, 0x41,
-				0x42, 0x43, 0x49, 0x4c, 0x4f, 0x60, 0x69, 0x6b,
-				0x6c, 0x6f, 0x6f, 0x71, 0x71, 0x76, 0x78, 0x78,
-				0x7a, 0x7d, 0x83, 0x85, 0x85, 0x86, 0x87, 0x8c,
-				0x92, 0x94, 0x95, 0x96, 0x96, 0x98, 0x9c, 0x9f,
-				0x9f, 0xa4, 0xa5, 0xa9, 0xa9, 0xa9, 0xac, 0xae,
-				0xb4, 0xb4, 0xbb, 0xbc, 0xbd, 0xbd, 0xc0, 0xc1,
-				0xc4, 0xc7, 0xca, 0xd0, 0xd1, 0xd2, 0xd4, 0xd9,
-				0xdd, 0xeb, 0xec, 0xee, 0xef, 0xf0, 0xf1, 0xf2,
-				0xf4, 0xf6, 0xf7, 0xf9, 0xfe, 0xff,
-			},
-			{
-				0x00, 0x01, 0x02, 0x03, 0x0c, 0x0e, 0x13, 0x14,
-				0x15, 0x17, 0x1a, 0x1e, 0x1e, 0x25, 0x26, 0x29,
-				0x29, 0x2c, 0x30, 0x35, 0x35, 0x38, 0x3b, 0x3c,
-				0x3e, 0x43, 0x44, 0x45, 0x4b, 0x4c, 0x4d, 0x50,
-				0x52, 0x55, 0x56, 0x57, 0x57, 0x59, 0x5a, 0x5f,
-				0x63, 0x69, 0x69, 0x6a, 0x6c, 0x6e, 0x79, 0x7d,
-				0x80, 0x83, 0x86, 0x89, 0x94, 0x96, 0x97, 0x98,
-				0xa2, 0xa3, 0xa5, 0xad, 0xad, 0xb0, 0xb1, 0xb2,
-				0xbe, 0xbe, 0xc3, 0xc6, 0xca, 0xcc, 0xcf, 0xd1,
-				0xd3, 0xd3, 0xe1, 0xe5, 0xe7, 0xe8, 0xe9, 0xeb,
-				0xec, 0xed, 0xf3, 0xf4, 0xf7, 0xfb,
-			},
-			{
-				0x06, 0x07, 0x0a, 0x0b, 0x16, 0x17, 0x18, 0x19,
-				0x20, 0x21, 0x23, 0x24, 0x24, 0x26, 0x27, 0x28,
-				0x2b, 0x2d, 0x2e, 0x2e, 0x30, 0x31, 0x32, 0x33,
-				0x3e, 0x40, 0x43, 0x45, 0x46, 0x46, 0x4a, 0x51,
-				0x53, 0x5c, 0x5d, 0x5e, 0x64, 0x67, 0x68, 0x68,
-				0x6a, 0x77, 0x7b, 0x7f, 0x84, 0x88, 0x89, 0x8a,
-				0x8a, 0x8b, 0x8c, 0x8d, 0x8e, 0x91, 0x92, 0x99,
-				0x9a, 0x9a, 0x9b, 0x9e, 0xa0, 0xa1, 0xa3, 0xa4,
-				0xb3, 0xba, 0xbb, 0xbf, 0xc0, 0xc3, 0xc4, 0xc9,
-				0xce, 0xcf, 0xda, 0xdb, 0xdc, 0xdd, 0xde, 0xe0,
-				0xe0, 0xe6, 0xed, 0xf2, 0xf5, 0xf8,
-			},
-			{
-				0x00, 0x01, 0x01, 0x03, 0x03, 0x04, 0x05, 0x06,
-				0x08, 0x0b, 0x0d, 0x11, 0x15, 0x16, 0x18, 0x1f,
-				0x1f, 0x21, 0x23, 0x28, 0x29, 0x2a, 0x2d, 0x2d,
-				0x36, 0x37, 0x39, 0x3a, 0x3a, 0x3a, 0x3d, 0x44,
-				0x45, 0x4a, 0x4a, 0x4f, 0x5b, 0x5f, 0x60, 0x61,
-				0x64, 0x66, 0x67, 0x6e, 0x70, 0x70, 0x72, 0x74,
-				0x77, 0x7e, 0x90, 0x91, 0x97, 0x99, 0x9b, 0x9d,
-				0xa1, 0xa4, 0xa7, 0xaa, 0xaa, 0xb7, 0xb8, 0xb8,
-				0xbc, 0xc5, 0xc7, 0xcc, 0xcc, 0xcf, 0xd0, 0xd2,
-				0xd4, 0xd6, 0xd7, 0xd9, 0xd9, 0xda, 0xe2, 0xe3,
-				0xe4, 0xf3, 0xf8, 0xfa, 0xfd, 0xfd,
-			},
-			{
-				0x02, 0x0a, 0x0c, 0x12, 0x12, 0x1a, 0x1b, 0x1b,
-				0x1c, 0x1c, 0x20, 0x22, 0x22, 0x2b, 0x2b, 0x2f,
-				0x33, 0x36, 0x37, 0x38, 0x39, 0x3b, 0x3f, 0x42,
-				0x47, 0x4d, 0x4e, 0x52, 0x58, 0x5a, 0x5d, 0x5f,
-				0x61, 0x63, 0x65, 0x6c, 0x6d, 0x6d, 0x72, 0x73,
-				0x74, 0x76, 0x79, 0x7c, 0x7e, 0x87, 0x89, 0x8b,
-				0x8d, 0x8f, 0x90, 0x95, 0x9d, 0xa0, 0xa2, 0xa7,
-				0xaa, 0xab, 0xaf, 0xb1, 0xb1, 0xb3, 0xb5, 0xb6,
-				0xb7, 0xb9, 0xb9, 0xc2, 0xc2, 0xc8, 0xcb, 0xcd,
-				0xcd, 0xd8, 0xdc, 0xde, 0xdf, 0xdf, 0xe7, 0xf3,
-				0xf5, 0xf6, 0xf9, 0xfe, 0xfe, 0xff,
-			},
-			{
-				0x07, 0x0a, 0x0f, 0x10, 0x11, 0x14, 0x17, 0x19,
-				0x1d, 0x27, 0x2a, 0x2e, 0x34, 0x35, 0x38, 0x39,
-				0x3c, 0x3d, 0x50, 0x52, 0x54, 0x54, 0x55, 0x55,
-				0x56, 0x59, 0x59, 0x5b, 0x5c, 0x5c, 0x62, 0x63,
-				0x66, 0x6a, 0x73, 0x75, 0x75, 0x7b, 0x7c, 0x81,
-				0x81, 0x82, 0x88, 0x8a, 0x8e, 0x93, 0x9c, 0xa2,
-				0xa6, 0xa8, 0xa8, 0xaf, 0xaf, 0xb0, 0xb2, 0xb4,
-				0xb5, 0xbf, 0xc1, 0xc5, 0xc6, 0xc9, 0xce, 0xd0,
-				0xd2, 0xd5, 0xd6, 0xd7, 0xd7, 0xdd, 0xdf, 0xe1,
-				0xe2, 0xe5, 0xe7, 0xe8, 0xe9, 0xea, 0xef, 0xf1,
-				0xf2, 0xf5, 0xf7, 0xfa, 0xfc, 0xfc,
-			},
-			{
-				0x06, 0x08, 0x09, 0x0e, 0x0f, 0x13, 0x16, 0x1a,
-				0x1d, 0x23, 0x2c, 0x31, 0x3d, 0x3f, 0x40, 0x41,
-				0x42, 0x47, 0x47, 0x48, 0x48, 0x49, 0x4b, 0x4e,
-				0x51, 0x53, 0x56, 0x58, 0x5a, 0x5b, 0x5e, 0x5e,
-				0x62, 0x65, 0x6b, 0x6f, 0x7a, 0x7a, 0x7d, 0x7f,
-				0x80, 0x82, 0x84, 0x86, 0x87, 0x8d, 0x8f, 0x8f,
-				0x90, 0x93, 0x9c, 0x9e, 0xa1, 0xa3, 0xa6, 0xa7,
-				0xab, 0xac, 0xae, 0xb6, 0xba, 0xbd, 0xc1, 0xc3,
-				0xc4, 0xc5, 0xc8, 0xcb, 0xd5, 0xd8, 0xda, 0xdb,
-				0xe0, 0xe3, 0xe4, 0xe6, 0xe8, 0xea, 0xec, 0xee,
-				0xf0, 0xf1, 0xf6, 0xfb, 0xfc, 0xfd,
-			},
-		},
-
-		// Group 39
-		{
-			{
-				0x00, 0x02, 0x03, 0x0b, 0x0f, 0x13, 0x14, 0x1e,
-				0x21, 0x21, 0x28, 0x29, 0x2c, 0x30, 0x3e, 0x40,
-				0x42, 0x43, 0x44, 0x4e, 0x4e, 0x4f, 0x51, 0x53,
-				0x62, 0x62, 0x64, 0x66, 0x67, 0x68, 0x6a, 0x75,
-				0x78, 0x7c, 0x7f, 0x80, 0x81, 0x8a, 0x8d, 0x92,
-				0x94, 0x94, 0x95, 0x96, 0xa2, 0xa3, 0xa5, 0xa7,
-				0xa7, 0xa9, 0xb2, 0xb3, 0xb5, 0xb6, 0xbd, 0xbe,
-				0xc1, 0xcc, 0xcd, 0xce, 0xd0, 0xd3, 0xd4, 0xd8,
-				0xd9, 0xda, 0xdd, 0xde, 0xdf, 0xe0, 0xe2, 0xe4,
-				0xe9, 0xec, 0xf1, 0xf2, 0xf3, 0xf3, 0xf4, 0xf5,
-				0xf6, 0xf7, 0xf8, 0xf9, 0xfa, 0xfe,
-			},
-			{
-				0x00, 0x04, 0x04, 0x05, 0x08, 0x09, 0x0c, 0x0e,
-				0x0e, 0x14, 0x15, 0x17, 0x17, 0x1a, 0x20, 0x23,
-				0x27, 0x2a, 0x2b, 0x37, 0x3d, 0x47, 0x48, 0x4c,
-				0x4e, 0x4f, 0x55, 0x5b, 0x60, 0x60, 0x60, 0x69,
-				0x69, 0x6b, 0x6c, 0x6d, 0x72, 0x74, 0x79, 0x7f,
-				0x80, 0x84, 0x85, 0x86, 0x87, 0x88, 0x89, 0x90,
-				0x92, 0x97, 0x99, 0xa1, 0xa3, 0xa5, 0xa8, 0xaa,
-				0xaa, 0xb0, 0xb2, 0xb9, 0xb9, 0xba, 0xc0, 0xc3,
-				0xc5, 0xc6, 0xc7, 0xca, 0xcb, 0xd2, 0xd7, 0xd8,
-				0xd9, 0xdb, 0xe4, 0xeb, 0xee, 0xf2, 0xf3, 0xf9,
-				0xf9, 0xfc, 0xfc, 0xfd, 0xfd, 0xfe,
-			},
-			{
-				0x08, 0x0c, 0x12, 0x18, 0x19, 0x1d, 0x1e, 0x26,
-				0x2f, 0x2f, 0x32, 0x33, 0x35, 0x36, 0x38, 0x39,
-				0x3c, 0x46, 0x46, 0x4b, 0x4c, 0x4f, 0x51, 0x55,
-				0x5e, 0x5f, 0x5f, 0x63, 0x65, 0x66, 0x68, 0x6a,
-				0x6b, 0x6f, 0x70, 0x71, 0x76, 0x7c, 0x81, 0x89,
-				0x8c, 0x8d, 0x8f, 0x91, 0x93, 0x94, 0x9c, 0x9f,
-				0x9f, 0xa0, 0xa6, 0xa7, 0xab, 0xac, 0xaf, 0xb0,
-				0xb7, 0xbc, 0xbd, 0xc0, 0xc0, 0xc2, 0xc4, 0xc6,
-				0xc9, 0xca, 0xcb, 0xcc, 0xce, 0xd0, 0xd1, 0xd4,
-				0xd6, 0xda, 0xdb, 0xe1, 0xe2, 0xe3, 0xe5, 0xe7,
-				0xec, 0xed, 0xf5, 0xf7, 0xfd, 0xff,
-			},
-			{
-				0x0d, 0x0d, 0x10, 0x11, 0x19, 0x1c, 0x1f, 0x24,
-				0x27, 0x29, 0x30, 0x31, 0x34, 0x34, 0x36, 0x3f,
-				0x43, 0x43, 0x49, 0x49, 0x4a, 0x50, 0x53, 0x54,
-				0x56, 0x59, 0x5b, 0x63, 0x67, 0x6a, 0x6e, 0x6e,
-				0x72, 0x73, 0x75, 0x78, 0x7b, 0x82, 0x83, 0x87,
-				0x8c, 0x90, 0x9a, 0x9b, 0x9e, 0xa0, 0xa1, 0xa2,
-				0xa4, 0xa6, 0xa9, 0xad, 0xb3, 0xb4, 0xb4, 0xbb,
-				0xbc, 0xbf, 0xbf, 0xc4, 0xc7, 0xc9, 0xcd, 0xcf,
-				0xcf, 0xd2, 0xd6, 0xd7, 0xde, 0xdf, 0xdf, 0xe0,
-				0xe1, 0xe3, 0xe6, 0xe6, 0xee, 0xef, 0xf0, 0xf0,
-				0xf1, 0xf4, 0xf6, 0xfa, 0xfb, 0xff,
-			},
-			{
-				0x06, 0x07, 0x09, 0x0a, 0x0f, 0x11, 0x12, 0x15,
-				0x16, 0x19, 0x1a, 0x1b, 0x22, 0x22, 0x24, 0x24,
-				0x25, 0x25, 0x26, 0x28, 0x2a, 0x2d, 0x2e, 0x35,
-				0x38, 0x39, 0x39, 0x3a, 0x3e, 0x3e, 0x44, 0x47,
-				0x4c, 0x4d, 0x56, 0x57, 0x58, 0x5a, 0x5c, 0x5d,
-				0x61, 0x65, 0x68, 0x69, 0x6d, 0x70, 0x74, 0x76,
-				0x77, 0x7a, 0x7b, 0x7e, 0x83, 0x84, 0x8a, 0x8b,
-				0x8c, 0x8e, 0x8f, 0x95, 0x96, 0x97, 0x9e, 0xa2,
-				0xa4, 0xab, 0xb6, 0xb8, 0xba, 0xba, 0xc1, 0xc3,
-				0xc5, 0xc8, 0xcb, 0xcc, 0xd3, 0xd5, 0xdd, 0xe7,
-				0xe9, 0xea, 0xea, 0xec, 0xef, 0xfb,
-			},
-			{
-				0x01, 0x01, 0x03, 0x05, 0x06, 0x0a, 0x0b, 0x13,
-				0x13, 0x16, 0x17, 0x18, 0x1c, 0x1d, 0x1f, 0x21,
-				0x22, 0x25, 0x2b, 0x2d, 0x2e, 0x33, 0x37, 0x3a,
-				0x3b, 0x3b, 0x3c, 0x41, 0x45, 0x4a, 0x4b, 0x52,
-				0x52, 0x54, 0x58, 0x5d, 0x5e, 0x61, 0x64, 0x6c,
-				0x6d, 0x6f, 0x77, 0x7a, 0x7d, 0x85, 0x86, 0x88,
-				0x8b, 0x8d, 0x91, 0x92, 0x97, 0x99, 0x9b, 0x9c,
-				0x9d, 0x9e, 0xaa, 0xac, 0xae, 0xae, 0xb5, 0xb6,
-				0xb8, 0xbe, 0xbf, 0xc5, 0xc7, 0xc8, 0xc9, 0xca,
-				0xcf, 0xd1, 0xd3, 0xd5, 0xd6, 0xdc, 0xde, 0xe7,
-				0xed, 0xf1, 0xf4, 0xf6, 0xf8, 0xfc,
-			},
-			{
-				0x01, 0x02, 0x04, 0x07, 0x09, 0x10, 0x1a, 0x1b,
-				0x1d, 0x1f, 0x20, 0x23, 0x27, 0x2a, 0x2b, 0x2c,
-				0x2f, 0x31, 0x32, 0x34, 0x35, 0x37, 0x3d, 0x3f,
-				0x40, 0x41, 0x42, 0x45, 0x48, 0x48, 0x4d, 0x4d,
-				0x50, 0x53, 0x55, 0x57, 0x59, 0x5a, 0x5c, 0x5d,
-				0x6c, 0x70, 0x71, 0x73, 0x75, 0x79, 0x7d, 0x7d,
-				0x7e, 0x80, 0x82, 0x86, 0x88, 0x8e, 0x90, 0x93,
-				0x98, 0x98, 0x9a, 0x9b, 0x9d, 0xa3, 0xa4, 0xa5,
-				0xa6, 0xa8, 0xad, 0xad, 0xaf, 0xb1, 0xb1, 0xb4,
-				0xb7, 0xb9, 0xbb, 0xbc, 0xc1, 0xc2, 0xdc, 0xe3,
-				0xe5, 0xe8, 0xe8, 0xe9, 0xeb, 0xf0,
-			},
-		},
-
-		// Group 40
-		{
-			{
-				0x02, 0x03, 0x04, 0x06, 0x06, 0x07, 0x08, 0x0b,
-				0x17, 0x18, 0x20, 0x21, 0x23, 0x25, 0x26, 0x27,
-				0x31, 0x32, 0x33, 0x34, 0x39, 0x3c, 0x3d, 0x42,
-				0x46, 0x51, 0x57, 0x57, 0x58, 0x59, 0x5c, 0x5e,
-				0x60, 0x61, 0x61, 0x66, 0x67, 0x68, 0x69, 0x6c,
-				0x6e, 0x79, 0x7c, 0x7d, 0x7e, 0x7f, 0x80, 0x84,
-				0x85, 0x8e, 0x8e, 0x8f, 0x90, 0x91, 0x93, 0x97,
-				0x9b, 0x9e, 0xab, 0xaf, 0xb6, 0xb8, 0xba, 0xbc,
-				0xbd, 0xbf, 0xc3, 0xc6, 0xc8, 0xce, 0xd2, 0xd5,
-				0xd5, 0xdc, 0xde, 0xe3, 0xea, 0xea, 0xec, 0xed,
-				0xf5, 0xfb, 0xfc, 0xfd, 0xfe, 0xff,
-			},
-			{
-				0x02, 0x03, 0x04, 0x0a, 0x11, 0x11, 0x14, 0x16,
-				0x19, 0x1d, 0x22, 0x23, 0x23, 0x2d, 0x2f, 0x31,
-				0x33, 0x34, 0x36, 0x36, 0x38, 0x42, 0x43, 0x44,
-				0x45, 0x47, 0x48, 0x49, 0x49, 0x4b, 0x4d, 0x4e,
-				0x4f, 0x51, 0x52, 0x52, 0x53, 0x55, 0x5e, 0x5f,
-				0x5f, 0x62, 0x66, 0x6b, 0x6b, 0x6d, 0x70, 0x77,
-				0x81, 0x85, 0x8b, 0x8b, 0x8c, 0x8c, 0x90, 0x91,
-				0x95, 0x9a, 0x9b, 0x9c, 0xa1, 0xa3, 0xa7, 0xa8,
-				0xb3, 0xb5, 0xbc, 0xc0, 0xca, 0xca, 0xcd, 0xd0,
-				0xd2, 0xd4, 0xdb, 0xdd, 0xe4, 0xe4, 0xe4, 0xe5,
-				0xec, 0xf2, 0xf4, 0xf7, 0xf8, 0xf9,
-			},
-			{
-				0x00, 0x03, 0x05, 0x06, 0x07, 0x07, 0x09, 0x0e,
-				0x12, 0x13, 0x15, 0x1e, 0x1e, 0x1f, 0x27, 0x28,
-				0x2b, 0x2d, 0x30, 0x34, 0x37, 0x3a, 0x3a, 0x3c,
-				0x3e, 0x3f, 0x41, 0x43, 0x44, 0x44, 0x4a, 0x4e,
-				0x5a, 0x64, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x76,
-				0x78, 0x79, 0x7b, 0x7e, 0x80, 0x82, 0x82, 0x83,
-				0x88, 0x89, 0x8a, 0x90, 0x95, 0x96, 0x99, 0x99,
-				0x9a, 0x9b, 0x9e, 0xa0, 0xa3, 0xa5, 0xa7, 0xac,
-				0xad, 0xb0, 0xb6, 0xb7, 0xbb, 0xbb, 0xc1, 0xc1,
-				0xc4, 0xcc, 0xd0, 0xdb, 0xdc, 0xe2, 0xe3, 0xe9,
-				0xeb, 0xef, 0xf1, 0xf5, 0xf5, 0xfa,
-			},
-			{
-				0x00, 0x0c, 0x0f, 0x10, 0x11, 0x14, 0x19, 0x1a,
-				0x1a, 0x1b, 0x1d, 0x1f, 0x21, 0x29, 0x2a, 0x2c,
-				0x2e, 0x38, 0x40, 0x45, 0x4a, 0x52, 0x58, 0x5d,
-				0x63, 0x63, 0x65, 0x68, 0x6a, 0x6a, 0x70, 0x71,
-				0x71, 0x72, 0x75, 0x75, 0x84, 0x88, 0x89, 0x8f,
-				0x94, 0x98, 0x99, 0x9c, 0x9f, 0xa0, 0xa2, 0xa4,
-				0xa5, 0xa6, 0xa8, 0xaa, 0xb2, 0xb4, 0xb5, 0xb9,
-				0xba, 0xbe, 0xc3, 0xc5, 0xc5, 0xc5, 0xc7, 0xc8,
-				0xcb, 0xcc, 0xce, 0xcf, 0xd1, 0xd3, 0xd3, 0xd8,
-				0xd9, 0xdc, 0xde, 0xde, 0xdf, 0xe0, 0xe1, 0xea,
-				0xeb, 0xed, 0xee, 0xf1, 0xf6, 0xfd,
-			},
-			{
-				0x05, 0x09, 0x0d, 0x15, 0x17, 0x18, 0x1b, 0x1d,
-				0x1e, 0x24, 0x24, 0x25, 0x28, 0x2a, 0x2e, 0x3a,
-				0x3b, 0x40, 0x41, 0x45, 0x46, 0x48, 0x4a, 0x4b,
-				0x50, 0x50, 0x54, 0x54, 0x55, 0x5b, 0x5c, 0x60,
-				0x61, 0x62, 0x64, 0x69, 0x6a, 0x6c, 0x6d, 0x73,
-				0x74, 0x76, 0x77, 0x7a, 0x7b, 0x7d, 0x83, 0x86,
-				0x88, 0x8a, 0x8d, 0x95, 0x97, 0x9c, 0x9d, 0x9d,
-				0xa1, 0xa2, 0xa4, 0xa6, 0xa6, 0xab, 0xac, 0xad,
-				0xae, 0xae, 0xaf, 0xb1, 0xb7, 0xb8, 0xbf, 0xc6,
-				0xcd, 0xcf, 0xd4, 0xd5, 0xd6, 0xd7, 0xd8, 0xdb,
-				0xe0, 0xe6, 0xe8, 0xf4, 0xf8, 0xfa,
-			},
-			{
-				0x05, 0x0a, 0x0d, 0x0e, 0x12, 0x13, 0x13, 0x16,
-				0x1c, 0x20, 0x20, 0x21, 0x25, 0x26, 0x2b, 0x2f,
-				0x2f, 0x30, 0x35, 0x3b, 0x3c, 0x3e, 0x3f, 0x41,
-				0x47, 0x4d, 0x4f, 0x51, 0x53, 0x53, 0x56, 0x59,
-				0x5b, 0x66, 0x69, 0x6e, 0x6f, 0x71, 0x73, 0x78,
-				0x7c, 0x85, 0x87, 0x87, 0x8d, 0x8f, 0x92, 0x93,
-				0x96, 0x98, 0x9e, 0x9f, 0xa7, 0xa9, 0xb0, 0xb1,
-				0xb2, 0xb3, 0xb4, 0xba, 0xbd, 0xc0, 0xc6, 0xc9,
-				0xc9, 0xc9, 0xcd, 0xce, 0xd1, 0xd6, 0xda, 0xdf,
-				0xe0, 0xe5, 0xe5, 0xe6, 0xe7, 0xe7, 0xe8, 0xe8,
-				0xe9, 0xee, 0xf3, 0xf6, 0xfb, 0xfe,
-			},
-			{
-				0x01, 0x01, 0x04, 0x08, 0x0b, 0x0c, 0x0d, 0x0f,
-				0x10, 0x17, 0x1a, 0x1c, 0x1f, 0x22, 0x26, 0x29,
-				0x29, 0x2b, 0x2c, 0x32, 0x35, 0x37, 0x39, 0x3d,
-				0x3e, 0x3f, 0x47, 0x4c, 0x4c, 0x4f, 0x50, 0x56,
-				0x5a, 0x5d, 0x60, 0x67, 0x68, 0x70, 0x7a, 0x7f,
-				0x81, 0x86, 0x89, 0x8c, 0x8e, 0x92, 0x94, 0x97,
-				0x9a, 0x9f, 0xa1, 0xa4, 0xa5, 0xa9, 0xaa, 0xad,
-				0xb8, 0xb9, 0xbc, 0xbe, 0xc2, 0xc2, 0xc4, 0xc7,
-				0xcb, 0xd7, 0xd9, 0xda, 0xdd, 0xe1, 0xe1, 0xe2,
-				0xeb, 0xee, 0xef, 0xf0, 0xf0, 0xf1, 0xf2, 0xf3,
-				0xf6, 0xf7, 0xf8, 0xf9, 0xfc, 0xff,
-			},
-		},
-
-		// Group 41
-		{
-			{
-				0x03, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x16,
-				0x1b, 0x1c, 0x1d, 0x21, 0x24, 0x26, 0x2a, 0x2d,
-				0x39, 0x3e, 0x43, 0x45, 0x48, 0x4e, 0x4e, 0x4f,
-				0x4f, 0x50, 0x54, 0x54, 0x55, 0x55, 0x55, 0x56,
-				0x57, 0x58, 0x5c, 0x5c, 0x5d, 0x63, 0x66, 0x67,
-				0x6c, 0x6e, 0x71, 0x75, 0x76, 0x77, 0x7b, 0x7e,
-				0x80, 0x83, 0x88, 0x90, 0x92, 0x93, 0x99, 0xa4,
-				0xa8, 0xaa, 0xad, 0xae, 0xaf, 0xb1, 0xb3, 0xb3,
-				0xb7, 0xb9, 0xbd, 0xca, 0xcb, 0xd3, 0xd7, 0xd9,
-				0xdb, 0xdc, 0xdd, 0xe1, 0xe1, 0xe9, 0xec, 0xef,
-				0xf3, 0xf5, 0xf7, 0xf8, 0xfc, 0xff,
-			},
-			{
-				0x00, 0x06, 0x08, 0x09, 0x0c, 0x0d, 0x11, 0x14,
-				0x1a, 0x1a, 0x1f, 0x24, 0x2a, 0x2c, 0x2f, 0x30,
-				0x33, 0x36, 0x3b, 0x42, 0x42, 0x45, 0x46, 0x46,
-				0x4a, 0x4c, 0x53, 0x57, 0x57, 0x59, 0x5e, 0x60,
-				0x63, 0x66, 0x68, 0x69, 0x6b, 0x6f, 0x70, 0x70,
-				0x71, 0x72, 0x73, 0x79, 0x7d, 0x7f, 0x83, 0x89,
-				0x8b, 0x8e, 0x98, 0x9e, 0x9f, 0xa2, 0xa2, 0xa4,
-				0xa6, 0xaf, 0xb1, 0xb6, 0xb7, 0xb8, 0xc0, 0xc5,
-				0xc6, 0xc7, 0xcb, 0xcf, 0xd0, 0xd4, 0xd7, 0xd9,
-				0xdb, 0xe2, 0xe2, 0xe7, 0xeb, 0xed, 0xed, 0xf0,
-				0xf1, 0xf6, 0xf8, 0xfd, 0xfe, 0xff,
-			},
-			{
-				0x05, 0x08, 0x10, 0x12, 0x13, 0x17, 0x1b, 0x1d,
-				0x1e, 0x1f, 0x22, 0x28, 0x2a, 0x2d, 0x2d, 0x31,
-				0x34, 0x35, 0x39, 0x3a, 0x3f, 0x40, 0x49, 0x49,
-				0x4b, 0x4b, 0x56, 0x5b, 0x5b, 0x5c, 0x5d, 0x5e,
-				0x5f, 0x61, 0x68, 0x72, 0x73, 0x7c, 0x7c, 0x7e,
-				0x7f, 0x80, 0x8b, 0x8f, 0x8f, 0x95, 0x97, 0x9b,
-				0xa0, 0xa1, 0xa1, 0xa3, 0xa7, 0xa8, 0xa9, 0xab,
-				0xb0, 0xb3, 0xb4, 0xb5, 0xb5, 0xb9, 0xbd, 0xc1,
-				0xc1, 0xc2, 0xc5, 0xc9, 0xca, 0xcc, 0xcd, 0xd6,
-				0xd6, 0xd8, 0xdb, 0xdc, 0xdf, 0xe0, 0xe4, 0xe4,
-				0xe5, 0xec, 0xee, 0xf8, 0xfb, 0xfd,
-			},
-			{
-				0x01, 0x03, 0x0b, 0x0f, 0x10, 0x15, 0x17, 0x18,
-				0x18, 0x1c, 0x1e, 0x20, 0x22, 0x26, 0x27, 0x29,
-				0x2e, 0x2f, 0x32, 0x36, 0x3a, 0x3c, 0x3c, 0x40,
-				0x41, 0x42, 0x47, 0x4a, 0x4b, 0x4c, 0x4d, 0x52,
-				0x58, 0x59, 0x62, 0x64, 0x6c, 0x6c, 0x6e, 0x71,
-				0x74, 0x75, 0x76, 0x78, 0x7a, 0x7b, 0x81, 0x82,
-				0x84, 0x84, 0x86, 0x86, 0x89, 0x8a, 0x8c, 0x8c,
-				0x8d, 0x91, 0x94, 0x95, 0x9b, 0x9d, 0xa5, 0xa9,
-				0xb0, 0xb2, 0xb2, 0xb4, 0xb6, 0xb7, 0xbe, 0xbf,
-				0xc7, 0xc9, 0xc9, 0xcb, 0xce, 0xd0, 0xd4, 0xd5,
-				0xda, 0xe0, 0xe9, 0xec, 0xf9, 0xfa,
-			},
-			{
-				0x01, 0x04, 0x07, 0x0f, 0x11, 0x12, 0x13, 0x14,
-				0x15, 0x16, 0x1b, 0x25, 0x28, 0x2c, 0x2e, 0x2f,
-				0x30, 0x31, 0x34, 0x35, 0x38, 0x38, 0x41, 0x47,
-				0x48, 0x50, 0x51, 0x52, 0x54, 0x5a, 0x67, 0x6b,
-				0x6d, 0x70, 0x77, 0x78, 0x7c, 0x81, 0x82, 0x85,
-				0x87, 0x88, 0x89, 0x8e, 0x90, 0x94, 0x96, 0x9a,
-				0x9c, 0x9d, 0x9f, 0xa1, 0xa3, 0xa5, 0xa7, 0xab,
-				0xac, 0xb6, 0xbc, 0xbe, 0xc4, 0xc8, 0xcc, 0xd2,
-				0xd2, 0xd3, 0xd7, 0xd8, 0xda, 0xde, 0xdf, 0xe2,
-				0xe3, 0xe4, 0xe5, 0xe5, 0xe6, 0xe7, 0xe8, 0xeb,
-				0xed, 0xf0, 0xf2, 0xf4, 0xfa, 0xfc,
-			},
-			{
-				0x02, 0x04, 0x05, 0x05, 0x09, 0x09, 0x0a, 0x0b,
-				0x11, 0x1e, 0x24, 0x26, 0x2b, 0x33, 0x33, 0x35,
-				0x37, 0x3a, 0x3c, 0x3d, 0x3f, 0x43, 0x44, 0x44,
-				0x4c, 0x4d, 0x53, 0x60, 0x61, 0x62, 0x65, 0x69,
-				0x6a, 0x6d, 0x6f, 0x74, 0x79, 0x7a, 0x85, 0x87,
-				0x8a, 0x8b, 0x8d, 0x90, 0x92, 0x93, 0x96, 0x97,
-				0x98, 0xa0, 0xa3, 0xa7, 0xac, 0xae, 0xba, 0xbc,
-				0xbd, 0xbf, 0xc0, 0xc1, 0xc3, 0xc4, 0xc6, 0xc8,
-				0xcd, 0xce, 0xcf, 0xd1, 0xd1, 0xd5, 0xd6, 0xdd,
-				0xde, 0xe1, 0xe3, 0xe6, 0xe7, 0xea, 0xea, 0xee,
-				0xf3, 0xf5, 0xf7, 0xf9, 0xfc, 0xfe,
-			},
-			{
-				0x00, 0x02, 0x06, 0x07, 0x0a, 0x0a, 0x0e, 0x17,
-				0x19, 0x19, 0x1c, 0x1f, 0x20, 0x21, 0x22, 0x23,
-				0x23, 0x23, 0x25, 0x27, 0x29, 0x2b, 0x32, 0x36,
-				0x37, 0x3b, 0x3d, 0x3e, 0x40, 0x43, 0x49, 0x4d,
-				0x50, 0x51, 0x5a, 0x5f, 0x63, 0x64, 0x65, 0x66,
-				0x68, 0x6a, 0x72, 0x76, 0x77, 0x7d, 0x8e, 0x8f,
-				0x91, 0x99, 0x9a, 0x9c, 0x9e, 0xa0, 0xa6, 0xaa,
-				0xac, 0xad, 0xb8, 0xba, 0xbb, 0xbb, 0xbb, 0xbe,
-				0xc0, 0xc2, 0xc3, 0xc3, 0xc5, 0xc6, 0xce, 0xcf,
-				0xd0, 0xd2, 0xd4, 0xe0, 0xe8, 0xeb, 0xef, 0xf1,
-				0xf2, 0xf4, 0xf6, 0xf7, 0xfb, 0xfe,
-			},
-		},
-
-		// Group 42
-		{
-			{
-				0x00, 0x02, 0x07, 0x07, 0x09, 0x0a, 0x0c, 0x0e,
-				0x0f, 0x11, 0x13, 0x2b, 0x2b, 0x2c, 0x31, 0x31,
-				0x3b, 0x46, 0x47, 0x4a, 0x4d, 0x50, 0x54, 0x57,
-				0x58, 0x5a, 0x5d, 0x5f, 0x6f, 0x73, 0x79, 0x7c,
-				0x7e, 0x7e, 0x81, 0x82, 0x90, 0x98, 0x9a, 0x9b,
-				0x9b, 0x9f, 0xa3, 0xa4, 0xa6, 0xa7, 0xaa, 0xac,
-				0xae, 0xaf, 0xb1, 0xb3, 0xb3, 0xb4, 0xb7, 0xb7,
-				0xb8, 0xb9, 0xbb, 0xbc, 0xbc, 0xbd, 0xc0, 0xc4,
-				0xc8, 0xce, 0xd1, 0xd2, 0xd6, 0xda, 0xdb, 0xdc,
-				0xe3, 0xe4, 0xe5, 0xe8, 0xe9, 0xea, 0xef, 0xf0,
-				0xf4, 0xf5, 0xf9, 0xfa, 0xfd, 0xff,
-			},
-			{
-				0x01, 0x03, 0x04, 0x06, 0x07, 0x11, 0x12, 0x13,
-				0x14, 0x1f, 0x21, 0x24, 0x26, 0x27, 0x28, 0x29,
-				0x2f, 0x34, 0x37, 0x3c, 0x3d, 0x3e, 0x3f, 0x43,
-				0x44, 0x4f, 0x52, 0x58, 0x5a, 0x5e, 0x60, 0x63,
-				0x63, 0x6a, 0x6b, 0x71, 0x71, 0x73, 0x79, 0x7b,
-				0x7f, 0x81, 0x83, 0x85, 0x86, 0x88, 0x8c, 0x93,
-				0x94, 0x97, 0x99, 0x99, 0x9c, 0xa3, 0xa6, 0xad,
-				0xae, 0xb5, 0xb5, 0xb6, 0xbe, 0xc1, 0xc2, 0xc3,
-				0xc6, 0xc7, 0xc9, 0xca, 0xcc, 0xce, 0xcf, 0xd1,
-				0xd2, 0xd5, 0xd8, 0xd8, 0xda, 0xde, 0xdf, 0xe5,
-				0xf0, 0xf2, 0xf3, 0xf6, 0xf7, 0xff,
-			},
-			{
-				0x0e, 0x16, 0x17, 0x1b, 0x20, 0x23, 0x2a, 0x2a,
-				0x2f, 0x34, 0x35, 0x36, 0x36, 0x37, 0x3a, 0x3b,
-				0x3c, 0x40, 0x45, 0x45, 0x48, 0x4b, 0x4b, 0x4c,
-				0x4d, 0x4e, 0x51, 0x52, 0x55, 0x56, 0x5d, 0x5e,
-				0x5f, 0x60, 0x61, 0x62, 0x62, 0x63, 0x64, 0x65,
-				0x67, 0x68, 0x68, 0x69, 0x6c, 0x6d, 0x6f, 0x77,
-				0x77, 0x7a, 0x7a, 0x7c, 0x83, 0x89, 0x8b, 0x8d,
-				0x8e, 0x94, 0x95, 0x98, 0x9c, 0x9d, 0xa5, 0xa7,
-				0xa9, 0xaa, 0xad, 0xaf, 0xb2, 0xb3, 0xb6, 0xba,
-				0xc4, 0xca, 0xcc, 0xd0, 0xd7, 0xdc, 0xe3, 0xe9,
-				0xf2, 0xf3, 0xf4, 0xf9, 0xfc, 0xfe,
-			},
-			{
-				0x02, 0x03, 0x04, 0x05, 0x0c, 0x0d, 0x10, 0x15,
-				0x1a, 0x1c, 0x1c, 0x22, 0x2b, 0x2d, 0x2e, 0x31,
-				0x32, 0x33, 0x33, 0x38, 0x3a, 0x3d, 0x3f, 0x41,
-				0x43, 0x46, 0x49, 0x4a, 0x4c, 0x50, 0x59, 0x5b,
-				0x5b, 0x5e, 0x64, 0x65, 0x6e, 0x6e, 0x70, 0x74,
-				0x76, 0x78, 0x7b, 0x82, 0x83, 0x86, 0x87, 0x8b,
-				0x8e, 0x8e, 0x8f, 0x90, 0x92, 0x94, 0x95, 0x96,
-				0x9d, 0xa1, 0xa2, 0xa2, 0xa5, 0xab, 0xaf, 0xba,
-				0xbb, 0xbc, 0xc1, 0xc5, 0xc7, 0xcb, 0xcc, 0xcd,
-				0xd6, 0xd9, 0xd9, 0xdb, 0xdd, 0xde, 0xdf, 0xe1,
-				0xe3, 0xec, 0xec, 0xf5, 0xfa, 0xfb,
-			},
-			{
-				0x05, 0x0a, 0x0b, 0x0d, 0x10, 0x12, 0x16, 0x1d,
-				0x1f, 0x1f, 0x20, 0x20, 0x22, 0x25, 0x26, 0x28,
-				0x33, 0x38, 0x39, 0x40, 0x41, 0x46, 0x49, 0x4c,
-				0x5b, 0x5c, 0x61, 0x61, 0x67, 0x69, 0x6b, 0x6c,
-				0x72, 0x72, 0x76, 0x7a, 0x7d, 0x80, 0x80, 0x84,
-				0x88, 0x8b, 0x90, 0x92, 0x96, 0x96, 0x97, 0x9a,
-				0x9b, 0x9c, 0x9e, 0xa0, 0xa1, 0xa2, 0xa4, 0xa8,
-				0xab, 0xb0, 0xba, 0xbb, 0xbd, 0xbf, 0xc0, 0xc5,
-				0xca, 0xcb, 0xcf, 0xd3, 0xd3, 0xdc, 0xe0, 0xe0,
-				0xe4, 0xe5, 0xe8, 0xea, 0xea, 0xeb, 0xf1, 0xf3,
-				0xf6, 0xf7, 0xf8, 0xf8, 0xfb, 0xfe,
-			},
-			{
-				0x00, 0x01, 0x03, 0x04, 0x08, 0x09, 0x0b, 0x0c,
-				0x0f, 0x13, 0x15, 0x18, 0x1d, 0x1d, 0x1e, 0x21,
-				0x22, 0x23, 0x24, 0x25, 0x29, 0x2c, 0x2d, 0x2e,
-				0x34, 0x39, 0x3d, 0x3e, 0x40, 0x42, 0x48, 0x4e,
-				0x51, 0x53, 0x54, 0x55, 0x56, 0x56, 0x57, 0x59,
-				0x5c, 0x5f, 0x66, 0x67, 0x6f, 0x75, 0x77, 0x7c,
-				0x7f, 0x84, 0x85, 0x87, 0x89, 0x8a, 0x8a, 0x8c,
-				0x8d, 0x8f, 0x9e, 0x9f, 0xa5, 0xa8, 0xb0, 0xb5,
-				0xb6, 0xb9, 0xbf, 0xc6, 0xc9, 0xd0, 0xd0, 0xd7,
-				0xd8, 0xdb, 0xe2, 0xe6, 0xe6, 0xe7, 0xe8, 0xeb,
-				0xed, 0xee, 0xf1, 0xfa, 0xfd, 0xfe,
-			},
-			{
-				0x06, 0x08, 0x11, 0x12, 0x14, 0x17, 0x18, 0x19,
-				0x19, 0x1a, 0x1b, 0x1e, 0x1e, 0x23, 0x24, 0x27,
-				0x2a, 0x30, 0x30, 0x32, 0x35, 0x3c, 0x42, 0x44,
-				0x47, 0x49, 0x4e, 0x4f, 0x53, 0x55, 0x5a, 0x5d,
-				0x66, 0x6a, 0x6d, 0x6d, 0x70, 0x74, 0x75, 0x78,
-				0x78, 0x7d, 0x7f, 0x81, 0x85, 0x89, 0x8d, 0x91,
-				0x91, 0x93, 0x99, 0xa0, 0xa4, 0xa8, 0xa9, 0xac,
-				0xac, 0xb1, 0xb2, 0xb4, 0xb7, 0xb8, 0xbe, 0xc2,
-				0xc3, 0xc3, 0xc8, 0xcd, 0xd2, 0xd4, 0xd4, 0xd5,
-				0xd6, 0xd7, 0xdd, 0xe1, 0xe2, 0xe2, 0xe6, 0xe7,
-				0xed, 0xee, 0xef, 0xf0, 0xf8, 0xfc,
-			},
-		},
-
-		// Group 43
-		{
-			{
-				0x03, 0x04, 0x07, 0x07, 0x14, 0x14, 0x15, 0x19,
-				0x1b, 0x1c, 0x1d, 0x1f, 0x24, 0x26, 0x29, 0x2b,
-				0x31, 0x34, 0x36, 0x37, 0x3d, 0x40, 0x45, 0x4f,
-				0x51, 0x52, 0x55, 0x59, 0x5b, 0x5c, 0x5e, 0x63,
-				0x63, 0x67, 0x68, 0x6d, 0x6f, 0x72, 0x75, 0x75,
-				0x7b, 0x7f, 0x80, 0x81, 0x82, 0x82, 0x85, 0x8c,
-				0x90, 0x96, 0x96, 0x9b, 0x9b, 0x9f, 0x9f, 0xa1,
-				0xa5, 0xa7, 0xa7, 0xa9, 0xaa, 0xb2, 0xb5, 0xb7,
-				0xb7, 0xb8, 0xc5, 0xc6, 0xcf, 0xcf, 0xd5, 0xd7,
-				0xd8, 0xdc, 0xdd, 0xe0, 0xe1, 0xe2, 0xe7, 0xed,
-				0xee, 0xf4, 0xf7, 0xfc, 0xfc, 0xff,
-			},
-			{
-				0x01, 0x06, 0x08, 0x0e, 0x0f, 0x10, 0x11, 0x13,
-				0x16, 0x18, 0x22, 0x23, 0x28, 0x29, 0x2b, 0x2d,
-				0x2e, 0x35, 0x37, 0x38, 0x3d, 0x44, 0x4d, 0x4d,
-				0x4e, 0x51, 0x53, 0x54, 0x57, 0x61, 0x66, 0x67,
-				0x68, 0x6e, 0x6f, 0x71, 0x72, 0x74, 0x7c, 0x7c,
-				0x7f, 0x84, 0x84, 0x89, 0x8a, 0x8b, 0x8d, 0x8f,
-				0x90, 0x91, 0x91, 0x93, 0x98, 0x99, 0x99, 0x9c,
-				0x9d, 0xa2, 0xa8, 0xac, 0xb3, 0xb4, 0xb6, 0xb8,
-				0xba, 0xbd, 0xbf, 0xc4, 0xc9, 0xcb, 0xcb, 0xcc,
-				0xd1, 0xd2, 0xd4, 0xd5, 0xdf, 0xe1, 0xe4, 0xed,
-				0xef, 0xef, 0xf2, 0xf7, 0xfa, 0xfa,
-			},
-			{
-				0x00, 0x02, 0x05, 0x06, 0x08, 0x0b, 0x0b, 0x0c,
-				0x11, 0x16, 0x18, 0x1a, 0x1d, 0x25, 0x28, 0x2e,
-				0x31, 0x39, 0x3a, 0x40, 0x46, 0x49, 0x51, 0x57,
-				0x58, 0x5a, 0x5c, 0x5d, 0x5f, 0x61, 0x64, 0x66,
-				0x6c, 0x73, 0x74, 0x75, 0x77, 0x7a, 0x7e, 0x80,
-				0x83, 0x86, 0x89, 0x8a, 0x8b, 0x8e, 0x8e, 0x91,
-				0x93, 0x98, 0x9d, 0x9e, 0xa2, 0xa4, 0xa8, 0xae,
-				0xb0, 0xb6, 0xb8, 0xba, 0xbb, 0xc2, 0xc2, 0xc6,
-				0xc6, 0xc8, 0xcd, 0xce, 0xd3, 0xd4, 0xd4, 0xd6,
-				0xd6, 0xd8, 0xe0, 0xe3, 0xe5, 0xe6, 0xe7, 0xea,
-				0xeb, 0xf3, 0xf4, 0xf6, 0xfb, 0xfd,
-			},
-			{
-				0x02, 0x04, 0x07, 0x0b, 0x0e, 0x13, 0x15, 0x17,
-				0x19, 0x1a, 0x1b, 0x1f, 0x20, 0x25, 0x2d, 0x2f,
-				0x30, 0x32, 0x32, 0x33, 0x34, 0x38, 0x39, 0x39,
-				0x3b, 0x3f, 0x42, 0x44, 0x45, 0x47, 0x48, 0x4c,
-				0x4c, 0x4e, 0x54, 0x57, 0x5c, 0x5d, 0x5e, 0x62,
-				0x64, 0x65, 0x6a, 0x6b, 0x6d, 0x6e, 0x76, 0x78,
-				0x79, 0x7d, 0x7e, 0x88, 0x8d, 0x92, 0x93, 0x97,
-				0xa1, 0xa6, 0xa6, 0xad, 0xad, 0xb0, 0xb4, 0xb6,
-				0xb9, 0xbc, 0xbe, 0xbf, 0xc1, 0xc8, 0xcd, 0xd0,
-				0xd2, 0xd7, 0xda, 0xda, 0xdb, 0xdc, 0xdd, 0xe0,
-				0xf0, 0xf1, 0xf5, 0xf8, 0xf9, 0xfd,
-			},
-			{
-				0x00, 0x03, 0x09, 0x09, 0x0d, 0x0d, 0x12, 0x12,
-				0x12, 0x17, 0x23, 0x24, 0x26, 0x2a, 0x2a, 0x2c,
-				0x2f, 0x30, 0x33, 0x35, 0x36, 0x38, 0x3a, 0x3c,
-				0x3e, 0x3f, 0x42, 0x43, 0x46, 0x46, 0x47, 0x47,
-				0x4a, 0x4c, 0x4f, 0x50, 0x52, 0x54, 0x55, 0x55,
-				0x56, 0x58, 0x5b, 0x5f, 0x60, 0x66, 0x69, 0x69,
-				0x6b, 0x6c, 0x6f, 0x76, 0x7d, 0x80, 0x81, 0x85,
-				0x86, 0x8c, 0x92, 0x94, 0x97, 0xa3, 0xaa, 0xab,
-				0xac, 0xaf, 0xb1, 0xb2, 0xb3, 0xbc, 0xbe, 0xc0,
-				0xc5, 0xce, 0xd0, 0xd7, 0xdc, 0xe6, 0xeb, 0xef,
-				0xf2, 0xf8, 0xfb, 0xfc, 0xfe, 0xfe,
-			},
-			{
-				0x01, 0x05, 0x09, 0x0a, 0x0a, 0x10, 0x10, 0x16,
-				0x1c, 0x1d, 0x1e, 0x20, 0x20, 0x21, 0x22, 0x2a,
-				0x2c, 0x34, 0x36, 0x3b, 0x3e, 0x41, 0x42, 0x48,
-				0x4e, 0x50, 0x53, 0x56, 0x56, 0x58, 0x5a, 0x5f,
-				0x6a, 0x71, 0x73, 0x77, 0x7a, 0x7b, 0x81, 0x87,
-				0x87, 0x88, 0x8d, 0x8e, 0x8f, 0x94, 0x95, 0x9a,
-				0x9c, 0x9d, 0xa2, 0xa5, 0xa5, 0xaf, 0xb1, 0xb9,
-				0xbb, 0xbc, 0xbd, 0xbe, 0xc0, 0xc1, 0xc2, 0xc3,
-				0xc4, 0xc7, 0xc8, 0xcb, 0xcd, 0xd0, 0xd1, 0xd3,
-				0xd9, 0xdb, 0xdd, 0xdf, 0xe2, 0xe5, 0xe8, 0xe9,
-				0xec, 0xec, 0xf1, 0xf3, 0xf9, 0xff,
-			},
-			{
-				0x08, 0x0a, 0x0c, 0x0e, 0x0f, 0x1e, 0x1f, 0x21,
-				0x27, 0x27, 0x28, 0x2b, 0x32, 0x33, 0x3c, 0x41,
-				0x43, 0x45, 0x48, 0x49, 0x4a, 0x4b, 0x4b, 0x59,
-				0x5a, 0x60, 0x62, 0x65, 0x69, 0x6a, 0x6c, 0x6d,
-				0x70, 0x70, 0x76, 0x78, 0x79, 0x7c, 0x7d, 0x83,
-				0x86, 0x8b, 0x95, 0x99, 0x9a, 0x9e, 0xa0, 0xa0,
-				0xa3, 0xa4, 0xa7, 0xa9, 0xab, 0xab, 0xae, 0xb0,
-				0xb5, 0xc3, 0xc5, 0xc7, 0xc9, 0xca, 0xca, 0xcc,
-				0xd3, 0xd5, 0xd8, 0xd9, 0xdb, 0xde, 0xde, 0xe2,
-				0xe3, 0xe4, 0xe6, 0xe8, 0xe9, 0xea, 0xed, 0xee,
-				0xf0, 0xf4, 0xf5, 0xf6, 0xfb, 0xfd,
-			},
-		},
-
-		// Group 44
-		{
-			{
-				0x03, 0x03, 0x06, 0x06, 0x07, 0x0a, 0x0e, 0x18,
-				0x18, 0x1a, 0x1b, 0x1e, 0x25, 0x26, 0x2d, 0x32,
-				0x33, 0x38, 0x3e, 0x45, 0x4d, 0x5e, 0x61, 0x62,
-				0x63, 0x64, 0x66, 0x68, 0x6a, 0x6b, 0x6b, 0x6e,
-				0x72, 0x7a, 0x7f, 0x81, 0x81, 0x82, 0x82, 0x86,
-				0x87, 0x88, 0x8b, 0x8d, 0x93, 0x95, 0x97, 0x98,
-				0x9d, 0x9e, 0xa0, 0xa2, 0xa4, 0xa6, 0xab, 0xb0,
-				0xb2, 0xb5, 0xb6, 0xba, 0xbe, 0xbf, 0xc5, 0xc6,
-				0xcf, 0xd2, 0xd3, 0xd4, 0xd8, 0xd9, 0xdc, 0xdd,
-				0xdd, 0xdf, 0xe7, 0xe8, 0xed, 0xee, 0xee, 0xef,
-				0xf0, 0xf2, 0xf5, 0xf6, 0xf6, 0xfa,
-			},
-			{
-				0x00, 0x01, 0x0f, 0x10, 0x12, 0x13, 0x1f, 0x1f,
-				0x1f, 0x22, 0x25, 0x26, 0x27, 0x27, 0x29, 0x2a,
-				0x2b, 0x2d, 0x2f, 0x31, 0x3a, 0x3b, 0x3c, 0x3d,
-				0x3f, 0x41, 0x49, 0x53, 0x57, 0x58, 0x59, 0x5c,
-				0x5e, 0x5f, 0x62, 0x63, 0x67, 0x6e, 0x70, 0x70,
-				0x71, 0x72, 0x74, 0x75, 0x76, 0x7b, 0x81, 0x85,
-				0x86, 0x86, 0x8a, 0x8f, 0x90, 0x91, 0x92, 0x92,
-				0x94, 0x9e, 0xa0, 0xa2, 0xa7, 0xac, 0xb2, 0xb4,
-				0xb9, 0xc3, 0xc4, 0xc8, 0xc9, 0xca, 0xca, 0xd3,
-				0xd9, 0xda, 0xde, 0xe2, 0xe5, 0xe7, 0xe8, 0xed,
-				0xee, 0xef, 0xf0, 0xf3, 0xfb, 0xff,
-			},
-			{
-				0x02, 0x05, 0x08, 0x0a, 0x15, 0x16, 0x19, 0x1a,
-				0x1a, 0x1d, 0x24, 0x25, 0x28, 0x2a, 0x2e, 0x30,
-				0x34, 0x35, 0x36, 0x3c, 0x3e, 0x42, 0x44, 0x45,
-				0x46, 0x47, 0x48, 0x49, 0x4e, 0x50, 0x52, 0x57,
-				0x5a, 0x5c, 0x5d, 0x60, 0x65, 0x67, 0x6a, 0x6e,
-				0x6f, 0x6f, 0x71, 0x78, 0x78, 0x79, 0x7d, 0x7e,
-				0x80, 0x80, 0x83, 0x85, 0x87, 0x88, 0x96, 0x97,
-				0x9b, 0x9f, 0xad, 0xae, 0xb3, 0xb3, 0xb5, 0xb6,
-				0xb8, 0xbd, 0xbd, 0xc1, 0xc5, 0xcc, 0xce, 0xcf,
-				0xd0, 0xd2, 0xd2, 0xd4, 0xe0, 0xe3, 0xe5, 0xe6,
-				0xea, 0xf7, 0xf9, 0xfb, 0xfc, 0xfe,
-			},
-			{
-				0x00, 0x02, 0x03, 0x04, 0x07, 0x0b, 0x0c, 0x0d,
-				0x0f, 0x12, 0x14, 0x15, 0x16, 0x20, 0x26, 0x2a,
-				0x2f, 0x36, 0x37, 0x38, 0x3f, 0x44, 0x45, 0x48,
-				0x4a, 0x4a, 0x4c, 0x4e, 0x4f, 0x51, 0x52, 0x53,
-				0x55, 0x59, 0x5a, 0x5b, 0x60, 0x64, 0x66, 0x66,
-				0x68, 0x69, 0x69, 0x73, 0x75, 0x77, 0x88, 0x8b,
-				0x8d, 0x8f, 0x93, 0x98, 0x98, 0x99, 0x9c, 0x9e,
-				0xa1, 0xa1, 0xa9, 0xaa, 0xae, 0xaf, 0xb0, 0xb3,
-				0xb7, 0xb8, 0xbb, 0xbc, 0xbc, 0xc0, 0xc1, 0xc8,
-				0xcc, 0xd9, 0xde, 0xdf, 0xe2, 0xe4, 0xe4, 0xf1,
-				0xf7, 0xf8, 0xf9, 0xfa, 0xfd, 0xfd,
-			},
-			{
-				0x02, 0x09, 0x09, 0x0b, 0x0d, 0x0e, 0x10, 0x13,
-				0x15, 0x17, 0x17, 0x1d, 0x20, 0x21, 0x22, 0x23,
-				0x29, 0x29, 0x2c, 0x2c, 0x2d, 0x32, 0x34, 0x35,
-				0x37, 0x39, 0x3a, 0x3b, 0x3d, 0x3e, 0x40, 0x42,
-				0x44, 0x47, 0x4b, 0x4d, 0x50, 0x51, 0x55, 0x56,
-				0x57, 0x58, 0x5f, 0x61, 0x6c, 0x74, 0x76, 0x77,
-				0x7a, 0x7f, 0x83, 0x89, 0x8c, 0x8c, 0x90, 0x95,
-				0x96, 0x96, 0x9a, 0x9b, 0x9c, 0x9f, 0xa9, 0xaa,
-				0xab, 0xaf, 0xbe, 0xc2, 0xc9, 0xce, 0xd0, 0xd1,
-				0xd6, 0xda, 0xdb, 0xdb, 0xe0, 0xe4, 0xe6, 0xf1,
-				0xf3, 0xf6, 0xf9, 0xfb, 0xfc, 0xff,
-			},
-			{
-				0x04, 0x05, 0x05, 0x06, 0x0a, 0x0b, 0x10, 0x11,
-				0x14, 0x18, 0x1c, 0x1c, 0x1e, 0x21, 0x23, 0x24,
-				0x2e, 0x36, 0x39, 0x39, 0x40, 0x42, 0x43, 0x46,
-				0x4b, 0x4c, 0x4f, 0x54, 0x54, 0x56, 0x58, 0x5b,
-				0x5c, 0x5d, 0x65, 0x69, 0x6a, 0x6d, 0x6d, 0x73,
-				0x7b, 0x7c, 0x89, 0x8a, 0x8b, 0x8d, 0x8e, 0x91,
-				0x99, 0x9a, 0x9d, 0xa2, 0xa3, 0xa3, 0xa4, 0xa4,
-				0xaa, 0xaf, 0xb1, 0xb1, 0xb4, 0xb6, 0xb7, 0xc4,
-				0xcd, 0xcd, 0xcd, 0xd1, 0xd6, 0xd7, 0xd8, 0xdc,
-				0xe1, 0xe3, 0xe8, 0xe9, 0xe9, 0xeb, 0xec, 0xec,
-				0xf2, 0xf3, 0xf7, 0xf8, 0xfa, 0xfe,
-			},
-			{
-				0x01, 0x08, 0x08, 0x09, 0x0c, 0x0f, 0x11, 0x13,
-				0x19, 0x1b, 0x1d, 0x27, 0x28, 0x2b, 0x30, 0x31,
-				0x33, 0x37, 0x3c, 0x41, 0x43, 0x47, 0x49, 0x4d,
-				0x52, 0x54, 0x5b, 0x60, 0x64, 0x6c, 0x72, 0x78,
-				0x79, 0x7a, 0x7c, 0x7d, 0x7e, 0x82, 0x84, 0x84,
-				0x8e, 0x94, 0xa5, 0xa5, 0xa6, 0xa7, 0xa8, 0xa8,
-				0xa9, 0xac, 0xad, 0xb4, 0xb9, 0xba, 0xbb, 0xbf,
-				0xc0, 0xc0, 0xc2, 0xc2, 0xc3, 0xc5, 0xc6, 0xc6,
-				0xc7, 0xc7, 0xc9, 0xcb, 0xcb, 0xcc, 0xce, 0xd0,
-				0xd5, 0xd5, 0xd7, 0xe1, 0xe3, 0xe7, 0xea, 0xeb,
-				0xeb, 0xf4, 0xf4, 0xf5, 0xfc, 0xff,
-			},
-		},
-
-		// Group 45
-		{
-			{
-				0x03, 0x0b, 0x0c, 0x12, 0x13, 0x19, 0x1e, 0x24,
-				0x2c, 0x2c, 0x31, 0x31, 0x32, 0x33, 0x34, 0x35,
-				0x35, 0x37, 0x39, 0x3c, 0x3d, 0x40, 0x41, 0x41,
-				0x44, 0x4d, 0x52, 0x52, 0x5b, 0x5d, 0x5f, 0x5f,
-				0x60, 0x62, 0x64, 0x64, 0x66, 0x6d, 0x70, 0x72,
-				0x72, 0x73, 0x78, 0x7c, 0x81, 0x82, 0x89, 0x8b,
-				0x8e, 0x90, 0x93, 0x96, 0xa4, 0xa5, 0xa9, 0xab,
-				0xaf, 0xb5, 0xb9, 0xbf, 0xbf, 0xc2, 0xc3, 0xc4,
-				0xc4, 0xc7, 0xcc, 0xcd, 0xcd, 0xd1, 0xd7, 0xd9,
-				0xe1, 0xe2, 0xe3, 0xeb, 0xed, 0xee, 0xf0, 0xf1,
-				0xf2, 0xf2, 0xf4, 0xf7, 0xf8, 0xff,
-			},
-			{
-				0x05, 0x09, 0x0d, 0x15, 0x19, 0x1c, 0x1e, 0x20,
-				0x22, 0x23, 0x26, 0x2a, 0x30, 0x31, 0x33, 0x39,
-				0x3d, 0x3f, 0x40, 0x44, 0x47, 0x48, 0x4a, 0x4c,
-				0x4f, 0x58, 0x5d, 0x67, 0x6c, 0x6e, 0x6e, 0x71,
-				0x75, 0x76, 0x7b, 0x7d, 0x7e, 0x87, 0x88, 0x8a,
-				0x8d, 0x8e, 0x92, 0x93, 0x9a, 0x9d, 0xa0, 0xa1,
-				0xa2, 0xa2, 0xa3, 0xa8, 0xaa, 0xac, 0xaf, 0xb4,
-				0xb7, 0xb7, 0xb9, 0xbb, 0xbd, 0xbf, 0xc0, 0xc1,
-				0xc6, 0xc9, 0xce, 0xcf, 0xd0, 0xd5, 0xd8, 0xda,
-				0xda, 0xdc, 0xde, 0xe3, 0xe7, 0xea, 0xeb, 0xf0,
-				0xf0, 0xf3, 0xf9, 0xfb, 0xfb, 0xfd,
-			},
-			{
-				0x00, 0x00, 0x02, 0x02, 0x04, 0x06, 0x0a, 0x0e,
-				0x11, 0x16, 0x17, 0x19, 0x1a, 0x1b, 0x1d, 0x27,
-				0x29, 0x2a, 0x2f, 0x30, 0x38, 0x3d, 0x3f, 0x43,
-				0x45, 0x45, 0x46, 0x46, 0x47, 0x49, 0x4a, 0x4c,
-				0x51, 0x53, 0x59, 0x59, 0x5c, 0x60, 0x65, 0x6f,
-				0x71, 0x72, 0x77, 0x79, 0x79, 0x7a, 0x7d, 0x7f,
-				0x85, 0x86, 0x8c, 0x8c, 0x8f, 0x92, 0x96, 0x98,
-				0x9a, 0x9f, 0xa0, 0xa1, 0xa6, 0xad, 0xae, 0xb1,
-				0xb1, 0xb3, 0xb6, 0xb8, 0xba, 0xbc, 0xc8, 0xcb,
-				0xd0, 0xde, 0xdf, 0xe0, 0xe2, 0xe9, 0xee, 0xf5,
-				0xf6, 0xf7, 0xf8, 0xf9, 0xfc, 0xfe,
-			},
-			{
-				0x0b, 0x0f, 0x11, 0x13, 0x1a, 0x20, 0x21, 0x23,
-				0x26, 0x27, 0x28, 0x2b, 0x2d, 0x36, 0x39, 0x3e,
-				0x42, 0x42, 0x4e, 0x4e, 0x50, 0x57, 0x57, 0x57,
-				0x58, 0x59, 0x5f, 0x62, 0x63, 0x65, 0x65, 0x6a,
-				0x6b, 0x6c, 0x6f, 0x76, 0x77, 0x78, 0x7e, 0x81,
-				0x8a, 0x90, 0x91, 0x91, 0x97, 0x98, 0x99, 0x9b,
-				0x9c, 0x9e, 0xa1, 0xa3, 0xa6, 0xa7, 0xa9, 0xac,
-				0xb0, 0xb2, 0xb4, 0xb9, 0xbc, 0xc2, 0xc5, 0xc6,
-				0xc6, 0xcb, 0xcd, 0xce, 0xd2, 0xd4, 0xd5, 0xda,
-				0xdb, 0xdc, 0xe4, 0xe4, 0xe6, 0xe6, 0xeb, 0xec,
-				0xef, 0xf1, 0xf9, 0xfc, 0xfd, 0xfe,
-			},
-			{
-				0x01, 0x07, 0x09, 0x0c, 0x0d, 0x0e, 0x0f, 0x10,
-				0x14, 0x15, 0x18, 0x1a, 0x29, 0x2d, 0x36, 0x38,
-				0x38, 0x3e, 0x3f, 0x43, 0x45, 0x49, 0x4a, 0x4b,
-				0x4d, 0x4f, 0x4f, 0x50, 0x51, 0x51, 0x52, 0x54,
-				0x56, 0x5c, 0x5e, 0x5e, 0x60, 0x61, 0x61, 0x69,
-				0x6a, 0x6a, 0x6c, 0x6e, 0x70, 0x82, 0x84, 0x87,
-				0x8a, 0x8b, 0x8f, 0x95, 0x9b, 0x9d, 0x9e, 0x9f,
-				0xa2, 0xa4, 0xa8, 0xab, 0xad, 0xae, 0xb0, 0xb1,
-				0xb5, 0xc0, 0xc3, 0xc7, 0xc8, 0xca, 0xca, 0xd1,
-				0xd6, 0xd7, 0xd9, 0xdc, 0xdd, 0xe4, 0xe6, 0xe9,
-				0xea, 0xec, 0xf7, 0xf8, 0xfe, 0xff,
-			},
-			{
-				0x04, 0x06, 0x07, 0x08, 0x08, 0x0c, 0x0e, 0x10,
-				0x11, 0x14, 0x17, 0x18, 0x1f, 0x21, 0x24, 0x25,
-				0x2a, 0x2b, 0x2f, 0x35, 0x37, 0x3a, 0x3b, 0x3c,
-				0x41, 0x48, 0x48, 0x53, 0x54, 0x55, 0x55, 0x58,
-				0x5a, 0x5a, 0x5b, 0x5d, 0x63, 0x66, 0x68, 0x6b,
-				0x6f, 0x73, 0x74, 0x75, 0x7a, 0x7b, 0x7d, 0x80,
-				0x82, 0x83, 0x89, 0x8f, 0x94, 0x94, 0x96, 0x99,
-				0xa0, 0xa7, 0xaa, 0xad, 0xb2, 0xb4, 0xb6, 0xba,
-				0xbb, 0xbe, 0xc1, 0xc2, 0xc7, 0xc9, 0xcc, 0xd3,
-				0xd4, 0xd6, 0xdb, 0xdd, 0xe0, 0xe1, 0xe5, 0xe5,
-				0xe7, 0xe8, 0xf1, 0xf4, 0xf5, 0xf5,
-			},
-			{
-				0x01, 0x03, 0x05, 0x0a, 0x12, 0x13, 0x16, 0x1b,
-				0x1c, 0x1d, 0x1d, 0x1f, 0x22, 0x22, 0x23, 0x25,
-				0x27, 0x28, 0x2b, 0x2c, 0x2e, 0x2e, 0x32, 0x34,
-				0x36, 0x3a, 0x3a, 0x3b, 0x43, 0x47, 0x4b, 0x56,
-				0x5b, 0x5c, 0x62, 0x67, 0x68, 0x69, 0x6d, 0x74,
-				0x78, 0x7c, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x86,
-				0x88, 0x8b, 0x8d, 0x8d, 0x91, 0x95, 0x97, 0x9c,
-				0x9c, 0x9d, 0xa3, 0xa5, 0xa7, 0xa9, 0xb3, 0xb7,
-				0xb8, 0xbd, 0xbe, 0xc1, 0xc5, 0xc5, 0xcc, 0xcf,
-				0xd2, 0xd3, 0xd7, 0xd8, 0xdf, 0xe3, 0xe7, 0xe8,
-				0xed, 0xef, 0xf3, 0xf6, 0xfa, 0xfa,
-			},
-		},
-
-		// Group 46
-		{
-			{
-				0x09, 0x09, 0x0f, 0x13, 0x15, 0x17, 0x1b, 0x1c,
-				0x1d, 0x20, 0x21, 0x24, 0x26, 0x28, 0x2c, 0x2f,
-				0x32, 0x33, 0x37, 0x3d, 0x3f, 0x42, 0x47, 0x47,
-				0x4e, 0x54, 0x5a, 0x5c, 0x5d, 0x5d, 0x60, 0x60,
-				0x65, 0x70, 0x72, 0x78, 0x79, 0x7c, 0x81, 0x87,
-				0x88, 0x88, 0x89, 0x8a, 0x8b, 0x8b, 0x92, 0x92,
-				0x93, 0x94, 0x95, 0x9e, 0xa5, 0xa6, 0xa8, 0xaa,
-				0xad, 0xb3, 0xb5, 0xba, 0xbc, 0xbd, 0xbe, 0xbe,
-				0xc1, 0xc3, 0xc4, 0xc9, 0xcb, 0xcc, 0xcd, 0xcf,
-				0xd0, 0xd3, 0xd5, 0xd7, 0xe0, 0xe2, 0xe5, 0xe7,
-				0xe9, 0xed, 0xf5, 0xf9, 0xfb, 0xfe,
-			},
-			{
-				0x00, 0x02, 0x05, 0x08, 0x0b, 0x0b, 0x12, 0x16,
-				0x18, 0x1a, 0x1c, 0x1e, 0x1f, 0x20, 0x24, 0x25,
-				0x27, 0x2a, 0x30, 0x38, 0x39, 0x39, 0x3d, 0x40,
-				0x47, 0x49, 0x49, 0x4c, 0x51, 0x53, 0x58, 0x60,
-				0x64, 0x66, 0x6a, 0x6c, 0x6d, 0x71, 0x73, 0x7a,
-				0x7d, 0x85, 0x8d, 0x8e, 0x8f, 0x97, 0x98, 0x99,
-				0x9c, 0x9d, 0xa1, 0xa6, 0xaa, 0xab, 0xb3, 0xb9,
-				0xbb, 0xbc, 0xbf, 0xc2, 0xc4, 0xc6, 0xc9, 0xcc,
-				0xce, 0xd6, 0xd6, 0xd7, 0xd8, 0xda, 0xe0, 0xe1,
-				0xe2, 0xe2, 0xe3, 0xe3, 0xe4, 0xea, 0xeb, 0xed,
-				0xef, 0xf0, 0xf1, 0xf3, 0xf4, 0xfc,
-			},
-			{
-				0x03, 0x04, 0x05, 0x07, 0x07, 0x10, 0x14, 0x17,
-				0x17, 0x18, 0x1e, 0x23, 0x23, 0x2c, 0x2d, 0x2d,
-				0x2e, 0x2e, 0x36, 0x3a, 0x3b, 0x3c, 0x3f, 0x41,
-				0x42, 0x44, 0x46, 0x4c, 0x51, 0x54, 0x55, 0x55,
-				0x56, 0x57, 0x57, 0x5e, 0x5f, 0x63, 0x64, 0x68,
-				0x68, 0x69, 0x6b, 0x6d, 0x6e, 0x75, 0x81, 0x84,
-				0x8f, 0x96, 0x9a, 0x9a, 0x9b, 0x9b, 0x9e, 0x9f,
-				0xa0, 0xa1, 0xa2, 0xa3, 0xa9, 0xab, 0xb1, 0xb2,
-				0xb7, 0xb7, 0xbb, 0xc0, 0xc5, 0xca, 0xcb, 0xd1,
-				0xd8, 0xdb, 0xdb, 0xdf, 0xe6, 0xe7, 0xe8, 0xea,
-				0xeb, 0xec, 0xee, 0xf6, 0xf7, 0xfa,
-			},
-			{
-				0x00, 0x01, 0x01, 0x07, 0x0a, 0x0a, 0x0d, 0x0d,
-				0x0e, 0x10, 0x11, 0x12, 0x13, 0x19, 0x21, 0x24,
-				0x26, 0x28, 0x2b, 0x2b, 0x2f, 0x30, 0x31, 0x33,
-				0x34, 0x36, 0x3a, 0x3a, 0x3c, 0x3d, 0x3e, 0x3e,
-				0x3e, 0x40, 0x41, 0x44, 0x45, 0x48, 0x4b, 0x4e,
-				0x50, 0x55, 0x5a, 0x5e, 0x62, 0x6a, 0x6b, 0x70,
-				0x71, 0x74, 0x76, 0x79, 0x7d, 0x82, 0x83, 0x8c,
-				0x8e, 0x91, 0x93, 0x94, 0x9d, 0xa3, 0xa4, 0xab,
-				0xac, 0xaf, 0xaf, 0xb0, 0xb6, 0xbf, 0xc3, 0xc5,
-				0xcd, 0xcf, 0xd2, 0xd2, 0xdb, 0xdd, 0xde, 0xe9,
-				0xef, 0xf0, 0xf4, 0xf5, 0xf8, 0xff,
-			},
-			{
-				0x05, 0x06, 0x08, 0x0f, 0x11, 0x16, 0x1a, 0x1f,
-				0x29, 0x2a, 0x31, 0x31, 0x34, 0x35, 0x39, 0x43,
-				0x43, 0x45, 0x45, 0x49, 0x4a, 0x52, 0x5b, 0x62,
-				0x69, 0x69, 0x6b, 0x6e, 0x6f, 0x71, 0x72, 0x74,
-				0x76, 0x77, 0x77, 0x78, 0x7e, 0x80, 0x82, 0x85,
-				0x86, 0x8a, 0x8c, 0x91, 0x96, 0x9b, 0x9f, 0xa2,
-				0xa2, 0xa4, 0xa5, 0xa9, 0xac, 0xae, 0xb1, 0xb4,
-				0xb5, 0xb8, 0xba, 0xc8, 0xc8, 0xc9, 0xca, 0xce,
-				0xce, 0xcf, 0xd0, 0xd0, 0xd1, 0xd4, 0xd8, 0xd9,
-				0xdc, 0xde, 0xdf, 0xe5, 0xe6, 0xe8, 0xe9, 0xec,
-				0xf1, 0xf2, 0xf3, 0xf7, 0xf9, 0xfd,
-			},
-			{
-				0x03, 0x04, 0x11, 0x14, 0x15, 0x1a, 0x1d, 0x22,
-				0x22, 0x25, 0x27, 0x32, 0x35, 0x35, 0x37, 0x38,
-				0x42, 0x48, 0x4b, 0x4d, 0x4e, 0x50, 0x52, 0x53,
-				0x5a, 0x5b, 0x5b, 0x5c, 0x61, 0x63, 0x66, 0x67,
-				0x77, 0x7a, 0x7b, 0x7b, 0x7c, 0x7f, 0x7f, 0x80,
-				0x83, 0x86, 0x87, 0x8d, 0x90, 0x91, 0x96, 0x98,
-				0x99, 0x9d, 0x9e, 0xa0, 0xa7, 0xa8, 0xae, 0xb0,
-				0xb4, 0xb5, 0xb6, 0xb7, 0xb8, 0xbd, 0xc1, 0xc2,
-				0xc7, 0xca, 0xcd, 0xd2, 0xd3, 0xd4, 0xd5, 0xd6,
-				0xd9, 0xda, 0xdd, 0xe1, 0xe8, 0xf2, 0xf5, 0xf6,
-				0xf9, 0xfb, 0xfc, 0xfd, 0xfe, 0xfe,
-			},
-			{
-				0x01, 0x02, 0x06, 0x06, 0x0a, 0x0c, 0x0c, 0x0e,
-				0x0e, 0x0f, 0x14, 0x19, 0x1b, 0x23, 0x29, 0x3b,
-				0x3f, 0x41, 0x43, 0x46, 0x4a, 0x4d, 0x4f, 0x4f,
-				0x4f, 0x53, 0x56, 0x58, 0x59, 0x59, 0x5f, 0x61,
-				0x62, 0x65, 0x67, 0x6c, 0x6d, 0x6f, 0x70, 0x73,
-				0x75, 0x7a, 0x7e, 0x7f, 0x81, 0x82, 0x83, 0x84,
-				0x88, 0x89, 0x89, 0x8c, 0x8e, 0x90, 0x95, 0x97,
-				0x98, 0x9c, 0xa5, 0xa7, 0xa9, 0xac, 0xad, 0xaf,
-				0xb2, 0xb6, 0xb9, 0xbd, 0xbf, 0xc0, 0xc6, 0xc7,
-				0xc7, 0xcb, 0xd5, 0xd9, 0xdc, 0xe4, 0xeb, 0xee,
-				0xf1, 0xf7, 0xf8, 0xf8, 0xfa, 0xff,
-			},
-		},
-
-		// Group 47
-		{
-			{
-				0x04, 0x0c, 0x16, 0x1b, 0x1c, 0x1d, 0x1e, 0x20,
-				0x20, 0x21, 0x22, 0x24, 0x28, 0x2d, 0x2f, 0x37,
-				0x38, 0x3c, 0x41, 0x4b, 0x4f, 0x50, 0x51, 0x5a,
-				0x5b, 0x61, 0x63, 0x63, 0x67, 0x68, 0x6c, 0x6f,
-				0x70, 0x73, 0x75, 0x76, 0x7a, 0x7c, 0x80, 0x83,
-				0x89, 0x8b, 0x8d, 0x90, 0x93, 0x93, 0x95, 0x96,
-				0x9c, 0x9e, 0xa2, 0xaa, 0xb1, 0xb1, 0xb3, 0xb3,
-				0xb7, 0xb9, 0xbb, 0xbd, 0xbf, 0xc1, 0xc4, 0xc6,
-				0xca, 0xca, 0xcb, 0xcd, 0xd7, 0xd9, 0xda, 0xde,
-				0xe0, 0xe4, 0xe7, 0xe7, 0xea, 0xeb, 0xec, 0xee,
-				0xf0, 0xf1, 0xf1, 0xf3, 0xf4, 0xf9,
-			},
-			{
-				0x06, 0x07, 0x09, 0x0a, 0x0b, 0x0b, 0x0c, 0x0e,
-				0x10, 0x10, 0x11, 0x11, 0x14, 0x16, 0x17, 0x1a,
-				0x1a, 0x25, 0x28, 0x2a, 0x30, 0x35, 0x37, 0x39,
-				0x40, 0x41, 0x43, 0x44, 0x46, 0x4d, 0x4e, 0x4e,
-				0x53, 0x54, 0x56, 0x57, 0x57, 0x5d, 0x60, 0x69,
-				0x6a, 0x72, 0x72, 0x77, 0x79, 0x7b, 0x7e, 0x85,
-				0x8d, 0x92, 0x98, 0x99, 0x9b, 0xa2, 0xa8, 0xa9,
-				0xaa, 0xac, 0xae, 0xb0, 0xb2, 0xb6, 0xba, 0xbb,
-				0xbe, 0xbe, 0xc5, 0xc7, 0xc9, 0xd0, 0xd2, 0xd6,
-				0xd6, 0xd7, 0xdb, 0xdd, 0xea, 0xed, 0xf2, 0xf4,
-				0xf5, 0xf6, 0xf8, 0xfb, 0xfc, 0xff,
-			},
-			{
-				0x00, 0x04, 0x06, 0x07, 0x0a, 0x12, 0x15, 0x1e,
-				0x21, 0x25, 0x28, 0x2a, 0x2b, 0x2c, 0x34, 0x36,
-				0x3e, 0x41, 0x47, 0x48, 0x48, 0x4b, 0x4f, 0x54,
-				0x55, 0x56, 0x59, 0x5c, 0x60, 0x60, 0x63, 0x67,
-				0x6c, 0x6e, 0x6f, 0x72, 0x77, 0x79, 0x7a, 0x7e,
-				0x7f, 0x81, 0x83, 0x84, 0x86, 0x97, 0x9a, 0x9a,
-				0x9a, 0x9e, 0x9f, 0xa1, 0xa5, 0xa6, 0xa8, 0xab,
-				0xb1, 0xb2, 0xb4, 0xb4, 0xb6, 0xb7, 0xb9, 0xc0,
-				0xc1, 0xc2, 0xc7, 0xcf, 0xd1, 0xd3, 0xd4, 0xd8,
-				0xda, 0xdd, 0xe5, 0xe5, 0xe9, 0xec, 0xed, 0xf2,
-				0xf3, 0xf6, 0xf7, 0xfb, 0xfc, 0xfe,
-			},
-			{
-				0x05, 0x05, 0x08, 0x09, 0x0b, 0x0f, 0x13, 0x13,
-				0x17, 0x19, 0x1e, 0x23, 0x23, 0x23, 0x29, 0x2c,
-				0x2f, 0x30, 0x31, 0x33, 0x37, 0x39, 0x3a, 0x3d,
-				0x3e, 0x3f, 0x42, 0x45, 0x46, 0x58, 0x5c, 0x5d,
-				0x6b, 0x6d, 0x6e, 0x70, 0x71, 0x73, 0x7c, 0x7f,
-				0x81, 0x88, 0x8d, 0x8e, 0x8f, 0x91, 0x93, 0x97,
-				0x98, 0x99, 0x9b, 0x9b, 0x9f, 0xa3, 0xa3, 0xa4,
-				0xa4, 0xa5, 0xaa, 0xab, 0xac, 0xaf, 0xb0, 0xb8,
-				0xb8, 0xb8, 0xbc, 0xbd, 0xbf, 0xc8, 0xd0, 0xd1,
-				0xd2, 0xd9, 0xdc, 0xe0, 0xe1, 0xe1, 0xe2, 0xe4,
-				0xe6, 0xe8, 0xee, 0xf7, 0xfd, 0xfd,
-			},
-			{
-				0x01, 0x03, 0x09, 0x0d, 0x10, 0x12, 0x12, 0x1c,
-				0x1d, 0x1f, 0x26, 0x27, 0x27, 0x2b, 0x2c, 0x2e,
-				0x34, 0x36, 0x3a, 0x3b, 0x3b, 0x3b, 0x3d, 0x3f,
-				0x42, 0x43, 0x48, 0x4a, 0x4a, 0x4c, 0x4d, 0x51,
-				0x52, 0x52, 0x53, 0x5e, 0x62, 0x64, 0x68, 0x6a,
-				0x6b, 0x6f, 0x73, 0x7d, 0x7d, 0x80, 0x82, 0x83,
-				0x85, 0x89, 0x89, 0x90, 0x91, 0x91, 0x94, 0x9e,
-				0xa0, 0xa1, 0xa9, 0xaf, 0xb3, 0xb5, 0xc4, 0xc5,
-				0xc6, 0xc9, 0xcc, 0xcc, 0xcd, 0xcd, 0xce, 0xd4,
-				0xd5, 0xd8, 0xdd, 0xde, 0xde, 0xdf, 0xe0, 0xe3,
-				0xe8, 0xe9, 0xea, 0xeb, 0xf5, 0xf9,
-			},
-			{
-				0x03, 0x07, 0x0d, 0x0e, 0x0f, 0x13, 0x15, 0x17,
-				0x18, 0x18, 0x19, 0x1b, 0x1f, 0x20, 0x22, 0x24,
-				0x26, 0x27, 0x2e, 0x31, 0x32, 0x33, 0x35, 0x3c,
-				0x40, 0x49, 0x4a, 0x4c, 0x4f, 0x58, 0x59, 0x5a,
-				0x5e, 0x5f, 0x61, 0x65, 0x66, 0x66, 0x68, 0x69,
-				0x6b, 0x75, 0x7f, 0x82, 0x86, 0x87, 0x87, 0x8a,
-				0x8b, 0x8f, 0x92, 0x96, 0x9c, 0x9d, 0x9d, 0xa0,
-				0xa6, 0xa7, 0xa7, 0xad, 0xae, 0xb0, 0xb7, 0xba,
-				0xbb, 0xbc, 0xc3, 0xc8, 0xc9, 0xca, 0xce, 0xcf,
-				0xd3, 0xd4, 0xd5, 0xdc, 0xdf, 0xe1, 0xef, 0xf0,
-				0xf3, 0xf8, 0xfa, 0xfa, 0xfe, 0xff,
-			},
-			{
-				0x00, 0x01, 0x02, 0x02, 0x05, 0x08, 0x0d, 0x11,
-				0x14, 0x19, 0x1f, 0x29, 0x2d, 0x32, 0x36, 0x38,
-				0x44, 0x45, 0x45, 0x47, 0x49, 0x50, 0x51, 0x55,
-				0x56, 0x5a, 0x5b, 0x5c, 0x5e, 0x5f, 0x62, 0x64,
-				0x65, 0x6a, 0x6c, 0x6d, 0x6d, 0x6e, 0x71, 0x74,
-				0x74, 0x76, 0x78, 0x78, 0x7b, 0x84, 0x88, 0x8a,
-				0x8c, 0x8c, 0x8e, 0x90, 0x92, 0x94, 0x94, 0x95,
-				0x98, 0x9f, 0xab, 0xad, 0xb2, 0xb5, 0xb5, 0xb6,
-				0xbe, 0xc0, 0xc1, 0xc2, 0xc3, 0xcb, 0xd2, 0xd6,
-				0xd7, 0xda, 0xdb, 0xe2, 0xe3, 0xe6, 0xed, 0xee,
-				0xef, 0xf7, 0xf9, 0xfa, 0xfb, 0xfd,
-			},
-		},
-
-		// Group 48
-		{
-			{
-				0x01, 0x01, 0x03, 0x04, 0x07, 0x10, 0x12, 0x1a,
-				0x1d, 0x1e, 0x22, 0x23, 0x23, 0x25, 0x26, 0x26,
-				0x2f, 0x2f, 0x30, 0x35, 0x35, 0x38, 0x3a, 0x3b,
-				0x3c, 0x45, 0x49, 0x4b, 0x51, 0x52, 0x53, 0x56,
-				0x59, 0x5d, 0x60, 0x62, 0x67, 0x67, 0x68, 0x6c,
-				0x72, 0x73, 0x7e, 0x80, 0x80, 0x83, 0x84, 0x89,
-				0x8d, 0x8e, 0x94, 0x95, 0x96, 0x99, 0x9c, 0xa1,
-				0xa7, 0xb4, 0xb6, 0xb8, 0xb9, 0xba, 0xbb, 0xbd,
-				0xbd, 0xc2, 0xc2, 0xc4, 0xc5, 0xc6, 0xca, 0xcd,
-				0xd2, 0xd2, 0xd3, 0xd5, 0xd6, 0xd6, 0xe1, 0xe2,
-				0xea, 0xeb, 0xec, 0xec, 0xee, 0xfc,
-			},
-			{
-				0x03, 0x04, 0x07, 0x0d, 0x0e, 0x0f, 0x11, 0x11,
-				0x14, 0x15, 0x1b, 0x1b, 0x1f, 0x20, 0x21, 0x22,
-				0x26, 0x2a, 0x2a, 0x2a, 0x2d, 0x2e, 0x31, 0x32,
-				0x33, 0x34, 0x35, 0x36, 0x3a, 0x3c, 0x3d, 0x3f,
-				0x42, 0x46, 0x49, 0x51, 0x52, 0x52, 0x55, 0x5a,
-				0x5a, 0x5b, 0x5c, 0x5f, 0x61, 0x63, 0x63, 0x6d,
-				0x75, 0x76, 0x7e, 0x87, 0x88, 0x89, 0x8d, 0x93,
-				0x95, 0x97, 0x98, 0x9e, 0x9f, 0xa0, 0xa5, 0xa7,
-				0xb2, 0xb8, 0xb8, 0xbb, 0xc0, 0xc3, 0xcd, 0xdd,
-				0xde, 0xdf, 0xdf, 0xe0, 0xe1, 0xe4, 0xe8, 0xeb,
-				0xec, 0xed, 0xf3, 0xfb, 0xfd, 0xfe,
-			},
-			{
-				0x05, 0x08, 0x09, 0x0b, 0x0d, 0x16, 0x16, 0x29,
-				0x2e, 0x37, 0x39, 0x3d, 0x3e, 0x41, 0x47, 0x47,
-				0x4a, 0x4a, 0x4d, 0x4f, 0x54, 0x54, 0x55, 0x5c,
-				0x63, 0x69, 0x6a, 0x6e, 0x74, 0x76, 0x76, 0x77,
-				0x78, 0x79, 0x7a, 0x7b, 0x7b, 0x7d, 0x81, 0x86,
-				0x87, 0x87, 0x8b, 0x8b, 0x91, 0x92, 0x94, 0x96,
-				0x99, 0x9a, 0x9b, 0xa4, 0xa6, 0xa8, 0xa9, 0xac,
-				0xb0, 0xb2, 0xb3, 0xb6, 0xb7, 0xbd, 0xc1, 0xc3,
-				0xc7, 0xc8, 0xc9, 0xce, 0xd4, 0xd4, 0xd6, 0xd7,
-				0xd7, 0xda, 0xdd, 0xdf, 0xe3, 0xe4, 0xef, 0xf2,
-				0xf5, 0xf5, 0xf6, 0xf7, 0xf9, 0xfa,
-			},
-			{
-				0x00, 0x03, 0x05, 0x06, 0x0c, 0x13, 0x15, 0x1a,
-				0x21, 0x28, 0x2c, 0x2f, 0x31, 0x39, 0x3b, 0x3e,
-				0x41, 0x43, 0x44, 0x48, 0x4c, 0x4f, 0x50, 0x51,
-				0x58, 0x5b, 0x5d, 0x62, 0x64, 0x65, 0x67, 0x68,
-				0x69, 0x6b, 0x6c, 0x6e, 0x70, 0x70, 0x71, 0x74,
-				0x78, 0x79, 0x7f, 0x7f, 0x80, 0x83, 0x8a, 0x8d,
-				0x90, 0x93, 0x9b, 0x9c, 0x9e, 0xa2, 0xa3, 0xa6,
-				0xaa, 0xac, 0xb0, 0xb1, 0xb3, 0xb3, 0xb4, 0xb4,
-				0xb5, 0xb7, 0xb9, 0xba, 0xbe, 0xcc, 0xcf, 0xd0,
-				0xd1, 0xda, 0xdb, 0xdc, 0xe1, 0xe3, 0xe6, 0xe6,
-				0xe9, 0xea, 0xed, 0xf0, 0xf1, 0xfc,
-			},
-			{
-				0x01, 0x04, 0x0a, 0x0a, 0x0b, 0x10, 0x13, 0x17,
-				0x1c, 0x20, 0x22, 0x25, 0x29, 0x2d, 0x30, 0x34,
-				0x37, 0x38, 0x3c, 0x40, 0x43, 0x44, 0x46, 0x48,
-				0x49, 0x4b, 0x4e, 0x53, 0x54, 0x59, 0x5c, 0x5d,
-				0x61, 0x66, 0x6a, 0x6b, 0x6e, 0x71, 0x73, 0x75,
-				0x82, 0x82, 0x85, 0x85, 0x88, 0x8a, 0x8e, 0x8f,
-				0x90, 0x91, 0x97, 0x98, 0xa0, 0xa3, 0xaf, 0xb0,
-				0xb1, 0xbb, 0xc1, 0xc1, 0xc4, 0xc5, 0xc8, 0xca,
-				0xcd, 0xcf, 0xd0, 0xd3, 0xd9, 0xdb, 0xdc, 0xde,
-				0xe0, 0xe4, 0xe5, 0xe7, 0xe9, 0xee, 0xef, 0xf1,
-				0xf2, 0xf3, 0xf8, 0xfc, 0xfd, 0xfe,
-			},
-			{
-				0x02, 0x02, 0x06, 0x09, 0x0f, 0x11, 0x12, 0x14,
-				0x18, 0x18, 0x24, 0x27, 0x27, 0x28, 0x2b, 0x30,
-				0x36, 0x44, 0x45, 0x4c, 0x4d, 0x4d, 0x50, 0x57,
-				0x5e, 0x5f, 0x60, 0x62, 0x64, 0x65, 0x6a, 0x6b,
-				0x6d, 0x6f, 0x6f, 0x71, 0x72, 0x74, 0x75, 0x77,
-				0x78, 0x7c, 0x7d, 0x8c, 0x8c, 0x8f, 0x90, 0x92,
-				0x93, 0x98, 0x9a, 0x9b, 0xa2, 0xa4, 0xa5, 0xa8,
-				0xaa, 0xab, 0xad, 0xad, 0xae, 0xaf, 0xb1, 0xb9,
-				0xbc, 0xbc, 0xbe, 0xc4, 0xc7, 0xc9, 0xc9, 0xca,
-				0xcc, 0xd2, 0xd8, 0xe5, 0xe7, 0xf6, 0xf7, 0xf8,
-				0xf9, 0xf9, 0xfa, 0xfb, 0xff, 0xff,
-			},
-			{
-				0x00, 0x08, 0x0a, 0x0c, 0x0e, 0x0f, 0x17, 0x19,
-				0x19, 0x1a, 0x1c, 0x1d, 0x1e, 0x1f, 0x24, 0x2b,
-				0x2c, 0x2d, 0x32, 0x33, 0x3d, 0x3f, 0x40, 0x42,
-				0x42, 0x46, 0x47, 0x48, 0x4b, 0x4e, 0x56, 0x57,
-				0x58, 0x5e, 0x66, 0x69, 0x6d, 0x7a, 0x7c, 0x7e,
-				0x81, 0x84, 0x84, 0x86, 0x86, 0x88, 0x8e, 0x96,
-				0x9d, 0x9d, 0x9d, 0x9e, 0x9f, 0xa1, 0xa7, 0xa9,
-				0xab, 0xab, 0xae, 0xae, 0xb5, 0xb6, 0xbf, 0xbf,
-				0xc0, 0xc0, 0xc6, 0xcb, 0xcb, 0xce, 0xd1, 0xd5,
-				0xd8, 0xd9, 0xde, 0xe2, 0xe3, 0xe7, 0xe8, 0xf0,
-				0xf1, 0xf4, 0xf4, 0xfa, 0xfb, 0xfd,
-			},
-		},
-
-		// Group 49
-		{
-			{
-				0x01, 0x02, 0x03, 0x04, 0x04, 0x06, 0x0a, 0x0c,
-				0x0e, 0x17, 0x19, 0x1b, 0x1f, 0x25, 0x32, 0x32,
-				0x36, 0x3a, 0x3a, 0x3f, 0x44, 0x46, 0x49, 0x4a,
-				0x4b, 0x4c, 0x4c, 0x4e, 0x50, 0x51, 0x55, 0x56,
-				0x58, 0x5b, 0x5e, 0x5e, 0x5f, 0x69, 0x6b, 0x6c,
-				0x71, 0x71, 0x73, 0x75, 0x76, 0x7b, 0x7c, 0x7f,
-				0x87, 0x93, 0x94, 0x99, 0x9d, 0xa3, 0xa5, 0xa8,
-				0xb1, 0xb2, 0xbb, 0xbc, 0xc1, 0xc4, 0xc7, 0xc7,
-				0xc9, 0xcb, 0xcf, 0xd2, 0xd3, 0xd5, 0xdb, 0xdb,
-				0xde, 0xe3, 0xe4, 0xe6, 0xe8, 0xe8, 0xed, 0xef,
-				0xf0, 0xf1, 0xf9, 0xfc, 0xfd, 0xff,
-			},
-			{
-				0x00, 0x0b, 0x0c, 0x14, 0x15, 0x1a, 0x1e, 0x22,
-				0x24, 0x29, 0x2d, 0x30, 0x34, 0x3b, 0x3c, 0x3c,
-				0x40, 0x40, 0x42, 0x43, 0x53, 0x54, 0x5b, 0x60,
-				0x61, 0x62, 0x66, 0x69, 0x6a, 0x6a, 0x6a, 0x6d,
-				0x70, 0x74, 0x74, 0x75, 0x77, 0x77, 0x78, 0x7a,
-				0x80, 0x81, 0x84, 0x86, 0x87, 0x8e, 0x8f, 0x90,
-				0x91, 0x92, 0x94, 0x98, 0x9a, 0x9a, 0x9b, 0x9e,
-				0xa0, 0xa2, 0xa4, 0xa6, 0xa7, 0xad, 0xb4, 0xb5,
-				0xb6, 0xbe, 0xbe, 0xbf, 0xc2, 0xc3, 0xc5, 0xd1,
-				0xd3, 0xd4, 0xd7, 0xd8, 0xda, 0xda, 0xdc, 0xe1,
-				0xe8, 0xea, 0xec, 0xf2, 0xf5, 0xfe,
-			},
-			{
-				0x05, 0x0d, 0x0e, 0x0f, 0x11, 0x14, 0x16, 0x17,
-				0x1e, 0x21, 0x24, 0x26, 0x27, 0x29, 0x2a, 0x2e,
-				0x2e, 0x30, 0x33, 0x36, 0x39, 0x3a, 0x3d, 0x45,
-				0x47, 0x48, 0x49, 0x4a, 0x4d, 0x52, 0x5c, 0x5f,
-				0x62, 0x65, 0x6e, 0x6f, 0x71, 0x78, 0x79, 0x7c,
-				0x80, 0x83, 0x8a, 0x8c, 0x8d, 0x8e, 0x91, 0x95,
-				0x96, 0x98, 0x99, 0x9d, 0xa5, 0xa5, 0xa8, 0xab,
-				0xae, 0xae, 0xaf, 0xb5, 0xb6, 0xb9, 0xbb, 0xbf,
-				0xc2, 0xc4, 0xc5, 0xcd, 0xcf, 0xd0, 0xd1, 0xd6,
-				0xd6, 0xd8, 0xde, 0xe3, 0xe7, 0xeb, 0xeb, 0xf3,
-				0xf4, 0xf8, 0xfa, 0xfa, 0xfb, 0xfc,
-			},
-			{
-				0x05, 0x09, 0x0b, 0x10, 0x15, 0x18, 0x18, 0x1a,
-				0x1a, 0x1c, 0x1d, 0x20, 0x21, 0x23, 0x2b, 0x2d,
-				0x31, 0x33, 0x35, 0x38, 0x38, 0x3d, 0x3e, 0x3f,
-				0x40, 0x41, 0x46, 0x4b, 0x51, 0x56, 0x56, 0x59,
-				0x5a, 0x5c, 0x5f, 0x64, 0x67, 0x6b, 0x72, 0x76,
-				0x77, 0x7c, 0x82, 0x83, 0x86, 0x88, 0x89, 0x8c,
-				0x8d, 0x8d, 0x8f, 0x92, 0x95, 0x97, 0x9b, 0x9c,
-				0xa2, 0xa3, 0xa4, 0xa9, 0xac, 0xac, 0xad, 0xad,
-				0xb0, 0xb3, 0xb4, 0xb8, 0xb9, 0xbd, 0xc0, 0xc2,
-				0xc6, 0xce, 0xd9, 0xdf, 0xe2, 0xe3, 0xe5, 0xe7,
-				0xf1, 0xf4, 0xf5, 0xf7, 0xf9, 0xfc,
-			},
-			{
-				0x00, 0x03, 0x09, 0x0a, 0x0c, 0x11, 0x12, 0x13,
-				0x17, 0x18, 0x20, 0x25, 0x25, 0x2d, 0x2f, 0x39,
-				0x39, 0x3f, 0x41, 0x42, 0x43, 0x48, 0x51, 0x52,
-				0x55, 0x5a, 0x63, 0x64, 0x65, 0x67, 0x68, 0x6c,
-				0x6d, 0x6e, 0x73, 0x73, 0x79, 0x7a, 0x7b, 0x7d,
-				0x7f, 0x81, 0x85, 0x88, 0x8a, 0x8b, 0x8e, 0x90,
-				0x93, 0x9b, 0x9f, 0xa0, 0xa1, 0xa7, 0xa7, 0xa9,
-				0xaa, 0xae, 0xb1, 0xb6, 0xbc, 0xbe, 0xc1, 0xc3,
-				0xc4, 0xc5, 0xc8, 0xcc, 0xcd, 0xce, 0xd0, 0xd4,
-				0xd4, 0xd7, 0xd9, 0xdd, 0xe0, 0xe4, 0xe6, 0xe9,
-				0xeb, 0xef, 0xf3, 0xf6, 0xf7, 0xfd,
-			},
-			{
-				0x02, 0x07, 0x08, 0x0b, 0x10, 0x12, 0x14, 0x15,
-				0x1c, 0x1d, 0x26, 0x27, 0x2a, 0x2a, 0x2b, 0x2c,
-				0x2e, 0x31, 0x35, 0x37, 0x37, 0x3b, 0x3d, 0x3e,
-				0x44, 0x45, 0x46, 0x47, 0x4e, 0x4f, 0x54, 0x58,
-				0x59, 0x5d, 0x5e, 0x60, 0x66, 0x6f, 0x70, 0x76,
-				0x7a, 0x7e, 0x7e, 0x82, 0x84, 0x96, 0x9a, 0x9c,
-				0x9e, 0x9f, 0xa0, 0xa1, 0xaa, 0xac, 0xaf, 0xb1,
-				0xb2, 0xb8, 0xbb, 0xbd, 0xc6, 0xc6, 0xcc, 0xcf,
-				0xd2, 0xd3, 0xd5, 0xd8, 0xdc, 0xdd, 0xe0, 0xe1,
-				0xe5, 0xea, 0xec, 0xed, 0xee, 0xee, 0xf0, 0xf1,
-				0xf2, 0xf3, 0xf6, 0xfb, 0xfe, 0xff,
-			},
-			{
-				0x01, 0x02, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0d,
-				0x0e, 0x0f, 0x10, 0x13, 0x16, 0x19, 0x1b, 0x1d,
-				0x1e, 0x1f, 0x20, 0x22, 0x23, 0x26, 0x28, 0x28,
-				0x28, 0x2c, 0x2f, 0x33, 0x34, 0x35, 0x37, 0x3b,
-				0x3e, 0x48, 0x4d, 0x4e, 0x4f, 0x50, 0x53, 0x57,
-				0x57, 0x59, 0x5d, 0x61, 0x63, 0x68, 0x6b, 0x72,
-				0x75, 0x7d, 0x81, 0x85, 0x88, 0x89, 0x8a, 0x8b,
-				0x8b, 0x97, 0x9c, 0xa6, 0xab, 0xb0, 0xb3, 0xb7,
-				0xb7, 0xba, 0xba, 0xc0, 0xc0, 0xc8, 0xc9, 0xc9,
-				0xca, 0xca, 0xcb, 0xd0, 0xd5, 0xdf, 0xe2, 0xe4,
-				0xe9, 0xef, 0xf0, 0xf8, 0xf8, 0xfb,
-			},
-		},
-
-		// Group 50
-		{
-			{
-				0x01, 0x03, 0x05, 0x05, 0x06, 0x09, 0x0c, 0x0d,
-				0x18, 0x18, 0x1a, 0x1b, 0x1e, 0x1e, 0x23, 0x25,
-				0x2d, 0x2f, 0x32, 0x34, 0x37, 0x39, 0x3a, 0x3a,
-				0x3b, 0x43, 0x46, 0x48, 0x49, 0x4d, 0x4f, 0x50,
-				0x55, 0x58, 0x59, 0x5d, 0x63, 0x65, 0x73, 0x77,
-				0x7a, 0x7d, 0x7f, 0x89, 0x8a, 0x8d, 0x90, 0x93,
-				0x94, 0x9b, 0x9b, 0x9f, 0xa3, 0xa8, 0xa9, 0xad,
-				0xb1, 0xb2, 0xb2, 0xb4, 0xb5, 0xb6, 0xb7, 0xb9,
-				0xbe, 0xc2, 0xcf, 0xcf, 0xd3, 0xd4, 0xd7, 0xd8,
-				0xda, 0xdb, 0xdd, 0xe3, 0xe3, 0xe6, 0xe8, 0xea,
-				0xf0, 0xf3, 0xfa, 0xfc, 0xfd, 0xff,
-			},
-			{
-				0x00, 0x02, 0x08, 0x09, 0x0c, 0x0d, 0x12, 0x14,
-				0x15, 0x16, 0x19, 0x19, 0x29, 0x2a, 0x2a, 0x2b,
-				0x2d, 0x2e, 0x31, 0x31, 0x36, 0x3d, 0x42, 0x44,
-				0x47, 0x48, 0x49, 0x4b, 0x4c, 0x52, 0x54, 0x56,
-				0x66, 0x6a, 0x6b, 0x6c, 0x73, 0x77, 0x78, 0x79,
-				0x7e, 0x7f, 0x81, 0x86, 0x87, 0x89, 0x90, 0x95,
-				0x95, 0x9b, 0x9c, 0x9e, 0x9e, 0x9f, 0xa1, 0xa2,
-				0xa3, 0xa5, 0xa6, 0xa7, 0xaa, 0xab, 0xac, 0xad,
-				0xaf, 0xb3, 0xb3, 0xb4, 0xc6, 0xc7, 0xc8, 0xc9,
-				0xd4, 0xd6, 0xd8, 0xd9, 0xdc, 0xde, 0xe3, 0xe5,
-				0xee, 0xf3, 0xf3, 0xfb, 0xfd, 0xff,
-			},
-			{
-				0x01, 0x07, 0x09, 0x0a, 0x0b, 0x0e, 0x14, 0x15,
-				0x1b, 0x1f, 0x22, 0x23, 0x33, 0x35, 0x35, 0x36,
-				0x38, 0x3c, 0x3d, 0x3e, 0x42, 0x44, 0x4a, 0x4c,
-				0x51, 0x57, 0x59, 0x5b, 0x5d, 0x60, 0x63, 0x69,
-				0x6a, 0x6c, 0x74, 0x75, 0x76, 0x7b, 0x7c, 0x7c,
-				0x7d, 0x7e, 0x82, 0x84, 0x85, 0x86, 0x8a, 0x8b,
-				0x8c, 0x8d, 0x8e, 0x94, 0x96, 0x98, 0xa2, 0xa5,
-				0xa6, 0xa9, 0xac, 0xb7, 0xb8, 0xb8, 0xbb, 0xbc,
-				0xc0, 0xc2, 0xc3, 0xc4, 0xc8, 0xca, 0xcb, 0xd6,
-				0xd9, 0xda, 0xdc, 0xdf, 0xe0, 0xe1, 0xe2, 0xe2,
-				0xe4, 0xe6, 0xe8, 0xec, 0xf4, 0xf5,
-			},
-			{
-				0x03, 0x04, 0x05, 0x0f, 0x10, 0x11, 0x13, 0x17,
-				0x1a, 0x1c, 0x24, 0x27, 0x28, 0x2c, 0x2c, 0x2f,
-				0x30, 0x33, 0x3c, 0x3e, 0x3e, 0x3f, 0x3f, 0x45,
-				0x46, 0x47, 0x47, 0x4e, 0x50, 0x54, 0x55, 0x57,
-				0x5a, 0x60, 0x64, 0x66, 0x67, 0x68, 0x69, 0x6e,
-				0x70, 0x72, 0x75, 0x80, 0x84, 0x84, 0x87, 0x88,
-				0x8f, 0x8f, 0x91, 0x97, 0x98, 0x99, 0x9a, 0x9c,
-				0xa8, 0xae, 0xb0, 0xb3, 0xba, 0xbd, 0xbd, 0xbe,
-				0xbe, 0xbf, 0xc1, 0xc5, 0xc6, 0xc7, 0xca, 0xcc,
-				0xcd, 0xce, 0xd2, 0xd3, 0xd6, 0xd8, 0xe1, 0xe7,
-				0xea, 0xee, 0xef, 0xf4, 0xf7, 0xfa,
-			},
-			{
-				0x01, 0x07, 0x08, 0x0b, 0x0e, 0x11, 0x16, 0x1d,
-				0x21, 0x22, 0x26, 0x2a, 0x34, 0x3d, 0x40, 0x41,
-				0x4b, 0x4f, 0x51, 0x52, 0x53, 0x54, 0x55, 0x58,
-				0x5b, 0x5b, 0x5e, 0x5e, 0x5f, 0x60, 0x64, 0x66,
-				0x6a, 0x6b, 0x6b, 0x6d, 0x6f, 0x71, 0x72, 0x76,
-				0x7b, 0x7e, 0x81, 0x85, 0x87, 0x8e, 0x91, 0x92,
-				0x93, 0x99, 0x9a, 0x9a, 0x9d, 0xa0, 0xa4, 0xa7,
-				0xa7, 0xab, 0xae, 0xaf, 0xb0, 0xba, 0xbb, 0xbc,
-				0xbf, 0xca, 0xcd, 0xd1, 0xd5, 0xe0, 0xe7, 0xe8,
-				0xec, 0xed, 0xed, 0xee, 0xf0, 0xf2, 0xf2, 0xf4,
-				0xf6, 0xf6, 0xfb, 0xfb, 0xfc, 0xfd,
-			},
-			{
-				0x00, 0x02, 0x04, 0x08, 0x0a, 0x0f, 0x12, 0x13,
-				0x15, 0x16, 0x17, 0x18, 0x1c, 0x1e, 0x20, 0x22,
-				0x26, 0x28, 0x2b, 0x2e, 0x30, 0x32, 0x35, 0x37,
-				0x38, 0x3a, 0x41, 0x43, 0x45, 0x49, 0x4a, 0x4c,
-				0x57, 0x5c, 0x5f, 0x61, 0x62, 0x6e, 0x6f, 0x70,
-				0x71, 0x72, 0x78, 0x7a, 0x81, 0x82, 0x83, 0x83,
-				0x88, 0x8b, 0x8c, 0x92, 0x94, 0x96, 0x96, 0x97,
-				0xa0, 0xa1, 0xa2, 0xaa, 0xb1, 0xbf, 0xc0, 0xc1,
-				0xc3, 0xc4, 0xc9, 0xcc, 0xcc, 0xce, 0xd0, 0xd3,
-				0xd4, 0xd9, 0xdb, 0xe4, 0xe5, 0xe7, 0xe9, 0xe9,
-				0xea, 0xef, 0xf1, 0xf1, 0xfe, 0xff,
-			},
-			{
-				0x00, 0x04, 0x06, 0x0d, 0x0f, 0x10, 0x1d, 0x1f,
-				0x20, 0x21, 0x24, 0x24, 0x25, 0x27, 0x29, 0x2d,
-				0x2f, 0x30, 0x32, 0x38, 0x39, 0x39, 0x3b, 0x3f,
-				0x40, 0x42, 0x44, 0x46, 0x4d, 0x4e, 0x4e, 0x50,
-				0x53, 0x56, 0x59, 0x5a, 0x5c, 0x61, 0x61, 0x62,
-				0x65, 0x67, 0x67, 0x68, 0x6d, 0x74, 0x79, 0x7b,
-				0x80, 0x8a, 0x8e, 0x90, 0x9d, 0xa4, 0xaf, 0xb1,
-				0xb5, 0xb6, 0xb6, 0xb9, 0xc5, 0xc7, 0xcb, 0xd0,
-				0xd1, 0xd2, 0xd5, 0xd7, 0xda, 0xdd, 0xde, 0xdf,
-				0xe0, 0xe2, 0xeb, 0xeb, 0xef, 0xf1, 0xf5, 0xf7,
-				0xf8, 0xf8, 0xf8, 0xf9, 0xf9, 0xfe,
-			},
-		},
-
-		// Group 51
-		{
-			{
-				0x00, 0x01, 0x05, 0x06, 0x09, 0x09, 0x0b, 0x13,
-				0x17, 0x17, 0x19, 0x1b, 0x23, 0x27, 0x28, 0x2c,
-				0x2e, 0x3a, 0x3d, 0x3f, 0x40, 0x41, 0x42, 0x46,
-				0x48, 0x4c, 0x4f, 0x50, 0x51, 0x52, 0x54, 0x57,
-				0x59, 0x61, 0x6c, 0x6d, 0x6e, 0x6f, 0x73, 0x76,
-				0x76, 0x78, 0x7a, 0x80, 0x82, 0x83, 0x86, 0x87,
-				0x89, 0x94, 0x96, 0x9c, 0x9c, 0x9f, 0xa0, 0xa2,
-				0xa4, 0xa4, 0xa6, 0xa7, 0xa9, 0xac, 0xb0, 0xb4,
-				0xb6, 0xc0, 0xc1, 0xc5, 0xc8, 0xd6, 0xd8, 0xda,
-				0xe0, 0xe0, 0xe4, 0xe5, 0xe7, 0xea, 0xed, 0xf1,
-				0xf4, 0xf5, 0xf6, 0xf9, 0xfa, 0xfe,
-			},
-			{
-				0x01, 0x0a, 0x10, 0x14, 0x14, 0x1c, 0x1d, 0x1e,
-				0x20, 0x22, 0x24, 0x27, 0x28, 0x2a, 0x2b, 0x35,
-				0x35, 0x3b, 0x3e, 0x43, 0x4e, 0x50, 0x51, 0x57,
-				0x59, 0x5d, 0x5d, 0x5e, 0x68, 0x6d, 0x71, 0x72,
-				0x73, 0x74, 0x7a, 0x7e, 0x7f, 0x82, 0x84, 0x85,
-				0x8a, 0x8b, 0x8d, 0x8f, 0x92, 0x94, 0x96, 0x96,
-				0x97, 0x98, 0x9b, 0xa3, 0xa8, 0xa9, 0xae, 0xb7,
-				0xb9, 0xbb, 0xbc, 0xbf, 0xc1, 0xc1, 0xc2, 0xc4,
-				0xc7, 0xc8, 0xc9, 0xc9, 0xca, 0xcd, 0xcf, 0xd7,
-				0xda, 0xde, 0xe1, 0xe2, 0xe6, 0xe9, 0xec, 0xee,
-				0xee, 0xf0, 0xf7, 0xfd, 0xfe, 0xff,
-			},
-			{
-				0x00, 0x03, 0x08, 0x0b, 0x0d, 0x0e, 0x0f, 0x13,
-				0x15, 0x18, 0x1a, 0x1b, 0x1f, 0x22, 0x26, 0x28,
-				0x2a, 0x2c, 0x2d, 0x2f, 0x32, 0x32, 0x33, 0x34,
-				0x34, 0x37, 0x42, 0x44, 0x45, 0x47, 0x49, 0x4f,
-				0x52, 0x53, 0x56, 0x5a, 0x5a, 0x5b, 0x5f, 0x64,
-				0x66, 0x66, 0x67, 0x6b, 0x6c, 0x72, 0x79, 0x7b,
-				0x84, 0x89, 0x8c, 0x8d, 0x8e, 0x8f, 0x92, 0x95,
-				0xa0, 0xa2, 0xa3, 0xa7, 0xa8, 0xab, 0xaf, 0xaf,
-				0xb0, 0xb2, 0xb7, 0xba, 0xbe, 0xbf, 0xc5, 0xc6,
-				0xcd, 0xd1, 0xd5, 0xd5, 0xd9, 0xdb, 0xdd, 0xe3,
-				0xe4, 0xe5, 0xec, 0xf3, 0xfd, 0xfe,
-			},
-			{
-				0x00, 0x02, 0x04, 0x08, 0x0c, 0x0e, 0x11, 0x18,
-				0x1a, 0x1f, 0x21, 0x22, 0x25, 0x26, 0x27, 0x2d,
-				0x2f, 0x31, 0x31, 0x35, 0x39, 0x43, 0x49, 0x4e,
-				0x53, 0x54, 0x58, 0x5c, 0x5c, 0x5e, 0x61, 0x62,
-				0x63, 0x63, 0x64, 0x65, 0x67, 0x69, 0x6e, 0x70,
-				0x75, 0x7c, 0x87, 0x88, 0x88, 0x88, 0x8b, 0x8e,
-				0x8f, 0x90, 0x93, 0x95, 0xa0, 0xa5, 0xa8, 0xab,
-				0xac, 0xad, 0xae, 0xb1, 0xb2, 0xb4, 0xb5, 0xb5,
-				0xb6, 0xb7, 0xbb, 0xbc, 0xc0, 0xc2, 0xc7, 0xcc,
-				0xce, 0xd1, 0xdb, 0xdc, 0xdc, 0xdf, 0xe1, 0xe1,
-				0xec, 0xef, 0xf1, 0xf8, 0xf9, 0xfb,
-			},
-			{
-				0x03, 0x04, 0x06, 0x08, 0x0c, 0x10, 0x12, 0x18,
-				0x1b, 0x1c, 0x1c, 0x1e, 0x1f, 0x21, 0x23, 0x25,
-				0x29, 0x32, 0x33, 0x38, 0x3e, 0x3f, 0x41, 0x44,
-				0x45, 0x45, 0x46, 0x4a, 0x4c, 0x4d, 0x51, 0x55,
-				0x56, 0x5b, 0x62, 0x62, 0x69, 0x6a, 0x75, 0x77,
-				0x77, 0x78, 0x79, 0x79, 0x7d, 0x7f, 0x80, 0x83,
-				0x85, 0x86, 0x93, 0x95, 0x9a, 0x9d, 0x9d, 0x9e,
-				0xa1, 0xa5, 0xb1, 0xb3, 0xb3, 0xb8, 0xbe, 0xc3,
-				0xc4, 0xcb, 0xcc, 0xce, 0xd0, 0xd0, 0xd3, 0xd5,
-				0xd7, 0xda, 0xdd, 0xdf, 0xe8, 0xe8, 0xe9, 0xef,
-				0xf0, 0xf2, 0xf3, 0xf5, 0xfc, 0xff,
-			},
-			{
-				0x02, 0x07, 0x07, 0x0d, 0x0f, 0x10, 0x12, 0x19,
-				0x1d, 0x1d, 0x24, 0x25, 0x29, 0x2b, 0x2e, 0x30,
-				0x30, 0x36, 0x38, 0x3c, 0x3c, 0x40, 0x44, 0x46,
-				0x48, 0x48, 0x4b, 0x4d, 0x4e, 0x54, 0x55, 0x55,
-				0x56, 0x5f, 0x60, 0x6a, 0x6f, 0x74, 0x7b, 0x7e,
-				0x80, 0x81, 0x8a, 0x8c, 0x90, 0x91, 0x93, 0x94,
-				0x99, 0x99, 0x9b, 0x9f, 0xa2, 0xa4, 0xa7, 0xaa,
-				0xb4, 0xb8, 0xb9, 0xbd, 0xc2, 0xc3, 0xcf, 0xcf,
-				0xd2, 0xd4, 0xd6, 0xd8, 0xd9, 0xd9, 0xdb, 0xde,
-				0xe0, 0xe2, 0xe2, 0xe3, 0xe7, 0xe9, 0xeb, 0xed,
-				0xee, 0xf2, 0xf6, 0xf8, 0xfa, 0xfb,
-			},
-			{
-				0x01, 0x04, 0x05, 0x0a, 0x0d, 0x11, 0x15, 0x16,
-				0x16, 0x17, 0x1e, 0x20, 0x2e, 0x36, 0x37, 0x37,
-				0x39, 0x3a, 0x3b, 0x3c, 0x3d, 0x3f, 0x40, 0x47,
-				0x4a, 0x4b, 0x58, 0x60, 0x65, 0x66, 0x68, 0x6b,
-				0x6f, 0x70, 0x71, 0x71, 0x76, 0x7a, 0x7c, 0x7d,
-				0x7f, 0x81, 0x84, 0x8a, 0x8d, 0x91, 0x92, 0x97,
-				0x97, 0x98, 0x9a, 0x9e, 0x9e, 0xa1, 0xa3, 0xa5,
-				0xa6, 0xaa, 0xad, 0xb1, 0xb9, 0xba, 0xbb, 0xbd,
-				0xc6, 0xc6, 0xc8, 0xca, 0xca, 0xcb, 0xcd, 0xd2,
-				0xd3, 0xd4, 0xd7, 0xd8, 0xdc, 0xe6, 0xea, 0xeb,
-				0xeb, 0xf4, 0xf7, 0xf9, 0xfc, 0xff,
-			},
-		},
-
-		// Group 52
-		{
-			{
-				0x02, 0x08, 0x08, 0x09, 0x0b, 0x0e, 0x0f, 0x12,
-				0x13, 0x13, 0x14, 0x1c, 0x1d, 0x20, 0x22, 0x23,
-				0x2a, 0x2b, 0x2c, 0x2f, 0x30, 0x32, 0x34, 0x39,
-				0x3d, 0x44, 0x45, 0x45, 0x48, 0x49, 0x4a, 0x4b,
-				0x4e, 0x53, 0x57, 0x61, 0x62, 0x63, 0x67, 0x69,
-				0x6b, 0x6b, 0x6f, 0x75, 0x79, 0x79, 0x7a, 0x82,
-				0x8a, 0x8c, 0x8c, 0x98, 0x98, 0x99, 0x9c, 0x9e,
-				0xa2, 0xa7, 0xac, 0xac, 0xad, 0xb1, 0xb5, 0xba,
-				0xbc, 0xbe, 0xc0, 0xc6, 0xc6, 0xc8, 0xd0, 0xd1,
-				0xd1, 0xd2, 0xd8, 0xdb, 0xef, 0xf1, 0xf3, 0xf5,
-				0xf5, 0xf7, 0xf8, 0xf9, 0xfc, 0xfd,
-			},
-			{
-				0x04, 0x07, 0x0f, 0x10, 0x12, 0x16, 0x18, 0x1c,
-				0x1f, 0x22, 0x25, 0x26, 0x28, 0x2a, 0x31, 0x34,
-				0x3b, 0x3b, 0x3c, 0x3e, 0x40, 0x47, 0x50, 0x50,
-				0x55, 0x55, 0x56, 0x5d, 0x66, 0x6f, 0x70, 0x71,
-				0x72, 0x76, 0x77, 0x7c, 0x7c, 0x83, 0x87, 0x88,
-				0x8e, 0x8e, 0x90, 0x92, 0x95, 0x95, 0x96, 0x9a,
-				0x9e, 0xa0, 0xa6, 0xa7, 0xab, 0xae, 0xaf, 0xb2,
-				0xb8, 0xbf, 0xc3, 0xc4, 0xc5, 0xc9, 0xcb, 0xcc,
-				0xce, 0xd0, 0xd3, 0xd7, 0xdc, 0xdf, 0xe0, 0xe2,
-				0xe2, 0xe3, 0xe3, 0xe4, 0xe5, 0xe7, 0xe8, 0xea,
-				0xeb, 0xec, 0xf2, 0xfa, 0xfe, 0xff,
-			},
-			{
-				0x00, 0x01, 0x03, 0x07, 0x0b, 0x0c, 0x10, 0x11,
-				0x14, 0x16, 0x1b, 0x1d, 0x1e, 0x1e, 0x21, 0x22,
-				0x27, 0x28, 0x2d, 0x2e, 0x30, 0x33, 0x35, 0x35,
-				0x36, 0x37, 0x3a, 0x3d, 0x3f, 0x41, 0x43, 0x4b,
-				0x4f, 0x51, 0x52, 0x58, 0x59, 0x5b, 0x5d, 0x5e,
-				0x64, 0x68, 0x6d, 0x6e, 0x70, 0x71, 0x75, 0x7b,
-				0x81, 0x81, 0x83, 0x83, 0x86, 0x88, 0x8c, 0xa0,
-				0xa4, 0xaa, 0xab, 0xae, 0xb1, 0xb4, 0xb8, 0xba,
-				0xbb, 0xbd, 0xbe, 0xbf, 0xc3, 0xca, 0xca, 0xd4,
-				0xd6, 0xd6, 0xd9, 0xde, 0xde, 0xe0, 0xe1, 0xe7,
-				0xec, 0xed, 0xf1, 0xf4, 0xf4, 0xf7,
-			},
-			{
-				0x00, 0x01, 0x05, 0x08, 0x09, 0x0b, 0x0c, 0x0f,
-				0x12, 0x17, 0x1a, 0x20, 0x24, 0x29, 0x2b, 0x2f,
-				0x36, 0x37, 0x38, 0x38, 0x3a, 0x3e, 0x41, 0x47,
-				0x4e, 0x4f, 0x54, 0x54, 0x56, 0x5f, 0x60, 0x61,
-				0x61, 0x62, 0x67, 0x70, 0x71, 0x72, 0x74, 0x77,
-				0x78, 0x7e, 0x80, 0x80, 0x82, 0x84, 0x85, 0x8b,
-				0x90, 0x91, 0x93, 0x9d, 0x9f, 0xa1, 0xa6, 0xa7,
-				0xad, 0xaf, 0xb0, 0xb4, 0xb5, 0xb6, 0xb6, 0xc1,
-				0xc2, 0xc2, 0xc8, 0xcd, 0xce, 0xcf, 0xd1, 0xd2,
-				0xd7, 0xdd, 0xe4, 0xe5, 0xe9, 0xeb, 0xee, 0xf0,
-				0xf6, 0xf6, 0xfb, 0xfb, 0xff, 0xff,
-			},
-			{
-				0x03, 0x04, 0x05, 0x0a, 0x0c, 0x1a, 0x23, 0x25,
-				0x26, 0x29, 0x2d, 0x2f, 0x31, 0x39, 0x3c, 0x42,
-				0x44, 0x47, 0x4c, 0x4d, 0x4f, 0x57, 0x5a, 0x5a,
-				0x5b, 0x5c, 0x63, 0x66, 0x68, 0x69, 0x6a, 0x6c,
-				0x73, 0x74, 0x76, 0x7b, 0x7d, 0x7f, 0x87, 0x89,
-				0x8a, 0x92, 0x93, 0x97, 0x99, 0x9a, 0xa1, 0xa2,
-				0xa3, 0xa3, 0xa5, 0xa8, 0xa9, 0xa9, 0xab, 0xac,
-				0xad, 0xb0, 0xb1, 0xb9, 0xb9, 0xbb, 0xc0, 0xc5,
-				0xc9, 0xca, 0xd3, 0xd4, 0xd5, 0xd5, 0xd7, 0xda,
-				0xdc, 0xdd, 0xe1, 0xe3, 0xe6, 0xe9, 0xef, 0xf0,
-				0xf3, 0xf7, 0xf8, 0xfc, 0xfd, 0xfe,
-			},
-			{
-				0x00, 0x01, 0x03, 0x06, 0x0d, 0x0d, 0x0e, 0x11,
-				0x14, 0x15, 0x15, 0x19, 0x19, 0x1b, 0x24, 0x27,
-				0x2c, 0x2e, 0x31, 0x35, 0x39, 0x40, 0x42, 0x43,
-				0x45, 0x46, 0x46, 0x48, 0x49, 0x4a, 0x4c, 0x4d,
-				0x50, 0x57, 0x5c, 0x5f, 0x5f, 0x60, 0x65, 0x65,
-				0x68, 0x6a, 0x6b, 0x6e, 0x6f, 0x73, 0x73, 0x78,
-				0x7c, 0x7d, 0x84, 0x86, 0x89, 0x8d, 0x8f, 0x94,
-				0x94, 0x97, 0x9a, 0x9b, 0x9d, 0x9f, 0xa0, 0xa5,
-				0xa8, 0xb2, 0xb7, 0xbc, 0xbd, 0xbe, 0xc7, 0xc8,
-				0xcb, 0xcc, 0xcf, 0xd3, 0xd8, 0xda, 0xe4, 0xe5,
-				0xe8, 0xea, 0xeb, 0xee, 0xf2, 0xf9,
-			},
-			{
-				0x02, 0x06, 0x06, 0x0a, 0x0e, 0x13, 0x17, 0x18,
-				0x1c, 0x1f, 0x20, 0x21, 0x23, 0x28, 0x2a, 0x2c,
-				0x2e, 0x32, 0x33, 0x38, 0x3b, 0x3d, 0x3f, 0x43,
-				0x4c, 0x4e, 0x51, 0x52, 0x53, 0x54, 0x56, 0x58,
-				0x59, 0x5a, 0x5b, 0x5e, 0x5e, 0x62, 0x64, 0x69,
-				0x6c, 0x6d, 0x6d, 0x72, 0x79, 0x7a, 0x7e, 0x7e,
-				0x7f, 0x85, 0x88, 0x89, 0x8b, 0x8d, 0x8f, 0x91,
-				0x96, 0x96, 0x98, 0x9b, 0x9c, 0xa4, 0xa8, 0xaa,
-				0xae, 0xb0, 0xb3, 0xb3, 0xb4, 0xb7, 0xc1, 0xc4,
-				0xc7, 0xcd, 0xd2, 0xd9, 0xdb, 0xdf, 0xe6, 0xea,
-				0xed, 0xf3, 0xf4, 0xf6, 0xfa, 0xfe,
-			},
-		},
-
-		// Group 53
-		{
-			{
-				0x01, 0x04, 0x06, 0x07, 0x09, 0x0a, 0x0b, 0x10,
-				0x15, 0x1a, 0x1a, 0x1c, 0x21, 0x24, 0x32, 0x35,
-				0x39, 0x3b, 0x3c, 0x3e, 0x3f, 0x40, 0x44, 0x45,
-				0x45, 0x46, 0x51, 0x52, 0x53, 0x57, 0x5d, 0x5d,
-				0x5e, 0x5f, 0x5f, 0x67, 0x6a, 0x6b, 0x6d, 0x6e,
-				0x6e, 0x74, 0x75, 0x79, 0x7a, 0x7b, 0x7c, 0x7c,
-				0x7d, 0x7e, 0x86, 0x86, 0x8c, 0x8d, 0x96, 0x97,
-				0x99, 0x9b, 0x9f, 0xa2, 0xa4, 0xa5, 0xa6, 0xa6,
-				0xb1, 0xb2, 0xbd, 0xc1, 0xc2, 0xc8, 0xcc, 0xcd,
-				0xcf, 0xd9, 0xd9, 0xda, 0xdc, 0xde, 0xe1, 0xe1,
-				0xe2, 0xe2, 0xf0, 0xf8, 0xf9, 0xfe,
-			},
-			{
-				0x01, 0x02, 0x03, 0x04, 0x09, 0x09, 0x13, 0x17,
-				0x18, 0x18, 0x19, 0x1d, 0x1d, 0x1f, 0x20, 0x22,
-				0x25, 0x26, 0x26, 0x30, 0x31, 0x38, 0x39, 0x3b,
-				0x3e, 0x47, 0x48, 0x4b, 0x4e, 0x52, 0x54, 0x55,
-				0x56, 0x5b, 0x61, 0x64, 0x65, 0x69, 0x6b, 0x6c,
-				0x72, 0x75, 0x76, 0x77, 0x79, 0x7a, 0x7c, 0x82,
-				0x85, 0x8a, 0x8d, 0x8d, 0x8f, 0x93, 0x95, 0x96,
-				0x9a, 0x9a, 0x9c, 0x9f, 0xab, 0xab, 0xad, 0xaf,
-				0xb5, 0xb7, 0xbc, 0xbd, 0xbe, 0xbf, 0xc2, 0xcd,
-				0xd0, 0xd0, 0xe0, 0xe2, 0xe5, 0xe6, 0xe7, 0xea,
-				0xf0, 0xf3, 0xf4, 0xfa, 0xfb, 0xfd,
-			},
-			{
-				0x04, 0x08, 0x0b, 0x0c, 0x0d, 0x0e, 0x0e, 0x14,
-				0x19, 0x1c, 0x22, 0x23, 0x24, 0x24, 0x27, 0x2a,
-				0x2a, 0x2b, 0x2d, 0x35, 0x35, 0x41, 0x41, 0x4a,
-				0x4c, 0x58, 0x5b, 0x64, 0x6f, 0x74, 0x74, 0x7f,
-				0x80, 0x80, 0x82, 0x84, 0x88, 0x90, 0x91, 0x94,
-				0x99, 0x9c, 0x9c, 0x9d, 0xa1, 0xa2, 0xa3, 0xa6,
-				0xac, 0xac, 0xae, 0xb0, 0xb0, 0xb0, 0xb2, 0xb2,
-				0xb3, 0xb4, 0xb8, 0xb9, 0xbc, 0xc1, 0xc4, 0xc5,
-				0xc7, 0xcb, 0xcb, 0xce, 0xce, 0xd2, 0xd3, 0xd4,
-				0xd6, 0xda, 0xdb, 0xdf, 0xe3, 0xe9, 0xeb, 0xee,
-				0xf0, 0xf1, 0xf5, 0xf7, 0xfc, 0xff,
-			},
-			{
-				0x02, 0x02, 0x0a, 0x0f, 0x11, 0x12, 0x12, 0x16,
-				0x19, 0x1d, 0x1f, 0x21, 0x2b, 0x2e, 0x30, 0x32,
-				0x33, 0x34, 0x37, 0x3a, 0x3d, 0x44, 0x48, 0x4e,
-				0x4f, 0x51, 0x5e, 0x60, 0x61, 0x62, 0x66, 0x67,
-				0x69, 0x6a, 0x70, 0x72, 0x76, 0x78, 0x7b, 0x80,
-				0x83, 0x88, 0x89, 0x8c, 0x8c, 0x91, 0x92, 0x93,
-				0x97, 0x9b, 0xa0, 0xa3, 0xa4, 0xa7, 0xa7, 0xad,
-				0xae, 0xb3, 0xba, 0xc3, 0xc5, 0xc7, 0xc9, 0xcc,
-				0xd3, 0xd6, 0xd7, 0xda, 0xdf, 0xe4, 0xe5, 0xe6,
-				0xe7, 0xe8, 0xea, 0xeb, 0xec, 0xee, 0xef, 0xf2,
-				0xf4, 0xf5, 0xf6, 0xf7, 0xfc, 0xfe,
-			},
-			{
-				0x00, 0x00, 0x03, 0x05, 0x06, 0x07, 0x08, 0x0a,
-				0x0d, 0x0f, 0x0f, 0x1b, 0x1b, 0x26, 0x27, 0x29,
-				0x2c, 0x2d, 0x2f, 0x32, 0x34, 0x38, 0x3a, 0x3a,
-				0x3c, 0x3d, 0x3f, 0x46, 0x49, 0x4b, 0x52, 0x58,
-				0x5a, 0x65, 0x66, 0x68, 0x68, 0x6b, 0x6c, 0x6c,
-				0x6d, 0x71, 0x75, 0x79, 0x81, 0x83, 0x87, 0x89,
-				0x8f, 0x97, 0x98, 0x9d, 0x9e, 0xa0, 0xa1, 0xa8,
-				0xaa, 0xab, 0xad, 0xae, 0xaf, 0xb6, 0xb8, 0xb9,
-				0xba, 0xbd, 0xbe, 0xc0, 0xc5, 0xd5, 0xdb, 0xdc,
-				0xdc, 0xdd, 0xe3, 0xe7, 0xe8, 0xec, 0xed, 0xef,
-				0xf5, 0xf8, 0xf9, 0xfc, 0xfd, 0xff,
-			},
-			{
-				0x06, 0x0c, 0x11, 0x13, 0x14, 0x15, 0x17, 0x1e,
-				0x20, 0x20, 0x21, 0x23, 0x25, 0x28, 0x28, 0x2c,
-				0x34, 0x36, 0x36, 0x38, 0x42, 0x43, 0x45, 0x49,
-				0x4b, 0x4d, 0x4d, 0x4f, 0x50, 0x53, 0x54, 0x54,
-				0x59, 0x5c, 0x5c, 0x5c, 0x63, 0x63, 0x6f, 0x70,
-				0x71, 0x73, 0x78, 0x7d, 0x7e, 0x81, 0x81, 0x89,
-				0x8b, 0x94, 0x95, 0x98, 0xa5, 0xaa, 0xb1, 0xb4,
-				0xb5, 0xb6, 0xbb, 0xbf, 0xc0, 0xc3, 0xc3, 0xc4,
-				0xc6, 0xc6, 0xc8, 0xca, 0xcb, 0xcf, 0xd1, 0xd3,
-				0xd4, 0xd7, 0xd8, 0xd8, 0xdb, 0xde, 0xdf, 0xe0,
-				0xe4, 0xed, 0xf3, 0xf4, 0xf8, 0xfa,
-			},
-			{
-				0x05, 0x08, 0x10, 0x12, 0x15, 0x16, 0x17, 0x1b,
-				0x1e, 0x22, 0x29, 0x2b, 0x2e, 0x2f, 0x31, 0x33,
-				0x37, 0x40, 0x42, 0x43, 0x44, 0x46, 0x47, 0x4a,
-				0x4c, 0x4e, 0x4f, 0x50, 0x55, 0x56, 0x57, 0x59,
-				0x59, 0x5a, 0x60, 0x62, 0x65, 0x6d, 0x6e, 0x71,
-				0x73, 0x77, 0x77, 0x7e, 0x7f, 0x84, 0x85, 0x87,
-				0x88, 0x8a, 0x8b, 0x8e, 0x8e, 0x90, 0x90, 0x92,
-				0x95, 0x9b, 0x9e, 0xa1, 0xa3, 0xa5, 0xa7, 0xa8,
-				0xa9, 0xa9, 0xac, 0xb6, 0xb7, 0xbb, 0xc7, 0xc9,
-				0xca, 0xcc, 0xd1, 0xd2, 0xd5, 0xdd, 0xe1, 0xe9,
-				0xea, 0xf1, 0xf2, 0xf6, 0xfa, 0xfb,
-			},
-		},
-
-		// Group 54
-		{
-			{
-				0x00, 0x03, 0x04, 0x06, 0x08, 0x09, 0x0c, 0x0c,
-				0x0f, 0x10, 0x11, 0x11, 0x11, 0x19, 0x23, 0x24,
-				0x29, 0x2d, 0x36, 0x38, 0x3a, 0x3c, 0x3f, 0x42,
-				0x43, 0x43, 0x45, 0x48, 0x4a, 0x4c, 0x55, 0x5e,
-				0x67, 0x69, 0x6b, 0x70, 0x70, 0x73, 0x73, 0x77,
-				0x7a, 0x7c, 0x7e, 0x7f, 0x83, 0x8b, 0x8d, 0x8e,
-				0x8f, 0x92, 0x94, 0x96, 0x97, 0x99, 0x9c, 0x9d,
-				0xa4, 0xa8, 0xab, 0xaf, 0xaf, 0xb0, 0xb2, 0xb5,
-				0xb8, 0xb9, 0xba, 0xbc, 0xc3, 0xc5, 0xd1, 0xd6,
-				0xdb, 0xdf, 0xe0, 0xe8, 0xea, 0xeb, 0xec, 0xee,
-				0xef, 0xf0, 0xfe, 0xfe, 0xff, 0xff,
-			},
-			{
-				0x00, 0x09, 0x0c, 0x10, 0x12, 0x15, 0x1c, 0x1d,
-				0x1e, 0x20, 0x23, 0x25, 0x26, 0x2a, 0x2a, 0x2f,
-				0x31, 0x33, 0x37, 0x3a, 0x3b, 0x3c, 0x3c, 0x43,
-				0x4d, 0x50, 0x52, 0x53, 0x55, 0x59, 0x59, 0x5d,
-				0x5d, 0x64, 0x68, 0x68, 0x6b, 0x6d, 0x6f, 0x73,
-				0x76, 0x83, 0x89, 0x8a, 0x8a, 0x8d, 0x94, 0x95,
-				0x95, 0x97, 0x9a, 0x9f, 0xa1, 0xa8, 0xa8, 0xa9,
-				0xab, 0xac, 0xad, 0xae, 0xba, 0xbb, 0xc1, 0xc2,
-				0xc3, 0xc6, 0xca, 0xd4, 0xd6, 0xdc, 0xdd, 0xde,
-				0xde, 0xe3, 0xe4, 0xe4, 0xe6, 0xf1, 0xf1, 0xf5,
-				0xf8, 0xfa, 0xfa, 0xfb, 0xfd, 0xfd,
-			},
-			{
-				0x01, 0x0d, 0x13, 0x13, 0x15, 0x17, 0x1b, 0x24,
-				0x26, 0x2a, 0x2f, 0x2f, 0x31, 0x33, 0x35, 0x36,
-				0x37, 0x39, 0x3a, 0x3e, 0x40, 0x42, 0x45, 0x49,
-				0x4a, 0x4b, 0x4d, 0x4e, 0x4f, 0x51, 0x54, 0x56,
-				0x56, 0x57, 0x57, 0x58, 0x5c, 0x5e, 0x60, 0x63,
-				0x69, 0x6a, 0x6e, 0x74, 0x75, 0x78, 0x7c, 0x7d,
-				0x7e, 0x80, 0x80, 0x81, 0x82, 0x84, 0x85, 0x87,
-				0x88, 0x8c, 0x8c, 0x91, 0x93, 0x96, 0xa0, 0xa3,
-				0xa5, 0xa9, 0xaa, 0xaa, 0xb5, 0xc1, 0xc9, 0xca,
-				0xce, 0xd7, 0xda, 0xde, 0xe2, 0xe3, 0xe5, 0xe6,
-				0xe9, 0xee, 0xf2, 0xf6, 0xf9, 0xf9,
-			},
-			{
-				0x00, 0x01, 0x07, 0x0a, 0x0a, 0x0b, 0x0d, 0x0f,
-				0x12, 0x12, 0x16, 0x17, 0x1a, 0x1a, 0x1b, 0x20,
-				0x21, 0x22, 0x25, 0x29, 0x2b, 0x2d, 0x30, 0x37,
-				0x3b, 0x3f, 0x40, 0x44, 0x46, 0x4e, 0x4f, 0x50,
-				0x52, 0x53, 0x58, 0x5a, 0x5b, 0x5b, 0x5c, 0x5e,
-				0x60, 0x62, 0x63, 0x64, 0x64, 0x68, 0x6d, 0x6f,
-				0x75, 0x77, 0x79, 0x7a, 0x7b, 0x7b, 0x8d, 0x90,
-				0x90, 0x91, 0x92, 0x93, 0x9a, 0x9a, 0xa2, 0xa6,
-				0xa7, 0xa7, 0xad, 0xb3, 0xb4, 0xbb, 0xbd, 0xbe,
-				0xbf, 0xc3, 0xc8, 0xc9, 0xd4, 0xda, 0xe7, 0xeb,
-				0xed, 0xf5, 0xf6, 0xf7, 0xf9, 0xfd,
-			},
-			{
-				0x04, 0x05, 0x0e, 0x0e, 0x10, 0x14, 0x16, 0x18,
-				0x19, 0x19, 0x1f, 0x24, 0x27, 0x28, 0x2c, 0x30,
-				0x32, 0x35, 0x38, 0x39, 0x3d, 0x3f, 0x44, 0x44,
-				0x46, 0x47, 0x48, 0x49, 0x49, 0x4b, 0x4c, 0x55,
-				0x5a, 0x5b, 0x5f, 0x61, 0x6b, 0x6c, 0x72, 0x75,
-				0x78, 0x79, 0x7d, 0x7f, 0x81, 0x82, 0x85, 0x89,
-				0x90, 0x91, 0x9c, 0x9d, 0x9e, 0x9f, 0xa0, 0xa2,
-				0xa6, 0xab, 0xac, 0xb0, 0xb2, 0xb7, 0xbb, 0xbc,
-				0xc6, 0xc7, 0xcc, 0xce, 0xcf, 0xcf, 0xd0, 0xd2,
-				0xd3, 0xd8, 0xdd, 0xdf, 0xe2, 0xe8, 0xe9, 0xea,
-				0xed, 0xf0, 0xf1, 0xf4, 0xfc, 0xfc,
-			},
-			{
-				0x02, 0x02, 0x08, 0x0a, 0x13, 0x14, 0x15, 0x18,
-				0x1d, 0x1e, 0x21, 0x23, 0x2b, 0x2e, 0x33, 0x36,
-				0x3d, 0x3e, 0x41, 0x50, 0x51, 0x5f, 0x62, 0x62,
-				0x66, 0x6a, 0x6f, 0x72, 0x74, 0x78, 0x7d, 0x80,
-				0x87, 0x8b, 0x8e, 0x8e, 0x92, 0x93, 0x94, 0x95,
-				0x98, 0x99, 0x9b, 0x9f, 0xa0, 0xa1, 0xa3, 0xa4,
-				0xa5, 0xb1, 0xb2, 0xb4, 0xb6, 0xb7, 0xb8, 0xbd,
-				0xbe, 0xc5, 0xc6, 0xc7, 0xc8, 0xca, 0xcb, 0xcc,
-				0xcd, 0xce, 0xd1, 0xd2, 0xd2, 0xd5, 0xd7, 0xd9,
-				0xd9, 0xd9, 0xdb, 0xdf, 0xe0, 0xe1, 0xe1, 0xe3,
-				0xec, 0xef, 0xf2, 0xf3, 0xf6, 0xf7,
-			},
-			{
-				0x01, 0x02, 0x03, 0x05, 0x06, 0x07, 0x0b, 0x1c,
-				0x1f, 0x22, 0x27, 0x28, 0x2c, 0x2e, 0x32, 0x34,
-				0x34, 0x38, 0x3e, 0x41, 0x47, 0x4c, 0x4d, 0x4f,
-				0x51, 0x54, 0x61, 0x65, 0x65, 0x66, 0x66, 0x67,
-				0x6c, 0x6e, 0x71, 0x71, 0x76, 0x77, 0x7b, 0x7f,
-				0x83, 0x84, 0x86, 0x86, 0x88, 0x8c, 0x8f, 0x96,
-				0x98, 0x9b, 0x9b, 0x9e, 0xa9, 0xae, 0xb0, 0xb1,
-				0xb3, 0xb5, 0xb6, 0xb9, 0xba, 0xbd, 0xbf, 0xc0,
-				0xc0, 0xc2, 0xc4, 0xc4, 0xc7, 0xcb, 0xcd, 0xd0,
-				0xd3, 0xd5, 0xd6, 0xd8, 0xdc, 0xe5, 0xe7, 0xf2,
-				0xf3, 0xf4, 0xf8, 0xfa, 0xfb, 0xff,
-			},
-		},
-
-		// Group 55
-		{
-			{
-				0x03, 0x05, 0x08, 0x09, 0x0a, 0x1c, 0x21, 0x22,
-				0x24, 0x25, 0x2a, 0x2b, 0x2d, 0x2e, 0x37, 0x38,
-				0x3f, 0x43, 0x47, 0x4f, 0x52, 0x57, 0x59, 0x5a,
-				0x5c, 0x5d, 0x5f, 0x61, 0x65, 0x65, 0x66, 0x68,
-				0x69, 0x6d, 0x6e, 0x6f, 0x73, 0x7d, 0x7f, 0x81,
-				0x82, 0x83, 0x85, 0x87, 0x88, 0x8a, 0x90, 0x94,
-				0x95, 0x98, 0x9a, 0x9f, 0xa1, 0xa1, 0xa5, 0xa7,
-				0xa7, 0xb0, 0xb2, 0xb4, 0xb5, 0xb7, 0xb8, 0xb8,
-				0xb9, 0xbd, 0xbd, 0xc4, 0xc7, 0xc7, 0xca, 0xcf,
-				0xd3, 0xd6, 0xd7, 0xe1, 0xe3, 0xe3, 0xe8, 0xe9,
-				0xeb, 0xee, 0xef, 0xf1, 0xf5, 0xfe,
-			},
-			{
-				0x00, 0x0b, 0x0d, 0x0f, 0x10, 0x14, 0x15, 0x18,
-				0x1a, 0x1c, 0x1e, 0x1e, 0x27, 0x2a, 0x2c, 0x2f,
-				0x34, 0x37, 0x3c, 0x3c, 0x40, 0x45, 0x46, 0x4b,
-				0x51, 0x52, 0x54, 0x55, 0x5c, 0x61, 0x63, 0x6b,
-				0x6b, 0x6c, 0x6c, 0x6c, 0x74, 0x7e, 0x7f, 0x80,
-				0x84, 0x87, 0x89, 0x90, 0x92, 0x99, 0xa1, 0xa2,
-				0xa8, 0xa9, 0xbd, 0xbe, 0xc3, 0xc6, 0xc8, 0xc9,
-				0xca, 0xcc, 0xcd, 0xce, 0xd1, 0xd4, 0xd4, 0xd6,
-				0xd9, 0xda, 0xdb, 0xdb, 0xdc, 0xde, 0xdf, 0xe0,
-				0xe0, 0xe5, 0xe7, 0xed, 0xf2, 0xf2, 0xf2, 0xf3,
-				0xf4, 0xf7, 0xf9, 0xfb, 0xfb, 0xff,
-			},
-			{
-				0x00, 0x00, 0x03, 0x03, 0x04, 0x06, 0x0b, 0x0b,
-				0x11, 0x12, 0x13, 0x16, 0x17, 0x17, 0x24, 0x25,
-				0x2d, 0x31, 0x32, 0x33, 0x34, 0x35, 0x39, 0x3a,
-				0x3b, 0x41, 0x41, 0x44, 0x47, 0x4a, 0x4b, 0x4b,
-				0x4d, 0x4f, 0x55, 0x57, 0x5f, 0x60, 0x64, 0x67,
-				0x70, 0x76, 0x77, 0x79, 0x7a, 0x7b, 0x7c, 0x86,
-				0x8c, 0x93, 0x94, 0x96, 0x97, 0x98, 0x9a, 0x9b,
-				0x9c, 0x9d, 0x9f, 0xa0, 0xa3, 0xa3, 0xa5, 0xa6,
-				0xaa, 0xac, 0xb7, 0xba, 0xbb, 0xbf, 0xc1, 0xc3,
-				0xc5, 0xc8, 0xcd, 0xcf, 0xd5, 0xe3, 0xe8, 0xf1,
-				0xf4, 0xf6, 0xf6, 0xfa, 0xfc, 0xfd,
-			},
-			{
-				0x02, 0x07, 0x0a, 0x0c, 0x0f, 0x10, 0x13, 0x14,
-				0x1d, 0x1d, 0x1f, 0x27, 0x28, 0x2d, 0x36, 0x3a,
-				0x3b, 0x40, 0x41, 0x42, 0x42, 0x45, 0x46, 0x47,
-				0x48, 0x49, 0x49, 0x4c, 0x4e, 0x50, 0x58, 0x5a,
-				0x5b, 0x5d, 0x5e, 0x5e, 0x63, 0x66, 0x68, 0x69,
-				0x6a, 0x6d, 0x70, 0x74, 0x75, 0x76, 0x7e, 0x85,
-				0x88, 0x8b, 0x92, 0x93, 0x97, 0xa2, 0xa4, 0xa5,
-				0xa6, 0xab, 0xae, 0xb2, 0xb3, 0xb3, 0xbb, 0xc0,
-				0xc2, 0xc5, 0xc6, 0xc9, 0xcb, 0xce, 0xce, 0xd0,
-				0xd1, 0xd3, 0xd3, 0xda, 0xde, 0xe5, 0xe6, 0xea,
-				0xee, 0xf1, 0xf8, 0xfa, 0xfc, 0xff,
-			},
-			{
-				0x01, 0x08, 0x09, 0x0c, 0x10, 0x11, 0x13, 0x17,
-				0x19, 0x1d, 0x1f, 0x20, 0x21, 0x23, 0x26, 0x28,
-				0x29, 0x2c, 0x2f, 0x32, 0x33, 0x34, 0x36, 0x3e,
-				0x3f, 0x44, 0x45, 0x46, 0x53, 0x54, 0x56, 0x5f,
-				0x61, 0x62, 0x6a, 0x6e, 0x74, 0x76, 0x78, 0x79,
-				0x7c, 0x7f, 0x81, 0x82, 0x83, 0x84, 0x87, 0x8b,
-				0x8d, 0x8d, 0x8f, 0x91, 0x96, 0x97, 0x9b, 0x9d,
-				0x9e, 0x9e, 0xa0, 0xa3, 0xa9, 0xad, 0xae, 0xaf,
-				0xaf, 0xb1, 0xb1, 0xb6, 0xb6, 0xbc, 0xcb, 0xd0,
-				0xd0, 0xd8, 0xd8, 0xd9, 0xdb, 0xe1, 0xe2, 0xe4,
-				0xe4, 0xed, 0xf4, 0xf5, 0xf7, 0xf9,
-			},
-			{
-				0x01, 0x01, 0x04, 0x05, 0x0d, 0x0e, 0x15, 0x16,
-				0x1a, 0x1b, 0x1e, 0x20, 0x26, 0x29, 0x2b, 0x30,
-				0x31, 0x37, 0x38, 0x38, 0x39, 0x3d, 0x43, 0x48,
-				0x4c, 0x4e, 0x56, 0x58, 0x5b, 0x60, 0x62, 0x63,
-				0x67, 0x6f, 0x70, 0x71, 0x71, 0x73, 0x75, 0x77,
-				0x7b, 0x80, 0x81, 0x82, 0x86, 0x89, 0x8b, 0x8e,
-				0x8f, 0x90, 0x91, 0x94, 0x99, 0xa4, 0xa4, 0xa7,
-				0xa8, 0xaa, 0xab, 0xac, 0xad, 0xae, 0xb0, 0xb5,
-				0xb9, 0xb9, 0xba, 0xbe, 0xbe, 0xbf, 0xc1, 0xc3,
-				0xcc, 0xcf, 0xd2, 0xda, 0xdd, 0xdf, 0xe0, 0xe2,
-				0xe4, 0xe6, 0xeb, 0xf0, 0xf3, 0xf7,
-			},
-			{
-				0x02, 0x02, 0x04, 0x06, 0x07, 0x07, 0x09, 0x0a,
-				0x0d, 0x0e, 0x12, 0x14, 0x16, 0x18, 0x19, 0x1b,
-				0x22, 0x23, 0x25, 0x2a, 0x2e, 0x2f, 0x30, 0x35,
-				0x36, 0x3d, 0x3e, 0x49, 0x4a, 0x4d, 0x4f, 0x50,
-				0x51, 0x53, 0x59, 0x5d, 0x5e, 0x64, 0x65, 0x67,
-				0x6d, 0x6f, 0x71, 0x72, 0x72, 0x78, 0x7a, 0x7d,
-				0x8a, 0x8c, 0x8d, 0x8e, 0x95, 0x9c, 0xa2, 0xa9,
-				0xac, 0xad, 0xb4, 0xb5, 0xbc, 0xbf, 0xc0, 0xc2,
-				0xc4, 0xcb, 0xd2, 0xd5, 0xd7, 0xdc, 0xdd, 0xdf,
-				0xe5, 0xe7, 0xe9, 0xea, 0xec, 0xec, 0xed, 0xef,
-				0xf0, 0xf0, 0xf8, 0xfd, 0xfe, 0xfe,
-			},
-		},
-
-		// Group 56
-		{
-			{
-				0x02, 0x07, 0x09, 0x10, 0x11, 0x13, 0x14, 0x14,
-				0x16, 0x19, 0x1e, 0x22, 0x24, 0x26, 0x2a, 0x2c,
-				0x2f, 0x30, 0x32, 0x3b, 0x42, 0x43, 0x45, 0x48,
-				0x4a, 0x4c, 0x4d, 0x51, 0x54, 0x5c, 0x5d, 0x5e,
-				0x5f, 0x60, 0x61, 0x67, 0x6a, 0x6d, 0x6e, 0x71,
-				0x73, 0x77, 0x77, 0x79, 0x7d, 0x7f, 0x7f, 0x80,
-				0x81, 0x86, 0x8f, 0x97, 0x98, 0x98, 0xa3, 0xa3,
-				0xa7, 0xb0, 0xb0, 0xb1, 0xb1, 0xb2, 0xb6, 0xb7,
-				0xbd, 0xc6, 0xc7, 0xc9, 0xcc, 0xd2, 0xd7, 0xdc,
-				0xdf, 0xe1, 0xe1, 0xe2, 0xe4, 0xe6, 0xea, 0xee,
-				0xf1, 0xf2, 0xf3, 0xfd, 0xfe, 0xff,
-			},
-			{
-				0x01, 0x04, 0x0a, 0x0f, 0x11, 0x13, 0x19, 0x1c,
-				0x23, 0x2f, 0x35, 0x37, 0x40, 0x40, 0x41, 0x47,
-				0x4f, 0x4f, 0x51, 0x51, 0x55, 0x56, 0x56, 0x58,
-				0x58, 0x59, 0x5b, 0x5d, 0x5e, 0x60, 0x62, 0x63,
-				0x69, 0x6a, 0x6b, 0x72, 0x75, 0x75, 0x78, 0x7c,
-				0x7e, 0x83, 0x83, 0x8b, 0x90, 0x93, 0x94, 0x9a,
-				0x9d, 0x9e, 0xa0, 0xa0, 0xa2, 0xa2, 0xa4, 0xa5,
-				0xa6, 0xa8, 0xaa, 0xad, 0xad, 0xae, 0xb3, 0xb6,
-				0xbe, 0xbf, 0xc4, 0xc5, 0xc5, 0xcd, 0xce, 0xd2,
-				0xd4, 0xda, 0xde, 0xf6, 0xf6, 0xf7, 0xf8, 0xf8,
-				0xfb, 0xfc, 0xfd, 0xfe, 0xfe, 0xff,
-			},
-			{
-				0x00, 0x02, 0x05, 0x06, 0x0a, 0x0c, 0x0c, 0x0d,
-				0x10, 0x17, 0x18, 0x1a, 0x1e, 0x21, 0x21, 0x2a,
-				0x2d, 0x2e, 0x33, 0x36, 0x37, 0x37, 0x39, 0x3d,
-				0x41, 0x49, 0x50, 0x53, 0x53, 0x5a, 0x5b, 0x61,
-				0x62, 0x6c, 0x70, 0x76, 0x78, 0x79, 0x7f, 0x83,
-				0x87, 0x88, 0x88, 0x89, 0x8b, 0x8f, 0x99, 0x9a,
-				0x9f, 0xa1, 0xa4, 0xa7, 0xa9, 0xaf, 0xb7, 0xba,
-				0xbb, 0xc0, 0xc3, 0xc3, 0xc3, 0xcf, 0xd2, 0xd3,
-				0xd4, 0xd5, 0xd8, 0xdb, 0xdc, 0xdf, 0xe0, 0xe1,
-				0xe2, 0xe3, 0xe6, 0xe8, 0xe9, 0xec, 0xed, 0xed,
-				0xf0, 0xf1, 0xf4, 0xf5, 0xf7, 0xfb,
-			},
-			{
-				0x03, 0x04, 0x05, 0x0c, 0x0e, 0x12, 0x15, 0x19,
-				0x1a, 0x1b, 0x1d, 0x28, 0x28, 0x29, 0x2a, 0x2b,
-				0x2b, 0x33, 0x34, 0x38, 0x38, 0x38, 0x3d, 0x43,
-				0x46, 0x47, 0x4b, 0x4e, 0x5c, 0x5c, 0x63, 0x64,
-				0x66, 0x6b, 0x6c, 0x6e, 0x6f, 0x71, 0x73, 0x76,
-				0x79, 0x7c, 0x82, 0x86, 0x8d, 0x8d, 0x90, 0x91,
-				0x92, 0x92, 0x96, 0x9a, 0x9c, 0x9c, 0x9d, 0x9f,
-				0xa3, 0xa5, 0xa8, 0xae, 0xb1, 0xb3, 0xb8, 0xbb,
-				0xbe, 0xc0, 0xcb, 0xce, 0xd1, 0xd6, 0xd7, 0xd8,
-				0xd9, 0xdf, 0xe8, 0xe9, 0xeb, 0xee, 0xef, 0xf0,
-				0xf1, 0xf3, 0xf5, 0xf9, 0xfa, 0xfc,
-			},
-			{
-				0x00, 0x01, 0x06, 0x08, 0x0b, 0x0d, 0x0f, 0x12,
-				0x17, 0x1b, 0x1d, 0x1f, 0x20, 0x21, 0x25, 0x25,
-				0x31, 0x3a, 0x3b, 0x3c, 0x3e, 0x42, 0x44, 0x48,
-				0x4d, 0x50, 0x52, 0x53, 0x54, 0x59, 0x5a, 0x60,
-				0x66, 0x69, 0x6d, 0x6e, 0x6f, 0x72, 0x74, 0x7a,
-				0x7a, 0x7b, 0x7d, 0x80, 0x82, 0x85, 0x87, 0x88,
-				0x8c, 0x8d, 0x8e, 0x94, 0x95, 0x96, 0x99, 0x9c,
-				0xa1, 0xa2, 0xa7, 0xab, 0xb2, 0xb7, 0xb8, 0xb9,
-				0xc2, 0xc4, 0xc6, 0xc7, 0xc9, 0xca, 0xcb, 0xd0,
-				0xd3, 0xd5, 0xd9, 0xd9, 0xdb, 0xdd, 0xe2, 0xe5,
-				0xea, 0xeb, 0xec, 0xed, 0xee, 0xfa,
-			},
-			{
-				0x01, 0x04, 0x09, 0x0e, 0x0e, 0x13, 0x16, 0x16,
-				0x18, 0x1c, 0x1c, 0x1f, 0x20, 0x22, 0x23, 0x27,
-				0x27, 0x2c, 0x2d, 0x2e, 0x31, 0x35, 0x3a, 0x3b,
-				0x3f, 0x40, 0x49, 0x4a, 0x4b, 0x4c, 0x4f, 0x50,
-				0x52, 0x55, 0x57, 0x5b, 0x5f, 0x61, 0x62, 0x68,
-				0x6c, 0x72, 0x73, 0x7e, 0x81, 0x82, 0x8b, 0x8c,
-				0x8e, 0x8f, 0x91, 0x93, 0x93, 0x95, 0x9b, 0x9e,
-				0x9f, 0xa8, 0xaa, 0xb4, 0xb5, 0xba, 0xbc, 0xbc,
-				0xbd, 0xc2, 0xca, 0xcb, 0xcd, 0xcf, 0xd0, 0xd1,
-				0xd3, 0xd6, 0xdd, 0xe5, 0xe7, 0xe7, 0xe8, 0xeb,
-				0xef, 0xf2, 0xf3, 0xf5, 0xf9, 0xfc,
-			},
-			{
-				0x03, 0x06, 0x07, 0x08, 0x0b, 0x15, 0x1f, 0x24,
-				0x26, 0x27, 0x29, 0x2c, 0x2f, 0x30, 0x32, 0x34,
-				0x36, 0x39, 0x3c, 0x3e, 0x3f, 0x44, 0x45, 0x46,
-				0x46, 0x49, 0x4a, 0x4e, 0x52, 0x57, 0x64, 0x65,
-				0x65, 0x67, 0x68, 0x6d, 0x70, 0x74, 0x75, 0x7b,
-				0x80, 0x84, 0x84, 0x85, 0x86, 0x89, 0x8a, 0x8a,
-				0x97, 0x9b, 0x9d, 0xa4, 0xa6, 0xa9, 0xab, 0xac,
-				0xac, 0xaf, 0xb4, 0xb5, 0xb6, 0xb9, 0xbd, 0xbf,
-				0xc1, 0xc1, 0xc1, 0xc2, 0xc6, 0xc8, 0xc8, 0xcc,
-				0xcd, 0xd7, 0xda, 0xda, 0xdb, 0xde, 0xe0, 0xe3,
-				0xe4, 0xe5, 0xf4, 0xf9, 0xfa, 0xfb,
-			},
-		},
-
-		// Group 57
-		{
-			{
-				0x02, 0x02, 0x03, 0x08, 0x11, 0x19, 0x1c, 0x22,
-				0x24, 0x28, 0x2e, 0x37, 0x39, 0x39, 0x40, 0x41,
-				0x43, 0x43, 0x46, 0x46, 0x49, 0x4b, 0x4d, 0x55,
-				0x56, 0x57, 0x5e, 0x5f, 0x68, 0x68, 0x69, 0x6b,
-				0x6b, 0x71, 0x71, 0x74, 0x78, 0x7d, 0x86, 0x88,
-				0x8a, 0x8b, 0x8e, 0x8f, 0x8f, 0x90, 0x92, 0x92,
-				0x95, 0x95, 0x98, 0x9a, 0x9c, 0x9d, 0x9e, 0xa0,
-				0xac, 0xad, 0xad, 0xaf, 0xb0, 0xb3, 0xb6, 0xb6,
-				0xb6, 0xbd, 0xc0, 0xc1, 0xc3, 0xc4, 0xcd, 0xcf,
-				0xd4, 0xd7, 0xda, 0xe2, 0xe6, 0xe7, 0xe9, 0xe9,
-				0xf0, 0xf5, 0xf6, 0xfc, 0xfe, 0xfe,
-			},
-			{
-				0x04, 0x05, 0x0a, 0x0b, 0x0b, 0x10, 0x12, 0x13,
-				0x16, 0x17, 0x1b, 0x22, 0x25, 0x29, 0x2b, 0x2b,
-				0x36, 0x38, 0x3d, 0x3e, 0x4d, 0x4e, 0x54, 0x55,
-				0x55, 0x57, 0x5c, 0x60, 0x65, 0x67, 0x6d, 0x6e,
-				0x6e, 0x6f, 0x6f, 0x77, 0x7a, 0x7b, 0x7d, 0x80,
-				0x85, 0x87, 0x89, 0x8b, 0x8d, 0x8d, 0x93, 0x94,
-				0x9e, 0x9f, 0xa0, 0xa4, 0xa7, 0xa8, 0xa9, 0xae,
-				0xb0, 0xb1, 0xb2, 0xb5, 0xba, 0xbb, 0xbb, 0xbd,
-				0xc5, 0xc6, 0xc9, 0xca, 0xcb, 0xce, 0xcf, 0xd0,
-				0xd2, 0xd4, 0xd6, 0xdf, 0xe0, 0xe8, 0xea, 0xeb,
-				0xec, 0xf1, 0xf2, 0xf4, 0xf8, 0xfd,
-			},
-			{
-				0x00, 0x06, 0x08, 0x0b, 0x0c, 0x0e, 0x11, 0x16,
-				0x1a, 0x1c, 0x21, 0x27, 0x2f, 0x32, 0x32, 0x37,
-				0x3c, 0x3c, 0x3f, 0x42, 0x48, 0x4a, 0x4e, 0x4e,
-				0x4f, 0x50, 0x52, 0x53, 0x5d, 0x61, 0x62, 0x6b,
-				0x70, 0x72, 0x7e, 0x80, 0x82, 0x84, 0x86, 0x8c,
-				0x93, 0x94, 0x96, 0x9b, 0x9b, 0xa5, 0xa6, 0xa6,
-				0xa8, 0xa9, 0xb0, 0xb1, 0xb2, 0xb4, 0xb8, 0xbb,
-				0xbd, 0xbf, 0xcb, 0xcc, 0xcd, 0xcd, 0xce, 0xd3,
-				0xd3, 0xd3, 0xd5, 0xd5, 0xd7, 0xd8, 0xdc, 0xdd,
-				0xdf, 0xe0, 0xe4, 0xe5, 0xea, 0xed, 0xef, 0xf1,
-				0xf2, 0xf4, 0xf6, 0xf9, 0xfb, 0xfb,
-			},
-			{
-				0x01, 0x01, 0x07, 0x09, 0x0e, 0x0f, 0x12, 0x14,
-				0x15, 0x18, 0x18, 0x1d, 0x1f, 0x27, 0x2a, 0x2e,
-				0x30, 0x34, 0x37, 0x3b, 0x41, 0x47, 0x49, 0x4a,
-				0x58, 0x5b, 0x5b, 0x5c, 0x5d, 0x60, 0x64, 0x66,
-				0x67, 0x69, 0x6c, 0x6d, 0x72, 0x73, 0x75, 0x76,
-				0x79, 0x79, 0x7a, 0x7e, 0x7f, 0x7f, 0x82, 0x82,
-				0x83, 0x83, 0x88, 0x89, 0x8a, 0x8b, 0x8c, 0x8e,
-				0x9a, 0x9d, 0xa1, 0xa8, 0xac, 0xb8, 0xba, 0xbc,
-				0xbe, 0xc1, 0xc4, 0xc8, 0xc9, 0xd9, 0xd9, 0xda,
-				0xdc, 0xe0, 0xe8, 0xec, 0xed, 0xee, 0xef, 0xf0,
-				0xf3, 0xf4, 0xf7, 0xf9, 0xfa, 0xff,
-			},
-			{
-				0x09, 0x0c, 0x0d, 0x0d, 0x0d, 0x14, 0x17, 0x1d,
-				0x20, 0x23, 0x25, 0x2c, 0x35, 0x39, 0x3a, 0x3a,
-				0x3c, 0x3e, 0x3f, 0x40, 0x40, 0x45, 0x47, 0x4b,
-				0x4c, 0x4c, 0x53, 0x54, 0x56, 0x59, 0x5a, 0x5e,
-				0x61, 0x62, 0x64, 0x66, 0x67, 0x6a, 0x70, 0x74,
-				0x76, 0x7c, 0x7d, 0x81, 0x88, 0x90, 0x90, 0x91,
-				0x99, 0x9b, 0xa0, 0xa2, 0xa3, 0xa3, 0xa7, 0xaa,
-				0xab, 0xad, 0xae, 0xb3, 0xb9, 0xb9, 0xbc, 0xbe,
-				0xc1, 0xc2, 0xc4, 0xcf, 0xd1, 0xd8, 0xdc, 0xde,
-				0xe1, 0xe2, 0xe3, 0xe3, 0xe4, 0xe6, 0xe7, 0xe9,
-				0xec, 0xee, 0xf9, 0xfc, 0xff, 0xff,
-			},
-			{
-				0x03, 0x04, 0x05, 0x06, 0x07, 0x09, 0x0e, 0x0f,
-				0x12, 0x13, 0x16, 0x19, 0x1a, 0x1b, 0x1e, 0x1f,
-				0x20, 0x22, 0x23, 0x24, 0x24, 0x25, 0x31, 0x33,
-				0x34, 0x3e, 0x3f, 0x44, 0x47, 0x4b, 0x4f, 0x50,
-				0x51, 0x52, 0x52, 0x58, 0x5a, 0x5c, 0x5d, 0x63,
-				0x63, 0x68, 0x69, 0x6c, 0x73, 0x75, 0x7b, 0x81,
-				0x84, 0x87, 0xa2, 0xa4, 0xa5, 0xa7, 0xa9, 0xaa,
-				0xaf, 0xb2, 0xb3, 0xb7, 0xba, 0xc0, 0xc2, 0xc3,
-				0xc6, 0xc8, 0xc9, 0xcb, 0xcc, 0xce, 0xd0, 0xd1,
-				0xd2, 0xd6, 0xdb, 0xdb, 0xdd, 0xde, 0xea, 0xeb,
-				0xf2, 0xf3, 0xf7, 0xfc, 0xfd, 0xfd,
-			},
-			{
-				0x00, 0x02, 0x0a, 0x10, 0x13, 0x15, 0x1e, 0x1f,
-				0x21, 0x26, 0x26, 0x28, 0x29, 0x2a, 0x2c, 0x2d,
-				0x2d, 0x2d, 0x2f, 0x30, 0x31, 0x33, 0x35, 0x35,
-				0x36, 0x36, 0x38, 0x3a, 0x3b, 0x3d, 0x42, 0x44,
-				0x45, 0x48, 0x51, 0x54, 0x59, 0x5f, 0x65, 0x6a,
-				0x73, 0x74, 0x75, 0x77, 0x78, 0x7c, 0x7e, 0x85,
-				0x87, 0x8c, 0x8f, 0x91, 0x94, 0x96, 0x97, 0x97,
-				0x98, 0x99, 0x9a, 0x9c, 0x9f, 0xa1, 0xa6, 0xab,
-				0xaf, 0xb4, 0xb5, 0xb7, 0xb8, 0xbf, 0xc0, 0xc5,
-				0xc7, 0xc7, 0xca, 0xd2, 0xd8, 0xe1, 0xe3, 0xe5,
-				0xe8, 0xeb, 0xf3, 0xf5, 0xf8, 0xfa,
-			},
-		},
-
-		// Group 58
-		{
-			{
-				0x04, 0x0d, 0x11, 0x12, 0x15, 0x1d, 0x20, 0x24,
-				0x24, 0x25, 0x26, 0x28, 0x29, 0x2d, 0x2e, 0x2f,
-				0x32, 0x34, 0x37, 0x3a, 0x3a, 0x43, 0x43, 0x46,
-				0x47, 0x4b, 0x4c, 0x4f, 0x52, 0x55, 0x5d, 0x62,
-				0x6a, 0x6d, 0x6e, 0x70, 0x71, 0x75, 0x77, 0x78,
-				0x7a, 0x7c, 0x7e, 0x7f, 0x81, 0x86, 0x89, 0x8f,
-				0x96, 0x9a, 0xa0, 0xa2, 0xa3, 0xab, 0xad, 0xb5,
-				0xb9, 0xba, 0xbe, 0xc0, 0xc1, 0xc3, 0xc5, 0xc5,
-				0xc9, 0xcb, 0xcc, 0xce, 0xd1, 0xd2, 0xd6, 0xdb,
-				0xdb, 0xde, 0xe1, 0xe3, 0xe8, 0xeb, 0xf0, 0xf3,
-				0xf6, 0xf8, 0xfb, 0xfc, 0xfc, 0xfd,
-			},
-			{
-				0x00, 0x01, 0x06, 0x07, 0x07, 0x08, 0x0b, 0x0e,
-				0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x27,
-				0x2a, 0x2c, 0x2f, 0x33, 0x35, 0x39, 0x3c, 0x3d,
-				0x3e, 0x3e, 0x48, 0x49, 0x4b, 0x4d, 0x53, 0x54,
-				0x57, 0x5b, 0x5b, 0x5e, 0x5e, 0x5e, 0x5f, 0x62,
-				0x64, 0x65, 0x6a, 0x7b, 0x7d, 0x82, 0x84, 0x8c,
-				0x8e, 0x90, 0x90, 0x97, 0x9c, 0xa0, 0xa3, 0xa5,
-				0xa8, 0xae, 0xb2, 0xb2, 0xb3, 0xb3, 0xb4, 0xb7,
-				0xba, 0xbd, 0xbf, 0xc2, 0xc4, 0xc8, 0xc8, 0xc9,
-				0xcf, 0xd1, 0xd1, 0xd3, 0xd6, 0xd7, 0xdc, 0xdd,
-				0xe7, 0xe9, 0xec, 0xec, 0xfb, 0xff,
-			},
-			{
-				0x02, 0x05, 0x09, 0x0f, 0x13, 0x16, 0x17, 0x19,
-				0x1f, 0x20, 0x24, 0x2d, 0x30, 0x33, 0x36, 0x3b,
-				0x3f, 0x3f, 0x42, 0x45, 0x46, 0x4e, 0x4e, 0x50,
-				0x52, 0x59, 0x64, 0x67, 0x69, 0x6a, 0x6c, 0x6d,
-				0x70, 0x72, 0x72, 0x77, 0x79, 0x80, 0x83, 0x84,
-				0x86, 0x88, 0x88, 0x89, 0x8a, 0x8a, 0x93, 0x93,
-				0x98, 0x99, 0x9b, 0x9b, 0x9d, 0x9e, 0xa2, 0xa4,
-				0xa6, 0xa7, 0xa8, 0xa9, 0xaf, 0xb1, 0xb2, 0xbc,
-				0xbe, 0xc2, 0xc7, 0xc8, 0xcb, 0xcc, 0xd2, 0xd4,
-				0xd5, 0xd9, 0xdd, 0xdf, 0xe0, 0xe3, 0xe4, 0xe6,
-				0xed, 0xf1, 0xf2, 0xf4, 0xf6, 0xf9,
-			},
-			{
-				0x02, 0x08, 0x09, 0x0b, 0x0c, 0x0d, 0x10, 0x18,
-				0x1a, 0x1a, 0x1b, 0x1b, 0x1e, 0x1e, 0x1f, 0x1f,
-				0x23, 0x26, 0x26, 0x2b, 0x2b, 0x36, 0x37, 0x39,
-				0x3f, 0x42, 0x44, 0x47, 0x4a, 0x4f, 0x55, 0x56,
-				0x58, 0x5b, 0x5c, 0x5f, 0x65, 0x67, 0x6b, 0x6e,
-				0x6f, 0x70, 0x71, 0x74, 0x75, 0x76, 0x78, 0x7a,
-				0x7d, 0x7f, 0x80, 0x8b, 0x8b, 0x8d, 0x8d, 0x91,
-				0x93, 0x95, 0x9c, 0x9f, 0x9f, 0xa4, 0xa5, 0xaa,
-				0xac, 0xac, 0xad, 0xae, 0xb0, 0xb1, 0xb5, 0xc2,
-				0xc3, 0xc6, 0xc7, 0xcf, 0xd0, 0xd8, 0xd8, 0xda,
-				0xde, 0xe2, 0xe2, 0xe4, 0xf3, 0xf7,
-			},
-			{
-				0x01, 0x03, 0x03, 0x04, 0x08, 0x0a, 0x0e, 0x0e,
-				0x11, 0x15, 0x16, 0x17, 0x18, 0x21, 0x30, 0x31,
-				0x32, 0x33, 0x38, 0x3e, 0x40, 0x44, 0x45, 0x49,
-				0x4c, 0x4f, 0x50, 0x51, 0x51, 0x54, 0x5c, 0x66,
-				0x66, 0x68, 0x68, 0x69, 0x6b, 0x6b, 0x6f, 0x74,
-				0x77, 0x79, 0x7c, 0x7e, 0x83, 0x85, 0x87, 0x8a,
-				0x8c, 0x8e, 0x8f, 0x8f, 0x94, 0x95, 0x97, 0x99,
-				0x9c, 0x9d, 0xa1, 0xa4, 0xa7, 0xab, 0xac, 0xb0,
-				0xb3, 0xb6, 0xb7, 0xbb, 0xc4, 0xc6, 0xd3, 0xd4,
-				0xdb, 0xdc, 0xdd, 0xde, 0xe0, 0xe5, 0xee, 0xef,
-				0xef, 0xf2, 0xf3, 0xf7, 0xfa, 0xfa,
-			},
-			{
-				0x00, 0x05, 0x0a, 0x0f, 0x13, 0x14, 0x14, 0x21,
-				0x22, 0x29, 0x29, 0x2a, 0x31, 0x38, 0x3b, 0x3c,
-				0x41, 0x41, 0x42, 0x45, 0x46, 0x48, 0x49, 0x4b,
-				0x53, 0x54, 0x56, 0x57, 0x5a, 0x5a, 0x5a, 0x5d,
-				0x5d, 0x60, 0x61, 0x61, 0x6c, 0x6f, 0x73, 0x73,
-				0x76, 0x79, 0x7b, 0x81, 0x82, 0x84, 0x85, 0x87,
-				0x91, 0x94, 0x95, 0x9d, 0x9e, 0xa6, 0xa9, 0xaa,
-				0xb4, 0xb6, 0xb8, 0xb9, 0xba, 0xbb, 0xbc, 0xbd,
-				0xbf, 0xc4, 0xc6, 0xca, 0xca, 0xcd, 0xd0, 0xd5,
-				0xd9, 0xda, 0xdf, 0xe1, 0xe5, 0xe7, 0xe8, 0xea,
-				0xed, 0xee, 0xf1, 0xf8, 0xf9, 0xfc,
-			},
-			{
-				0x00, 0x05, 0x06, 0x06, 0x07, 0x0c, 0x0d, 0x10,
-				0x12, 0x15, 0x1c, 0x21, 0x22, 0x23, 0x23, 0x25,
-				0x27, 0x28, 0x2a, 0x2c, 0x2e, 0x31, 0x34, 0x34,
-				0x35, 0x3a, 0x3d, 0x40, 0x41, 0x4a, 0x4d, 0x50,
-				0x51, 0x56, 0x57, 0x58, 0x59, 0x59, 0x5f, 0x60,
-				0x60, 0x63, 0x63, 0x6e, 0x73, 0x7e, 0x92, 0x92,
-				0x96, 0x98, 0x9a, 0x9e, 0x9f, 0xa1, 0xa7, 0xaf,
-				0xb6, 0xb8, 0xbd, 0xc0, 0xc1, 0xc1, 0xcb, 0xcd,
-				0xce, 0xd0, 0xd2, 0xd7, 0xda, 0xe0, 0xe4, 0xe6,
-				0xe9, 0xea, 0xeb, 0xf0, 0xf2, 0xf4, 0xf5, 0xf5,
-				0xfa, 0xfd, 0xfe, 0xfe, 0xff, 0xff,
-			},
-		},
-
-		// Group 59
-		{
-			{
-				0x02, 0x03, 0x04, 0x0a, 0x14, 0x20, 0x21, 0x24,
-				0x27, 0x29, 0x2e, 0x31, 0x31, 0x3b, 0x3b, 0x44,
-				0x44, 0x46, 0x48, 0x4d, 0x55, 0x56, 0x5b, 0x5d,
-				0x5e, 0x63, 0x68, 0x69, 0x6c, 0x6f, 0x71, 0x71,
-				0x79, 0x79, 0x7d, 0x7e, 0x7e, 0x7f, 0x7f, 0x86,
-				0x86, 0x88, 0x89, 0x8e, 0x92, 0x94, 0x9e, 0xa0,
-				0xa1, 0xa8, 0xab, 0xad, 0xb1, 0xb4, 0xb6, 0xbb,
-				0xbc, 0xbe, 0xc3, 0xc5, 0xc6, 0xc7, 0xc8, 0xc9,
-				0xcb, 0xcb, 0xcc, 0xd0, 0xd0, 0xd7, 0xdc, 0xdf,
-				0xe1, 0xe2, 0xe3, 0xe6, 0xe7, 0xe9, 0xea, 0xea,
-				0xec, 0xec, 0xf8, 0xfa, 0xfb, 0xfc,
-			},
-			{
-				0x07, 0x0d, 0x0e, 0x0f, 0x10, 0x13, 0x1a, 0x1b,
-				0x1e, 0x1e, 0x23, 0x26, 0x27, 0x28, 0x28, 0x2d,
-				0x2f, 0x30, 0x31, 0x32, 0x32, 0x33, 0x34, 0x38,
-				0x39, 0x39, 0x3a, 0x3c, 0x3f, 0x40, 0x47, 0x48,
-				0x4a, 0x4b, 0x4b, 0x52, 0x53, 0x55, 0x57, 0x58,
-				0x64, 0x65, 0x66, 0x68, 0x6a, 0x73, 0x7e, 0x82,
-				0x83, 0x8b, 0x94, 0x94, 0x96, 0x97, 0x9c, 0x9d,
-				0x9f, 0xa5, 0xa5, 0xac, 0xaf, 0xb3, 0xb5, 0xb6,
-				0xb7, 0xb7, 0xbe, 0xbf, 0xc0, 0xc4, 0xc8, 0xcd,
-				0xcd, 0xce, 0xd8, 0xda, 0xe0, 0xe7, 0xec, 0xf1,
-				0xf2, 0xf2, 0xf4, 0xf5, 0xf7, 0xfa,
-			},
-			{
-				0x06, 0x09, 0x0f, 0x10, 0x15, 0x17, 0x18, 0x19,
-				0x1a, 0x1c, 0x1c, 0x22, 0x24, 0x28, 0x2a, 0x2b,
-				0x2d, 0x33, 0x35, 0x35, 0x36, 0x38, 0x3c, 0x3f,
-				0x41, 0x42, 0x42, 0x45, 0x46, 0x48, 0x49, 0x4e,
-				0x5d, 0x62, 0x62, 0x6c, 0x6d, 0x70, 0x71, 0x73,
-				0x76, 0x76, 0x77, 0x81, 0x85, 0x8e, 0x8f, 0x8f,
-				0x93, 0x99, 0x9a, 0x9e, 0xa2, 0xa3, 0xa4, 0xa6,
-				0xa7, 0xaa, 0xad, 0xae, 0xae, 0xb1, 0xb2, 0xb3,
-				0xb5, 0xb8, 0xb8, 0xb9, 0xba, 0xbd, 0xbf, 0xc1,
-				0xc2, 0xd2, 0xd7, 0xd9, 0xd9, 0xe8, 0xea, 0xed,
-				0xef, 0xf1, 0xf3, 0xf5, 0xfd, 0xfd,
-			},
-			{
-				0x01, 0x03, 0x07, 0x08, 0x0c, 0x12, 0x14, 0x21,
-				0x25, 0x2c, 0x2c, 0x2e, 0x32, 0x37, 0x3f, 0x41,
-				0x47, 0x4b, 0x50, 0x54, 0x5b, 0x5c, 0x5f, 0x67,
-				0x67, 0x6c, 0x70, 0x72, 0x7d, 0x81, 0x84, 0x86,
-				0x87, 0x8a, 0x8a, 0x8c, 0x8d, 0x90, 0x91, 0x99,
-				0x9a, 0x9e, 0x9f, 0xa1, 0xa2, 0xa8, 0xa9, 0xaa,
-				0xab, 0xad, 0xb0, 0xb0, 0xb4, 0xb5, 0xb6, 0xba,
-				0xbb, 0xbd, 0xc1, 0xc2, 0xc3, 0xc5, 0xc7, 0xca,
-				0xcb, 0xcf, 0xcf, 0xd1, 0xd4, 0xda, 0xde, 0xe0,
-				0xe2, 0xe5, 0xe8, 0xe9, 0xeb, 0xed, 0xee, 0xf3,
-				0xf6, 0xf6, 0xf7, 0xfc, 0xfd, 0xff,
-			},
-			{
-				0x08, 0x0a, 0x0f, 0x11, 0x12, 0x16, 0x17, 0x1f,
-				0x20, 0x22, 0x23, 0x25, 0x2a, 0x2f, 0x34, 0x38,
-				0x3d, 0x3e, 0x40, 0x43, 0x45, 0x49, 0x4c, 0x4d,
-				0x50, 0x53, 0x54, 0x58, 0x5a, 0x5e, 0x5f, 0x61,
-				0x64, 0x66, 0x69, 0x6a, 0x6b, 0x6f, 0x72, 0x78,
-				0x78, 0x7a, 0x7b, 0x7c, 0x7d, 0x82, 0x85, 0x87,
-				0x89, 0x8e, 0x91, 0x92, 0x93, 0x93, 0x95, 0x97,
-				0x98, 0x99, 0xa0, 0xa5, 0xa6, 0xbc, 0xbe, 0xc5,
-				0xc9, 0xd0, 0xd3, 0xd4, 0xd5, 0xd5, 0xd6, 0xd9,
-				0xdb, 0xdb, 0xdc, 0xdd, 0xe5, 0xeb, 0xed, 0xf0,
-				0xf4, 0xf5, 0xf9, 0xfc, 0xfe, 0xff,
-			},
-			{
-				0x00, 0x02, 0x04, 0x0b, 0x0e, 0x11, 0x15, 0x15,
-				0x16, 0x18, 0x1a, 0x1d, 0x1d, 0x1f, 0x22, 0x25,
-				0x26, 0x29, 0x2b, 0x30, 0x33, 0x36, 0x37, 0x39,
-				0x3a, 0x3d, 0x3e, 0x4a, 0x4c, 0x4f, 0x4f, 0x51,
-				0x56, 0x57, 0x59, 0x5a, 0x5c, 0x5f, 0x60, 0x60,
-				0x61, 0x65, 0x68, 0x6a, 0x6f, 0x74, 0x75, 0x76,
-				0x77, 0x7a, 0x7b, 0x7c, 0x80, 0x80, 0x84, 0x84,
-				0x8a, 0x8d, 0x90, 0x95, 0x96, 0x98, 0x9b, 0x9c,
-				0xa8, 0xb0, 0xb2, 0xc0, 0xc4, 0xc7, 0xc8, 0xcc,
-				0xd1, 0xd2, 0xda, 0xdd, 0xe1, 0xe3, 0xe4, 0xe5,
-				0xe8, 0xef, 0xf0, 0xf6, 0xf8, 0xfe,
-			},
-			{
-				0x00, 0x00, 0x01, 0x05, 0x05, 0x06, 0x09, 0x0b,
-				0x0c, 0x0d, 0x11, 0x13, 0x16, 0x19, 0x1b, 0x1e,
-				0x21, 0x36, 0x37, 0x3b, 0x40, 0x42, 0x43, 0x4a,
-				0x4d, 0x4e, 0x51, 0x52, 0x56, 0x59, 0x5d, 0x63,
-				0x65, 0x69, 0x6b, 0x6d, 0x6e, 0x6e, 0x72, 0x74,
-				0x75, 0x7b, 0x7c, 0x7f, 0x81, 0x83, 0x88, 0x8b,
-				0x8b, 0x8c, 0x96, 0x9b, 0x9d, 0xa0, 0xa3, 0xa4,
-				0xa7, 0xa9, 0xac, 0xaf, 0xb9, 0xc0, 0xc1, 0xc4,
-				0xc6, 0xc9, 0xca, 0xce, 0xce, 0xd1, 0xd3, 0xd6,
-				0xd7, 0xd8, 0xde, 0xdf, 0xe0, 0xe2, 0xe4, 0xe6,
-				0xe7, 0xee, 0xef, 0xf1, 0xf9, 0xfb,
-			},
-		},
-
-		// Group 60
-		{
-			{
-				0x02, 0x03, 0x06, 0x07, 0x11, 0x16, 0x17, 0x1a,
-				0x1d, 0x1f, 0x20, 0x22, 0x2c, 0x2d, 0x31, 0x37,
-				0x3a, 0x3b, 0x43, 0x43, 0x44, 0x45, 0x46, 0x49,
-				0x4e, 0x4f, 0x4f, 0x54, 0x58, 0x59, 0x5b, 0x60,
-				0x66, 0x69, 0x6e, 0x6f, 0x73, 0x75, 0x76, 0x7a,
-				0x7b, 0x7d, 0x83, 0x83, 0x85, 0x8b, 0x8d, 0x93,
-				0x95, 0x97, 0x98, 0x98, 0x9b, 0x9d, 0x9e, 0xa0,
-				0xa8, 0xa9, 0xaf, 0xb1, 0xb1, 0xb3, 0xbd, 0xc0,
-				0xc1, 0xc2, 0xc4, 0xcc, 0xcd, 0xcf, 0xd2, 0xd3,
-				0xd6, 0xd6, 0xd8, 0xdb, 0xe5, 0xe5, 0xea, 0xed,
-				0xf1, 0xf2, 0xf3, 0xf5, 0xf7, 0xfe,
-			},
-			{
-				0x04, 0x0a, 0x0b, 0x0d, 0x0f, 0x0f, 0x13, 0x16,
-				0x1d, 0x1e, 0x25, 0x27, 0x27, 0x28, 0x2c, 0x35,
-				0x39, 0x3e, 0x41, 0x44, 0x46, 0x48, 0x4b, 0x4e,
-				0x50, 0x53, 0x56, 0x57, 0x5c, 0x66, 0x6c, 0x6d,
-				0x6e, 0x70, 0x70, 0x73, 0x78, 0x7b, 0x7c, 0x7e,
-				0x80, 0x80, 0x81, 0x86, 0x8a, 0x8d, 0x90, 0x92,
-				0x9c, 0xa1, 0xa2, 0xa7, 0xad, 0xae, 0xaf, 0xb0,
-				0xb2, 0xb8, 0xb9, 0xbb, 0xbc, 0xc0, 0xc1, 0xc2,
-				0xc5, 0xc9, 0xcb, 0xcc, 0xce, 0xd1, 0xd4, 0xd5,
-				0xd7, 0xdc, 0xdf, 0xe3, 0xe6, 0xee, 0xee, 0xef,
-				0xf2, 0xf3, 0xf4, 0xf5, 0xf6, 0xfd,
-			},
-			{
-				0x00, 0x03, 0x06, 0x07, 0x0e, 0x10, 0x11, 0x14,
-				0x15, 0x19, 0x1b, 0x1e, 0x20, 0x21, 0x24, 0x30,
-				0x33, 0x34, 0x35, 0x36, 0x3c, 0x3c, 0x40, 0x42,
-				0x42, 0x45, 0x47, 0x48, 0x4b, 0x4d, 0x4d, 0x4e,
-				0x50, 0x56, 0x57, 0x58, 0x5c, 0x60, 0x62, 0x69,
-				0x6b, 0x6b, 0x6e, 0x71, 0x72, 0x74, 0x77, 0x78,
-				0x79, 0x7b, 0x82, 0x84, 0x84, 0x85, 0x88, 0x8c,
-				0x8f, 0x9e, 0xa3, 0xa3, 0xa6, 0xab, 0xb1, 0xb3,
-				0xb3, 0xb4, 0xb4, 0xbe, 0xc6, 0xc8, 0xcd, 0xcd,
-				0xcf, 0xd0, 0xd8, 0xd9, 0xdd, 0xdf, 0xe1, 0xe3,
-				0xe4, 0xe8, 0xf0, 0xf0, 0xf4, 0xfb,
-			},
-			{
-				0x01, 0x04, 0x04, 0x0a, 0x0b, 0x0b, 0x10, 0x13,
-				0x15, 0x17, 0x18, 0x1a, 0x23, 0x29, 0x2a, 0x31,
-				0x32, 0x34, 0x37, 0x38, 0x3a, 0x3e, 0x3f, 0x42,
-				0x48, 0x52, 0x52, 0x57, 0x5a, 0x5d, 0x5e, 0x64,
-				0x65, 0x71, 0x72, 0x74, 0x74, 0x75, 0x76, 0x79,
-				0x7e, 0x7f, 0x87, 0x88, 0x89, 0x89, 0x8e, 0x99,
-				0x9b, 0xa4, 0xa7, 0xab, 0xb0, 0xb6, 0xb8, 0xb9,
-				0xbb, 0xbb, 0xbd, 0xbf, 0xc4, 0xca, 0xcc, 0xd0,
-				0xd5, 0xd7, 0xd8, 0xdc, 0xe1, 0xe2, 0xe3, 0xe7,
-				0xe9, 0xea, 0xec, 0xed, 0xf1, 0xf6, 0xf7, 0xf8,
-				0xfa, 0xfc, 0xfc, 0xfd, 0xff, 0xff,
-			},
-			{
-				0x01, 0x01, 0x05, 0x08, 0x14, 0x19, 0x1b, 0x1c,
-				0x23, 0x25, 0x26, 0x28, 0x28, 0x29, 0x2a, 0x2b,
-				0x2c, 0x2d, 0x36, 0x3f, 0x40, 0x41, 0x43, 0x44,
-				0x47, 0x47, 0x49, 0x4f, 0x51, 0x54, 0x55, 0x55,
-				0x5b, 0x5b, 0x5f, 0x61, 0x62, 0x64, 0x67, 0x67,
-				0x68, 0x6a, 0x6c, 0x6d, 0x7a, 0x7d, 0x7f, 0x81,
-				0x81, 0x86, 0x8a, 0x8b, 0x91, 0x95, 0xa5, 0xaa,
-				0xad, 0xae, 0xb0, 0xb7, 0xba, 0xbc, 0xbe, 0xc5,
-				0xc6, 0xcf, 0xd2, 0xd3, 0xd4, 0xd9, 0xda, 0xda,
-				0xdc, 0xde, 0xe2, 0xe4, 0xe7, 0xe8, 0xe9, 0xea,
-				0xeb, 0xeb, 0xec, 0xf2, 0xfa, 0xfb,
-			},
-			{
-				0x00, 0x02, 0x12, 0x12, 0x18, 0x1f, 0x21, 0x21,
-				0x29, 0x2d, 0x2e, 0x2f, 0x31, 0x32, 0x32, 0x33,
-				0x35, 0x39, 0x3b, 0x3d, 0x3d, 0x4a, 0x4a, 0x4c,
-				0x51, 0x53, 0x5a, 0x5d, 0x5e, 0x5f, 0x63, 0x63,
-				0x65, 0x65, 0x66, 0x6a, 0x76, 0x77, 0x7a, 0x7c,
-				0x7c, 0x7d, 0x85, 0x87, 0x8c, 0x8e, 0x8e, 0x8f,
-				0x91, 0x92, 0x94, 0x99, 0x9a, 0x9a, 0x9d, 0x9f,
-				0x9f, 0xa3, 0xa6, 0xa6, 0xac, 0xb2, 0xb5, 0xb5,
-				0xb5, 0xb6, 0xb7, 0xbd, 0xc2, 0xc3, 0xc7, 0xca,
-				0xce, 0xdb, 0xdf, 0xe0, 0xe2, 0xe4, 0xe5, 0xe6,
-				0xe6, 0xe8, 0xef, 0xf1, 0xf5, 0xf9,
-			},
-			{
-				0x00, 0x03, 0x05, 0x07, 0x08, 0x09, 0x09, 0x0c,
-				0x0c, 0x0d, 0x0d, 0x0e, 0x1c, 0x1e, 0x22, 0x24,
-				0x26, 0x27, 0x2b, 0x2e, 0x2f, 0x2f, 0x30, 0x38,
-				0x46, 0x4c, 0x59, 0x5a, 0x5f, 0x61, 0x63, 0x68,
-				0x6f, 0x73, 0x80, 0x82, 0x88, 0x90, 0x90, 0x93,
-				0x93, 0x94, 0x96, 0x96, 0x97, 0x97, 0x98, 0x9a,
-				0x9c, 0x9c, 0x9f, 0xa0, 0xa1, 0xa2, 0xa4, 0xa5,
-				0xa8, 0xa9, 0xaa, 0xac, 0xae, 0xb6, 0xb8, 0xba,
-				0xbf, 0xc3, 0xc7, 0xc7, 0xc8, 0xc9, 0xcb, 0xd0,
-				0xd1, 0xd7, 0xdd, 0xde, 0xe0, 0xec, 0xee, 0xf3,
-				0xf8, 0xf8, 0xf9, 0xfa, 0xfc, 0xfe,
-			},
-		},
-
-		// Group 61
-		{
-			{
-				0x01, 0x03, 0x04, 0x04, 0x05, 0x07, 0x09, 0x0a,
-				0x0b, 0x0c, 0x10, 0x11, 0x13, 0x1b, 0x1c, 0x20,
-				0x20, 0x23, 0x2b, 0x2c, 0x30, 0x35, 0x39, 0x3b,
-				0x41, 0x47, 0x48, 0x4d, 0x4e, 0x4f, 0x50, 0x54,
-				0x5b, 0x5c, 0x5d, 0x60, 0x62, 0x62, 0x64, 0x67,
-				0x6a, 0x6a, 0x6e, 0x70, 0x72, 0x75, 0x78, 0x7c,
-				0x7d, 0x7d, 0x7f, 0x83, 0x84, 0x86, 0x89, 0x93,
-				0x9a, 0x9b, 0x9e, 0xa3, 0xa4, 0xa7, 0xad, 0xaf,
-				0xb2, 0xb4, 0xb6, 0xb9, 0xbc, 0xc0, 0xc1, 0xc3,
-				0xc5, 0xc8, 0xce, 0xd5, 0xd5, 0xdf, 0xe1, 0xe5,
-				0xe8, 0xeb, 0xf4, 0xf4, 0xf7, 0xf9,
-			},
-			{
-				0x02, 0x03, 0x06, 0x0b, 0x0f, 0x10, 0x12, 0x14,
-				0x14, 0x15, 0x1f, 0x21, 0x23, 0x24, 0x25, 0x28,
-				0x2d, 0x34, 0x3a, 0x3d, 0x3d, 0x3f, 0x40, 0x41,
-				0x47, 0x49, 0x4b, 0x51, 0x52, 0x52, 0x54, 0x58,
-				0x5c, 0x61, 0x6c, 0x72, 0x75, 0x76, 0x77, 0x7d,
-				0x80, 0x81, 0x82, 0x92, 0x9f, 0xa0, 0xa1, 0xa2,
-				0xa3, 0xa6, 0xa9, 0xac, 0xad, 0xb0, 0xb1, 0xb1,
-				0xb2, 0xb8, 0xbb, 0xbe, 0xc0, 0xc2, 0xc7, 0xc9,
-				0xcb, 0xd0, 0xd1, 0xd3, 0xd4, 0xd6, 0xd8, 0xda,
-				0xde, 0xde, 0xe3, 0xe4, 0xe5, 0xe6, 0xef, 0xf2,
-				0xf3, 0xf4, 0xfa, 0xfc, 0xfd, 0xff,
-			},
-			{
-				0x00, 0x01, 0x08, 0x08, 0x0d, 0x0e, 0x0e, 0x11,
-				0x12, 0x17, 0x21, 0x29, 0x29, 0x2a, 0x2e, 0x30,
-				0x31, 0x32, 0x3c, 0x3e, 0x44, 0x4e, 0x4f, 0x55,
-				0x55, 0x5a, 0x5b, 0x5d, 0x5f, 0x60, 0x62, 0x6d,
-				0x73, 0x73, 0x74, 0x75, 0x76, 0x77, 0x78, 0x79,
-				0x7a, 0x7e, 0x80, 0x82, 0x84, 0x85, 0x87, 0x87,
-				0x8a, 0x8b, 0x8d, 0x8e, 0x96, 0x97, 0x9c, 0xa2,
-				0xa5, 0xa7, 0xae, 0xb0, 0xb2, 0xb3, 0xb5, 0xb7,
-				0xb7, 0xb9, 0xbb, 0xbc, 0xcb, 0xd2, 0xd6, 0xd7,
-				0xd9, 0xdf, 0xdf, 0xe2, 0xe2, 0xe3, 0xe7, 0xec,
-				0xee, 0xf0, 0xf8, 0xfc, 0xfe, 0xff,
-			},
-			{
-				0x04, 0x07, 0x0e, 0x0f, 0x11, 0x16, 0x18, 0x18,
-				0x19, 0x1a, 0x1b, 0x2b, 0x2c, 0x2e, 0x33, 0x35,
-				0x3a, 0x40, 0x42, 0x43, 0x43, 0x45, 0x46, 0x48,
-				0x4a, 0x4c, 0x4f, 0x57, 0x59, 0x59, 0x5f, 0x63,
-				0x66, 0x67, 0x67, 0x68, 0x6c, 0x6d, 0x6e, 0x6f,
-				0x79, 0x7f, 0x83, 0x8a, 0x8b, 0x8e, 0x8f, 0x8f,
-				0x92, 0x96, 0x9d, 0x9f, 0xa0, 0xa1, 0xa1, 0xa5,
-				0xa7, 0xa8, 0xaa, 0xab, 0xac, 0xb4, 0xb5, 0xb6,
-				0xb8, 0xbe, 0xc2, 0xc7, 0xcc, 0xce, 0xd5, 0xd8,
-				0xe0, 0xe1, 0xe3, 0xe8, 0xe8, 0xef, 0xf0, 0xf1,
-				0xf5, 0xf6, 0xf9, 0xfa, 0xfb, 0xff,
-			},
-			{
-				0x05, 0x08, 0x0d, 0x16, 0x19, 0x1a, 0x22, 0x24,
-				0x25, 0x26, 0x26, 0x2a, 0x2d, 0x2e, 0x31, 0x34,
-				0x36, 0x37, 0x38, 0x38, 0x39, 0x3b, 0x3c, 0x3d,
-				0x3e, 0x41, 0x44, 0x45, 0x45, 0x49, 0x4c, 0x4e,
-				0x56, 0x58, 0x5f, 0x64, 0x65, 0x66, 0x68, 0x69,
-				0x6b, 0x70, 0x7a, 0x7b, 0x7e, 0x83, 0x85, 0x89,
-				0x8c, 0x91, 0x91, 0x98, 0x99, 0x9a, 0x9b, 0x9c,
-				0x9d, 0xa9, 0xab, 0xaf, 0xba, 0xbf, 0xc0, 0xc4,
-				0xc8, 0xc8, 0xc9, 0xc9, 0xca, 0xcd, 0xd0, 0xd2,
-				0xd2, 0xdc, 0xe7, 0xe9, 0xec, 0xed, 0xef, 0xf3,
-				0xf5, 0xf8, 0xf8, 0xf9, 0xfd, 0xfe,
-			},
-			{
-				0x00, 0x06, 0x09, 0x0c, 0x17, 0x17, 0x1b, 0x1c,
-				0x1e, 0x1e, 0x1f, 0x20, 0x21, 0x24, 0x28, 0x2f,
-				0x32, 0x33, 0x36, 0x37, 0x3a, 0x3f, 0x42, 0x49,
-				0x4a, 0x4b, 0x50, 0x52, 0x53, 0x53, 0x56, 0x57,
-				0x5a, 0x5e, 0x61, 0x68, 0x6a, 0x6b, 0x71, 0x76,
-				0x79, 0x7b, 0x7c, 0x81, 0x81, 0x8a, 0x8c, 0x8d,
-				0x90, 0x93, 0x94, 0x95, 0x97, 0x98, 0x9a, 0xa2,
-				0xa4, 0xa8, 0xaa, 0xad, 0xae, 0xba, 0xbd, 0xbf,
-				0xc1, 0xc4, 0xc5, 0xcd, 0xd1, 0xd1, 0xd4, 0xd7,
-				0xd9, 0xda, 0xdb, 0xdc, 0xe0, 0xe4, 0xe6, 0xeb,
-				0xee, 0xf1, 0xf2, 0xf7, 0xfb, 0xfe,
-			},
-			{
-				0x02, 0x05, 0x07, 0x0a, 0x13, 0x15, 0x15, 0x19,
-				0x1a, 0x1d, 0x1d, 0x1d, 0x1f, 0x22, 0x23, 0x27,
-				0x27, 0x2a, 0x2f, 0x30, 0x3f, 0x40, 0x42, 0x43,
-				0x46, 0x4d, 0x51, 0x53, 0x54, 0x55, 0x5d, 0x5e,
-				0x63, 0x65, 0x69, 0x6b, 0x6c, 0x6f, 0x71, 0x74,
-				0x74, 0x7b, 0x80, 0x85, 0x86, 0x88, 0x88, 0x90,
-				0x90, 0x94, 0x95, 0x99, 0x9e, 0xa0, 0xa6, 0xb0,
-				0xb3, 0xbd, 0xbf, 0xc3, 0xc4, 0xc6, 0xc6, 0xc7,
-				0xca, 0xcc, 0xcc, 0xcf, 0xcf, 0xd3, 0xd6, 0xdb,
-				0xdd, 0xdd, 0xde, 0xe4, 0xe7, 0xe9, 0xea, 0xea,
-				0xed, 0xed, 0xf2, 0xf6, 0xf6, 0xfc,
-			},
-		},
-
-		// Group 62
-		{
-			{
-				0x01, 0x05, 0x09, 0x0e, 0x10, 0x16, 0x17, 0x1b,
-				0x1c, 0x1d, 0x1f, 0x20, 0x20, 0x27, 0x27, 0x29,
-				0x2b, 0x2e, 0x35, 0x3a, 0x3b, 0x3c, 0x3d, 0x41,
-				0x42, 0x45, 0x49, 0x4a, 0x4c, 0x4d, 0x4d, 0x4e,
-				0x53, 0x5b, 0x62, 0x6c, 0x6d, 0x72, 0x74, 0x74,
-				0x79, 0x7b, 0x7f, 0x80, 0x81, 0x83, 0x88, 0x89,
-				0x89, 0x8a, 0x91, 0x92, 0x92, 0x94, 0x97, 0x9a,
-				0x9c, 0x9e, 0xa3, 0xac, 0xae, 0xaf, 0xb2, 0xb2,
-				0xb4, 0xb5, 0xb9, 0xba, 0xbd, 0xbd, 0xc0, 0xc2,
-				0xc6, 0xc8, 0xcc, 0xcf, 0xd0, 0xd5, 0xe3, 0xe3,
-				0xef, 0xf1, 0xf2, 0xf5, 0xf7, 0xfb,
-			},
-			{
-				0x01, 0x03, 0x03, 0x06, 0x0c, 0x0e, 0x11, 0x12,
-				0x13, 0x15, 0x1b, 0x1b, 0x1f, 0x22, 0x23, 0x24,
-				0x28, 0x2a, 0x32, 0x33, 0x34, 0x41, 0x44, 0x45,
-				0x50, 0x50, 0x51, 0x51, 0x54, 0x57, 0x58, 0x59,
-				0x5a, 0x5c, 0x5d, 0x60, 0x61, 0x65, 0x66, 0x6b,
-				0x6b, 0x6f, 0x7d, 0x83, 0x84, 0x85, 0x86, 0x91,
-				0x95, 0x96, 0x99, 0x9d, 0x9f, 0xa1, 0xa2, 0xa7,
-				0xaa, 0xb1, 0xb7, 0xb8, 0xbb, 0xbb, 0xbe, 0xbf,
-				0xc3, 0xc4, 0xc6, 0xcf, 0xd2, 0xd6, 0xd8, 0xda,
-				0xda, 0xde, 0xdf, 0xe0, 0xe6, 0xe7, 0xec, 0xed,
-				0xee, 0xef, 0xf0, 0xf7, 0xfa, 0xfd,
-			},
-			{
-				0x04, 0x07, 0x08, 0x0b, 0x10, 0x10, 0x18, 0x1a,
-				0x20, 0x24, 0x2d, 0x2f, 0x31, 0x35, 0x3a, 0x3b,
-				0x3f, 0x3f, 0x40, 0x42, 0x52, 0x52, 0x55, 0x57,
-				0x5d, 0x5e, 0x60, 0x62, 0x64, 0x66, 0x68, 0x6e,
-				0x6e, 0x77, 0x77, 0x77, 0x79, 0x7a, 0x81, 0x84,
-				0x8a, 0x8b, 0x8b, 0x8c, 0x8d, 0x93, 0x94, 0x96,
-				0x98, 0x99, 0x9e, 0xa4, 0xa4, 0xa9, 0xae, 0xaf,
-				0xb3, 0xb4, 0xb5, 0xb5, 0xb7, 0xb9, 0xbd, 0xc5,
-				0xc6, 0xc7, 0xc9, 0xcb, 0xce, 0xd1, 0xd4, 0xd5,
-				0xd9, 0xe2, 0xe2, 0xe4, 0xe4, 0xe5, 0xe9, 0xe9,
-				0xed, 0xee, 0xf1, 0xf4, 0xf6, 0xfe,
-			},
-			{
-				0x06, 0x07, 0x08, 0x09, 0x0d, 0x12, 0x15, 0x18,
-				0x18, 0x1a, 0x1f, 0x25, 0x25, 0x27, 0x2b, 0x32,
-				0x39, 0x3e, 0x44, 0x44, 0x46, 0x47, 0x48, 0x4b,
-				0x4e, 0x4f, 0x53, 0x54, 0x63, 0x64, 0x67, 0x68,
-				0x69, 0x69, 0x6c, 0x70, 0x73, 0x75, 0x76, 0x7a,
-				0x81, 0x82, 0x86, 0x86, 0x87, 0x88, 0x8c, 0x90,
-				0x93, 0x97, 0x98, 0x98, 0x9b, 0x9c, 0xa6, 0xa9,
-				0xb0, 0xb1, 0xb3, 0xbb, 0xbc, 0xc1, 0xc3, 0xc4,
-				0xc7, 0xc8, 0xd0, 0xd3, 0xd3, 0xd4, 0xd6, 0xd8,
-				0xdc, 0xdd, 0xe0, 0xe0, 0xe2, 0xe5, 0xe7, 0xeb,
-				0xee, 0xf0, 0xfb, 0xfb, 0xfc, 0xff,
-			},
-			{
-				0x00, 0x03, 0x05, 0x05, 0x06, 0x11, 0x13, 0x17,
-				0x17, 0x19, 0x1c, 0x1c, 0x1e, 0x22, 0x28, 0x2e,
-				0x31, 0x32, 0x34, 0x35, 0x37, 0x39, 0x3b, 0x3d,
-				0x3e, 0x43, 0x46, 0x4f, 0x53, 0x55, 0x59, 0x5a,
-				0x5a, 0x61, 0x63, 0x67, 0x68, 0x6a, 0x70, 0x71,
-				0x75, 0x7c, 0x80, 0x8c, 0x8d, 0x8f, 0x8f, 0x95,
-				0x9b, 0x9e, 0x9f, 0xa0, 0xa3, 0xa7, 0xa8, 0xaa,
-				0xab, 0xb0, 0xb8, 0xb9, 0xc5, 0xcb, 0xcc, 0xcc,
-				0xcd, 0xce, 0xd3, 0xd8, 0xdd, 0xde, 0xdf, 0xe6,
-				0xe8, 0xea, 0xec, 0xf1, 0xf3, 0xf5, 0xf8, 0xf8,
-				0xf9, 0xf9, 0xfa, 0xfc, 0xfe, 0xff,
-			},
-			{
-				0x02, 0x04, 0x08, 0x0c, 0x0d, 0x0e, 0x0f, 0x0f,
-				0x14, 0x14, 0x19, 0x1a, 0x1d, 0x23, 0x23, 0x26,
-				0x26, 0x2c, 0x2d, 0x2e, 0x2f, 0x30, 0x30, 0x30,
-				0x36, 0x36, 0x37, 0x38, 0x3c, 0x42, 0x43, 0x45,
-				0x46, 0x48, 0x4a, 0x4d, 0x4f, 0x55, 0x58, 0x5b,
-				0x5c, 0x5e, 0x65, 0x6c, 0x6d, 0x71, 0x72, 0x72,
-				0x7c, 0x7d, 0x7e, 0x7e, 0x7f, 0x8b, 0x8e, 0x90,
-				0x93, 0x9d, 0xa1, 0xa5, 0xa5, 0xa6, 0xa8, 0xac,
-				0xaf, 0xb6, 0xbe, 0xc0, 0xc1, 0xc9, 0xd0, 0xd2,
-				0xdd, 0xde, 0xe1, 0xe1, 0xe3, 0xe8, 0xea, 0xeb,
-				0xf0, 0xf2, 0xf3, 0xf4, 0xf7, 0xfd,
-			},
-			{
-				0x00, 0x02, 0x0a, 0x0a, 0x0b, 0x0f, 0x16, 0x1d,
-				0x1e, 0x21, 0x21, 0x29, 0x2a, 0x2b, 0x2c, 0x33,
-				0x38, 0x40, 0x40, 0x43, 0x47, 0x49, 0x4b, 0x4c,
-				0x52, 0x56, 0x56, 0x5e, 0x5f, 0x5f, 0x60, 0x69,
-				0x6a, 0x6a, 0x6f, 0x73, 0x76, 0x78, 0x78, 0x7b,
-				0x80, 0x82, 0x83, 0x85, 0x87, 0x87, 0x8d, 0x8e,
-				0x90, 0x9a, 0x9c, 0xa0, 0xa1, 0xa2, 0xa2, 0xa5,
-				0xab, 0xad, 0xad, 0xb1, 0xb6, 0xb6, 0xba, 0xbc,
-				0xbe, 0xbf, 0xc1, 0xc2, 0xca, 0xca, 0xcd, 0xd1,
-				0xd4, 0xd7, 0xd7, 0xd9, 0xda, 0xdb, 0xdb, 0xdc,
-				0xdc, 0xe1, 0xe4, 0xe8, 0xf6, 0xf9,
-			},
-		},
-
-		// Group 63
-		{
-			{
-				0x02, 0x11, 0x14, 0x16, 0x18, 0x1e, 0x1f, 0x1f,
-				0x25, 0x35, 0x37, 0x40, 0x47, 0x49, 0x4a, 0x4e,
-				0x4f, 0x50, 0x51, 0x52, 0x59, 0x5d, 0x65, 0x68,
-				0x6c, 0x6d, 0x6f, 0x70, 0x71, 0x72, 0x72, 0x73,
-				0x75, 0x76, 0x79, 0x7a, 0x7e, 0x7f, 0x80, 0x82,
-				0x83, 0x84, 0x86, 0x87, 0x8d, 0x94, 0x95, 0x96,
-				0x97, 0x99, 0x9b, 0x9d, 0xa1, 0xa5, 0xab, 0xad,
-				0xb0, 0xb1, 0xb4, 0xb8, 0xb8, 0xbd, 0xc7, 0xc7,
-				0xca, 0xcb, 0xcb, 0xcf, 0xd1, 0xd2, 0xd8, 0xda,
-				0xdc, 0xdd, 0xde, 0xe3, 0xe3, 0xe4, 0xe5, 0xe6,
-				0xe9, 0xed, 0xee, 0xf0, 0xf3, 0xf5,
-			},
-			{
-				0x03, 0x03, 0x05, 0x06, 0x08, 0x09, 0x0b, 0x0c,
-				0x0e, 0x17, 0x1a, 0x1e, 0x21, 0x24, 0x27, 0x28,
-				0x2b, 0x30, 0x32, 0x35, 0x39, 0x39, 0x3b, 0x44,
-				0x45, 0x49, 0x4c, 0x4d, 0x4f, 0x51, 0x52, 0x56,
-				0x5c, 0x5c, 0x5e, 0x6b, 0x6b, 0x6d, 0x74, 0x77,
-				0x78, 0x7a, 0x7b, 0x7f, 0x80, 0x82, 0x85, 0x87,
-				0x88, 0x8a, 0x8a, 0x8e, 0x90, 0x93, 0x9a, 0xa8,
-				0xb1, 0xb2, 0xb4, 0xb5, 0xb7, 0xb8, 0xba, 0xbb,
-				0xbe, 0xbf, 0xc1, 0xc2, 0xc2, 0xc3, 0xc4, 0xce,
-				0xcf, 0xd9, 0xde, 0xe0, 0xe2, 0xe2, 0xe5, 0xe6,
-				0xe7, 0xe8, 0xe9, 0xe9, 0xec, 0xef,
-			},
-			{
-				0x00, 0x01, 0x03, 0x0c, 0x0d, 0x0f, 0x13, 0x1c,
-				0x23, 0x27, 0x29, 0x29, 0x29, 0x2a, 0x2e, 0x2e,
-				0x31, 0x31, 0x36, 0x3e, 0x40, 0x41, 0x41, 0x46,
-				0x48, 0x4c, 0x4e, 0x52, 0x53, 0x53, 0x54, 0x5b,
-				0x5b, 0x5f, 0x63, 0x64, 0x64, 0x67, 0x6e, 0x70,
-				0x7c, 0x7d, 0x7e, 0x81, 0x83, 0x88, 0x89, 0x8c,
-				0x8d, 0x8f, 0x8f, 0x96, 0x98, 0x99, 0x99, 0x9a,
-				0x9c, 0x9e, 0x9f, 0xa0, 0xa4, 0xa7, 0xa7, 0xa9,
-				0xb0, 0xb2, 0xb3, 0xb6, 0xbb, 0xc5, 0xc6, 0xca,
-				0xd1, 0xd1, 0xd3, 0xd7, 0xdf, 0xe1, 0xee, 0xf1,
-				0xf4, 0xf7, 0xfc, 0xfe, 0xff, 0xff,
-			},
-			{
-				0x02, 0x06, 0x08, 0x09, 0x0a, 0x0e, 0x0e, 0x0f,
-				0x10, 0x1a, 0x1b, 0x1b, 0x1d, 0x22, 0x22, 0x24,
-				0x2b, 0x2f, 0x2f, 0x34, 0x36, 0x38, 0x3b, 0x3f,
-				0x42, 0x4d, 0x4e, 0x56, 0x57, 0x59, 0x59, 0x5e,
-				0x60, 0x61, 0x62, 0x66, 0x69, 0x6a, 0x6a, 0x6b,
-				0x6c, 0x6f, 0x71, 0x73, 0x75, 0x76, 0x79, 0x7b,
-				0x84, 0x8b, 0x8b, 0x8c, 0x8c, 0x8e, 0x91, 0x95,
-				0x9f, 0xa1, 0xa3, 0xa6, 0xa7, 0xaa, 0xac, 0xad,
-				0xae, 0xaf, 0xbe, 0xc3, 0xc4, 0xc5, 0xc9, 0xcc,
-				0xcc, 0xce, 0xd2, 0xd5, 0xd5, 0xda, 0xdd, 0xea,
-				0xeb, 0xf0, 0xf3, 0xf5, 0xf8, 0xfb,
-			},
-			{
-				0x00, 0x01, 0x01, 0x04, 0x05, 0x0b, 0x0f, 0x13,
-				0x15, 0x15, 0x17, 0x1c, 0x21, 0x23, 0x25, 0x28,
-				0x2d, 0x2e, 0x37, 0x3a, 0x3a, 0x3c, 0x3e, 0x42,
-				0x43, 0x43, 0x44, 0x48, 0x4c, 0x54, 0x58, 0x5a,
-				0x5a, 0x62, 0x62, 0x65, 0x6e, 0x71, 0x78, 0x7c,
-				0x7d, 0x86, 0x91, 0x94, 0x97, 0x9b, 0x9b, 0xa0,
-				0xa2, 0xa9, 0xab, 0xac, 0xae, 0xb3, 0xba, 0xbc,
-				0xbd, 0xc0, 0xc6, 0xc6, 0xc8, 0xca, 0xcd, 0xd3,
-				0xd4, 0xd6, 0xd7, 0xd7, 0xd8, 0xda, 0xdb, 0xdc,
-				0xdf, 0xe1, 0xe4, 0xe8, 0xea, 0xeb, 0xec, 0xed,
-				0xee, 0xf1, 0xf6, 0xfa, 0xfc, 0xfd,
-			},
-			{
-				0x04, 0x07, 0x08, 0x0a, 0x0a, 0x0d, 0x11, 0x12,
-				0x14, 0x16, 0x18, 0x19, 0x1f, 0x25, 0x26, 0x2a,
-				0x2c, 0x33, 0x34, 0x3d, 0x45, 0x46, 0x47, 0x4a,
-				0x4a, 0x4b, 0x51, 0x5d, 0x5e, 0x60, 0x61, 0x64,
-				0x67, 0x68, 0x69, 0x6d, 0x6f, 0x77, 0x77, 0x7b,
-				0x7c, 0x81, 0x87, 0x88, 0x8a, 0x91, 0x92, 0x92,
-				0x93, 0x97, 0x9a, 0x9d, 0x9f, 0xa0, 0xa5, 0xaa,
-				0xaf, 0xb6, 0xb7, 0xbb, 0xc0, 0xc2, 0xc3, 0xc7,
-				0xc8, 0xc8, 0xc9, 0xcc, 0xcd, 0xce, 0xd0, 0xd6,
-				0xe4, 0xe7, 0xeb, 0xef, 0xf2, 0xf4, 0xf4, 0xf6,
-				0xf7, 0xf7, 0xf8, 0xf9, 0xf9, 0xfe,
-			},
-			{
-				0x00, 0x07, 0x0c, 0x0d, 0x10, 0x12, 0x15, 0x17,
-				0x19, 0x1a, 0x1c, 0x1d, 0x20, 0x20, 0x26, 0x27,
-				0x2c, 0x2c, 0x2d, 0x30, 0x32, 0x33, 0x33, 0x35,
-				0x37, 0x38, 0x3c, 0x3d, 0x3f, 0x46, 0x4b, 0x50,
-				0x50, 0x55, 0x55, 0x56, 0x57, 0x58, 0x5f, 0x5f,
-				0x63, 0x66, 0x70, 0x72, 0x74, 0x7f, 0x85, 0x89,
-				0x90, 0x98, 0x98, 0x9c, 0x9d, 0x9e, 0xa2, 0xa3,
-				0xa4, 0xa5, 0xa6, 0xa8, 0xb5, 0xb7, 0xb9, 0xb9,
-				0xb9, 0xbc, 0xbf, 0xc1, 0xc4, 0xc5, 0xd0, 0xd2,
-				0xd4, 0xd9, 0xd9, 0xdb, 0xdf, 0xe0, 0xe1, 0xe2,
-				0xe6, 0xed, 0xf2, 0xfa, 0xfb, 0xfd,
-			},
-		},
-
-		// Group 64
-		{
-			{
-				0x04, 0x04, 0x06, 0x09, 0x0c, 0x0d, 0x0d, 0x0f,
-				0x11, 0x12, 0x16, 0x19, 0x1a, 0x20, 0x20, 0x27,
-				0x28, 0x2d, 0x2f, 0x30, 0x30, 0x33, 0x34, 0x39,
-				0x50, 0x51, 0x53, 0x59, 0x59, 0x5a, 0x5c, 0x5e,
-				0x5f, 0x60, 0x64, 0x6f, 0x70, 0x74, 0x75, 0x77,
-				0x7c, 0x81, 0x84, 0x85, 0x86, 0x87, 0x89, 0x8d,
-				0x8f, 0x90, 0x93, 0x96, 0x96, 0xa0, 0xa5, 0xa7,
-				0xaa, 0xac, 0xaf, 0xb0, 0xb4, 0xb6, 0xbb, 0xc3,
-				0xc4, 0xc5, 0xc7, 0xc7, 0xca, 0xcb, 0xd0, 0xd8,
-				0xd9, 0xda, 0xe0, 0xe1, 0xe4, 0xe7, 0xe8, 0xea,
-				0xee, 0xf0, 0xf1, 0xf4, 0xf5, 0xf9,
-			},
-			{
-				0x02, 0x03, 0x03, 0x03, 0x04, 0x05, 0x05, 0x0a,
-				0x0b, 0x0c, 0x0f, 0x15, 0x1b, 0x1b, 0x1c, 0x22,
-				0x23, 0x26, 0x26, 0x28, 0x29, 0x29, 0x2c, 0x2c,
-				0x2f, 0x37, 0x38, 0x39, 0x3d, 0x3e, 0x41, 0x43,
-				0x45, 0x54, 0x54, 0x55, 0x55, 0x57, 0x58, 0x5d,
-				0x65, 0x68, 0x6a, 0x6d, 0x70, 0x71, 0x7a, 0x80,
-				0x81, 0x82, 0x85, 0x89, 0x8b, 0x8c, 0x8c, 0x92,
-				0x97, 0x9f, 0xa2, 0xa5, 0xa6, 0xa9, 0xae, 0xb4,
-				0xb5, 0xb9, 0xc4, 0xc7, 0xc9, 0xcf, 0xd1, 0xd6,
-				0xd7, 0xd7, 0xdf, 0xe2, 0xe5, 0xe5, 0xeb, 0xee,
-				0xef, 0xf0, 0xf2, 0xf7, 0xf8, 0xfc,
-			},
-			{
-				0x00, 0x08, 0x0d, 0x10, 0x12, 0x14, 0x16, 0x17,
-				0x17, 0x18, 0x1a, 0x1d, 0x1e, 0x1f, 0x21, 0x23,
-				0x24, 0x24, 0x27, 0x31, 0x33, 0x35, 0x36, 0x38,
-				0x3c, 0x43, 0x49, 0x4b, 0x51, 0x56, 0x62, 0x64,
-				0x68, 0x69, 0x6b, 0x6b, 0x6c, 0x6c, 0x72, 0x72,
-				0x76, 0x78, 0x79, 0x7e, 0x7f, 0x80, 0x86, 0x88,
-				0x8c, 0x8e, 0x8f, 0x90, 0x94, 0x95, 0x97, 0x9d,
-				0x9d, 0xa4, 0xa6, 0xa8, 0xaa, 0xaa, 0xac, 0xb0,
-				0xb4, 0xb5, 0xb9, 0xba, 0xc3, 0xc4, 0xc6, 0xcd,
-				0xcd, 0xd2, 0xd3, 0xd8, 0xdb, 0xe2, 0xe8, 0xe9,
-				0xeb, 0xeb, 0xf6, 0xfa, 0xfc, 0xff,
-			},
-			{
-				0x00, 0x01, 0x07, 0x0c, 0x0e, 0x0f, 0x11, 0x18,
-				0x19, 0x1c, 0x1c, 0x1e, 0x1f, 0x21, 0x25, 0x2a,
-				0x2a, 0x2b, 0x32, 0x36, 0x3b, 0x3f, 0x3f, 0x41,
-				0x46, 0x4e, 0x4e, 0x52, 0x56, 0x5a, 0x5b, 0x5e,
-				0x61, 0x61, 0x65, 0x66, 0x67, 0x6a, 0x6a, 0x6b,
-				0x6d, 0x6e, 0x6f, 0x7d, 0x7e, 0x7f, 0x82, 0x84,
-				0x8a, 0x8d, 0x91, 0xa1, 0xb1, 0xb1, 0xb3, 0xb3,
-				0xb6, 0xb7, 0xba, 0xbb, 0xbd, 0xbe, 0xc2, 0xca,
-				0xcb, 0xcc, 0xce, 0xcf, 0xd2, 0xd4, 0xd5, 0xd6,
-				0xd7, 0xdb, 0xdd, 0xe0, 0xe3, 0xe7, 0xee, 0xf3,
-				0xf4, 0xf9, 0xfb, 0xfd, 0xfd, 0xfe,
-			},
-			{
-				0x02, 0x10, 0x12, 0x13, 0x13, 0x1e, 0x24, 0x25,
-				0x2d, 0x2e, 0x2e, 0x31, 0x3c, 0x3d, 0x3e, 0x42,
-				0x44, 0x44, 0x45, 0x46, 0x48, 0x49, 0x4b, 0x4c,
-				0x4c, 0x4d, 0x4f, 0x53, 0x5c, 0x62, 0x63, 0x63,
-				0x67, 0x68, 0x6f, 0x71, 0x74, 0x77, 0x79, 0x7a,
-				0x7d, 0x82, 0x8e, 0x90, 0x93, 0x95, 0x98, 0x9a,
-				0x9e, 0x9f, 0xa1, 0xa7, 0xab, 0xab, 0xad, 0xad,
-				0xae, 0xaf, 0xb5, 0xbc, 0xbd, 0xbe, 0xc1, 0xc1,
-				0xc5, 0xc6, 0xc8, 0xc9, 0xc9, 0xcc, 0xcd, 0xce,
-				0xd5, 0xd8, 0xda, 0xdc, 0xde, 0xec, 0xef, 0xef,
-				0xf1, 0xf3, 0xf4, 0xf6, 0xf8, 0xff,
-			},
-			{
-				0x00, 0x01, 0x05, 0x08, 0x09, 0x0b, 0x0e, 0x13,
-				0x15, 0x1d, 0x1d, 0x1f, 0x28, 0x2b, 0x2c, 0x2e,
-				0x32, 0x34, 0x3a, 0x3c, 0x41, 0x44, 0x45, 0x47,
-				0x47, 0x48, 0x4a, 0x4b, 0x54, 0x57, 0x60, 0x61,
-				0x6e, 0x72, 0x73, 0x75, 0x7b, 0x7c, 0x7f, 0x81,
-				0x83, 0x87, 0x87, 0x88, 0x8d, 0x92, 0x96, 0x98,
-				0x9a, 0x9b, 0x9e, 0xa0, 0xa2, 0xa3, 0xa8, 0xa9,
-				0xa9, 0xb2, 0xb3, 0xb7, 0xbc, 0xbf, 0xc0, 0xc0,
-				0xc2, 0xc2, 0xc8, 0xca, 0xcb, 0xd2, 0xde, 0xdf,
-				0xdf, 0xe0, 0xe1, 0xe2, 0xe6, 0xe6, 0xea, 0xed,
-				0xf2, 0xf5, 0xfa, 0xfb, 0xfb, 0xfe,
-			},
-			{
-				0x06, 0x07, 0x0a, 0x0a, 0x0b, 0x10, 0x14, 0x15,
-				0x17, 0x1b, 0x22, 0x25, 0x2a, 0x2b, 0x35, 0x35,
-				0x37, 0x39, 0x3a, 0x3b, 0x40, 0x40, 0x42, 0x4a,
-				0x4d, 0x4f, 0x4f, 0x50, 0x52, 0x58, 0x5b, 0x5c,
-				0x5d, 0x5d, 0x5f, 0x65, 0x66, 0x66, 0x69, 0x73,
-				0x76, 0x78, 0x7a, 0x7b, 0x83, 0x83, 0x89, 0x8a,
-				0x8b, 0x91, 0x94, 0x97, 0x99, 0x99, 0x9a, 0x9b,
-				0x9c, 0x9c, 0xa3, 0xa4, 0xb2, 0xb6, 0xb8, 0xb8,
-				0xbf, 0xd0, 0xd0, 0xd1, 0xd3, 0xd4, 0xd5, 0xd9,
-				0xdc, 0xdd, 0xe3, 0xe4, 0xe9, 0xea, 0xec, 0xed,
-				0xed, 0xf2, 0xf7, 0xf9, 0xfd, 0xfe,
-			},
-		},
-
-		// Group 65
-		{
-			{
-				0x00, 0x00, 0x01, 0x01, 0x04, 0x05, 0x09, 0x0f,
-				0x16, 0x19, 0x1a, 0x1b, 0x1d, 0x1f, 0x24, 0x27,
-				0x28, 0x29, 0x2c, 0x2d, 0x2e, 0x33, 0x3a, 0x3b,
-				0x3c, 0x3e, 0x3e, 0x40, 0x45, 0x46, 0x48, 0x4b,
-				0x4e, 0x4f, 0x51, 0x55, 0x56, 0x57, 0x57, 0x5d,
-				0x5e, 0x62, 0x63, 0x64, 0x68, 0x69, 0x69, 0x74,
-				0x7a, 0x80, 0x81, 0x82, 0x83, 0x85, 0x87, 0x8d,
-				0x8d, 0x95, 0x97, 0xa1, 0xa6, 0xa7, 0xa7, 0xa8,
-				0xa9, 0xaf, 0xb1, 0xb4, 0xb6, 0xb9, 0xbc, 0xc2,
-				0xc9, 0xcb, 0xd0, 0xd4, 0xd6, 0xd7, 0xd8, 0xdb,
-				0xdc, 0xe2, 0xe7, 0xed, 0xf2, 0xf5,
-			},
-			{
-				0x02, 0x04, 0x0a, 0x10, 0x10, 0x15, 0x1a, 0x1d,
-				0x1f, 0x23, 0x25, 0x30, 0x36, 0x37, 0x37, 0x39,
-				0x40, 0x44, 0x49, 0x49, 0x4d, 0x50, 0x58, 0x5a,
-				0x67, 0x68, 0x6d, 0x6d, 0x6f, 0x70, 0x72, 0x76,
-				0x78, 0x78, 0x79, 0x7d, 0x7d, 0x7e, 0x7f, 0x81,
-				0x84, 0x8f, 0x93, 0x95, 0x98, 0x9a, 0x9f, 0xa1,
-				0xa3, 0xa4, 0xa4, 0xa5, 0xa5, 0xa6, 0xa9, 0xab,
-				0xac, 0xb0, 0xb7, 0xb9, 0xbd, 0xbd, 0xbe, 0xc3,
-				0xc3, 0xc6, 0xca, 0xcc, 0xcc, 0xd1, 0xd9, 0xdc,
-				0xe2, 0xe4, 0xe7, 0xe9, 0xea, 0xec, 0xf0, 0xf1,
-				0xf1, 0xf6, 0xf6, 0xf7, 0xf8, 0xfe,
-			},
-			{
-				0x05, 0x08, 0x0b, 0x0c, 0x0d, 0x0f, 0x11, 0x1c,
-				0x1e, 0x21, 0x21, 0x22, 0x28, 0x2b, 0x2b, 0x2d,
-				0x2f, 0x31, 0x33, 0x35, 0x37, 0x42, 0x4a, 0x4b,
-				0x4c, 0x4f, 0x50, 0x52, 0x53, 0x5d, 0x61, 0x62,
-				0x64, 0x6e, 0x71, 0x72, 0x79, 0x7a, 0x7c, 0x7e,
-				0x7e, 0x7f, 0x84, 0x86, 0x8a, 0x92, 0x94, 0x96,
-				0x9a, 0xa0, 0xa3, 0xa3, 0xaa, 0xaa, 0xae, 0xb2,
-				0xb2, 0xb3, 0xba, 0xbe, 0xbf, 0xc5, 0xcc, 0xcd,
-				0xce, 0xcf, 0xd1, 0xd2, 0xd9, 0xda, 0xde, 0xdf,
-				0xe4, 0xe8, 0xe8, 0xea, 0xeb, 0xee, 0xf0, 0xf7,
-				0xf7, 0xfa, 0xfb, 0xfd, 0xfe, 0xff,
-			},
-			{
-				0x03, 0x06, 0x07, 0x0d, 0x1b, 0x25, 0x2e, 0x2f,
-				0x33, 0x34, 0x36, 0x39, 0x3a, 0x3a, 0x3d, 0x3e,
-				0x41, 0x45, 0x57, 0x59, 0x5a, 0x60, 0x60, 0x61,
-				0x62, 0x65, 0x66, 0x66, 0x6a, 0x6c, 0x6f, 0x70,
-				0x73, 0x76, 0x77, 0x7c, 0x81, 0x82, 0x89, 0x8c,
-				0x90, 0x90, 0x91, 0x93, 0x96, 0x9d, 0x9d, 0x9e,
-				0x9e, 0xa2, 0xaa, 0xad, 0xaf, 0xb0, 0xb1, 0xb1,
-				0xb6, 0xb8, 0xbb, 0xbf, 0xc0, 0xc1, 0xc7, 0xc8,
-				0xca, 0xcb, 0xce, 0xd1, 0xd5, 0xdb, 0xdf, 0xe1,
-				0xe3, 0xe3, 0xe6, 0xea, 0xeb, 0xec, 0xef, 0xf1,
-				0xf2, 0xf3, 0xf3, 0xf4, 0xfb, 0xff,
-			},
-			{
-				0x04, 0x08, 0x08, 0x0c, 0x13, 0x13, 0x14, 0x18,
-				0x19, 0x1e, 0x20, 0x22, 0x23, 0x28, 0x2a, 0x30,
-				0x32, 0x34, 0x35, 0x3c, 0x3c, 0x3d, 0x3f, 0x40,
-				0x41, 0x43, 0x43, 0x48, 0x4c, 0x4d, 0x51, 0x52,
-				0x54, 0x55, 0x56, 0x5c, 0x5f, 0x63, 0x66, 0x68,
-				0x6a, 0x6b, 0x71, 0x73, 0x77, 0x77, 0x79, 0x7a,
-				0x7b, 0x7f, 0x85, 0x8a, 0x8c, 0x8e, 0x90, 0x97,
-				0x9b, 0xac, 0xae, 0xae, 0xb2, 0xb3, 0xb5, 0xb5,
-				0xb7, 0xbc, 0xc8, 0xcb, 0xcd, 0xcf, 0xd0, 0xd6,
-				0xdd, 0xde, 0xde, 0xe3, 0xe5, 0xe5, 0xee, 0xef,
-				0xf2, 0xf3, 0xf6, 0xf9, 0xf9, 0xfc,
-			},
-			{
-				0x07, 0x09, 0x0b, 0x11, 0x11, 0x12, 0x14, 0x15,
-				0x17, 0x1d, 0x1e, 0x1f, 0x20, 0x26, 0x29, 0x2c,
-				0x2c, 0x30, 0x31, 0x32, 0x38, 0x38, 0x3f, 0x47,
-				0x47, 0x53, 0x53, 0x56, 0x5b, 0x5b, 0x5c, 0x5f,
-				0x67, 0x6b, 0x6c, 0x6d, 0x71, 0x74, 0x75, 0x7b,
-				0x83, 0x83, 0x85, 0x86, 0x87, 0x88, 0x88, 0x8a,
-				0x8b, 0x8b, 0x8e, 0x92, 0x99, 0x9a, 0xa0, 0xa2,
-				0xa4, 0xa9, 0xac, 0xb3, 0xb4, 0xb5, 0xb8, 0xbb,
-				0xc0, 0xc1, 0xc4, 0xc5, 0xc7, 0xc8, 0xd2, 0xd3,
-				0xd4, 0xd5, 0xd8, 0xd8, 0xe0, 0xe0, 0xe1, 0xe6,
-				0xe8, 0xe9, 0xed, 0xf5, 0xf8, 0xfa,
-			},
-			{
-				0x02, 0x03, 0x06, 0x07, 0x0a, 0x0e, 0x0e, 0x12,
-				0x16, 0x17, 0x18, 0x1b, 0x1c, 0x22, 0x24, 0x26,
-				0x26, 0x27, 0x29, 0x2a, 0x2d, 0x3b, 0x3d, 0x42,
-				0x44, 0x46, 0x4a, 0x4e, 0x54, 0x58, 0x59, 0x5c,
-				0x5e, 0x61, 0x65, 0x67, 0x6a, 0x6e, 0x75, 0x78,
-				0x7d, 0x80, 0x82, 0x89, 0x8d, 0x8f, 0x91, 0x91,
-				0x94, 0x98, 0x99, 0x9b, 0x9c, 0x9c, 0x9e, 0x9f,
-				0xa6, 0xa7, 0xa8, 0xab, 0xad, 0xb0, 0xb7, 0xba,
-				0xc2, 0xc4, 0xc4, 0xc6, 0xc9, 0xd3, 0xd6, 0xd7,
-				0xd9, 0xda, 0xdc, 0xdd, 0xdd, 0xe1, 0xe5, 0xe7,
-				0xec, 0xf4, 0xf5, 0xfa, 0xfc, 0xfd,
-			},
-		},
-
-		// Group 66
-		{
-			{
-				0x05, 0x0a, 0x0c, 0x0c, 0x0f, 0x14, 0x16, 0x16,
-				0x22, 0x25, 0x30, 0x31, 0x34, 0x36, 0x3a, 0x41,
-				0x43, 0x49, 0x51, 0x52, 0x58, 0x58, 0x5a, 0x61,
-				0x6a, 0x6b, 0x6c, 0x6e, 0x71, 0x73, 0x74, 0x75,
-				0x76, 0x7a, 0x7b, 0x7c, 0x7c, 0x7e, 0x80, 0x82,
-				0x82, 0x89, 0x8c, 0x8e, 0x92, 0x92, 0x93, 0x96,
-				0x99, 0x9f, 0xa0, 0xa4, 0xa9, 0xac, 0xae, 0xaf,
-				0xb3, 0xb4, 0xb6, 0xb8, 0xba, 0xba, 0xbe, 0xc1,
-				0xc4, 0xc4, 0xc5, 0xc7, 0xc9, 0xca, 0xcc, 0xce,
-				0xcf, 0xd2, 0xd5, 0xd7, 0xdc, 0xe1, 0xe2, 0xe2,
-				0xe4, 0xeb, 0xed, 0xef, 0xf4, 0xf9,
-			},
-			{
-				0x00, 0x00, 0x02, 0x04, 0x05, 0x06, 0x07, 0x09,
-				0x09, 0x0a, 0x0e, 0x11, 0x14, 0x20, 0x22, 0x23,
-				0x27, 0x29, 0x2a, 0x2c, 0x2c, 0x2d, 0x37, 0x37,
-				0x3d, 0x3e, 0x42, 0x42, 0x43, 0x46, 0x46, 0x4c,
-				0x50, 0x55, 0x57, 0x59, 0x5b, 0x5d, 0x5d, 0x60,
-				0x67, 0x68, 0x68, 0x69, 0x70, 0x79, 0x79, 0x81,
-				0x85, 0x85, 0x88, 0x88, 0x8b, 0x8d, 0x8e, 0x8f,
-				0x92, 0x93, 0x95, 0x9a, 0x9d, 0xa5, 0xb7, 0xba,
-				0xbf, 0xbf, 0xc3, 0xca, 0xcc, 0xd4, 0xd6, 0xd7,
-				0xd8, 0xdd, 0xdd, 0xdd, 0xdf, 0xe0, 0xe4, 0xe6,
-				0xea, 0xed, 0xf2, 0xf9, 0xfc, 0xff,
-			},
-			{
-				0x00, 0x01, 0x03, 0x0a, 0x11, 0x15, 0x18, 0x1b,
-				0x1d, 0x1d, 0x1f, 0x21, 0x23, 0x2b, 0x2f, 0x34,
-				0x35, 0x3c, 0x41, 0x44, 0x48, 0x4e, 0x52, 0x53,
-				0x54, 0x57, 0x5a, 0x5f, 0x62, 0x65, 0x71, 0x72,
-				0x74, 0x75, 0x78, 0x79, 0x7d, 0x7d, 0x80, 0x84,
-				0x87, 0x8a, 0x90, 0x90, 0x93, 0x94, 0x94, 0x96,
-				0x97, 0x98, 0x9c, 0x9c, 0x9e, 0xa2, 0xa9, 0xad,
-				0xae, 0xb0, 0xb0, 0xb1, 0xb3, 0xb4, 0xb9, 0xbb,
-				0xbf, 0xc1, 0xc6, 0xc8, 0xca, 0xcb, 0xcd, 0xce,
-				0xcf, 0xd0, 0xd8, 0xd9, 0xe0, 0xe1, 0xe1, 0xe5,
-				0xe7, 0xee, 0xef, 0xf0, 0xfb, 0xfc,
-			},
-			{
-				0x03, 0x06, 0x07, 0x08, 0x0b, 0x11, 0x12, 0x19,
-				0x19, 0x1b, 0x1c, 0x26, 0x28, 0x2b, 0x2e, 0x2e,
-				0x31, 0x32, 0x33, 0x3f, 0x42, 0x44, 0x49, 0x4d,
-				0x4d, 0x4f, 0x5b, 0x5d, 0x65, 0x66, 0x67, 0x68,
-				0x69, 0x69, 0x6b, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
-				0x77, 0x7a, 0x81, 0x86, 0x89, 0x8f, 0x90, 0x91,
-				0x97, 0x98, 0x9a, 0xa4, 0xa6, 0xa7, 0xa8, 0xaa,
-				0xab, 0xac, 0xb5, 0xb6, 0xb8, 0xbb, 0xbc, 0xbc,
-				0xbd, 0xd4, 0xd6, 0xd9, 0xd9, 0xda, 0xdb, 0xdc,
-				0xe3, 0xe5, 0xe6, 0xe9, 0xea, 0xeb, 0xf1, 0xf3,
-				0xf5, 0xf8, 0xfa, 0xfa, 0xfd, 0xff,
-			},
-			{
-				0x01, 0x02, 0x04, 0x04, 0x09, 0x0f, 0x10, 0x12,
-				0x13, 0x13, 0x16, 0x1e, 0x1e, 0x1f, 0x20, 0x25,
-				0x25, 0x26, 0x27, 0x28, 0x29, 0x2a, 0x2d, 0x2f,
-				0x32, 0x32, 0x35, 0x37, 0x3b, 0x3c, 0x3e, 0x3f,
-				0x45, 0x4a, 0x4e, 0x4f, 0x50, 0x53, 0x53, 0x54,
-				0x56, 0x5c, 0x5c, 0x5e, 0x5e, 0x5f, 0x61, 0x6a,
-				0x6d, 0x7b, 0x7f, 0x86, 0x8d, 0x91, 0x95, 0x9e,
-				0x9f, 0xa5, 0xa8, 0xa9, 0xad, 0xb7, 0xb7, 0xbb,
-				0xc0, 0xc6, 0xc7, 0xc8, 0xc9, 0xce, 0xd0, 0xd3,
-				0xd8, 0xde, 0xe3, 0xe4, 0xea, 0xec, 0xf2, 0xf4,
-				0xf6, 0xf7, 0xf8, 0xfc, 0xfe, 0xff,
-			},
-			{
-				0x0b, 0x10, 0x15, 0x17, 0x17, 0x17, 0x18, 0x1a,
-				0x1c, 0x1f, 0x24, 0x24, 0x36, 0x38, 0x3b, 0x3b,
-				0x3d, 0x3f, 0x45, 0x48, 0x4a, 0x4b, 0x55, 0x56,
-				0x5b, 0x5f, 0x62, 0x63, 0x67, 0x6a, 0x6e, 0x6f,
-				0x70, 0x73, 0x77, 0x78, 0x7c, 0x80, 0x83, 0x87,
-				0x88, 0x89, 0x8a, 0x8b, 0x8b, 0x8c, 0x99, 0x9b,
-				0x9b, 0x9d, 0xa1, 0xa2, 0xa3, 0xa6, 0xa6, 0xaf,
-				0xb1, 0xb2, 0xb2, 0xb9, 0xbd, 0xbe, 0xc0, 0xc2,
-				0xc3, 0xcb, 0xd1, 0xd1, 0xd2, 0xd3, 0xd5, 0xda,
-				0xde, 0xdf, 0xe0, 0xe7, 0xe7, 0xe8, 0xee, 0xef,
-				0xf0, 0xf2, 0xf3, 0xf5, 0xf8, 0xf9,
-			},
-			{
-				0x03, 0x08, 0x0d, 0x0d, 0x0e, 0x13, 0x1a, 0x21,
-				0x2b, 0x30, 0x33, 0x38, 0x39, 0x39, 0x3a, 0x3e,
-				0x40, 0x40, 0x44, 0x45, 0x46, 0x47, 0x47, 0x4a,
-				0x4b, 0x4c, 0x4c, 0x4d, 0x51, 0x54, 0x59, 0x60,
-				0x62, 0x63, 0x64, 0x64, 0x66, 0x66, 0x6d, 0x71,
-				0x76, 0x78, 0x7e, 0x7f, 0x7f, 0x83, 0x84, 0x84,
-				0x85, 0x87, 0x8f, 0x96, 0x97, 0xa0, 0xa1, 0xa2,
-				0xa3, 0xa5, 0xa7, 0xa8, 0xaa, 0xab, 0xac, 0xad,
-				0xae, 0xaf, 0xb5, 0xb8, 0xbc, 0xbe, 0xc2, 0xc5,
-				0xcb, 0xcd, 0xd7, 0xdb, 0xde, 0xe8, 0xe9, 0xec,
-				0xf1, 0xf6, 0xf7, 0xfb, 0xfd, 0xfe,
-			},
-		},
-
-		// Group 67
-		{
-			{
-				0x03, 0x04, 0x07, 0x09, 0x0d, 0x12, 0x16, 0x19,
-				0x1b, 0x1e, 0x20, 0x29, 0x2a, 0x36, 0x3c, 0x3d,
-				0x42, 0x47, 0x48, 0x4e, 0x55, 0x56, 0x5a, 0x5b,
-				0x5e, 0x61, 0x63, 0x64, 0x66, 0x69, 0x71, 0x72,
-				0x74, 0x77, 0x7a, 0x7e, 0x7e, 0x7f, 0x83, 0x84,
-				0x85, 0x88, 0x8b, 0x8e, 0x8e, 0x8f, 0x94, 0x96,
-				0x96, 0x9e, 0xa0, 0xa1, 0xa3, 0xa4, 0xa6, 0xa6,
-				0xac, 0xb0, 0xb2, 0xb6, 0xb9, 0xbc, 0xbe, 0xbe,
-				0xc1, 0xc3, 0xc7, 0xcc, 0xcd, 0xd0, 0xd6, 0xde,
-				0xdf, 0xe0, 0xe1, 0xe3, 0xe7, 0xe8, 0xeb, 0xeb,
-				0xec, 0xf2, 0xf4, 0xf9, 0xfb, 0xff,
-			},
-			{
-				0x01, 0x03, 0x08, 0x0f, 0x10, 0x10, 0x13, 0x14,
-				0x16, 0x17, 0x17, 0x1a, 0x1a, 0x1c, 0x1c, 0x1d,
-				0x23, 0x26, 0x27, 0x27, 0x38, 0x3b, 0x3d, 0x3e,
-				0x44, 0x45, 0x4a, 0x4c, 0x53, 0x66, 0x6a, 0x6e,
-				0x6e, 0x6f, 0x70, 0x78, 0x79, 0x7d, 0x81, 0x83,
-				0x86, 0x87, 0x89, 0x90, 0x91, 0x93, 0x9c, 0x9c,
-				0x9e, 0x9f, 0xa5, 0xa8, 0xad, 0xb0, 0xb1, 0xb3,
-				0xb3, 0xbc, 0xc0, 0xc6, 0xc9, 0xcc, 0xcf, 0xcf,
-				0xd0, 0xd2, 0xd5, 0xd7, 0xd8, 0xd9, 0xda, 0xdb,
-				0xe7, 0xe9, 0xea, 0xec, 0xf0, 0xf0, 0xf3, 0xf3,
-				0xf6, 0xf8, 0xf8, 0xfa, 0xfc, 0xfd,
-			},
-			{
-				0x02, 0x02, 0x05, 0x09, 0x11, 0x15, 0x17, 0x19,
-				0x1d, 0x22, 0x23, 0x24, 0x28, 0x28, 0x2e, 0x2e,
-				0x31, 0x33, 0x34, 0x34, 0x35, 0x39, 0x3c, 0x3f,
-				0x41, 0x42, 0x44, 0x49, 0x4b, 0x4c, 0x4d, 0x50,
-				0x51, 0x57, 0x58, 0x5a, 0x62, 0x66, 0x68, 0x6c,
-				0x6d, 0x6d, 0x6f, 0x70, 0x75, 0x77, 0x77, 0x78,
-				0x79, 0x7f, 0x81, 0x84, 0x8a, 0x8b, 0x8d, 0x8d,
-				0x8f, 0x90, 0x94, 0xaa, 0xaf, 0xb3, 0xb5, 0xb6,
-				0xba, 0xbd, 0xc1, 0xc3, 0xc4, 0xc9, 0xcb, 0xd3,
-				0xd3, 0xd4, 0xd7, 0xd9, 0xdc, 0xdf, 0xe3, 0xe6,
-				0xe7, 0xe9, 0xee, 0xf1, 0xf5, 0xfb,
-			},
-			{
-				0x05, 0x09, 0x0a, 0x0a, 0x12, 0x1a, 0x1b, 0x1d,
-				0x1f, 0x22, 0x28, 0x29, 0x3a, 0x3a, 0x3b, 0x3b,
-				0x3f, 0x40, 0x40, 0x42, 0x43, 0x47, 0x48, 0x4a,
-				0x4e, 0x4f, 0x50, 0x55, 0x58, 0x5b, 0x5d, 0x5e,
-				0x5f, 0x64, 0x65, 0x67, 0x6b, 0x6b, 0x76, 0x7c,
-				0x80, 0x84, 0x8a, 0x8c, 0x90, 0x92, 0x95, 0x97,
-				0x97, 0x98, 0x98, 0x99, 0x9a, 0x9a, 0x9d, 0x9f,
-				0xa2, 0xa7, 0xa8, 0xab, 0xac, 0xad, 0xb0, 0xb4,
-				0xb7, 0xb8, 0xba, 0xbb, 0xbb, 0xbd, 0xbd, 0xc0,
-				0xc2, 0xc8, 0xcd, 0xcf, 0xd4, 0xd6, 0xd8, 0xe4,
-				0xe6, 0xed, 0xee, 0xf7, 0xfd, 0xfe,
-			},
-			{
-				0x00, 0x01, 0x06, 0x0c, 0x0d, 0x0e, 0x0f, 0x13,
-				0x18, 0x19, 0x1e, 0x1f, 0x23, 0x24, 0x25, 0x26,
-				0x2a, 0x2b, 0x2b, 0x2f, 0x30, 0x39, 0x3a, 0x3c,
-				0x40, 0x43, 0x44, 0x46, 0x49, 0x4a, 0x4d, 0x4e,
-				0x51, 0x52, 0x53, 0x54, 0x54, 0x5f, 0x60, 0x62,
-				0x65, 0x67, 0x68, 0x6e, 0x6f, 0x71, 0x73, 0x79,
-				0x7b, 0x7c, 0x82, 0x83, 0x87, 0x8d, 0x8e, 0x91,
-				0x92, 0x9b, 0x9b, 0xa3, 0xa5, 0xa7, 0xa9, 0xb4,
-				0xb5, 0xb9, 0xc1, 0xc2, 0xc4, 0xc5, 0xc8, 0xcb,
-				0xd0, 0xda, 0xdc, 0xdd, 0xdd, 0xde, 0xdf, 0xe0,
-				0xe2, 0xe3, 0xe5, 0xe6, 0xe8, 0xf7,
-			},
-			{
-				0x03, 0x04, 0x08, 0x0b, 0x0b, 0x0e, 0x10, 0x11,
-				0x14, 0x15, 0x20, 0x21, 0x21, 0x25, 0x25, 0x26,
-				0x2a, 0x2e, 0x2f, 0x30, 0x32, 0x33, 0x35, 0x37,
-				0x38, 0x3d, 0x3e, 0x41, 0x45, 0x46, 0x4b, 0x52,
-				0x56, 0x59, 0x63, 0x65, 0x69, 0x6c, 0x72, 0x75,
-				0x76, 0x7b, 0x7d, 0x80, 0x82, 0x85, 0x85, 0x8c,
-				0x8f, 0x92, 0x93, 0x98, 0x99, 0x9b, 0x9c, 0x9d,
-				0xa2, 0xa4, 0xab, 0xac, 0xae, 0xaf, 0xb1, 0xb7,
-				0xb8, 0xbf, 0xbf, 0xc7, 0xcb, 0xcd, 0xce, 0xd2,
-				0xd6, 0xdb, 0xdc, 0xe1, 0xe4, 0xe5, 0xe9, 0xed,
-				0xef, 0xef, 0xf1, 0xf4, 0xf6, 0xfe,
-			},
-			{
-				0x00, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0e,
-				0x15, 0x18, 0x1b, 0x2c, 0x2c, 0x2d, 0x2d, 0x31,
-				0x32, 0x34, 0x36, 0x37, 0x3f, 0x4f, 0x55, 0x57,
-				0x58, 0x59, 0x5c, 0x5c, 0x5d, 0x60, 0x61, 0x62,
-				0x6a, 0x6a, 0x6c, 0x73, 0x74, 0x76, 0x7a, 0x7a,
-				0x7e, 0x82, 0x86, 0x88, 0x88, 0x89, 0x94, 0x95,
-				0x99, 0x9d, 0xa0, 0xa1, 0xa9, 0xaa, 0xaa, 0xad,
-				0xae, 0xae, 0xb2, 0xb2, 0xb9, 0xbe, 0xc5, 0xc6,
-				0xc8, 0xca, 0xca, 0xce, 0xd1, 0xd1, 0xd2, 0xd5,
-				0xd5, 0xdb, 0xdd, 0xe2, 0xe5, 0xea, 0xed, 0xf2,
-				0xf5, 0xf9, 0xfa, 0xfa, 0xfc, 0xff,
-			},
-		},
-
-		// Group 68
-		{
-			{
-				0x00, 0x01, 0x01, 0x01, 0x02, 0x07, 0x0d, 0x0f,
-				0x16, 0x24, 0x26, 0x26, 0x2c, 0x30, 0x38, 0x39,
-				0x3a, 0x3b, 0x3d, 0x3f, 0x43, 0x44, 0x46, 0x49,
-				0x50, 0x54, 0x56, 0x59, 0x5c, 0x5f, 0x65, 0x69,
-				0x6a, 0x6c, 0x6e, 0x6e, 0x6f, 0x70, 0x73, 0x76,
-				0x7a, 0x7a, 0x7b, 0x7d, 0x82, 0x83, 0x84, 0x91,
-				0x94, 0x98, 0x99, 0x9b, 0x9d, 0x9e, 0xa1, 0xa3,
-				0xa4, 0xa4, 0xac, 0xad, 0xaf, 0xb2, 0xb3, 0xb6,
-				0xb7, 0xb9, 0xbd, 0xbf, 0xcb, 0xcf, 0xd0, 0xd2,
-				0xd2, 0xd4, 0xd4, 0xd7, 0xdc, 0xdd, 0xde, 0xe0,
-				0xe0, 0xe2, 0xe3, 0xf0, 0xfe, 0xff,
-			},
-			{
-				0x00, 0x02, 0x07, 0x09, 0x0d, 0x0f, 0x10, 0x12,
-				0x12, 0x14, 0x18, 0x19, 0x1b, 0x1c, 0x1f, 0x1f,
-				0x27, 0x29, 0x2b, 0x30, 0x31, 0x34, 0x3e, 0x3f,
-				0x41, 0x47, 0x47, 0x4e, 0x4e, 0x4f, 0x50, 0x51,
-				0x53, 0x56, 0x5e, 0x62, 0x68, 0x72, 0x75, 0x77,
-				0x7e, 0x80, 0x8b, 0x8b, 0x8c, 0x8d, 0x8e, 0x99,
-				0x99, 0x9a, 0x9a, 0x9b, 0x9e, 0xa8, 0xae, 0xb0,
-				0xb3, 0xb5, 0xb6, 0xb8, 0xbe, 0xc0, 0xc1, 0xc3,
-				0xc3, 0xc9, 0xcc, 0xcd, 0xd2, 0xd3, 0xdb, 0xde,
-				0xdf, 0xdf, 0xe1, 0xe5, 0xe7, 0xec, 0xee, 0xf1,
-				0xf2, 0xf7, 0xf7, 0xf8, 0xf9, 0xfc,
-			},
-			{
-				0x03, 0x04, 0x0b, 0x10, 0x13, 0x14, 0x16, 0x19,
-				0x1a, 0x1d, 0x1e, 0x1e, 0x28, 0x2a, 0x2e, 0x30,
-				0x33, 0x35, 0x37, 0x39, 0x3b, 0x41, 0x42, 0x43,
-				0x45, 0x48, 0x4a, 0x4c, 0x52, 0x57, 0x58, 0x5c,
-				0x5d, 0x61, 0x62, 0x63, 0x64, 0x65, 0x69, 0x70,
-				0x72, 0x74, 0x75, 0x79, 0x7b, 0x7d, 0x7e, 0x81,
-				0x81, 0x85, 0x95, 0x95, 0x9d, 0x9e, 0x9f, 0xa0,
-				0xa1, 0xa5, 0xa6, 0xa7, 0xac, 0xb0, 0xb2, 0xb5,
-				0xb7, 0xba, 0xbe, 0xc5, 0xca, 0xcb, 0xcb, 0xce,
-				0xd6, 0xdf, 0xe1, 0xe4, 0xe8, 0xe9, 0xe9, 0xea,
-				0xeb, 0xee, 0xf0, 0xf2, 0xfc, 0xfd,
-			},
-			{
-				0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0e, 0x11, 0x21,
-				0x2a, 0x2b, 0x2e, 0x48, 0x4b, 0x4c, 0x4f, 0x51,
-				0x51, 0x52, 0x54, 0x54, 0x55, 0x57, 0x59, 0x5f,
-				0x5f, 0x60, 0x63, 0x66, 0x66, 0x6a, 0x6b, 0x6d,
-				0x6f, 0x71, 0x74, 0x77, 0x78, 0x79, 0x79, 0x7a,
-				0x7c, 0x7e, 0x7f, 0x7f, 0x89, 0x89, 0x8a, 0x8d,
-				0x8d, 0x94, 0x97, 0x9c, 0xa2, 0xa7, 0xa8, 0xa9,
-				0xab, 0xaf, 0xb1, 0xb1, 0xb3, 0xb4, 0xb8, 0xba,
-				0xbb, 0xbb, 0xc2, 0xc4, 0xc7, 0xc8, 0xc9, 0xca,
-				0xcc, 0xcd, 0xd0, 0xd8, 0xd9, 0xe2, 0xe9, 0xef,
-				0xf3, 0xf5, 0xf8, 0xf8, 0xfa, 0xfc,
-			},
-			{
-				0x04, 0x06, 0x06, 0x0b, 0x13, 0x15, 0x16, 0x1b,
-				0x1d, 0x20, 0x21, 0x24, 0x25, 0x27, 0x2a, 0x2d,
-				0x2f, 0x32, 0x33, 0x36, 0x37, 0x3a, 0x4a, 0x4b,
-				0x57, 0x5a, 0x5d, 0x5e, 0x62, 0x68, 0x6c, 0x6d,
-				0x6f, 0x71, 0x75, 0x78, 0x7b, 0x80, 0x83, 0x84,
-				0x87, 0x88, 0x8a, 0x8a, 0x8b, 0x8f, 0x90, 0x93,
-				0x93, 0x97, 0x9a, 0x9f, 0xa2, 0xa3, 0xa5, 0xa7,
-				0xa9, 0xaa, 0xac, 0xad, 0xad, 0xb4, 0xbd, 0xc0,
-				0xc4, 0xc8, 0xcf, 0xd1, 0xd5, 0xda, 0xdb, 0xe0,
-				0xe3, 0xe5, 0xeb, 0xec, 0xed, 0xef, 0xf3, 0xf4,
-				0xf5, 0xf6, 0xf6, 0xfa, 0xfb, 0xfd,
-			},
-			{
-				0x03, 0x05, 0x09, 0x0b, 0x0c, 0x11, 0x12, 0x13,
-				0x17, 0x1a, 0x1b, 0x1c, 0x1f, 0x22, 0x23, 0x25,
-				0x28, 0x28, 0x29, 0x2c, 0x2c, 0x2d, 0x2d, 0x2f,
-				0x31, 0x32, 0x35, 0x36, 0x3b, 0x3e, 0x40, 0x44,
-				0x49, 0x4d, 0x4d, 0x4e, 0x53, 0x55, 0x60, 0x64,
-				0x6b, 0x73, 0x74, 0x78, 0x7c, 0x7c, 0x82, 0x83,
-				0x87, 0x88, 0x8e, 0x8f, 0x90, 0x91, 0x91, 0x92,
-				0x96, 0x97, 0x9c, 0xa6, 0xb4, 0xb5, 0xb9, 0xbc,
-				0xbf, 0xc1, 0xc1, 0xc3, 0xc5, 0xce, 0xd3, 0xd5,
-				0xd6, 0xd8, 0xdc, 0xe4, 0xe6, 0xe6, 0xe8, 0xf1,
-				0xf4, 0xf5, 0xf9, 0xfb, 0xfe, 0xff,
-			},
-			{
-				0x00, 0x05, 0x08, 0x08, 0x0a, 0x0c, 0x0e, 0x15,
-				0x17, 0x18, 0x1a, 0x1e, 0x20, 0x22, 0x23, 0x24,
-				0x27, 0x33, 0x34, 0x38, 0x3c, 0x3c, 0x3d, 0x40,
-				0x42, 0x45, 0x46, 0x47, 0x4b, 0x4d, 0x52, 0x58,
-				0x5a, 0x5b, 0x5b, 0x61, 0x66, 0x67, 0x67, 0x6b,
-				0x6c, 0x76, 0x77, 0x85, 0x86, 0x86, 0x87, 0x8c,
-				0x92, 0x94, 0x96, 0x96, 0x98, 0x9c, 0xa0, 0xa0,
-				0xaa, 0xaa, 0xab, 0xae, 0xb1, 0xb2, 0xbc, 0xc2,
-				0xc6, 0xc6, 0xc7, 0xd0, 0xd1, 0xd1, 0xd4, 0xd7,
-				0xd8, 0xd9, 0xda, 0xdd, 0xde, 0xe7, 0xe8, 0xea,
-				0xeb, 0xed, 0xf0, 0xf2, 0xfa, 0xfe,
-			},
-		},
-
-		// Group 69
-		{
-			{
-				0x03, 0x05, 0x0d, 0x12, 0x12, 0x18, 0x19, 0x1b,
-				0x1b, 0x24, 0x28, 0x29, 0x2c, 0x2f, 0x32, 0x32,
-				0x33, 0x35, 0x36, 0x38, 0x39, 0x3a, 0x40, 0x40,
-				0x41, 0x43, 0x44, 0x46, 0x47, 0x49, 0x4e, 0x4f,
-				0x52, 0x53, 0x62, 0x62, 0x64, 0x65, 0x68, 0x69,
-				0x6b, 0x70, 0x74, 0x7a, 0x7c, 0x82, 0x84, 0x8e,
-				0x91, 0x92, 0x93, 0x98, 0xa1, 0xa1, 0xa2, 0xa4,
-				0xa6, 0xa7, 0xa8, 0xac, 0xaf, 0xb4, 0xb6, 0xb7,
-				0xbd, 0xc3, 0xc7, 0xc7, 0xc9, 0xca, 0xcd, 0xce,
-				0xd2, 0xd5, 0xd8, 0xe0, 0xe2, 0xeb, 0xee, 0xf1,
-				0xf3, 0xf5, 0xf7, 0xf7, 0xfa, 0xfe,
-			},
-			{
-				0x02, 0x03, 0x04, 0x04, 0x05, 0x07, 0x09, 0x0a,
-				0x0e, 0x18, 0x1e, 0x1f, 0x20, 0x21, 0x22, 0x25,
-				0x27, 0x29, 0x2d, 0x2e, 0x30, 0x38, 0x3b, 0x3d,
-				0x3f, 0x41, 0x42, 0x4a, 0x4b, 0x53, 0x57, 0x59,
-				0x5b, 0x5d, 0x5f, 0x61, 0x6f, 0x75, 0x79, 0x7a,
-				0x7b, 0x7b, 0x7d, 0x84, 0x86, 0x87, 0x89, 0x8c,
-				0x8d, 0x93, 0x94, 0x97, 0xa2, 0xa3, 0xa3, 0xaa,
-				0xab, 0xaf, 0xb5, 0xb7, 0xba, 0xbe, 0xc0, 0xc4,
-				0xc8, 0xcf, 0xd0, 0xd3, 0xd9, 0xda, 0xda, 0xdc,
-				0xdc, 0xde, 0xdf, 0xdf, 0xe1, 0xe4, 0xe9, 0xec,
-				0xf2, 0xf3, 0xf4, 0xf8, 0xf8, 0xfc,
-			},
-			{
-				0x0b, 0x0c, 0x0e, 0x0f, 0x14, 0x17, 0x1a, 0x1f,
-				0x26, 0x26, 0x27, 0x2d, 0x2d, 0x39, 0x3f, 0x47,
-				0x4b, 0x4d, 0x4d, 0x4f, 0x50, 0x54, 0x54, 0x55,
-				0x56, 0x5e, 0x60, 0x60, 0x63, 0x64, 0x6a, 0x6d,
-				0x6e, 0x6f, 0x71, 0x73, 0x77, 0x77, 0x7c, 0x7d,
-				0x85, 0x8d, 0x8e, 0x8f, 0x94, 0x95, 0xa0, 0xa3,
-				0xa5, 0xaa, 0xab, 0xac, 0xac, 0xad, 0xb1, 0xb2,
-				0xb5, 0xb6, 0xb8, 0xb9, 0xbb, 0xbf, 0xc0, 0xc6,
-				0xc8, 0xca, 0xcb, 0xcd, 0xce, 0xd1, 0xd2, 0xd3,
-				0xd4, 0xd6, 0xda, 0xe3, 0xe4, 0xe5, 0xe6, 0xe8,
-				0xea, 0xea, 0xec, 0xf6, 0xf7, 0xf8,
-			},
-			{
-				0x00, 0x00, 0x01, 0x01, 0x06, 0x0c, 0x0f, 0x10,
-				0x13, 0x15, 0x1a, 0x1e, 0x21, 0x22, 0x28, 0x2a,
-				0x37, 0x38, 0x3a, 0x3c, 0x42, 0x42, 0x43, 0x44,
-				0x4a, 0x4c, 0x4d, 0x52, 0x57, 0x5c, 0x5e, 0x5f,
-				0x62, 0x65, 0x67, 0x68, 0x71, 0x72, 0x72, 0x76,
-				0x76, 0x78, 0x80, 0x82, 0x89, 0x90, 0x94, 0x96,
-				0x96, 0x9b, 0x9c, 0x9d, 0x9e, 0x9f, 0xa5, 0xa6,
-				0xa7, 0xa9, 0xb6, 0xba, 0xbd, 0xbf, 0xc2, 0xc3,
-				0xc4, 0xc9, 0xcb, 0xcc, 0xcd, 0xdb, 0xdc, 0xdd,
-				0xde, 0xe0, 0xe5, 0xe7, 0xe7, 0xe9, 0xeb, 0xed,
-				0xf1, 0xf9, 0xfb, 0xfb, 0xfd, 0xff,
-			},
-			{
-				0x05, 0x08, 0x09, 0x09, 0x11, 0x13, 0x14, 0x15,
-				0x1c, 0x1d, 0x1f, 0x23, 0x2a, 0x2f, 0x2f, 0x3d,
-				0x3e, 0x3e, 0x46, 0x48, 0x48, 0x48, 0x50, 0x55,
-				0x55, 0x58, 0x59, 0x5a, 0x5c, 0x5f, 0x61, 0x66,
-				0x67, 0x69, 0x6b, 0x6c, 0x6e, 0x6e, 0x79, 0x7e,
-				0x7f, 0x86, 0x87, 0x88, 0x8a, 0x8b, 0x97, 0x99,
-				0x9a, 0x9f, 0xa1, 0xa4, 0xa9, 0xa9, 0xaa, 0xad,
-				0xae, 0xb0, 0xb4, 0xb5, 0xb7, 0xbb, 0xbb, 0xbe,
-				0xc2, 0xc2, 0xcc, 0xcf, 0xd1, 0xd6, 0xd7, 0xd7,
-				0xd8, 0xdd, 0xe1, 0xe2, 0xe3, 0xe5, 0xe6, 0xef,
-				0xf2, 0xf4, 0xf5, 0xfa, 0xfb, 0xfe,
-			},
-			{
-				0x02, 0x04, 0x06, 0x08, 0x0a, 0x0b, 0x0f, 0x11,
-				0x16, 0x16, 0x17, 0x19, 0x20, 0x23, 0x24, 0x25,
-				0x2b, 0x2c, 0x34, 0x36, 0x44, 0x49, 0x49, 0x4b,
-				0x4c, 0x51, 0x51, 0x52, 0x58, 0x59, 0x5d, 0x60,
-				0x63, 0x6a, 0x6c, 0x6d, 0x70, 0x72, 0x74, 0x7f,
-				0x80, 0x81, 0x81, 0x83, 0x8b, 0x8c, 0x8f, 0x90,
-				0x92, 0x95, 0x97, 0x98, 0x99, 0x9a, 0x9b, 0x9c,
-				0xb1, 0xb2, 0xb3, 0xb3, 0xb8, 0xb9, 0xbc, 0xbc,
-				0xbd, 0xc1, 0xc5, 0xc5, 0xca, 0xd0, 0xd1, 0xd5,
-				0xd5, 0xd6, 0xd7, 0xd9, 0xde, 0xe1, 0xe2, 0xe6,
-				0xe8, 0xee, 0xef, 0xf0, 0xfc, 0xff,
-			},
-			{
-				0x03, 0x07, 0x0d, 0x10, 0x12, 0x18, 0x1c, 0x1d,
-				0x1d, 0x20, 0x27, 0x2b, 0x2c, 0x2e, 0x30, 0x31,
-				0x31, 0x33, 0x33, 0x34, 0x35, 0x37, 0x3b, 0x3c,
-				0x43, 0x45, 0x45, 0x45, 0x47, 0x4e, 0x51, 0x56,
-				0x58, 0x5a, 0x5b, 0x5d, 0x63, 0x65, 0x66, 0x67,
-				0x68, 0x6b, 0x6d, 0x70, 0x73, 0x75, 0x78, 0x79,
-				0x7b, 0x7e, 0x83, 0x85, 0x88, 0x8a, 0x8a, 0x91,
-				0x91, 0x9d, 0x9e, 0xa0, 0xa8, 0xae, 0xb0, 0xb1,
-				0xb3, 0xb8, 0xbf, 0xc1, 0xc3, 0xc6, 0xc8, 0xc9,
-				0xd4, 0xd8, 0xdb, 0xdf, 0xed, 0xef, 0xf0, 0xf2,
-				0xf3, 0xf6, 0xf9, 0xf9, 0xfa, 0xfd,
-			},
-		},
-
-		// Group 70
-		{
-			{
-				0x01, 0x02, 0x05, 0x07, 0x08, 0x09, 0x0b, 0x11,
-				0x11, 0x11, 0x12, 0x19, 0x1a, 0x1d, 0x1e, 0x20,
-				0x36, 0x3c, 0x42, 0x43, 0x43, 0x44, 0x45, 0x4f,
-				0x52, 0x59, 0x5d, 0x5d, 0x5f, 0x64, 0x66, 0x6a,
-				0x6d, 0x6d, 0x6e, 0x72, 0x74, 0x75, 0x79, 0x7e,
-				0x7f, 0x82, 0x83, 0x85, 0x86, 0x8c, 0x90, 0x91,
-				0x97, 0x99, 0x9a, 0x9b, 0x9c, 0x9f, 0xa1, 0xa2,
-				0xa6, 0xab, 0xac, 0xae, 0xb1, 0xb4, 0xb6, 0xbc,
-				0xbd, 0xbd, 0xc1, 0xc2, 0xc5, 0xcb, 0xd4, 0xd6,
-				0xda, 0xdc, 0xea, 0xea, 0xec, 0xed, 0xef, 0xef,
-				0xf2, 0xf2, 0xf3, 0xf5, 0xfb, 0xfd,
-			},
-			{
-				0x00, 0x04, 0x07, 0x10, 0x12, 0x14, 0x15, 0x18,
-				0x19, 0x1c, 0x1f, 0x21, 0x24, 0x2a, 0x2c, 0x32,
-				0x32, 0x34, 0x35, 0x36, 0x37, 0x38, 0x39, 0x46,
-				0x49, 0x4a, 0x4c, 0x4e, 0x50, 0x54, 0x55, 0x55,
-				0x57, 0x5a, 0x5a, 0x5c, 0x61, 0x63, 0x65, 0x6b,
-				0x6f, 0x70, 0x77, 0x77, 0x7f, 0x80, 0x83, 0x84,
-				0x87, 0x8c, 0x9c, 0xa4, 0xa5, 0xaa, 0xaf, 0xb0,
-				0xb0, 0xb2, 0xb3, 0xb5, 0xb9, 0xbe, 0xbf, 0xc1,
-				0xc1, 0xc2, 0xc3, 0xc5, 0xca, 0xcc, 0xd2, 0xd2,
-				0xd4, 0xdb, 0xde, 0xdf, 0xe0, 0xe1, 0xe9, 0xee,
-				0xf0, 0xf1, 0xf3, 0xf5, 0xfc, 0xfe,
-			},
-			{
-				0x00, 0x06, 0x0b, 0x0c, 0x0c, 0x0d, 0x15, 0x16,
-				0x17, 0x1b, 0x1c, 0x1e, 0x20, 0x21, 0x22, 0x23,
-				0x25, 0x29, 0x2b, 0x2b, 0x31, 0x31, 0x31, 0x34,
-				0x38, 0x3b, 0x42, 0x47, 0x48, 0x4b, 0x52, 0x57,
-				0x5e, 0x65, 0x68, 0x68, 0x6b, 0x6e, 0x72, 0x73,
-				0x76, 0x79, 0x7a, 0x7b, 0x7b, 0x7d, 0x7e, 0x81,
-				0x84, 0x8a, 0x90, 0x93, 0x94, 0x96, 0x99, 0xa9,
-				0xaf, 0xaf, 0xb2, 0xb3, 0xb6, 0xb6, 0xb9, 0xb9,
-				0xbe, 0xbf, 0xc4, 0xcc, 0xcd, 0xce, 0xd2, 0xd3,
-				0xd3, 0xd4, 0xd5, 0xe1, 0xe2, 0xe5, 0xe6, 0xe8,
-				0xf1, 0xf6, 0xf8, 0xf9, 0xfa, 0xfc,
-			},
-			{
-				0x01, 0x02, 0x08, 0x0d, 0x0d, 0x10, 0x12, 0x13,
-				0x14, 0x16, 0x1b, 0x1d, 0x22, 0x23, 0x24, 0x27,
-				0x28, 0x2f, 0x30, 0x32, 0x35, 0x38, 0x3a, 0x3b,
-				0x3e, 0x3e, 0x41, 0x43, 0x45, 0x46, 0x49, 0x4a,
-				0x4f, 0x56, 0x5a, 0x5b, 0x5f, 0x71, 0x73, 0x75,
-				0x78, 0x7a, 0x7f, 0x80, 0x89, 0x89, 0x8a, 0x8b,
-				0x8b, 0x8e, 0x93, 0x94, 0x94, 0x98, 0x9d, 0xa0,
-				0xa2, 0xa6, 0xaa, 0xad, 0xb0, 0xb4, 0xb8, 0xb8,
-				0xba, 0xbc, 0xc4, 0xc7, 0xc8, 0xca, 0xd0, 0xd1,
-				0xdc, 0xdd, 0xdf, 0xe0, 0xe0, 0xe2, 0xe4, 0xe4,
-				0xe7, 0xe9, 0xea, 0xeb, 0xf4, 0xfe,
-			},
-			{
-				0x00, 0x03, 0x04, 0x06, 0x07, 0x0b, 0x0e, 0x0f,
-				0x0f, 0x13, 0x17, 0x1d, 0x1f, 0x25, 0x26, 0x29,
-				0x2c, 0x2d, 0x2e, 0x33, 0x33, 0x36, 0x3c, 0x3f,
-				0x3f, 0x40, 0x40, 0x40, 0x47, 0x51, 0x54, 0x56,
-				0x58, 0x5d, 0x63, 0x69, 0x6c, 0x6f, 0x70, 0x76,
-				0x78, 0x7c, 0x82, 0x86, 0x8d, 0x8d, 0x8f, 0x91,
-				0x92, 0x95, 0x95, 0x97, 0x9a, 0xa0, 0xa1, 0xa1,
-				0xa3, 0xa7, 0xa8, 0xa8, 0xa9, 0xad, 0xb1, 0xc6,
-				0xc8, 0xc9, 0xcb, 0xce, 0xcf, 0xd6, 0xd7, 0xd8,
-				0xda, 0xdd, 0xde, 0xe3, 0xeb, 0xeb, 0xec, 0xf0,
-				0xf1, 0xf3, 0xf4, 0xf8, 0xfc, 0xff,
-			},
-			{
-				0x01, 0x03, 0x06, 0x08, 0x09, 0x0a, 0x0e, 0x15,
-				0x18, 0x19, 0x26, 0x27, 0x29, 0x2d, 0x2e, 0x2e,
-				0x2f, 0x33, 0x39, 0x3a, 0x44, 0x48, 0x4b, 0x4c,
-				0x4d, 0x4d, 0x51, 0x53, 0x54, 0x59, 0x5c, 0x5e,
-				0x61, 0x62, 0x66, 0x6a, 0x6d, 0x72, 0x73, 0x74,
-				0x75, 0x76, 0x7b, 0x7c, 0x7d, 0x81, 0x87, 0x88,
-				0x88, 0x8e, 0x8f, 0x91, 0x92, 0x96, 0x98, 0x9b,
-				0x9d, 0x9e, 0x9f, 0xa2, 0xa5, 0xa5, 0xad, 0xae,
-				0xb3, 0xb5, 0xb7, 0xba, 0xbc, 0xc3, 0xc9, 0xcb,
-				0xce, 0xcf, 0xd0, 0xd1, 0xd8, 0xd9, 0xdb, 0xdf,
-				0xe3, 0xe5, 0xe8, 0xf6, 0xfb, 0xfd,
-			},
-			{
-				0x05, 0x05, 0x0a, 0x0c, 0x10, 0x1a, 0x1e, 0x23,
-				0x28, 0x2a, 0x30, 0x37, 0x3d, 0x3d, 0x41, 0x4d,
-				0x4e, 0x4f, 0x50, 0x52, 0x53, 0x58, 0x5b, 0x5b,
-				0x60, 0x60, 0x62, 0x64, 0x66, 0x67, 0x67, 0x69,
-				0x6c, 0x6c, 0x70, 0x71, 0x7a, 0x7c, 0x7e, 0x85,
-				0x8b, 0x8f, 0x90, 0x9a, 0x9e, 0xa3, 0xa4, 0xa7,
-				0xa9, 0xab, 0xab, 0xac, 0xb1, 0xb2, 0xb7, 0xbb,
-				0xbb, 0xbd, 0xc0, 0xc0, 0xc0, 0xc6, 0xc7, 0xc7,
-				0xcd, 0xd3, 0xd5, 0xd5, 0xd7, 0xd9, 0xda, 0xe6,
-				0xe7, 0xe8, 0xe9, 0xed, 0xee, 0xf0, 0xf2, 0xf5,
-				0xf7, 0xf7, 0xf8, 0xf9, 0xfa, 0xff,
-			},
-		},
-
-		// Group 71
-		{
-			{
-				0x04, 0x0d, 0x10, 0x11, 0x1c, 0x1e, 0x1e, 0x21,
-				0x23, 0x23, 0x25, 0x2a, 0x2b, 0x2c, 0x37, 0x39,
-				0x3b, 0x3c, 0x44, 0x46, 0x4a, 0x4b, 0x4c, 0x4d,
-				0x50, 0x56, 0x56, 0x58, 0x5a, 0x60, 0x62, 0x64,
-				0x64, 0x66, 0x67, 0x69, 0x6b, 0x6f, 0x6f, 0x70,
-				0x74, 0x76, 0x77, 0x7c, 0x7e, 0x7f, 0x83, 0x89,
-				0x8a, 0x8e, 0x8f, 0x91, 0x93, 0x9c, 0x9c, 0x9d,
-				0xa2, 0xa3, 0xa5, 0xab, 0xb7, 0xb7, 0xb9, 0xc2,
-				0xc4, 0xc5, 0xc7, 0xc8, 0xcf, 0xd3, 0xd3, 0xd7,
-				0xd9, 0xda, 0xe2, 0xea, 0xeb, 0xec, 0xef, 0xf2,
-				0xf4, 0xf9, 0xfc, 0xfe, 0xfe, 0xff,
-			},
-			{
-				0x00, 0x02, 0x05, 0x07, 0x09, 0x0c, 0x0e, 0x12,
-				0x13, 0x14, 0x18, 0x2a, 0x2e, 0x2f, 0x32, 0x35,
-				0x35, 0x37, 0x3a, 0x40, 0x47, 0x4d, 0x51, 0x52,
-				0x54, 0x58, 0x5a, 0x5c, 0x5e, 0x5f, 0x61, 0x66,
-				0x6a, 0x6b, 0x6c, 0x6c, 0x6d, 0x6e, 0x73, 0x79,
-				0x7a, 0x7d, 0x81, 0x82, 0x85, 0x8c, 0x8d, 0x90,
-				0x92, 0x92, 0x94, 0x95, 0x97, 0x98, 0x98, 0x9f,
-				0xa1, 0xa4, 0xa7, 0xa8, 0xac, 0xad, 0xb2, 0xb3,
-				0xb8, 0xbb, 0xbb, 0xbd, 0xc3, 0xc7, 0xc9, 0xcb,
-				0xcb, 0xd2, 0xd5, 0xd5, 0xd6, 0xd7, 0xd8, 0xdc,
-				0xde, 0xe3, 0xe7, 0xf0, 0xf5, 0xfd,
-			},
-			{
-				0x01, 0x03, 0x03, 0x0a, 0x0b, 0x0f, 0x11, 0x14,
-				0x16, 0x17, 0x1f, 0x21, 0x24, 0x25, 0x27, 0x28,
-				0x2a, 0x2b, 0x30, 0x31, 0x32, 0x33, 0x34, 0x36,
-				0x36, 0x38, 0x39, 0x3a, 0x3e, 0x43, 0x44, 0x47,
-				0x49, 0x49, 0x4a, 0x4c, 0x51, 0x54, 0x57, 0x57,
-				0x59, 0x62, 0x63, 0x64, 0x65, 0x68, 0x6a, 0x70,
-				0x71, 0x72, 0x74, 0x7b, 0x89, 0x8a, 0x8b, 0x8f,
-				0x98, 0x9a, 0xa1, 0xa5, 0xaa, 0xac, 0xaf, 0xb1,
-				0xb4, 0xb6, 0xba, 0xbe, 0xc1, 0xc3, 0xc3, 0xd0,
-				0xd1, 0xd2, 0xd4, 0xd4, 0xd8, 0xdb, 0xdf, 0xe0,
-				0xe2, 0xe5, 0xef, 0xf5, 0xf7, 0xf9,
-			},
-			{
-				0x07, 0x08, 0x08, 0x0c, 0x0f, 0x17, 0x1a, 0x20,
-				0x28, 0x29, 0x2d, 0x32, 0x37, 0x3b, 0x3f, 0x45,
-				0x46, 0x4a, 0x4b, 0x4e, 0x4f, 0x52, 0x59, 0x5d,
-				0x5f, 0x60, 0x67, 0x69, 0x6f, 0x73, 0x73, 0x74,
-				0x76, 0x78, 0x79, 0x7a, 0x7c, 0x7d, 0x80, 0x80,
-				0x82, 0x84, 0x87, 0x8b, 0x8b, 0x8c, 0x8d, 0x8e,
-				0x94, 0x99, 0x9a, 0x9b, 0x9d, 0x9f, 0xa3, 0xa6,
-				0xa7, 0xaa, 0xad, 0xad, 0xae, 0xb1, 0xb8, 0xba,
-				0xbc, 0xbd, 0xc1, 0xc4, 0xc9, 0xcb, 0xd1, 0xdb,
-				0xdc, 0xdd, 0xdf, 0xe0, 0xe6, 0xeb, 0xef, 0xf0,
-				0xf1, 0xf2, 0xf3, 0xf6, 0xf8, 0xfc,
-			},
-			{
-				0x00, 0x04, 0x06, 0x0a, 0x0e, 0x0f, 0x10, 0x13,
-				0x15, 0x19, 0x19, 0x1d, 0x1f, 0x20, 0x20, 0x24,
-				0x2c, 0x2e, 0x30, 0x31, 0x3c, 0x41, 0x43, 0x45,
-				0x4e, 0x4e, 0x4f, 0x4f, 0x53, 0x54, 0x56, 0x58,
-				0x5b, 0x5e, 0x60, 0x63, 0x63, 0x65, 0x6e, 0x77,
-				0x78, 0x7c, 0x7e, 0x81, 0x84, 0x88, 0x95, 0x95,
-				0x96, 0x9e, 0xa0, 0xa0, 0xa0, 0xa9, 0xae, 0xb0,
-				0xb0, 0xb1, 0xb5, 0xb6, 0xb8, 0xb9, 0xba, 0xbc,
-				0xbd, 0xc1, 0xc2, 0xc5, 0xc5, 0xc6, 0xc7, 0xca,
-				0xca, 0xce, 0xce, 0xcf, 0xd0, 0xd5, 0xd6, 0xde,
-				0xdf, 0xe1, 0xe4, 0xf1, 0xf7, 0xfa,
-			},
-			{
-				0x02, 0x05, 0x09, 0x0b, 0x0b, 0x12, 0x16, 0x19,
-				0x26, 0x26, 0x2f, 0x31, 0x38, 0x3b, 0x3c, 0x3d,
-				0x3e, 0x40, 0x42, 0x42, 0x46, 0x53, 0x55, 0x55,
-				0x5b, 0x68, 0x6c, 0x71, 0x72, 0x75, 0x79, 0x7d,
-				0x7f, 0x84, 0x85, 0x86, 0x86, 0x88, 0x8c, 0x8d,
-				0x90, 0x91, 0x93, 0x97, 0x99, 0x9b, 0x9b, 0xa2,
-				0xa4, 0xa6, 0xa6, 0xa9, 0xaa, 0xac, 0xae, 0xaf,
-				0xb2, 0xb5, 0xbe, 0xbf, 0xc0, 0xca, 0xcc, 0xcc,
-				0xd2, 0xd9, 0xda, 0xdd, 0xe1, 0xe3, 0xe4, 0xe6,
-				0xe7, 0xe8, 0xe9, 0xe9, 0xec, 0xed, 0xed, 0xee,
-				0xf0, 0xf3, 0xf6, 0xf8, 0xfa, 0xfd,
-			},
-			{
-				0x00, 0x01, 0x06, 0x09, 0x0d, 0x11, 0x14, 0x15,
-				0x18, 0x1a, 0x1b, 0x1b, 0x1c, 0x1d, 0x1e, 0x22,
-				0x22, 0x25, 0x26, 0x27, 0x29, 0x2d, 0x33, 0x34,
-				0x39, 0x3d, 0x3e, 0x3f, 0x41, 0x48, 0x48, 0x4c,
-				0x50, 0x52, 0x53, 0x5c, 0x5d, 0x61, 0x68, 0x6d,
-				0x75, 0x75, 0x77, 0x7a, 0x7b, 0x83, 0x86, 0x87,
-				0x8e, 0x94, 0x96, 0x9e, 0xa8, 0xab, 0xaf, 0xb3,
-				0xb4, 0xb5, 0xbc, 0xbe, 0xbf, 0xbf, 0xc0, 0xc2,
-				0xc6, 0xc6, 0xc8, 0xcd, 0xcd, 0xd4, 0xd9, 0xe1,
-				0xe4, 0xe5, 0xe7, 0xe8, 0xe9, 0xea, 0xee, 0xf4,
-				0xf6, 0xf8, 0xfb, 0xfb, 0xfe, 0xff,
-			},
-		},
-
-		// Group 72
-		{
-			{
-				0x01, 0x02, 0x03, 0x04, 0x06, 0x07, 0x0a, 0x0c,
-				0x0d, 0x10, 0x11, 0x15, 0x1a, 0x20, 0x21, 0x28,
-				0x28, 0x2f, 0x32, 0x34, 0x35, 0x37, 0x3e, 0x3f,
-				0x45, 0x46, 0x49, 0x4a, 0x51, 0x53, 0x54, 0x56,
-				0x59, 0x59, 0x5c, 0x60, 0x61, 0x66, 0x6c, 0x6e,
-				0x71, 0x71, 0x72, 0x79, 0x7e, 0x81, 0x84, 0x8a,
-				0x8b, 0x8c, 0x96, 0xa0, 0xa1, 0xa2, 0xa4, 0xa4,
-				0xa7, 0xa8, 0xa9, 0xa9, 0xad, 0xb3, 0xb3, 0xb5,
-				0xb7, 0xb8, 0xbe, 0xc2, 0xc6, 0xc8, 0xcb, 0xcd,
-				0xd3, 0xd3, 0xda, 0xdc, 0xe0, 0xe1, 0xe2, 0xee,
-				0xf2, 0xf3, 0xf4, 0xf5, 0xfb, 0xfe,
-			},
-			{
-				0x00, 0x08, 0x0b, 0x0d, 0x11, 0x13, 0x16, 0x1c,
-				0x1d, 0x1f, 0x21, 0x23, 0x24, 0x27, 0x2d, 0x2e,
-				0x33, 0x38, 0x3c, 0x3e, 0x42, 0x43, 0x43, 0x44,
-				0x46, 0x4d, 0x4f, 0x53, 0x55, 0x58, 0x58, 0x5d,
-				0x65, 0x67, 0x68, 0x6a, 0x6b, 0x6d, 0x6d, 0x71,
-				0x73, 0x76, 0x7c, 0x7d, 0x7d, 0x80, 0x80, 0x81,
-				0x83, 0x84, 0x89, 0x93, 0x93, 0x94, 0x96, 0x99,
-				0x9c, 0x9d, 0x9e, 0x9e, 0xa2, 0xa6, 0xb0, 0xb1,
-				0xb7, 0xbb, 0xbd, 0xc1, 0xc1, 0xc3, 0xc4, 0xc9,
-				0xcc, 0xcc, 0xd5, 0xdb, 0xdd, 0xe3, 0xe8, 0xe9,
-				0xeb, 0xf0, 0xf1, 0xf5, 0xfd, 0xff,
-			},
-			{
-				0x00, 0x01, 0x04, 0x05, 0x05, 0x09, 0x0b, 0x0e,
-				0x0f, 0x12, 0x12, 0x14, 0x16, 0x18, 0x1b, 0x1d,
-				0x1e, 0x20, 0x27, 0x2a, 0x2b, 0x2c, 0x3c, 0x3c,
-				0x3e, 0x40, 0x44, 0x47, 0x4b, 0x4c, 0x4c, 0x55,
-				0x5a, 0x5b, 0x60, 0x62, 0x67, 0x68, 0x6b, 0x6e,
-				0x73, 0x74, 0x78, 0x7a, 0x82, 0x8f, 0x8f, 0x90,
-				0x91, 0x9a, 0x9a, 0x9b, 0x9b, 0xa0, 0xa5, 0xa6,
-				0xaf, 0xba, 0xbf, 0xc1, 0xc5, 0xc5, 0xc6, 0xd1,
-				0xd1, 0xd4, 0xd4, 0xd6, 0xd8, 0xda, 0xdc, 0xdd,
-				0xde, 0xdf, 0xe0, 0xe3, 0xe4, 0xe5, 0xe6, 0xef,
-				0xef, 0xf7, 0xf7, 0xf8, 0xfb, 0xfc,
-			},
-			{
-				0x0c, 0x0d, 0x10, 0x15, 0x1b, 0x1e, 0x22, 0x23,
-				0x29, 0x2c, 0x2f, 0x31, 0x31, 0x35, 0x39, 0x3b,
-				0x3b, 0x3f, 0x43, 0x45, 0x47, 0x48, 0x4b, 0x4e,
-				0x4e, 0x4f, 0x50, 0x54, 0x56, 0x57, 0x5a, 0x5c,
-				0x60, 0x63, 0x6c, 0x6f, 0x70, 0x73, 0x75, 0x7a,
-				0x7e, 0x80, 0x81, 0x82, 0x83, 0x85, 0x88, 0x88,
-				0x8a, 0x8d, 0x8e, 0x90, 0x95, 0x98, 0x9c, 0x9e,
-				0xaa, 0xab, 0xac, 0xb2, 0xb3, 0xb6, 0xba, 0xbc,
-				0xbe, 0xc8, 0xca, 0xd1, 0xd2, 0xd3, 0xd7, 0xdb,
-				0xdd, 0xde, 0xe2, 0xe5, 0xea, 0xee, 0xf0, 0xf4,
-				0xf6, 0xf8, 0xf9, 0xfa, 0xfd, 0xfd,
-			},
-			{
-				0x03, 0x06, 0x09, 0x09, 0x0b, 0x0e, 0x10, 0x17,
-				0x19, 0x19, 0x1a, 0x25, 0x26, 0x29, 0x2b, 0x30,
-				0x32, 0x38, 0x3d, 0x40, 0x41, 0x45, 0x48, 0x4c,
-				0x4f, 0x50, 0x50, 0x51, 0x57, 0x5f, 0x64, 0x65,
-				0x66, 0x69, 0x70, 0x72, 0x72, 0x78, 0x7b, 0x7c,
-				0x86, 0x88, 0x8c, 0x8d, 0x8e, 0x92, 0x95, 0x97,
-				0x99, 0x9f, 0xa3, 0xa5, 0xa7, 0xaa, 0xae, 0xaf,
-				0xb0, 0xb2, 0xb4, 0xb8, 0xb8, 0xb9, 0xba, 0xbb,
-				0xc0, 0xc2, 0xc7, 0xc9, 0xcb, 0xce, 0xd9, 0xda,
-				0xdf, 0xe1, 0xe3, 0xe4, 0xe5, 0xe7, 0xe8, 0xeb,
-				0xec, 0xed, 0xf1, 0xf3, 0xf7, 0xfe,
-			},
-			{
-				0x02, 0x06, 0x07, 0x0f, 0x13, 0x17, 0x18, 0x1c,
-				0x1d, 0x1f, 0x22, 0x24, 0x26, 0x27, 0x29, 0x2a,
-				0x2d, 0x33, 0x36, 0x36, 0x40, 0x41, 0x42, 0x47,
-				0x48, 0x4a, 0x4d, 0x4d, 0x52, 0x57, 0x59, 0x5a,
-				0x5d, 0x5f, 0x62, 0x69, 0x6a, 0x77, 0x77, 0x79,
-				0x7b, 0x7c, 0x7f, 0x85, 0x86, 0x87, 0x8c, 0x90,
-				0x92, 0x97, 0x98, 0xa1, 0xa3, 0xa7, 0xad, 0xaf,
-				0xb4, 0xb5, 0xb9, 0xbc, 0xc0, 0xc3, 0xc7, 0xca,
-				0xcb, 0xcd, 0xce, 0xcf, 0xcf, 0xd0, 0xd2, 0xd6,
-				0xd6, 0xd7, 0xd8, 0xd8, 0xe7, 0xec, 0xed, 0xef,
-				0xf4, 0xf5, 0xf9, 0xfa, 0xfc, 0xff,
-			},
-			{
-				0x08, 0x0a, 0x14, 0x19, 0x1e, 0x1f, 0x25, 0x2e,
-				0x30, 0x33, 0x34, 0x36, 0x37, 0x39, 0x3a, 0x3a,
-				0x3d, 0x3d, 0x46, 0x49, 0x4a, 0x4b, 0x52, 0x5b,
-				0x5e, 0x5e, 0x5e, 0x61, 0x62, 0x63, 0x63, 0x64,
-				0x65, 0x66, 0x67, 0x69, 0x6f, 0x6f, 0x74, 0x75,
-				0x76, 0x7a, 0x7d, 0x7e, 0x7f, 0x7f, 0x82, 0x87,
-				0x89, 0x8b, 0x8d, 0x91, 0x92, 0x94, 0x9d, 0x9f,
-				0xa5, 0xa8, 0xaa, 0xab, 0xac, 0xae, 0xb1, 0xb2,
-				0xb5, 0xb6, 0xbd, 0xbf, 0xc4, 0xc5, 0xca, 0xce,
-				0xd0, 0xd5, 0xd9, 0xe6, 0xe9, 0xea, 0xed, 0xee,
-				0xf2, 0xf2, 0xf3, 0xf6, 0xf9, 0xfe,
-			},
-		},
-
-		// Group 73
-		{
-			{
-				0x00, 0x08, 0x09, 0x0a, 0x0d, 0x11, 0x19, 0x1a,
-				0x1e, 0x1e, 0x25, 0x25, 0x29, 0x2f, 0x36, 0x3d,
-				0x3d, 0x3f, 0x40, 0x42, 0x44, 0x44, 0x46, 0x48,
-				0x4c, 0x4f, 0x4f, 0x50, 0x53, 0x5c, 0x5d, 0x5e,
-				0x5f, 0x60, 0x61, 0x62, 0x63, 0x69, 0x71, 0x73,
-				0x76, 0x7c, 0x83, 0x8a, 0x8b, 0x90, 0x93, 0x95,
-				0x97, 0x9a, 0x9e, 0xa0, 0xa1, 0xa2, 0xa4, 0xa6,
-				0xaa, 0xab, 0xac, 0xb6, 0xb6, 0xb7, 0xb9, 0xbc,
-				0xbe, 0xbe, 0xc0, 0xc3, 0xc5, 0xc6, 0xcb, 0xd8,
-				0xdc, 0xdc, 0xdf, 0xe1, 0xe4, 0xe6, 0xe6, 0xe7,
-				0xea, 0xef, 0xf9, 0xfb, 0xfc, 0xff,
-			},
-			{
-				0x01, 0x02, 0x04, 0x09, 0x0b, 0x0e, 0x12, 0x15,
-				0x15, 0x17, 0x1a, 0x1c, 0x20, 0x27, 0x33, 0x34,
-				0x35, 0x38, 0x45, 0x4b, 0x4c, 0x52, 0x54, 0x54,
-				0x57, 0x59, 0x59, 0x5b, 0x5c, 0x62, 0x64, 0x65,
-				0x6c, 0x6d, 0x6e, 0x6f, 0x71, 0x72, 0x76, 0x77,
-				0x7c, 0x85, 0x86, 0x88, 0x92, 0x94, 0x95, 0x96,
-				0x98, 0x9c, 0x9d, 0x9f, 0x9f, 0xa3, 0xa6, 0xa9,
-				0xaa, 0xab, 0xac, 0xaf, 0xaf, 0xb0, 0xb9, 0xba,
-				0xbd, 0xc1, 0xc3, 0xc9, 0xcb, 0xcc, 0xcd, 0xce,
-				0xd0, 0xd3, 0xd5, 0xd6, 0xd7, 0xdc, 0xe8, 0xe9,
-				0xee, 0xf1, 0xf2, 0xfd, 0xfe, 0xff,
-			},
-			{
-				0x03, 0x03, 0x10, 0x16, 0x18, 0x1b, 0x1b, 0x1f,
-				0x21, 0x22, 0x23, 0x2a, 0x2d, 0x31, 0x38, 0x3a,
-				0x3e, 0x42, 0x44, 0x48, 0x49, 0x49, 0x4a, 0x4c,
-				0x4d, 0x50, 0x51, 0x52, 0x54, 0x55, 0x5a, 0x64,
-				0x65, 0x66, 0x67, 0x68, 0x6b, 0x6b, 0x6e, 0x6f,
-				0x71, 0x73, 0x74, 0x77, 0x78, 0x79, 0x7b, 0x7f,
-				0x81, 0x81, 0x82, 0x84, 0x89, 0x8c, 0x8d, 0x8f,
-				0x96, 0x97, 0x99, 0x99, 0x9b, 0x9d, 0xa0, 0xa4,
-				0xa5, 0xa9, 0xad, 0xae, 0xb1, 0xb2, 0xb5, 0xba,
-				0xbc, 0xc8, 0xca, 0xd1, 0xda, 0xe0, 0xe3, 0xe3,
-				0xe9, 0xea, 0xea, 0xec, 0xec, 0xf1,
-			},
-			{
-				0x05, 0x0b, 0x0c, 0x0f, 0x12, 0x14, 0x15, 0x19,
-				0x26, 0x27, 0x29, 0x2b, 0x2c, 0x2d, 0x2e, 0x30,
-				0x36, 0x39, 0x39, 0x3f, 0x40, 0x42, 0x46, 0x4e,
-				0x50, 0x53, 0x5a, 0x5a, 0x5b, 0x5d, 0x5e, 0x5f,
-				0x67, 0x68, 0x6a, 0x6c, 0x6c, 0x74, 0x7a, 0x7d,
-				0x7f, 0x80, 0x84, 0x86, 0x8b, 0x8e, 0x8e, 0x90,
-				0x92, 0x98, 0xa7, 0xa8, 0xae, 0xb1, 0xb4, 0xb5,
-				0xbb, 0xbd, 0xbf, 0xbf, 0xc2, 0xc8, 0xc9, 0xcb,
-				0xcd, 0xcf, 0xd1, 0xd2, 0xd2, 0xd6, 0xd8, 0xd9,
-				0xe0, 0xe2, 0xe8, 0xeb, 0xee, 0xf0, 0xf0, 0xf2,
-				0xf4, 0xf5, 0xf6, 0xf7, 0xf9, 0xfd,
-			},
-			{
-				0x00, 0x02, 0x06, 0x06, 0x09, 0x0d, 0x0e, 0x13,
-				0x14, 0x16, 0x17, 0x1c, 0x1d, 0x20, 0x21, 0x24,
-				0x24, 0x25, 0x26, 0x26, 0x28, 0x2b, 0x2c, 0x2e,
-				0x2e, 0x32, 0x33, 0x34, 0x38, 0x39, 0x3b, 0x3b,
-				0x3c, 0x3c, 0x43, 0x47, 0x4a, 0x4b, 0x4b, 0x4d,
-				0x52, 0x55, 0x56, 0x56, 0x57, 0x60, 0x61, 0x69,
-				0x70, 0x75, 0x80, 0x82, 0x83, 0x8c, 0x91, 0x92,
-				0x94, 0x96, 0x9c, 0x9e, 0xa3, 0xb0, 0xb3, 0xb8,
-				0xb8, 0xbb, 0xc1, 0xc7, 0xca, 0xcc, 0xcf, 0xd3,
-				0xd4, 0xd9, 0xdb, 0xde, 0xdf, 0xe2, 0xe5, 0xe7,
-				0xed, 0xf4, 0xf5, 0xf8, 0xfa, 0xfa,
-			},
-			{
-				0x01, 0x04, 0x07, 0x08, 0x0a, 0x0f, 0x10, 0x13,
-				0x13, 0x16, 0x1b, 0x1c, 0x1d, 0x27, 0x2d, 0x2f,
-				0x30, 0x31, 0x32, 0x35, 0x37, 0x37, 0x3a, 0x3e,
-				0x41, 0x43, 0x47, 0x51, 0x55, 0x63, 0x6a, 0x6a,
-				0x6e, 0x70, 0x73, 0x75, 0x77, 0x79, 0x7a, 0x7d,
-				0x7e, 0x84, 0x85, 0x87, 0x87, 0x88, 0x89, 0x8a,
-				0x8b, 0x8c, 0x8d, 0xa2, 0xa5, 0xa7, 0xa8, 0xa9,
-				0xac, 0xae, 0xb1, 0xb2, 0xb5, 0xc0, 0xc4, 0xc7,
-				0xd0, 0xd4, 0xd5, 0xd6, 0xd7, 0xd8, 0xd9, 0xda,
-				0xdd, 0xdd, 0xde, 0xe0, 0xe1, 0xe4, 0xe6, 0xe7,
-				0xe9, 0xeb, 0xef, 0xf3, 0xf4, 0xfc,
-			},
-			{
-				0x01, 0x05, 0x07, 0x07, 0x0b, 0x0c, 0x11, 0x14,
-				0x18, 0x1e, 0x1f, 0x22, 0x23, 0x24, 0x28, 0x2a,
-				0x32, 0x35, 0x36, 0x3b, 0x3d, 0x41, 0x45, 0x4e,
-				0x56, 0x57, 0x58, 0x58, 0x5e, 0x64, 0x65, 0x66,
-				0x67, 0x68, 0x6d, 0x72, 0x75, 0x78, 0x7b, 0x7d,
-				0x7e, 0x7f, 0x85, 0x8e, 0x8f, 0x91, 0x93, 0x95,
-				0x9a, 0x9b, 0x9d, 0xa0, 0xa1, 0xa4, 0xad, 0xb3,
-				0xb4, 0xb6, 0xb7, 0xb8, 0xbb, 0xbe, 0xbf, 0xc2,
-				0xc4, 0xc5, 0xc5, 0xc6, 0xcd, 0xce, 0xd1, 0xdb,
-				0xdd, 0xe5, 0xe8, 0xec, 0xed, 0xf3, 0xf6, 0xf6,
-				0xf7, 0xf8, 0xf8, 0xfb, 0xfb, 0xfe,
-			},
-		},
-
-		// Group 74
-		{
-			{
-				0x05, 0x07, 0x1b, 0x1d, 0x24, 0x24, 0x25, 0x25,
-				0x26, 0x2a, 0x2b, 0x2c, 0x31, 0x31, 0x33, 0x34,
-				0x37, 0x38, 0x38, 0x3c, 0x3d, 0x41, 0x44, 0x46,
-				0x4d, 0x56, 0x57, 0x59, 0x5b, 0x61, 0x62, 0x62,
-				0x64, 0x65, 0x66, 0x68, 0x6c, 0x6e, 0x74, 0x76,
-				0x78, 0x7c, 0x7c, 0x7d, 0x7e, 0x80, 0x84, 0x86,
-				0x88, 0x99, 0x9f, 0xa4, 0xa7, 0xa9, 0xae, 0xb7,
-				0xb9, 0xba, 0xbb, 0xbc, 0xbf, 0xc0, 0xc0, 0xc5,
-				0xc8, 0xcb, 0xcd, 0xcd, 0xd0, 0xd2, 0xda, 0xdd,
-				0xde, 0xe1, 0xe1, 0xe9, 0xeb, 0xf2, 0xf4, 0xf8,
-				0xf8, 0xfb, 0xfc, 0xfd, 0xfe, 0xff,
-			},
-			{
-				0x01, 0x02, 0x03, 0x0b, 0x0f, 0x10, 0x11, 0x17,
-				0x18, 0x19, 0x1a, 0x1a, 0x1e, 0x1f, 0x20, 0x24,
-				0x2e, 0x2f, 0x30, 0x33, 0x35, 0x36, 0x36, 0x37,
-				0x3a, 0x42, 0x45, 0x46, 0x48, 0x4b, 0x4c, 0x4e,
-				0x4e, 0x50, 0x55, 0x5a, 0x5c, 0x6c, 0x6d, 0x6d,
-				0x6e, 0x71, 0x71, 0x79, 0x7a, 0x7b, 0x83, 0x85,
-				0x87, 0x88, 0x89, 0x8a, 0x8c, 0x8d, 0x8f, 0x95,
-				0x96, 0xa6, 0xa8, 0xaa, 0xac, 0xaf, 0xb2, 0xb8,
-				0xba, 0xbb, 0xc4, 0xc7, 0xca, 0xcc, 0xce, 0xd1,
-				0xd1, 0xd2, 0xd7, 0xd8, 0xd9, 0xda, 0xdc, 0xe5,
-				0xee, 0xf1, 0xf2, 0xf3, 0xf7, 0xfb,
-			},
-			{
-				0x02, 0x05, 0x06, 0x08, 0x0a, 0x0c, 0x0c, 0x0d,
-				0x0d, 0x11, 0x13, 0x16, 0x1c, 0x1e, 0x23, 0x23,
-				0x27, 0x28, 0x28, 0x2a, 0x2d, 0x2e, 0x33, 0x35,
-				0x39, 0x3e, 0x3e, 0x42, 0x47, 0x49, 0x4d, 0x4f,
-				0x5a, 0x5c, 0x5e, 0x60, 0x65, 0x6e, 0x6f, 0x71,
-				0x72, 0x74, 0x7d, 0x7f, 0x82, 0x82, 0x83, 0x88,
-				0x8a, 0x8f, 0x96, 0x97, 0x9b, 0x9c, 0x9e, 0xa3,
-				0xa5, 0xa6, 0xab, 0xab, 0xaf, 0xb1, 0xb2, 0xb3,
-				0xb3, 0xbd, 0xbe, 0xbf, 0xc2, 0xc3, 0xc3, 0xc5,
-				0xc6, 0xc7, 0xce, 0xcf, 0xd4, 0xd6, 0xd7, 0xe5,
-				0xeb, 0xec, 0xfa, 0xfb, 0xfc, 0xfe,
-			},
-			{
-				0x06, 0x08, 0x0b, 0x0e, 0x12, 0x13, 0x14, 0x19,
-				0x19, 0x1c, 0x1c, 0x1d, 0x1f, 0x20, 0x25, 0x2b,
-				0x2c, 0x2d, 0x2d, 0x32, 0x34, 0x3b, 0x3b, 0x3c,
-				0x3e, 0x3f, 0x45, 0x46, 0x47, 0x4a, 0x4e, 0x50,
-				0x50, 0x53, 0x53, 0x54, 0x57, 0x58, 0x58, 0x5d,
-				0x5e, 0x61, 0x65, 0x69, 0x6d, 0x70, 0x70, 0x70,
-				0x72, 0x77, 0x78, 0x81, 0x84, 0x85, 0x89, 0x8c,
-				0x8f, 0x90, 0x92, 0x94, 0x97, 0x9e, 0x9f, 0xa5,
-				0xb0, 0xb1, 0xb7, 0xb8, 0xbd, 0xc2, 0xca, 0xcf,
-				0xd1, 0xd3, 0xd8, 0xdf, 0xe3, 0xe4, 0xe7, 0xe7,
-				0xea, 0xed, 0xef, 0xf6, 0xf6, 0xf9,
-			},
-			{
-				0x00, 0x03, 0x04, 0x09, 0x0a, 0x0f, 0x10, 0x13,
-				0x16, 0x17, 0x1e, 0x21, 0x22, 0x26, 0x26, 0x2f,
-				0x30, 0x35, 0x3b, 0x3d, 0x3f, 0x40, 0x41, 0x43,
-				0x44, 0x45, 0x48, 0x4a, 0x4b, 0x4f, 0x52, 0x5d,
-				0x5f, 0x66, 0x67, 0x67, 0x69, 0x6a, 0x72, 0x77,
-				0x7f, 0x8d, 0x8e, 0x90, 0x92, 0x95, 0x99, 0x9a,
-				0x9d, 0xa2, 0xa7, 0xaa, 0xac, 0xb0, 0xb8, 0xba,
-				0xbc, 0xbe, 0xbe, 0xbf, 0xc1, 0xc4, 0xc6, 0xc6,
-				0xcb, 0xd0, 0xd3, 0xd5, 0xd8, 0xdc, 0xdc, 0xde,
-				0xdf, 0xe0, 0xe1, 0xe4, 0xea, 0xea, 0xef, 0xf0,
-				0xf2, 0xf3, 0xf4, 0xf5, 0xf9, 0xfa,
-			},
-			{
-				0x00, 0x01, 0x01, 0x06, 0x07, 0x0d, 0x0e, 0x14,
-				0x15, 0x15, 0x15, 0x18, 0x1b, 0x1b, 0x1d, 0x21,
-				0x22, 0x27, 0x29, 0x38, 0x39, 0x3c, 0x43, 0x49,
-				0x49, 0x4b, 0x55, 0x57, 0x59, 0x5a, 0x5f, 0x60,
-				0x62, 0x64, 0x68, 0x6b, 0x74, 0x75, 0x75, 0x79,
-				0x7b, 0x7f, 0x84, 0x87, 0x8b, 0x8d, 0x8e, 0x93,
-				0x93, 0x94, 0x98, 0x98, 0x9a, 0x9d, 0x9f, 0xa1,
-				0xa2, 0xa5, 0xa8, 0xa9, 0xb4, 0xb5, 0xb5, 0xb9,
-				0xc9, 0xc9, 0xcc, 0xd4, 0xd5, 0xd7, 0xd9, 0xdb,
-				0xde, 0xe2, 0xe3, 0xe6, 0xe8, 0xe8, 0xe9, 0xf0,
-				0xf1, 0xf5, 0xf6, 0xf7, 0xfd, 0xff,
-			},
-			{
-				0x04, 0x05, 0x09, 0x0c, 0x11, 0x12, 0x18, 0x22,
-				0x29, 0x2a, 0x2b, 0x2f, 0x31, 0x32, 0x3a, 0x3d,
-				0x40, 0x44, 0x4c, 0x4d, 0x4f, 0x51, 0x51, 0x52,
-				0x54, 0x56, 0x56, 0x59, 0x5b, 0x5b, 0x60, 0x63,
-				0x63, 0x6a, 0x6b, 0x6f, 0x73, 0x73, 0x76, 0x7a,
-				0x7c, 0x7e, 0x80, 0x80, 0x81, 0x82, 0x86, 0x8b,
-				0x91, 0x91, 0x97, 0x9b, 0x9c, 0xa0, 0xa0, 0xa1,
-				0xa3, 0xa4, 0xa8, 0xad, 0xad, 0xae, 0xb4, 0xb4,
-				0xb6, 0xb6, 0xc0, 0xc1, 0xc8, 0xc9, 0xcc, 0xd2,
-				0xd6, 0xdb, 0xdd, 0xdf, 0xe0, 0xe2, 0xe6, 0xe7,
-				0xec, 0xec, 0xed, 0xee, 0xf5, 0xf8,
-			},
-		},
-
-		// Group 75
-		{
-			{
-				0x02, 0x03, 0x04, 0x06, 0x0c, 0x0d, 0x0f, 0x12,
-				0x20, 0x23, 0x29, 0x2b, 0x2e, 0x2e, 0x31, 0x34,
-				0x37, 0x3a, 0x3b, 0x40, 0x47, 0x49, 0x4e, 0x4e,
-				0x50, 0x5e, 0x60, 0x62, 0x6c, 0x70, 0x71, 0x74,
-				0x75, 0x77, 0x7c, 0x7f, 0x82, 0x86, 0x88, 0x8a,
-				0x8b, 0x8b, 0x8f, 0x8f, 0x8f, 0x90, 0x93, 0x95,
-				0x9b, 0xa0, 0xa1, 0xa2, 0xa5, 0xa8, 0xaa, 0xac,
-				0xb2, 0xb4, 0xb4, 0xb5, 0xbf, 0xc1, 0xc2, 0xc4,
-				0xc4, 0xc6, 0xc7, 0xc9, 0xcd, 0xcd, 0xce, 0xd2,
-				0xd8, 0xd9, 0xd9, 0xdc, 0xe3, 0xe7, 0xea, 0xea,
-				0xec, 0xee, 0xf0, 0xf9, 0xfb, 0xfe,
-			},
-			{
-				0x01, 0x01, 0x04, 0x07, 0x0a, 0x10, 0x11, 0x11,
-				0x16, 0x16, 0x18, 0x18, 0x19, 0x1a, 0x1c, 0x1c,
-				0x27, 0x27, 0x30, 0x33, 0x35, 0x3a, 0x3c, 0x3f,
-				0x44, 0x47, 0x48, 0x4f, 0x54, 0x55, 0x56, 0x57,
-				0x59, 0x5a, 0x5b, 0x5b, 0x5b, 0x5d, 0x5e, 0x65,
-				0x68, 0x69, 0x6a, 0x6d, 0x6e, 0x7c, 0x7e, 0x81,
-				0x84, 0x89, 0x8c, 0x8d, 0x90, 0x97, 0x99, 0xa2,
-				0xa3, 0xa7, 0xa8, 0xaf, 0xb3, 0xb4, 0xb5, 0xb7,
-				0xb9, 0xba, 0xbb, 0xbd, 0xc5, 0xc6, 0xca, 0xca,
-				0xcb, 0xce, 0xcf, 0xd0, 0xd1, 0xd6, 0xd8, 0xdd,
-				0xdf, 0xe0, 0xe4, 0xeb, 0xec, 0xf7,
-			},
-			{
-				0x01, 0x08, 0x09, 0x0a, 0x0d, 0x0f, 0x12, 0x13,
-				0x14, 0x15, 0x1c, 0x1d, 0x1e, 0x1f, 0x24, 0x24,
-				0x29, 0x2a, 0x2b, 0x2d, 0x32, 0x35, 0x36, 0x36,
-				0x37, 0x3d, 0x3d, 0x3d, 0x3e, 0x42, 0x46, 0x48,
-				0x49, 0x4a, 0x4b, 0x4c, 0x60, 0x61, 0x69, 0x6a,
-				0x6f, 0x70, 0x7c, 0x7d, 0x81, 0x83, 0x84, 0x85,
-				0x85, 0x86, 0x88, 0x88, 0x92, 0x95, 0x96, 0x97,
-				0x97, 0x9c, 0x9d, 0x9d, 0xa3, 0xa6, 0xaa, 0xb3,
-				0xb6, 0xb6, 0xb6, 0xbd, 0xbf, 0xc0, 0xc3, 0xc7,
-				0xc9, 0xca, 0xcb, 0xd4, 0xd9, 0xdd, 0xde, 0xe1,
-				0xe5, 0xef, 0xf1, 0xf3, 0xf8, 0xfd,
-			},
-			{
-				0x02, 0x05, 0x0e, 0x13, 0x14, 0x19, 0x1a, 0x1b,
-				0x1e, 0x20, 0x20, 0x26, 0x28, 0x2d, 0x2f, 0x30,
-				0x37, 0x38, 0x3c, 0x43, 0x45, 0x4c, 0x4f, 0x53,
-				0x5a, 0x5c, 0x5d, 0x61, 0x62, 0x64, 0x65, 0x67,
-				0x68, 0x6c, 0x72, 0x72, 0x74, 0x76, 0x77, 0x78,
-				0x79, 0x7b, 0x7d, 0x7e, 0x7f, 0x84, 0x87, 0x8a,
-				0x98, 0x9c, 0x9e, 0x9e, 0xa0, 0xa5, 0xa9, 0xa9,
-				0xab, 0xad, 0xb3, 0xb8, 0xbc, 0xbd, 0xbe, 0xc5,
-				0xc8, 0xcc, 0xd0, 0xd1, 0xd2, 0xd4, 0xde, 0xdf,
-				0xe1, 0xe4, 0xe6, 0xe7, 0xe9, 0xea, 0xec, 0xee,
-				0xef, 0xf0, 0xf2, 0xf4, 0xf6, 0xfa,
-			},
-			{
-				0x00, 0x05, 0x08, 0x0e, 0x10, 0x11, 0x15, 0x18,
-				0x1b, 0x21, 0x22, 0x22, 0x25, 0x2a, 0x2c, 0x32,
-				0x32, 0x33, 0x3e, 0x3f, 0x41, 0x41, 0x42, 0x4a,
-				0x4b, 0x4f, 0x51, 0x51, 0x52, 0x53, 0x54, 0x55,
-				0x58, 0x62, 0x64, 0x66, 0x6e, 0x73, 0x74, 0x78,
-				0x7a, 0x7e, 0x80, 0x80, 0x82, 0x8c, 0x8d, 0x8e,
-				0x91, 0x94, 0x96, 0xa1, 0xa3, 0xa4, 0xa4, 0xaa,
-				0xae, 0xae, 0xaf, 0xb1, 0xb8, 0xb9, 0xc0, 0xc3,
-				0xc6, 0xcc, 0xcc, 0xcd, 0xd5, 0xd6, 0xd7, 0xdc,
-				0xe3, 0xe5, 0xe6, 0xe8, 0xeb, 0xed, 0xf1, 0xf2,
-				0xf5, 0xf6, 0xf8, 0xfb, 0xfc, 0xfd,
-			},
-			{
-				0x00, 0x03, 0x09, 0x0a, 0x0b, 0x0b, 0x0d, 0x0f,
-				0x13, 0x17, 0x19, 0x1d, 0x1f, 0x21, 0x21, 0x22,
-				0x23, 0x2d, 0x2f, 0x34, 0x35, 0x38, 0x39, 0x3b,
-				0x3c, 0x46, 0x4d, 0x50, 0x52, 0x57, 0x58, 0x5a,
-				0x5f, 0x69, 0x6a, 0x6b, 0x6e, 0x6f, 0x73, 0x75,
-				0x79, 0x7a, 0x7b, 0x7f, 0x80, 0x83, 0x87, 0x8b,
-				0x92, 0x93, 0x94, 0x94, 0x96, 0x98, 0x9a, 0x9c,
-				0x9f, 0xa1, 0xa6, 0xa7, 0xb0, 0xc2, 0xc2, 0xc9,
-				0xce, 0xcf, 0xd3, 0xd7, 0xda, 0xda, 0xdb, 0xdf,
-				0xe0, 0xe2, 0xe6, 0xe8, 0xe8, 0xed, 0xf2, 0xf3,
-				0xf4, 0xf5, 0xf7, 0xfb, 0xfd, 0xff,
-			},
-			{
-				0x04, 0x06, 0x07, 0x08, 0x0c, 0x0e, 0x12, 0x16,
-				0x17, 0x24, 0x25, 0x26, 0x28, 0x29, 0x2c, 0x2f,
-				0x31, 0x39, 0x40, 0x43, 0x44, 0x45, 0x46, 0x4b,
-				0x4d, 0x53, 0x56, 0x59, 0x5c, 0x5e, 0x5f, 0x63,
-				0x63, 0x66, 0x67, 0x67, 0x6b, 0x6d, 0x6d, 0x71,
-				0x76, 0x81, 0x89, 0x8e, 0x91, 0x99, 0x9a, 0x9b,
-				0x9f, 0xa5, 0xab, 0xac, 0xad, 0xb0, 0xb1, 0xb2,
-				0xb7, 0xb8, 0xba, 0xbb, 0xbc, 0xbe, 0xbf, 0xc1,
-				0xc8, 0xd3, 0xd5, 0xd6, 0xda, 0xdb, 0xdc, 0xdd,
-				0xe2, 0xe4, 0xe5, 0xe9, 0xee, 0xf6, 0xf9, 0xfa,
-				0xfa, 0xfc, 0xfc, 0xfe, 0xff, 0xff,
-			},
-		},
-
-		// Group 76
-		{
-			{
-				0x02, 0x03, 0x06, 0x0a, 0x0b, 0x0d, 0x13, 0x1a,
-				0x1e, 0x1f, 0x1f, 0x23, 0x23, 0x25, 0x2a, 0x30,
-				0x31, 0x33, 0x34, 0x36, 0x38, 0x3f, 0x41, 0x42,
-				0x48, 0x49, 0x4b, 0x4d, 0x4d, 0x4e, 0x4f, 0x51,
-				0x53, 0x55, 0x59, 0x5c, 0x60, 0x63, 0x6d, 0x70,
-				0x73, 0x74, 0x75, 0x76, 0x78, 0x7c, 0x80, 0x81,
-				0x82, 0x88, 0x91, 0x99, 0x9a, 0x9b, 0x9f, 0xa2,
-				0xa2, 0xa4, 0xa5, 0xa7, 0xa9, 0xab, 0xac, 0xad,
-				0xaf, 0xb7, 0xca, 0xcf, 0xd3, 0xd3, 0xdd, 0xde,
-				0xe0, 0xe0, 0xe1, 0xe2, 0xe5, 0xe9, 0xec, 0xf3,
-				0xf7, 0xf7, 0xfd, 0xfe, 0xff, 0xff,
-			},
-			{
-				0x00, 0x01, 0x07, 0x07, 0x09, 0x0c, 0x0d, 0x11,
-				0x17, 0x17, 0x19, 0x1b, 0x1e, 0x20, 0x22, 0x25,
-				0x27, 0x29, 0x2b, 0x2c, 0x2f, 0x30, 0x35, 0x36,
-				0x3a, 0x3b, 0x3e, 0x43, 0x44, 0x47, 0x4c, 0x4f,
-				0x50, 0x5d, 0x61, 0x63, 0x65, 0x6a, 0x6e, 0x70,
-				0x71, 0x73, 0x78, 0x79, 0x7e, 0x81, 0x84, 0x84,
-				0x87, 0x89, 0x8d, 0x8d, 0x8e, 0x8f, 0x94, 0x97,
-				0x98, 0xa3, 0xaa, 0xb2, 0xb2, 0xb3, 0xb4, 0xb5,
-				0xb5, 0xbc, 0xbd, 0xbd, 0xbf, 0xc0, 0xc2, 0xc2,
-				0xca, 0xcb, 0xcc, 0xd6, 0xd9, 0xdb, 0xe1, 0xe8,
-				0xeb, 0xf1, 0xf5, 0xf8, 0xfa, 0xfd,
-			},
-			{
-				0x02, 0x03, 0x05, 0x0f, 0x12, 0x15, 0x16, 0x18,
-				0x1a, 0x1c, 0x1d, 0x1f, 0x23, 0x26, 0x2a, 0x2c,
-				0x2e, 0x32, 0x33, 0x35, 0x37, 0x38, 0x39, 0x3f,
-				0x40, 0x45, 0x46, 0x47, 0x4b, 0x50, 0x56, 0x58,
-				0x59, 0x65, 0x66, 0x67, 0x69, 0x6b, 0x6c, 0x7b,
-				0x7d, 0x7e, 0x86, 0x88, 0x8c, 0x8f, 0x92, 0x93,
-				0x95, 0x96, 0x96, 0x97, 0x98, 0x9a, 0x9c, 0xa0,
-				0xa1, 0xa8, 0xaa, 0xb3, 0xb6, 0xb7, 0xb7, 0xbb,
-				0xc5, 0xc6, 0xd4, 0xd4, 0xd5, 0xd7, 0xdb, 0xde,
-				0xe2, 0xe3, 0xe3, 0xe4, 0xe5, 0xe6, 0xe7, 0xeb,
-				0xee, 0xf1, 0xf2, 0xf9, 0xfa, 0xfc,
-			},
-			{
-				0x00, 0x03, 0x04, 0x06, 0x09, 0x10, 0x11, 0x14,
-				0x15, 0x19, 0x1e, 0x21, 0x28, 0x29, 0x2b, 0x31,
-				0x34, 0x3d, 0x41, 0x42, 0x44, 0x53, 0x54, 0x57,
-				0x5a, 0x5b, 0x5c, 0x5e, 0x5f, 0x62, 0x66, 0x68,
-				0x68, 0x74, 0x76, 0x7c, 0x7f, 0x85, 0x87, 0x89,
-				0x8a, 0x8b, 0x8e, 0x8f, 0x90, 0x91, 0x92, 0x9c,
-				0x9d, 0x9e, 0xa4, 0xa6, 0xa7, 0xa8, 0xac, 0xad,
-				0xae, 0xb1, 0xb4, 0xb6, 0xb8, 0xb9, 0xba, 0xc1,
-				0xc3, 0xc3, 0xc6, 0xc8, 0xcd, 0xce, 0xd0, 0xd2,
-				0xd3, 0xd5, 0xd7, 0xd8, 0xdc, 0xdd, 0xdf, 0xf0,
-				0xf2, 0xf3, 0xf3, 0xf6, 0xf8, 0xfe,
-			},
-			{
-				0x05, 0x06, 0x08, 0x08, 0x0b, 0x0e, 0x0e, 0x12,
-				0x16, 0x19, 0x1b, 0x1c, 0x1d, 0x20, 0x20, 0x26,
-				0x27, 0x2c, 0x2e, 0x2f, 0x30, 0x32, 0x34, 0x3a,
-				0x3b, 0x3d, 0x49, 0x4a, 0x51, 0x54, 0x54, 0x55,
-				0x56, 0x5d, 0x5f, 0x64, 0x67, 0x69, 0x6b, 0x6c,
-				0x6d, 0x6e, 0x6f, 0x72, 0x75, 0x77, 0x7a, 0x80,
-				0x83, 0x83, 0x85, 0x8a, 0x8b, 0x8d, 0x93, 0x94,
-				0x95, 0x9d, 0xa3, 0xa5, 0xa7, 0xa9, 0xb0, 0xb5,
-				0xb6, 0xb9, 0xba, 0xbc, 0xc0, 0xc0, 0xc4, 0xc6,
-				0xc7, 0xc8, 0xc9, 0xce, 0xcf, 0xd9, 0xe4, 0xe6,
-				0xe8, 0xed, 0xf0, 0xf4, 0xf5, 0xfc,
-			},
-			{
-				0x01, 0x04, 0x08, 0x0a, 0x0c, 0x0f, 0x10, 0x13,
-				0x14, 0x18, 0x18, 0x26, 0x28, 0x2d, 0x2d, 0x2e,
-				0x33, 0x36, 0x37, 0x3c, 0x3c, 0x3c, 0x3d, 0x40,
-				0x48, 0x55, 0x57, 0x58, 0x59, 0x5b, 0x5e, 0x6a,
-				0x6e, 0x70, 0x71, 0x77, 0x7a, 0x7b, 0x7c, 0x7d,
-				0x7f, 0x84, 0x86, 0x8e, 0x93, 0x95, 0x9b, 0x9e,
-				0xaa, 0xab, 0xae, 0xb0, 0xb0, 0xb8, 0xb9, 0xbe,
-				0xbf, 0xc1, 0xc4, 0xc7, 0xc9, 0xcc, 0xcc, 0xcd,
-				0xcd, 0xce, 0xd1, 0xd2, 0xd6, 0xda, 0xdc, 0xdc,
-				0xdf, 0xe4, 0xe7, 0xea, 0xeb, 0xec, 0xee, 0xef,
-				0xf4, 0xf6, 0xf7, 0xf9, 0xfb, 0xfe,
-			},
-			{
-				0x0a, 0x11, 0x1b, 0x21, 0x22, 0x24, 0x24, 0x25,
-				0x27, 0x28, 0x2a, 0x38, 0x39, 0x3b, 0x3e, 0x42,
-				0x43, 0x45, 0x46, 0x4a, 0x4c, 0x4e, 0x50, 0x52,
-				0x52, 0x53, 0x58, 0x5a, 0x60, 0x61, 0x61, 0x62,
-				0x64, 0x6d, 0x6f, 0x72, 0x72, 0x79, 0x7d, 0x7e,
-				0x82, 0x85, 0x86, 0x8c, 0x90, 0x94, 0x98, 0x99,
-				0x9a, 0x9b, 0x9e, 0x9f, 0xa0, 0xa1, 0xa3, 0xa5,
-				0xa6, 0xaf, 0xb1, 0xb3, 0xbb, 0xbe, 0xbf, 0xc3,
-				0xc5, 0xc5, 0xc8, 0xcb, 0xcf, 0xd0, 0xd1, 0xd4,
-				0xd8, 0xd9, 0xda, 0xe6, 0xe8, 0xe9, 0xea, 0xed,
-				0xed, 0xef, 0xf0, 0xf1, 0xf4, 0xfb,
-			},
-		},
-
-		// Group 77
-		{
-			{
-				0x07, 0x09, 0x0a, 0x0c, 0x0d, 0x10, 0x12, 0x13,
-				0x19, 0x21, 0x27, 0x28, 0x28, 0x2d, 0x2f, 0x2f,
-				0x31, 0x32, 0x32, 0x34, 0x3c, 0x3d, 0x3e, 0x3f,
-				0x41, 0x41, 0x41, 0x44, 0x45, 0x50, 0x58, 0x59,
-				0x5a, 0x5e, 0x60, 0x63, 0x6a, 0x6c, 0x6c, 0x6d,
-				0x78, 0x7a, 0x7c, 0x7f, 0x7f, 0x84, 0x8a, 0x8d,
-				0x8e, 0x8e, 0x8f, 0x91, 0x92, 0x93, 0x98, 0x99,
-				0x9a, 0x9b, 0x9b, 0x9e, 0xa5, 0xae, 0xae, 0xb0,
-				0xb1, 0xb3, 0xb4, 0xb6, 0xb8, 0xbd, 0xc4, 0xc9,
-				0xca, 0xcc, 0xd2, 0xd7, 0xdb, 0xdc, 0xdf, 0xe3,
-				0xe9, 0xec, 0xf5, 0xf9, 0xfc, 0xfd,
-			},
-			{
-				0x00, 0x08, 0x08, 0x0e, 0x14, 0x1c, 0x1e, 0x20,
-				0x29, 0x29, 0x2a, 0x2d, 0x30, 0x33, 0x36, 0x37,
-				0x39, 0x42, 0x44, 0x4b, 0x4d, 0x4e, 0x4f, 0x52,
-				0x54, 0x56, 0x5a, 0x5c, 0x65, 0x68, 0x6c, 0x6d,
-				0x6e, 0x6f, 0x6f, 0x71, 0x72, 0x74, 0x75, 0x79,
-				0x7a, 0x7b, 0x80, 0x87, 0x88, 0x89, 0x8a, 0x93,
-				0x9e, 0xaa, 0xb2, 0xb3, 0xb6, 0xba, 0xbb, 0xbc,
-				0xbc, 0xc3, 0xc6, 0xc7, 0xc8, 0xc9, 0xd0, 0xd0,
-				0xd1, 0xd2, 0xd4, 0xd7, 0xd9, 0xda, 0xda, 0xde,
-				0xde, 0xdf, 0xe1, 0xe9, 0xea, 0xeb, 0xee, 0xef,
-				0xf1, 0xf5, 0xf8, 0xf8, 0xfc, 0xfe,
-			},
-			{
-				0x00, 0x02, 0x04, 0x06, 0x07, 0x0b, 0x0b, 0x0f,
-				0x10, 0x10, 0x11, 0x13, 0x14, 0x14, 0x16, 0x23,
-				0x26, 0x2a, 0x32, 0x34, 0x39, 0x46, 0x47, 0x4a,
-				0x51, 0x52, 0x54, 0x58, 0x5a, 0x5f, 0x5f, 0x60,
-				0x60, 0x62, 0x67, 0x67, 0x69, 0x6b, 0x70, 0x73,
-				0x77, 0x7b, 0x83, 0x83, 0x85, 0x87, 0x97, 0x98,
-				0x9c, 0xa3, 0xa4, 0xa4, 0xa5, 0xa7, 0xac, 0xad,
-				0xaf, 0xb0, 0xb3, 0xb4, 0xbb, 0xbc, 0xbd, 0xbe,
-				0xc6, 0xc7, 0xce, 0xd4, 0xd6, 0xd7, 0xd8, 0xe1,
-				0xe2, 0xe2, 0xe4, 0xe5, 0xe6, 0xe8, 0xea, 0xed,
-				0xf1, 0xf3, 0xfb, 0xfd, 0xfe, 0xff,
-			},
-			{
-				0x06, 0x08, 0x09, 0x0b, 0x17, 0x18, 0x1f, 0x20,
-				0x24, 0x2b, 0x2c, 0x2d, 0x34, 0x38, 0x3d, 0x40,
-				0x43, 0x4e, 0x4e, 0x4f, 0x54, 0x55, 0x5b, 0x5d,
-				0x66, 0x67, 0x68, 0x6a, 0x6b, 0x6b, 0x6e, 0x70,
-				0x71, 0x72, 0x73, 0x76, 0x7f, 0x82, 0x85, 0x89,
-				0x89, 0x8c, 0x8f, 0x90, 0x90, 0x91, 0x93, 0x94,
-				0x94, 0x99, 0x9d, 0x9f, 0xa0, 0xa5, 0xa6, 0xa7,
-				0xa8, 0xae, 0xaf, 0xb7, 0xb8, 0xbb, 0xbd, 0xbe,
-				0xc0, 0xc1, 0xc4, 0xc5, 0xcb, 0xcc, 0xce, 0xcf,
-				0xd1, 0xd6, 0xd9, 0xda, 0xe0, 0xe3, 0xe3, 0xf0,
-				0xf3, 0xf4, 0xf4, 0xf7, 0xf9, 0xff,
-			},
-			{
-				0x03, 0x03, 0x09, 0x0c, 0x0c, 0x0d, 0x0e, 0x12,
-				0x15, 0x16, 0x18, 0x1a, 0x1b, 0x1d, 0x23, 0x24,
-				0x28, 0x29, 0x2c, 0x2e, 0x31, 0x35, 0x37, 0x3a,
-				0x3b, 0x3e, 0x47, 0x48, 0x49, 0x4c, 0x4d, 0x53,
-				0x53, 0x56, 0x57, 0x5c, 0x5d, 0x61, 0x62, 0x63,
-				0x64, 0x64, 0x68, 0x74, 0x77, 0x7d, 0x7e, 0x80,
-				0x80, 0x81, 0x82, 0x85, 0x86, 0x8a, 0x8b, 0x92,
-				0x95, 0x97, 0x9c, 0xa2, 0xa3, 0xa9, 0xaa, 0xab,
-				0xac, 0xad, 0xb5, 0xb5, 0xba, 0xbf, 0xc0, 0xc2,
-				0xca, 0xcd, 0xcf, 0xd3, 0xd5, 0xd5, 0xdb, 0xe0,
-				0xe4, 0xe7, 0xf4, 0xf6, 0xfd, 0xfe,
-			},
-			{
-				0x02, 0x04, 0x13, 0x17, 0x1a, 0x1b, 0x1c, 0x1d,
-				0x1f, 0x22, 0x25, 0x27, 0x30, 0x35, 0x36, 0x3a,
-				0x3f, 0x40, 0x42, 0x43, 0x44, 0x45, 0x46, 0x48,
-				0x49, 0x4b, 0x51, 0x55, 0x56, 0x57, 0x5b, 0x5b,
-				0x62, 0x65, 0x69, 0x70, 0x72, 0x77, 0x78, 0x79,
-				0x7a, 0x7d, 0x81, 0x83, 0x86, 0x88, 0x8b, 0x8f,
-				0x96, 0x99, 0x9a, 0x9b, 0x9d, 0x9f, 0xa1, 0xa2,
-				0xa6, 0xa7, 0xa9, 0xac, 0xad, 0xb5, 0xb7, 0xbe,
-				0xc1, 0xc3, 0xc5, 0xc6, 0xc8, 0xcb, 0xce, 0xcf,
-				0xd3, 0xe0, 0xe5, 0xe6, 0xe7, 0xe8, 0xec, 0xec,
-				0xed, 0xee, 0xf2, 0xf3, 0xfa, 0xfb,
-			},
-			{
-				0x00, 0x01, 0x01, 0x01, 0x05, 0x05, 0x0a, 0x0a,
-				0x0d, 0x0f, 0x11, 0x15, 0x19, 0x1e, 0x1e, 0x21,
-				0x22, 0x25, 0x26, 0x2b, 0x2e, 0x33, 0x35, 0x38,
-				0x3b, 0x3c, 0x3e, 0x40, 0x45, 0x4a, 0x4c, 0x50,
-				0x59, 0x5c, 0x5e, 0x61, 0x64, 0x66, 0x69, 0x6f,
-				0x75, 0x75, 0x76, 0x7c, 0x7e, 0x84, 0x8c, 0x8d,
-				0x95, 0x96, 0x9c, 0x9e, 0x9f, 0xa0, 0xa1, 0xa6,
-				0xa8, 0xab, 0xb1, 0xb2, 0xb8, 0xb9, 0xb9, 0xbf,
-				0xc2, 0xc3, 0xc8, 0xcd, 0xd0, 0xd8, 0xd9, 0xdc,
-				0xdd, 0xdd, 0xe1, 0xe7, 0xeb, 0xef, 0xf0, 0xf2,
-				0xf5, 0xf6, 0xf6, 0xf7, 0xf9, 0xfa,
-			},
-		},
-
-		// Group 78
-		{
-			{
-				0x04, 0x04, 0x05, 0x09, 0x0b, 0x0d, 0x0f, 0x11,
-				0x12, 0x1f, 0x21, 0x2a, 0x2f, 0x32, 0x33, 0x33,
-				0x35, 0x3b, 0x3c, 0x41, 0x44, 0x45, 0x47, 0x4e,
-				0x4f, 0x55, 0x56, 0x57, 0x5a, 0x5d, 0x60, 0x61,
-				0x61, 0x67, 0x6b, 0x6b, 0x6e, 0x70, 0x75, 0x77,
-				0x7a, 0x7c, 0x7e, 0x80, 0x81, 0x83, 0x88, 0x8c,
-				0x8e, 0x8f, 0x90, 0x95, 0x98, 0x99, 0x9a, 0x9c,
-				0xa2, 0xa8, 0xae, 0xb5, 0xb7, 0xbd, 0xc0, 0xc5,
-				0xc7, 0xc9, 0xcc, 0xd0, 0xd2, 0xd5, 0xda, 0xdd,
-				0xde, 0xe2, 0xe4, 0xe7, 0xe8, 0xe9, 0xeb, 0xed,
-				0xef, 0xf7, 0xf7, 0xf9, 0xfc, 0xfe,
-			},
-			{
-				0x02, 0x03, 0x08, 0x0a, 0x0d, 0x10, 0x13, 0x15,
-				0x1e, 0x22, 0x24, 0x2c, 0x2c, 0x2d, 0x2e, 0x37,
-				0x38, 0x39, 0x3c, 0x3c, 0x3d, 0x3f, 0x40, 0x41,
-				0x43, 0x43, 0x49, 0x4a, 0x4b, 0x4b, 0x4c, 0x4e,
-				0x53, 0x53, 0x55, 0x56, 0x57, 0x5b, 0x5d, 0x5f,
-				0x62, 0x68, 0x68, 0x7e, 0x7f, 0x85, 0x86, 0x88,
-				0x89, 0x8c, 0x8d, 0x8e, 0x93, 0x94, 0x9e, 0x9e,
-				0xa6, 0xa9, 0xab, 0xb7, 0xb9, 0xba, 0xbb, 0xbc,
-				0xbe, 0xc2, 0xcb, 0xcb, 0xce, 0xd0, 0xd2, 0xd3,
-				0xd4, 0xd6, 0xd9, 0xdd, 0xdf, 0xe0, 0xe6, 0xeb,
-				0xf4, 0xf6, 0xf8, 0xf8, 0xf9, 0xfa,
-			},
-			{
-				0x00, 0x03, 0x09, 0x0c, 0x16, 0x1b, 0x1b, 0x1c,
-				0x1f, 0x26, 0x27, 0x27, 0x28, 0x2b, 0x2b, 0x2c,
-				0x30, 0x3b, 0x42, 0x45, 0x47, 0x4a, 0x4b, 0x4e,
-				0x52, 0x55, 0x5a, 0x5f, 0x63, 0x66, 0x67, 0x68,
-				0x69, 0x6d, 0x6f, 0x71, 0x71, 0x73, 0x73, 0x7c,
-				0x7f, 0x84, 0x87, 0x88, 0x8a, 0x8a, 0x8d, 0x91,
-				0x94, 0x98, 0x9b, 0x9f, 0xa0, 0xa1, 0xa2, 0xa3,
-				0xa5, 0xa9, 0xaa, 0xab, 0xad, 0xad, 0xb2, 0xb3,
-				0xb4, 0xb4, 0xb8, 0xbd, 0xbe, 0xc7, 0xca, 0xcc,
-				0xd3, 0xd7, 0xd7, 0xd9, 0xdb, 0xe0, 0xe4, 0xeb,
-				0xef, 0xf2, 0xf2, 0xf3, 0xfa, 0xfe,
-			},
-			{
-				0x01, 0x06, 0x0c, 0x0f, 0x11, 0x16, 0x16, 0x24,
-				0x28, 0x2a, 0x31, 0x32, 0x34, 0x36, 0x37, 0x3b,
-				0x3d, 0x4d, 0x53, 0x58, 0x59, 0x5a, 0x5b, 0x63,
-				0x64, 0x65, 0x65, 0x66, 0x6e, 0x71, 0x74, 0x76,
-				0x78, 0x78, 0x79, 0x7a, 0x7b, 0x7d, 0x7d, 0x81,
-				0x83, 0x85, 0x8b, 0x90, 0x91, 0x92, 0x94, 0x96,
-				0x97, 0x99, 0xa0, 0xa1, 0xa3, 0xa4, 0xa7, 0xa8,
-				0xac, 0xae, 0xaf, 0xb0, 0xb1, 0xb2, 0xb6, 0xbc,
-				0xc6, 0xc8, 0xc9, 0xca, 0xcc, 0xcd, 0xce, 0xd2,
-				0xd4, 0xe3, 0xe3, 0xe7, 0xed, 0xf2, 0xf5, 0xf7,
-				0xf8, 0xf9, 0xfc, 0xff, 0xff, 0xff,
-			},
-			{
-				0x01, 0x05, 0x06, 0x08, 0x0b, 0x0e, 0x10, 0x12,
-				0x13, 0x15, 0x19, 0x1a, 0x1d, 0x1d, 0x20, 0x23,
-				0x26, 0x29, 0x34, 0x35, 0x3a, 0x3e, 0x49, 0x4d,
-				0x4f, 0x50, 0x60, 0x69, 0x6a, 0x6c, 0x6d, 0x70,
-				0x72, 0x74, 0x75, 0x77, 0x78, 0x80, 0x82, 0x82,
-				0x86, 0x90, 0x92, 0x95, 0x96, 0x99, 0xa4, 0xaa,
-				0xaf, 0xb0, 0xb1, 0xb3, 0xb5, 0xb9, 0xbd, 0xbf,
-				0xc1, 0xc2, 0xc2, 0xc5, 0xc9, 0xcb, 0xce, 0xcf,
-				0xd6, 0xd8, 0xda, 0xdc, 0xdf, 0xdf, 0xe1, 0xe3,
-				0xe5, 0xe6, 0xe8, 0xe9, 0xea, 0xec, 0xf4, 0xf6,
-				0xfb, 0xfb, 0xfc, 0xfd, 0xfd, 0xfe,
-			},
-			{
-				0x00, 0x07, 0x07, 0x0e, 0x0e, 0x11, 0x12, 0x17,
-				0x17, 0x18, 0x1e, 0x1f, 0x22, 0x25, 0x25, 0x29,
-				0x2a, 0x2b, 0x36, 0x38, 0x38, 0x3a, 0x40, 0x42,
-				0x44, 0x46, 0x46, 0x48, 0x4c, 0x50, 0x51, 0x51,
-				0x54, 0x58, 0x5c, 0x60, 0x64, 0x6c, 0x6f, 0x72,
-				0x75, 0x76, 0x76, 0x79, 0x7b, 0x80, 0x8a, 0x8b,
-				0x8f, 0x93, 0x95, 0x96, 0x97, 0x98, 0x9a, 0x9c,
-				0x9f, 0xa0, 0xa5, 0xa6, 0xac, 0xac, 0xb4, 0xb6,
-				0xbf, 0xc0, 0xc1, 0xc4, 0xc6, 0xcd, 0xd0, 0xd1,
-				0xd4, 0xd5, 0xdc, 0xe1, 0xe2, 0xe9, 0xea, 0xec,
-				0xed, 0xf0, 0xf1, 0xf1, 0xf3, 0xf3,
-			},
-			{
-				0x02, 0x0a, 0x0f, 0x14, 0x14, 0x15, 0x18, 0x19,
-				0x1a, 0x1c, 0x20, 0x21, 0x21, 0x23, 0x25, 0x26,
-				0x2d, 0x2e, 0x2f, 0x30, 0x31, 0x31, 0x32, 0x39,
-				0x3d, 0x3e, 0x3f, 0x48, 0x4c, 0x4f, 0x52, 0x54,
-				0x57, 0x59, 0x5c, 0x5e, 0x5e, 0x62, 0x62, 0x6a,
-				0x6b, 0x6d, 0x72, 0x7b, 0x7e, 0x84, 0x87, 0x89,
-				0x8c, 0x91, 0x97, 0x9a, 0x9b, 0x9c, 0x9d, 0x9d,
-				0xa5, 0xa7, 0xb8, 0xb8, 0xba, 0xbb, 0xc0, 0xc3,
-				0xc3, 0xc4, 0xc5, 0xc7, 0xc8, 0xc8, 0xcd, 0xcf,
-				0xd1, 0xd1, 0xd8, 0xdb, 0xde, 0xde, 0xe5, 0xe8,
-				0xee, 0xee, 0xf0, 0xf5, 0xfa, 0xfd,
-			},
-		},
-
-		// Group 79
-		{
-			{
-				0x00, 0x03, 0x06, 0x08, 0x0d, 0x1a, 0x22, 0x23,
-				0x2b, 0x2c, 0x2d, 0x2e, 0x37, 0x37, 0x3a, 0x3a,
-				0x3b, 0x43, 0x44, 0x45, 0x4e, 0x56, 0x57, 0x57,
-				0x58, 0x58, 0x59, 0x59, 0x5a, 0x5f, 0x5f, 0x63,
-				0x6c, 0x6e, 0x6f, 0x74, 0x75, 0x76, 0x77, 0x7a,
-				0x7d, 0x83, 0x86, 0x8a, 0x93, 0x97, 0x9a, 0x9b,
-				0x9b, 0x9c, 0x9d, 0x9e, 0x9e, 0x9f, 0xa4, 0xa5,
-				0xa5, 0xa6, 0xa8, 0xab, 0xae, 0xaf, 0xb0, 0xb1,
-				0xb4, 0xb7, 0xbe, 0xbf, 0xc2, 0xc3, 0xc6, 0xcf,
-				0xd3, 0xd3, 0xd7, 0xdb, 0xdb, 0xe0, 0xe2, 0xe4,
-				0xe7, 0xe7, 0xf3, 0xf4, 0xf9, 0xfe,
-			},
-			{
-				0x03, 0x05, 0x0a, 0x0e, 0x10, 0x1e, 0x1e, 0x1f,
-				0x20, 0x20, 0x21, 0x28, 0x2b, 0x2d, 0x2f, 0x30,
-				0x31, 0x35, 0x3c, 0x3c, 0x3d, 0x3d, 0x41, 0x41,
-				0x42, 0x43, 0x44, 0x47, 0x49, 0x50, 0x52, 0x52,
-				0x53, 0x5c, 0x60, 0x66, 0x69, 0x6c, 0x6f, 0x70,
-				0x70, 0x72, 0x77, 0x79, 0x84, 0x8b, 0x8c, 0x8d,
-				0x8e, 0x8f, 0x93, 0x95, 0x96, 0x97, 0x9a, 0xa4,
-				0xa7, 0xa7, 0xa8, 0xaf, 0xb0, 0xb3, 0xb7, 0xba,
-				0xc0, 0xc0, 0xc1, 0xc2, 0xc7, 0xc7, 0xc8, 0xc9,
-				0xc9, 0xcb, 0xcf, 0xd2, 0xd9, 0xe5, 0xea, 0xeb,
-				0xee, 0xf2, 0xf6, 0xfa, 0xfc, 0xff,
-			},
-			{
-				0x07, 0x09, 0x0b, 0x11, 0x12, 0x17, 0x18, 0x1b,
-				0x23, 0x24, 0x24, 0x26, 0x29, 0x29, 0x32, 0x34,
-				0x34, 0x38, 0x39, 0x3f, 0x3f, 0x42, 0x4d, 0x51,
-				0x51, 0x5b, 0x5d, 0x5d, 0x5e, 0x68, 0x6d, 0x72,
-				0x76, 0x78, 0x79, 0x7a, 0x7b, 0x7c, 0x7c, 0x81,
-				0x88, 0x8a, 0x8c, 0x8f, 0x90, 0x93, 0x99, 0x9a,
-				0x9c, 0x9f, 0xa1, 0xa3, 0xa4, 0xaa, 0xab, 0xad,
-				0xb6, 0xbb, 0xbc, 0xc1, 0xc1, 0xc3, 0xc4, 0xc5,
-				0xcb, 0xce, 0xd0, 0xd1, 0xd1, 0xd4, 0xd6, 0xd7,
-				0xdb, 0xdc, 0xdd, 0xe3, 0xe8, 0xec, 0xed, 0xee,
-				0xf2, 0xf3, 0xf5, 0xf8, 0xfb, 0xff,
-			},
-			{
-				0x07, 0x0b, 0x0c, 0x0f, 0x10, 0x11, 0x14, 0x15,
-				0x1c, 0x1d, 0x20, 0x22, 0x25, 0x26, 0x2b, 0x2e,
-				0x2f, 0x35, 0x36, 0x3b, 0x3d, 0x3e, 0x40, 0x40,
-				0x42, 0x46, 0x46, 0x47, 0x48, 0x4b, 0x4c, 0x4d,
-				0x4f, 0x50, 0x54, 0x55, 0x56, 0x5a, 0x5c, 0x5c,
-				0x60, 0x62, 0x65, 0x67, 0x69, 0x6a, 0x73, 0x75,
-				0x7d, 0x7e, 0x7f, 0x80, 0x84, 0x98, 0x99, 0x9d,
-				0xa0, 0xa0, 0xa2, 0xa5, 0xa9, 0xac, 0xb1, 0xb6,
-				0xb9, 0xc0, 0xc4, 0xc5, 0xc8, 0xca, 0xcd, 0xd0,
-				0xd1, 0xd4, 0xd5, 0xd8, 0xd9, 0xda, 0xdf, 0xe1,
-				0xe2, 0xe3, 0xe4, 0xe8, 0xe9, 0xea,
-			},
-			{
-				0x02, 0x08, 0x09, 0x0e, 0x0f, 0x13, 0x16, 0x16,
-				0x19, 0x1a, 0x1d, 0x1f, 0x21, 0x27, 0x33, 0x38,
-				0x38, 0x39, 0x3e, 0x44, 0x49, 0x49, 0x4d, 0x59,
-				0x5a, 0x5b, 0x61, 0x62, 0x63, 0x64, 0x68, 0x6a,
-				0x6e, 0x6e, 0x6f, 0x70, 0x75, 0x7b, 0x7e, 0x82,
-				0x85, 0x86, 0x87, 0x88, 0x89, 0x89, 0x89, 0x8e,
-				0x8f, 0x90, 0x92, 0x92, 0x94, 0x94, 0x96, 0x98,
-				0x9d, 0xa2, 0xa3, 0xa6, 0xa9, 0xac, 0xb0, 0xb2,
-				0xb2, 0xb5, 0xbd, 0xbe, 0xbe, 0xbf, 0xc5, 0xc6,
-				0xd2, 0xda, 0xdc, 0xe1, 0xe3, 0xe6, 0xe9, 0xed,
-				0xef, 0xf0, 0xf1, 0xf8, 0xfb, 0xfe,
-			},
-			{
-				0x00, 0x01, 0x01, 0x01, 0x02, 0x04, 0x04, 0x0a,
-				0x0c, 0x0d, 0x0f, 0x11, 0x12, 0x13, 0x15, 0x17,
-				0x1b, 0x1c, 0x21, 0x27, 0x28, 0x2a, 0x30, 0x30,
-				0x3f, 0x45, 0x4a, 0x4e, 0x4e, 0x4f, 0x4f, 0x53,
-				0x53, 0x55, 0x55, 0x5e, 0x64, 0x65, 0x66, 0x67,
-				0x6b, 0x6d, 0x71, 0x71, 0x74, 0x7a, 0x7c, 0x84,
-				0x85, 0x86, 0x87, 0x8d, 0x94, 0x9e, 0x9f, 0xa1,
-				0xad, 0xae, 0xb3, 0xb6, 0xb8, 0xba, 0xbc, 0xc2,
-				0xc3, 0xca, 0xcc, 0xcc, 0xcd, 0xd5, 0xd6, 0xdd,
-				0xde, 0xe5, 0xe8, 0xeb, 0xed, 0xef, 0xf2, 0xf4,
-				0xf6, 0xf7, 0xfc, 0xfc, 0xfd, 0xfd,
-			},
-			{
-				0x05, 0x06, 0x0c, 0x0e, 0x12, 0x13, 0x14, 0x18,
-				0x19, 0x25, 0x28, 0x2a, 0x2c, 0x2f, 0x31, 0x32,
-				0x33, 0x34, 0x36, 0x3b, 0x40, 0x43, 0x46, 0x48,
-				0x4a, 0x4b, 0x4c, 0x54, 0x5d, 0x61, 0x64, 0x6b,
-				0x6b, 0x73, 0x78, 0x7f, 0x80, 0x81, 0x82, 0x82,
-				0x83, 0x83, 0x8a, 0x8b, 0x8d, 0x8e, 0x91, 0x91,
-				0x95, 0xa0, 0xa1, 0xa6, 0xa7, 0xaa, 0xb4, 0xb5,
-				0xb8, 0xb9, 0xbb, 0xbd, 0xc8, 0xc9, 0xca, 0xce,
-				0xd0, 0xd3, 0xd4, 0xd8, 0xde, 0xdf, 0xe0, 0xe5,
-				0xe6, 0xea, 0xec, 0xf0, 0xf0, 0xf1, 0xf3, 0xf5,
-				0xf6, 0xf7, 0xf9, 0xfa, 0xfd, 0xff,
-			},
-		},
-
-		// Group 80
-		{
-			{
-				0x10, 0x19, 0x1b, 0x20, 0x26, 0x27, 0x2c, 0x2c,
-				0x2e, 0x34, 0x34, 0x36, 0x38, 0x3c, 0x3f, 0x45,
-				0x47, 0x47, 0x4d, 0x53, 0x55, 0x5d, 0x60, 0x60,
-				0x61, 0x63, 0x64, 0x64, 0x65, 0x69, 0x6b, 0x6d,
-				0x6e, 0x6f, 0x73, 0x77, 0x7d, 0x84, 0x86, 0x88,
-				0x88, 0x8f, 0x90, 0x91, 0x92, 0x93, 0x96, 0x9b,
-				0x9b, 0x9d, 0xa8, 0xac, 0xad, 0xae, 0xae, 0xb2,
-				0xb6, 0xb7, 0xb7, 0xb8, 0xbb, 0xbb, 0xbe, 0xc3,
-				0xc4, 0xc5, 0xcb, 0xcc, 0xd2, 0xd3, 0xd6, 0xd7,
-				0xd9, 0xd9, 0xda, 0xda, 0xdb, 0xe0, 0xe0, 0xe1,
-				0xe3, 0xe5, 0xec, 0xf2, 0xf4, 0xf9,
-			},
-			{
-				0x00, 0x01, 0x04, 0x06, 0x0a, 0x0e, 0x12, 0x14,
-				0x17, 0x18, 0x1c, 0x1c, 0x21, 0x25, 0x29, 0x2c,
-				0x2f, 0x31, 0x33, 0x3a, 0x3d, 0x3e, 0x3f, 0x41,
-				0x44, 0x49, 0x4c, 0x4c, 0x51, 0x52, 0x58, 0x5b,
-				0x5f, 0x67, 0x68, 0x7a, 0x7b, 0x7b, 0x7c, 0x80,
-				0x82, 0x89, 0x8b, 0x8c, 0x8c, 0x90, 0x99, 0x9c,
-				0x9f, 0x9f, 0xa0, 0xa2, 0xa2, 0xa3, 0xa7, 0xa9,
-				0xa9, 0xac, 0xb0, 0xb3, 0xb5, 0xb8, 0xb9, 0xb9,
-				0xbc, 0xbd, 0xbf, 0xbf, 0xc4, 0xc9, 0xcd, 0xd3,
-				0xd4, 0xd8, 0xe1, 0xe2, 0xe6, 0xe7, 0xe8, 0xe9,
-				0xed, 0xef, 0xf0, 0xf5, 0xf8, 0xfe,
-			},
-			{
-				0x02, 0x03, 0x07, 0x07, 0x09, 0x09, 0x0b, 0x0d,
-				0x0e, 0x16, 0x1d, 0x1f, 0x20, 0x22, 0x22, 0x25,
-				0x2a, 0x2b, 0x2b, 0x2d, 0x30, 0x32, 0x33, 0x35,
-				0x36, 0x37, 0x3b, 0x42, 0x46, 0x4c, 0x4f, 0x50,
-				0x51, 0x54, 0x59, 0x5e, 0x62, 0x68, 0x6c, 0x6e,
-				0x74, 0x75, 0x76, 0x77, 0x79, 0x81, 0x82, 0x85,
-				0x86, 0x8d, 0x8d, 0x8f, 0x91, 0x94, 0x95, 0x97,
-				0x9d, 0xa8, 0xad, 0xb1, 0xb4, 0xba, 0xbe, 0xbf,
-				0xc0, 0xc7, 0xca, 0xce, 0xd2, 0xd5, 0xd6, 0xd7,
-				0xd8, 0xdd, 0xdf, 0xe1, 0xeb, 0xee, 0xee, 0xf3,
-				0xf4, 0xf6, 0xf6, 0xf7, 0xf7, 0xf9,
-			},
-			{
-				0x01, 0x0c, 0x0d, 0x12, 0x13, 0x19, 0x1b, 0x1e,
-				0x1f, 0x21, 0x23, 0x28, 0x2d, 0x30, 0x30, 0x32,
-				0x37, 0x38, 0x3a, 0x3c, 0x40, 0x41, 0x42, 0x44,
-				0x45, 0x49, 0x4a, 0x4a, 0x4b, 0x4d, 0x4f, 0x51,
-				0x52, 0x54, 0x56, 0x57, 0x5c, 0x61, 0x65, 0x67,
-				0x67, 0x68, 0x6a, 0x6b, 0x74, 0x75, 0x79, 0x80,
-				0x83, 0x87, 0x87, 0x88, 0x8b, 0x8e, 0x94, 0x9b,
-				0xa1, 0xa3, 0xa6, 0xa7, 0xab, 0xb1, 0xb1, 0xb5,
-				0xb6, 0xbb, 0xbd, 0xc5, 0xd0, 0xd5, 0xdc, 0xdd,
-				0xde, 0xe4, 0xe7, 0xe8, 0xe9, 0xed, 0xef, 0xf1,
-				0xf4, 0xf5, 0xf7, 0xfb, 0xfd, 0xfd,
-			},
-			{
-				0x00, 0x04, 0x06, 0x08, 0x0c, 0x15, 0x1b, 0x1e,
-				0x20, 0x26, 0x26, 0x2d, 0x2f, 0x31, 0x35, 0x39,
-				0x3e, 0x43, 0x43, 0x47, 0x49, 0x53, 0x55, 0x5c,
-				0x5c, 0x5e, 0x5f, 0x62, 0x63, 0x66, 0x66, 0x6b,
-				0x70, 0x71, 0x72, 0x73, 0x76, 0x7d, 0x7e, 0x80,
-				0x83, 0x89, 0x8a, 0x90, 0x95, 0x98, 0x99, 0xa0,
-				0xa2, 0xa3, 0xa4, 0xb0, 0xb6, 0xbc, 0xbc, 0xc1,
-				0xc1, 0xc2, 0xc3, 0xc6, 0xc8, 0xcc, 0xcc, 0xce,
-				0xcf, 0xd0, 0xd0, 0xd1, 0xd1, 0xd2, 0xd4, 0xd4,
-				0xd5, 0xdc, 0xdd, 0xde, 0xde, 0xe6, 0xe7, 0xe8,
-				0xec, 0xf2, 0xf6, 0xfa, 0xfc, 0xff,
-			},
-			{
-				0x03, 0x05, 0x06, 0x08, 0x0a, 0x0b, 0x0d, 0x0f,
-				0x0f, 0x10, 0x13, 0x14, 0x16, 0x16, 0x17, 0x18,
-				0x1a, 0x21, 0x22, 0x23, 0x24, 0x27, 0x28, 0x29,
-				0x34, 0x3b, 0x43, 0x46, 0x48, 0x4b, 0x4e, 0x50,
-				0x52, 0x56, 0x57, 0x59, 0x5a, 0x5b, 0x5d, 0x63,
-				0x66, 0x6a, 0x6f, 0x71, 0x73, 0x78, 0x7a, 0x7b,
-				0x7c, 0x7e, 0x84, 0x85, 0x8e, 0x93, 0x96, 0x9a,
-				0x9a, 0x9c, 0x9e, 0xa1, 0xa5, 0xb0, 0xb3, 0xb3,
-				0xb9, 0xc0, 0xc2, 0xc3, 0xc4, 0xcb, 0xcf, 0xd3,
-				0xdc, 0xdf, 0xdf, 0xea, 0xeb, 0xec, 0xf0, 0xf3,
-				0xf5, 0xf9, 0xfa, 0xfb, 0xfc, 0xff,
-			},
-			{
-				0x02, 0x05, 0x07, 0x11, 0x11, 0x15, 0x19, 0x1a,
-				0x1a, 0x1c, 0x1d, 0x1d, 0x24, 0x24, 0x25, 0x28,
-				0x2a, 0x2e, 0x39, 0x3d, 0x40, 0x42, 0x48, 0x4e,
-				0x53, 0x58, 0x5a, 0x5b, 0x62, 0x64, 0x69, 0x6c,
-				0x6d, 0x70, 0x72, 0x76, 0x78, 0x7e, 0x7f, 0x7f,
-				0x7f, 0x81, 0x8a, 0x92, 0x94, 0x96, 0x97, 0x98,
-				0x9a, 0x9d, 0x9e, 0x9e, 0x9f, 0xa4, 0xa5, 0xa6,
-				0xaa, 0xaa, 0xab, 0xaf, 0xaf, 0xb2, 0xb2, 0xb4,
-				0xba, 0xc0, 0xc6, 0xc7, 0xc8, 0xc9, 0xca, 0xcd,
-				0xdb, 0xe0, 0xe2, 0xe3, 0xe4, 0xe5, 0xe9, 0xea,
-				0xed, 0xf1, 0xf1, 0xf8, 0xf8, 0xfe,
-			},
-		},
-
-		// Group 81
-		{
-			{
-				0x01, 0x02, 0x04, 0x04, 0x05, 0x07, 0x07, 0x08,
-				0x09, 0x0a, 0x0c, 0x0c, 0x11, 0x14, 0x15, 0x17,
-				0x19, 0x1c, 0x1f, 0x22, 0x2a, 0x31, 0x33, 0x35,
-				0x3a, 0x3f, 0x42, 0x43, 0x45, 0x4b, 0x4d, 0x54,
-				0x55, 0x59, 0x59, 0x5a, 0x5a, 0x5b, 0x5c, 0x5f,
-				0x62, 0x69, 0x6e, 0x71, 0x74, 0x7e, 0x80, 0x82,
-				0x82, 0x83, 0x92, 0x95, 0x99, 0x9b, 0xa1, 0xa5,
-				0xa6, 0xa6, 0xaa, 0xad, 0xaf, 0xb0, 0xb1, 0xb2,
-				0xb2, 0xba, 0xbc, 0xc0, 0xc5, 0xc6, 0xcb, 0xcb,
-				0xd1, 0xd3, 0xd7, 0xdc, 0xdd, 0xe0, 0xe1, 0xe4,
-				0xec, 0xee, 0xf1, 0xf3, 0xf7, 0xff,
-			},
-			{
-				0x00, 0x06, 0x08, 0x0b, 0x0c, 0x0e, 0x0f, 0x0f,
-				0x12, 0x16, 0x17, 0x1e, 0x20, 0x25, 0x28, 0x29,
-				0x2b, 0x2c, 0x2e, 0x34, 0x3b, 0x42, 0x43, 0x47,
-				0x4a, 0x52, 0x61, 0x63, 0x65, 0x66, 0x69, 0x6c,
-				0x70, 0x75, 0x75, 0x76, 0x78, 0x7a, 0x7b, 0x7c,
-				0x7d, 0x7f, 0x82, 0x83, 0x86, 0x89, 0x8a, 0x8d,
-				0x8f, 0x91, 0x92, 0x94, 0x94, 0x95, 0x9d, 0x9f,
-				0xa3, 0xa5, 0xa8, 0xa9, 0xab, 0xac, 0xb0, 0xb3,
-				0xb5, 0xbb, 0xbc, 0xbf, 0xc2, 0xc5, 0xc6, 0xc6,
-				0xc9, 0xcc, 0xda, 0xda, 0xdf, 0xe0, 0xe2, 0xe6,
-				0xe7, 0xe9, 0xf4, 0xf6, 0xf9, 0xfd,
-			},
-			{
-				0x00, 0x01, 0x09, 0x0a, 0x10, 0x13, 0x17, 0x18,
-				0x1b, 0x1d, 0x1e, 0x20, 0x24, 0x25, 0x36, 0x38,
-				0x3c, 0x3c, 0x3d, 0x41, 0x41, 0x43, 0x44, 0x44,
-				0x45, 0x48, 0x48, 0x49, 0x49, 0x4b, 0x4e, 0x52,
-				0x57, 0x58, 0x5c, 0x62, 0x63, 0x65, 0x67, 0x6e,
-				0x6e, 0x74, 0x75, 0x78, 0x7a, 0x7a, 0x7c, 0x7d,
-				0x7e, 0x80, 0x87, 0x88, 0x8b, 0x8b, 0x8f, 0x91,
-				0x97, 0x98, 0xa1, 0xa7, 0xa9, 0xaa, 0xb1, 0xb8,
-				0xbd, 0xc3, 0xc4, 0xc9, 0xcd, 0xd4, 0xd4, 0xd5,
-				0xd9, 0xdb, 0xdc, 0xe1, 0xe3, 0xe7, 0xe9, 0xea,
-				0xeb, 0xec, 0xf2, 0xf5, 0xfa, 0xfe,
-			},
-			{
-				0x03, 0x06, 0x09, 0x0a, 0x0b, 0x0d, 0x10, 0x15,
-				0x16, 0x1c, 0x1f, 0x23, 0x26, 0x2f, 0x30, 0x30,
-				0x32, 0x36, 0x37, 0x39, 0x40, 0x40, 0x46, 0x48,
-				0x4a, 0x4e, 0x4f, 0x50, 0x50, 0x50, 0x51, 0x52,
-				0x53, 0x5b, 0x6c, 0x6d, 0x70, 0x70, 0x72, 0x77,
-				0x78, 0x7b, 0x7b, 0x7d, 0x81, 0x85, 0x89, 0x93,
-				0x96, 0x9e, 0xa0, 0xa0, 0xab, 0xad, 0xae, 0xae,
-				0xb7, 0xbc, 0xc1, 0xc1, 0xc7, 0xc7, 0xca, 0xcc,
-				0xce, 0xcf, 0xcf, 0xd2, 0xd5, 0xd6, 0xd6, 0xdf,
-				0xe0, 0xe5, 0xe6, 0xed, 0xef, 0xef, 0xf3, 0xf5,
-				0xf5, 0xf6, 0xf7, 0xfb, 0xfd, 0xff,
-			},
-			{
-				0x03, 0x04, 0x0d, 0x0e, 0x13, 0x1d, 0x21, 0x22,
-				0x24, 0x27, 0x28, 0x2a, 0x2d, 0x32, 0x34, 0x35,
-				0x38, 0x3b, 0x3e, 0x4b, 0x4c, 0x51, 0x56, 0x5d,
-				0x5d, 0x5f, 0x61, 0x67, 0x67, 0x68, 0x68, 0x69,
-				0x6a, 0x6a, 0x6b, 0x6d, 0x71, 0x73, 0x73, 0x74,
-				0x76, 0x84, 0x87, 0x8d, 0x90, 0x96, 0x97, 0x98,
-				0x99, 0x9a, 0x9c, 0x9e, 0xa0, 0xa2, 0xa4, 0xa4,
-				0xaf, 0xb4, 0xb6, 0xb7, 0xba, 0xbb, 0xbd, 0xc2,
-				0xc4, 0xc7, 0xc8, 0xcc, 0xcd, 0xce, 0xd1, 0xd3,
-				0xd4, 0xd6, 0xd7, 0xdb, 0xdb, 0xde, 0xe1, 0xe2,
-				0xe4, 0xe9, 0xea, 0xf2, 0xf3, 0xf4,
-			},
-			{
-				0x00, 0x05, 0x07, 0x08, 0x0e, 0x12, 0x14, 0x18,
-				0x19, 0x1a, 0x22, 0x23, 0x26, 0x29, 0x29, 0x2c,
-				0x2c, 0x2d, 0x2e, 0x2f, 0x31, 0x38, 0x3a, 0x3c,
-				0x3d, 0x3e, 0x47, 0x49, 0x4c, 0x53, 0x57, 0x58,
-				0x5e, 0x60, 0x61, 0x63, 0x66, 0x6f, 0x71, 0x72,
-				0x79, 0x79, 0x84, 0x85, 0x8a, 0x8e, 0x93, 0x98,
-				0x9a, 0x9b, 0x9f, 0xa7, 0xac, 0xb4, 0xb5, 0xb6,
-				0xb9, 0xbe, 0xbf, 0xc1, 0xc2, 0xc5, 0xca, 0xd0,
-				0xd1, 0xd9, 0xd9, 0xda, 0xdd, 0xdd, 0xe3, 0xe8,
-				0xeb, 0xed, 0xee, 0xef, 0xf0, 0xf1, 0xf7, 0xf8,
-				0xf8, 0xf9, 0xfa, 0xfb, 0xfc, 0xff,
-			},
-			{
-				0x01, 0x02, 0x11, 0x15, 0x1a, 0x1b, 0x1c, 0x21,
-				0x23, 0x26, 0x27, 0x27, 0x2b, 0x30, 0x32, 0x33,
-				0x37, 0x39, 0x3f, 0x40, 0x46, 0x4d, 0x4e, 0x4f,
-				0x54, 0x55, 0x56, 0x5e, 0x5e, 0x60, 0x64, 0x64,
-				0x66, 0x6a, 0x6b, 0x6d, 0x6f, 0x6f, 0x76, 0x77,
-				0x7f, 0x81, 0x86, 0x87, 0x88, 0x8c, 0x8c, 0x8e,
-				0x90, 0x91, 0x96, 0x9c, 0x9d, 0xa1, 0xa2, 0xa3,
-				0xa6, 0xa7, 0xa8, 0xb3, 0xb3, 0xb7, 0xb8, 0xb9,
-				0xba, 0xbd, 0xbe, 0xc0, 0xc3, 0xc4, 0xc8, 0xc8,
-				0xd0, 0xd2, 0xd8, 0xd8, 0xde, 0xe5, 0xe8, 0xed,
-				0xf0, 0xf1, 0xf8, 0xfc, 0xfd, 0xfe,
-			},
-		},
-
-		// Group 82
-		{
-			{
-				0x00, 0x02, 0x05, 0x07, 0x0b, 0x0c, 0x0c, 0x0d,
-				0x0e, 0x11, 0x12, 0x16, 0x17, 0x17, 0x1d, 0x24,
-				0x26, 0x2c, 0x2e, 0x2e, 0x30, 0x34, 0x3e, 0x40,
-				0x41, 0x44, 0x45, 0x49, 0x4d, 0x50, 0x52, 0x52,
-				0x57, 0x5e, 0x64, 0x65, 0x68, 0x68, 0x6b, 0x6d,
-				0x6e, 0x71, 0x72, 0x72, 0x73, 0x73, 0x74, 0x76,
-				0x80, 0x81, 0x85, 0x87, 0x8b, 0x8b, 0x8f, 0x92,
-				0x9f, 0xa2, 0xaa, 0xb1, 0xb2, 0xb4, 0xb4, 0xb4,
-				0xb5, 0xb8, 0xb9, 0xba, 0xbb, 0xbf, 0xc2, 0xc5,
-				0xc6, 0xc7, 0xc8, 0xce, 0xd0, 0xd1, 0xd6, 0xdf,
-				0xe1, 0xe3, 0xe7, 0xf0, 0xf2, 0xf7,
-			},
-			{
-				0x00, 0x02, 0x03, 0x05, 0x06, 0x08, 0x0a, 0x0c,
-				0x0f, 0x10, 0x13, 0x13, 0x17, 0x19, 0x1e, 0x23,
-				0x25, 0x26, 0x29, 0x2b, 0x2c, 0x30, 0x34, 0x39,
-				0x3a, 0x43, 0x44, 0x4a, 0x4e, 0x4f, 0x4f, 0x50,
-				0x50, 0x58, 0x65, 0x6a, 0x6b, 0x6c, 0x6c, 0x78,
-				0x79, 0x7a, 0x88, 0x89, 0x8c, 0x92, 0x93, 0x94,
-				0x95, 0x95, 0x96, 0x97, 0x97, 0x98, 0x9a, 0x9a,
-				0x9a, 0x9e, 0xa2, 0xa3, 0xa4, 0xa4, 0xa5, 0xa7,
-				0xa8, 0xb6, 0xb7, 0xbb, 0xbd, 0xbd, 0xbe, 0xc0,
-				0xc9, 0xcc, 0xd4, 0xdd, 0xdf, 0xe4, 0xe8, 0xeb,
-				0xef, 0xef, 0xf5, 0xfd, 0xfd, 0xfe,
-			},
-			{
-				0x07, 0x19, 0x1a, 0x1f, 0x20, 0x21, 0x22, 0x24,
-				0x25, 0x28, 0x29, 0x2f, 0x32, 0x35, 0x35, 0x36,
-				0x39, 0x3a, 0x3d, 0x40, 0x42, 0x46, 0x47, 0x4b,
-				0x4d, 0x51, 0x55, 0x5c, 0x5d, 0x5f, 0x5f, 0x60,
-				0x61, 0x6a, 0x6e, 0x6f, 0x6f, 0x70, 0x70, 0x72,
-				0x77, 0x79, 0x7b, 0x80, 0x82, 0x83, 0x84, 0x88,
-				0x8a, 0x8d, 0x90, 0x97, 0x99, 0xa1, 0xa2, 0xa6,
-				0xaa, 0xac, 0xad, 0xb0, 0xb0, 0xb6, 0xbf, 0xc1,
-				0xc3, 0xc5, 0xc9, 0xcd, 0xcd, 0xce, 0xcf, 0xd5,
-				0xd8, 0xde, 0xde, 0xe1, 0xe2, 0xe5, 0xe6, 0xe9,
-				0xea, 0xf1, 0xf5, 0xf6, 0xf9, 0xfb,
-			},
-			{
-				0x04, 0x08, 0x0e, 0x16, 0x16, 0x1a, 0x1b, 0x1c,
-				0x1e, 0x2a, 0x2d, 0x33, 0x37, 0x37, 0x3f, 0x42,
-				0x48, 0x54, 0x56, 0x56, 0x5a, 0x5b, 0x5f, 0x60,
-				0x63, 0x71, 0x75, 0x7a, 0x7c, 0x7f, 0x84, 0x86,
-				0x89, 0x8c, 0x8e, 0x8f, 0x91, 0x91, 0x92, 0x93,
-				0x94, 0x98, 0x9b, 0x9d, 0x9d, 0x9e, 0xa0, 0xa1,
-				0xab, 0xad, 0xaf, 0xb1, 0xb6, 0xbe, 0xbf, 0xc0,
-				0xc3, 0xc4, 0xc4, 0xcc, 0xcf, 0xd1, 0xd2, 0xd3,
-				0xd3, 0xd5, 0xd6, 0xd9, 0xda, 0xdb, 0xdb, 0xdc,
-				0xdd, 0xe4, 0xe7, 0xe8, 0xe9, 0xeb, 0xf7, 0xfa,
-				0xfa, 0xfb, 0xfc, 0xfe, 0xff, 0xff,
-			},
-			{
-				0x01, 0x02, 0x04, 0x06, 0x08, 0x0a, 0x0f, 0x14,
-				0x15, 0x15, 0x20, 0x21, 0x23, 0x27, 0x2a, 0x31,
-				0x31, 0x32, 0x33, 0x34, 0x36, 0x3d, 0x3f, 0x45,
-				0x47, 0x49, 0x4a, 0x4a, 0x4c, 0x4c, 0x4c, 0x4d,
-				0x51, 0x53, 0x56, 0x59, 0x59, 0x62, 0x63, 0x64,
-				0x65, 0x6d, 0x71, 0x75, 0x76, 0x78, 0x7b, 0x7c,
-				0x81, 0x82, 0x85, 0x8b, 0x8e, 0x8e, 0x90, 0x96,
-				0x98, 0x99, 0x9c, 0x9f, 0xa0, 0xa7, 0xae, 0xaf,
-				0xb2, 0xc1, 0xc2, 0xc7, 0xc8, 0xc8, 0xca, 0xca,
-				0xd1, 0xd4, 0xd5, 0xd8, 0xda, 0xe3, 0xed, 0xed,
-				0xee, 0xf1, 0xf2, 0xf3, 0xf8, 0xf9,
-			},
-			{
-				0x03, 0x06, 0x07, 0x09, 0x0b, 0x10, 0x11, 0x12,
-				0x14, 0x18, 0x1c, 0x1f, 0x24, 0x2a, 0x2b, 0x2f,
-				0x33, 0x35, 0x36, 0x38, 0x38, 0x3b, 0x3b, 0x3c,
-				0x3c, 0x3d, 0x3e, 0x47, 0x4b, 0x4e, 0x51, 0x52,
-				0x53, 0x55, 0x57, 0x59, 0x5d, 0x66, 0x67, 0x69,
-				0x6b, 0x75, 0x77, 0x7d, 0x7e, 0x80, 0x86, 0x8a,
-				0x8d, 0x90, 0x9c, 0xa5, 0xa8, 0xa9, 0xa9, 0xab,
-				0xac, 0xb3, 0xb5, 0xb7, 0xbe, 0xc4, 0xc6, 0xc9,
-				0xca, 0xd0, 0xd2, 0xd7, 0xdc, 0xe0, 0xe0, 0xe1,
-				0xe2, 0xe6, 0xeb, 0xec, 0xec, 0xee, 0xf0, 0xf1,
-				0xf3, 0xf4, 0xf6, 0xf7, 0xf8, 0xfc,
-			},
-			{
-				0x01, 0x03, 0x09, 0x0b, 0x0d, 0x0e, 0x18, 0x1b,
-				0x1c, 0x1d, 0x22, 0x27, 0x28, 0x2d, 0x2f, 0x30,
-				0x38, 0x3b, 0x3f, 0x40, 0x41, 0x43, 0x46, 0x48,
-				0x4b, 0x54, 0x57, 0x58, 0x5a, 0x5b, 0x5c, 0x5e,
-				0x60, 0x61, 0x62, 0x66, 0x67, 0x69, 0x6e, 0x74,
-				0x79, 0x7d, 0x7e, 0x7e, 0x7f, 0x7f, 0x83, 0x87,
-				0x88, 0x9b, 0xa0, 0xa3, 0xa4, 0xa6, 0xa7, 0xab,
-				0xac, 0xae, 0xb3, 0xb5, 0xb8, 0xb9, 0xba, 0xba,
-				0xbb, 0xbc, 0xbc, 0xc0, 0xc3, 0xc6, 0xc7, 0xcb,
-				0xcb, 0xcd, 0xd7, 0xd9, 0xdf, 0xe0, 0xe5, 0xea,
-				0xec, 0xef, 0xf4, 0xf5, 0xfb, 0xfd,
-			},
-		},
-
-		// Group 83
-		{
-			{
-				0x03, 0x03, 0x04, 0x08, 0x09, 0x18, 0x1b, 0x1e,
-				0x1f, 0x20, 0x23, 0x24, 0x2c, 0x2e, 0x33, 0x34,
-				0x37, 0x3d, 0x3f, 0x44, 0x45, 0x4b, 0x4c, 0x52,
-				0x52, 0x53, 0x55, 0x56, 0x56, 0x56, 0x57, 0x58,
-				0x58, 0x59, 0x5a, 0x62, 0x68, 0x6b, 0x6c, 0x71,
-				0x71, 0x75, 0x76, 0x78, 0x79, 0x80, 0x84, 0x85,
-				0x87, 0x8d, 0x8e, 0x90, 0x91, 0x9b, 0x9e, 0xa1,
-				0xab, 0xac, 0xaf, 0xb2, 0xb3, 0xb6, 0xb7, 0xc2,
-				0xc6, 0xc8, 0xc9, 0xca, 0xcb, 0xcf, 0xd2, 0xd3,
-				0xdc, 0xde, 0xe0, 0xe4, 0xe7, 0xe8, 0xec, 0xed,
-				0xee, 0xee, 0xf3, 0xf9, 0xfc, 0xff,
-			},
-			{
-				0x00, 0x04, 0x06, 0x07, 0x0b, 0x0b, 0x0c, 0x0f,
-				0x0f, 0x11, 0x1f, 0x20, 0x21, 0x21, 0x24, 0x27,
-				0x28, 0x28, 0x2c, 0x2d, 0x2e, 0x2f, 0x31, 0x33,
-				0x37, 0x41, 0x43, 0x47, 0x49, 0x4a, 0x4d, 0x53,
-				0x5c, 0x63, 0x67, 0x6a, 0x6e, 0x72, 0x72, 0x75,
-				0x76, 0x77, 0x7d, 0x88, 0x8b, 0x93, 0x98, 0x9b,
-				0x9e, 0xa0, 0xa2, 0xa4, 0xa4, 0xa5, 0xa8, 0xa9,
-				0xaa, 0xaa, 0xae, 0xb0, 0xb1, 0xb4, 0xb5, 0xb8,
-				0xc3, 0xc4, 0xc5, 0xc8, 0xcc, 0xce, 0xce, 0xcf,
-				0xd0, 0xd0, 0xd5, 0xd6, 0xd7, 0xe3, 0xe4, 0xeb,
-				0xee, 0xf6, 0xf8, 0xfa, 0xfb, 0xfe,
-			},
-			{
-				0x01, 0x02, 0x05, 0x05, 0x07, 0x09, 0x0a, 0x0d,
-				0x0f, 0x16, 0x1a, 0x1a, 0x1b, 0x27, 0x29, 0x29,
-				0x32, 0x3b, 0x3e, 0x3e, 0x3f, 0x42, 0x46, 0x4b,
-				0x50, 0x53, 0x54, 0x5d, 0x5e, 0x5f, 0x64, 0x65,
-				0x66, 0x66, 0x69, 0x79, 0x7c, 0x7c, 0x7d, 0x82,
-				0x83, 0x86, 0x87, 0x8b, 0x8e, 0x90, 0x93, 0x94,
-				0x95, 0x95, 0x96, 0x96, 0x99, 0x9a, 0x9f, 0xa4,
-				0xb2, 0xb3, 0xb3, 0xb6, 0xb6, 0xb8, 0xba, 0xbb,
-				0xbb, 0xbc, 0xbf, 0xc0, 0xc1, 0xc2, 0xcd, 0xd8,
-				0xd8, 0xd9, 0xe0, 0xe6, 0xe8, 0xe8, 0xe9, 0xec,
-				0xf2, 0xf3, 0xf4, 0xf6, 0xf7, 0xff,
-			},
-			{
-				0x02, 0x04, 0x0a, 0x15, 0x15, 0x19, 0x1c, 0x1d,
-				0x1e, 0x23, 0x26, 0x2a, 0x2b, 0x2f, 0x35, 0x38,
-				0x40, 0x41, 0x43, 0x46, 0x47, 0x48, 0x49, 0x57,
-				0x5b, 0x5b, 0x5c, 0x5e, 0x61, 0x62, 0x64, 0x6f,
-				0x70, 0x72, 0x74, 0x76, 0x78, 0x7a, 0x7b, 0x7b,
-				0x7c, 0x80, 0x82, 0x84, 0x8c, 0x95, 0x99, 0x99,
-				0x9b, 0x9c, 0xa8, 0xac, 0xad, 0xb0, 0xb1, 0xb4,
-				0xb4, 0xb7, 0xb8, 0xb9, 0xba, 0xbd, 0xbe, 0xbe,
-				0xc2, 0xc4, 0xc9, 0xca, 0xcb, 0xcb, 0xd1, 0xd4,
-				0xd9, 0xdb, 0xdd, 0xdd, 0xde, 0xdf, 0xea, 0xef,
-				0xef, 0xf1, 0xf3, 0xf4, 0xf8, 0xfb,
-			},
-			{
-				0x00, 0x09, 0x0e, 0x13, 0x14, 0x15, 0x16, 0x18,
-				0x1b, 0x1c, 0x1c, 0x25, 0x2b, 0x30, 0x36, 0x36,
-				0x3c, 0x40, 0x48, 0x4b, 0x4d, 0x4e, 0x4e, 0x51,
-				0x54, 0x63, 0x64, 0x66, 0x67, 0x69, 0x6c, 0x6d,
-				0x6e, 0x70, 0x73, 0x75, 0x77, 0x7b, 0x7e, 0x7e,
-				0x7f, 0x7f, 0x81, 0x84, 0x86, 0x88, 0x89, 0x8d,
-				0x8f, 0x92, 0x97, 0x98, 0x9f, 0xa0, 0xa5, 0xa7,
-				0xb0, 0xba, 0xbb, 0xbd, 0xc0, 0xc3, 0xc7, 0xc7,
-				0xcc, 0xcd, 0xd1, 0xd2, 0xd3, 0xd3, 0xd5, 0xd6,
-				0xd7, 0xdb, 0xe1, 0xe1, 0xe4, 0xe5, 0xe9, 0xf1,
-				0xf9, 0xfa, 0xfa, 0xfb, 0xfd, 0xfe,
-			},
-			{
-				0x02, 0x05, 0x06, 0x0a, 0x0c, 0x0d, 0x11, 0x13,
-				0x14, 0x17, 0x17, 0x1a, 0x22, 0x25, 0x2a, 0x2b,
-				0x2d, 0x31, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3d,
-				0x45, 0x4c, 0x4c, 0x4d, 0x4f, 0x51, 0x55, 0x57,
-				0x5d, 0x5f, 0x60, 0x60, 0x61, 0x68, 0x6a, 0x6d,
-				0x6e, 0x6f, 0x73, 0x74, 0x7a, 0x7d, 0x7e, 0x89,
-				0x8a, 0x8b, 0x8d, 0x8f, 0x92, 0x93, 0x9a, 0x9c,
-				0x9d, 0xa1, 0xa2, 0xa6, 0xa7, 0xa9, 0xab, 0xae,
-				0xb5, 0xb9, 0xbc, 0xbe, 0xc1, 0xc6, 0xca, 0xcc,
-				0xd1, 0xd4, 0xd8, 0xda, 0xdc, 0xdf, 0xe3, 0xe5,
-				0xe7, 0xeb, 0xed, 0xf0, 0xf5, 0xf5,
-			},
-			{
-				0x00, 0x01, 0x06, 0x07, 0x08, 0x0e, 0x10, 0x10,
-				0x12, 0x12, 0x18, 0x19, 0x1d, 0x22, 0x26, 0x2c,
-				0x30, 0x32, 0x34, 0x35, 0x39, 0x3a, 0x3b, 0x3c,
-				0x42, 0x44, 0x49, 0x4a, 0x4e, 0x4f, 0x50, 0x52,
-				0x59, 0x5a, 0x5a, 0x65, 0x6a, 0x6b, 0x6f, 0x70,
-				0x71, 0x81, 0x81, 0x83, 0x85, 0x8a, 0x8c, 0x8e,
-				0x91, 0x91, 0x94, 0x97, 0x9c, 0x9d, 0x9e, 0xa1,
-				0xa3, 0xa3, 0xa6, 0xa6, 0xa7, 0xad, 0xaf, 0xb2,
-				0xb7, 0xbf, 0xc0, 0xc5, 0xc6, 0xcf, 0xd7, 0xda,
-				0xdc, 0xdd, 0xe2, 0xe2, 0xe6, 0xe9, 0xea, 0xec,
-				0xf0, 0xf2, 0xf7, 0xf9, 0xfc, 0xfd,
-			},
-		},
-
-		// Group 84
-		{
-			{
-				0x0a, 0x0b, 0x0d, 0x0f, 0x11, 0x15, 0x17, 0x18,
-				0x19, 0x1a, 0x1e, 0x1e, 0x22, 0x25, 0x25, 0x27,
-				0x29, 0x2e, 0x2e, 0x31, 0x35, 0x3a, 0x3f, 0x40,
-				0x45, 0x46, 0x47, 0x4a, 0x4b, 0x4e, 0x50, 0x51,
-				0x59, 0x5b, 0x5d, 0x60, 0x62, 0x66, 0x67, 0x69,
-				0x6f, 0x79, 0x7c, 0x81, 0x87, 0x8b, 0x91, 0x94,
-				0x9a, 0x9c, 0x9d, 0xa0, 0xac, 0xb0, 0xb2, 0xb3,
-				0xb7, 0xb8, 0xbc, 0xbe, 0xbe, 0xbf, 0xc1, 0xc3,
-				0xc4, 0xc8, 0xcd, 0xce, 0xcf, 0xd0, 0xd1, 0xd5,
-				0xd7, 0xd9, 0xdc, 0xdd, 0xe2, 0xe3, 0xec, 0xee,
-				0xf0, 0xf3, 0xf6, 0xf7, 0xfc, 0xfe,
-			},
-			{
-				0x00, 0x01, 0x02, 0x04, 0x05, 0x08, 0x0f, 0x12,
-				0x14, 0x17, 0x1d, 0x1f, 0x22, 0x29, 0x2c, 0x34,
-				0x37, 0x3a, 0x3d, 0x3d, 0x3e, 0x40, 0x49, 0x4b,
-				0x4d, 0x53, 0x61, 0x62, 0x66, 0x69, 0x6c, 0x71,
-				0x71, 0x73, 0x75, 0x77, 0x7d, 0x7e, 0x80, 0x88,
-				0x88, 0x89, 0x8c, 0x92, 0x98, 0x99, 0x9e, 0x9e,
-				0xa5, 0xa6, 0xa6, 0xa8, 0xad, 0xae, 0xae, 0xaf,
-				0xaf, 0xb1, 0xb4, 0xb6, 0xb8, 0xb8, 0xb9, 0xbb,
-				0xc2, 0xc7, 0xca, 0xcc, 0xcd, 0xd1, 0xd4, 0xd4,
-				0xda, 0xdc, 0xdd, 0xde, 0xe0, 0xeb, 0xeb, 0xec,
-				0xef, 0xf1, 0xf1, 0xf5, 0xf8, 0xfc,
-			},
-			{
-				0x06, 0x0e, 0x0e, 0x10, 0x16, 0x19, 0x1a, 0x1b,
-				0x1c, 0x20, 0x21, 0x23, 0x26, 0x2a, 0x2c, 0x2c,
-				0x2d, 0x30, 0x34, 0x35, 0x36, 0x3b, 0x3b, 0x3c,
-				0x3e, 0x46, 0x47, 0x48, 0x4c, 0x4c, 0x4d, 0x4e,
-				0x4e, 0x56, 0x57, 0x5a, 0x5b, 0x5e, 0x63, 0x63,
-				0x6d, 0x6f, 0x70, 0x72, 0x75, 0x7a, 0x83, 0x84,
-				0x86, 0x94, 0x94, 0x96, 0x97, 0x99, 0x9b, 0x9c,
-				0xa3, 0xa4, 0xa5, 0xaa, 0xac, 0xaf, 0xb5, 0xb7,
-				0xbb, 0xc0, 0xc5, 0xc6, 0xc8, 0xcb, 0xcd, 0xd1,
-				0xd2, 0xd3, 0xd6, 0xdd, 0xde, 0xe7, 0xf4, 0xf7,
-				0xf9, 0xf9, 0xfa, 0xfa, 0xfb, 0xfd,
-			},
-			{
-				0x01, 0x03, 0x03, 0x05, 0x06, 0x07, 0x09, 0x11,
-				0x12, 0x13, 0x15, 0x1a, 0x1b, 0x1c, 0x1f, 0x21,
-				0x23, 0x27, 0x28, 0x28, 0x2b, 0x32, 0x36, 0x41,
-				0x43, 0x49, 0x4a, 0x4d, 0x5e, 0x64, 0x65, 0x68,
-				0x6b, 0x6c, 0x6d, 0x70, 0x74, 0x79, 0x7b, 0x7b,
-				0x7e, 0x7f, 0x81, 0x82, 0x87, 0x8c, 0x8c, 0x8d,
-				0x90, 0x93, 0x95, 0x96, 0x97, 0x98, 0x9b, 0x9b,
-				0xa2, 0xa3, 0xa7, 0xad, 0xb3, 0xb4, 0xb5, 0xb9,
-				0xc4, 0xc6, 0xca, 0xcb, 0xce, 0xd4, 0xd6, 0xd7,
-				0xd9, 0xda, 0xdb, 0xe0, 0xe1, 0xe3, 0xe5, 0xec,
-				0xed, 0xef, 0xf2, 0xf5, 0xfd, 0xff,
-			},
-			{
-				0x02, 0x04, 0x0a, 0x0b, 0x0c, 0x14, 0x18, 0x1b,
-				0x1c, 0x1e, 0x22, 0x24, 0x2a, 0x2b, 0x32, 0x33,
-				0x38, 0x39, 0x39, 0x40, 0x41, 0x44, 0x4f, 0x51,
-				0x55, 0x5f, 0x64, 0x64, 0x65, 0x70, 0x73, 0x74,
-				0x76, 0x7e, 0x80, 0x81, 0x82, 0x85, 0x88, 0x89,
-				0x8a, 0x8d, 0x8e, 0x8f, 0x90, 0x91, 0x92, 0x93,
-				0x93, 0x95, 0x9a, 0x9d, 0x9f, 0xa0, 0xa8, 0xa9,
-				0xaa, 0xac, 0xb2, 0xb7, 0xba, 0xbc, 0xbd, 0xbd,
-				0xbf, 0xc2, 0xc3, 0xc5, 0xc7, 0xc9, 0xca, 0xcc,
-				0xd0, 0xd3, 0xd6, 0xd8, 0xd9, 0xdf, 0xe2, 0xe5,
-				0xed, 0xee, 0xf3, 0xf9, 0xfb, 0xfe,
-			},
-			{
-				0x05, 0x07, 0x08, 0x09, 0x0b, 0x0c, 0x0c, 0x0e,
-				0x12, 0x18, 0x20, 0x24, 0x26, 0x2f, 0x31, 0x31,
-				0x33, 0x35, 0x3a, 0x3c, 0x42, 0x42, 0x43, 0x47,
-				0x51, 0x52, 0x53, 0x54, 0x57, 0x59, 0x5c, 0x5d,
-				0x61, 0x63, 0x67, 0x68, 0x68, 0x6a, 0x6b, 0x6e,
-				0x77, 0x78, 0x78, 0x7b, 0x7d, 0x7f, 0x83, 0x84,
-				0x87, 0x8a, 0x8b, 0x8e, 0x8f, 0x99, 0x9f, 0xa1,
-				0xa4, 0xa7, 0xaa, 0xb3, 0xba, 0xc5, 0xc9, 0xcb,
-				0xcf, 0xcf, 0xd2, 0xd5, 0xd8, 0xdf, 0xe1, 0xe5,
-				0xe6, 0xe6, 0xe7, 0xe8, 0xe8, 0xea, 0xea, 0xeb,
-				0xf0, 0xf5, 0xf6, 0xf6, 0xfb, 0xff,
-			},
-			{
-				0x00, 0x04, 0x0d, 0x0d, 0x10, 0x13, 0x16, 0x17,
-				0x1d, 0x1d, 0x23, 0x28, 0x2b, 0x2d, 0x2e, 0x2f,
-				0x30, 0x37, 0x38, 0x39, 0x3b, 0x3f, 0x44, 0x45,
-				0x48, 0x48, 0x4b, 0x4f, 0x50, 0x50, 0x52, 0x53,
-				0x54, 0x55, 0x56, 0x58, 0x58, 0x5a, 0x5a, 0x5c,
-				0x5f, 0x60, 0x6a, 0x6b, 0x6e, 0x6f, 0x72, 0x76,
-				0x7a, 0x7c, 0x7f, 0x83, 0x85, 0x86, 0x86, 0x89,
-				0x8a, 0x92, 0x96, 0x9e, 0xa0, 0xa1, 0xa2, 0xa5,
-				0xa8, 0xa9, 0xab, 0xab, 0xad, 0xb0, 0xb1, 0xb2,
-				0xb6, 0xbf, 0xc0, 0xc1, 0xdb, 0xe4, 0xe4, 0xe6,
-				0xe8, 0xe9, 0xe9, 0xf2, 0xf4, 0xf8,
-			},
-		},
-
-		// Group 85
-		{
-			{
-				0x01, 0x02, 0x07, 0x08, 0x0e, 0x14, 0x15, 0x1b,
-				0x1d, 0x20, 0x25, 0x27, 0x29, 0x32, 0x33, 0x3d,
-				0x42, 0x45, 0x47, 0x49, 0x49, 0x49, 0x51, 0x57,
-				0x58, 0x5e, 0x60, 0x65, 0x67, 0x6f, 0x70, 0x75,
-				0x75, 0x78, 0x79, 0x7b, 0x7e, 0x7f, 0x81, 0x8b,
-				0x8e, 0x94, 0x95, 0x97, 0x9a, 0x9a, 0x9b, 0x9d,
-				0x9e, 0xa0, 0xa6, 0xa9, 0xae, 0xaf, 0xb1, 0xb2,
-				0xba, 0xbb, 0xbc, 0xbd, 0xbe, 0xc1, 0xc3, 0xc3,
-				0xcc, 0xd2, 0xd4, 0xd7, 0xd8, 0xe1, 0xe2, 0xe2,
-				0xe3, 0xe5, 0xe7, 0xe7, 0xe9, 0xe9, 0xea, 0xeb,
-				0xec, 0xee, 0xf4, 0xf5, 0xf8, 0xfe,
-			},
-			{
-				0x00, 0x04, 0x06, 0x07, 0x0a, 0x0b, 0x0f, 0x11,
-				0x1b, 0x1c, 0x1c, 0x22, 0x25, 0x27, 0x29, 0x2c,
-				0x2e, 0x2f, 0x31, 0x36, 0x37, 0x3c, 0x3f, 0x40,
-				0x42, 0x45, 0x4d, 0x55, 0x59, 0x5d, 0x5d, 0x61,
-				0x63, 0x64, 0x6c, 0x6d, 0x72, 0x72, 0x74, 0x77,
-				0x7c, 0x7d, 0x89, 0x8c, 0x8c, 0x90, 0x95, 0x97,
-				0x99, 0x9d, 0xa1, 0xa3, 0xa6, 0xa8, 0xa8, 0xa9,
-				0xad, 0xae, 0xb0, 0xb5, 0xb6, 0xb6, 0xb8, 0xb8,
-				0xbf, 0xc1, 0xc4, 0xcb, 0xcd, 0xd3, 0xd4, 0xd6,
-				0xd8, 0xd8, 0xd9, 0xda, 0xdb, 0xde, 0xdf, 0xf1,
-				0xf1, 0xf2, 0xf6, 0xfb, 0xfd, 0xfd,
-			},
-			{
-				0x02, 0x0a, 0x0b, 0x0c, 0x10, 0x12, 0x13, 0x14,
-				0x19, 0x20, 0x23, 0x27, 0x2a, 0x2c, 0x2d, 0x2e,
-				0x31, 0x36, 0x3c, 0x41, 0x42, 0x44, 0x46, 0x4b,
-				0x4d, 0x4e, 0x4f, 0x53, 0x58, 0x5a, 0x5a, 0x5b,
-				0x5b, 0x5f, 0x62, 0x62, 0x63, 0x66, 0x6c, 0x6e,
-				0x73, 0x74, 0x78, 0x7b, 0x81, 0x85, 0x86, 0x88,
-				0x90, 0x93, 0x94, 0x96, 0x9c, 0x9f, 0xa4, 0xa5,
-				0xa6, 0xaa, 0xac, 0xad, 0xad, 0xb4, 0xbc, 0xbf,
-				0xc0, 0xc2, 0xc7, 0xc8, 0xca, 0xcd, 0xce, 0xcf,
-				0xd2, 0xd2, 0xd4, 0xd7, 0xdc, 0xdd, 0xe0, 0xe4,
-				0xe8, 0xf0, 0xf7, 0xf8, 0xf9, 0xfe,
-			},
-			{
-				0x00, 0x03, 0x04, 0x05, 0x08, 0x0d, 0x10, 0x12,
-				0x16, 0x18, 0x1a, 0x1e, 0x1f, 0x21, 0x26, 0x29,
-				0x2a, 0x2b, 0x2d, 0x2e, 0x30, 0x33, 0x34, 0x35,
-				0x38, 0x3b, 0x43, 0x43, 0x46, 0x47, 0x4c, 0x57,
-				0x5a, 0x67, 0x69, 0x6a, 0x6b, 0x6e, 0x74, 0x76,
-				0x79, 0x7a, 0x7a, 0x7e, 0x7f, 0x80, 0x82, 0x84,
-				0x86, 0x8d, 0x91, 0x92, 0x95, 0x96, 0x98, 0x9f,
-				0xa1, 0xa4, 0xac, 0xae, 0xb1, 0xb7, 0xb9, 0xb9,
-				0xbd, 0xbe, 0xc0, 0xc6, 0xc7, 0xce, 0xd3, 0xd7,
-				0xd9, 0xdb, 0xe0, 0xe5, 0xe6, 0xe8, 0xe8, 0xe9,
-				0xec, 0xed, 0xf5, 0xf6, 0xf7, 0xf9,
-			},
-			{
-				0x02, 0x03, 0x06, 0x0c, 0x0d, 0x0f, 0x11, 0x16,
-				0x16, 0x17, 0x17, 0x18, 0x1a, 0x1d, 0x1e, 0x24,
-				0x32, 0x34, 0x39, 0x3e, 0x40, 0x41, 0x43, 0x48,
-				0x4a, 0x4b, 0x4b, 0x4f, 0x50, 0x50, 0x50, 0x52,
-				0x54, 0x54, 0x56, 0x59, 0x60, 0x63, 0x64, 0x66,
-				0x68, 0x69, 0x6d, 0x6e, 0x70, 0x71, 0x72, 0x73,
-				0x77, 0x78, 0x7b, 0x7c, 0x82, 0x86, 0x87, 0x8a,
-				0x8a, 0x8b, 0x8e, 0x8f, 0x91, 0x98, 0x9b, 0xa2,
-				0xac, 0xb2, 0xb3, 0xb4, 0xb5, 0xb5, 0xba, 0xba,
-				0xbf, 0xc4, 0xc5, 0xc9, 0xcc, 0xd0, 0xd1, 0xde,
-				0xe1, 0xe3, 0xee, 0xf3, 0xf5, 0xfb,
-			},
-			{
-				0x05, 0x0d, 0x15, 0x19, 0x1b, 0x1d, 0x22, 0x23,
-				0x24, 0x26, 0x28, 0x2b, 0x35, 0x36, 0x3a, 0x3b,
-				0x3c, 0x3e, 0x3e, 0x3f, 0x4a, 0x4c, 0x52, 0x58,
-				0x5b, 0x5c, 0x61, 0x65, 0x66, 0x67, 0x68, 0x68,
-				0x6b, 0x76, 0x76, 0x77, 0x7c, 0x82, 0x83, 0x85,
-				0x88, 0x88, 0x89, 0x92, 0x93, 0x9c, 0x9f, 0xa0,
-				0xa3, 0xa3, 0xa7, 0xaa, 0xab, 0xaf, 0xb0, 0xb3,
-				0xbb, 0xbb, 0xbd, 0xc2, 0xc5, 0xc5, 0xc6, 0xcb,
-				0xcf, 0xd0, 0xd5, 0xd5, 0xda, 0xdc, 0xdd, 0xe4,
-				0xe4, 0xe5, 0xed, 0xef, 0xef, 0xef, 0xf3, 0xfa,
-				0xfa, 0xfb, 0xfc, 0xfd, 0xff, 0xff,
-			},
-			{
-				0x01, 0x07, 0x09, 0x09, 0x0e, 0x13, 0x18, 0x1c,
-				0x1e, 0x1f, 0x21, 0x22, 0x26, 0x28, 0x28, 0x2b,
-				0x2d, 0x2f, 0x30, 0x32, 0x37, 0x38, 0x38, 0x39,
-				0x3a, 0x3d, 0x3f, 0x40, 0x44, 0x44, 0x48, 0x4e,
-				0x51, 0x53, 0x55, 0x56, 0x5c, 0x5e, 0x5f, 0x61,
-				0x6a, 0x6f, 0x70, 0x71, 0x7d, 0x80, 0x83, 0x83,
-				0x84, 0x87, 0x8d, 0x8f, 0x91, 0x92, 0x94, 0x97,
-				0x99, 0x9e, 0xa0, 0xa2, 0xa5, 0xa7, 0xa9, 0xab,
-				0xb0, 0xb7, 0xc8, 0xc9, 0xca, 0xd1, 0xd1, 0xd5,
-				0xd6, 0xda, 0xdd, 0xdf, 0xe6, 0xea, 0xeb, 0xec,
-				0xf0, 0xf2, 0xf4, 0xf9, 0xfa, 0xfc,
-			},
-		},
-
-		// Group 86
-		{
-			{
-				0x00, 0x01, 0x04, 0x06, 0x08, 0x0a, 0x0a, 0x0b,
-				0x12, 0x13, 0x18, 0x1a, 0x1e, 0x1f, 0x21, 0x22,
-				0x23, 0x23, 0x24, 0x2b, 0x2d, 0x2d, 0x33, 0x34,
-				0x39, 0x3b, 0x3c, 0x3d, 0x3f, 0x40, 0x44, 0x45,
-				0x4a, 0x4c, 0x4d, 0x54, 0x58, 0x59, 0x5c, 0x65,
-				0x6c, 0x76, 0x77, 0x7b, 0x7c, 0x82, 0x85, 0x85,
-				0x87, 0x8b, 0x8b, 0x8c, 0x8e, 0x90, 0x96, 0x97,
-				0x9a, 0x9b, 0x9d, 0xa1, 0xa3, 0xa5, 0xa9, 0xb2,
-				0xb3, 0xb5, 0xb6, 0xb9, 0xbb, 0xbf, 0xc6, 0xc8,
-				0xd7, 0xd8, 0xde, 0xe0, 0xe1, 0xe3, 0xe5, 0xe8,
-				0xe9, 0xeb, 0xeb, 0xf4, 0xf7, 0xfa,
-			},
-			{
-				0x02, 0x05, 0x0c, 0x11, 0x12, 0x15, 0x16, 0x17,
-				0x1d, 0x22, 0x28, 0x29, 0x2a, 0x2f, 0x2f, 0x36,
-				0x36, 0x39, 0x3a, 0x41, 0x47, 0x4b, 0x4e, 0x4e,
-				0x58, 0x59, 0x5a, 0x5b, 0x5e, 0x63, 0x68, 0x6b,
-				0x6b, 0x6c, 0x6e, 0x72, 0x76, 0x7a, 0x7d, 0x7d,
-				0x7e, 0x7f, 0x80, 0x87, 0x92, 0x98, 0x99, 0x9d,
-				0x9f, 0xa3, 0xa4, 0xa8, 0xad, 0xad, 0xb1, 0xb3,
-				0xb4, 0xb7, 0xb8, 0xc0, 0xc0, 0xc1, 0xc2, 0xc2,
-				0xc4, 0xc5, 0xc8, 0xc9, 0xc9, 0xca, 0xcd, 0xd1,
-				0xd6, 0xd7, 0xe2, 0xe4, 0xe4, 0xec, 0xf2, 0xf6,
-				0xf9, 0xf9, 0xfb, 0xfb, 0xfd, 0xff,
-			},
-			{
-				0x01, 0x07, 0x0c, 0x0d, 0x0e, 0x18, 0x1b, 0x25,
-				0x27, 0x29, 0x2f, 0x31, 0x32, 0x33, 0x38, 0x3e,
-				0x4d, 0x50, 0x52, 0x54, 0x55, 0x5f, 0x5f, 0x63,
-				0x64, 0x66, 0x68, 0x69, 0x69, 0x6c, 0x6f, 0x6f,
-				0x71, 0x72, 0x74, 0x75, 0x75, 0x78, 0x79, 0x7b,
-				0x7c, 0x7d, 0x80, 0x83, 0x83, 0x86, 0x89, 0x8a,
-				0x93, 0x9c, 0x9c, 0xa2, 0xaa, 0xab, 0xac, 0xae,
-				0xae, 0xaf, 0xb6, 0xb6, 0xb9, 0xbd, 0xbe, 0xc1,
-				0xc3, 0xc3, 0xc4, 0xc7, 0xcf, 0xd2, 0xd5, 0xd9,
-				0xd9, 0xdb, 0xdd, 0xe2, 0xe8, 0xea, 0xf0, 0xf3,
-				0xf4, 0xf5, 0xf6, 0xf8, 0xfa, 0xfe,
-			},
-			{
-				0x00, 0x02, 0x08, 0x0b, 0x0b, 0x0c, 0x10, 0x14,
-				0x1c, 0x1d, 0x20, 0x2b, 0x2e, 0x31, 0x34, 0x35,
-				0x36, 0x37, 0x37, 0x3c, 0x3d, 0x41, 0x46, 0x49,
-				0x4a, 0x4c, 0x51, 0x53, 0x56, 0x57, 0x5c, 0x5d,
-				0x60, 0x60, 0x62, 0x64, 0x73, 0x74, 0x74, 0x75,
-				0x76, 0x77, 0x78, 0x78, 0x81, 0x84, 0x86, 0x8a,
-				0x8d, 0x8f, 0x90, 0x92, 0x95, 0x95, 0x96, 0x98,
-				0x99, 0x9a, 0x9b, 0x9e, 0xa0, 0xa0, 0xa1, 0xa2,
-				0xb0, 0xb0, 0xb2, 0xb3, 0xb5, 0xb5, 0xbe, 0xc2,
-				0xca, 0xcb, 0xcb, 0xcc, 0xce, 0xd1, 0xd5, 0xdb,
-				0xec, 0xed, 0xee, 0xf1, 0xf4, 0xfd,
-			},
-			{
-				0x01, 0x03, 0x03, 0x04, 0x08, 0x09, 0x09, 0x0e,
-				0x0f, 0x16, 0x17, 0x1a, 0x1c, 0x20, 0x26, 0x27,
-				0x2a, 0x2a, 0x2d, 0x2e, 0x2e, 0x32, 0x32, 0x3b,
-				0x3d, 0x3e, 0x40, 0x41, 0x43, 0x48, 0x49, 0x4b,
-				0x53, 0x56, 0x5a, 0x5b, 0x65, 0x6a, 0x6e, 0x70,
-				0x70, 0x71, 0x73, 0x7a, 0x81, 0x88, 0x88, 0x8c,
-				0x8d, 0x8f, 0x95, 0x97, 0xa2, 0xac, 0xb4, 0xba,
-				0xbc, 0xbc, 0xbe, 0xc0, 0xc3, 0xc5, 0xc6, 0xc7,
-				0xca, 0xcd, 0xd6, 0xdb, 0xde, 0xe0, 0xe3, 0xe4,
-				0xe6, 0xe9, 0xea, 0xed, 0xef, 0xf2, 0xf3, 0xf3,
-				0xf5, 0xf6, 0xfb, 0xfc, 0xfc, 0xfe,
-			},
-			{
-				0x05, 0x06, 0x07, 0x09, 0x0d, 0x0f, 0x10, 0x11,
-				0x12, 0x13, 0x14, 0x14, 0x15, 0x19, 0x1b, 0x1b,
-				0x1d, 0x1f, 0x21, 0x25, 0x2b, 0x2c, 0x2c, 0x30,
-				0x30, 0x35, 0x37, 0x38, 0x3b, 0x42, 0x42, 0x43,
-				0x4c, 0x4f, 0x50, 0x52, 0x55, 0x57, 0x5e, 0x60,
-				0x61, 0x62, 0x66, 0x67, 0x6a, 0x72, 0x80, 0x82,
-				0x88, 0x89, 0x91, 0x91, 0x94, 0x94, 0xa4, 0xa5,
-				0xa8, 0xaa, 0xb0, 0xb1, 0xb2, 0xb8, 0xbb, 0xbd,
-				0xbf, 0xc8, 0xcd, 0xd3, 0xd4, 0xd7, 0xd9, 0xda,
-				0xdc, 0xdd, 0xdf, 0xe0, 0xe7, 0xeb, 0xed, 0xef,
-				0xef, 0xf0, 0xf1, 0xf1, 0xf7, 0xff,
-			},
-			{
-				0x00, 0x03, 0x0d, 0x19, 0x1e, 0x1f, 0x24, 0x25,
-				0x26, 0x28, 0x28, 0x3a, 0x3c, 0x3f, 0x44, 0x45,
-				0x46, 0x47, 0x48, 0x48, 0x4d, 0x4f, 0x51, 0x53,
-				0x56, 0x5c, 0x5d, 0x5d, 0x61, 0x67, 0x6d, 0x6d,
-				0x6e, 0x6f, 0x79, 0x7e, 0x7f, 0x7f, 0x81, 0x82,
-				0x84, 0x8e, 0x8f, 0x90, 0x93, 0x9b, 0x9e, 0x9f,
-				0xa0, 0xa5, 0xa6, 0xa6, 0xa7, 0xa7, 0xa8, 0xa9,
-				0xab, 0xaf, 0xb7, 0xb9, 0xba, 0xbf, 0xc5, 0xc9,
-				0xcc, 0xce, 0xcf, 0xcf, 0xd0, 0xd0, 0xd2, 0xd3,
-				0xd4, 0xd4, 0xd8, 0xd8, 0xda, 0xdc, 0xdf, 0xe1,
-				0xe5, 0xe6, 0xe7, 0xee, 0xf8, 0xfc,
-			},
-		},
-
-		// Group 87
-		{
-			{
-				0x06, 0x07, 0x08, 0x0e, 0x1a, 0x1b, 0x1d, 0x1e,
-				0x1e, 0x21, 0x24, 0x29, 0x2a, 0x2e, 0x34, 0x39,
-				0x3a, 0x3b, 0x3b, 0x3d, 0x3e, 0x3e, 0x3e, 0x3f,
-				0x41, 0x42, 0x48, 0x4b, 0x4b, 0x4c, 0x50, 0x50,
-				0x52, 0x53, 0x55, 0x5a, 0x5c, 0x60, 0x68, 0x6a,
-				0x6d, 0x6d, 0x6e, 0x71, 0x73, 0x75, 0x7c, 0x81,
-				0x88, 0x8c, 0x8f, 0x95, 0x97, 0x98, 0x9a, 0xa1,
-				0xa8, 0xab, 0xb1, 0xb3, 0xb3, 0xb4, 0xb7, 0xbc,
-				0xbf, 0xc2, 0xc8, 0xca, 0xd6, 0xda, 0xdb, 0xde,
-				0xdf, 0xe0, 0xe4, 0xe5, 0xe7, 0xed, 0xef, 0xf0,
-				0xf6, 0xf7, 0xf9, 0xfb, 0xfe, 0xff,
-			},
-			{
-				0x00, 0x03, 0x0d, 0x12, 0x15, 0x16, 0x1f, 0x22,
-				0x23, 0x24, 0x2f, 0x30, 0x37, 0x38, 0x40, 0x42,
-				0x47, 0x48, 0x49, 0x4d, 0x4e, 0x57, 0x5b, 0x5c,
-				0x5e, 0x61, 0x62, 0x66, 0x67, 0x69, 0x69, 0x6d,
-				0x72, 0x77, 0x79, 0x7a, 0x7f, 0x84, 0x85, 0x87,
-				0x89, 0x8c, 0x8d, 0x8d, 0x96, 0x97, 0x98, 0x9d,
-				0x9e, 0x9f, 0xa5, 0xa6, 0xa7, 0xad, 0xae, 0xb4,
-				0xb6, 0xba, 0xba, 0xbb, 0xbb, 0xbc, 0xbd, 0xc2,
-				0xc4, 0xc5, 0xc6, 0xcc, 0xce, 0xd1, 0xd2, 0xd4,
-				0xd4, 0xd7, 0xd7, 0xd8, 0xda, 0xdc, 0xe2, 0xe7,
-				0xec, 0xf0, 0xf5, 0xf6, 0xfc, 0xff,
-			},
-			{
-				0x00, 0x01, 0x04, 0x0c, 0x0e, 0x0f, 0x10, 0x13,
-				0x13, 0x16, 0x19, 0x1b, 0x1d, 0x20, 0x27, 0x27,
-				0x2b, 0x2b, 0x2d, 0x2e, 0x32, 0x33, 0x35, 0x44,
-				0x45, 0x47, 0x48, 0x4a, 0x51, 0x52, 0x53, 0x54,
-				0x55, 0x56, 0x58, 0x59, 0x59, 0x60, 0x64, 0x66,
-				0x73, 0x78, 0x7b, 0x7c, 0x7d, 0x7f, 0x82, 0x83,
-				0x88, 0x8a, 0x8e, 0x91, 0x95, 0x99, 0x99, 0x9b,
-				0x9c, 0xa2, 0xa2, 0xa3, 0xa3, 0xa4, 0xa5, 0xad,
-				0xb0, 0xb4, 0xb7, 0xb8, 0xba, 0xc1, 0xc1, 0xc3,
-				0xca, 0xcc, 0xcf, 0xd2, 0xd4, 0xe0, 0xe1, 0xe3,
-				0xe8, 0xee, 0xf3, 0xf5, 0xf6, 0xfe,
-			},
-			{
-				0x01, 0x02, 0x02, 0x03, 0x0a, 0x0b, 0x0e, 0x11,
-				0x14, 0x18, 0x1b, 0x1c, 0x22, 0x28, 0x29, 0x2a,
-				0x2a, 0x2b, 0x30, 0x31, 0x34, 0x35, 0x36, 0x3c,
-				0x3f, 0x45, 0x46, 0x46, 0x47, 0x4c, 0x4d, 0x58,
-				0x58, 0x5d, 0x5f, 0x66, 0x67, 0x6f, 0x72, 0x76,
-				0x76, 0x79, 0x82, 0x83, 0x86, 0x8a, 0x8b, 0x8e,
-				0x92, 0x93, 0x94, 0x94, 0x9c, 0x9e, 0xa6, 0xa8,
-				0xac, 0xaf, 0xb2, 0xb8, 0xb9, 0xbe, 0xbe, 0xc0,
-				0xc4, 0xc5, 0xcb, 0xcd, 0xd0, 0xd3, 0xd3, 0xd5,
-				0xd6, 0xd8, 0xd9, 0xe8, 0xea, 0xf1, 0xf4, 0xf4,
-				0xf8, 0xfa, 0xfb, 0xfc, 0xfd, 0xfe,
-			},
-			{
-				0x04, 0x05, 0x08, 0x0b, 0x0c, 0x0f, 0x10, 0x15,
-				0x16, 0x17, 0x1e, 0x1f, 0x20, 0x21, 0x25, 0x2c,
-				0x2c, 0x2d, 0x2f, 0x31, 0x31, 0x32, 0x33, 0x34,
-				0x3d, 0x3d, 0x49, 0x4c, 0x4e, 0x51, 0x5b, 0x5d,
-				0x5d, 0x60, 0x62, 0x65, 0x6a, 0x6b, 0x6b, 0x6e,
-				0x74, 0x75, 0x77, 0x79, 0x7d, 0x7f, 0x84, 0x86,
-				0x8c, 0x90, 0x90, 0x98, 0x9d, 0x9f, 0xa0, 0xa3,
-				0xa7, 0xa9, 0xaa, 0xaa, 0xb0, 0xbd, 0xbf, 0xc0,
-				0xc5, 0xc9, 0xcb, 0xcc, 0xce, 0xd1, 0xd3, 0xd5,
-				0xd5, 0xd9, 0xdc, 0xdc, 0xde, 0xe1, 0xe5, 0xe6,
-				0xe9, 0xea, 0xeb, 0xec, 0xf7, 0xfd,
-			},
-			{
-				0x05, 0x06, 0x09, 0x0a, 0x0d, 0x11, 0x11, 0x14,
-				0x18, 0x19, 0x1a, 0x1c, 0x22, 0x25, 0x28, 0x2f,
-				0x36, 0x38, 0x39, 0x3f, 0x40, 0x41, 0x45, 0x46,
-				0x53, 0x54, 0x5a, 0x61, 0x63, 0x63, 0x65, 0x68,
-				0x71, 0x71, 0x78, 0x7c, 0x80, 0x80, 0x80, 0x81,
-				0x82, 0x86, 0x87, 0x89, 0x92, 0x93, 0x96, 0x9b,
-				0x9f, 0xa0, 0xa4, 0xa6, 0xa7, 0xac, 0xae, 0xb2,
-				0xb3, 0xb5, 0xb5, 0xb6, 0xc3, 0xc3, 0xc6, 0xc7,
-				0xc8, 0xc9, 0xca, 0xcf, 0xd0, 0xd1, 0xd7, 0xde,
-				0xe2, 0xe2, 0xe3, 0xe4, 0xe6, 0xea, 0xed, 0xef,
-				0xf1, 0xf2, 0xf3, 0xf7, 0xf9, 0xff,
-			},
-			{
-				0x07, 0x09, 0x12, 0x17, 0x1c, 0x1f, 0x23, 0x26,
-				0x26, 0x29, 0x2c, 0x30, 0x37, 0x37, 0x3a, 0x3c,
-				0x40, 0x41, 0x43, 0x43, 0x44, 0x4a, 0x4f, 0x4f,
-				0x56, 0x57, 0x57, 0x5a, 0x5e, 0x5f, 0x5f, 0x64,
-				0x67, 0x68, 0x6a, 0x6c, 0x6c, 0x6f, 0x70, 0x70,
-				0x74, 0x7a, 0x7b, 0x7d, 0x7e, 0x7e, 0x83, 0x85,
-				0x87, 0x8b, 0x8e, 0x8f, 0x8f, 0x91, 0x93, 0x9a,
-				0x9a, 0x9e, 0xa1, 0xa8, 0xa9, 0xa9, 0xab, 0xac,
-				0xaf, 0xb0, 0xb1, 0xb6, 0xb9, 0xb9, 0xc7, 0xc7,
-				0xcd, 0xdb, 0xdd, 0xdd, 0xdf, 0xe1, 0xe8, 0xe9,
-				0xeb, 0xee, 0xf0, 0xf2, 0xf8, 0xfa,
-			},
-		},
-
-		// Group 88
-		{
-			{
-				0x0a, 0x0c, 0x10, 0x16, 0x17, 0x1d, 0x1e, 0x21,
-				0x24, 0x25, 0x25, 0x29, 0x2b, 0x2e, 0x2e, 0x32,
-				0x34, 0x35, 0x3b, 0x3f, 0x3f, 0x40, 0x41, 0x42,
-				0x48, 0x4a, 0x4e, 0x5a, 0x64, 0x6d, 0x6d, 0x6f,
-				0x70, 0x71, 0x71, 0x72, 0x76, 0x76, 0x7b, 0x81,
-				0x84, 0x88, 0x89, 0x8b, 0x90, 0x95, 0x96, 0x99,
-				0x9a, 0x9a, 0x9b, 0xa1, 0xa2, 0xa9, 0xa9, 0xac,
-				0xae, 0xb1, 0xb6, 0xb7, 0xba, 0xbd, 0xbf, 0xc1,
-				0xc3, 0xc4, 0xcb, 0xcd, 0xcd, 0xcf, 0xd6, 0xdb,
-				0xdd, 0xdd, 0xde, 0xdf, 0xe2, 0xe8, 0xea, 0xed,
-				0xee, 0xf2, 0xf5, 0xf7, 0xfc, 0xfe,
-			},
-			{
-				0x02, 0x04, 0x06, 0x07, 0x0a, 0x0b, 0x0b, 0x0e,
-				0x15, 0x18, 0x19, 0x1d, 0x1e, 0x1f, 0x20, 0x22,
-				0x23, 0x27, 0x27, 0x2d, 0x33, 0x37, 0x37, 0x3e,
-				0x43, 0x49, 0x4b, 0x4c, 0x4f, 0x4f, 0x54, 0x55,
-				0x57, 0x59, 0x5a, 0x5b, 0x5d, 0x61, 0x61, 0x63,
-				0x72, 0x78, 0x7a, 0x7a, 0x82, 0x82, 0x87, 0x8f,
-				0x91, 0x94, 0x94, 0x97, 0x98, 0x98, 0x9a, 0x9e,
-				0xa3, 0xa4, 0xa5, 0xa6, 0xad, 0xad, 0xb0, 0xb2,
-				0xb3, 0xb7, 0xb9, 0xbc, 0xbe, 0xc0, 0xc5, 0xc6,
-				0xcb, 0xce, 0xcf, 0xd7, 0xda, 0xdb, 0xdc, 0xde,
-				0xdf, 0xe7, 0xe7, 0xf9, 0xf9, 0xfb,
-			},
-			{
-				0x01, 0x02, 0x08, 0x08, 0x08, 0x09, 0x0a, 0x0d,
-				0x10, 0x13, 0x15, 0x19, 0x1b, 0x1c, 0x20, 0x25,
-				0x26, 0x33, 0x38, 0x3d, 0x3f, 0x41, 0x43, 0x44,
-				0x45, 0x47, 0x48, 0x52, 0x53, 0x53, 0x57, 0x58,
-				0x5e, 0x61, 0x63, 0x65, 0x67, 0x69, 0x6e, 0x73,
-				0x74, 0x75, 0x75, 0x80, 0x81, 0x83, 0x8c, 0x8f,
-				0x93, 0x94, 0x99, 0x9b, 0x9f, 0xa0, 0xa1, 0xa2,
-				0xa6, 0xa7, 0xaa, 0xab, 0xaf, 0xb0, 0xb2, 0xb9,
-				0xc0, 0xc2, 0xc3, 0xc5, 0xc6, 0xc6, 0xc8, 0xc9,
-				0xd2, 0xd4, 0xd7, 0xd9, 0xdb, 0xde, 0xe3, 0xe4,
-				0xe5, 0xf0, 0xf6, 0xf7, 0xfd, 0xfe,
-			},
-			{
-				0x01, 0x05, 0x09, 0x09, 0x0c, 0x0e, 0x0e, 0x0f,
-				0x0f, 0x12, 0x13, 0x14, 0x1a, 0x21, 0x26, 0x2c,
-				0x34, 0x39, 0x39, 0x45, 0x46, 0x47, 0x48, 0x4e,
-				0x50, 0x51, 0x55, 0x58, 0x5c, 0x5c, 0x5f, 0x5f,
-				0x62, 0x66, 0x67, 0x6a, 0x6b, 0x6c, 0x6f, 0x70,
-				0x72, 0x77, 0x78, 0x7d, 0x83, 0x84, 0x86, 0x89,
-				0x8e, 0x91, 0x93, 0x96, 0x9c, 0x9d, 0xa8, 0xaa,
-				0xaa, 0xad, 0xae, 0xaf, 0xb8, 0xbb, 0xbe, 0xbf,
-				0xc0, 0xc2, 0xc7, 0xc8, 0xca, 0xca, 0xcc, 0xcf,
-				0xd1, 0xd4, 0xe1, 0xe5, 0xe7, 0xec, 0xf3, 0xf4,
-				0xf4, 0xf4, 0xf5, 0xf6, 0xf7, 0xf9,
-			},
-			{
-				0x03, 0x03, 0x04, 0x11, 0x1a, 0x1b, 0x1e, 0x22,
-				0x28, 0x29, 0x2b, 0x2c, 0x2f, 0x31, 0x31, 0x37,
-				0x3a, 0x3b, 0x3e, 0x42, 0x47, 0x4b, 0x4c, 0x4c,
-				0x50, 0x51, 0x56, 0x59, 0x64, 0x67, 0x68, 0x69,
-				0x6f, 0x77, 0x79, 0x79, 0x7b, 0x7e, 0x85, 0x86,
-				0x88, 0x8a, 0x8a, 0x8d, 0x92, 0x9b, 0x9c, 0x9d,
-				0x9f, 0xa0, 0xa3, 0xa5, 0xa5, 0xa7, 0xab, 0xb1,
-				0xb5, 0xb9, 0xbc, 0xc4, 0xc5, 0xc7, 0xc9, 0xcc,
-				0xce, 0xce, 0xd0, 0xd2, 0xd3, 0xd5, 0xd7, 0xd8,
-				0xd9, 0xd9, 0xdc, 0xdc, 0xe0, 0xe2, 0xe3, 0xee,
-				0xf0, 0xf8, 0xf8, 0xfc, 0xff, 0xff,
-			},
-			{
-				0x00, 0x00, 0x03, 0x05, 0x06, 0x0d, 0x11, 0x12,
-				0x14, 0x17, 0x18, 0x1a, 0x1f, 0x23, 0x24, 0x29,
-				0x2a, 0x2f, 0x30, 0x32, 0x35, 0x36, 0x36, 0x3a,
-				0x3d, 0x3d, 0x40, 0x41, 0x49, 0x4a, 0x4d, 0x52,
-				0x54, 0x5d, 0x5e, 0x60, 0x60, 0x62, 0x68, 0x69,
-				0x6b, 0x6c, 0x6e, 0x73, 0x74, 0x7c, 0x7c, 0x7d,
-				0x7e, 0x80, 0x81, 0x84, 0x85, 0x87, 0x8b, 0x8d,
-				0x8e, 0x97, 0x99, 0xa4, 0xb5, 0xb7, 0xb8, 0xbb,
-				0xbc, 0xbd, 0xc1, 0xd1, 0xd3, 0xd8, 0xda, 0xdf,
-				0xe3, 0xe4, 0xe6, 0xea, 0xec, 0xf1, 0xf2, 0xf3,
-				0xf5, 0xf8, 0xfa, 0xfa, 0xfb, 0xfd,
-			},
-			{
-				0x02, 0x05, 0x06, 0x07, 0x0d, 0x15, 0x16, 0x18,
-				0x1c, 0x27, 0x28, 0x2a, 0x2b, 0x2d, 0x2d, 0x30,
-				0x38, 0x3c, 0x3c, 0x3e, 0x44, 0x46, 0x4d, 0x4d,
-				0x56, 0x59, 0x5b, 0x65, 0x66, 0x6a, 0x6b, 0x6d,
-				0x6e, 0x71, 0x74, 0x77, 0x7b, 0x7e, 0x7f, 0x7f,
-				0x87, 0x88, 0x8c, 0x90, 0x92, 0x92, 0x95, 0x98,
-				0x9d, 0x9e, 0xa0, 0xa8, 0xac, 0xaf, 0xb0, 0xb1,
-				0xb3, 0xb4, 0xb4, 0xb6, 0xb8, 0xba, 0xbf, 0xcd,
-				0xd0, 0xd5, 0xd6, 0xe0, 0xe0, 0xe1, 0xe5, 0xe6,
-				0xe8, 0xe9, 0xe9, 0xeb, 0xeb, 0xeb, 0xed, 0xed,
-				0xef, 0xef, 0xf0, 0xf1, 0xf6, 0xfe,
-			},
-		},
-
-		// Group 89
-		{
-			{
-				0x02, 0x03, 0x04, 0x05, 0x09, 0x0c, 0x10, 0x12,
-				0x15, 0x18, 0x1f, 0x2b, 0x2d, 0x2e, 0x32, 0x46,
-				0x46, 0x47, 0x47, 0x4a, 0x55, 0x56, 0x5a, 0x5e,
-				0x5f, 0x60, 0x63, 0x66, 0x69, 0x6c, 0x6d, 0x72,
-				0x73, 0x75, 0x77, 0x7c, 0x7e, 0x80, 0x82, 0x83,
-				0x83, 0x84, 0x89, 0x8b, 0x8c, 0x8d, 0x93, 0x99,
-				0x9a, 0xa5, 0xab, 0xad, 0xae, 0xaf, 0xaf, 0xb3,
-				0xb7, 0xba, 0xbb, 0xbc, 0xbf, 0xc0, 0xc3, 0xc6,
-				0xc7, 0xc8, 0xc9, 0xcb, 0xcf, 0xcf, 0xd0, 0xdd,
-				0xdd, 0xde, 0xe0, 0xe1, 0xe2, 0xe4, 0xe8, 0xe9,
-				0xec, 0xed, 0xf6, 0xfc, 0xfd, 0xfe,
-			},
-			{
-				0x00, 0x05, 0x0b, 0x0b, 0x13, 0x13, 0x13, 0x17,
-				0x19, 0x1b, 0x1b, 0x1d, 0x1e, 0x21, 0x21, 0x25,
-				0x29, 0x2b, 0x32, 0x36, 0x36, 0x3c, 0x3c, 0x3f,
-				0x3f, 0x42, 0x45, 0x4c, 0x4e, 0x54, 0x57, 0x58,
-				0x5d, 0x60, 0x64, 0x67, 0x68, 0x6e, 0x71, 0x73,
-				0x79, 0x7c, 0x7e, 0x80, 0x81, 0x85, 0x86, 0x87,
-				0x8b, 0x8e, 0x8f, 0x94, 0x95, 0x95, 0x99, 0x9a,
-				0x9d, 0x9d, 0x9e, 0xa6, 0xa7, 0xaa, 0xb0, 0xb8,
-				0xbe, 0xc0, 0xc1, 0xc5, 0xc5, 0xcd, 0xd1, 0xd5,
-				0xd6, 0xd7, 0xda, 0xe1, 0xe3, 0xe7, 0xe7, 0xeb,
-				0xef, 0xf2, 0xf4, 0xf9, 0xfa, 0xfc,
-			},
-			{
-				0x00, 0x03, 0x03, 0x04, 0x07, 0x0a, 0x0e, 0x0f,
-				0x10, 0x14, 0x1c, 0x1d, 0x1f, 0x20, 0x21, 0x24,
-				0x26, 0x26, 0x28, 0x2c, 0x33, 0x35, 0x39, 0x3d,
-				0x3e, 0x40, 0x44, 0x4f, 0x4f, 0x50, 0x51, 0x56,
-				0x57, 0x59, 0x5c, 0x5d, 0x60, 0x61, 0x65, 0x6c,
-				0x75, 0x7a, 0x7d, 0x7f, 0x84, 0x88, 0x8d, 0x91,
-				0x91, 0x92, 0x93, 0x96, 0x96, 0x97, 0x99, 0x9c,
-				0x9c, 0xa1, 0xa6, 0xa7, 0xa9, 0xad, 0xaf, 0xb1,
-				0xb7, 0xb9, 0xb9, 0xba, 0xbc, 0xbd, 0xbe, 0xbf,
-				0xc3, 0xc6, 0xca, 0xcc, 0xcd, 0xce, 0xd9, 0xe0,
-				0xe5, 0xe8, 0xea, 0xf4, 0xf7, 0xf8,
-			},
-			{
-				0x00, 0x01, 0x06, 0x08, 0x08, 0x09, 0x0a, 0x0d,
-				0x11, 0x12, 0x14, 0x15, 0x16, 0x18, 0x19, 0x1a,
-				0x1a, 0x1f, 0x20, 0x20, 0x28, 0x29, 0x2a, 0x2c,
-				0x30, 0x30, 0x31, 0x34, 0x3a, 0x42, 0x43, 0x44,
-				0x46, 0x48, 0x4e, 0x52, 0x53, 0x55, 0x56, 0x64,
-				0x66, 0x6a, 0x6f, 0x75, 0x7b, 0x7b, 0x7f, 0x81,
-				0x82, 0x88, 0x8a, 0x8a, 0x8c, 0x8c, 0x8e, 0x8f,
-				0x9b, 0x9c, 0x9f, 0xa0, 0xaa, 0xb2, 0xb5, 0xb8,
-				0xc2, 0xc8, 0xc9, 0xcc, 0xcd, 0xce, 0xd3, 0xd4,
-				0xd5, 0xdb, 0xe1, 0xe3, 0xe4, 0xea, 0xeb, 0xeb,
-				0xec, 0xee, 0xf5, 0xf5, 0xf6, 0xf8,
-			},
-			{
-				0x07, 0x08, 0x0c, 0x0e, 0x0e, 0x0f, 0x15, 0x1e,
-				0x22, 0x23, 0x25, 0x27, 0x2c, 0x2f, 0x34, 0x34,
-				0x3b, 0x3d, 0x47, 0x49, 0x49, 0x4c, 0x51, 0x52,
-				0x5b, 0x5e, 0x5f, 0x61, 0x62, 0x69, 0x69, 0x70,
-				0x74, 0x74, 0x76, 0x77, 0x78, 0x79, 0x7b, 0x7d,
-				0x83, 0x84, 0x85, 0x87, 0x8b, 0x91, 0x98, 0x98,
-				0x9f, 0x9f, 0xa2, 0xa4, 0xa8, 0xab, 0xac, 0xb0,
-				0xb2, 0xb4, 0xb6, 0xb6, 0xbb, 0xbd, 0xc1, 0xc4,
-				0xc4, 0xcc, 0xd0, 0xd3, 0xd3, 0xd4, 0xd8, 0xdc,
-				0xdd, 0xe3, 0xe4, 0xe5, 0xe6, 0xe9, 0xf0, 0xf0,
-				0xf1, 0xf1, 0xf3, 0xf5, 0xfa, 0xfd,
-			},
-			{
-				0x01, 0x05, 0x07, 0x0a, 0x0d, 0x0f, 0x17, 0x1c,
-				0x22, 0x22, 0x24, 0x28, 0x2a, 0x2e, 0x31, 0x33,
-				0x35, 0x35, 0x37, 0x3a, 0x3e, 0x40, 0x41, 0x43,
-				0x45, 0x48, 0x4a, 0x4b, 0x4b, 0x4d, 0x53, 0x54,
-				0x59, 0x5a, 0x5b, 0x5c, 0x5d, 0x63, 0x67, 0x68,
-				0x6b, 0x6d, 0x6e, 0x6e, 0x73, 0x76, 0x7a, 0x7a,
-				0x7c, 0x7f, 0x81, 0x93, 0x98, 0x9b, 0xa0, 0xa4,
-				0xa4, 0xa5, 0xa7, 0xa8, 0xb1, 0xb2, 0xb5, 0xc2,
-				0xc7, 0xcb, 0xcf, 0xd2, 0xd2, 0xd7, 0xd9, 0xda,
-				0xdb, 0xdc, 0xdf, 0xdf, 0xe7, 0xee, 0xef, 0xf0,
-				0xf2, 0xf3, 0xf7, 0xfd, 0xff, 0xff,
-			},
-			{
-				0x02, 0x02, 0x06, 0x0c, 0x11, 0x12, 0x14, 0x16,
-				0x18, 0x23, 0x23, 0x24, 0x27, 0x2d, 0x2f, 0x32,
-				0x37, 0x38, 0x38, 0x39, 0x3b, 0x41, 0x49, 0x4d,
-				0x50, 0x53, 0x54, 0x55, 0x57, 0x58, 0x59, 0x5a,
-				0x5e, 0x62, 0x65, 0x68, 0x6a, 0x6a, 0x6b, 0x6d,
-				0x6f, 0x70, 0x71, 0x72, 0x76, 0x78, 0x79, 0x7e,
-				0x85, 0x86, 0x89, 0x8f, 0x90, 0x90, 0x90, 0x92,
-				0x94, 0x97, 0x9b, 0x9e, 0xa0, 0xa1, 0xa2, 0xa3,
-				0xa3, 0xa9, 0xab, 0xac, 0xae, 0xb1, 0xb3, 0xb4,
-				0xbf, 0xc6, 0xca, 0xd1, 0xd6, 0xd8, 0xde, 0xe2,
-				0xe6, 0xed, 0xf9, 0xfb, 0xfb, 0xfe,
-			},
-		},
-
-		// Group 90
-		{
-			{
-				0x03, 0x08, 0x0d, 0x16, 0x16, 0x17, 0x1a, 0x1c,
-				0x1d, 0x1e, 0x1e, 0x22, 0x24, 0x25, 0x25, 0x28,
-				0x2b, 0x2c, 0x2c, 0x30, 0x31, 0x32, 0x34, 0x39,
-				0x3a, 0x3d, 0x3f, 0x41, 0x41, 0x43, 0x46, 0x49,
-				0x49, 0x58, 0x5d, 0x5f, 0x60, 0x64, 0x65, 0x6a,
-				0x70, 0x73, 0x74, 0x7a, 0x7c, 0x7f, 0x80, 0x83,
-				0x89, 0x8b, 0x8e, 0x8e, 0x8f, 0x90, 0x95, 0x9a,
-				0xa0, 0xa6, 0xad, 0xb0, 0xb3, 0xb5, 0xb6, 0xb7,
-				0xb8, 0xba, 0xbe, 0xbe, 0xc6, 0xcd, 0xd3, 0xd8,
-				0xda, 0xdb, 0xe0, 0xe1, 0xea, 0xeb, 0xed, 0xf4,
-				0xf4, 0xf4, 0xf5, 0xf8, 0xfa, 0xfe,
-			},
-			{
-				0x0b, 0x0e, 0x0f, 0x11, 0x11, 0x12, 0x12, 0x13,
-				0x17, 0x19, 0x1d, 0x27, 0x29, 0x2d, 0x31, 0x39,
-				0x3b, 0x3c, 0x3e, 0x46, 0x4a, 0x4c, 0x54, 0x58,
-				0x5b, 0x5e, 0x62, 0x62, 0x63, 0x63, 0x64, 0x65,
-				0x69, 0x6a, 0x6b, 0x6b, 0x6d, 0x6e, 0x71, 0x72,
-				0x73, 0x76, 0x82, 0x83, 0x87, 0x88, 0x8a, 0x8c,
-				0x8d, 0x8f, 0x90, 0x93, 0x95, 0x9b, 0xa1, 0xa2,
-				0xa5, 0xa9, 0xab, 0xae, 0xb4, 0xb6, 0xb9, 0xbe,
-				0xbf, 0xc7, 0xc7, 0xc8, 0xc8, 0xcb, 0xcc, 0xcd,
-				0xcf, 0xd3, 0xd6, 0xde, 0xdf, 0xe2, 0xe7, 0xe9,
-				0xee, 0xef, 0xf2, 0xf2, 0xf7, 0xfa,
-			},
-			{
-				0x00, 0x00, 0x02, 0x03, 0x06, 0x07, 0x07, 0x08,
-				0x0a, 0x0f, 0x14, 0x14, 0x18, 0x18, 0x1b, 0x1b,
-				0x1c, 0x1f, 0x23, 0x23, 0x26, 0x29, 0x2f, 0x37,
-				0x38, 0x39, 0x3c, 0x43, 0x4c, 0x4e, 0x50, 0x52,
-				0x53, 0x54, 0x54, 0x55, 0x5a, 0x5f, 0x66, 0x68,
-				0x6a, 0x6c, 0x79, 0x79, 0x7b, 0x7c, 0x84, 0x86,
-				0x86, 0x89, 0x8a, 0x8b, 0x91, 0x92, 0x95, 0x97,
-				0x99, 0x9c, 0xa3, 0xa4, 0xaa, 0xab, 0xaf, 0xb4,
-				0xc2, 0xc4, 0xc5, 0xc5, 0xce, 0xd1, 0xd5, 0xd9,
-				0xd9, 0xd9, 0xdd, 0xdf, 0xe1, 0xe6, 0xf3, 0xf5,
-				0xf5, 0xf6, 0xf7, 0xf7, 0xf9, 0xfd,
-			},
-			{
-				0x02, 0x03, 0x05, 0x09, 0x0c, 0x0e, 0x13, 0x17,
-				0x1a, 0x1b, 0x1f, 0x20, 0x20, 0x21, 0x27, 0x2a,
-				0x2a, 0x2d, 0x32, 0x34, 0x3b, 0x3f, 0x40, 0x44,
-				0x47, 0x4a, 0x4d, 0x4e, 0x53, 0x55, 0x56, 0x59,
-				0x5d, 0x61, 0x66, 0x6f, 0x71, 0x78, 0x7e, 0x81,
-				0x88, 0x8b, 0x8f, 0x94, 0x9e, 0x9f, 0xa1, 0xa2,
-				0xa4, 0xa6, 0xae, 0xb1, 0xb1, 0xb3, 0xb8, 0xb9,
-				0xba, 0xbb, 0xc0, 0xc0, 0xc3, 0xc4, 0xc6, 0xca,
-				0xcb, 0xcb, 0xcf, 0xd0, 0xd0, 0xe2, 0xe3, 0xe3,
-				0xe4, 0xe5, 0xe5, 0xe7, 0xe9, 0xec, 0xf0, 0xf1,
-				0xf3, 0xfb, 0xfc, 0xfc, 0xfd, 0xfd,
-			},
-			{
-				0x01, 0x01, 0x04, 0x09, 0x0c, 0x0d, 0x14, 0x16,
-				0x22, 0x24, 0x29, 0x2e, 0x33, 0x35, 0x37, 0x38,
-				0x40, 0x47, 0x48, 0x4d, 0x4f, 0x50, 0x51, 0x51,
-				0x52, 0x57, 0x5a, 0x5c, 0x5c, 0x67, 0x67, 0x68,
-				0x68, 0x69, 0x6c, 0x6f, 0x6f, 0x71, 0x72, 0x72,
-				0x78, 0x79, 0x7d, 0x80, 0x87, 0x8c, 0x91, 0x91,
-				0x92, 0x93, 0x94, 0x96, 0x96, 0x98, 0x9a, 0x9c,
-				0x9d, 0x9e, 0x9f, 0xa8, 0xa9, 0xac, 0xac, 0xad,
-				0xb2, 0xb5, 0xbd, 0xc0, 0xc1, 0xc4, 0xc6, 0xc7,
-				0xc8, 0xca, 0xd2, 0xdb, 0xdd, 0xdf, 0xe1, 0xe5,
-				0xee, 0xef, 0xef, 0xf0, 0xfb, 0xff,
-			},
-			{
-				0x06, 0x0a, 0x0a, 0x0d, 0x10, 0x18, 0x1f, 0x21,
-				0x26, 0x28, 0x2e, 0x2f, 0x32, 0x33, 0x35, 0x35,
-				0x36, 0x36, 0x3d, 0x3e, 0x44, 0x4b, 0x53, 0x55,
-				0x57, 0x5e, 0x5f, 0x61, 0x6b, 0x6d, 0x6e, 0x70,
-				0x75, 0x75, 0x7a, 0x7b, 0x7d, 0x7f, 0x82, 0x84,
-				0x85, 0x85, 0x88, 0x8d, 0x8e, 0x97, 0x98, 0x9b,
-				0x9b, 0x9e, 0xa0, 0xa0, 0xa2, 0xa3, 0xa5, 0xa7,
-				0xa8, 0xac, 0xb3, 0xb7, 0xbc, 0xbd, 0xbd, 0xbf,
-				0xc3, 0xc3, 0xce, 0xd0, 0xd2, 0xd5, 0xd6, 0xd7,
-				0xd7, 0xda, 0xdc, 0xde, 0xe4, 0xe6, 0xe8, 0xea,
-				0xeb, 0xec, 0xed, 0xf1, 0xf6, 0xf8,
-			},
-			{
-				0x04, 0x05, 0x07, 0x0b, 0x10, 0x12, 0x13, 0x15,
-				0x15, 0x19, 0x21, 0x24, 0x28, 0x2b, 0x30, 0x30,
-				0x33, 0x3a, 0x3d, 0x42, 0x42, 0x45, 0x45, 0x45,
-				0x48, 0x49, 0x4b, 0x4f, 0x52, 0x56, 0x57, 0x59,
-				0x5a, 0x5b, 0x60, 0x6c, 0x74, 0x76, 0x76, 0x77,
-				0x77, 0x7d, 0x7e, 0x81, 0x90, 0x99, 0x9d, 0xa6,
-				0xa7, 0xaa, 0xae, 0xaf, 0xb0, 0xb2, 0xb4, 0xb5,
-				0xbb, 0xbc, 0xc1, 0xc2, 0xc9, 0xc9, 0xcc, 0xcd,
-				0xd1, 0xd4, 0xd4, 0xd5, 0xd6, 0xd7, 0xd8, 0xda,
-				0xdc, 0xdd, 0xe0, 0xe2, 0xe7, 0xe8, 0xeb, 0xed,
-				0xf2, 0xf3, 0xf8, 0xf9, 0xfe, 0xff,
-			},
-		},
-
-		// Group 91
-		{
-			{
-				0x03, 0x04, 0x08, 0x0b, 0x0c, 0x0e, 0x17, 0x18,
-				0x1c, 0x1f, 0x21, 0x24, 0x26, 0x26, 0x29, 0x2c,
-				0x2d, 0x32, 0x33, 0x37, 0x3a, 0x3b, 0x3c, 0x42,
-				0x48, 0x4f, 0x52, 0x57, 0x58, 0x5b, 0x5b, 0x5e,
-				0x65, 0x66, 0x6c, 0x6f, 0x70, 0x72, 0x73, 0x77,
-				0x79, 0x7a, 0x7b, 0x7e, 0x82, 0x83, 0x84, 0x88,
-				0x88, 0x8d, 0x8f, 0x93, 0x95, 0x99, 0x9c, 0x9d,
-				0x9e, 0xa5, 0xa5, 0xa7, 0xac, 0xac, 0xad, 0xae,
-				0xb0, 0xb0, 0xb5, 0xb9, 0xba, 0xbf, 0xc7, 0xc9,
-				0xd1, 0xd2, 0xd4, 0xd8, 0xdc, 0xde, 0xe0, 0xe3,
-				0xe8, 0xec, 0xed, 0xf6, 0xf7, 0xff,
-			},
-			{
-				0x04, 0x07, 0x08, 0x09, 0x0b, 0x11, 0x14, 0x1b,
-				0x1b, 0x1c, 0x27, 0x2b, 0x2b, 0x38, 0x3a, 0x3b,
-				0x3e, 0x40, 0x41, 0x43, 0x45, 0x46, 0x49, 0x4b,
-				0x4c, 0x4e, 0x51, 0x59, 0x5a, 0x5c, 0x67, 0x67,
-				0x69, 0x6b, 0x70, 0x72, 0x73, 0x79, 0x7a, 0x7b,
-				0x7d, 0x7d, 0x7e, 0x82, 0x86, 0x88, 0x89, 0x91,
-				0x93, 0x9c, 0x9e, 0xa0, 0xa2, 0xa3, 0xaa, 0xad,
-				0xaf, 0xaf, 0xb4, 0xb6, 0xba, 0xbc, 0xc0, 0xc3,
-				0xc7, 0xc8, 0xc9, 0xca, 0xce, 0xd0, 0xd2, 0xd4,
-				0xda, 0xdb, 0xdb, 0xdf, 0xe8, 0xef, 0xef, 0xf0,
-				0xf3, 0xf5, 0xf5, 0xf7, 0xfb, 0xfd,
-			},
-			{
-				0x00, 0x02, 0x10, 0x16, 0x17, 0x19, 0x19, 0x21,
-				0x23, 0x24, 0x2e, 0x30, 0x31, 0x35, 0x3a, 0x3c,
-				0x3d, 0x40, 0x40, 0x42, 0x43, 0x47, 0x4a, 0x4f,
-				0x50, 0x51, 0x52, 0x57, 0x58, 0x58, 0x5e, 0x60,
-				0x63, 0x64, 0x69, 0x6d, 0x6f, 0x75, 0x76, 0x77,
-				0x7a, 0x7c, 0x7f, 0x80, 0x8a, 0x90, 0x92, 0x98,
-				0x99, 0x9a, 0x9c, 0x9f, 0x9f, 0xa7, 0xa8, 0xb3,
-				0xb5, 0xb7, 0xb8, 0xb9, 0xbd, 0xbf, 0xc0, 0xc2,
-				0xc5, 0xc6, 0xcd, 0xd0, 0xd2, 0xd3, 0xd8, 0xda,
-				0xdc, 0xe2, 0xe6, 0xed, 0xee, 0xf1, 0xf2, 0xf5,
-				0xf7, 0xf8, 0xfc, 0xfd, 0xfe, 0xff,
-			},
-			{
-				0x01, 0x02, 0x03, 0x06, 0x07, 0x08, 0x0c, 0x0e,
-				0x12, 0x13, 0x15, 0x1a, 0x1e, 0x22, 0x25, 0x28,
-				0x2a, 0x32, 0x33, 0x34, 0x37, 0x3c, 0x3d, 0x3f,
-				0x43, 0x44, 0x44, 0x45, 0x47, 0x4b, 0x4c, 0x4d,
-				0x4e, 0x4f, 0x51, 0x53, 0x62, 0x63, 0x65, 0x66,
-				0x69, 0x6d, 0x71, 0x74, 0x78, 0x83, 0x84, 0x84,
-				0x86, 0x87, 0x8b, 0x92, 0x93, 0x96, 0x9a, 0x9d,
-				0x9d, 0xa9, 0xab, 0xae, 0xaf, 0xb7, 0xbd, 0xbd,
-				0xc2, 0xc3, 0xc4, 0xc6, 0xcb, 0xcc, 0xcd, 0xce,
-				0xcf, 0xd7, 0xe0, 0xe1, 0xe6, 0xe9, 0xf0, 0xf1,
-				0xf2, 0xf4, 0xf4, 0xf9, 0xfa, 0xfc,
-			},
-			{
-				0x00, 0x02, 0x06, 0x09, 0x0c, 0x0d, 0x0f, 0x0f,
-				0x12, 0x1a, 0x23, 0x25, 0x26, 0x27, 0x27, 0x2a,
-				0x2c, 0x2e, 0x31, 0x34, 0x36, 0x38, 0x3e, 0x48,
-				0x4a, 0x4d, 0x55, 0x56, 0x59, 0x59, 0x5c, 0x5d,
-				0x5d, 0x5f, 0x6a, 0x6a, 0x6e, 0x76, 0x78, 0x79,
-				0x7e, 0x7f, 0x80, 0x85, 0x8a, 0x8e, 0x8f, 0x91,
-				0x92, 0x94, 0x95, 0x96, 0x9f, 0xa1, 0xa2, 0xa4,
-				0xa6, 0xaa, 0xab, 0xb2, 0xb3, 0xb4, 0xb4, 0xb5,
-				0xbb, 0xc5, 0xcd, 0xcf, 0xd5, 0xd7, 0xd9, 0xd9,
-				0xe4, 0xe5, 0xe5, 0xe9, 0xea, 0xea, 0xeb, 0xec,
-				0xee, 0xee, 0xf2, 0xfa, 0xfa, 0xfe,
-			},
-			{
-				0x05, 0x0a, 0x0b, 0x0d, 0x10, 0x13, 0x14, 0x14,
-				0x16, 0x16, 0x1d, 0x1e, 0x1f, 0x20, 0x23, 0x24,
-				0x28, 0x29, 0x29, 0x2a, 0x2d, 0x2f, 0x2f, 0x31,
-				0x34, 0x39, 0x3e, 0x49, 0x4a, 0x50, 0x54, 0x56,
-				0x5f, 0x60, 0x61, 0x61, 0x62, 0x64, 0x68, 0x6b,
-				0x6b, 0x6c, 0x6e, 0x71, 0x73, 0x74, 0x74, 0x75,
-				0x7c, 0x81, 0x81, 0x85, 0x89, 0x89, 0x8b, 0x8d,
-				0x8e, 0x90, 0x97, 0x9b, 0x9b, 0xa1, 0xa6, 0xb1,
-				0xb1, 0xb6, 0xb8, 0xbc, 0xbe, 0xc0, 0xc1, 0xc1,
-				0xc8, 0xc8, 0xd1, 0xd3, 0xd5, 0xd6, 0xe2, 0xe4,
-				0xe7, 0xeb, 0xf3, 0xf9, 0xfb, 0xfe,
-			},
-			{
-				0x01, 0x01, 0x05, 0x0a, 0x11, 0x15, 0x18, 0x19,
-				0x1d, 0x20, 0x22, 0x25, 0x28, 0x2f, 0x30, 0x33,
-				0x35, 0x36, 0x38, 0x39, 0x3f, 0x3f, 0x41, 0x46,
-				0x4c, 0x4d, 0x52, 0x53, 0x54, 0x55, 0x5a, 0x5d,
-				0x63, 0x68, 0x6c, 0x71, 0x7b, 0x7c, 0x81, 0x87,
-				0x87, 0x8b, 0x8c, 0x8c, 0x8d, 0x91, 0x94, 0x97,
-				0x98, 0x9e, 0xa0, 0xa3, 0xa4, 0xa5, 0xa6, 0xa8,
-				0xa8, 0xa9, 0xaa, 0xab, 0xb2, 0xbb, 0xbe, 0xc4,
-				0xca, 0xcb, 0xcc, 0xce, 0xd3, 0xd4, 0xd6, 0xdb,
-				0xdc, 0xdd, 0xdd, 0xde, 0xde, 0xdf, 0xe1, 0xe3,
-				0xe7, 0xe8, 0xeb, 0xed, 0xf6, 0xf8,
-			},
-		},
-	}
-)
@@ -0,0 +1,984 @@
+// Code generated by z3bgen from alphabets/cookie.json. DO NOT EDIT.
+
+package z3b
+
+// CookieEncodingVersion is the version byte of CookieEncoding
+const CookieEncodingVersion = 2
+
+// CookieEncodingPrefix starts text prefixed with CookieEncoding
+const CookieEncodingPrefix = '!'
+
+// CookieEncoding is a variant of StdEncoding for cookie values and URL query
+// parameters. It does not use the ';', ',' or space characters, so the set
+// control characters are "_-$`!.". Prefixed text starts with '!', so it is told
+// apart from StdEncoding.
+var CookieEncoding = newEncoding(CookieEncodingVersion, CookieEncodingPrefix, "#%&()*+/0123456789:<=>?@ABCDEFGHIJKLMNOPQRSTUVWXYZ[]^abcdefghijklmnopqrstuvwxyz{|}", "_-$`!.", [][numSets]string{
+
+	// Group 0 '#'
+	{
+		"\x01\x05\x10\x11\x12\x17\x1a\x1f\x21\x24\x25\x2a\x2b\x32\x3e\x40\x44\x46\x48\x49\x4a\x4b\x4c\x4d\x4e\x4f\x52\x54\x57\x59\x5d\x5e\x64\x67\x68\x6a\x6b\x6c\x71\x72\x76\x79\x7c\x81\x82\x86\x8d\x8f\x91\x97\x99\xa3\xa4\xa7\xaa\xab\xb2\xb3\xb4\xb5\xb6\xb8\xbb\xbc\xbe\xc0\xc1\xc2\xc4\xc7\xd8\xdb\xdd\xe1\xe8\xe9\xf0\xf5\xf7\xfa\xfb\xfe",
+		"\x00\x03\x04\x09\x0b\x0c\x0f\x13\x16\x18\x1b\x20\x23\x26\x2c\x2f\x34\x35\x37\x3a\x42\x50\x51\x53\x5a\x5b\x5c\x5f\x61\x66\x6e\x70\x73\x74\x7e\x80\x84\x87\x88\x89\x8c\x90\x92\x93\x95\x96\x9a\x9c\x9e\xa0\xa2\xa6\xa8\xac\xad\xae\xaf\xb0\xb1\xb7\xb9\xbd\xbf\xca\xcc\xce\xd1\xd2\xd5\xd9\xda\xe2\xe4\xe5\xe7\xeb\xee\xf3\xf6\xf9\xfc\xfd",
+		"\x02\x06\x07\x08\x0a\x0e\x14\x19\x1c\x1d\x1e\x22\x27\x28\x29\x2d\x2e\x31\x33\x36\x38\x39\x3b\x3c\x3d\x3f\x41\x43\x45\x47\x55\x60\x62\x63\x65\x69\x6d\x6f\x75\x77\x78\x7a\x7b\x7d\x7f\x83\x8a\x8b\x8e\x94\x98\x9d\x9f\xa1\xa5\xa9\xba\xc5\xc6\xc8\xc9\xcb\xcd\xcf\xd0\xd3\xd4\xd6\xd7\xdc\xde\xdf\xe0\xea\xec\xed\xef\xf1\xf2\xf4\xf8\xff",
+		"\x02\x03\x07\x0a\x0d\x0e\x11\x15\x17\x18\x1c\x21\x2b\x2e\x2f\x30\x31\x32\x35\x3a\x42\x4a\x4b\x4f\x56\x58\x5a\x5c\x63\x6e\x6f\x72\x75\x79\x7e\x82\x83\x84\x85\x86\x89\x8d\x8f\x90\x95\x96\x97\x9a\x9b\x9e\xa0\xa3\xa7\xab\xad\xae\xaf\xb5\xb6\xb8\xba\xbb\xc3\xc8\xcb\xcc\xd1\xd2\xd3\xd4\xdf\xe2\xe3\xe5\xe6\xe7\xeb\xed\xf1\xf2\xf6\xfa",
+		"\x00\x04\x05\x08\x09\x0b\x0c\x0d\x10\x12\x14\x15\x16\x1a\x1b\x1d\x20\x25\x26\x2c\x2d\x30\x34\x36\x38\x3d\x3e\x40\x41\x43\x52\x54\x57\x58\x5d\x5e\x5f\x60\x64\x65\x66\x68\x6b\x78\x7a\x7d\x80\x85\x88\x8b\x8e\x91\x93\x94\x9d\xa1\xa4\xa6\xa9\xac\xb3\xb9\xbe\xbf\xc1\xc2\xc4\xc6\xc9\xd0\xd5\xd7\xda\xe3\xec\xf0\xf3\xf5\xfb\xfc\xfe\xff",
+		"\x01\x06\x0f\x13\x19\x1e\x1f\x22\x27\x28\x29\x37\x39\x3b\x3c\x3f\x47\x48\x49\x4d\x4e\x50\x53\x59\x5b\x62\x67\x6c\x70\x71\x73\x74\x77\x7b\x7c\x7f\x81\x87\x8a\x8c\x92\x98\x99\x9b\x9f\xa2\xa5\xa8\xb0\xb1\xb2\xb4\xb7\xbc\xbd\xc0\xc3\xc5\xc7\xca\xcd\xce\xcf\xd6\xd8\xd9\xdb\xdc\xdd\xde\xe0\xe1\xe4\xe6\xe9\xee\xef\xf4\xf7\xf8\xf9\xfd",
+		"\x04\x06\x0a\x0b\x0d\x0e\x0f\x18\x19\x1b\x23\x24\x25\x26\x2a\x2c\x33\x36\x3e\x40\x43\x44\x45\x46\x4c\x51\x55\x56\x5a\x5d\x60\x61\x62\x65\x68\x69\x6a\x6b\x6c\x6d\x70\x76\x78\x7d\x85\x89\x8e\x91\x92\x94\x9b\x9c\xa0\xa5\xaa\xac\xae\xb1\xb5\xb8\xbe\xbf\xc2\xc3\xc4\xc9\xcc\xcd\xd0\xd3\xde\xdf\xe5\xe8\xe9\xea\xec\xf0\xf1\xf7\xf8\xfa",
+	},
+
+	// Group 1 '%'
+	{
+		"\x01\x03\x05\x06\x0f\x14\x1b\x1d\x20\x22\x2a\x2d\x2e\x2f\x31\x32\x3c\x3d\x3e\x3f\x42\x45\x48\x4c\x4e\x51\x5a\x5d\x61\x63\x64\x67\x6a\x6c\x6f\x71\x74\x75\x78\x7a\x7c\x7f\x80\x8d\x90\x92\x99\x9b\x9f\xa3\xa7\xb3\xb4\xb7\xb8\xb9\xbd\xc1\xc2\xc3\xc9\xca\xcc\xcd\xcf\xd1\xd2\xdc\xdf\xe2\xe3\xe4\xe7\xe8\xec\xed\xf2\xf3\xf5\xf6\xf9\xfb",
+		"\x02\x08\x09\x0a\x0c\x10\x12\x15\x18\x19\x1a\x21\x28\x2b\x30\x34\x35\x49\x4a\x4b\x4d\x4f\x52\x53\x55\x57\x58\x59\x5c\x62\x68\x6d\x72\x76\x77\x79\x7b\x7d\x81\x85\x89\x8c\x8f\x93\x94\x97\x98\x9a\x9c\xa2\xa5\xa6\xa8\xa9\xad\xb2\xb6\xbb\xbc\xbe\xbf\xc0\xc4\xc6\xc8\xd4\xd5\xd6\xd7\xd8\xd9\xda\xe0\xe5\xe6\xe9\xea\xee\xf1\xf4\xfc\xfe",
+		"\x00\x04\x0d\x0e\x11\x16\x17\x1c\x1e\x23\x24\x25\x26\x27\x29\x2c\x33\x36\x37\x38\x39\x3a\x3b\x40\x41\x43\x44\x46\x47\x50\x54\x56\x5b\x5e\x60\x65\x66\x69\x6b\x6e\x73\x7e\x82\x83\x84\x86\x87\x88\x8a\x8e\x91\x95\x96\x9d\x9e\xa0\xa4\xaa\xab\xac\xae\xaf\xb0\xb1\xb5\xba\xc5\xc7\xcb\xce\xd0\xd3\xdd\xde\xe1\xeb\xf0\xf7\xf8\xfa\xfd\xff",
+		"\x05\x07\x08\x0b\x0c\x10\x12\x13\x1f\x21\x23\x25\x27\x2d\x33\x3a\x3e\x44\x45\x48\x49\x4b\x52\x53\x56\x59\x5b\x5c\x5d\x5f\x65\x6b\x6d\x6f\x70\x71\x76\x79\x7a\x7d\x81\x82\x87\x88\x89\x8b\x94\x97\x99\x9e\x9f\xa1\xa4\xa6\xa8\xab\xaf\xb5\xb6\xbc\xc0\xc3\xc4\xc5\xc8\xd3\xd7\xda\xdb\xdc\xdf\xe1\xe3\xe5\xe6\xe8\xe9\xeb\xef\xf0\xf1\xfd",
+		"\x03\x07\x0a\x0b\x11\x13\x18\x1f\x20\x22\x24\x29\x2a\x2b\x31\x34\x3b\x3f\x41\x42\x46\x4d\x4e\x4f\x51\x54\x55\x58\x5a\x5f\x60\x64\x68\x69\x6a\x72\x7b\x83\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x96\x98\xa1\xa3\xa5\xa7\xaa\xac\xad\xae\xb1\xb2\xb3\xb4\xb7\xb8\xb9\xbe\xc1\xc6\xc9\xca\xcb\xcc\xcf\xd0\xd5\xd6\xd8\xd9\xde\xe0\xe4\xf7\xfb",
+		"\x00\x01\x02\x04\x06\x09\x0d\x0e\x0f\x14\x15\x16\x17\x19\x1a\x1b\x1c\x1d\x1e\x26\x28\x2c\x2e\x2f\x30\x35\x36\x37\x38\x39\x3d\x40\x43\x47\x50\x5e\x61\x62\x67\x6c\x6e\x73\x74\x78\x7c\x7f\x84\x85\x93\x95\x9a\x9b\x9c\x9d\xa0\xa2\xb0\xba\xbb\xbd\xbf\xc2\xc7\xcd\xce\xd1\xd2\xe2\xea\xec\xed\xee\xef\xf2\xf4\xf5\xf6\xf8\xf9\xfa\xfc\xff",
+		"\x07\x0d\x14\x1c\x1e\x21\x26\x2e\x30\x32\x38\x39\x3c\x3d\x40\x4a\x4c\x51\x52\x53\x54\x56\x57\x58\x5a\x5c\x5e\x61\x63\x66\x69\x6a\x6c\x6e\x6f\x70\x75\x76\x77\x78\x7c\x7e\x80\x86\x87\x8c\x8d\x8e\x90\x91\x92\x94\x9a\x9b\xa3\xa8\xa9\xad\xb0\xb1\xb2\xb8\xc1\xc2\xc7\xcc\xcd\xcf\xd1\xd4\xd7\xdb\xdd\xdf\xe3\xe7\xf0\xf1\xf3\xf4\xf7\xfe",
+	},
+
+	// Group 2 '&'
+	{
+		"\x02\x07\x0f\x11\x14\x19\x1c\x1e\x1f\x24\x25\x26\x27\x29\x2b\x2f\x31\x33\x34\x35\x3d\x47\x49\x51\x53\x54\x56\x5a\x5c\x5e\x61\x63\x64\x74\x75\x77\x79\x7f\x80\x81\x82\x8a\x8e\x92\x93\x98\x9b\xa0\xa5\xa7\xa8\xa9\xaa\xae\xaf\xb1\xb3\xb5\xbb\xbc\xbd\xbe\xbf\xc0\xc1\xc4\xc5\xc6\xca\xd0\xd8\xd9\xda\xe1\xe3\xe5\xe9\xea\xf5\xf6\xfc\xfe",
+		"\x01\x05\x06\x0a\x0b\x0d\x0e\x13\x18\x1b\x23\x28\x2c\x36\x38\x39\x3b\x3e\x3f\x40\x41\x43\x45\x46\x4a\x4b\x4c\x4e\x4f\x58\x59\x5b\x5f\x60\x66\x6a\x6b\x6d\x6f\x70\x71\x72\x76\x78\x83\x84\x85\x8c\x8d\x94\x95\x99\x9c\xa4\xa6\xab\xac\xb0\xb6\xb8\xc2\xc8\xcc\xcd\xdb\xdc\xdd\xdf\xe2\xe4\xe6\xe8\xed\xee\xf0\xf1\xf3\xf4\xf8\xf9\xfd\xff",
+		"\x00\x03\x04\x08\x09\x0c\x12\x15\x16\x17\x1a\x1d\x20\x21\x22\x2a\x2d\x2e\x30\x37\x3a\x3c\x44\x48\x50\x52\x55\x57\x5d\x62\x67\x68\x6c\x6e\x73\x7a\x7b\x7c\x7d\x7e\x86\x87\x88\x89\x8b\x8f\x90\x96\x97\x9a\x9d\x9f\xa1\xa2\xa3\xad\xb2\xb4\xb9\xba\xc3\xc7\xc9\xcb\xce\xcf\xd1\xd2\xd3\xd4\xd6\xd7\xde\xe0\xe7\xeb\xec\xef\xf2\xf7\xfa\xfb",
+		"\x03\x04\x07\x0b\x0c\x0d\x10\x16\x1b\x1e\x26\x27\x2a\x2b\x2f\x32\x33\x34\x38\x42\x46\x49\x4d\x5f\x60\x61\x65\x69\x6e\x6f\x70\x73\x74\x77\x78\x7e\x7f\x82\x84\x86\x87\x8a\x8b\x8e\x91\x92\x97\x98\x9b\x9e\x9f\xa1\xa3\xa4\xaa\xab\xad\xae\xaf\xb0\xb1\xb7\xb8\xbc\xc6\xc9\xcf\xd3\xd5\xd6\xd7\xda\xdb\xe1\xe2\xe4\xe7\xe9\xec\xf1\xf8\xfb",
+		"\x01\x02\x09\x0e\x10\x14\x17\x1a\x1c\x20\x29\x2d\x2e\x32\x36\x37\x39\x3e\x41\x42\x44\x45\x47\x48\x4b\x4c\x4d\x4e\x50\x53\x54\x56\x57\x5c\x5d\x5e\x63\x64\x65\x66\x6b\x6c\x72\x76\x7b\x81\x89\x8c\x93\x94\x95\xa0\xa2\xa8\xa9\xac\xb2\xb7\xba\xbb\xbd\xbf\xc0\xc1\xc2\xc3\xc5\xc8\xca\xcb\xcd\xce\xd4\xdd\xde\xea\xef\xf0\xf5\xf6\xf7\xfd",
+		"\x00\x06\x08\x0a\x0f\x11\x12\x13\x18\x19\x1d\x1f\x22\x23\x24\x25\x28\x30\x3a\x3b\x3c\x3d\x3f\x40\x43\x4a\x4f\x51\x52\x55\x59\x5a\x5b\x62\x67\x68\x69\x6a\x6d\x71\x75\x7a\x7c\x7d\x80\x83\x85\x88\x8f\x91\x96\x99\x9a\x9d\x9e\xa5\xa6\xa7\xb4\xb6\xb9\xc4\xcc\xd0\xd1\xd2\xd5\xd9\xdc\xdf\xe0\xe3\xe5\xe6\xeb\xed\xee\xf3\xf4\xfc\xfe\xff",
+		"\x05\x07\x09\x0b\x14\x15\x17\x18\x1a\x1c\x1d\x20\x21\x23\x25\x2c\x2d\x2f\x31\x35\x38\x39\x43\x4d\x50\x52\x58\x5c\x5d\x61\x6b\x6c\x6e\x72\x75\x78\x79\x7e\x83\x84\x87\x88\x8a\x8d\x90\x95\x96\x99\x9c\xa0\xa1\xa3\xa4\xaa\xaf\xb1\xb3\xb5\xba\xbe\xc2\xc7\xcd\xd2\xd4\xd7\xd8\xde\xe5\xe8\xeb\xec\xee\xef\xf1\xf2\xf6\xf9\xfa\xfb\xfd\xff",
+	},
+
+	// Group 3 '('
+	{
+		"\x00\x06\x0c\x0e\x17\x18\x1b\x20\x23\x24\x26\x27\x28\x2a\x2b\x2c\x31\x33\x35\x39\x3c\x3e\x40\x43\x45\x46\x49\x4f\x56\x5e\x62\x69\x70\x71\x75\x77\x7b\x7c\x7d\x80\x81\x8c\x8d\x8e\x90\x95\x96\x9d\x9f\xa1\xa6\xab\xb2\xb3\xb5\xb6\xb7\xc0\xc4\xc5\xc9\xcb\xcc\xcd\xce\xd1\xd2\xd5\xd6\xd9\xda\xdb\xdc\xde\xe5\xe6\xe9\xec\xf7\xf8\xfc\xff",
+		"\x03\x04\x05\x0a\x0b\x0d\x11\x15\x1c\x1d\x1e\x21\x25\x30\x32\x36\x37\x38\x3a\x4b\x4c\x4d\x51\x52\x55\x5a\x5d\x60\x63\x65\x66\x6a\x6e\x6f\x73\x74\x76\x84\x85\x86\x88\x8a\x91\x92\x93\x99\x9b\x9c\x9e\xa0\xa2\xa3\xa5\xa9\xaa\xad\xaf\xb1\xb4\xb8\xba\xbd\xbe\xbf\xc1\xd3\xd4\xd8\xdd\xdf\xe0\xe1\xe2\xe7\xe8\xea\xed\xef\xf2\xf4\xfa\xfe",
+		"\x02\x07\x08\x09\x0f\x10\x12\x13\x14\x16\x19\x1a\x1f\x22\x29\x2d\x2e\x2f\x34\x3b\x3d\x41\x47\x48\x4e\x50\x53\x54\x57\x58\x59\x5b\x5c\x5f\x61\x64\x67\x68\x6c\x6d\x72\x78\x79\x7a\x7e\x83\x87\x89\x8b\x8f\x94\x97\x98\x9a\xa4\xa7\xa8\xac\xae\xb0\xb9\xbb\xbc\xc3\xc6\xc7\xc8\xca\xcf\xd0\xd7\xe3\xe4\xeb\xee\xf0\xf1\xf3\xf5\xf9\xfb\xfd",
+		"\x01\x08\x0a\x10\x14\x1e\x21\x22\x25\x26\x27\x2e\x30\x31\x32\x3f\x42\x44\x47\x48\x4a\x4d\x4f\x50\x51\x52\x56\x58\x5c\x5d\x60\x62\x66\x68\x6a\x6b\x6d\x70\x74\x75\x76\x7b\x7f\x80\x82\x88\x8b\x91\x94\x95\x96\x99\xa0\xa1\xa2\xa4\xa6\xad\xb0\xb5\xbf\xc1\xc2\xc7\xc8\xc9\xcb\xcd\xd5\xd6\xd8\xdf\xe2\xe5\xea\xeb\xec\xed\xf2\xf6\xfa\xfb",
+		"\x02\x05\x07\x0b\x11\x12\x13\x15\x16\x17\x19\x1a\x1c\x20\x28\x29\x2d\x33\x35\x38\x39\x3a\x3b\x3c\x3e\x3f\x41\x42\x44\x45\x53\x57\x59\x5b\x5f\x61\x63\x64\x67\x6b\x6f\x71\x79\x7c\x7e\x82\x84\x85\x87\x89\x8e\x98\x9d\x9e\xa8\xa9\xaa\xac\xae\xb1\xb9\xbb\xbc\xc2\xc5\xca\xcf\xd0\xd1\xdb\xdd\xde\xe1\xe4\xe8\xee\xf1\xf5\xf6\xfc\xfe\xff",
+		"\x00\x01\x03\x04\x09\x0c\x0d\x0e\x0f\x18\x1b\x1d\x1f\x23\x24\x2a\x2c\x2f\x34\x3d\x40\x43\x46\x4a\x4b\x4c\x4e\x54\x55\x5a\x5e\x65\x69\x6c\x6e\x72\x73\x78\x7d\x7f\x81\x83\x86\x8c\x8d\x8f\x90\x92\x93\x97\x9b\x9c\xa3\xa5\xa7\xab\xb4\xb7\xb8\xbd\xbe\xc0\xc3\xc4\xc6\xcc\xce\xd2\xd3\xda\xdc\xe0\xe6\xe7\xe9\xef\xf0\xf4\xf7\xf8\xf9\xfd",
+		"\x01\x04\x06\x07\x09\x0a\x0b\x0f\x19\x1f\x21\x23\x25\x26\x2b\x32\x36\x37\x39\x3c\x3e\x3f\x44\x49\x54\x5a\x5d\x5f\x61\x65\x68\x69\x6a\x6b\x76\x77\x78\x7a\x7b\x7e\x8a\x8d\x91\x96\x97\x98\x9a\x9c\x9f\xa7\xaa\xae\xaf\xb0\xb2\xb3\xb4\xb6\xb7\xba\xc6\xcd\xce\xd0\xd4\xd7\xd9\xdb\xe0\xe2\xe3\xe5\xea\xec\xee\xf0\xf1\xf3\xf4\xf5\xf6\xfe",
+	},
+
+	// Group 4 ')'
+	{
+		"\x09\x0c\x0d\x0e\x13\x14\x17\x1a\x1e\x24\x27\x29\x2d\x31\x32\x37\x3b\x40\x42\x47\x49\x53\x55\x56\x57\x59\x5e\x5f\x62\x65\x67\x6c\x6d\x72\x76\x80\x89\x8a\x8c\x8d\x8f\x90\x93\x96\x97\x98\x99\x9b\x9f\xa3\xa8\xab\xac\xad\xae\xb2\xb5\xb7\xb8\xb9\xba\xbf\xc0\xc3\xca\xcd\xd1\xd5\xd6\xda\xdc\xdd\xde\xdf\xe0\xe6\xe8\xeb\xef\xf3\xf8\xfa",
+		"\x00\x01\x02\x03\x05\x06\x10\x11\x12\x16\x19\x1c\x1d\x1f\x20\x21\x22\x2a\x2b\x35\x36\x38\x39\x3a\x3f\x41\x44\x45\x4a\x4c\x4d\x4e\x52\x54\x5d\x61\x63\x64\x66\x6a\x6e\x6f\x75\x77\x78\x7c\x7d\x82\x83\x84\x86\x8b\x8e\x91\x92\x94\x95\x9c\xa0\xa9\xaa\xb1\xb3\xbb\xbe\xc2\xc5\xce\xd9\xe1\xe2\xe3\xe4\xea\xed\xee\xf0\xf2\xf4\xfb\xfc\xff",
+		"\x04\x07\x08\x0a\x0f\x15\x18\x23\x25\x26\x28\x2c\x2e\x2f\x30\x33\x3c\x3d\x3e\x43\x46\x48\x4b\x4f\x50\x51\x58\x5a\x5b\x5c\x60\x68\x6b\x70\x71\x73\x74\x7a\x7b\x7f\x81\x85\x87\x88\x9a\x9d\xa1\xa2\xa4\xa5\xa6\xa7\xaf\xb0\xb4\xb6\xbc\xbd\xc1\xc4\xc6\xc7\xc8\xc9\xcb\xcf\xd0\xd2\xd3\xd4\xd7\xd8\xdb\xe5\xe7\xe9\xf1\xf5\xf7\xf9\xfd\xfe",
+		"\x00\x01\x02\x04\x0a\x0b\x0c\x0d\x11\x12\x14\x17\x19\x1a\x1b\x21\x26\x29\x34\x36\x38\x3b\x3f\x44\x48\x4c\x53\x56\x58\x59\x5b\x5c\x5d\x5f\x66\x69\x6a\x6b\x6d\x71\x73\x75\x77\x79\x7b\x7d\x7e\x80\x85\x87\x89\x8e\x93\x95\x9b\x9e\x9f\xa0\xa3\xa9\xb1\xb5\xb7\xbb\xbc\xbe\xc6\xc9\xcc\xce\xd5\xd7\xda\xdc\xdd\xea\xeb\xec\xf0\xf2\xf4\xf6",
+		"\x03\x08\x0b\x0e\x0f\x10\x13\x15\x1b\x1c\x1d\x1f\x25\x28\x2d\x2f\x30\x31\x34\x35\x3a\x41\x42\x43\x45\x4a\x4b\x4e\x50\x51\x55\x5e\x68\x6f\x72\x74\x78\x79\x81\x83\x86\x88\x8a\x8b\x8c\x8d\x8f\x97\x9c\xa1\xa5\xa8\xaa\xac\xb3\xb9\xba\xbd\xbf\xc2\xc5\xca\xcc\xcf\xd1\xd2\xd4\xd8\xdb\xde\xdf\xe0\xe3\xe5\xe6\xec\xed\xf6\xf8\xfa\xfc\xfd",
+		"\x05\x06\x07\x09\x16\x18\x1e\x20\x22\x23\x24\x27\x2b\x2e\x32\x33\x37\x39\x3c\x3d\x40\x47\x4d\x4f\x52\x54\x5a\x61\x62\x63\x64\x65\x67\x6c\x70\x76\x7a\x7c\x7e\x7f\x82\x84\x90\x91\x92\x94\x96\x99\x9d\xa2\xa6\xa7\xab\xad\xae\xaf\xb0\xb4\xc0\xc1\xc3\xc4\xc8\xcb\xd0\xd3\xd6\xd9\xe1\xe2\xe4\xe7\xe8\xe9\xee\xef\xf3\xf7\xf9\xfb\xfe\xff",
+		"\x00\x01\x05\x08\x0f\x12\x18\x1b\x1d\x2a\x2c\x3b\x3c\x3d\x3e\x46\x48\x49\x4a\x53\x57\x5a\x5d\x60\x63\x64\x65\x69\x6b\x6e\x72\x74\x75\x76\x7d\x7f\x80\x83\x8b\x90\x91\x95\x98\x9a\x9b\x9c\x9e\xa0\xa2\xa4\xa9\xaa\xac\xad\xae\xb0\xb2\xb6\xb8\xb9\xbf\xc3\xc4\xc6\xc7\xc8\xcb\xcd\xd4\xd8\xda\xdd\xde\xe5\xe8\xe9\xed\xf1\xf4\xf5\xfd\xff",
+	},
+
+	// Group 5 '*'
+	{
+		"\x01\x07\x10\x11\x12\x13\x15\x17\x1c\x1d\x1f\x21\x26\x27\x28\x2c\x2e\x33\x36\x3a\x3c\x3d\x42\x43\x44\x48\x4a\x51\x53\x55\x58\x62\x65\x66\x69\x71\x76\x7a\x7f\x80\x81\x86\x8a\x8b\x8c\x8d\x8e\x8f\x93\x95\x96\x98\x9a\x9d\xa5\xa9\xb1\xb2\xb3\xb7\xbb\xbf\xc1\xc2\xc7\xca\xce\xcf\xd8\xd9\xdb\xde\xe0\xe7\xe8\xea\xec\xf0\xf5\xf8\xfa\xff",
+		"\x00\x08\x09\x0d\x0e\x14\x16\x1a\x1b\x20\x2d\x30\x31\x35\x38\x39\x3b\x3e\x3f\x40\x45\x46\x49\x4d\x4e\x52\x54\x5c\x5d\x5f\x60\x63\x64\x67\x68\x6d\x6e\x6f\x78\x82\x83\x84\x87\x88\x89\x91\x92\x97\x99\x9c\x9e\xa2\xa6\xa8\xaa\xab\xb8\xba\xbc\xc3\xc4\xc6\xcb\xcc\xcd\xd0\xd3\xd4\xd5\xdf\xe1\xe2\xeb\xee\xef\xf1\xf4\xf6\xf7\xf9\xfb\xfe",
+		"\x02\x03\x04\x05\x06\x0a\x0b\x0f\x18\x19\x1e\x22\x23\x24\x25\x29\x2a\x2b\x2f\x32\x34\x37\x41\x47\x4b\x4c\x4f\x57\x59\x5a\x5b\x5e\x61\x6a\x6b\x6c\x70\x73\x74\x75\x77\x79\x7c\x7d\x7e\x85\x90\x94\x9b\xa0\xa1\xa3\xa4\xa7\xac\xad\xae\xaf\xb0\xb4\xb5\xb6\xb9\xbe\xc0\xc5\xc8\xc9\xd1\xd6\xd7\xda\xdd\xe3\xe4\xe5\xe6\xe9\xed\xf2\xf3\xfc",
+		"\x03\x04\x05\x07\x08\x0c\x0e\x23\x24\x27\x2b\x33\x3b\x3c\x43\x46\x4e\x50\x52\x56\x57\x5b\x60\x63\x64\x6b\x6d\x6e\x71\x72\x73\x74\x76\x7b\x7e\x82\x84\x87\x89\x8d\x8f\x90\x91\x92\x95\x96\x99\x9b\x9e\x9f\xa3\xb1\xb2\xb4\xb5\xb7\xb8\xb9\xbd\xbe\xc3\xc4\xcb\xd0\xd2\xd3\xd4\xdb\xdc\xdd\xde\xe0\xe4\xe6\xee\xf1\xf2\xf4\xf9\xfc\xfd\xfe",
+		"\x06\x09\x0b\x0c\x0d\x11\x12\x16\x17\x18\x1c\x20\x21\x25\x28\x2a\x2f\x39\x3f\x40\x42\x45\x49\x4a\x4b\x4c\x55\x58\x5c\x5e\x5f\x62\x65\x67\x69\x70\x72\x75\x77\x78\x79\x7b\x7c\x83\x88\x8a\x8e\x93\x9c\x9f\xa0\xa4\xa9\xae\xaf\xb0\xb3\xbd\xbf\xc1\xc2\xc5\xca\xcd\xce\xd2\xd9\xda\xdc\xe3\xe5\xe7\xe8\xea\xeb\xec\xed\xef\xf3\xf8\xfb\xfd",
+		"\x00\x01\x02\x0a\x0f\x10\x13\x19\x1a\x1e\x1f\x22\x26\x29\x2c\x30\x32\x35\x36\x37\x38\x3a\x3d\x3e\x41\x44\x47\x48\x4d\x4f\x51\x53\x54\x56\x59\x5a\x5d\x66\x6a\x6c\x6f\x7a\x7d\x7f\x80\x81\x85\x86\x8c\x94\x97\x9a\x9d\xa2\xa6\xa8\xaa\xab\xac\xad\xb6\xba\xbb\xbc\xc0\xc6\xc8\xc9\xcf\xd1\xd5\xd6\xd8\xdf\xe2\xe9\xf0\xf5\xf6\xf7\xfa\xff",
+		"\x04\x0d\x0e\x10\x12\x13\x14\x15\x16\x1b\x1c\x1d\x22\x2a\x2d\x2e\x31\x34\x38\x39\x3b\x43\x44\x50\x53\x54\x56\x5a\x5c\x61\x64\x68\x6a\x6e\x6f\x71\x72\x73\x74\x79\x7e\x7f\x81\x83\x84\x85\x8b\x8d\x90\x98\x9a\x9b\x9e\xa1\xa5\xa7\xac\xaf\xb1\xb6\xb7\xbb\xbd\xc0\xc3\xc7\xca\xcc\xcd\xcf\xd0\xd4\xd6\xd7\xd8\xdb\xe1\xe6\xef\xf1\xfb\xff",
+	},
+
+	// Group 6 '+'
+	{
+		"\x03\x05\x06\x08\x09\x0e\x11\x13\x15\x17\x20\x22\x23\x26\x28\x2f\x30\x31\x32\x33\x34\x3b\x3c\x3e\x43\x47\x4a\x52\x53\x57\x5a\x5d\x5e\x5f\x62\x64\x66\x68\x6d\x75\x78\x7b\x7d\x81\x86\x87\x8e\x8f\x96\x97\x9a\x9b\x9e\x9f\xa2\xa3\xa5\xa7\xab\xb0\xb2\xb4\xb5\xb8\xb9\xbe\xc2\xc4\xc7\xcc\xcd\xd2\xd3\xd8\xe0\xe6\xe7\xf2\xf8\xfa\xfb\xfe",
+		"\x00\x04\x07\x0d\x10\x12\x16\x1b\x1c\x24\x25\x29\x2a\x2b\x36\x37\x38\x39\x3a\x3d\x40\x41\x44\x45\x4c\x55\x59\x5c\x61\x63\x6c\x6e\x6f\x71\x79\x7a\x80\x82\x89\x8b\x8c\x90\x91\x92\x93\x94\x98\x99\x9c\xa1\xa4\xa6\xa8\xad\xb1\xb6\xba\xbf\xc3\xc6\xc8\xcb\xcf\xd0\xd1\xd4\xda\xdd\xdf\xe1\xe4\xe8\xea\xeb\xed\xef\xf1\xf6\xf7\xfc\xfd\xff",
+		"\x01\x02\x0a\x0b\x0c\x0f\x14\x18\x19\x1a\x1e\x1f\x21\x27\x2c\x2d\x2e\x42\x46\x48\x49\x4d\x4f\x50\x51\x54\x56\x58\x5b\x60\x65\x67\x69\x6a\x6b\x70\x72\x73\x74\x76\x77\x7c\x7e\x7f\x83\x84\x85\x88\x8a\x8d\x95\x9d\xa9\xaa\xac\xae\xaf\xb7\xbb\xbc\xbd\xc0\xc1\xc5\xc9\xca\xce\xd5\xd6\xd7\xd9\xdb\xdc\xde\xe2\xe5\xe9\xec\xf0\xf3\xf4\xf9",
+		"\x02\x03\x09\x0e\x10\x14\x16\x18\x1a\x1d\x1e\x27\x28\x30\x32\x35\x3c\x3d\x3f\x40\x44\x45\x46\x49\x4b\x4d\x4e\x4f\x54\x55\x5c\x63\x68\x6a\x78\x7c\x7d\x7f\x80\x82\x83\x84\x8b\x8f\x90\x96\x9d\x9e\xa0\xac\xb1\xb2\xb3\xb7\xbb\xbc\xbd\xc0\xc4\xc5\xc6\xc7\xc9\xca\xce\xcf\xd6\xda\xdc\xe1\xe3\xe4\xe6\xeb\xee\xef\xf2\xf5\xf7\xf8\xfb\xfc",
+		"\x01\x04\x06\x0a\x0b\x0c\x0f\x13\x15\x17\x19\x1d\x1f\x20\x22\x23\x25\x26\x29\x2b\x2e\x35\x37\x3b\x3e\x43\x47\x48\x4b\x4e\x56\x59\x5a\x5b\x5e\x65\x66\x69\x6b\x6c\x6d\x6f\x74\x77\x7a\x7e\x88\x89\x8a\x91\x92\x9f\xa0\xa1\xa3\xa4\xa7\xab\xae\xb3\xb4\xb6\xb9\xbe\xbf\xc3\xcc\xcd\xd3\xd9\xdd\xde\xe3\xe5\xee\xf0\xf1\xf4\xf5\xf9\xfd\xff",
+		"\x00\x08\x0d\x11\x1b\x1c\x21\x24\x2a\x2c\x2d\x2f\x31\x33\x34\x38\x39\x3a\x3f\x41\x42\x4a\x4c\x50\x51\x52\x53\x58\x5d\x5f\x60\x61\x64\x67\x70\x72\x73\x75\x76\x7b\x81\x86\x8c\x8d\x8e\x95\x97\x98\x99\x9a\x9b\x9c\xa2\xa6\xa8\xa9\xaa\xad\xaf\xb0\xb5\xb8\xba\xc8\xcb\xd0\xd1\xd4\xd5\xd7\xd8\xdb\xdf\xe0\xe2\xe7\xe9\xec\xed\xf3\xfa\xfe",
+		"\x05\x07\x08\x09\x10\x12\x14\x17\x19\x1b\x1f\x27\x2a\x2e\x32\x35\x36\x3e\x41\x42\x44\x47\x4e\x55\x57\x61\x62\x65\x6c\x6e\x71\x74\x75\x79\x85\x87\x88\x89\x8a\x90\x91\x93\x94\x95\x9b\xa0\xa4\xa5\xa7\xa9\xad\xae\xaf\xb2\xbb\xbc\xbf\xc0\xc1\xc2\xc3\xc7\xc8\xcb\xd0\xd2\xda\xdb\xdd\xdf\xe1\xe3\xe8\xea\xec\xee\xf1\xf2\xf3\xf6\xf7\xfb",
+	},
+
+	// Group 7 '/'
+	{
+		"\x00\x04\x07\x0e\x12\x13\x16\x17\x19\x1b\x1e\x20\x24\x27\x28\x2d\x2f\x31\x35\x39\x3b\x47\x48\x4e\x4f\x57\x5a\x5b\x60\x61\x62\x68\x6a\x71\x72\x7c\x81\x82\x84\x86\x87\x8b\x8f\x93\x98\x99\x9a\x9c\x9e\xa0\xa1\xa2\xa4\xa6\xa7\xa9\xac\xb4\xb5\xb9\xc3\xc8\xc9\xd1\xd4\xd5\xd8\xd9\xda\xdc\xdd\xe2\xe3\xe4\xe5\xef\xf0\xf1\xf2\xf4\xf5\xfc",
+		"\x01\x05\x09\x0a\x0b\x0c\x0f\x10\x14\x15\x1a\x1c\x1d\x1f\x22\x23\x25\x2a\x2b\x2c\x2e\x30\x38\x3c\x3d\x3e\x42\x46\x49\x4a\x4b\x4d\x50\x51\x52\x54\x55\x59\x5c\x5d\x5f\x65\x6c\x6d\x6e\x6f\x70\x73\x7a\x7d\x7f\x80\x8a\x8d\x90\x94\x96\x9b\xa8\xaf\xb0\xb2\xb7\xbb\xbd\xbf\xc0\xc2\xc6\xcf\xd3\xd7\xdf\xe0\xe6\xe8\xe9\xed\xf6\xf7\xfa\xff",
+		"\x02\x03\x06\x0d\x11\x18\x21\x26\x29\x32\x33\x34\x36\x3a\x3f\x40\x43\x44\x45\x4c\x53\x56\x58\x5e\x63\x64\x66\x67\x69\x74\x75\x76\x77\x78\x7b\x7e\x83\x85\x88\x89\x8c\x8e\x91\x92\x95\x9d\x9f\xa3\xa5\xaa\xad\xae\xb1\xb3\xb6\xb8\xba\xbc\xbe\xc1\xc5\xc7\xca\xcb\xcc\xcd\xce\xd2\xd6\xde\xe1\xe7\xea\xeb\xec\xee\xf3\xf8\xf9\xfb\xfd\xfe",
+		"\x02\x03\x04\x07\x08\x09\x0c\x0f\x10\x12\x14\x1d\x26\x2c\x2d\x2e\x2f\x32\x33\x34\x37\x3b\x3c\x41\x42\x4b\x4d\x4f\x55\x57\x58\x5b\x5f\x61\x62\x65\x69\x6a\x6b\x70\x71\x76\x77\x78\x79\x7a\x7b\x7d\x7f\x81\x85\x89\x92\x95\x96\x97\x98\x9e\xa5\xa6\xab\xad\xb5\xbb\xbd\xc1\xc3\xc4\xc7\xd0\xd1\xd8\xda\xdb\xe1\xe2\xea\xec\xee\xf0\xf4\xf9",
+		"\x0b\x0d\x0e\x13\x1b\x1c\x22\x25\x28\x2b\x30\x35\x37\x3d\x3e\x40\x41\x44\x47\x49\x4a\x4c\x50\x51\x5c\x63\x64\x66\x67\x68\x6b\x6c\x6d\x6e\x75\x79\x7c\x84\x86\x8a\x8b\x8c\x8e\x90\x91\x9c\x9d\x9f\xa1\xa2\xa3\xa8\xab\xae\xaf\xb0\xb2\xb8\xbe\xc2\xc4\xc5\xc6\xc8\xc9\xce\xd0\xd6\xd9\xdb\xde\xe0\xe7\xe8\xeb\xf1\xf2\xf6\xf7\xfa\xfe\xff",
+		"\x00\x05\x06\x08\x0a\x11\x15\x16\x17\x19\x1a\x1e\x1f\x20\x21\x23\x27\x2a\x31\x38\x39\x3a\x43\x45\x46\x48\x4e\x52\x56\x59\x5d\x5e\x6f\x72\x73\x74\x7e\x80\x82\x83\x87\x88\x8d\x8f\x93\x94\x97\x99\x9a\x9b\xa4\xaa\xac\xb3\xb4\xb7\xb9\xba\xbc\xc0\xca\xcb\xcc\xcd\xcf\xd3\xd4\xd5\xd7\xdc\xdd\xe3\xe4\xe5\xe6\xe9\xed\xf3\xf5\xf8\xfb\xfd",
+		"\x01\x05\x06\x09\x18\x19\x1c\x20\x24\x29\x2a\x2b\x2c\x2e\x2f\x30\x36\x3b\x3f\x44\x4b\x52\x53\x54\x58\x59\x5a\x5b\x5c\x5e\x60\x66\x67\x6f\x72\x74\x79\x7a\x7b\x7d\x81\x82\x83\x86\x87\x88\x89\x8a\x90\x91\x93\x95\x9c\x9e\x9f\xa0\xa5\xa6\xa7\xa9\xae\xb0\xb1\xb3\xb6\xbc\xbe\xbf\xc7\xc8\xcf\xd2\xd7\xdb\xdf\xe6\xef\xf0\xf3\xfb\xfc\xfe",
+	},
+
+	// Group 8 '0'
+	{
+		"\x02\x04\x05\x06\x09\x10\x12\x13\x15\x16\x18\x19\x1c\x1d\x1e\x1f\x26\x2f\x30\x38\x39\x47\x4a\x4b\x4e\x4f\x51\x52\x53\x54\x59\x5c\x5d\x5e\x62\x70\x73\x75\x76\x78\x7c\x7d\x7e\x82\x83\x89\x8e\x8f\x90\x93\x95\x99\x9e\xa1\xa3\xa6\xa7\xaa\xab\xae\xaf\xb2\xb6\xba\xc8\xce\xcf\xd7\xdb\xdc\xdd\xde\xe2\xe6\xea\xed\xf0\xf1\xf3\xf6\xf9\xfb",
+		"\x00\x03\x0c\x0e\x1b\x22\x28\x29\x2c\x2e\x34\x35\x36\x3a\x3c\x3d\x40\x41\x43\x46\x48\x4d\x55\x56\x58\x5a\x5b\x64\x66\x67\x69\x6a\x6b\x6e\x71\x72\x7a\x80\x85\x87\x88\x8a\x8c\x8d\x91\x92\x97\x9b\x9c\x9d\xa0\xa2\xa4\xa5\xa9\xac\xb7\xb9\xbc\xbf\xc1\xc2\xc3\xc7\xc9\xca\xcd\xd4\xd5\xd8\xdf\xe0\xe5\xe7\xe9\xec\xef\xf4\xf7\xf8\xfe\xff",
+		"\x01\x07\x0a\x0b\x0d\x0f\x11\x14\x17\x1a\x20\x21\x23\x24\x25\x27\x2a\x2d\x31\x32\x33\x37\x3b\x3e\x3f\x42\x44\x45\x49\x4c\x50\x57\x5f\x60\x61\x63\x65\x68\x6c\x6d\x6f\x74\x77\x79\x7f\x81\x84\x8b\x94\x96\x98\x9a\x9f\xa8\xad\xb0\xb1\xb3\xb4\xb8\xbb\xbd\xbe\xc0\xc4\xc5\xcc\xd0\xd1\xd2\xd3\xd6\xd9\xda\xe1\xe3\xe8\xee\xf2\xf5\xfc\xfd",
+		"\x05\x07\x08\x0e\x13\x19\x1a\x1c\x1e\x23\x29\x2b\x2e\x2f\x32\x33\x39\x3a\x3b\x44\x4a\x4d\x4e\x50\x53\x54\x59\x5b\x5e\x60\x62\x64\x65\x6d\x6e\x74\x78\x7a\x7b\x80\x81\x85\x86\x89\x8a\x8b\x8c\x90\x91\x94\x95\x9b\x9d\xa2\xa8\xa9\xb4\xb5\xbc\xbe\xc6\xc7\xcb\xce\xcf\xd2\xd3\xd4\xd7\xd8\xda\xe4\xe9\xeb\xee\xef\xf0\xf3\xf8\xfa\xfb\xfc",
+		"\x03\x08\x09\x0b\x0c\x11\x12\x14\x17\x18\x21\x24\x25\x27\x2b\x34\x37\x38\x3c\x3e\x3f\x40\x41\x43\x46\x47\x51\x5d\x5f\x63\x66\x68\x69\x6a\x6f\x70\x72\x77\x7b\x7c\x7f\x82\x83\x86\x8e\x92\x96\x98\x9a\xa3\xa4\xa7\xab\xad\xae\xb0\xb8\xbb\xc0\xc1\xc4\xca\xcb\xcc\xd0\xd5\xd9\xdb\xdc\xdd\xe0\xe4\xe5\xe6\xe7\xea\xeb\xf4\xf6\xf7\xfa\xff",
+		"\x02\x04\x06\x0d\x15\x16\x1b\x1d\x1f\x20\x22\x26\x28\x2a\x2c\x2d\x30\x31\x35\x3d\x42\x45\x49\x4b\x4c\x4f\x52\x55\x56\x58\x61\x67\x6b\x6c\x71\x73\x75\x76\x79\x7d\x84\x87\x88\x8d\x8f\x93\x97\x99\x9c\x9e\x9f\xa1\xa6\xaa\xac\xaf\xb1\xb2\xb5\xb6\xb7\xb9\xba\xbd\xbf\xc2\xc5\xc6\xc8\xc9\xcd\xd1\xd6\xde\xdf\xe3\xe8\xec\xed\xf2\xfd\xfe",
+		"\x00\x01\x02\x07\x0a\x0c\x0f\x10\x17\x18\x1e\x2a\x2c\x2d\x33\x34\x35\x36\x3d\x44\x48\x4e\x52\x57\x5a\x5b\x5c\x5d\x5e\x62\x65\x67\x72\x75\x78\x79\x7e\x80\x87\x8e\x93\x97\x98\x9c\x9f\xa0\xa1\xa2\xa5\xa7\xa8\xaa\xad\xae\xaf\xb0\xb1\xb2\xb3\xb9\xbb\xbc\xc0\xc3\xc4\xce\xd1\xd3\xdd\xe1\xe2\xe5\xea\xec\xef\xf0\xf1\xf3\xf4\xf5\xf6\xf9",
+	},
+
+	// Group 9 '1'
+	{
+		"\x07\x08\x0b\x0d\x13\x1a\x22\x28\x2a\x2d\x2e\x30\x32\x33\x3b\x3c\x40\x41\x45\x46\x49\x4b\x50\x51\x53\x56\x57\x58\x59\x5c\x5f\x63\x64\x65\x66\x6a\x6f\x70\x74\x79\x7b\x7c\x81\x88\x8d\x91\x93\x99\x9c\xa5\xa6\xa8\xa9\xaa\xac\xae\xaf\xb1\xb6\xb8\xb9\xbd\xbe\xc2\xcb\xcc\xd1\xd2\xd5\xe2\xe3\xe5\xe6\xe8\xeb\xef\xf1\xf2\xf6\xf9\xfa\xfd",
+		"\x01\x02\x03\x04\x09\x0c\x0e\x0f\x10\x12\x14\x15\x1b\x1c\x1d\x24\x25\x29\x31\x34\x35\x38\x3d\x3f\x42\x43\x48\x4c\x54\x5a\x5b\x5e\x62\x67\x68\x6c\x6d\x71\x73\x76\x78\x7e\x7f\x82\x84\x8a\x8f\x90\x92\x98\x9b\x9e\x9f\xa0\xa3\xa4\xa7\xab\xb0\xbc\xbf\xc0\xc1\xc5\xc6\xc8\xcd\xce\xd6\xda\xdc\xdd\xde\xea\xec\xed\xf0\xf7\xf8\xfb\xfc\xff",
+		"\x00\x05\x06\x0a\x11\x16\x17\x18\x19\x1e\x1f\x20\x21\x23\x26\x27\x2b\x2c\x2f\x39\x3e\x44\x47\x4a\x4e\x4f\x52\x61\x69\x6b\x6e\x72\x75\x77\x7a\x7d\x80\x83\x85\x86\x87\x89\x8b\x8c\x8e\x96\x97\x9d\xa1\xa2\xad\xb2\xb3\xb4\xb5\xb7\xba\xbb\xc3\xc4\xc7\xc9\xca\xcf\xd0\xd3\xd4\xd7\xd8\xd9\xdb\xdf\xe0\xe1\xe4\xe7\xe9\xee\xf3\xf4\xf5\xfe",
+		"\x02\x04\x06\x08\x09\x0a\x0b\x0c\x10\x12\x18\x1a\x1b\x1e\x1f\x28\x2a\x2c\x34\x36\x37\x39\x3a\x3b\x46\x48\x4d\x51\x55\x57\x5c\x5d\x5f\x60\x66\x6b\x6f\x73\x74\x77\x7a\x81\x86\x8b\x8c\x90\x92\x93\x94\x95\x97\x98\x9a\xa5\xb0\xb1\xb3\xb4\xb5\xc0\xc1\xc7\xcc\xcd\xd3\xd4\xda\xdc\xdd\xde\xe0\xe1\xe3\xe7\xeb\xef\xf0\xf1\xf5\xf7\xf9\xfb",
+		"\x00\x01\x03\x05\x0d\x0f\x17\x1c\x20\x23\x24\x29\x2e\x30\x35\x37\x3a\x3c\x3f\x40\x41\x44\x45\x47\x49\x4a\x4c\x4d\x54\x56\x58\x5e\x60\x61\x62\x69\x6c\x6d\x71\x72\x76\x78\x79\x7b\x7c\x7d\x7e\x7f\x82\x85\x8a\x94\x96\x9a\x9e\x9f\xa2\xa4\xa6\xa8\xa9\xaf\xb8\xba\xbc\xbe\xbf\xc4\xc6\xc8\xc9\xca\xcb\xd0\xd1\xd2\xd5\xe5\xea\xed\xee\xff",
+		"\x07\x0e\x11\x13\x15\x16\x19\x1d\x22\x26\x27\x2d\x2f\x32\x33\x36\x3d\x3e\x43\x4e\x50\x52\x53\x55\x59\x5a\x5b\x5d\x63\x64\x65\x67\x68\x6a\x6e\x70\x75\x83\x84\x87\x88\x89\x8e\x8f\x91\x95\x9b\x9c\xa0\xa1\xa3\xa7\xaa\xab\xac\xad\xae\xb6\xb7\xbb\xbd\xc2\xc3\xc5\xce\xcf\xd6\xd9\xdb\xe2\xe4\xe6\xe9\xf2\xf3\xf4\xf6\xf8\xfa\xfc\xfd\xfe",
+		"\x04\x06\x0b\x14\x15\x18\x21\x25\x26\x29\x2b\x2c\x31\x33\x36\x38\x39\x42\x45\x47\x48\x4b\x4f\x50\x51\x52\x56\x58\x59\x5a\x60\x62\x64\x65\x66\x68\x6c\x70\x73\x74\x76\x7a\x80\x81\x87\x89\x8a\x8d\x90\x96\x99\x9d\xa2\xa3\xa4\xa8\xa9\xae\xb1\xb2\xb6\xb7\xb8\xb9\xbb\xbc\xbf\xc4\xd0\xd4\xd7\xd8\xde\xdf\xe6\xe8\xec\xef\xf3\xf4\xf5\xf8",
+	},
+
+	// Group 10 '2'
+	{
+		"\x02\x06\x09\x0a\x14\x16\x17\x18\x1c\x1e\x21\x24\x25\x33\x37\x38\x39\x3a\x3c\x3e\x42\x43\x46\x49\x4b\x4c\x54\x56\x59\x5c\x5d\x5e\x60\x61\x68\x69\x6a\x6f\x70\x71\x74\x77\x79\x7c\x8a\x90\x91\x92\x93\x95\x96\x9d\xa0\xa2\xa3\xa5\xaa\xad\xae\xb0\xb2\xb5\xb8\xb9\xbb\xc0\xc3\xc8\xc9\xca\xcf\xd4\xd8\xd9\xdd\xe1\xeb\xed\xf8\xfc\xfd\xfe",
+		"\x01\x03\x04\x07\x0b\x0f\x11\x13\x15\x1a\x23\x27\x28\x2a\x2b\x2d\x30\x34\x35\x36\x3f\x40\x41\x45\x4a\x4d\x4f\x52\x55\x57\x5b\x62\x64\x65\x66\x6d\x75\x76\x7a\x7b\x7d\x7f\x81\x85\x86\x88\x89\x8f\x98\x99\x9e\xa6\xa7\xa8\xab\xb1\xb3\xb4\xb6\xbd\xbe\xc1\xc4\xc7\xcc\xd2\xd3\xd5\xd7\xda\xde\xdf\xe3\xe5\xe7\xea\xee\xef\xf0\xf4\xf6\xfa",
+		"\x05\x08\x0c\x0e\x10\x12\x19\x1b\x1d\x1f\x20\x22\x26\x2c\x2e\x2f\x31\x32\x3b\x3d\x44\x48\x4e\x50\x53\x58\x5f\x63\x67\x6b\x6c\x72\x73\x78\x7e\x80\x82\x83\x84\x87\x8b\x8c\x8d\x8e\x94\x97\x9a\x9b\x9c\x9f\xa1\xa9\xac\xaf\xb7\xba\xbc\xbf\xc2\xc5\xc6\xcd\xce\xd0\xd1\xd6\xdc\xe0\xe2\xe4\xe6\xe8\xe9\xec\xf1\xf2\xf3\xf5\xf7\xf9\xfb\xff",
+		"\x00\x04\x07\x0b\x0d\x0e\x12\x15\x1a\x1d\x1f\x21\x27\x29\x2b\x2c\x2d\x31\x33\x37\x40\x41\x43\x47\x48\x49\x50\x51\x55\x5a\x5c\x5d\x60\x64\x65\x6a\x6e\x6f\x70\x74\x82\x84\x85\x87\x88\x8c\x8e\x90\x92\x98\x99\x9e\x9f\xa2\xa4\xa5\xaa\xab\xad\xae\xb2\xc1\xc2\xc8\xcb\xcc\xd0\xd4\xd9\xdb\xe4\xe7\xe9\xea\xef\xf2\xf3\xf6\xf7\xfa\xfd\xff",
+		"\x00\x02\x18\x1b\x20\x22\x23\x2e\x2f\x30\x35\x36\x38\x39\x3b\x3c\x3e\x44\x47\x4b\x4c\x4e\x51\x52\x54\x57\x58\x5a\x5b\x5f\x62\x63\x66\x67\x6e\x71\x72\x75\x77\x78\x7d\x7e\x80\x89\x91\x94\x9c\xa1\xa3\xa6\xa7\xa8\xac\xb0\xb4\xb7\xba\xbd\xc0\xc4\xc6\xc7\xc9\xcb\xcd\xcf\xd2\xd6\xd7\xd8\xdb\xde\xe0\xe2\xe5\xe6\xec\xf0\xf1\xf5\xfb\xfe",
+		"\x03\x05\x06\x08\x09\x0a\x0c\x0d\x0f\x11\x13\x16\x17\x19\x1c\x1e\x26\x28\x2a\x34\x3f\x45\x4d\x4f\x53\x56\x59\x5e\x68\x69\x6c\x6d\x76\x79\x7a\x7b\x7c\x7f\x83\x86\x8a\x8b\x8d\x8f\x93\x95\x96\x97\x9a\x9b\x9d\xa0\xa4\xa9\xaf\xb1\xb3\xb5\xb6\xb8\xb9\xbb\xbe\xc3\xc5\xca\xce\xd1\xd3\xd5\xdc\xdd\xdf\xe1\xe3\xe8\xeb\xed\xf4\xf8\xf9\xfc",
+		"\x01\x02\x09\x0b\x10\x11\x14\x15\x16\x21\x23\x24\x25\x28\x29\x2a\x2c\x2e\x30\x32\x34\x35\x38\x39\x3a\x3d\x41\x42\x46\x47\x4a\x4b\x4c\x4f\x53\x57\x59\x5b\x61\x6a\x6b\x6d\x73\x75\x7a\x7e\x81\x85\x88\x89\x8a\x8e\x95\x97\x9c\x9f\xa3\xa4\xa8\xa9\xb0\xba\xbb\xbc\xbd\xbe\xbf\xcf\xd0\xd3\xd8\xd9\xda\xdc\xdd\xe0\xe5\xee\xf5\xf8\xf9\xfa",
+	},
+
+	// Group 11 '3'
+	{
+		"\x00\x05\x07\x08\x0c\x0d\x0f\x11\x14\x15\x1a\x1f\x20\x25\x27\x2b\x2e\x30\x31\x37\x3a\x3f\x42\x45\x46\x47\x48\x4c\x50\x51\x59\x5c\x5f\x65\x68\x69\x6e\x71\x72\x78\x79\x7c\x7d\x7f\x80\x84\x85\x8a\x8c\x8f\x92\x9e\xa2\xa3\xa4\xa6\xa8\xab\xb3\xb6\xb8\xbc\xbe\xbf\xc2\xc8\xd0\xd2\xd6\xd8\xd9\xda\xdb\xe1\xe3\xe4\xe7\xe8\xe9\xec\xf5\xfe",
+		"\x01\x02\x06\x09\x0b\x12\x16\x18\x19\x1b\x1c\x1d\x23\x24\x28\x29\x2a\x2c\x32\x33\x35\x36\x40\x43\x49\x4b\x52\x53\x55\x56\x57\x5b\x5d\x5e\x67\x6c\x6d\x73\x74\x76\x7a\x7b\x7e\x82\x87\x88\x91\x96\x97\x9b\x9c\xa0\xa7\xa9\xaa\xad\xb1\xb4\xb9\xbb\xc0\xc1\xc4\xc5\xc6\xc7\xcf\xd1\xd3\xd7\xdf\xe2\xe5\xea\xeb\xed\xee\xf0\xf2\xf4\xf8\xfc",
+		"\x03\x04\x0a\x0e\x10\x17\x1e\x21\x22\x26\x2d\x2f\x34\x38\x39\x3b\x3c\x3d\x3e\x44\x4a\x4d\x4e\x4f\x54\x58\x5a\x60\x61\x63\x64\x66\x6a\x6b\x6f\x75\x77\x81\x83\x89\x8b\x8d\x8e\x90\x93\x94\x95\x98\x99\x9d\x9f\xa1\xa5\xac\xae\xaf\xb0\xb2\xb5\xb7\xba\xbd\xc3\xc9\xca\xcb\xcc\xcd\xd4\xd5\xdc\xdd\xde\xe0\xe6\xef\xf1\xf3\xf6\xfa\xfb\xff",
+		"\x00\x03\x05\x06\x0a\x0e\x12\x13\x15\x1a\x28\x29\x2f\x32\x36\x3a\x40\x41\x43\x45\x48\x4e\x52\x54\x55\x5b\x5c\x62\x63\x67\x6a\x6c\x6d\x6e\x70\x78\x7b\x7f\x81\x85\x86\x89\x8a\x8b\x8d\x93\x94\x95\x96\x97\x9a\x9b\x9c\xa8\xad\xaf\xb0\xb2\xb4\xb6\xb8\xba\xbb\xbf\xc8\xcc\xce\xd0\xd1\xd4\xd9\xe1\xe3\xe5\xe9\xeb\xf0\xf7\xf9\xfb\xfc\xfd",
+		"\x01\x04\x07\x09\x0c\x0d\x0f\x11\x19\x1d\x20\x22\x23\x27\x2b\x2c\x35\x38\x3b\x3f\x41\x42\x47\x4a\x4c\x53\x58\x5a\x5e\x5f\x61\x62\x6b\x6f\x70\x74\x76\x7a\x80\x83\x8e\x90\x98\x9a\xa1\xa2\xa4\xa6\xa9\xab\xac\xb3\xc1\xc3\xc4\xc5\xc6\xc7\xca\xcb\xce\xcf\xd3\xd5\xd6\xd7\xd8\xda\xdb\xdc\xe7\xed\xee\xf1\xf4\xf6\xf7\xf8\xf9\xfa\xfd\xff",
+		"\x0b\x10\x14\x17\x1c\x1e\x1f\x21\x24\x25\x2a\x2d\x2e\x30\x31\x33\x37\x39\x3c\x3d\x3e\x44\x46\x49\x4b\x4d\x4f\x50\x51\x56\x59\x60\x64\x65\x66\x68\x69\x72\x75\x77\x79\x7c\x7d\x82\x84\x86\x87\x88\x8c\x91\x92\x99\x9d\x9f\xa0\xa3\xa7\xaa\xae\xb5\xb7\xb9\xbc\xbe\xc0\xc2\xc9\xd2\xdd\xde\xdf\xe2\xe4\xe6\xe8\xea\xec\xef\xf2\xf3\xf5\xfe",
+		"\x02\x08\x0a\x0b\x0c\x0f\x13\x15\x16\x17\x18\x1b\x1c\x21\x25\x26\x31\x33\x34\x37\x3c\x3f\x40\x41\x46\x47\x4e\x50\x52\x57\x58\x5b\x5d\x5f\x60\x61\x63\x64\x65\x67\x6f\x70\x71\x73\x78\x79\x7e\x82\x88\x8f\x9e\xa1\xa3\xa5\xa8\xad\xb1\xb2\xb3\xb9\xba\xbd\xc1\xc3\xc7\xc8\xcb\xcc\xcd\xce\xd4\xd7\xda\xe0\xe2\xe6\xe8\xec\xed\xf0\xf3\xfe",
+	},
+
+	// Group 12 '4'
+	{
+		"\x07\x0e\x0f\x10\x15\x17\x1a\x20\x22\x28\x2b\x2f\x30\x31\x32\x33\x3a\x3d\x3e\x3f\x44\x46\x48\x4a\x4c\x4d\x50\x56\x57\x5c\x5e\x5f\x63\x64\x65\x6a\x6c\x6d\x6f\x70\x72\x75\x79\x7d\x7e\x7f\x86\x87\x91\x93\x95\x9b\x9c\x9d\x9e\xa1\xa4\xa8\xaa\xac\xae\xaf\xb3\xb5\xb7\xb9\xba\xc2\xca\xd0\xd2\xd3\xd6\xdb\xdc\xde\xe1\xe3\xe6\xeb\xf4\xfe",
+		"\x02\x03\x05\x09\x0a\x0c\x12\x13\x14\x19\x1b\x1c\x1e\x1f\x21\x25\x27\x29\x35\x36\x38\x3b\x43\x45\x49\x4e\x53\x54\x5b\x60\x71\x74\x76\x77\x78\x7a\x7b\x80\x81\x84\x85\x88\x89\x8a\x8d\x8e\x8f\x92\x94\x97\x98\x9a\xa2\xa6\xa9\xb1\xb4\xb8\xbb\xbc\xbf\xc1\xc4\xc5\xd4\xd5\xd7\xd8\xda\xdd\xe0\xe5\xe7\xe8\xe9\xea\xf0\xf1\xf3\xf7\xf9\xff",
+		"\x01\x04\x06\x08\x0b\x0d\x11\x16\x1d\x23\x24\x2a\x2c\x2d\x2e\x34\x37\x39\x3c\x40\x41\x42\x47\x4b\x4f\x51\x52\x55\x58\x59\x5a\x5d\x61\x62\x66\x67\x68\x69\x6b\x73\x7c\x82\x8b\x8c\x90\x96\x99\x9f\xa0\xa5\xa7\xab\xb0\xb2\xb6\xbd\xbe\xc0\xc3\xc6\xc7\xc8\xc9\xcc\xcd\xce\xcf\xd1\xdf\xe2\xe4\xec\xee\xef\xf2\xf5\xf6\xf8\xfa\xfb\xfc\xfd",
+		"\x00\x01\x03\x0e\x0f\x11\x14\x18\x1a\x1b\x1d\x1e\x1f\x21\x25\x26\x29\x2b\x2f\x31\x34\x35\x37\x3b\x3e\x43\x45\x48\x4b\x4d\x53\x57\x58\x5d\x5e\x64\x6b\x6c\x6e\x7b\x80\x81\x82\x83\x86\x87\x88\x8c\x8d\x91\x99\x9f\xa1\xa2\xa3\xad\xae\xb0\xb3\xb4\xb6\xbf\xc2\xc3\xc5\xcb\xcd\xd5\xd8\xd9\xda\xdb\xe1\xe2\xe4\xe6\xeb\xed\xee\xf0\xfd\xfe",
+		"\x00\x02\x05\x08\x09\x0a\x0b\x0d\x13\x17\x19\x20\x22\x24\x26\x2a\x30\x39\x3c\x40\x41\x42\x46\x4c\x51\x54\x55\x5b\x5c\x5f\x60\x63\x68\x6a\x6f\x75\x76\x77\x7d\x83\x84\x85\x8b\x8e\x8f\x93\x95\x97\x9b\x9c\x9e\xa0\xa3\xa4\xa6\xa7\xaa\xac\xb1\xb9\xba\xbd\xc7\xcb\xce\xd0\xd1\xd3\xd4\xd6\xd9\xdc\xe9\xec\xed\xef\xf3\xf4\xf7\xf8\xfb\xfc",
+		"\x06\x07\x0c\x10\x12\x15\x18\x1c\x27\x28\x2c\x2d\x2e\x32\x33\x38\x3a\x3d\x3f\x44\x47\x49\x4a\x4e\x4f\x50\x52\x59\x5a\x61\x62\x65\x67\x69\x6d\x6e\x70\x72\x74\x78\x7c\x7e\x7f\x89\x90\x94\x96\x98\x9a\x9d\xa5\xa8\xa9\xab\xaf\xb2\xb7\xb8\xbb\xbc\xbe\xc0\xc1\xc4\xc8\xc9\xca\xcc\xcf\xdd\xdf\xe0\xe3\xe5\xe8\xea\xf1\xf2\xf5\xf6\xf9\xfa",
+		"\x04\x06\x0b\x0c\x11\x12\x16\x17\x18\x19\x1b\x1d\x23\x26\x2e\x30\x35\x36\x38\x3a\x3d\x40\x46\x47\x4a\x4e\x4f\x51\x52\x54\x56\x58\x59\x5f\x66\x68\x6b\x6d\x6f\x71\x72\x73\x79\x7a\x82\x83\x8a\x8c\x8f\x92\x93\x98\x9d\x9e\xa3\xa8\xa9\xab\xad\xae\xb5\xb8\xb9\xc0\xc1\xc6\xc8\xd2\xd7\xd9\xdb\xdc\xdd\xde\xe3\xe7\xec\xed\xee\xf2\xf3\xff",
+	},
+
+	// Group 13 '5'
+	{
+		"\x00\x02\x06\x07\x09\x0a\x14\x17\x1b\x21\x22\x27\x2f\x30\x34\x36\x3d\x40\x41\x45\x46\x4d\x54\x56\x57\x5a\x5c\x5d\x62\x63\x66\x68\x69\x6c\x6d\x72\x76\x77\x7d\x7e\x80\x89\x8b\x8e\x8f\x90\x94\x95\x9f\xa0\xa1\xa3\xa4\xa8\xaa\xad\xb2\xb3\xb8\xbd\xc0\xc3\xc6\xc8\xca\xcb\xcd\xd0\xd1\xd4\xd6\xda\xdb\xe3\xe7\xee\xf0\xf4\xf6\xfb\xfc\xfd",
+		"\x03\x04\x05\x08\x0d\x12\x1c\x1d\x1f\x20\x23\x24\x26\x2a\x2d\x31\x33\x37\x38\x39\x3a\x3e\x42\x43\x47\x49\x4a\x4c\x4f\x50\x52\x53\x5f\x64\x65\x6b\x6e\x70\x71\x73\x75\x78\x7f\x84\x85\x86\x88\x8a\x92\x97\x9e\xac\xae\xaf\xb1\xb4\xb5\xba\xbe\xbf\xc2\xc9\xcc\xcf\xd3\xd5\xd7\xd8\xde\xdf\xe0\xe2\xe9\xea\xeb\xed\xef\xf5\xf7\xfa\xfe\xff",
+		"\x01\x0b\x0c\x0e\x10\x11\x13\x15\x16\x18\x19\x1a\x1e\x25\x28\x29\x2b\x2c\x2e\x32\x35\x3b\x3c\x3f\x44\x4b\x51\x55\x59\x5b\x5e\x60\x61\x67\x6a\x6f\x74\x79\x7a\x7b\x7c\x81\x82\x83\x87\x8c\x8d\x91\x93\x96\x98\x99\x9a\x9b\x9c\x9d\xa2\xa6\xa7\xa9\xab\xb6\xb7\xb9\xbb\xbc\xc1\xc4\xc5\xc7\xd2\xd9\xdc\xe4\xe5\xe6\xe8\xf1\xf2\xf3\xf8\xf9",
+		"\x0d\x0e\x0f\x10\x17\x19\x1a\x1c\x1e\x24\x26\x33\x38\x3e\x3f\x48\x4a\x4b\x4e\x4f\x50\x52\x53\x54\x56\x58\x59\x5b\x5d\x68\x6d\x6f\x74\x76\x78\x81\x85\x87\x88\x8f\x91\x99\x9c\xa3\xa4\xa5\xa7\xa8\xa9\xb0\xb2\xb6\xb8\xbb\xbe\xbf\xc1\xc3\xc8\xcd\xce\xd1\xd2\xd5\xdb\xdd\xdf\xe0\xe1\xe2\xe4\xe9\xeb\xec\xf2\xf3\xf5\xfa\xfb\xfc\xfd\xfe",
+		"\x02\x04\x05\x06\x07\x0a\x0b\x0f\x11\x12\x13\x20\x23\x28\x29\x2b\x2e\x30\x32\x37\x3c\x40\x43\x46\x48\x49\x4e\x51\x55\x58\x5a\x5f\x64\x65\x6a\x6b\x6e\x72\x77\x7e\x7f\x82\x83\x89\x8a\x93\x95\x96\x97\x9a\x9b\xa0\xa1\xa5\xaa\xac\xb1\xb3\xb7\xba\xbc\xc2\xc6\xc7\xcb\xce\xcf\xd0\xd3\xd4\xd7\xdd\xde\xe1\xe3\xe6\xe7\xe8\xec\xee\xf0\xf8",
+		"\x00\x01\x03\x08\x09\x0c\x14\x15\x16\x18\x1b\x1f\x21\x25\x27\x2a\x2d\x2f\x34\x36\x39\x3d\x41\x42\x45\x47\x4c\x4d\x57\x5e\x60\x62\x63\x67\x6c\x71\x73\x75\x79\x7a\x7b\x7d\x80\x84\x86\x8c\x8d\x8e\x90\x92\x94\x98\x9d\x9e\x9f\xa2\xa6\xab\xad\xae\xb0\xb5\xb9\xc0\xc4\xc5\xc9\xca\xcc\xd6\xd8\xd9\xdc\xe5\xea\xed\xef\xf1\xf4\xf7\xf9\xff",
+		"\x05\x0a\x0d\x11\x16\x17\x19\x1d\x22\x23\x29\x2c\x31\x34\x35\x36\x37\x39\x3a\x3b\x3c\x3f\x40\x43\x44\x46\x53\x55\x58\x5c\x5f\x61\x66\x69\x6c\x6d\x6e\x70\x71\x78\x7c\x7e\x83\x86\x87\x88\x8b\x8f\x93\x97\x98\xa0\xa1\xad\xaf\xb4\xbb\xbc\xbd\xbf\xc0\xc3\xc4\xcf\xd0\xd8\xda\xdc\xe1\xe2\xe3\xe5\xea\xeb\xee\xf0\xf2\xf4\xf5\xf6\xf9\xfa",
+	},
+
+	// Group 14 '6'
+	{
+		"\x03\x05\x07\x08\x0b\x0f\x10\x13\x14\x19\x1f\x20\x22\x24\x25\x29\x2a\x2b\x2c\x36\x38\x39\x3b\x3e\x3f\x40\x43\x49\x4c\x5b\x62\x63\x69\x6a\x6c\x6d\x6e\x6f\x71\x73\x74\x76\x7d\x7e\x7f\x81\x84\x88\x8e\x8f\x92\x94\x9b\xa4\xa5\xa8\xb1\xb5\xbe\xc3\xc5\xc7\xc9\xcc\xce\xd0\xd4\xd6\xd7\xd9\xda\xdc\xde\xe2\xe3\xe7\xec\xed\xf1\xf6\xf9\xfc",
+		"\x01\x02\x04\x0a\x0d\x0e\x11\x18\x1b\x1d\x21\x23\x26\x27\x28\x2e\x2f\x30\x31\x34\x42\x45\x46\x48\x4a\x4b\x4d\x4f\x50\x51\x54\x56\x57\x5a\x5c\x5e\x64\x65\x6b\x70\x77\x78\x7b\x80\x83\x87\x8a\x8b\x8c\x8d\x93\x95\x99\x9d\x9f\xa2\xaa\xad\xb0\xb6\xb8\xba\xbb\xbf\xc1\xc2\xc4\xcb\xd2\xd5\xdd\xdf\xe0\xe1\xe6\xeb\xf0\xf4\xf5\xf8\xfe\xff",
+		"\x00\x09\x0c\x15\x16\x17\x1a\x1c\x1e\x2d\x32\x35\x37\x3a\x3c\x3d\x41\x44\x47\x4e\x52\x53\x55\x58\x59\x5d\x5f\x60\x61\x66\x67\x72\x75\x79\x82\x85\x86\x89\x90\x91\x96\x97\x98\x9a\x9e\xa0\xa1\xa3\xa6\xa7\xa9\xab\xac\xae\xaf\xb2\xb3\xb4\xb7\xb9\xbc\xbd\xc6\xc8\xca\xcd\xcf\xd1\xd3\xd8\xe4\xe5\xe8\xe9\xea\xee\xef\xf2\xf7\xfa\xfb\xfd",
+		"\x06\x09\x10\x12\x13\x14\x15\x1d\x1e\x1f\x21\x23\x24\x28\x33\x37\x3a\x3c\x41\x44\x49\x4b\x54\x59\x5c\x60\x65\x68\x6b\x73\x75\x7a\x7b\x7c\x7d\x80\x82\x84\x86\x88\x89\x8d\x91\x9a\x9b\x9c\xa1\xa2\xa3\xa5\xa6\xab\xae\xaf\xb2\xb6\xb7\xb8\xb9\xbc\xbd\xbe\xbf\xc0\xc5\xc9\xce\xcf\xd3\xdb\xdc\xde\xe3\xe5\xe6\xe8\xea\xeb\xef\xf3\xf6\xf7",
+		"\x00\x04\x05\x06\x08\x0d\x0f\x11\x12\x17\x18\x19\x20\x26\x2a\x2b\x2c\x31\x33\x35\x38\x39\x3d\x42\x43\x4f\x51\x52\x56\x57\x5e\x67\x68\x6a\x6c\x6d\x71\x72\x74\x7a\x7f\x83\x87\x8b\x93\x94\x96\x98\x9f\xa0\xa7\xa8\xad\xb0\xb3\xba\xc0\xc1\xc2\xc4\xc6\xcd\xd2\xd6\xd7\xd9\xdd\xe2\xe4\xe7\xf0\xf1\xf2\xf3\xf4\xf8\xf9\xfa\xfb\xfc\xfe\xff",
+		"\x01\x02\x07\x0a\x0b\x0c\x0e\x16\x1a\x1c\x22\x27\x2e\x2f\x30\x34\x36\x3f\x40\x45\x46\x47\x48\x4a\x4c\x4d\x4e\x50\x53\x55\x58\x5a\x5b\x5d\x5f\x61\x62\x63\x6e\x6f\x70\x76\x77\x78\x79\x7e\x81\x8c\x8f\x90\x92\x95\x97\x99\x9c\x9d\xa4\xa9\xaa\xac\xb1\xb5\xbb\xc3\xc7\xc8\xca\xcb\xcc\xd0\xd1\xd5\xd8\xda\xdb\xdf\xe1\xec\xed\xee\xf5\xfd",
+		"\x01\x03\x04\x09\x0c\x0d\x13\x14\x1b\x1c\x24\x25\x29\x2c\x2d\x32\x33\x37\x3b\x3e\x40\x48\x4f\x50\x51\x53\x56\x58\x59\x5b\x5d\x5f\x60\x62\x64\x65\x66\x69\x6a\x6b\x6d\x71\x72\x73\x79\x7c\x7d\x7e\x85\x8a\x8b\x8e\x91\x96\x97\x9d\x9e\x9f\xa1\xa7\xac\xae\xb0\xb4\xc1\xc3\xc5\xc7\xd2\xd4\xd5\xdf\xe0\xe2\xe6\xe9\xeb\xec\xf4\xf7\xf8\xf9",
+	},
+
+	// Group 15 '7'
+	{
+		"\x04\x06\x09\x0c\x0d\x0e\x0f\x13\x18\x1c\x1e\x24\x25\x2d\x31\x33\x36\x38\x3a\x3c\x41\x42\x43\x47\x48\x4c\x4f\x53\x55\x56\x5b\x5c\x5f\x60\x61\x64\x68\x6b\x6e\x75\x7b\x7c\x7f\x80\x81\x88\x89\x8b\x8f\x93\x9a\x9b\x9c\x9d\xa0\xa3\xa6\xa8\xa9\xb7\xbb\xbc\xc8\xc9\xca\xcc\xd1\xd2\xd4\xd6\xdc\xdd\xdf\xe0\xe2\xe8\xed\xef\xf7\xfc\xfd\xff",
+		"\x00\x01\x02\x03\x07\x08\x0a\x0b\x10\x11\x14\x16\x1a\x1d\x26\x2a\x34\x37\x39\x3d\x44\x4e\x51\x58\x59\x5a\x5e\x62\x63\x67\x69\x6a\x6c\x6d\x74\x76\x77\x78\x7a\x7d\x82\x84\x85\x87\x8d\x95\x98\x99\xa1\xaa\xab\xae\xb0\xb1\xb4\xb5\xba\xc0\xc1\xc4\xc6\xc7\xcb\xce\xcf\xd0\xd7\xd9\xda\xde\xe4\xe7\xe9\xec\xf0\xf1\xf2\xf3\xf5\xf9\xfb\xfe",
+		"\x05\x12\x15\x17\x19\x1b\x1f\x20\x21\x22\x23\x27\x28\x2b\x2c\x2e\x2f\x30\x32\x3b\x3e\x3f\x40\x45\x46\x49\x4a\x4b\x4d\x50\x52\x54\x57\x5d\x65\x66\x6f\x71\x72\x73\x79\x83\x86\x8a\x8c\x8e\x90\x91\x92\x94\x96\x97\x9f\xa2\xa4\xa5\xa7\xac\xad\xb2\xb3\xb6\xb8\xb9\xbd\xbe\xc2\xcd\xd3\xd5\xd8\xdb\xe1\xe3\xe5\xe6\xea\xeb\xee\xf6\xf8\xfa",
+		"\x00\x02\x04\x0b\x0d\x13\x16\x18\x1e\x20\x24\x25\x28\x29\x2c\x2e\x31\x32\x35\x3a\x40\x4c\x50\x53\x56\x59\x5d\x5f\x64\x66\x68\x6a\x6b\x6c\x6d\x70\x71\x75\x78\x7a\x7b\x7c\x7d\x7e\x80\x81\x82\x84\x85\x86\x87\x91\x99\x9d\x9e\xa2\xa8\xab\xaf\xb1\xb2\xb7\xb9\xbb\xbf\xc3\xc4\xc5\xc7\xca\xcd\xcf\xd0\xd3\xdb\xdc\xe6\xe7\xee\xf0\xf4\xfc",
+		"\x09\x0a\x10\x17\x19\x1a\x1d\x1f\x22\x29\x2d\x2f\x30\x34\x36\x37\x3b\x3c\x3d\x41\x42\x44\x48\x4d\x4f\x58\x63\x65\x70\x74\x76\x7e\x88\x8a\x8d\x8e\x8f\x90\x92\x94\x96\x98\x9a\x9e\x9f\xa0\xa1\xa3\xa9\xad\xae\xb4\xb6\xb8\xbd\xbe\xbf\xc1\xc3\xc5\xd4\xd6\xd7\xdd\xdf\xe0\xe5\xea\xed\xef\xf1\xf2\xf3\xf5\xf6\xf7\xf8\xf9\xfa\xfb\xfd\xfe",
+		"\x01\x03\x05\x06\x08\x0c\x0e\x0f\x12\x15\x21\x23\x26\x27\x2a\x2b\x33\x35\x38\x3e\x3f\x43\x45\x46\x47\x49\x4a\x4b\x4e\x51\x52\x55\x57\x5a\x5b\x5c\x5e\x60\x62\x67\x69\x6e\x72\x73\x77\x79\x7f\x83\x89\x8b\x8c\x93\x95\x97\x9c\xa4\xa7\xac\xaf\xb3\xb5\xba\xbc\xc0\xc2\xc6\xc8\xc9\xcc\xd1\xd2\xd5\xd8\xd9\xda\xe1\xe2\xe3\xe8\xec\xf4\xff",
+		"\x02\x06\x07\x08\x0b\x0c\x0d\x0f\x10\x11\x14\x16\x18\x19\x1a\x1b\x1c\x20\x2d\x32\x33\x37\x39\x3c\x40\x41\x44\x53\x54\x5b\x5c\x61\x67\x6e\x6f\x7b\x7c\x80\x84\x86\x8a\x91\x93\x99\x9b\x9c\xa2\xa3\xa4\xa5\xa6\xaa\xae\xb0\xb5\xb7\xb9\xbc\xc1\xc3\xc6\xcb\xcc\xce\xd3\xd5\xdc\xde\xe0\xe3\xe4\xe5\xe6\xe9\xeb\xee\xef\xf1\xf6\xf7\xfc\xfe",
+	},
+
+	// Group 16 '8'
+	{
+		"\x00\x01\x06\x08\x11\x14\x15\x16\x18\x1d\x22\x23\x28\x29\x2b\x34\x35\x37\x38\x3a\x3e\x40\x41\x45\x48\x4d\x4f\x52\x58\x59\x61\x64\x66\x70\x72\x77\x7a\x7b\x7c\x7e\x80\x81\x86\x8b\x8c\x90\x9a\x9c\x9f\xa2\xa5\xa9\xab\xb1\xb4\xb6\xbd\xbe\xbf\xc1\xc3\xc5\xcb\xd0\xd3\xd7\xda\xdb\xde\xe0\xe2\xe3\xe5\xe6\xe8\xed\xf2\xf3\xf7\xfc\xfd\xff",
+		"\x02\x09\x0a\x0b\x0c\x0e\x0f\x10\x13\x1a\x1f\x20\x2c\x2d\x2f\x30\x31\x36\x39\x3b\x44\x49\x4a\x4c\x4e\x53\x55\x57\x5b\x5c\x60\x63\x65\x6a\x6b\x6e\x71\x75\x76\x79\x7f\x82\x84\x87\x8d\x8e\x91\x94\x97\x98\xaa\xac\xad\xae\xaf\xb0\xb8\xbb\xbc\xc7\xc8\xc9\xcc\xce\xcf\xd1\xd2\xd4\xd6\xdc\xdd\xe4\xe7\xe9\xeb\xec\xf1\xf4\xf5\xf8\xf9\xfb",
+		"\x03\x05\x07\x0d\x12\x17\x19\x1b\x1c\x1e\x21\x25\x26\x27\x2a\x2e\x32\x33\x3c\x3f\x43\x46\x47\x4b\x50\x54\x56\x5a\x5d\x5e\x5f\x62\x67\x68\x69\x6c\x6d\x6f\x73\x74\x78\x7d\x83\x85\x88\x89\x8a\x8f\x92\x93\x95\x96\x99\x9b\x9d\x9e\xa0\xa1\xa4\xa6\xa7\xa8\xb2\xb3\xb7\xb9\xc0\xc2\xc6\xca\xcd\xd5\xd8\xd9\xe1\xea\xee\xef\xf0\xf6\xfa\xfe",
+		"\x00\x04\x06\x09\x0d\x0e\x0f\x10\x1e\x20\x22\x24\x27\x2d\x30\x33\x36\x38\x39\x3d\x3f\x42\x43\x44\x46\x47\x48\x4c\x50\x51\x53\x58\x5a\x5c\x5f\x67\x6d\x71\x7f\x8d\x8e\x8f\x9a\x9b\x9c\xa1\xa2\xa3\xa4\xa6\xb0\xb1\xb3\xb4\xb5\xb6\xb7\xba\xbb\xc0\xc2\xc3\xc4\xc7\xc9\xca\xcc\xd9\xdf\xe3\xe6\xe7\xe9\xee\xef\xf2\xf3\xf7\xfa\xfc\xfd\xff",
+		"\x02\x03\x08\x0a\x11\x13\x14\x15\x17\x19\x1b\x1d\x21\x24\x26\x28\x2f\x31\x32\x34\x35\x3b\x3d\x4a\x4e\x4f\x51\x52\x57\x59\x5d\x63\x65\x66\x6a\x6b\x6f\x70\x74\x76\x77\x78\x79\x7c\x84\x85\x86\x87\x88\x92\x97\x9d\xa0\xa3\xaa\xad\xb2\xb5\xb9\xba\xbc\xbd\xc1\xc4\xc5\xcd\xce\xd1\xd4\xd7\xd8\xdc\xdd\xdf\xe1\xe2\xe4\xea\xf1\xf5\xf9\xfe",
+		"\x01\x04\x05\x0b\x0c\x12\x16\x18\x1a\x1c\x25\x29\x2a\x2b\x2c\x2e\x37\x3c\x3e\x40\x41\x42\x45\x49\x4b\x4d\x5e\x60\x61\x62\x64\x68\x69\x72\x73\x75\x7b\x81\x82\x83\x89\x8b\x8c\x90\x91\x93\x94\x95\x96\x98\x99\x9e\x9f\xa5\xa8\xa9\xab\xac\xae\xaf\xb8\xbe\xc6\xc8\xcb\xcf\xd0\xd2\xd3\xd5\xd6\xda\xdb\xde\xe5\xe8\xeb\xec\xf0\xf4\xf6\xf8",
+		"\x07\x08\x11\x16\x1f\x21\x23\x2b\x35\x36\x37\x38\x39\x3a\x3c\x3d\x44\x47\x49\x4b\x54\x55\x56\x5b\x5e\x62\x69\x6c\x6e\x71\x72\x73\x7a\x7b\x7c\x7d\x7e\x7f\x80\x82\x84\x85\x87\x8a\x8b\x8d\x8f\x91\x92\x93\x95\x98\x9a\x9c\x9d\xa2\xa3\xa7\xa9\xb7\xbd\xbf\xc3\xc9\xda\xde\xdf\xe0\xe2\xe4\xe7\xe8\xeb\xec\xed\xee\xf2\xf3\xf7\xfb\xfc\xfe",
+	},
+
+	// Group 17 '9'
+	{
+		"\x02\x09\x0a\x0e\x0f\x10\x13\x17\x18\x1b\x31\x35\x36\x3d\x42\x44\x47\x48\x49\x4e\x50\x51\x52\x56\x5a\x5b\x5f\x60\x62\x63\x64\x65\x67\x69\x6d\x70\x74\x77\x78\x79\x7b\x7c\x80\x83\x84\x88\x8b\x8f\x90\x93\x94\x96\x9a\x9c\x9e\xa0\xa4\xa5\xa8\xac\xae\xaf\xb1\xb4\xbb\xc0\xc2\xd1\xd3\xda\xde\xe5\xe6\xeb\xec\xed\xf0\xf1\xf2\xf4\xf6\xf8",
+		"\x00\x03\x05\x06\x08\x0b\x0c\x0d\x11\x15\x19\x1d\x1e\x1f\x20\x21\x25\x26\x2c\x2d\x2e\x30\x33\x37\x38\x39\x3a\x43\x45\x4a\x4b\x4c\x53\x54\x55\x58\x5c\x66\x68\x6b\x75\x76\x7a\x7d\x85\x87\x8a\x8c\x8d\x91\x97\x98\x99\xa1\xa3\xa6\xa7\xa9\xb2\xb7\xba\xbc\xc1\xc7\xc8\xce\xd0\xd2\xd4\xd7\xdb\xe1\xe2\xe4\xe7\xe8\xe9\xf3\xf7\xfa\xfb\xff",
+		"\x01\x04\x07\x12\x14\x16\x1a\x22\x23\x24\x27\x28\x29\x2a\x2b\x2f\x32\x34\x3b\x3c\x3e\x3f\x40\x41\x46\x4d\x4f\x59\x5d\x5e\x61\x6a\x6c\x6e\x6f\x72\x73\x7e\x7f\x81\x89\x8e\x92\x95\x9b\x9d\x9f\xa2\xab\xad\xb0\xb5\xb6\xb8\xbd\xbe\xbf\xc3\xc4\xc5\xc6\xc9\xca\xcb\xcc\xcd\xcf\xd5\xd6\xd9\xdc\xdd\xdf\xe0\xea\xee\xef\xf5\xf9\xfc\xfd\xfe",
+		"\x00\x10\x14\x1a\x1c\x1e\x23\x27\x28\x2a\x2b\x2d\x30\x34\x36\x37\x3b\x3c\x3e\x40\x47\x49\x4d\x4f\x50\x52\x53\x57\x58\x5d\x63\x65\x6c\x6d\x6f\x71\x72\x78\x79\x7b\x7d\x7e\x7f\x81\x82\x83\x84\x85\x86\x8d\x90\x95\x96\x98\x99\x9a\x9e\x9f\xa2\xa6\xa7\xaa\xb3\xb9\xc3\xc4\xc6\xc8\xca\xcb\xcf\xd0\xd7\xd8\xda\xe0\xe3\xe7\xeb\xee\xf8\xfd",
+		"\x02\x08\x0b\x0d\x0f\x11\x13\x15\x16\x1c\x21\x22\x24\x31\x32\x35\x38\x3a\x3d\x41\x45\x4c\x54\x56\x57\x59\x5b\x5f\x60\x62\x64\x66\x6a\x70\x71\x7a\x7c\x82\x86\x88\x8c\x8f\x93\x94\xa1\xa4\xaa\xac\xad\xae\xaf\xb1\xb3\xb4\xb5\xb7\xb8\xb9\xbb\xbf\xc0\xc2\xc5\xc7\xc9\xd1\xd3\xd4\xd5\xd6\xd8\xdb\xe1\xe4\xe6\xe8\xe9\xec\xef\xf1\xf6\xff",
+		"\x01\x03\x04\x05\x06\x07\x09\x0a\x0c\x0e\x12\x17\x18\x19\x1b\x1f\x20\x25\x26\x2c\x2e\x33\x39\x3f\x42\x43\x44\x46\x48\x4a\x4b\x51\x55\x5a\x5c\x5e\x61\x68\x69\x6e\x73\x74\x76\x77\x87\x89\x8a\x8b\x91\x92\x97\x9b\x9c\x9d\xa0\xa3\xa5\xab\xb0\xb6\xbd\xbe\xc1\xcc\xcd\xd2\xd9\xdc\xdd\xdf\xe2\xe5\xea\xed\xf0\xf3\xf5\xf7\xf9\xfa\xfc\xfe",
+		"\x01\x06\x07\x18\x19\x1a\x1d\x22\x25\x29\x2d\x2f\x32\x34\x37\x3a\x3c\x3d\x43\x44\x47\x49\x4b\x4e\x51\x56\x59\x5d\x63\x67\x6b\x6d\x75\x76\x78\x79\x7d\x7e\x7f\x80\x81\x88\x8c\x8e\x8f\x93\x9b\x9c\xa0\xa3\xa5\xa8\xa9\xaa\xad\xb0\xb2\xb7\xba\xbc\xbf\xc0\xc9\xcb\xcc\xce\xd5\xda\xdb\xdd\xde\xdf\xe0\xe3\xe7\xed\xee\xf2\xf4\xf7\xfb\xfd",
+	},
+
+	// Group 18 ':'
+	{
+		"\x00\x03\x06\x08\x13\x17\x18\x19\x1a\x1b\x1e\x22\x24\x25\x29\x2a\x2b\x2c\x2e\x30\x37\x39\x3e\x3f\x40\x42\x43\x45\x48\x4a\x4d\x4f\x53\x55\x56\x57\x59\x5c\x5f\x63\x65\x69\x6d\x6f\x73\x78\x7a\x86\x8a\x8b\x8d\x91\x92\x96\x97\x9e\x9f\xa0\xa4\xae\xb0\xb7\xbf\xc2\xc7\xc9\xcb\xcc\xd1\xd2\xd6\xd9\xde\xe5\xeb\xed\xf0\xf2\xf3\xf5\xf9\xfa",
+		"\x04\x05\x07\x0a\x0b\x16\x1c\x1d\x1f\x20\x23\x2d\x31\x34\x3a\x3b\x3c\x3d\x47\x4c\x4e\x51\x58\x5b\x5d\x5e\x60\x62\x67\x6a\x71\x72\x74\x76\x7b\x7c\x7d\x7f\x80\x84\x85\x8c\x8f\x93\x94\x95\x9b\x9c\x9d\xa2\xa3\xa5\xad\xb3\xb4\xb5\xb8\xbc\xc1\xc3\xc4\xc6\xca\xce\xd0\xd3\xd8\xdf\xe0\xe2\xe3\xe7\xe9\xea\xef\xf1\xf6\xf7\xf8\xfb\xfd\xfe",
+		"\x01\x02\x09\x0c\x0d\x11\x12\x15\x21\x26\x27\x28\x32\x33\x35\x36\x38\x41\x44\x46\x49\x50\x52\x54\x61\x64\x66\x6c\x6e\x70\x75\x77\x79\x7e\x81\x82\x87\x88\x89\x8e\x90\x98\x99\x9a\xa1\xa6\xa7\xa8\xa9\xaa\xab\xac\xaf\xb1\xb2\xb6\xb9\xba\xbb\xbd\xbe\xc0\xc5\xc8\xcd\xcf\xd4\xd5\xd7\xda\xdb\xdc\xdd\xe1\xe4\xe6\xe8\xec\xee\xf4\xfc\xff",
+		"\x00\x03\x07\x0b\x0e\x0f\x10\x11\x14\x28\x29\x2a\x2f\x35\x36\x37\x38\x3b\x3d\x3e\x40\x43\x48\x4b\x50\x52\x54\x57\x5a\x60\x62\x67\x68\x6b\x71\x73\x74\x7d\x83\x85\x87\x8c\x8e\x91\x95\x9d\xa2\xa8\xa9\xab\xb1\xb4\xb8\xbd\xbe\xc1\xc7\xc8\xcb\xcd\xce\xcf\xd5\xd8\xd9\xdb\xdd\xde\xdf\xe0\xe3\xe9\xef\xf1\xf4\xf5\xf8\xf9\xfa\xfc\xfd\xff",
+		"\x02\x05\x06\x0c\x0e\x12\x13\x15\x17\x19\x1c\x24\x2c\x2e\x30\x32\x33\x34\x39\x47\x49\x4a\x4e\x51\x55\x56\x58\x59\x5a\x5c\x5e\x61\x63\x65\x69\x6a\x6e\x6f\x70\x75\x79\x7a\x7b\x7e\x7f\x81\x82\x88\x8a\x8f\x90\x94\x96\x97\x98\x99\x9a\xa3\xa4\xa5\xac\xaf\xb0\xb2\xc0\xc2\xc3\xc5\xca\xcc\xd2\xd3\xda\xdc\xe2\xe4\xe5\xe7\xed\xf3\xf7\xfe",
+		"\x01\x08\x09\x0a\x0d\x0f\x10\x14\x16\x1d\x1e\x1f\x20\x21\x23\x26\x27\x2b\x2d\x2f\x31\x3a\x3c\x41\x42\x44\x45\x4c\x4d\x4f\x53\x5b\x5d\x5f\x64\x66\x68\x6b\x6c\x6d\x72\x77\x78\x7c\x80\x83\x84\x86\x89\x8b\x8d\x92\x93\x9c\x9e\x9f\xa1\xa6\xaa\xae\xb3\xb5\xb6\xb9\xba\xbb\xbc\xbf\xc4\xc9\xd0\xd1\xd4\xd7\xe1\xe8\xea\xeb\xf0\xf2\xf6\xfb",
+		"\x01\x03\x04\x05\x07\x0b\x10\x11\x16\x18\x1a\x1b\x20\x22\x25\x26\x2a\x2d\x2f\x30\x3c\x3f\x40\x46\x48\x4b\x4c\x4f\x50\x57\x5f\x66\x69\x6a\x6f\x70\x76\x7a\x7f\x82\x83\x8b\x8c\x8d\x8e\x96\x9a\x9b\x9d\x9f\xa0\xa1\xa2\xa4\xa6\xa7\xa9\xad\xaf\xb6\xb7\xc3\xc6\xc8\xce\xd3\xd4\xd6\xd8\xd9\xe1\xe4\xe6\xeb\xec\xed\xee\xef\xf5\xf7\xf8\xfc",
+	},
+
+	// Group 19 '<'
+	{
+		"\x01\x08\x09\x0a\x0b\x0d\x0e\x13\x14\x17\x18\x1d\x26\x27\x29\x2a\x2d\x31\x33\x38\x39\x3b\x40\x42\x44\x47\x48\x4f\x54\x55\x59\x5a\x5c\x5e\x61\x66\x68\x70\x71\x72\x80\x83\x8b\x8c\x8d\x91\x94\x96\x9b\x9e\x9f\xa3\xa4\xa5\xab\xae\xb5\xb6\xb8\xba\xbb\xc0\xc1\xc8\xcc\xcd\xce\xd1\xd3\xd6\xd7\xdc\xe3\xe7\xeb\xef\xf1\xf3\xf7\xf9\xfb\xff",
+		"\x00\x03\x04\x05\x06\x0c\x0f\x11\x16\x1b\x1f\x22\x28\x2b\x2c\x2e\x2f\x30\x3a\x3d\x43\x49\x4a\x4b\x4d\x4e\x51\x52\x58\x5d\x60\x62\x63\x64\x67\x6e\x73\x75\x76\x79\x7b\x7d\x7f\x84\x88\x89\x8a\x90\x95\x99\x9c\xa0\xa2\xaa\xac\xad\xaf\xb0\xb1\xb3\xb9\xbc\xbd\xbf\xc2\xc3\xc6\xc7\xcb\xdb\xdd\xde\xe0\xe1\xe2\xe5\xe8\xea\xf0\xf5\xf6\xf8",
+		"\x02\x10\x12\x15\x19\x1a\x1c\x1e\x20\x21\x23\x24\x25\x32\x34\x35\x36\x37\x3c\x3e\x3f\x41\x45\x46\x4c\x50\x53\x56\x57\x5f\x65\x69\x6b\x6c\x6d\x6f\x74\x77\x78\x7c\x7e\x81\x82\x85\x86\x87\x8e\x8f\x92\x93\x97\x98\x9a\xa1\xa6\xa7\xa8\xa9\xb2\xb4\xb7\xbe\xc4\xc5\xc9\xca\xcf\xd2\xd4\xd5\xd8\xd9\xda\xdf\xe4\xe6\xe9\xed\xee\xfa\xfc\xfe",
+		"\x02\x05\x07\x0a\x11\x13\x16\x1b\x1c\x20\x29\x2a\x2b\x30\x31\x32\x33\x40\x42\x44\x46\x47\x48\x4e\x51\x57\x58\x5b\x5f\x62\x68\x6a\x6b\x6f\x72\x74\x77\x7a\x81\x82\x84\x8a\x8c\x8f\x91\x92\x95\x9a\x9c\x9d\xa0\xa3\xa6\xab\xac\xad\xae\xb1\xb3\xb9\xc2\xc4\xcc\xcd\xd0\xd4\xd5\xdb\xdc\xdd\xe1\xe2\xe3\xe4\xea\xec\xee\xf1\xf2\xf4\xf9\xfd",
+		"\x00\x06\x09\x0f\x17\x1a\x1f\x22\x23\x24\x28\x2c\x2d\x37\x39\x3b\x3c\x3d\x3e\x41\x43\x49\x4b\x4d\x50\x54\x56\x59\x5a\x5b\x5c\x5d\x5e\x60\x66\x6a\x6d\x70\x73\x75\x78\x79\x7a\x7c\x7e\x80\x83\x86\x87\x8e\x90\x9e\x9f\xa2\xa7\xa8\xa9\xaa\xb7\xba\xc1\xc3\xc6\xd1\xd6\xd7\xd8\xda\xdf\xe5\xe9\xec\xf2\xf3\xf4\xf5\xf6\xf7\xf8\xfb\xfc\xfe",
+		"\x01\x03\x04\x07\x08\x0b\x0c\x0d\x0e\x10\x12\x18\x19\x1d\x1e\x21\x25\x26\x27\x2e\x2f\x35\x36\x3a\x3f\x45\x4c\x4f\x52\x53\x61\x63\x64\x65\x67\x69\x6c\x71\x76\x7b\x7f\x85\x88\x89\x8b\x8d\x93\x96\x97\x9b\x9d\xa1\xa4\xa5\xaf\xb0\xb2\xb6\xb8\xbb\xbc\xbd\xbe\xbf\xc0\xc5\xc7\xc8\xca\xcb\xce\xcf\xd3\xd9\xde\xe7\xeb\xed\xef\xf0\xfd\xff",
+		"\x02\x05\x06\x08\x0f\x14\x15\x18\x1d\x1e\x25\x2f\x33\x34\x36\x38\x3b\x42\x49\x4a\x4c\x53\x55\x56\x57\x5a\x5e\x64\x65\x66\x67\x6e\x72\x78\x7b\x7c\x7d\x7e\x7f\x81\x85\x8a\x8d\x8f\x94\x96\x98\x99\x9b\xa2\xa9\xac\xad\xae\xaf\xb4\xb5\xb6\xb8\xb9\xc4\xc9\xcd\xd0\xd2\xd6\xda\xde\xe0\xe4\xe5\xe6\xe7\xe8\xeb\xf0\xf3\xf5\xf6\xf7\xfa\xfd",
+	},
+
+	// Group 20 '='
+	{
+		"\x00\x05\x08\x09\x0c\x0e\x10\x11\x12\x18\x19\x1c\x1f\x2f\x31\x34\x39\x3a\x3b\x40\x41\x43\x4d\x4e\x52\x53\x56\x57\x58\x5e\x60\x64\x65\x6b\x6f\x70\x71\x74\x75\x76\x78\x7c\x7f\x8a\x8f\x92\x96\x98\x99\x9a\x9b\x9f\xa4\xaa\xab\xaf\xb1\xb4\xb6\xb7\xb8\xba\xbc\xbd\xbf\xc1\xc5\xc7\xc9\xca\xcf\xd2\xd4\xd6\xd8\xe1\xe8\xec\xee\xf9\xfa\xfc",
+		"\x02\x03\x06\x07\x0a\x15\x16\x17\x1a\x1d\x21\x24\x25\x29\x2b\x30\x36\x37\x38\x3c\x42\x44\x46\x47\x48\x49\x4a\x4c\x51\x54\x5b\x5c\x5d\x62\x67\x69\x6a\x6e\x77\x79\x7a\x81\x82\x84\x8b\x8c\x91\x94\x95\x97\x9c\x9d\xa0\xa2\xad\xae\xb5\xbe\xc0\xc3\xc8\xcc\xcd\xd0\xd1\xd5\xd7\xd9\xdd\xe0\xe4\xe6\xe9\xea\xeb\xf2\xf3\xf5\xf6\xf8\xfd\xff",
+		"\x01\x04\x0b\x0d\x0f\x13\x14\x1b\x1e\x20\x22\x23\x26\x28\x2a\x2c\x2d\x32\x33\x35\x3d\x3f\x45\x4f\x50\x55\x5a\x5f\x61\x63\x66\x68\x6c\x6d\x72\x7b\x7d\x7e\x80\x83\x85\x86\x87\x88\x89\x90\x93\x9e\xa1\xa3\xa5\xa6\xa8\xa9\xac\xb0\xb2\xb3\xb9\xc2\xc4\xc6\xcb\xce\xd3\xda\xdb\xdc\xde\xdf\xe2\xe3\xe5\xe7\xed\xef\xf0\xf1\xf4\xf7\xfb\xfe",
+		"\x00\x01\x0c\x0d\x12\x13\x14\x1a\x20\x27\x28\x2d\x2e\x34\x3c\x3e\x40\x41\x42\x45\x48\x4b\x4c\x52\x58\x59\x5c\x61\x62\x67\x69\x6a\x6d\x70\x71\x73\x74\x76\x79\x7b\x7c\x7e\x84\x89\x8d\x8e\x8f\x91\x99\x9c\x9f\xa0\xa1\xa2\xa3\xa4\xa5\xa6\xa7\xaa\xad\xbb\xc0\xc2\xc8\xcb\xcc\xcd\xcf\xd7\xdc\xe2\xe3\xe6\xeb\xed\xf0\xf1\xf6\xf7\xfb\xfd",
+		"\x02\x04\x05\x06\x09\x10\x11\x16\x17\x18\x19\x1c\x1d\x1e\x1f\x22\x27\x2a\x2b\x2c\x2e\x33\x35\x38\x3b\x3e\x43\x44\x47\x49\x4e\x4f\x51\x54\x55\x5a\x5d\x5e\x5f\x60\x6b\x6c\x6f\x72\x77\x7f\x81\x82\x87\x8a\x8d\x95\x9e\xa7\xa9\xab\xac\xb1\xb3\xbe\xc3\xc5\xc7\xca\xce\xd0\xd1\xd2\xd3\xd4\xd5\xd6\xdb\xdd\xe4\xe8\xe9\xf2\xf3\xf4\xf8\xfc",
+		"\x03\x07\x08\x0a\x0b\x0f\x21\x23\x24\x25\x26\x29\x2f\x30\x31\x36\x37\x39\x3a\x3d\x46\x4a\x4b\x53\x56\x59\x63\x64\x65\x66\x68\x73\x75\x78\x7a\x7d\x86\x88\x8b\x8e\x90\x92\x93\x94\x96\x97\x98\x9a\x9b\xa8\xaf\xb2\xb4\xb5\xb6\xb7\xb8\xb9\xba\xbb\xbc\xbd\xbf\xc1\xc4\xc6\xc9\xd8\xd9\xda\xde\xdf\xe0\xe1\xe5\xe7\xea\xec\xef\xf5\xfe\xff",
+		"\x01\x06\x0b\x0d\x0e\x12\x13\x15\x19\x1b\x1d\x1e\x21\x27\x29\x2a\x2b\x2c\x32\x34\x35\x36\x39\x3a\x3d\x3f\x42\x45\x48\x4a\x4d\x4f\x50\x55\x57\x58\x5b\x5c\x62\x63\x65\x66\x6e\x70\x71\x74\x80\x82\x83\x85\x87\x8c\x8e\x92\x95\x98\x9d\xa4\xa7\xa9\xae\xaf\xb0\xb4\xb9\xbe\xc9\xce\xd1\xd3\xd6\xd8\xdc\xde\xe2\xe3\xee\xf3\xf7\xf9\xfa\xfd",
+	},
+
+	// Group 21 '>'
+	{
+		"\x02\x04\x0a\x0f\x11\x15\x17\x1b\x1e\x24\x29\x2a\x32\x33\x38\x3b\x3c\x3e\x3f\x41\x44\x4c\x4d\x53\x54\x5b\x62\x63\x65\x67\x69\x6a\x6b\x6c\x6f\x75\x76\x78\x79\x7d\x7f\x81\x82\x85\x89\x8b\x8c\x8e\x95\x97\x9b\x9c\xa0\xa2\xa6\xa9\xab\xac\xad\xb2\xb3\xb5\xb7\xba\xbb\xbd\xbf\xc0\xc6\xcb\xcc\xdb\xe2\xe3\xe4\xe7\xe8\xef\xf0\xf1\xfc\xfd",
+		"\x01\x05\x0b\x0c\x0d\x0e\x12\x16\x19\x1a\x1d\x1f\x20\x27\x2b\x2c\x2e\x2f\x36\x37\x3a\x43\x46\x48\x49\x4a\x4b\x52\x55\x56\x59\x5c\x5e\x61\x66\x6e\x70\x72\x73\x74\x7a\x7c\x7e\x84\x86\x88\x8f\x90\x94\x96\x99\x9a\x9d\x9e\xa3\xa5\xa8\xb4\xb8\xb9\xc1\xc8\xc9\xca\xcf\xd1\xd2\xd3\xd5\xd7\xd9\xda\xdc\xdd\xde\xe0\xed\xee\xf2\xf3\xfa\xff",
+		"\x03\x06\x07\x08\x09\x10\x13\x14\x18\x1c\x21\x22\x23\x25\x26\x28\x2d\x31\x34\x35\x39\x3d\x40\x47\x4f\x50\x51\x57\x58\x5a\x5d\x5f\x64\x68\x6d\x71\x77\x7b\x80\x83\x8a\x8d\x91\x92\x98\x9f\xa1\xa4\xa7\xaa\xae\xaf\xb0\xb1\xb6\xbe\xc2\xc3\xc4\xc5\xc7\xcd\xce\xd0\xd4\xd6\xd8\xdf\xe1\xe5\xe6\xe9\xea\xeb\xec\xf4\xf5\xf7\xf8\xf9\xfb\xfe",
+		"\x00\x01\x0b\x0e\x0f\x10\x11\x13\x1a\x1d\x26\x29\x2c\x2e\x30\x33\x36\x37\x3a\x3c\x40\x41\x42\x44\x45\x4c\x4d\x4e\x50\x51\x55\x56\x57\x58\x59\x5c\x5f\x60\x63\x64\x69\x6b\x6f\x70\x74\x7e\x81\x86\x87\x8e\x92\x93\x98\x9f\xa3\xa4\xa5\xa6\xa9\xac\xae\xb0\xbc\xbd\xbe\xc1\xc4\xc6\xca\xcd\xce\xd2\xd7\xe5\xe6\xe9\xea\xee\xf0\xf5\xf6\xf7",
+		"\x00\x03\x0c\x0d\x16\x17\x18\x19\x1b\x1e\x20\x24\x27\x2b\x2d\x2f\x30\x31\x38\x39\x3d\x42\x45\x46\x4b\x4f\x5a\x5e\x60\x65\x66\x67\x68\x6a\x6c\x6d\x6e\x71\x72\x73\x76\x77\x79\x7d\x7f\x82\x83\x89\x8d\x93\x94\x95\x96\x9c\x9e\xaa\xad\xb2\xb3\xb4\xb5\xb7\xba\xbc\xc0\xc2\xc3\xc9\xd0\xd8\xdb\xdf\xed\xef\xf1\xf8\xfa\xfb\xfc\xfd\xfe\xff",
+		"\x02\x04\x05\x06\x07\x08\x09\x0a\x12\x14\x1c\x1f\x21\x22\x23\x25\x28\x32\x34\x35\x3b\x3e\x3f\x43\x47\x48\x49\x4e\x53\x54\x5b\x5d\x61\x62\x75\x78\x7b\x7c\x80\x85\x87\x88\x8a\x8b\x8c\x90\x97\x99\x9a\x9b\x9d\xa1\xa2\xa7\xa8\xab\xb6\xb8\xb9\xbf\xc5\xc7\xcb\xcc\xcf\xd3\xd4\xd5\xd6\xd9\xda\xdc\xe0\xe1\xe2\xe3\xe4\xe7\xeb\xec\xf2\xf9",
+		"\x08\x0d\x10\x11\x13\x14\x15\x19\x25\x28\x2a\x31\x36\x39\x3a\x3e\x3f\x41\x49\x4a\x4c\x4e\x51\x52\x53\x55\x57\x5e\x61\x66\x67\x6e\x6f\x70\x71\x7a\x7f\x83\x84\x86\x8d\x8f\x91\x98\x99\x9c\xa0\xa1\xa6\xaa\xaf\xb1\xb4\xb6\xba\xbb\xbc\xbe\xc3\xc5\xc7\xc8\xcb\xcc\xcd\xce\xd1\xd2\xda\xdd\xde\xe5\xe7\xe8\xe9\xea\xf0\xf3\xf4\xf6\xf9\xfd",
+	},
+
+	// Group 22 '?'
+	{
+		"\x00\x01\x02\x0a\x0b\x13\x14\x16\x17\x18\x1c\x20\x28\x2e\x32\x33\x34\x37\x38\x39\x3e\x40\x42\x47\x48\x4a\x4d\x53\x55\x56\x57\x58\x5a\x5b\x62\x64\x67\x6f\x73\x83\x84\x86\x89\x90\x91\x92\x9b\x9c\xa2\xa5\xa6\xa7\xa9\xac\xae\xaf\xb3\xb4\xb5\xb8\xb9\xc4\xc5\xc8\xca\xd4\xd6\xd7\xd8\xdf\xe2\xe3\xe5\xe7\xed\xee\xf4\xf8\xfa\xfb\xfe\xff",
+		"\x03\x05\x07\x08\x0d\x0f\x11\x1b\x24\x25\x26\x29\x2a\x2d\x2f\x30\x31\x35\x3a\x3b\x3d\x41\x45\x46\x4b\x4c\x4e\x4f\x50\x52\x5c\x5d\x61\x65\x69\x70\x74\x75\x76\x77\x78\x7a\x7b\x7c\x81\x82\x87\x88\x8a\x8c\x95\x9d\x9e\xa0\xa8\xb0\xb2\xba\xbb\xbc\xbd\xbf\xc3\xc6\xcb\xcd\xcf\xd2\xd3\xd5\xd9\xdb\xdd\xde\xe0\xe4\xe9\xec\xf1\xf3\xf7\xfc",
+		"\x04\x06\x09\x0c\x0e\x10\x12\x15\x19\x1a\x1d\x1e\x1f\x21\x22\x27\x2b\x2c\x36\x3c\x3f\x43\x44\x51\x54\x59\x5e\x5f\x66\x68\x6a\x6b\x6c\x6d\x6e\x71\x72\x79\x7d\x7e\x7f\x80\x85\x8b\x8d\x8e\x8f\x93\x94\x97\x98\x99\xa1\xa3\xa4\xaa\xab\xad\xb1\xb7\xbe\xc0\xc1\xc2\xc7\xc9\xcc\xce\xd0\xd1\xda\xdc\xe1\xe6\xe8\xea\xef\xf0\xf2\xf5\xf6\xf9",
+		"\x04\x0e\x0f\x10\x11\x13\x16\x17\x19\x1d\x22\x23\x25\x2a\x32\x33\x3d\x3e\x49\x4d\x52\x58\x59\x5a\x5e\x60\x61\x63\x66\x68\x6c\x72\x75\x76\x77\x78\x7a\x7d\x7f\x84\x8b\x8c\x8e\x90\x91\x93\x96\x99\x9a\x9f\xa2\xa6\xa8\xac\xae\xaf\xb6\xbc\xbd\xbe\xc1\xc6\xc8\xcd\xce\xcf\xd1\xd2\xd6\xd7\xd8\xdc\xdf\xe6\xe8\xea\xeb\xf1\xf4\xf6\xf9\xfd",
+		"\x00\x07\x0a\x0b\x0c\x0d\x1a\x1e\x21\x23\x24\x26\x28\x2b\x2f\x30\x31\x35\x36\x3a\x3c\x44\x45\x49\x4a\x4e\x4f\x50\x53\x54\x56\x5d\x60\x63\x65\x6d\x6f\x71\x73\x7c\x81\x83\x85\x87\x8a\x95\x97\x9a\x9b\x9c\x9e\xa4\xa5\xa7\xa9\xab\xad\xb0\xb3\xb4\xb6\xb7\xb8\xb9\xbb\xc0\xc4\xc5\xc9\xd0\xd9\xda\xe0\xe1\xeb\xee\xf0\xf3\xf7\xfd\xfe\xff",
+		"\x02\x03\x05\x06\x08\x12\x14\x15\x18\x1c\x20\x27\x2c\x2d\x34\x37\x39\x40\x41\x42\x43\x47\x48\x4b\x4c\x51\x55\x57\x5b\x5c\x5f\x62\x64\x67\x69\x6a\x6b\x6e\x70\x7b\x7e\x82\x88\x89\x8f\x92\x94\x96\x98\x9d\x9f\xa0\xa1\xa3\xaa\xb2\xb5\xba\xbf\xc2\xc3\xc7\xca\xcc\xd3\xd4\xd5\xde\xe2\xe3\xe4\xe5\xe7\xe9\xec\xed\xef\xf2\xf8\xfa\xfb\xfc",
+		"\x00\x01\x05\x07\x09\x0a\x1b\x1c\x1d\x1f\x20\x21\x24\x29\x2b\x2c\x2e\x30\x33\x38\x3b\x3d\x3f\x42\x45\x46\x47\x52\x5b\x5c\x60\x61\x63\x65\x68\x70\x74\x75\x79\x80\x83\x86\x8b\x8d\x93\x94\x9b\x9d\xa2\xa3\xa4\xa5\xab\xac\xaf\xb1\xb7\xbb\xc3\xcb\xce\xd0\xd1\xd3\xd4\xd6\xd8\xdb\xdc\xdd\xe1\xe3\xe5\xe6\xe7\xe9\xf0\xf1\xf5\xf9\xfa\xfb",
+	},
+
+	// Group 23 '@'
+	{
+		"\x06\x0b\x0e\x15\x1a\x1c\x1e\x24\x25\x2b\x34\x35\x37\x39\x3e\x3f\x40\x42\x46\x4a\x4d\x54\x58\x59\x5c\x5d\x5f\x60\x61\x62\x63\x64\x65\x67\x69\x6c\x6f\x70\x71\x74\x77\x82\x85\x8b\x8d\x97\x98\x9a\x9b\x9c\xa0\xa4\xa9\xab\xac\xb0\xb2\xb3\xb8\xbb\xbd\xbf\xc2\xc3\xc4\xcc\xd2\xd5\xd7\xd9\xda\xdc\xdd\xde\xdf\xe3\xe9\xeb\xee\xef\xf2\xfc",
+		"\x03\x04\x09\x0c\x0f\x13\x17\x18\x19\x1f\x28\x2a\x2c\x2d\x2e\x31\x36\x38\x3c\x41\x47\x48\x49\x4c\x51\x56\x57\x5b\x5e\x6a\x6b\x78\x79\x7a\x7d\x7e\x81\x84\x87\x8a\x8c\x8e\x8f\x90\x92\x94\x9d\x9f\xa1\xa5\xa6\xa7\xa8\xad\xae\xb1\xb4\xb6\xbc\xbe\xc0\xc6\xc7\xc9\xcb\xd0\xd3\xd8\xe1\xe2\xe6\xe7\xe8\xea\xed\xf3\xf4\xf6\xf8\xf9\xfb\xff",
+		"\x00\x02\x05\x07\x08\x0a\x0d\x10\x11\x12\x14\x16\x1b\x1d\x20\x21\x22\x23\x26\x27\x29\x2f\x30\x33\x3a\x3b\x3d\x43\x44\x45\x4b\x4e\x50\x52\x53\x55\x5a\x66\x68\x6d\x72\x73\x75\x76\x7b\x7c\x7f\x80\x83\x86\x88\x89\x91\x93\x95\x96\x99\x9e\xa3\xaa\xaf\xb5\xb7\xba\xc1\xc5\xc8\xca\xcd\xce\xd6\xdb\xe4\xe5\xec\xf0\xf1\xf5\xf7\xfa\xfd\xfe",
+		"\x01\x09\x0d\x0e\x13\x16\x1c\x21\x2b\x2f\x32\x33\x35\x39\x3e\x41\x42\x4f\x50\x54\x57\x5c\x5d\x61\x62\x63\x64\x65\x67\x68\x6c\x6e\x6f\x70\x74\x76\x79\x7b\x7e\x80\x86\x88\x8b\x8d\x90\x93\x96\x98\x9c\x9e\xa0\xa2\xa6\xaa\xab\xad\xb3\xb9\xbf\xc0\xc3\xc4\xc6\xc8\xcd\xce\xcf\xd0\xd1\xd3\xd4\xd5\xd7\xd8\xdb\xdc\xdf\xe0\xe3\xe6\xe7\xf3",
+		"\x00\x02\x03\x04\x05\x06\x07\x08\x0a\x0c\x12\x19\x1a\x1b\x1e\x20\x22\x23\x25\x26\x2c\x2d\x30\x31\x32\x37\x3f\x40\x44\x45\x46\x48\x4a\x4d\x53\x55\x56\x58\x69\x6b\x6d\x75\x77\x78\x7a\x7d\x83\x89\x8a\x8c\x8e\x9a\x9d\xa3\xa4\xa7\xa8\xae\xaf\xb0\xb1\xb4\xb5\xbb\xbd\xc1\xc2\xc7\xc9\xca\xcb\xcf\xd1\xd4\xe4\xee\xf2\xf6\xf7\xf8\xfb\xfd",
+		"\x01\x0b\x0f\x10\x11\x14\x15\x18\x1d\x1f\x24\x29\x2a\x34\x36\x38\x3a\x3b\x3c\x3d\x43\x47\x4b\x4c\x4f\x51\x5a\x5f\x60\x66\x6a\x6e\x72\x73\x7c\x81\x82\x84\x85\x87\x91\x92\x94\x95\x97\x99\x9b\x9f\xa2\xa5\xa9\xac\xb2\xb6\xb9\xbc\xbe\xc5\xcc\xd2\xd6\xd9\xda\xdd\xde\xe0\xe1\xe5\xe8\xe9\xea\xeb\xec\xed\xef\xf0\xf5\xf9\xfa\xfc\xfe\xff",
+		"\x00\x01\x07\x08\x0e\x11\x15\x16\x17\x18\x22\x27\x28\x2d\x2e\x2f\x30\x39\x3d\x44\x45\x49\x4a\x4d\x4e\x50\x52\x59\x5b\x5e\x5f\x67\x68\x69\x6d\x71\x72\x74\x76\x79\x7f\x82\x85\x87\x88\x8b\x8c\x8e\x8f\x91\x96\x9a\x9d\x9e\xa1\xa2\xa8\xaa\xb0\xb1\xb2\xb3\xb6\xb7\xb8\xba\xbc\xbe\xc4\xc7\xc9\xcd\xd2\xd7\xda\xdf\xe2\xe6\xea\xf0\xf1\xf4",
+	},
+
+	// Group 24 'A'
+	{
+		"\x01\x02\x05\x07\x09\x0b\x12\x1a\x1c\x1d\x27\x2c\x2f\x36\x37\x3a\x3b\x3d\x3e\x42\x43\x44\x45\x46\x49\x4a\x4b\x4e\x4f\x54\x55\x58\x5a\x5c\x5d\x60\x63\x68\x6d\x6e\x71\x74\x75\x77\x78\x7a\x7c\x80\x82\x84\x87\x89\x8c\x90\x95\x9a\x9b\xac\xb1\xb3\xb4\xb7\xbf\xc2\xc4\xc9\xd0\xd1\xd2\xd3\xd6\xd7\xd8\xde\xe2\xe4\xe5\xe9\xf4\xf5\xf8\xfe",
+		"\x06\x0e\x10\x11\x17\x1f\x20\x21\x22\x23\x24\x25\x26\x2a\x2b\x2e\x30\x31\x38\x39\x40\x47\x50\x51\x56\x5e\x5f\x6a\x6b\x70\x73\x76\x79\x7e\x7f\x8a\x8d\x8f\x91\x93\x94\x96\x97\x98\x99\x9c\xa0\xa1\xa2\xa3\xa8\xa9\xad\xaf\xb0\xb2\xb6\xbc\xbe\xc8\xca\xcc\xcd\xce\xcf\xda\xdc\xdd\xe3\xe7\xea\xeb\xec\xee\xef\xf0\xf1\xf6\xf9\xfc\xfd\xff",
+		"\x00\x04\x08\x0c\x0d\x0f\x13\x14\x15\x16\x18\x19\x1b\x1e\x28\x29\x2d\x32\x33\x34\x35\x3c\x3f\x41\x48\x4c\x4d\x52\x53\x57\x59\x5b\x61\x62\x64\x65\x66\x67\x69\x6c\x6f\x72\x7d\x81\x86\x88\x8b\x92\x9e\x9f\xa4\xa5\xa6\xa7\xaa\xab\xae\xb5\xb8\xbb\xbd\xc0\xc1\xc3\xc5\xc6\xc7\xcb\xd4\xd5\xd9\xdb\xdf\xe0\xe6\xe8\xed\xf2\xf3\xf7\xfa\xfb",
+		"\x03\x04\x06\x0a\x18\x1c\x1d\x1f\x24\x2c\x2f\x30\x38\x41\x44\x48\x4b\x4c\x4d\x51\x53\x55\x59\x5d\x60\x63\x69\x6a\x6c\x6d\x75\x77\x7b\x7c\x7d\x7f\x83\x85\x88\x8b\x8c\x8e\x90\x91\x92\x96\x9a\x9d\xa1\xa6\xb2\xb5\xb7\xb8\xb9\xba\xbc\xbd\xc0\xc2\xc5\xc7\xc9\xcb\xcf\xd1\xd6\xd7\xd9\xdb\xe1\xe2\xe5\xeb\xf2\xf3\xf7\xfa\xfb\xfc\xfd\xff",
+		"\x00\x03\x08\x09\x0b\x0c\x10\x15\x17\x1a\x20\x21\x22\x25\x29\x2b\x2e\x31\x3a\x3c\x3d\x42\x43\x46\x49\x4f\x50\x52\x54\x5f\x61\x64\x67\x6b\x71\x72\x78\x79\x7b\x7e\x85\x87\x89\x8e\x8f\x93\x97\x98\x9d\x9e\xa4\xa7\xa8\xa9\xab\xae\xb0\xb3\xb6\xb9\xba\xc6\xc8\xce\xd4\xd8\xdd\xde\xdf\xe0\xe1\xe4\xe6\xe8\xe9\xea\xec\xed\xef\xf6\xf9\xfe",
+		"\x01\x0a\x0d\x0e\x0f\x11\x13\x16\x1b\x23\x26\x2a\x32\x33\x34\x36\x37\x39\x3b\x3e\x3f\x40\x45\x4e\x56\x57\x58\x5a\x5b\x5c\x5e\x65\x66\x68\x6e\x6f\x70\x73\x74\x76\x7a\x80\x82\x83\x86\x8d\x94\x95\x99\x9b\x9c\x9f\xa0\xa2\xa5\xaa\xac\xad\xb1\xb4\xbb\xbe\xbf\xc1\xc3\xc4\xca\xcc\xcd\xd0\xd2\xd3\xd5\xda\xdc\xe3\xe7\xee\xf1\xf4\xf5\xf8",
+		"\x02\x04\x05\x07\x09\x0a\x0b\x0e\x12\x14\x18\x19\x1b\x1d\x1e\x22\x24\x26\x27\x28\x29\x2b\x2d\x2e\x33\x35\x39\x3a\x3d\x46\x47\x48\x4a\x4d\x51\x53\x56\x62\x69\x6a\x6c\x71\x75\x76\x7b\x7c\x7d\x7f\x81\x82\x84\x89\x8a\x8f\x91\x96\x99\xa0\xa3\xa4\xa8\xab\xad\xae\xaf\xb0\xb9\xbd\xc3\xc5\xc6\xc7\xd3\xd5\xed\xef\xf0\xf3\xf6\xf9\xfc\xfe",
+	},
+
+	// Group 25 'B'
+	{
+		"\x03\x05\x09\x10\x11\x16\x19\x1b\x1c\x1d\x1e\x21\x23\x2c\x2f\x32\x33\x37\x40\x42\x46\x47\x4a\x4c\x4f\x56\x5b\x5c\x62\x63\x65\x67\x6b\x6d\x70\x72\x76\x79\x7a\x7b\x7c\x7e\x83\x87\x88\x89\x8b\x8d\x8e\x8f\x99\x9e\xa0\xa2\xa3\xa9\xae\xb4\xb5\xbb\xbe\xbf\xc0\xc2\xc3\xc4\xc8\xca\xcb\xcd\xcf\xd0\xd2\xd8\xe0\xe7\xe9\xec\xf3\xf4\xfb\xfc",
+		"\x00\x01\x07\x08\x0d\x0e\x12\x13\x17\x18\x1a\x1f\x22\x24\x26\x28\x2a\x2d\x2e\x34\x35\x39\x3a\x3b\x3e\x3f\x41\x48\x4b\x54\x5a\x5f\x60\x64\x66\x68\x6c\x74\x78\x7f\x80\x84\x8a\x90\x94\x95\x97\x9b\x9c\xa1\xa4\xaa\xac\xad\xaf\xb0\xb2\xb3\xb6\xb8\xc5\xcc\xd1\xd3\xd4\xd5\xd6\xd7\xd9\xda\xdd\xde\xdf\xe1\xe4\xe5\xe6\xed\xee\xef\xf0\xfe",
+		"\x02\x04\x06\x0b\x0c\x0f\x14\x15\x20\x25\x27\x29\x2b\x30\x31\x38\x3c\x3d\x43\x44\x45\x4d\x4e\x50\x51\x52\x53\x55\x57\x58\x59\x5d\x5e\x61\x6a\x6e\x6f\x71\x73\x77\x7d\x81\x82\x86\x8c\x91\x92\x93\x98\x9a\x9d\x9f\xa5\xa6\xa7\xa8\xab\xb1\xb7\xb9\xba\xbc\xbd\xc1\xc6\xc7\xc9\xce\xdb\xe2\xe3\xe8\xea\xeb\xf1\xf2\xf6\xf8\xf9\xfa\xfd\xff",
+		"\x05\x08\x09\x0a\x0d\x13\x18\x19\x26\x27\x28\x2b\x2f\x31\x35\x36\x3a\x3d\x3e\x40\x42\x47\x49\x4b\x4d\x50\x51\x59\x5d\x62\x64\x66\x68\x69\x6d\x70\x72\x73\x75\x77\x7f\x83\x85\x89\x8c\x90\x96\x9e\xa0\xa3\xa4\xaa\xab\xaf\xb0\xb1\xb3\xb4\xb7\xb8\xc2\xc7\xc8\xc9\xd0\xd1\xd3\xd4\xd7\xdc\xdd\xdf\xe1\xe5\xe7\xea\xee\xf0\xf1\xf5\xf7\xfe",
+		"\x00\x02\x03\x04\x07\x0a\x0b\x0e\x0f\x11\x15\x1f\x23\x24\x29\x30\x32\x33\x34\x36\x3b\x3f\x44\x46\x49\x4e\x52\x5a\x5e\x65\x69\x6b\x6c\x6f\x71\x76\x78\x7a\x7e\x81\x85\x87\x8d\x8e\x8f\x92\x93\x94\x95\x9b\x9c\x9d\xa6\xa8\xac\xad\xae\xb6\xba\xbb\xbf\xc0\xc1\xca\xcd\xce\xcf\xd8\xdc\xde\xe0\xe2\xe4\xe9\xec\xf2\xf3\xf4\xf5\xf6\xf8\xfc",
+		"\x01\x06\x0c\x10\x16\x17\x1a\x1b\x1c\x1d\x20\x21\x22\x25\x2a\x2d\x2e\x37\x38\x3c\x43\x48\x4a\x4c\x4f\x53\x54\x55\x56\x57\x58\x5b\x5c\x5f\x61\x63\x67\x6a\x6e\x74\x75\x7b\x7d\x80\x84\x86\x88\x8b\x91\x97\x99\x9a\xa2\xa5\xa7\xa9\xb2\xb5\xb9\xbc\xbd\xbe\xc3\xc4\xc5\xcc\xd2\xd5\xd6\xd9\xda\xdb\xe6\xeb\xed\xef\xf7\xf9\xfa\xfb\xfd\xff",
+		"\x02\x03\x04\x07\x10\x11\x12\x14\x18\x1e\x1f\x21\x23\x24\x25\x28\x2a\x2c\x31\x38\x39\x3b\x3f\x41\x45\x48\x4c\x4e\x51\x56\x57\x59\x5c\x5f\x60\x68\x6c\x6d\x6f\x75\x79\x7a\x7c\x7f\x82\x8a\x8c\x90\x91\x95\x96\x97\x98\x99\x9f\xa1\xa2\xa4\xa9\xab\xb3\xb6\xba\xbc\xc1\xc2\xc5\xc6\xc9\xcb\xcc\xcf\xd4\xd8\xd9\xda\xe2\xe3\xe4\xe8\xe9\xf3",
+	},
+
+	// Group 26 'C'
+	{
+		"\x02\x08\x0a\x0c\x0f\x10\x13\x15\x17\x18\x19\x22\x24\x25\x27\x2a\x31\x39\x3a\x3b\x45\x46\x48\x4a\x4d\x4f\x51\x53\x57\x58\x5d\x5e\x65\x68\x69\x6a\x6c\x6e\x74\x7b\x85\x86\x87\x88\x89\x8b\x8d\x8e\x8f\x92\x93\x9d\x9f\xa0\xad\xb3\xb4\xb8\xbb\xbd\xc1\xc2\xc3\xc4\xc9\xcf\xd0\xd5\xd8\xd9\xda\xdb\xdd\xe3\xe4\xe5\xec\xf7\xfa\xfd\xfe\xff",
+		"\x00\x04\x09\x0d\x11\x14\x16\x1c\x1d\x1f\x20\x23\x28\x2c\x2d\x2f\x30\x33\x34\x36\x38\x3c\x3f\x4c\x4e\x50\x52\x54\x56\x59\x5a\x5b\x60\x67\x6b\x72\x73\x75\x76\x78\x7d\x7e\x7f\x83\x84\x8a\x8c\x94\x97\x9b\x9e\xa4\xa5\xab\xac\xaf\xb2\xb5\xb7\xbe\xbf\xc6\xc8\xcb\xcd\xce\xd1\xd3\xd6\xd7\xde\xe0\xe7\xe8\xe9\xea\xee\xef\xf1\xf6\xfb\xfc",
+		"\x01\x05\x06\x07\x0e\x12\x1a\x1b\x1e\x21\x26\x29\x2b\x2e\x32\x35\x37\x3d\x3e\x40\x41\x42\x44\x47\x49\x4b\x55\x5c\x5f\x61\x62\x63\x64\x66\x6d\x6f\x70\x71\x79\x7a\x7c\x80\x81\x82\x90\x91\x95\x96\x98\x99\x9a\x9c\xa2\xa3\xa6\xa8\xa9\xae\xb0\xb1\xb6\xba\xbc\xc0\xc5\xc7\xcc\xd2\xdc\xdf\xe1\xe2\xe6\xeb\xed\xf0\xf2\xf3\xf4\xf5\xf8\xf9",
+		"\x01\x03\x06\x09\x0b\x0c\x0e\x12\x13\x16\x17\x1a\x1f\x28\x29\x2a\x2e\x2f\x32\x33\x35\x36\x41\x43\x48\x49\x4b\x4f\x51\x58\x5b\x5d\x5e\x5f\x61\x64\x69\x6a\x75\x76\x77\x78\x82\x83\x90\x92\x93\x95\x96\x97\x99\x9d\xa1\xa7\xa8\xaa\xab\xaf\xb0\xb4\xb5\xb7\xb9\xbc\xc1\xc5\xc9\xca\xcb\xd4\xda\xdf\xe7\xea\xeb\xed\xef\xf3\xf8\xfc\xfd\xfe",
+		"\x02\x07\x0b\x0d\x0f\x10\x11\x14\x19\x1b\x20\x23\x24\x26\x2c\x2d\x31\x37\x3d\x3e\x43\x45\x46\x47\x4e\x50\x54\x55\x56\x57\x5a\x5c\x60\x63\x67\x68\x6c\x6e\x6f\x70\x71\x74\x7d\x85\x8a\x8b\x8d\x8e\x91\x94\x9e\xac\xae\xb2\xb6\xb9\xba\xbd\xc0\xc4\xcc\xcd\xcf\xd3\xd4\xd6\xd9\xdb\xdc\xe0\xe2\xe3\xe4\xe6\xec\xee\xf0\xf4\xf5\xf9\xfa\xff",
+		"\x00\x05\x08\x0a\x15\x18\x1c\x1e\x21\x22\x25\x27\x30\x34\x39\x3a\x3b\x3c\x3f\x42\x4a\x4c\x4d\x53\x59\x65\x6d\x72\x73\x77\x79\x7a\x7b\x7c\x7e\x7f\x80\x86\x87\x88\x89\x8c\x8f\x98\x9a\x9b\x9c\x9f\xa0\xa2\xa3\xa5\xa6\xa7\xa9\xaa\xad\xb1\xb3\xb8\xbb\xbe\xbf\xc2\xc3\xc6\xc7\xc8\xca\xce\xd0\xd1\xd2\xd7\xd8\xdd\xe1\xe5\xe9\xf1\xf6\xfb",
+		"\x03\x04\x06\x07\x09\x0c\x13\x15\x1c\x1d\x20\x25\x29\x2b\x34\x37\x38\x3c\x40\x42\x44\x45\x46\x47\x4b\x4c\x4e\x52\x54\x60\x62\x66\x69\x6a\x6b\x6e\x72\x75\x7b\x7c\x7e\x7f\x81\x84\x8b\x8d\x91\x93\x9e\xa1\xa3\xa4\xac\xae\xb0\xb3\xbf\xc2\xc3\xc7\xc8\xcb\xcc\xcd\xcf\xd5\xda\xdc\xde\xe0\xe5\xe6\xe8\xec\xed\xee\xf2\xf4\xf6\xf7\xf9\xfe",
+	},
+
+	// Group 27 'D'
+	{
+		"\x02\x05\x06\x0c\x0d\x0e\x10\x12\x13\x18\x19\x1c\x1f\x26\x27\x29\x34\x37\x38\x39\x3d\x42\x44\x46\x49\x4d\x4e\x55\x57\x58\x60\x63\x69\x6d\x71\x7a\x80\x81\x85\x86\x88\x89\x8b\x8f\x92\x96\x97\x9a\x9c\xa0\xa3\xa5\xa7\xa9\xaa\xac\xad\xaf\xb3\xb5\xb7\xb9\xba\xc4\xc5\xca\xce\xd0\xd5\xd7\xda\xdf\xe2\xe7\xea\xed\xee\xef\xf0\xf1\xf9\xfe",
+		"\x01\x08\x0a\x0f\x15\x16\x17\x1b\x1d\x1e\x23\x28\x2a\x2d\x2e\x2f\x30\x32\x33\x3f\x45\x4b\x4c\x51\x5a\x5b\x5c\x5f\x62\x66\x6a\x6b\x70\x72\x74\x78\x79\x7e\x7f\x84\x8a\x8c\x8d\x90\x93\x95\x99\x9b\x9d\xa1\xa2\xa4\xa6\xbb\xbc\xbd\xbf\xc0\xc1\xc2\xc6\xc7\xc8\xcc\xcf\xd1\xd2\xd3\xd4\xd6\xd9\xdc\xde\xe1\xe4\xe8\xeb\xf3\xfa\xfb\xfc\xff",
+		"\x00\x04\x07\x09\x0b\x11\x14\x1a\x20\x21\x24\x25\x2b\x2c\x35\x36\x3a\x3b\x3c\x40\x41\x43\x47\x48\x4a\x4f\x50\x52\x53\x54\x56\x59\x5d\x5e\x61\x64\x65\x68\x6e\x6f\x73\x75\x76\x77\x7b\x7c\x7d\x82\x83\x87\x8e\x91\x94\x98\x9e\x9f\xa8\xab\xae\xb0\xb1\xb4\xb6\xbe\xc3\xc9\xcb\xcd\xd8\xdb\xdd\xe0\xe3\xe5\xe6\xe9\xec\xf2\xf5\xf7\xf8\xfd",
+		"\x01\x02\x03\x04\x05\x10\x13\x16\x1b\x1c\x20\x22\x27\x28\x2c\x31\x34\x35\x37\x3e\x44\x45\x4a\x4b\x4d\x4e\x51\x52\x58\x5b\x5d\x66\x67\x68\x69\x6b\x6c\x70\x73\x75\x76\x78\x7e\x88\x8a\x8b\x91\x96\x99\x9c\x9d\x9e\xa0\xa5\xaa\xaf\xb2\xb3\xb5\xb7\xb8\xbc\xbe\xbf\xc3\xd0\xd1\xd3\xd5\xd6\xd9\xdb\xe1\xe6\xe8\xea\xeb\xf0\xf4\xf5\xf6\xf8",
+		"\x03\x06\x07\x0a\x0c\x0f\x11\x14\x18\x19\x1a\x1e\x22\x24\x2a\x2b\x2d\x31\x36\x3b\x3c\x43\x46\x47\x48\x4c\x57\x59\x5a\x5e\x62\x63\x67\x6c\x6d\x6e\x72\x74\x7a\x7b\x7d\x80\x81\x83\x86\x8d\x8e\x92\x93\x94\x9a\x9f\xa1\xa2\xa3\xa7\xa9\xac\xae\xb0\xb1\xb4\xb6\xba\xc0\xc2\xc5\xcd\xcf\xd2\xd4\xd7\xda\xe0\xe3\xef\xf3\xf4\xf6\xfa\xfb\xff",
+		"\x00\x09\x0b\x0d\x0e\x12\x15\x17\x1d\x1f\x21\x25\x26\x32\x38\x39\x3a\x3d\x3e\x3f\x41\x42\x49\x4f\x50\x53\x54\x55\x56\x5c\x60\x61\x64\x65\x6f\x71\x77\x79\x7c\x7f\x82\x84\x85\x87\x89\x8c\x90\x97\x98\x9b\xa4\xa6\xa8\xab\xad\xb2\xb8\xb9\xbd\xc4\xc6\xc7\xc8\xca\xcb\xcc\xce\xd8\xdd\xde\xdf\xe2\xe5\xec\xed\xee\xf1\xf2\xf7\xf9\xfc\xfd",
+		"\x01\x05\x06\x07\x08\x0d\x0f\x13\x14\x16\x17\x21\x23\x27\x29\x2d\x2e\x2f\x30\x33\x34\x36\x40\x41\x43\x48\x53\x55\x58\x59\x5d\x5f\x61\x66\x67\x6a\x6d\x73\x76\x7c\x7d\x84\x86\x87\x8b\x8e\x8f\x95\x99\x9b\xa3\xaa\xab\xae\xb4\xb5\xb6\xb9\xbb\xbe\xc1\xc4\xc5\xc7\xc9\xcc\xce\xcf\xd8\xdc\xdd\xde\xe3\xe4\xe7\xe9\xea\xed\xee\xfc\xfd\xfe",
+	},
+
+	// Group 28 'E'
+	{
+		"\x06\x08\x0b\x0e\x0f\x13\x14\x17\x1b\x1f\x22\x24\x26\x27\x28\x29\x2b\x30\x36\x37\x38\x3a\x3b\x3d\x44\x45\x47\x48\x4b\x4d\x50\x51\x53\x57\x58\x5b\x62\x63\x65\x67\x6b\x6f\x70\x71\x74\x7b\x7c\x7f\x83\x85\x88\x8c\x90\x93\x9c\xa1\xa2\xa3\xa4\xac\xae\xaf\xb1\xb8\xba\xbc\xbd\xc1\xc4\xca\xce\xd2\xdf\xe1\xe4\xec\xed\xf2\xf3\xf4\xfb\xff",
+		"\x02\x0a\x0c\x15\x1a\x1d\x20\x23\x2a\x2d\x2f\x35\x39\x3c\x3e\x43\x46\x49\x4c\x52\x54\x56\x5a\x5c\x5d\x5e\x64\x66\x6a\x6c\x6d\x6e\x72\x76\x79\x7a\x7e\x80\x82\x84\x8e\x91\x92\x94\x97\x9d\xa0\xa5\xa7\xa9\xaa\xb2\xb3\xb4\xbe\xc2\xc5\xc9\xcb\xcf\xd0\xd1\xd6\xd8\xd9\xda\xdb\xdc\xdd\xe2\xe3\xe5\xe9\xea\xeb\xef\xf0\xf6\xf7\xf9\xfa\xfc",
+		"\x00\x01\x03\x04\x05\x07\x09\x0d\x10\x12\x16\x18\x19\x1e\x21\x25\x2c\x2e\x31\x32\x33\x34\x3f\x41\x42\x4a\x4e\x4f\x55\x59\x5f\x60\x61\x68\x69\x73\x75\x77\x78\x7d\x81\x86\x87\x89\x8a\x8b\x8f\x95\x98\x99\x9a\x9b\x9f\xa6\xa8\xad\xb5\xb7\xb9\xbf\xc0\xc3\xc6\xc7\xc8\xcc\xcd\xd3\xd4\xd5\xd7\xde\xe0\xe6\xe7\xe8\xee\xf1\xf5\xf8\xfd\xfe",
+		"\x02\x04\x0a\x0e\x0f\x10\x11\x12\x14\x18\x1a\x1b\x1c\x24\x29\x30\x34\x37\x38\x39\x3f\x40\x41\x45\x47\x4b\x4c\x4d\x51\x54\x56\x5a\x5f\x60\x68\x6a\x6d\x6f\x70\x86\x8c\x8d\x8f\x90\x94\x96\x99\x9a\x9b\x9e\xa6\xa8\xa9\xaa\xab\xac\xb0\xb1\xb6\xb8\xb9\xbb\xbd\xbe\xbf\xc0\xc2\xc4\xc6\xcc\xd0\xd2\xd5\xd7\xe6\xe7\xf0\xf2\xf3\xf5\xfa\xff",
+		"\x01\x03\x06\x07\x08\x09\x0d\x11\x1c\x23\x25\x26\x27\x28\x2b\x2d\x32\x33\x36\x3a\x3b\x40\x48\x49\x4e\x4f\x53\x55\x57\x58\x5b\x5d\x5e\x62\x63\x67\x72\x73\x74\x76\x77\x78\x7a\x7d\x83\x87\x8d\x97\xa0\xa4\xa7\xab\xad\xae\xaf\xb0\xba\xbb\xc7\xc9\xca\xcb\xcd\xce\xd9\xdb\xdc\xdf\xe1\xe4\xe9\xec\xed\xee\xf1\xf4\xf6\xf7\xf9\xfb\xfd\xfe",
+		"\x0b\x0c\x13\x15\x19\x1f\x21\x22\x2a\x2c\x2e\x2f\x31\x35\x3c\x3e\x42\x43\x46\x4a\x50\x52\x59\x5c\x61\x64\x65\x69\x6b\x6c\x6e\x71\x75\x7b\x7c\x7e\x80\x81\x82\x84\x85\x88\x89\x8a\x8b\x8e\x91\x92\x93\x95\x96\x98\x9c\x9d\x9e\x9f\xa1\xa2\xa3\xb2\xb3\xb4\xb5\xb6\xb7\xbc\xc1\xc5\xc8\xd3\xd4\xda\xdd\xde\xe0\xe2\xe3\xe5\xe8\xeb\xf8\xfc",
+		"\x00\x05\x06\x0e\x0f\x10\x13\x16\x17\x18\x1a\x1d\x1e\x1f\x20\x24\x2a\x2b\x33\x35\x3b\x3c\x3d\x3e\x44\x46\x49\x4c\x4e\x51\x55\x5a\x63\x66\x67\x6a\x6c\x6e\x73\x76\x79\x7b\x7e\x7f\x89\x8a\x8c\x92\x95\x96\x99\x9d\x9e\xa3\xa5\xa9\xac\xad\xb2\xbb\xbc\xc3\xcf\xd1\xd6\xd8\xd9\xdb\xe0\xe4\xe8\xea\xef\xf0\xf2\xf6\xf7\xf8\xf9\xfc\xfe\xff",
+	},
+
+	// Group 29 'F'
+	{
+		"\x01\x05\x07\x09\x0a\x0f\x11\x18\x19\x1a\x1d\x20\x27\x2a\x31\x32\x39\x3c\x3f\x42\x46\x48\x4d\x4e\x51\x57\x64\x67\x6d\x6e\x74\x75\x77\x79\x7b\x7c\x7e\x80\x82\x83\x84\x85\x89\x8f\x90\x95\x9a\x9b\xa0\xa1\xa4\xa6\xaa\xad\xae\xb3\xb5\xb9\xbb\xbc\xbd\xc1\xc5\xc6\xc9\xcb\xd0\xd3\xdb\xdc\xdd\xe2\xe6\xe8\xec\xee\xf4\xf5\xf9\xfb\xfd\xff",
+		"\x03\x06\x0d\x0e\x13\x16\x1b\x21\x22\x24\x26\x28\x29\x2b\x2c\x30\x33\x35\x38\x3a\x3b\x3d\x3e\x43\x47\x4c\x50\x52\x53\x56\x58\x59\x5a\x5f\x63\x65\x6c\x73\x76\x7f\x81\x86\x88\x8a\x8b\x8c\x8e\x97\x98\x9c\x9d\xa2\xa3\xa5\xa8\xaf\xb0\xb6\xb8\xbe\xbf\xc2\xc4\xca\xcc\xcd\xd2\xd4\xd6\xd7\xd8\xda\xde\xdf\xe1\xe4\xe7\xea\xf2\xf6\xfa\xfc",
+		"\x00\x02\x04\x08\x0b\x0c\x12\x14\x15\x1c\x1e\x1f\x23\x25\x2d\x2e\x34\x36\x37\x40\x41\x44\x45\x49\x4a\x4b\x4f\x54\x55\x5b\x5c\x5d\x60\x61\x62\x66\x68\x6a\x6b\x6f\x70\x71\x72\x78\x7a\x7d\x87\x8d\x92\x93\x94\x96\x99\x9e\x9f\xa7\xa9\xac\xb1\xb2\xb4\xb7\xba\xc3\xc8\xce\xcf\xd1\xd5\xd9\xe0\xe3\xe5\xe9\xeb\xef\xf0\xf1\xf3\xf7\xf8\xfe",
+		"\x04\x0d\x10\x16\x17\x18\x19\x1c\x20\x21\x23\x25\x2c\x2d\x2f\x31\x35\x3b\x3c\x3d\x44\x48\x4c\x54\x56\x5a\x5e\x61\x64\x68\x69\x6c\x6d\x72\x74\x7d\x7e\x7f\x81\x82\x84\x87\x8c\x8d\x8e\x8f\x91\x92\x97\x99\x9b\x9c\xa2\xa5\xa6\xa8\xab\xad\xbc\xc0\xc2\xc3\xc6\xc7\xc9\xcb\xcd\xd1\xd2\xd3\xd9\xdc\xdf\xe3\xe4\xe5\xe8\xed\xf3\xf9\xfb\xfd",
+		"\x00\x06\x0a\x0b\x0c\x0f\x10\x13\x15\x17\x1a\x1b\x1f\x22\x27\x29\x2a\x2e\x2f\x32\x33\x34\x38\x39\x3e\x40\x41\x43\x45\x46\x4b\x4f\x51\x53\x55\x58\x59\x5b\x5c\x5e\x60\x67\x69\x6e\x71\x75\x7b\x7c\x86\x8a\x8b\x90\x94\x95\x96\x9d\xa0\xa1\xa3\xa4\xa9\xae\xb2\xbb\xbd\xc0\xc4\xc5\xc7\xca\xce\xcf\xd5\xd8\xdb\xdd\xe6\xec\xed\xf5\xf6\xf8",
+		"\x01\x02\x05\x07\x08\x0e\x11\x12\x14\x1d\x1e\x24\x26\x2b\x30\x36\x37\x3a\x42\x47\x49\x4e\x50\x52\x57\x5d\x62\x63\x65\x66\x6a\x6b\x6f\x70\x73\x76\x77\x78\x7a\x83\x85\x89\x91\x93\x98\x9a\x9e\x9f\xa7\xaa\xab\xac\xaf\xb3\xb4\xb5\xb6\xb8\xba\xbe\xbf\xc1\xc8\xd4\xd6\xd7\xda\xde\xe0\xe1\xe2\xe7\xe9\xea\xeb\xee\xf0\xf2\xf4\xf7\xfc\xff",
+		"\x01\x03\x06\x09\x0a\x10\x12\x13\x16\x18\x1b\x1c\x27\x28\x2a\x30\x34\x37\x38\x3b\x3f\x40\x43\x46\x4a\x4c\x4d\x50\x52\x53\x54\x57\x5d\x5f\x63\x66\x6a\x6b\x6e\x70\x76\x79\x7e\x80\x85\x86\x88\x89\x8b\x92\x94\x9d\xa3\xa5\xa9\xab\xb0\xb1\xb2\xb4\xb5\xb6\xb7\xb9\xba\xc3\xc9\xcc\xce\xd0\xd6\xd7\xd9\xdf\xe2\xe8\xec\xed\xef\xf1\xfa\xfe",
+	},
+
+	// Group 30 'G'
+	{
+		"\x03\x0d\x12\x15\x16\x1d\x1e\x22\x26\x28\x29\x2a\x2d\x30\x34\x36\x37\x38\x39\x3f\x42\x43\x45\x46\x47\x48\x49\x4a\x4d\x53\x54\x58\x5d\x5e\x64\x67\x6b\x6c\x6d\x70\x71\x7f\x83\x88\x89\x8a\x8b\x8d\x98\x9c\xa2\xa7\xa8\xab\xaf\xb0\xb1\xb4\xb9\xc8\xca\xcb\xcd\xd3\xd5\xd6\xd7\xd8\xd9\xdc\xe2\xe5\xe7\xe8\xea\xeb\xef\xf1\xf4\xf5\xf6\xfe",
+		"\x06\x08\x0a\x0b\x0e\x0f\x11\x14\x17\x18\x1b\x1f\x20\x23\x24\x27\x2b\x2c\x2f\x31\x33\x3b\x3c\x3e\x41\x44\x4b\x4c\x4e\x55\x59\x5b\x5c\x5f\x61\x65\x68\x6e\x6f\x72\x75\x76\x7b\x7d\x87\x8c\x91\x93\x95\x96\x97\x9b\x9d\x9e\x9f\xa0\xa4\xa6\xac\xad\xae\xb7\xba\xbb\xbc\xbe\xc3\xc4\xc9\xd0\xda\xdd\xdf\xe0\xe6\xe9\xec\xf3\xf9\xfa\xfb\xff",
+		"\x00\x01\x05\x07\x09\x0c\x10\x13\x19\x1a\x1c\x25\x2e\x32\x35\x3d\x40\x51\x52\x56\x57\x5a\x60\x62\x63\x66\x69\x6a\x74\x77\x78\x79\x7a\x7c\x7e\x80\x81\x82\x84\x85\x86\x8e\x8f\x92\x94\x99\x9a\xa1\xa3\xa5\xa9\xaa\xb2\xb3\xb5\xb6\xb8\xbd\xbf\xc1\xc2\xc5\xc6\xc7\xcc\xcf\xd1\xd2\xd4\xdb\xde\xe1\xe3\xe4\xed\xee\xf0\xf2\xf7\xf8\xfc\xfd",
+		"\x02\x03\x04\x07\x09\x0a\x13\x1b\x21\x23\x25\x2d\x31\x3a\x3f\x40\x46\x48\x49\x4f\x50\x53\x54\x55\x57\x60\x63\x64\x68\x69\x6c\x71\x72\x73\x76\x7b\x7c\x7d\x7f\x88\x89\x90\x91\x92\x93\x95\x96\x97\x98\x9d\xab\xac\xaf\xb1\xb2\xb7\xbd\xbe\xc0\xc1\xc2\xc3\xc7\xcb\xce\xcf\xd0\xd2\xd5\xd6\xd7\xd8\xda\xe2\xe5\xe6\xe7\xea\xef\xf4\xf9\xfe",
+		"\x02\x04\x05\x0b\x0d\x0f\x14\x15\x16\x18\x19\x22\x26\x28\x2b\x2e\x2f\x30\x32\x35\x38\x3c\x3e\x44\x4a\x4b\x4c\x4e\x4f\x50\x51\x52\x58\x5a\x5b\x5c\x5d\x5f\x66\x6a\x6d\x6e\x73\x77\x79\x81\x82\x8a\x8c\x8d\x8f\x94\x9a\x9b\x9c\x9f\xa0\xa8\xa9\xad\xae\xb0\xb3\xb4\xbb\xbf\xc0\xc4\xc8\xc9\xce\xd1\xd3\xd4\xe1\xe8\xe9\xeb\xf1\xf5\xf7\xfd",
+		"\x00\x06\x08\x0e\x10\x11\x12\x17\x1a\x1c\x1d\x1e\x1f\x20\x21\x27\x29\x2a\x2c\x33\x36\x37\x39\x3a\x3b\x3d\x41\x43\x45\x47\x4d\x56\x5e\x61\x62\x65\x67\x6b\x6f\x70\x75\x78\x7a\x7e\x80\x83\x84\x86\x87\x8b\x8e\x90\x99\xa2\xa3\xa4\xa5\xa6\xb6\xb8\xb9\xba\xc5\xc6\xcd\xd9\xdb\xdc\xdd\xde\xdf\xe0\xe4\xec\xed\xee\xf0\xf2\xf6\xf8\xfb\xff",
+		"\x01\x04\x09\x0c\x12\x15\x18\x1c\x20\x21\x24\x26\x27\x28\x2b\x2c\x2d\x2e\x34\x36\x38\x3b\x42\x44\x48\x4a\x4f\x57\x59\x5b\x5e\x67\x6b\x71\x72\x74\x76\x78\x79\x7d\x80\x85\x87\x8e\x91\x9c\x9e\xa1\xa2\xa7\xaa\xb5\xb6\xb9\xba\xbc\xc2\xc7\xc8\xc9\xca\xcc\xcf\xd4\xd5\xd7\xd8\xd9\xdb\xe0\xe2\xe3\xe9\xee\xf0\xf3\xf4\xf5\xf6\xfa\xfc\xfd",
+	},
+
+	// Group 31 'H'
+	{
+		"\x00\x02\x07\x0a\x0b\x0e\x12\x15\x18\x1c\x1e\x23\x26\x31\x33\x34\x37\x45\x47\x4c\x4e\x52\x53\x56\x57\x58\x59\x5c\x5f\x61\x66\x6e\x6f\x75\x76\x78\x80\x81\x82\x83\x85\x88\x8c\x8e\x8f\x90\x93\x95\x96\xa0\xa3\xa4\xa7\xa9\xab\xad\xb3\xb4\xb7\xbd\xbe\xbf\xc3\xc8\xcc\xcd\xcf\xd5\xd6\xd8\xd9\xda\xe6\xe9\xec\xee\xf0\xf1\xfb\xfc\xfd\xfe",
+		"\x01\x03\x04\x09\x0d\x0f\x13\x20\x22\x24\x25\x27\x28\x29\x2b\x2c\x2f\x32\x35\x36\x39\x3d\x3f\x40\x41\x42\x43\x44\x46\x48\x49\x4d\x4f\x50\x51\x5d\x5e\x60\x62\x63\x67\x69\x6b\x6d\x71\x77\x7a\x7d\x7f\x87\x92\x94\x98\x9b\x9c\x9e\xa2\xaf\xb0\xb1\xb8\xb9\xbb\xc1\xc6\xc9\xca\xd1\xdb\xdc\xdd\xe1\xe3\xe5\xe7\xea\xeb\xef\xf3\xf4\xf5\xfa",
+		"\x05\x06\x0c\x10\x11\x14\x16\x17\x19\x1a\x1b\x1d\x1f\x21\x2a\x2d\x2e\x30\x38\x3a\x3b\x3e\x4a\x4b\x54\x55\x5a\x5b\x64\x65\x6a\x70\x72\x73\x74\x79\x7b\x7c\x7e\x84\x86\x89\x8a\x8b\x8d\x91\x97\x99\x9a\x9d\x9f\xa5\xa6\xa8\xaa\xac\xae\xb2\xb5\xb6\xba\xbc\xc0\xc2\xc4\xc5\xc7\xcb\xce\xd0\xd2\xd3\xdf\xe0\xe2\xe4\xe8\xf2\xf6\xf7\xf9\xff",
+		"\x06\x08\x09\x0a\x0b\x0f\x12\x1a\x1c\x20\x22\x27\x2a\x2c\x2f\x37\x39\x3a\x3c\x3f\x40\x42\x45\x47\x48\x4c\x4f\x58\x60\x63\x65\x66\x68\x69\x6b\x6c\x70\x72\x76\x78\x79\x7a\x7e\x86\x88\x90\x94\x98\xa1\xa3\xa5\xa8\xaa\xac\xaf\xb2\xb3\xb8\xb9\xc0\xc1\xc5\xca\xd1\xd4\xd6\xd7\xda\xdc\xde\xe1\xe4\xe7\xe9\xed\xee\xef\xf2\xf4\xf5\xf8\xfe",
+		"\x00\x01\x03\x08\x10\x11\x15\x16\x1b\x1d\x1f\x21\x24\x2b\x2d\x30\x31\x32\x33\x35\x36\x3d\x3e\x49\x4b\x4d\x51\x52\x54\x55\x56\x57\x5a\x5b\x5f\x62\x67\x68\x6d\x6e\x6f\x71\x77\x7b\x7c\x7d\x7f\x80\x85\x8c\x99\x9a\x9e\x9f\xa2\xa6\xa9\xb0\xb4\xb5\xb7\xbb\xbc\xbd\xbf\xc2\xc3\xcc\xce\xd0\xd3\xe5\xe6\xe8\xeb\xf3\xf6\xf7\xf8\xfb\xfc\xfd",
+		"\x02\x05\x07\x0c\x14\x17\x19\x1e\x26\x28\x2e\x34\x38\x3b\x3c\x43\x44\x46\x4a\x4e\x50\x5c\x5d\x5e\x61\x64\x6a\x6c\x74\x75\x81\x82\x83\x84\x87\x89\x8a\x8d\x8e\x8f\x91\x92\x95\x96\x97\x9b\x9c\x9d\xa0\xa1\xa4\xa7\xab\xae\xb1\xb6\xba\xbe\xc4\xc6\xc7\xc9\xcb\xcd\xd2\xd4\xd5\xd7\xd8\xd9\xdb\xde\xdf\xe0\xe2\xe3\xea\xec\xed\xf0\xf1\xf9",
+		"\x00\x03\x04\x06\x08\x09\x0d\x0e\x13\x18\x1c\x22\x23\x25\x26\x27\x29\x2d\x34\x3e\x40\x41\x45\x47\x4d\x50\x53\x59\x5c\x5e\x6b\x70\x72\x73\x88\x89\x8a\x8b\x8f\x90\x93\x96\x99\x9d\x9f\xa1\xa4\xa7\xa8\xa9\xac\xad\xb4\xb7\xbd\xbf\xc2\xc4\xc8\xcb\xcf\xd6\xd9\xdb\xdc\xdd\xdf\xe0\xe2\xe3\xe5\xe7\xe9\xee\xf0\xf1\xf7\xf9\xfa\xfb\xfc\xff",
+	},
+
+	// Group 32 'I'
+	{
+		"\x01\x03\x04\x07\x08\x09\x0d\x10\x11\x13\x15\x17\x21\x23\x24\x27\x29\x2b\x2c\x31\x33\x3e\x45\x46\x4a\x4c\x4e\x4f\x54\x58\x5a\x5c\x5d\x63\x65\x66\x67\x6d\x6e\x6f\x70\x71\x72\x7b\x7c\x85\x86\x89\x8b\x8f\x98\x99\x9a\x9e\xa2\xa5\xae\xb3\xb8\xba\xbf\xc2\xc6\xc9\xcc\xd0\xd1\xd6\xd7\xdd\xde\xdf\xe1\xe5\xe6\xe7\xed\xee\xf0\xf2\xf4\xff",
+		"\x00\x02\x06\x0a\x0c\x14\x18\x1a\x1b\x1e\x28\x2d\x2e\x32\x34\x35\x36\x38\x3a\x3b\x3d\x3f\x41\x42\x47\x48\x49\x55\x57\x5e\x60\x61\x69\x6a\x6b\x6c\x73\x74\x77\x79\x80\x81\x83\x8a\x8e\x91\x93\x96\x9c\x9d\x9f\xa0\xa3\xa7\xa8\xaa\xab\xac\xaf\xb1\xb4\xb9\xbb\xbc\xbd\xbe\xca\xcd\xd5\xda\xdb\xe0\xe3\xe8\xe9\xeb\xf1\xf5\xf7\xf8\xfc\xfe",
+		"\x05\x0b\x0e\x0f\x12\x16\x19\x1c\x1d\x1f\x20\x22\x25\x26\x2a\x2f\x30\x37\x39\x3c\x40\x43\x44\x4b\x50\x51\x52\x53\x56\x59\x5b\x62\x64\x68\x75\x76\x78\x7a\x7e\x7f\x84\x87\x88\x8c\x8d\x90\x92\x94\x95\x97\xa1\xa4\xa6\xa9\xad\xb6\xb7\xc0\xc1\xc3\xc4\xc5\xc7\xc8\xcb\xce\xcf\xd2\xd4\xd8\xd9\xdc\xe2\xe4\xea\xec\xef\xf3\xf9\xfa\xfb\xfd",
+		"\x04\x05\x09\x10\x12\x13\x17\x18\x19\x1a\x1b\x22\x23\x27\x2c\x31\x34\x38\x3e\x41\x43\x44\x45\x4b\x4d\x55\x56\x57\x5a\x5b\x5e\x5f\x63\x64\x68\x6a\x71\x73\x77\x7a\x7b\x7d\x80\x82\x85\x8a\x8b\x91\x92\x96\x97\x9b\xa1\xa5\xa6\xaa\xb0\xb2\xb5\xb6\xb9\xbd\xc2\xc5\xc6\xca\xcd\xcf\xd2\xd3\xda\xdb\xdf\xe9\xee\xef\xf0\xf3\xf6\xf7\xf9\xfd",
+		"\x00\x02\x07\x08\x0d\x11\x1c\x1f\x21\x28\x29\x2a\x2d\x2e\x2f\x30\x32\x35\x37\x39\x3d\x42\x47\x48\x49\x4a\x4c\x4d\x4e\x4f\x51\x52\x53\x5c\x5f\x65\x67\x6b\x6c\x74\x75\x7c\x81\x83\x90\x98\x9b\x9c\x9e\x9f\xa3\xa7\xa9\xab\xad\xae\xb0\xb2\xb3\xb5\xb8\xbb\xbc\xbf\xc0\xc7\xc8\xd3\xd7\xde\xe1\xe5\xe6\xe7\xeb\xec\xed\xf8\xfa\xfb\xfe\xff",
+		"\x01\x03\x06\x0a\x0b\x0c\x0f\x14\x15\x16\x1d\x1e\x20\x24\x2b\x36\x3a\x3b\x3c\x3f\x50\x58\x59\x5d\x60\x61\x62\x66\x69\x6d\x6e\x6f\x76\x78\x79\x7d\x7e\x7f\x82\x87\x88\x8c\x8e\x8f\x93\x94\x95\x99\x9a\x9d\xa0\xa8\xac\xaf\xb1\xb4\xbe\xc1\xc3\xc4\xc9\xcb\xcc\xce\xd0\xd1\xd4\xd6\xd8\xd9\xdc\xdd\xe2\xe3\xe4\xe8\xea\xf1\xf2\xf5\xf6\xfc",
+		"\x00\x03\x05\x0a\x0c\x0d\x0e\x11\x13\x14\x15\x16\x22\x24\x25\x26\x27\x2f\x33\x35\x40\x45\x46\x47\x48\x49\x4f\x54\x57\x59\x60\x62\x66\x70\x71\x72\x75\x78\x79\x7b\x7c\x7f\x80\x82\x84\x86\x88\x89\x8c\x8d\x96\xa2\xa4\xa6\xa9\xaf\xb1\xb4\xb7\xb8\xb9\xba\xbc\xc0\xc2\xc3\xc9\xca\xcb\xd1\xd5\xd6\xde\xe0\xe1\xe4\xe9\xf3\xf4\xf8\xfd\xff",
+	},
+
+	// Group 33 'J'
+	{
+		"\x01\x05\x08\x10\x12\x13\x16\x1c\x1e\x22\x26\x29\x30\x32\x3b\x3e\x3f\x42\x46\x49\x4f\x50\x52\x53\x59\x5a\x5b\x5f\x63\x67\x6a\x6f\x71\x75\x76\x79\x7b\x7d\x7e\x7f\x80\x83\x86\x92\x95\x97\x98\x99\x9a\x9d\x9f\xa0\xab\xac\xb0\xb1\xb3\xc2\xc3\xc5\xca\xce\xcf\xd2\xd3\xd4\xd5\xdd\xe7\xe9\xea\xeb\xef\xf0\xf1\xf2\xf8\xf9\xfa\xfc\xfd\xfe",
+		"\x00\x04\x06\x07\x0b\x0d\x14\x19\x1b\x1d\x24\x25\x27\x2c\x2d\x2e\x2f\x33\x35\x36\x43\x47\x48\x4b\x4c\x4d\x4e\x51\x56\x57\x58\x5d\x5e\x60\x62\x64\x68\x69\x6b\x6d\x73\x7a\x7c\x88\x8a\x8b\x8d\x8e\x93\x94\x96\x9c\x9e\xa1\xa2\xa4\xa5\xa7\xaa\xad\xb2\xb4\xb7\xb8\xbb\xbd\xbf\xc0\xc1\xc4\xc9\xcc\xcd\xd0\xd1\xe1\xe3\xe4\xe5\xe8\xee\xff",
+		"\x02\x09\x0a\x0c\x0e\x0f\x11\x15\x17\x18\x1a\x1f\x20\x21\x23\x28\x2a\x34\x37\x38\x39\x3a\x3c\x3d\x40\x41\x44\x45\x4a\x54\x55\x5c\x65\x66\x6e\x70\x72\x74\x78\x81\x84\x85\x87\x89\x8c\x8f\x90\x91\x9b\xa3\xa6\xa8\xa9\xae\xaf\xb5\xb6\xb9\xba\xbc\xbe\xc6\xc7\xc8\xcb\xd6\xd7\xd9\xda\xdb\xdc\xde\xdf\xe0\xe6\xec\xed\xf3\xf4\xf5\xf6\xfb",
+		"\x03\x09\x11\x16\x1b\x1d\x1e\x23\x24\x2b\x2c\x2e\x30\x31\x35\x36\x38\x3b\x40\x49\x4c\x4f\x55\x58\x5b\x5c\x61\x62\x69\x6c\x70\x71\x73\x75\x77\x79\x7b\x7c\x7d\x7f\x82\x84\x89\x8e\x91\x94\x99\x9b\x9c\x9f\xa2\xa6\xa8\xa9\xaa\xad\xae\xb0\xb5\xb6\xba\xc1\xc3\xc4\xc6\xca\xce\xd3\xd4\xd7\xd8\xd9\xdc\xe2\xe4\xe8\xea\xed\xef\xf7\xf8\xfa",
+		"\x00\x01\x07\x0c\x0e\x10\x13\x14\x15\x18\x19\x1f\x22\x25\x26\x29\x2b\x2f\x37\x39\x3e\x3f\x41\x42\x43\x45\x4a\x4d\x50\x51\x53\x56\x57\x5d\x5e\x5f\x64\x67\x6b\x6c\x6d\x6e\x72\x74\x76\x77\x7a\x7e\x80\x82\x85\x8a\x8d\x93\x95\xa3\xb2\xb3\xb4\xb7\xbc\xc0\xc7\xcc\xcd\xcf\xd2\xd8\xde\xdf\xe0\xe2\xe6\xe9\xeb\xf0\xf2\xf3\xf7\xfc\xfe\xff",
+		"\x02\x03\x04\x05\x06\x0a\x0b\x0d\x0f\x12\x17\x1a\x1c\x20\x21\x27\x2a\x2d\x31\x33\x34\x3a\x3c\x3d\x44\x46\x47\x4b\x52\x54\x59\x60\x61\x63\x65\x66\x68\x6f\x78\x81\x83\x87\x88\x8b\x8c\x8f\x90\x96\x97\x98\x9a\x9d\x9e\xa0\xa1\xa5\xab\xaf\xb1\xbb\xbd\xbf\xc8\xc9\xcb\xd1\xd5\xd6\xda\xdb\xdd\xe1\xe3\xe7\xec\xee\xf1\xf4\xf6\xf9\xfb\xfd",
+		"\x01\x03\x08\x0c\x12\x15\x16\x17\x19\x1d\x25\x26\x28\x29\x2e\x32\x34\x37\x3d\x47\x48\x4c\x4e\x53\x56\x5a\x5c\x5f\x68\x6a\x6c\x6d\x6e\x6f\x72\x7d\x81\x85\x86\x8f\x90\x92\x95\x97\x9a\x9d\x9e\xa0\xa4\xa6\xa7\xa9\xab\xac\xb1\xb3\xb4\xb8\xb9\xbb\xbd\xbe\xc0\xc2\xc4\xc5\xc7\xc8\xcc\xcd\xd0\xd8\xdc\xde\xe5\xe8\xec\xef\xf0\xf1\xf5\xfa",
+	},
+
+	// Group 34 'K'
+	{
+		"\x03\x04\x06\x0d\x0e\x10\x11\x17\x2c\x2d\x2e\x33\x36\x3d\x3e\x40\x47\x48\x49\x4b\x4d\x50\x51\x52\x5c\x5d\x62\x69\x6e\x6f\x73\x74\x79\x7c\x81\x82\x87\x88\x89\x8c\x8d\x8e\x92\x94\x95\x9a\x9b\xa2\xa4\xa8\xaa\xab\xac\xae\xb0\xb1\xb5\xb6\xb8\xba\xbc\xbd\xbe\xc1\xc3\xc6\xcc\xcd\xd0\xd6\xd8\xd9\xdf\xe5\xe8\xed\xf0\xf5\xf6\xf9\xfa\xfe",
+		"\x01\x02\x07\x0a\x0b\x0c\x15\x1a\x1b\x1d\x1e\x1f\x20\x26\x28\x29\x2b\x31\x34\x38\x3c\x41\x43\x45\x46\x4c\x4e\x4f\x54\x55\x56\x59\x5e\x5f\x63\x64\x65\x67\x6a\x70\x72\x78\x7a\x7e\x7f\x83\x84\x85\x86\x8b\x8f\x91\x93\x96\x98\x9c\x9d\x9f\xa1\xa9\xad\xb2\xb4\xb7\xb9\xc0\xc4\xc5\xc7\xca\xcb\xd1\xd3\xd7\xda\xdc\xe3\xe6\xe7\xf3\xf7\xfd",
+		"\x00\x05\x08\x09\x0f\x12\x13\x14\x16\x18\x19\x1c\x21\x23\x24\x27\x2a\x2f\x30\x35\x37\x39\x3a\x3b\x3f\x42\x4a\x53\x57\x58\x5a\x5b\x61\x66\x68\x6b\x6c\x6d\x71\x75\x76\x77\x7b\x7d\x80\x8a\x90\x97\x99\x9e\xa0\xa3\xa5\xa7\xaf\xb3\xbf\xc2\xc8\xc9\xce\xcf\xd2\xd4\xd5\xdd\xde\xe0\xe1\xe2\xe4\xe9\xea\xeb\xec\xee\xef\xf1\xf2\xf4\xfb\xfc",
+		"\x05\x07\x0c\x0e\x10\x11\x15\x20\x22\x25\x27\x2a\x2e\x2f\x32\x34\x39\x3e\x40\x44\x45\x46\x4a\x4b\x4d\x51\x57\x5b\x5c\x5e\x60\x68\x6c\x6e\x78\x79\x7a\x7e\x81\x82\x86\x87\x89\x94\x95\x96\x97\x98\x99\x9a\x9c\xa4\xa6\xa8\xab\xac\xae\xb0\xb5\xba\xbb\xbe\xc1\xce\xd0\xd7\xd9\xdb\xdc\xdf\xe0\xe4\xe5\xe8\xe9\xee\xf2\xf5\xf8\xfa\xfb\xff",
+		"\x00\x03\x06\x08\x0a\x0d\x0f\x12\x13\x1c\x1e\x21\x25\x28\x29\x2c\x2d\x30\x32\x37\x3f\x42\x49\x4f\x50\x54\x56\x5d\x5f\x60\x62\x63\x65\x67\x6a\x6d\x6f\x71\x73\x74\x76\x77\x7b\x7f\x80\x84\x85\x8a\x90\x93\x9b\xa0\xa1\xa2\xa6\xa7\xa9\xad\xb3\xb6\xb8\xb9\xbb\xc3\xc5\xc7\xcf\xd2\xd3\xd4\xdd\xe1\xe6\xe7\xec\xed\xef\xf1\xf4\xf7\xf8\xfe",
+		"\x01\x02\x04\x09\x0b\x14\x16\x17\x18\x19\x1a\x1b\x1d\x1f\x22\x23\x26\x2b\x31\x33\x36\x38\x3a\x3b\x3c\x3d\x41\x43\x44\x47\x4c\x4e\x52\x53\x55\x59\x5a\x61\x64\x72\x75\x7d\x88\x8b\x8c\x8e\x8f\x91\x92\x9f\xa3\xa5\xaa\xaf\xb1\xb4\xb7\xbc\xbd\xbf\xc0\xc2\xc4\xc6\xc9\xca\xcb\xcc\xcd\xd8\xda\xdb\xde\xe2\xea\xf0\xf3\xf6\xf9\xfc\xfd\xff",
+		"\x04\x0a\x0c\x0f\x14\x15\x16\x1a\x23\x24\x26\x28\x29\x2c\x2d\x2e\x30\x35\x38\x3b\x47\x48\x4c\x50\x54\x58\x59\x60\x62\x66\x67\x69\x6b\x70\x75\x79\x7a\x7c\x7e\x81\x83\x84\x8d\x8f\x92\x94\x95\x96\x99\x9c\x9d\x9e\x9f\xa0\xa7\xa8\xb1\xb2\xb3\xb4\xb6\xc1\xc8\xc9\xcd\xd1\xd5\xd6\xda\xdc\xdd\xde\xe3\xe6\xe7\xeb\xf2\xf3\xf6\xf8\xfb\xfd",
+	},
+
+	// Group 35 'L'
+	{
+		"\x04\x0f\x16\x18\x1d\x1e\x1f\x21\x22\x23\x24\x26\x2a\x2c\x30\x34\x3b\x3c\x3d\x40\x42\x44\x48\x4b\x4c\x50\x55\x59\x5c\x5e\x63\x65\x6a\x6d\x6e\x70\x73\x74\x7f\x81\x85\x86\x89\x8a\x8b\x8e\x93\x96\x99\xa1\xa3\xa6\xab\xac\xad\xaf\xb1\xb2\xb3\xb7\xb8\xba\xc5\xc6\xc8\xce\xd1\xd2\xd4\xd8\xda\xde\xe0\xe5\xef\xf0\xf1\xf4\xf5\xf7\xf8\xfc",
+		"\x00\x02\x03\x06\x07\x0c\x0d\x11\x13\x14\x15\x17\x1a\x1c\x25\x29\x2b\x32\x33\x35\x37\x3a\x41\x43\x45\x49\x4a\x4e\x4f\x51\x57\x5f\x60\x62\x66\x68\x6c\x6f\x71\x79\x80\x82\x83\x84\x87\x88\x8d\x8f\x90\x92\x94\x95\x97\x9a\x9b\xa8\xa9\xaa\xae\xbf\xc0\xc1\xc7\xc9\xcb\xcf\xd0\xd5\xd9\xdd\xe1\xe2\xe4\xea\xec\xee\xf2\xf6\xf9\xfa\xfb\xfe",
+		"\x01\x05\x08\x09\x0a\x0e\x10\x12\x19\x1b\x20\x28\x2d\x2e\x2f\x31\x36\x38\x39\x3e\x3f\x46\x47\x4d\x52\x53\x56\x58\x5b\x5d\x61\x64\x69\x6b\x72\x75\x76\x77\x78\x7a\x7b\x7c\x7d\x7e\x8c\x91\x98\x9c\x9d\x9e\x9f\xa0\xa2\xa4\xa5\xa7\xb0\xb4\xb5\xb6\xb9\xbb\xbd\xbe\xc2\xc4\xca\xcc\xcd\xd3\xd6\xd7\xdb\xdc\xdf\xe7\xe8\xe9\xed\xf3\xfd\xff",
+		"\x01\x03\x08\x09\x0a\x0b\x0f\x15\x1e\x26\x27\x2e\x33\x35\x36\x38\x39\x3b\x3e\x42\x43\x44\x47\x4c\x4e\x51\x54\x57\x58\x5a\x5c\x5d\x5e\x62\x64\x65\x66\x67\x6e\x70\x75\x78\x7b\x7c\x80\x85\x89\x8a\x8b\x8d\x91\x93\x94\x9b\x9e\xa7\xa8\xaa\xaf\xb2\xb8\xbc\xc0\xc2\xc3\xc6\xc8\xca\xcf\xd5\xda\xdd\xe3\xe6\xe7\xeb\xec\xee\xf3\xf6\xfa\xfd",
+		"\x00\x0b\x0c\x0e\x13\x17\x18\x19\x1a\x1b\x20\x22\x23\x27\x2a\x2b\x2d\x31\x32\x3a\x45\x46\x4b\x4d\x50\x53\x56\x5a\x5b\x60\x61\x6b\x6d\x72\x73\x76\x77\x7e\x81\x83\x86\x8e\x8f\x98\x9c\x9d\xa1\xa3\xa6\xab\xac\xb3\xb6\xb9\xbb\xc1\xc3\xc7\xcb\xcc\xd0\xd1\xd6\xd7\xd8\xdf\xe0\xe1\xe2\xe3\xe4\xe5\xe6\xe8\xe9\xeb\xed\xf1\xf5\xf7\xf8\xf9",
+		"\x04\x07\x0d\x10\x11\x14\x1c\x1f\x21\x24\x25\x28\x29\x2c\x2f\x34\x37\x3c\x3d\x3f\x40\x41\x48\x49\x54\x59\x5f\x63\x67\x68\x6a\x6c\x6f\x71\x74\x79\x7a\x7d\x7f\x82\x87\x88\x90\x95\x96\x97\x99\x9a\x9f\xa0\xa2\xa4\xa5\xa9\xad\xae\xb0\xb1\xb4\xb5\xb7\xba\xbc\xbd\xbe\xc4\xc5\xcd\xce\xd2\xd3\xd9\xdb\xdc\xde\xea\xef\xf0\xfb\xfc\xfe\xff",
+		"\x00\x01\x02\x05\x06\x09\x10\x12\x16\x1d\x1e\x20\x25\x26\x2a\x2d\x2f\x30\x3a\x3b\x43\x45\x46\x47\x49\x4a\x4f\x51\x52\x55\x5a\x5e\x65\x66\x69\x6b\x71\x72\x76\x78\x7b\x7d\x7e\x80\x82\x84\x88\x89\x8c\x8d\x91\x92\x95\x97\x99\x9f\xa0\xa6\xa9\xab\xad\xae\xaf\xb5\xb8\xba\xbe\xbf\xc5\xc9\xd0\xd4\xe4\xe5\xe7\xef\xf0\xf1\xf2\xf4\xf6\xf8",
+	},
+
+	// Group 36 'M'
+	{
+		"\x02\x03\x05\x0a\x0b\x0c\x0d\x0f\x16\x1f\x20\x22\x2c\x2e\x2f\x32\x33\x34\x37\x38\x3c\x3e\x3f\x40\x42\x4b\x51\x5f\x66\x69\x6a\x6e\x6f\x71\x74\x79\x7a\x7b\x81\x90\x92\x96\x98\x99\x9a\x9c\x9e\x9f\xa0\xa1\xa2\xa5\xa9\xaa\xab\xad\xae\xb0\xb6\xba\xbf\xc3\xcc\xcd\xce\xd2\xd5\xdc\xde\xdf\xe1\xe4\xe7\xeb\xed\xee\xf4\xf6\xf9\xfa\xfb\xfc",
+		"\x00\x04\x06\x08\x0e\x12\x13\x19\x1c\x28\x29\x2a\x35\x36\x3d\x43\x44\x45\x48\x4a\x50\x52\x54\x56\x57\x58\x5d\x5e\x60\x62\x63\x64\x65\x6d\x73\x76\x77\x78\x7c\x7d\x7f\x80\x82\x86\x87\x88\x8a\x8b\x8c\x8d\x93\x94\x97\x9b\xa4\xac\xaf\xb1\xb2\xb5\xb7\xb9\xc2\xc4\xc7\xc9\xca\xcf\xd0\xd1\xd4\xd9\xdb\xe0\xe2\xe5\xe6\xe9\xea\xf3\xfe\xff",
+		"\x01\x07\x09\x10\x11\x14\x15\x1a\x1b\x1d\x1e\x21\x23\x24\x25\x26\x27\x2b\x2d\x30\x31\x39\x3a\x3b\x41\x46\x47\x4c\x4f\x53\x55\x59\x5a\x5b\x5c\x61\x67\x68\x6b\x6c\x70\x72\x75\x7e\x83\x84\x89\x8e\x8f\x91\x95\x9d\xa3\xa6\xa7\xa8\xb3\xb4\xb8\xbb\xbc\xbe\xc0\xc1\xc5\xc6\xc8\xcb\xd7\xd8\xda\xdd\xe3\xe8\xec\xef\xf0\xf1\xf5\xf7\xf8\xfd",
+		"\x00\x01\x05\x08\x0c\x0f\x12\x13\x16\x17\x18\x1a\x1d\x21\x2a\x32\x34\x38\x39\x3f\x47\x48\x49\x4a\x4c\x4d\x4e\x50\x53\x56\x58\x5a\x5b\x5e\x5f\x6b\x6d\x71\x7b\x7c\x7e\x81\x85\x88\x8a\x8d\x8f\x95\x9a\x9b\xa5\xaa\xac\xad\xb3\xb7\xb8\xbc\xbd\xbe\xc1\xc6\xc8\xc9\xca\xcb\xce\xd0\xd2\xd3\xd6\xdb\xdc\xe4\xe9\xea\xeb\xed\xf2\xf4\xfc\xfe",
+		"\x02\x03\x04\x07\x09\x0b\x0d\x0e\x11\x18\x19\x20\x22\x25\x29\x2b\x2c\x31\x37\x3a\x3b\x3d\x45\x46\x49\x51\x52\x55\x61\x65\x68\x69\x6a\x6f\x73\x75\x78\x7a\x7d\x7f\x80\x87\x89\x8b\x8e\x91\x93\x94\x96\x98\x9d\xa9\xab\xaf\xb0\xb1\xb2\xba\xbb\xbd\xc2\xc4\xcf\xd4\xd7\xd9\xdd\xde\xdf\xe1\xe5\xe6\xe8\xec\xee\xef\xf5\xf7\xf8\xf9\xfa\xff",
+		"\x06\x0a\x14\x15\x17\x1c\x1e\x1f\x24\x26\x27\x28\x2e\x2f\x30\x33\x35\x36\x40\x41\x43\x44\x4b\x4d\x4f\x59\x5c\x5d\x60\x62\x63\x64\x66\x6c\x6e\x70\x72\x74\x77\x79\x82\x83\x84\x85\x8c\x90\x92\x97\x99\x9c\x9e\x9f\xa1\xa3\xa4\xa6\xa7\xa8\xae\xb4\xb5\xb6\xb9\xc0\xc5\xcc\xcd\xd1\xd3\xd5\xd6\xd8\xda\xe0\xe2\xe3\xf0\xf1\xf2\xf6\xfb\xfd",
+		"\x0a\x0d\x10\x12\x13\x16\x1a\x1b\x1f\x23\x25\x26\x29\x2d\x30\x34\x3a\x3b\x3c\x3d\x3e\x42\x44\x45\x47\x4e\x51\x53\x54\x55\x57\x5a\x5b\x5d\x61\x64\x67\x69\x6c\x6f\x72\x74\x76\x81\x86\x87\x89\x95\x98\x9a\x9f\xa0\xa2\xaf\xb0\xb3\xb5\xbd\xbf\xc1\xc3\xc4\xc5\xc7\xcf\xd1\xd3\xd4\xdd\xdf\xe0\xe5\xe7\xea\xed\xef\xf1\xf2\xf3\xf4\xf6\xfc",
+	},
+
+	// Group 37 'N'
+	{
+		"\x03\x06\x08\x0b\x12\x13\x17\x18\x19\x1b\x21\x27\x2a\x2f\x30\x31\x35\x36\x39\x41\x42\x43\x44\x4d\x4e\x50\x58\x59\x5a\x60\x62\x69\x6a\x6e\x70\x71\x72\x74\x76\x7a\x7b\x7c\x7d\x7f\x82\x84\x85\x88\x8b\x8d\x93\x94\x9d\x9f\xa0\xa4\xa5\xa6\xaa\xaf\xb4\xb5\xb6\xb8\xbc\xbd\xc1\xc5\xca\xcc\xcf\xd6\xd9\xda\xdd\xe1\xe5\xe6\xeb\xed\xef\xf0",
+		"\x01\x07\x09\x0a\x0d\x0e\x14\x1e\x1f\x25\x29\x2b\x2c\x32\x33\x37\x3a\x3b\x3c\x3f\x47\x48\x4a\x4b\x4c\x4f\x51\x53\x5b\x5c\x5e\x66\x67\x68\x6b\x6f\x75\x79\x81\x86\x87\x89\x8a\x8c\x8f\x91\x96\x98\x9b\xa2\xa7\xa9\xac\xad\xae\xb7\xbf\xc6\xc8\xcb\xcd\xd2\xd3\xd5\xdb\xdc\xde\xdf\xe0\xe2\xe3\xe8\xe9\xea\xec\xee\xf1\xf5\xf7\xfa\xfd\xfe",
+		"\x00\x04\x05\x0c\x0f\x10\x11\x15\x16\x1a\x1c\x1d\x20\x22\x23\x24\x28\x2d\x2e\x34\x38\x3d\x3e\x40\x45\x46\x49\x52\x54\x55\x56\x57\x5d\x5f\x63\x64\x65\x6c\x6d\x73\x80\x83\x8e\x90\x92\x95\x97\x99\x9a\x9c\x9e\xa1\xa3\xa8\xab\xb0\xb1\xb2\xb3\xb9\xba\xbb\xbe\xc2\xc3\xc4\xc7\xc9\xce\xd0\xd1\xd4\xd7\xd8\xe4\xe7\xf2\xf3\xf4\xfb\xfc\xff",
+		"\x02\x04\x07\x0d\x0e\x16\x1f\x21\x25\x26\x28\x29\x2a\x2b\x2c\x2e\x2f\x32\x3e\x40\x46\x4a\x4e\x50\x53\x59\x5c\x5d\x61\x62\x63\x68\x6e\x6f\x71\x77\x78\x7e\x85\x87\x8c\x8e\x8f\x91\x93\x96\x9f\xa1\xa6\xac\xb1\xb2\xb6\xb8\xba\xbc\xbd\xc0\xc2\xc4\xca\xcb\xcd\xce\xd6\xd9\xdd\xdf\xe0\xe5\xeb\xee\xf3\xf5\xf6\xf8\xf9\xfa\xfb\xfd\xfe\xff",
+		"\x00\x01\x08\x09\x0c\x14\x17\x1a\x1b\x1c\x1e\x22\x23\x26\x27\x35\x36\x37\x3a\x41\x43\x45\x48\x49\x4d\x54\x57\x58\x5f\x60\x61\x65\x66\x6a\x6c\x6d\x72\x73\x75\x78\x7b\x7c\x7f\x81\x83\x84\x88\x94\x9a\x9b\xa2\xa3\xa5\xa7\xa9\xab\xad\xaf\xb7\xb9\xc0\xc1\xc3\xc5\xc7\xc8\xcf\xd2\xd3\xd7\xd8\xdb\xdc\xe4\xe8\xef\xf1\xf2\xf7\xf8\xf9\xfc",
+		"\x02\x03\x06\x0a\x0b\x0f\x10\x11\x12\x13\x15\x18\x1d\x20\x24\x31\x34\x38\x39\x3b\x3c\x3d\x3f\x42\x44\x47\x4b\x4c\x4f\x51\x56\x5b\x5e\x64\x69\x6b\x70\x74\x76\x77\x79\x7d\x7e\x80\x82\x86\x89\x8a\x8d\x90\x92\x97\x98\x99\x9c\x9d\x9e\xa0\xa4\xa8\xaa\xb3\xb4\xb5\xbb\xbe\xc6\xc9\xcc\xd0\xd1\xd4\xd5\xda\xde\xe3\xe9\xea\xed\xf0\xf4\xf6",
+		"\x03\x05\x08\x0a\x0c\x0d\x11\x13\x14\x15\x16\x19\x1b\x1c\x20\x25\x27\x2b\x2d\x2f\x30\x32\x33\x3b\x46\x48\x4a\x52\x55\x5a\x66\x67\x6c\x74\x7a\x7b\x7f\x80\x81\x82\x84\x86\x8b\x8c\x90\x95\x97\x9a\x9b\xa3\xa6\xa9\xad\xae\xb0\xb4\xb6\xb7\xba\xbb\xbf\xc2\xc5\xce\xd7\xd8\xda\xdc\xdd\xe1\xe2\xe6\xe7\xe8\xec\xf1\xf3\xf6\xf7\xf8\xf9\xfb",
+	},
+
+	// Group 38 'O'
+	{
+		"\x00\x03\x07\x0a\x0c\x0d\x0e\x0f\x13\x16\x1a\x1c\x1d\x1e\x20\x25\x26\x2b\x2d\x33\x34\x37\x3a\x3d\x3e\x3f\x4a\x4d\x52\x53\x54\x55\x56\x5f\x6a\x6b\x6d\x70\x72\x76\x79\x7a\x7e\x7f\x88\x91\x92\x94\x99\x9c\x9e\xa0\xa1\xa2\xa3\xa6\xa9\xac\xad\xb1\xb3\xb7\xb8\xba\xbd\xc2\xc4\xc5\xc9\xd0\xd4\xd5\xd8\xd9\xde\xe1\xee\xf1\xf5\xf6\xfa\xfc",
+		"\x01\x04\x06\x17\x18\x22\x28\x2a\x2c\x2e\x2f\x35\x36\x39\x40\x41\x43\x45\x46\x47\x48\x4e\x4f\x51\x57\x58\x5a\x5b\x5d\x5e\x61\x62\x65\x66\x67\x68\x6c\x6e\x71\x73\x74\x78\x80\x82\x85\x8a\x8b\x8c\x8f\x90\x97\x98\x9f\xaa\xab\xaf\xb6\xbe\xbf\xc6\xc7\xcb\xcd\xd1\xd2\xda\xdb\xdc\xdd\xdf\xe4\xe5\xe6\xe7\xe8\xe9\xeb\xf2\xf3\xf4\xf9\xfe",
+		"\x02\x05\x08\x09\x0b\x10\x11\x12\x14\x1b\x1f\x21\x23\x24\x27\x29\x30\x31\x32\x38\x3b\x3c\x42\x49\x4b\x4c\x50\x59\x5c\x60\x63\x64\x69\x6f\x75\x77\x7b\x7d\x81\x83\x84\x86\x87\x89\x8d\x8e\x93\x95\x96\x9a\x9b\x9d\xa4\xa8\xae\xb0\xb4\xb5\xb9\xbb\xbc\xc0\xc1\xc8\xca\xcc\xce\xcf\xd3\xd6\xe0\xe3\xea\xec\xed\xef\xf0\xf7\xf8\xfb\xfd\xff",
+		"\x05\x07\x0f\x10\x12\x15\x19\x1b\x1e\x20\x23\x28\x2e\x30\x32\x34\x3c\x44\x4d\x4f\x53\x57\x5a\x5e\x5f\x68\x69\x6c\x6e\x72\x75\x76\x7c\x7e\x7f\x82\x86\x88\x89\x8a\x8d\x8f\x9d\xa2\xa3\xa4\xa5\xa6\xa7\xaa\xad\xae\xaf\xb0\xb1\xb2\xb5\xb9\xba\xbb\xbc\xbd\xc0\xc2\xc3\xc6\xc7\xcc\xd6\xd7\xe1\xe2\xe3\xe5\xeb\xee\xf2\xf3\xf6\xf8\xfb\xfe",
+		"\x00\x04\x0c\x0e\x13\x14\x15\x16\x18\x1a\x1c\x1f\x21\x22\x24\x25\x26\x27\x2a\x2c\x2f\x35\x37\x3b\x3f\x43\x44\x45\x46\x47\x49\x4c\x50\x51\x52\x58\x59\x62\x6a\x6d\x73\x77\x79\x7a\x7d\x83\x84\x8b\x8e\x90\x92\x95\x97\x99\x9a\x9f\xa0\xa9\xb2\xb4\xb6\xbf\xc1\xc3\xc5\xce\xcf\xd1\xd3\xd7\xda\xe0\xe2\xe4\xe6\xe9\xea\xef\xf0\xf4\xfc\xff",
+		"\x01\x02\x03\x06\x08\x0a\x0b\x0d\x11\x17\x19\x1d\x29\x2d\x33\x38\x39\x3a\x3d\x3e\x40\x41\x42\x48\x4b\x4e\x54\x55\x56\x5b\x5c\x5d\x60\x63\x64\x65\x67\x6b\x6f\x70\x71\x74\x80\x81\x85\x8c\x91\x94\x9b\xa1\xa5\xa7\xa8\xab\xac\xb3\xb7\xb8\xbe\xc4\xc8\xc9\xca\xcb\xcd\xd0\xd2\xd5\xd8\xd9\xdb\xdc\xdd\xde\xe7\xec\xed\xf1\xf5\xf7\xf9\xfa",
+		"\x05\x09\x0c\x0d\x0e\x0f\x13\x14\x15\x16\x17\x1a\x20\x29\x2a\x2b\x31\x36\x37\x3a\x3b\x3d\x3f\x41\x47\x4a\x4e\x56\x5f\x61\x66\x67\x68\x6a\x6d\x6e\x75\x78\x79\x7b\x7c\x7d\x7f\x87\x8a\x93\x96\x97\x98\x9c\x9e\x9f\xa5\xa9\xad\xaf\xb0\xb1\xb6\xbb\xbc\xbd\xc3\xc6\xc8\xca\xcd\xd3\xd4\xd8\xdf\xe1\xe3\xe5\xe8\xeb\xf1\xf2\xf6\xf9\xfd\xff",
+	},
+
+	// Group 39 'P'
+	{
+		"\x06\x0b\x12\x14\x15\x1b\x1c\x22\x24\x26\x28\x2b\x2c\x2d\x2f\x33\x35\x3a\x3e\x3f\x40\x42\x46\x48\x49\x4a\x4c\x4f\x51\x53\x55\x58\x5b\x5d\x5f\x62\x63\x6b\x6e\x77\x78\x7a\x7b\x7d\x7e\x7f\x80\x82\x83\x89\x97\x9b\x9d\x9f\xa1\xa4\xa6\xaa\xac\xb0\xb3\xba\xc5\xc6\xc7\xc8\xcd\xcf\xd3\xd4\xd8\xdf\xe1\xe4\xe7\xed\xef\xf3\xf5\xf8\xf9\xfd",
+		"\x00\x02\x03\x05\x07\x09\x0f\x10\x11\x16\x17\x19\x21\x25\x27\x29\x2a\x2e\x31\x32\x37\x38\x41\x43\x47\x50\x54\x56\x57\x5a\x5c\x60\x64\x65\x67\x69\x70\x72\x73\x75\x79\x85\x88\x8c\x90\x91\x93\x94\x99\x9a\x9e\xa0\xa8\xb2\xb4\xb6\xb9\xbc\xbd\xbe\xbf\xc1\xc3\xc9\xce\xd5\xd6\xd9\xda\xdc\xdd\xe0\xe6\xe9\xec\xee\xf0\xf6\xf7\xfa\xfc\xff",
+		"\x01\x04\x08\x0a\x0c\x0d\x0e\x13\x18\x1a\x1d\x1e\x1f\x23\x30\x34\x36\x39\x3c\x3d\x44\x45\x4b\x4e\x52\x59\x5e\x61\x68\x6a\x6c\x6d\x6f\x71\x74\x76\x7c\x81\x84\x86\x87\x8a\x8b\x8d\x8e\x8f\x92\x95\x96\x98\x9c\xa2\xa5\xa9\xab\xae\xb1\xb5\xb7\xb8\xbb\xc0\xc2\xca\xcb\xcc\xd0\xd2\xd7\xdb\xde\xe2\xe3\xe5\xe8\xea\xeb\xf1\xf2\xf4\xfb\xfe",
+		"\x08\x09\x0b\x0c\x11\x12\x14\x15\x17\x18\x1c\x20\x21\x23\x28\x2b\x2c\x38\x3b\x3e\x48\x4d\x4f\x50\x51\x53\x56\x59\x5d\x61\x66\x67\x68\x6a\x70\x72\x74\x78\x7c\x80\x84\x85\x88\x8a\x8b\x8d\x8f\x96\x9b\x9f\xa3\xa5\xa7\xaa\xac\xad\xae\xaf\xb3\xb7\xbd\xc0\xc1\xc4\xc5\xc7\xc9\xcf\xd0\xd1\xdb\xdc\xdf\xe1\xe3\xe7\xec\xed\xef\xf8\xfa\xfd",
+		"\x01\x02\x06\x07\x0d\x0e\x0f\x10\x13\x1a\x1d\x20\x24\x26\x27\x29\x2a\x2d\x2e\x32\x33\x34\x35\x36\x43\x45\x47\x4d\x4e\x5e\x62\x63\x65\x66\x6b\x6c\x6d\x6f\x71\x75\x76\x7a\x7e\x82\x83\x86\x87\x89\x8c\x91\x93\x94\x95\x98\x99\x9a\x9c\xa0\xa3\xa6\xa7\xab\xb0\xb4\xb5\xbc\xc2\xc8\xcb\xcc\xd3\xd4\xd8\xda\xdd\xde\xe8\xea\xf2\xf3\xf6\xf9",
+		"\x00\x03\x04\x05\x0a\x16\x19\x1b\x1e\x1f\x22\x2f\x30\x31\x37\x39\x3a\x3b\x3c\x3d\x40\x41\x42\x44\x49\x4a\x4b\x4c\x52\x54\x55\x57\x58\x5c\x60\x64\x69\x6e\x73\x77\x79\x7b\x7d\x7f\x81\x8e\x90\x92\x97\x9d\x9e\xa1\xa2\xad\xb2\xb6\xb8\xb9\xba\xbb\xbe\xbf\xc3\xc6\xca\xcd\xce\xd2\xd5\xd6\xd7\xd9\xe0\xe2\xe4\xe6\xee\xf1\xf4\xf5\xfb\xff",
+		"\x00\x02\x04\x08\x12\x15\x1d\x1e\x23\x25\x29\x37\x3b\x3f\x43\x44\x45\x46\x4d\x51\x53\x54\x55\x5a\x5b\x5d\x5f\x60\x61\x64\x67\x6a\x75\x76\x77\x78\x7c\x7f\x82\x83\x84\x8d\x8e\x8f\x91\x93\x9c\x9e\xa4\xa6\xa8\xa9\xaf\xb1\xb4\xb5\xb7\xbc\xbe\xc4\xc5\xce\xcf\xd1\xd4\xdb\xde\xe4\xe5\xe7\xe9\xeb\xed\xef\xf0\xf1\xf4\xf6\xf7\xfc\xfe\xff",
+	},
+
+	// Group 40 'Q'
+	{
+		"\x04\x06\x08\x09\x0b\x0c\x12\x17\x24\x29\x2d\x2f\x30\x33\x36\x3a\x3b\x3e\x41\x42\x43\x44\x45\x47\x48\x49\x4a\x4d\x51\x53\x54\x56\x5b\x5c\x60\x62\x63\x65\x67\x6c\x71\x73\x78\x79\x83\x8b\x8f\x91\x92\x94\x95\x96\x9b\x9f\xa0\xa3\xa7\xa9\xaa\xab\xb1\xb2\xb5\xc4\xc9\xca\xd0\xd1\xd4\xd5\xd8\xdb\xdc\xdd\xde\xe3\xe4\xe9\xea\xef\xf1\xff",
+		"\x01\x02\x03\x0a\x0d\x0e\x0f\x10\x15\x16\x1a\x1b\x1f\x22\x27\x28\x2c\x2e\x32\x34\x35\x39\x3c\x3f\x40\x4c\x50\x52\x55\x57\x5d\x6d\x74\x76\x7d\x81\x82\x84\x85\x87\x88\x8e\x90\x98\x99\x9a\xa2\xa6\xad\xae\xaf\xb3\xb4\xb6\xbe\xc1\xc2\xc8\xcb\xcc\xcf\xd2\xd6\xd9\xda\xdf\xe0\xe1\xe2\xe6\xe8\xec\xed\xf0\xf4\xf5\xf6\xf8\xf9\xfa\xfb\xfe",
+		"\x00\x05\x07\x11\x13\x14\x18\x19\x1c\x1d\x1e\x20\x21\x23\x25\x26\x2b\x37\x38\x3d\x46\x4b\x4e\x4f\x58\x5a\x5e\x5f\x61\x64\x66\x68\x69\x6a\x6b\x6e\x6f\x70\x72\x75\x77\x7a\x7b\x7c\x7e\x80\x86\x89\x8a\x8c\x8d\x93\x97\x9c\x9d\x9e\xa1\xa4\xa5\xa8\xac\xb0\xb7\xb8\xba\xbc\xbf\xc0\xc3\xc6\xc7\xcd\xce\xd3\xd7\xe5\xe7\xee\xf2\xf7\xfc\xfd",
+		"\x01\x06\x09\x0a\x0d\x13\x16\x18\x24\x27\x28\x2a\x2b\x2c\x2f\x30\x31\x32\x36\x3d\x3e\x40\x43\x44\x4d\x4e\x56\x59\x60\x67\x6d\x6e\x6f\x70\x7a\x7f\x81\x82\x89\x8f\x93\x95\x96\x97\x99\x9b\xa0\xa2\xa7\xb7\xb8\xb9\xba\xbb\xbc\xbd\xbe\xbf\xc0\xc5\xc7\xc8\xc9\xcb\xd1\xd2\xd3\xd4\xd7\xd8\xd9\xda\xdd\xe2\xe5\xe7\xeb\xec\xee\xf0\xf3\xf8",
+		"\x00\x02\x03\x08\x0e\x0f\x1a\x1b\x1f\x20\x25\x26\x29\x2a\x2d\x31\x33\x34\x35\x39\x41\x46\x48\x49\x4c\x4f\x50\x51\x53\x57\x58\x59\x5a\x5e\x61\x64\x66\x6b\x6c\x73\x74\x76\x78\x7c\x83\x84\x86\x8c\x8e\x90\x91\x94\x98\x9d\xa5\xaa\xab\xad\xb1\xb2\xb5\xb6\xb9\xbb\xc1\xc3\xc5\xc6\xcd\xd0\xd5\xdf\xe3\xe6\xeb\xf4\xf5\xf6\xf7\xf9\xfb\xfc",
+		"\x04\x07\x0b\x0c\x10\x11\x14\x15\x17\x19\x1c\x1d\x1e\x22\x23\x2e\x37\x38\x3a\x3b\x3c\x3f\x42\x45\x47\x4a\x4b\x55\x5b\x5c\x5d\x5f\x62\x63\x65\x69\x6a\x71\x75\x77\x7b\x7e\x7f\x80\x85\x87\x88\x8a\x8b\x8d\x92\x9a\x9e\x9f\xa1\xa8\xa9\xae\xb0\xb3\xb4\xc2\xca\xcc\xce\xcf\xdb\xdc\xde\xe1\xe4\xe8\xea\xed\xef\xf1\xf2\xf3\xfa\xfd\xfe\xff",
+		"\x00\x01\x05\x08\x0c\x0d\x0f\x12\x1b\x1e\x21\x25\x2a\x2b\x2c\x32\x35\x37\x38\x3e\x40\x48\x52\x54\x57\x59\x60\x61\x62\x63\x65\x68\x6b\x72\x74\x76\x78\x79\x7d\x7f\x80\x8b\x8c\x8e\x94\x97\x9b\x9c\x9e\x9f\xa0\xa3\xa4\xa6\xa8\xac\xaf\xb1\xb3\xb7\xb8\xbb\xbd\xc4\xc5\xcb\xcc\xd1\xd4\xd6\xdb\xdc\xdf\xe0\xe1\xe9\xee\xef\xf3\xf4\xf6\xfb",
+	},
+
+	// Group 41 'R'
+	{
+		"\x04\x06\x08\x09\x0a\x0b\x12\x13\x14\x15\x1a\x1c\x1e\x21\x29\x2a\x2c\x30\x32\x35\x39\x3a\x3d\x42\x45\x47\x48\x4a\x4c\x4e\x52\x54\x56\x57\x5c\x69\x6b\x6e\x70\x78\x7b\x80\x81\x83\x86\x89\x8c\x8d\x8e\x90\x99\x9a\x9c\x9e\x9f\xa0\xa3\xa7\xb2\xbc\xbf\xc1\xc3\xc4\xd1\xd3\xd4\xd5\xd9\xda\xdb\xe1\xe3\xe4\xe6\xee\xf0\xf2\xf3\xf8\xfb\xff",
+		"\x00\x03\x05\x0e\x0f\x10\x11\x17\x19\x1b\x22\x23\x26\x27\x2b\x2d\x2e\x33\x34\x36\x3c\x3e\x40\x43\x4f\x50\x53\x58\x59\x5a\x5b\x5f\x61\x63\x67\x6c\x6d\x6f\x71\x72\x74\x76\x7a\x7c\x7f\x84\x85\x91\x93\x94\x96\xa1\xa2\xa6\xa8\xab\xb0\xb3\xb7\xbb\xbd\xc5\xc6\xcd\xce\xd2\xd6\xd7\xd8\xdc\xdd\xe9\xec\xed\xef\xf1\xf4\xf6\xf7\xfc\xfd\xfe",
+		"\x01\x02\x07\x0c\x16\x18\x1d\x1f\x24\x25\x28\x2f\x31\x37\x38\x3b\x3f\x41\x44\x46\x49\x4b\x4d\x51\x55\x5e\x60\x62\x64\x65\x68\x6a\x75\x77\x79\x7e\x82\x87\x88\x8a\x8b\x92\x95\x97\x98\x9b\xa4\xa5\xa9\xaa\xad\xae\xaf\xb1\xb4\xb5\xb6\xb8\xb9\xba\xbe\xc2\xc7\xc8\xc9\xca\xcb\xcc\xcf\xd0\xde\xdf\xe0\xe2\xe5\xe7\xe8\xea\xeb\xf5\xf9\xfa",
+		"\x06\x07\x0d\x0e\x11\x15\x1a\x1f\x20\x21\x25\x27\x2b\x2d\x2e\x2f\x30\x32\x36\x44\x45\x4c\x50\x52\x55\x56\x5c\x5d\x5e\x62\x65\x66\x68\x6c\x70\x72\x73\x75\x76\x78\x79\x7a\x7b\x7d\x80\x83\x84\x8f\x9a\x9b\x9d\x9e\xac\xad\xb1\xb2\xb6\xb9\xbb\xbc\xbe\xc0\xc8\xcf\xd0\xd1\xd2\xd4\xd5\xd6\xd8\xdc\xdd\xde\xe0\xe9\xeb\xec\xf0\xf2\xf7\xfe",
+		"\x01\x04\x08\x09\x0a\x10\x12\x14\x19\x24\x26\x28\x29\x2a\x2c\x34\x37\x38\x3c\x3e\x3f\x41\x46\x47\x48\x4d\x4e\x53\x58\x59\x5d\x5f\x64\x66\x67\x69\x6b\x6f\x86\x89\x8a\x8b\x8e\x8f\x90\x93\x96\x97\x98\x9d\xa1\xa8\xac\xae\xb3\xb4\xb5\xb7\xb8\xba\xbf\xc1\xc3\xca\xcb\xcc\xcd\xce\xd3\xd9\xdb\xdf\xe1\xe5\xe6\xe8\xf1\xf4\xf6\xf9\xfb\xff",
+		"\x03\x05\x0c\x0d\x0f\x13\x16\x17\x18\x1b\x1c\x1d\x1e\x20\x22\x23\x31\x35\x39\x3a\x3b\x3d\x49\x4a\x4b\x4f\x51\x54\x5a\x5b\x60\x61\x63\x6a\x6d\x71\x73\x7c\x7d\x7e\x7f\x81\x82\x85\x87\x88\x8c\x8d\x91\x92\x94\x95\x99\x9c\x9f\xa0\xa2\xa3\xa4\xa5\xa7\xa9\xaa\xb0\xbd\xc0\xc4\xc5\xc6\xc7\xd7\xda\xe2\xe4\xe7\xed\xef\xf3\xf5\xf8\xfa\xfd",
+		"\x00\x02\x09\x0b\x0f\x16\x1a\x1b\x1c\x22\x23\x28\x29\x2b\x33\x35\x36\x38\x3b\x3c\x40\x42\x43\x4a\x4b\x4e\x53\x55\x56\x57\x5b\x5c\x5e\x60\x61\x66\x6b\x6e\x74\x75\x76\x77\x78\x82\x88\x8b\x8c\x8e\x93\x96\x99\x9e\x9f\xa6\xab\xad\xaf\xb8\xc2\xc3\xc4\xc6\xc7\xc9\xcd\xcf\xd0\xd4\xd8\xe2\xe3\xe9\xea\xeb\xec\xee\xef\xf0\xf1\xf4\xfb\xfc",
+	},
+
+	// Group 42 'S'
+	{
+		"\x00\x01\x04\x05\x07\x0d\x0e\x0f\x13\x15\x16\x1a\x1e\x1f\x21\x26\x27\x2a\x2d\x34\x37\x39\x3b\x3d\x44\x45\x46\x4a\x5b\x61\x62\x63\x64\x66\x67\x68\x6d\x70\x71\x76\x7b\x7e\x80\x82\x84\x91\x94\x95\x96\x97\xa0\xa2\xa3\xa5\xa7\xa8\xab\xac\xb0\xb3\xb5\xb7\xb8\xbf\xc8\xcd\xcf\xd2\xd7\xd8\xda\xdc\xe1\xe6\xe7\xec\xed\xf1\xf5\xfa\xfb\xfc",
+		"\x03\x09\x0a\x0b\x14\x17\x18\x19\x1b\x1c\x1d\x24\x25\x28\x29\x2e\x2f\x32\x35\x36\x3c\x3e\x3f\x41\x48\x4c\x4e\x4f\x50\x52\x53\x54\x59\x5a\x5e\x5f\x65\x6a\x6b\x6f\x73\x77\x78\x81\x83\x87\x88\x89\x8a\x8b\x8c\x8d\x90\x93\x98\x9a\x9b\xa1\xa6\xaa\xaf\xb6\xb9\xba\xbb\xbc\xbe\xc4\xc6\xcb\xcc\xd0\xd3\xd9\xdf\xe4\xe9\xee\xf0\xf6\xf8\xfe",
+		"\x02\x08\x0c\x10\x11\x12\x20\x22\x23\x2b\x2c\x30\x31\x33\x38\x3a\x40\x42\x43\x47\x49\x4d\x51\x55\x56\x57\x58\x5c\x5d\x60\x69\x6c\x6e\x72\x74\x75\x79\x7c\x7d\x7f\x85\x86\x8e\x8f\x92\x99\x9c\x9d\x9f\xa4\xa9\xad\xae\xb1\xb2\xb4\xc0\xc2\xc3\xc5\xc7\xc9\xca\xce\xd1\xd4\xd5\xd6\xdb\xdd\xe0\xe2\xe3\xe5\xe8\xea\xeb\xef\xf2\xf4\xfd\xff",
+		"\x00\x01\x06\x0f\x1e\x22\x24\x26\x29\x2a\x2b\x2c\x2d\x33\x35\x36\x39\x3e\x3f\x40\x41\x4b\x4d\x51\x54\x56\x58\x5f\x68\x6e\x71\x74\x7a\x80\x83\x84\x85\x8a\x8d\x8e\x92\x93\x94\x98\x9e\x9f\xa1\xa7\xa9\xaa\xad\xae\xb0\xb2\xb3\xb5\xb8\xbd\xc1\xc6\xc8\xc9\xcc\xcd\xd0\xd1\xdd\xde\xe2\xe3\xe7\xe8\xef\xf2\xf3\xf5\xf7\xf9\xfa\xfb\xfc\xff",
+		"\x02\x08\x09\x0b\x0d\x11\x12\x14\x15\x16\x1a\x1c\x1d\x20\x21\x2e\x30\x37\x3b\x3c\x3d\x42\x43\x46\x49\x4b\x50\x53\x57\x59\x5b\x64\x69\x6c\x6d\x6f\x70\x76\x78\x79\x7a\x7e\x7f\x89\x8b\x91\x95\x96\x97\x99\x9d\x9e\xa5\xa6\xab\xaf\xb1\xb6\xb7\xbb\xbd\xc2\xca\xce\xd3\xd4\xd5\xd7\xd9\xda\xdb\xdc\xe0\xe1\xec\xee\xf1\xf3\xf4\xf7\xf9\xfd",
+		"\x03\x04\x06\x07\x0a\x0c\x0e\x10\x13\x17\x18\x19\x1f\x23\x27\x28\x2f\x31\x32\x38\x44\x45\x47\x48\x4a\x4c\x4f\x52\x55\x5a\x5c\x5e\x62\x63\x65\x66\x67\x6b\x72\x73\x75\x77\x7d\x81\x82\x87\x88\x8c\x8f\x90\x9b\x9c\xa0\xa3\xa4\xa8\xac\xb9\xba\xbc\xbe\xbf\xc0\xc1\xc3\xc5\xc7\xcb\xcf\xd2\xd6\xdf\xe4\xe5\xe6\xe9\xea\xeb\xed\xf0\xf8\xfe",
+		"\x05\x07\x0a\x12\x16\x17\x19\x1b\x1c\x25\x26\x27\x2a\x2f\x33\x34\x3a\x40\x43\x45\x47\x48\x4c\x4d\x4e\x50\x52\x56\x5d\x60\x61\x69\x6a\x76\x78\x7a\x7b\x7c\x7d\x85\x86\x88\x89\x8e\x91\x93\x98\x9a\x9b\x9f\xa2\xa3\xaa\xad\xaf\xb4\xb7\xba\xbb\xbc\xc3\xc4\xc6\xc8\xce\xd1\xd2\xd3\xd6\xd8\xd9\xdb\xdd\xde\xe0\xe4\xe7\xe8\xec\xf0\xf5\xf6",
+	},
+
+	// Group 43 'T'
+	{
+		"\x00\x04\x07\x09\x0a\x0b\x0c\x0d\x0f\x12\x16\x18\x1a\x1e\x24\x2e\x2f\x30\x35\x39\x3c\x41\x43\x45\x48\x49\x4b\x4d\x52\x56\x58\x5a\x5c\x60\x61\x68\x6d\x75\x76\x77\x7c\x80\x81\x8b\x90\x97\x99\x9f\xa0\xa4\xa6\xa8\xa9\xad\xb0\xb1\xba\xbf\xc2\xc4\xc9\xca\xce\xcf\xd2\xd3\xd7\xdb\xdf\xe0\xe3\xe4\xe5\xed\xee\xef\xf0\xf2\xf4\xf6\xf9\xfa",
+		"\x01\x03\x05\x08\x0e\x19\x1c\x1f\x20\x28\x29\x2a\x2c\x31\x34\x36\x37\x38\x3a\x3e\x3f\x44\x46\x47\x4e\x4f\x51\x5b\x5d\x63\x64\x65\x6a\x6c\x6e\x73\x78\x79\x7a\x7d\x82\x86\x8d\x92\x93\x95\x9c\x9e\xa1\xa2\xa5\xac\xaf\xb2\xb4\xb7\xb9\xbb\xbd\xbe\xc3\xc5\xcb\xd0\xd1\xd6\xd8\xd9\xdc\xe1\xe6\xe7\xe8\xf1\xf3\xf5\xf7\xf8\xfc\xfd\xfe\xff",
+		"\x02\x06\x11\x13\x14\x15\x17\x1b\x1d\x21\x22\x23\x25\x26\x27\x2b\x2d\x33\x3b\x3d\x40\x42\x4a\x4c\x50\x53\x55\x57\x59\x5e\x5f\x62\x66\x67\x69\x6b\x6f\x71\x72\x74\x7b\x7e\x7f\x83\x84\x85\x87\x88\x89\x8a\x8c\x8e\x8f\x94\x96\x98\x9a\x9b\x9d\xa3\xaa\xab\xae\xb3\xb5\xb6\xbc\xc0\xc1\xc6\xc7\xc8\xcc\xd4\xda\xde\xe2\xe9\xea\xeb\xec\xfb",
+		"\x02\x07\x0f\x10\x13\x15\x1c\x1e\x24\x25\x2c\x2f\x31\x32\x33\x34\x39\x3f\x42\x43\x44\x47\x4a\x4b\x4d\x50\x54\x56\x5f\x63\x68\x6f\x70\x71\x74\x76\x7f\x80\x81\x8a\x8c\x8d\x90\x91\x98\x9b\x9c\xa0\xa1\xa2\xa6\xa7\xa8\xac\xaf\xb5\xb8\xba\xbc\xbf\xc1\xc8\xcd\xd0\xd1\xd2\xd3\xd5\xd9\xdd\xde\xe2\xe8\xeb\xec\xed\xf0\xf1\xf3\xf5\xf7\xfc",
+		"\x05\x06\x08\x0b\x0e\x14\x16\x19\x1a\x1b\x20\x21\x27\x28\x2b\x2e\x32\x38\x3b\x40\x45\x48\x4c\x4e\x53\x54\x58\x59\x5c\x5d\x60\x65\x66\x69\x6b\x70\x79\x7a\x7c\x7d\x7e\x84\x86\x87\x8f\x92\x94\x95\x96\x9a\x9d\xa3\xa4\xaa\xb1\xb3\xb4\xb7\xb8\xb9\xbb\xbd\xbe\xc2\xc3\xcd\xce\xd4\xd5\xdc\xdd\xe1\xe3\xe6\xe9\xea\xf2\xf6\xf9\xfa\xfd\xfe",
+		"\x00\x01\x03\x04\x09\x0a\x0c\x0d\x10\x11\x12\x1d\x1f\x22\x23\x26\x29\x2d\x30\x35\x37\x3a\x3c\x3d\x3e\x46\x49\x4f\x51\x55\x57\x5a\x5b\x5e\x61\x64\x67\x6a\x6c\x6d\x6e\x73\x75\x77\x83\x85\x88\x89\x8b\x91\x97\x99\x9f\xa5\xa7\xa9\xab\xae\xb2\xb6\xc0\xc5\xc6\xc9\xca\xcb\xcc\xcf\xd6\xd7\xda\xdb\xe0\xe4\xe5\xe7\xee\xef\xf4\xf8\xfb\xff",
+		"\x00\x04\x07\x09\x0e\x17\x18\x1a\x1c\x1d\x22\x23\x25\x2a\x33\x35\x36\x40\x41\x44\x45\x47\x50\x51\x52\x54\x5c\x61\x62\x64\x6e\x71\x72\x73\x78\x7b\x7c\x7d\x82\x84\x87\x8e\x90\x93\x97\x99\x9a\x9c\x9d\x9e\x9f\xa1\xa7\xa9\xac\xad\xb0\xb2\xb5\xb6\xb7\xbb\xbd\xc1\xc3\xc4\xc7\xcd\xd0\xd1\xd8\xd9\xdc\xdd\xdf\xe3\xe4\xe7\xeb\xed\xf5\xf6",
+	},
+
+	// Group 44 'U'
+	{
+		"\x01\x04\x05\x09\x0d\x0f\x11\x16\x19\x1a\x1b\x1d\x1e\x21\x23\x2b\x30\x31\x33\x34\x37\x3b\x42\x46\x4b\x4d\x4f\x51\x57\x58\x5a\x5d\x5f\x60\x63\x65\x67\x69\x6e\x71\x73\x74\x76\x7d\x80\x81\x82\x83\x86\x8a\x8c\x8d\x8e\x90\x99\x9b\x9f\xa0\xa1\xa8\xaa\xab\xac\xb0\xb9\xbd\xbe\xc8\xca\xcf\xd2\xd9\xdb\xde\xe0\xe8\xed\xf3\xf4\xf6\xf9\xfa",
+		"\x00\x03\x07\x08\x0c\x0e\x12\x1f\x25\x28\x2e\x2f\x32\x35\x36\x3a\x3c\x3d\x40\x41\x44\x45\x47\x4c\x50\x53\x54\x56\x5c\x5e\x61\x68\x6a\x6d\x75\x77\x79\x7b\x7c\x84\x85\x88\x89\x8b\x8f\x95\x9a\x9c\x9e\xa5\xa7\xa9\xaf\xb1\xb2\xb3\xb5\xb8\xba\xbb\xbc\xc0\xc2\xc3\xc4\xcd\xdf\xe1\xe3\xe4\xe7\xe9\xea\xeb\xec\xef\xf5\xf8\xfb\xfd\xfe\xff",
+		"\x02\x06\x0a\x0b\x10\x13\x14\x15\x17\x18\x1c\x20\x22\x24\x26\x29\x2a\x2c\x2d\x39\x3e\x3f\x43\x48\x4a\x4e\x52\x55\x59\x5b\x62\x64\x66\x6b\x6c\x6f\x70\x72\x78\x7a\x7e\x7f\x87\x92\x93\x94\x96\x97\x98\x9d\xa2\xa3\xa4\xa6\xad\xae\xb6\xb7\xbf\xc1\xc6\xc7\xc9\xce\xd0\xd1\xd3\xd4\xd5\xd6\xd7\xd8\xda\xdc\xdd\xe2\xe5\xe6\xee\xf0\xf1\xf7",
+		"\x00\x08\x0d\x0e\x10\x11\x13\x19\x1a\x1b\x1d\x1f\x27\x28\x2d\x30\x34\x38\x3b\x3e\x3f\x40\x43\x46\x49\x4a\x4d\x52\x58\x5d\x5e\x61\x62\x65\x68\x69\x6a\x6b\x6c\x77\x78\x82\x83\x84\x85\x8e\x91\x9e\xa0\xa2\xa7\xa8\xaa\xac\xad\xae\xb1\xb3\xb4\xb7\xba\xc2\xc5\xc7\xca\xcb\xcc\xcd\xd5\xd7\xdd\xdf\xe0\xe1\xe4\xea\xeb\xf2\xf6\xf7\xf9\xfc",
+		"\x01\x02\x06\x07\x0a\x0c\x17\x20\x21\x22\x24\x26\x27\x2c\x2f\x31\x32\x33\x35\x3c\x3d\x47\x4b\x4e\x4f\x50\x51\x54\x56\x59\x5f\x63\x64\x66\x6d\x71\x74\x79\x7d\x7f\x81\x87\x88\x8c\x8f\x91\x92\x97\x98\x99\x9a\x9d\xa1\xa4\xa6\xb0\xb5\xb6\xb8\xbb\xbc\xbf\xc3\xc6\xc9\xcc\xcf\xd8\xdb\xdc\xe5\xe8\xe9\xec\xed\xee\xf2\xf3\xf4\xfa\xfb\xff",
+		"\x03\x04\x05\x09\x0b\x0f\x16\x18\x1c\x1e\x23\x25\x29\x2a\x2b\x2e\x36\x38\x39\x41\x44\x45\x48\x4c\x53\x57\x5a\x5b\x5c\x60\x67\x6e\x6f\x70\x72\x75\x76\x7a\x7c\x7e\x80\x86\x89\x8a\x8b\x8d\x90\x93\x94\x95\x9b\x9c\x9f\xa3\xa5\xa9\xab\xaf\xb9\xbd\xc0\xc5\xc8\xcb\xce\xd1\xd2\xd3\xd4\xd6\xd9\xda\xde\xe3\xe6\xe7\xef\xf1\xf5\xfc\xfd\xfe",
+		"\x00\x0f\x10\x11\x12\x14\x15\x1c\x1d\x22\x2b\x2d\x2f\x37\x39\x3a\x3d\x40\x42\x44\x49\x4a\x4c\x4e\x54\x55\x57\x62\x63\x65\x68\x69\x6a\x6e\x71\x72\x73\x7b\x7c\x84\x85\x89\x8c\x8d\x90\x93\x95\x96\xb0\xb2\xb4\xb5\xb6\xb8\xba\xbb\xbe\xbf\xc1\xc4\xc5\xc8\xcd\xcf\xd0\xd1\xd5\xd7\xdb\xdc\xe2\xe6\xe7\xe9\xed\xf0\xf1\xf2\xf3\xf8\xfc\xfd",
+	},
+
+	// Group 45 'V'
+	{
+		"\x01\x03\x04\x05\x07\x08\x10\x11\x13\x15\x1d\x1e\x21\x22\x24\x26\x27\x2c\x2d\x2f\x33\x35\x37\x38\x3a\x3e\x43\x45\x46\x4c\x4d\x50\x52\x53\x58\x62\x64\x66\x68\x6a\x6c\x7a\x7d\x82\x89\x8b\x8f\x96\x98\x99\x9b\x9f\xa0\xa2\xa3\xa4\xa5\xa7\xb1\xb3\xb6\xb9\xba\xbc\xbf\xc0\xc1\xc6\xcb\xcc\xd1\xd4\xd7\xdb\xdf\xe8\xe9\xeb\xec\xed\xf6\xfe",
+		"\x06\x09\x0a\x0b\x0d\x0f\x12\x18\x19\x20\x25\x2a\x30\x34\x36\x3b\x3c\x3f\x40\x42\x44\x49\x4a\x4f\x57\x5b\x5d\x5f\x63\x67\x6f\x70\x74\x75\x76\x77\x79\x7b\x7e\x80\x83\x85\x87\x88\x8a\x90\x9c\x9e\xa9\xad\xb0\xb2\xb8\xbb\xc2\xc3\xc5\xc7\xc8\xca\xcd\xcf\xd0\xd2\xd9\xda\xdc\xdd\xde\xe1\xe5\xe6\xea\xee\xef\xf4\xf8\xf9\xfb\xfc\xfd\xff",
+		"\x00\x02\x0e\x14\x16\x17\x1a\x1b\x1c\x1f\x28\x29\x2e\x31\x32\x39\x3d\x41\x47\x48\x4b\x4e\x51\x54\x55\x56\x59\x5a\x5c\x5e\x60\x65\x69\x6b\x6d\x6e\x72\x73\x78\x7c\x81\x84\x86\x8c\x8d\x8e\x91\x92\x93\x94\x95\x97\x9a\x9d\xa1\xa6\xaa\xab\xac\xae\xaf\xb4\xb5\xb7\xbd\xbe\xc4\xc9\xce\xd5\xd6\xd8\xe0\xe3\xe4\xe7\xf0\xf2\xf3\xf5\xf7\xfa",
+		"\x02\x04\x06\x08\x09\x0a\x0c\x0d\x1a\x23\x24\x2a\x2b\x32\x35\x39\x3b\x3e\x43\x44\x46\x47\x48\x49\x4d\x4f\x50\x53\x55\x5c\x5e\x5f\x61\x66\x6b\x71\x75\x77\x7b\x7f\x84\x8c\x8e\x91\x97\x98\x9f\xa0\xa2\xa3\xa4\xa5\xa8\xaa\xab\xac\xb1\xb5\xba\xbd\xbe\xc0\xc8\xcd\xce\xcf\xd0\xd1\xd2\xd3\xd9\xdd\xdf\xe0\xe2\xe7\xed\xee\xef\xf1\xf8\xff",
+		"\x01\x05\x07\x0b\x0c\x0e\x18\x1b\x1c\x27\x28\x29\x2c\x2e\x33\x34\x37\x38\x3a\x3f\x40\x41\x4a\x4b\x4e\x51\x5b\x60\x61\x67\x69\x6e\x71\x74\x78\x79\x7c\x7f\x80\x81\x82\x83\x88\x89\x90\x96\x99\x9d\x9e\xa6\xad\xae\xb0\xb2\xb3\xb4\xb7\xb8\xb9\xbf\xc5\xc7\xc9\xcc\xd6\xd7\xd8\xdc\xe1\xe2\xe3\xe6\xe9\xea\xeb\xec\xf0\xf2\xf4\xf6\xfc\xfe",
+		"\x00\x03\x0f\x10\x11\x13\x15\x16\x17\x1d\x1e\x20\x22\x23\x25\x26\x2b\x2d\x30\x31\x3c\x3d\x42\x45\x52\x54\x56\x57\x59\x5a\x62\x63\x64\x65\x68\x6a\x6c\x6d\x6f\x70\x72\x73\x76\x7a\x7d\x7e\x85\x86\x87\x8a\x8b\x8d\x8f\x92\x93\x94\x95\x9a\x9b\x9c\xa7\xa8\xa9\xb6\xbb\xbc\xc1\xc3\xc4\xc6\xd4\xd5\xda\xdb\xde\xe4\xe5\xf1\xf7\xfa\xfb\xfd",
+		"\x02\x06\x0c\x0d\x0e\x12\x13\x14\x19\x1d\x1f\x21\x24\x27\x2a\x2f\x36\x39\x3d\x3f\x41\x48\x4b\x4c\x4d\x4f\x50\x52\x53\x54\x58\x5a\x5c\x5d\x69\x6d\x76\x7e\x81\x83\x84\x88\x89\x8c\x92\x93\x94\x9a\x9b\x9c\xa1\xa5\xaa\xac\xad\xaf\xb3\xb7\xba\xbe\xc2\xc3\xc5\xca\xcb\xcc\xce\xcf\xd3\xd7\xd8\xd9\xe3\xe6\xe8\xe9\xf0\xf2\xf3\xf5\xf8\xf9",
+	},
+
+	// Group 46 'W'
+	{
+		"\x00\x01\x04\x08\x0d\x12\x16\x1b\x1c\x20\x21\x2d\x2e\x2f\x34\x37\x3a\x3b\x43\x45\x4d\x50\x51\x54\x55\x58\x5a\x5b\x5d\x61\x62\x63\x65\x67\x68\x6a\x6d\x70\x71\x7b\x7c\x7f\x82\x89\x8b\x95\x97\x9b\x9c\x9d\x9f\xa0\xa1\xa3\xa4\xa8\xab\xad\xaf\xb1\xb7\xb9\xbe\xc0\xc1\xc3\xc4\xc6\xc8\xd0\xd1\xd2\xd3\xdc\xdf\xe1\xe2\xe3\xec\xf9\xfa\xfd",
+		"\x02\x03\x0b\x0e\x10\x18\x1a\x1e\x22\x24\x25\x27\x28\x29\x2b\x31\x36\x39\x3e\x41\x42\x49\x4c\x4e\x4f\x5c\x69\x6c\x73\x76\x77\x78\x79\x7a\x7e\x80\x83\x87\x88\x8a\x8c\x8d\x8f\x91\x92\xa7\xa9\xae\xb2\xb3\xb4\xb5\xb8\xba\xbc\xbd\xbf\xc7\xca\xcc\xcd\xd4\xd5\xd6\xd8\xd9\xda\xdb\xdd\xde\xe4\xe5\xe8\xe9\xeb\xed\xf1\xf3\xf6\xf7\xfb\xfe",
+		"\x05\x06\x07\x09\x0c\x0f\x11\x13\x19\x1d\x1f\x23\x26\x2c\x30\x32\x35\x38\x3c\x3d\x3f\x40\x44\x46\x47\x48\x4a\x4b\x52\x53\x56\x57\x59\x5e\x5f\x60\x64\x66\x6b\x6f\x72\x74\x75\x7d\x84\x85\x86\x8e\x93\x94\x98\x99\x9a\x9e\xa2\xa5\xa6\xaa\xac\xb0\xb6\xbb\xc2\xc5\xc9\xcb\xce\xcf\xd7\xe0\xe6\xe7\xea\xee\xef\xf0\xf2\xf4\xf5\xf8\xfc\xff",
+		"\x00\x02\x03\x05\x09\x0a\x0d\x12\x14\x15\x17\x19\x1d\x1e\x29\x2a\x2f\x33\x37\x3d\x3f\x42\x49\x4a\x51\x56\x5a\x5c\x5f\x62\x66\x67\x6b\x6c\x6e\x6f\x70\x72\x76\x78\x81\x82\x84\x89\x8a\x90\x91\x93\x96\x98\x9a\x9b\x9c\xa0\xa4\xa7\xa9\xaa\xac\xad\xb6\xb8\xbc\xbd\xc0\xc3\xca\xcb\xd1\xd3\xda\xdd\xe2\xe3\xe8\xeb\xed\xf2\xf4\xf8\xfa\xfd",
+		"\x04\x0a\x0b\x0c\x10\x18\x1a\x1f\x25\x27\x30\x33\x34\x38\x3a\x41\x44\x45\x46\x47\x4b\x4c\x4f\x50\x53\x54\x61\x68\x6e\x73\x74\x77\x79\x7a\x7c\x7d\x7f\x81\x87\x8b\x8c\x90\x94\x96\x9f\xa1\xa5\xae\xb1\xb2\xb7\xb9\xba\xbb\xbe\xbf\xc1\xc2\xc9\xcd\xcf\xd0\xd2\xd5\xd6\xd7\xde\xdf\xe0\xe1\xe4\xe9\xec\xee\xef\xf0\xf1\xf3\xf6\xfc\xfe\xff",
+		"\x06\x07\x08\x0f\x11\x13\x14\x16\x17\x1b\x1c\x20\x21\x22\x23\x28\x2b\x2c\x2d\x2e\x31\x35\x36\x39\x3b\x3e\x40\x43\x48\x4d\x4e\x52\x57\x58\x59\x5b\x5d\x5e\x60\x64\x65\x69\x6a\x6d\x71\x75\x7e\x83\x85\x86\x88\x8d\x8e\x92\x95\x99\xa2\xa3\xa6\xa8\xaf\xb0\xb3\xb4\xb5\xc5\xc6\xc7\xc8\xcc\xce\xd4\xd8\xd9\xdb\xdc\xe5\xe6\xe7\xea\xf7\xf9",
+		"\x01\x04\x05\x06\x0c\x0e\x15\x16\x19\x22\x24\x25\x26\x27\x29\x2a\x2b\x2c\x32\x33\x3a\x3c\x45\x48\x4e\x52\x53\x55\x58\x5a\x5c\x60\x63\x66\x6b\x6d\x72\x73\x75\x77\x78\x7a\x7b\x80\x81\x85\x89\x8b\x8f\x96\x97\x9d\x9e\xa1\xa9\xab\xad\xaf\xb0\xb5\xb6\xb9\xc4\xc5\xca\xcf\xd4\xd8\xd9\xdb\xde\xe6\xe8\xea\xef\xf0\xf3\xf4\xf5\xfb\xfe\xff",
+	},
+
+	// Group 47 'X'
+	{
+		"\x00\x03\x07\x0d\x14\x16\x18\x1a\x1f\x25\x29\x2a\x2c\x33\x34\x37\x3a\x3c\x3e\x43\x45\x49\x4b\x4d\x4f\x50\x52\x54\x56\x5a\x5d\x5f\x60\x66\x68\x6a\x6c\x6e\x75\x76\x7a\x7f\x83\x84\x8a\x8b\x8c\x8f\x91\x93\x94\x95\x96\x98\x9b\x9d\xa4\xa5\xaa\xb2\xb4\xb5\xb6\xbc\xbf\xc0\xc4\xc8\xcc\xd2\xd8\xdb\xdc\xde\xeb\xed\xf1\xf6\xf8\xf9\xfa\xfb",
+		"\x06\x08\x0e\x12\x1b\x1e\x21\x22\x24\x27\x2b\x2e\x2f\x35\x36\x39\x3f\x42\x44\x46\x48\x4c\x4e\x51\x53\x55\x57\x58\x5b\x5e\x62\x65\x69\x6b\x6d\x70\x72\x73\x74\x79\x7c\x82\x85\x89\x8d\x97\x9c\x9e\xa1\xa2\xa7\xad\xae\xb3\xb7\xb8\xb9\xba\xbe\xc6\xc9\xcd\xcf\xd0\xd1\xd3\xd5\xd7\xd9\xdf\xe0\xe1\xe3\xe4\xe5\xe8\xec\xef\xf0\xf2\xf3\xfc",
+		"\x01\x02\x04\x05\x09\x0a\x0b\x0c\x0f\x10\x13\x15\x17\x19\x1c\x1d\x23\x26\x28\x30\x31\x32\x38\x3b\x3d\x40\x41\x47\x4a\x59\x5c\x61\x63\x64\x67\x6f\x71\x77\x7d\x80\x81\x86\x87\x88\x8e\x90\x92\x99\x9a\x9f\xa0\xa3\xa6\xa8\xa9\xab\xac\xaf\xb0\xb1\xbb\xc1\xc2\xc3\xc5\xc7\xca\xcb\xce\xd4\xd6\xdd\xe2\xe6\xe7\xe9\xea\xee\xf4\xf5\xf7\xff",
+		"\x02\x04\x06\x0b\x10\x11\x14\x15\x1a\x20\x22\x23\x2a\x2d\x32\x33\x34\x36\x37\x38\x3d\x3e\x43\x4a\x4b\x4e\x51\x54\x57\x59\x5a\x5e\x69\x6a\x6f\x72\x77\x78\x7b\x7c\x7e\x84\x85\x87\x88\x89\x8a\x8b\x90\x94\x98\x9d\xa1\xaa\xb0\xb1\xb4\xb6\xbd\xc0\xc1\xc6\xc8\xc9\xcd\xce\xd0\xd8\xda\xde\xe2\xe4\xe6\xe7\xe9\xea\xef\xf3\xf7\xfc\xfd\xfe",
+		"\x01\x07\x09\x0c\x0f\x11\x13\x16\x18\x1c\x1e\x1f\x20\x21\x24\x26\x2b\x2c\x2e\x2f\x30\x3c\x3f\x41\x44\x45\x47\x48\x49\x4c\x53\x55\x56\x5b\x60\x61\x63\x64\x65\x67\x6b\x6d\x6e\x70\x73\x74\x75\x78\x79\x7b\x7f\x80\x8d\x91\x93\x97\x9a\x9c\x9e\xa7\xa8\xa9\xac\xb8\xba\xbb\xbc\xbf\xc3\xc4\xc5\xc7\xd9\xdb\xdf\xe5\xeb\xec\xf1\xf2\xfe\xff",
+		"\x00\x03\x08\x0a\x0e\x17\x1b\x1d\x25\x27\x29\x2d\x31\x35\x39\x3a\x3b\x40\x42\x46\x4f\x50\x52\x58\x5c\x5d\x5f\x66\x68\x6c\x71\x76\x7a\x7d\x7e\x81\x82\x83\x86\x8c\x8e\x8f\x92\x95\x99\x9b\x9f\xa0\xa2\xa3\xa4\xa6\xab\xaf\xb3\xb7\xb9\xbd\xbe\xc2\xcb\xcf\xd1\xd2\xd3\xd4\xd5\xd6\xdc\xdd\xe1\xe3\xe8\xed\xf0\xf4\xf5\xf6\xf8\xfa\xfb\xfd",
+		"\x03\x04\x05\x08\x0a\x0b\x0d\x12\x13\x17\x19\x1a\x1d\x22\x28\x2c\x2e\x3d\x3f\x41\x43\x45\x46\x48\x4d\x4e\x55\x5e\x5f\x62\x65\x69\x6d\x6e\x6f\x76\x79\x80\x83\x89\x8a\x8c\x8f\x92\x93\x95\x96\x9b\x9c\x9e\xa5\xaa\xac\xad\xae\xb1\xb2\xb3\xb4\xb5\xb7\xb8\xb9\xbc\xc3\xc5\xc7\xca\xcc\xd1\xd7\xda\xdf\xe0\xe5\xee\xef\xf1\xf6\xf7\xf9\xff",
+	},
+
+	// Group 48 'Y'
+	{
+		"\x00\x02\x06\x08\x09\x0a\x11\x13\x23\x25\x27\x2d\x2f\x32\x33\x34\x3a\x3c\x3e\x3f\x41\x4c\x4d\x4f\x54\x56\x59\x5a\x5b\x5c\x5d\x5e\x5f\x62\x67\x6c\x6d\x75\x78\x7a\x7b\x7c\x7f\x80\x84\x87\x89\x8e\x94\x95\x96\x97\x98\x9c\xa2\xa9\xac\xb0\xb1\xb3\xb7\xba\xbb\xbd\xbe\xbf\xc3\xc4\xc9\xcd\xd4\xd5\xdb\xe0\xe6\xe8\xe9\xed\xee\xf0\xf3\xfd",
+		"\x01\x04\x07\x10\x12\x14\x15\x1b\x1c\x1d\x1f\x22\x24\x26\x28\x29\x2b\x2c\x2e\x37\x38\x39\x3b\x42\x43\x45\x48\x49\x57\x60\x61\x65\x66\x69\x6a\x6e\x70\x73\x79\x7e\x81\x85\x86\x88\x8b\x8c\x90\x92\x93\x99\x9a\x9b\x9e\xa4\xa5\xa7\xad\xaf\xb2\xb4\xb5\xb6\xbc\xc0\xc6\xcc\xce\xcf\xd6\xdd\xdf\xe1\xe2\xe4\xe7\xec\xef\xf4\xf6\xf8\xfc\xff",
+		"\x03\x05\x0b\x0c\x0d\x0e\x0f\x16\x17\x18\x19\x1a\x1e\x20\x21\x2a\x31\x35\x36\x3d\x40\x44\x46\x4a\x4b\x4e\x51\x52\x53\x55\x58\x63\x64\x68\x6b\x6f\x71\x74\x76\x77\x82\x83\x8a\x8d\x8f\x91\x9d\x9f\xa0\xa1\xa3\xa6\xa8\xaa\xab\xae\xb8\xb9\xc1\xc2\xc5\xc7\xc8\xca\xcb\xd0\xd1\xd2\xd3\xd7\xd8\xd9\xda\xdc\xe3\xe5\xea\xeb\xf5\xf7\xf9\xfe",
+		"\x02\x05\x06\x0d\x0e\x0f\x13\x14\x1b\x1c\x1f\x2a\x2b\x2f\x30\x3a\x42\x44\x45\x47\x48\x4d\x4f\x50\x53\x58\x5c\x64\x68\x6a\x6d\x70\x71\x72\x73\x74\x79\x7c\x7d\x7f\x80\x86\x8c\x90\x91\x94\x96\x9b\x9c\x9e\x9f\xa0\xa7\xab\xaf\xb1\xb3\xb4\xb5\xb7\xb8\xba\xc0\xc2\xc6\xc7\xd5\xd7\xd9\xdc\xdd\xde\xe0\xe1\xe3\xe5\xf0\xf1\xf2\xfa\xfb\xfc",
+		"\x00\x01\x08\x09\x10\x11\x12\x15\x1d\x20\x24\x25\x26\x27\x28\x2d\x31\x35\x36\x38\x3b\x3e\x3f\x47\x4a\x4c\x51\x54\x5a\x5d\x5e\x5f\x60\x61\x65\x69\x72\x75\x77\x7a\x7b\x7e\x83\x85\x89\x8a\x8d\x8e\x98\xa1\xa2\xa3\xa4\xaa\xbc\xbe\xbf\xc3\xc4\xc5\xc9\xca\xcc\xd2\xd3\xd4\xd8\xda\xe4\xe6\xe7\xe8\xeb\xf1\xf2\xf6\xf7\xf9\xfa\xfb\xfd\xff",
+		"\x03\x04\x0a\x0b\x0c\x16\x17\x18\x19\x1a\x1e\x21\x22\x23\x29\x2c\x2e\x34\x39\x3c\x3d\x40\x41\x43\x46\x49\x4b\x50\x55\x56\x57\x5b\x62\x63\x66\x6b\x6e\x6f\x78\x7d\x82\x87\x88\x8b\x8f\x92\x95\x97\x9d\xa5\xa6\xa8\xa9\xac\xae\xb2\xb9\xbb\xbd\xc1\xc8\xcb\xcd\xce\xcf\xd0\xd1\xd6\xdb\xde\xdf\xe2\xe9\xea\xec\xed\xee\xef\xf3\xf4\xf5\xfe",
+		"\x01\x02\x04\x07\x08\x0a\x0b\x0f\x10\x17\x1f\x21\x26\x2d\x30\x32\x33\x37\x3f\x41\x4c\x4e\x4f\x50\x51\x52\x53\x59\x5a\x60\x61\x66\x67\x6c\x6e\x74\x76\x78\x81\x84\x85\x8a\x93\x94\x96\x98\x99\x9a\x9b\x9f\xa1\xa2\xa6\xad\xae\xaf\xb0\xb1\xb6\xb9\xbb\xbc\xc1\xc3\xc5\xc9\xcb\xcc\xcd\xd1\xd3\xd8\xe5\xe9\xed\xee\xf1\xf6\xf8\xfb\xfd\xfe",
+	},
+
+	// Group 49 'Z'
+	{
+		"\x01\x04\x05\x0a\x0d\x0e\x10\x11\x12\x16\x17\x1a\x20\x22\x24\x28\x2d\x2e\x35\x37\x3a\x3d\x3e\x43\x44\x4d\x4e\x50\x56\x5c\x62\x63\x64\x66\x67\x68\x6b\x6f\x74\x76\x77\x7a\x7f\x84\x8b\x8c\x90\x92\x98\x9a\x9c\xa8\xab\xac\xaf\xb0\xb7\xb8\xbc\xc0\xc5\xc7\xc9\xd0\xd4\xd5\xd6\xd8\xdb\xdf\xe0\xe3\xe6\xe7\xea\xee\xef\xf0\xf2\xf5\xf6\xfe",
+		"\x00\x02\x07\x08\x0c\x0f\x14\x15\x18\x1f\x21\x25\x26\x27\x29\x2b\x2f\x30\x31\x32\x34\x39\x3b\x3c\x40\x41\x45\x47\x48\x49\x4b\x4c\x52\x54\x57\x58\x59\x5d\x69\x6e\x71\x73\x78\x80\x82\x83\x85\x87\x8e\x8f\x91\x93\x94\x9f\xa0\xa1\xa5\xa9\xad\xae\xb1\xb5\xb6\xb9\xba\xbb\xbf\xc1\xc8\xca\xce\xd3\xd7\xda\xdc\xe9\xeb\xf1\xf3\xf7\xfc\xff",
+		"\x09\x0b\x13\x19\x1b\x1c\x1d\x1e\x23\x2a\x2c\x36\x38\x3f\x42\x46\x4a\x4f\x53\x55\x5a\x5b\x5e\x5f\x60\x61\x65\x6c\x6d\x70\x72\x75\x79\x7b\x7c\x7d\x7e\x81\x86\x88\x89\x8d\x95\x96\x97\x99\x9b\x9d\x9e\xa3\xa4\xa6\xa7\xaa\xb2\xb3\xbd\xbe\xc2\xc3\xc4\xcb\xcc\xcd\xd1\xd2\xd9\xdd\xde\xe1\xe2\xe4\xe5\xe8\xec\xed\xf4\xf8\xf9\xfa\xfb\xfd",
+		"\x01\x02\x03\x06\x0b\x0c\x10\x11\x15\x16\x18\x19\x1b\x1c\x1d\x20\x21\x22\x29\x2b\x2d\x33\x36\x37\x3a\x3b\x3d\x48\x49\x4b\x4e\x51\x58\x5a\x61\x6a\x6b\x6c\x6d\x72\x79\x7c\x83\x86\x8a\x8d\x8e\x90\x92\x94\x95\x96\x9f\xa0\xa2\xa4\xa5\xa6\xa7\xa9\xaa\xb1\xb3\xb4\xb6\xbc\xbe\xbf\xc2\xc6\xc9\xcc\xcf\xd0\xd1\xda\xde\xe0\xf0\xf2\xf4\xfa",
+		"\x00\x03\x05\x07\x09\x0f\x17\x1e\x1f\x23\x24\x28\x2c\x2f\x31\x32\x34\x3c\x3f\x40\x41\x42\x44\x46\x47\x4c\x4d\x52\x55\x56\x57\x59\x5d\x5f\x60\x64\x65\x6a\x6f\x76\x77\x78\x7b\x7d\x80\x84\x8a\x8b\x8c\x93\x97\x9c\x9e\xa1\xa3\xac\xb2\xb5\xc3\xc5\xc6\xc8\xca\xd2\xd3\xd6\xd7\xd8\xd9\xdf\xe1\xe3\xe5\xe7\xe8\xea\xee\xf8\xf9\xfb\xfc\xfd",
+		"\x04\x06\x08\x0d\x0e\x12\x1a\x25\x26\x27\x2a\x30\x33\x35\x3e\x45\x4a\x50\x51\x53\x54\x5b\x5c\x5e\x63\x69\x6e\x70\x71\x73\x74\x75\x7a\x7e\x7f\x81\x82\x85\x88\x8f\x98\x9a\x9b\x9d\xa2\xa8\xab\xad\xae\xaf\xb0\xb4\xb8\xb9\xbb\xbd\xc0\xc1\xc4\xc7\xcb\xcd\xce\xcf\xd5\xdb\xdc\xdd\xe2\xe4\xe6\xe9\xeb\xec\xed\xef\xf1\xf3\xf6\xf7\xfe\xff",
+		"\x01\x03\x0a\x0b\x0e\x0f\x10\x12\x13\x14\x17\x18\x1c\x22\x2a\x2b\x2c\x2d\x2e\x30\x33\x38\x39\x3b\x3d\x3f\x43\x4f\x51\x52\x5b\x5f\x61\x62\x66\x67\x68\x6a\x70\x71\x73\x79\x7d\x81\x86\x87\x89\x8b\x8f\x91\x93\x94\x96\x97\x99\x9b\xa4\xa5\xa6\xa7\xae\xb3\xb7\xba\xbe\xbf\xc4\xc7\xca\xcc\xd0\xd4\xd8\xdb\xe3\xea\xec\xf2\xf5\xf6\xfd\xfe",
+	},
+
+	// Group 50 '['
+	{
+		"\x01\x03\x04\x06\x0b\x0f\x10\x11\x1a\x1d\x21\x28\x29\x2c\x2d\x34\x36\x39\x3c\x3f\x40\x42\x47\x4e\x4f\x50\x52\x53\x56\x57\x58\x59\x63\x6a\x6c\x70\x72\x73\x74\x79\x7b\x81\x84\x86\x88\x98\x9b\x9c\x9e\xa0\xa6\xa8\xa9\xb4\xb7\xb8\xb9\xbd\xbe\xc2\xc6\xc7\xca\xcd\xce\xd0\xd1\xd2\xde\xe0\xe1\xe5\xe9\xee\xf0\xf2\xf8\xf9\xfa\xfb\xfc\xfd",
+		"\x00\x02\x05\x09\x0d\x12\x14\x16\x17\x19\x1e\x1f\x20\x2b\x31\x35\x37\x38\x43\x44\x48\x49\x4a\x4d\x51\x54\x55\x5a\x5c\x5f\x62\x64\x65\x67\x69\x6d\x76\x78\x7a\x7d\x83\x87\x8a\x8b\x8d\x8f\x90\x94\x97\x99\x9d\xa3\xa4\xab\xac\xae\xb0\xb1\xb2\xb5\xb6\xba\xbb\xc1\xc4\xc5\xc8\xc9\xd6\xd9\xdb\xdc\xe2\xe3\xe4\xe7\xeb\xec\xef\xf3\xf6\xfe",
+		"\x07\x08\x0a\x0c\x0e\x13\x15\x18\x1b\x22\x23\x24\x26\x27\x2a\x2e\x2f\x30\x32\x33\x3b\x3d\x41\x45\x46\x4b\x4c\x5d\x5e\x60\x61\x66\x6b\x6e\x6f\x71\x75\x77\x7c\x7e\x7f\x80\x82\x85\x89\x8c\x8e\x91\x92\x93\x95\x96\x9a\x9f\xa1\xa2\xa7\xaa\xad\xaf\xb3\xbc\xbf\xc0\xc3\xcb\xcc\xcf\xd3\xd5\xd7\xd8\xdd\xdf\xe6\xe8\xea\xf1\xf4\xf5\xf7\xff",
+		"\x01\x02\x05\x0f\x15\x17\x1c\x24\x25\x29\x2d\x30\x32\x34\x35\x36\x37\x3a\x3d\x3e\x3f\x41\x46\x47\x48\x51\x55\x56\x5a\x5b\x68\x69\x6c\x6d\x6f\x70\x72\x74\x78\x7a\x7e\x7f\x82\x84\x89\x8f\x92\x97\x9b\x9c\x9d\x9e\x9f\xa3\xa5\xa8\xab\xac\xad\xb0\xb2\xb7\xb8\xb9\xba\xbc\xc5\xce\xd2\xd4\xd5\xd6\xda\xde\xe1\xe5\xed\xf0\xf1\xf3\xf7\xff",
+		"\x00\x06\x08\x09\x0a\x0b\x0c\x0e\x10\x13\x18\x19\x1b\x1f\x20\x21\x23\x25\x26\x28\x2b\x33\x39\x3a\x3c\x3e\x43\x45\x49\x4c\x4d\x50\x57\x60\x62\x64\x6b\x6e\x71\x76\x77\x7b\x7c\x7d\x80\x83\x86\x87\x8c\x90\x98\x9a\xa1\xa5\xa6\xaa\xae\xb1\xbb\xc1\xc2\xc3\xc4\xc8\xcc\xcf\xd1\xd3\xd7\xd8\xda\xdd\xdf\xe0\xe4\xe8\xe9\xec\xfa\xfc\xfd\xfe",
+		"\x03\x0d\x11\x12\x14\x16\x1c\x1d\x1e\x22\x27\x2a\x2c\x2e\x2f\x31\x38\x3b\x42\x44\x4a\x4b\x4e\x52\x53\x54\x58\x5b\x5f\x61\x63\x65\x67\x68\x6a\x79\x85\x8a\x8b\x8d\x8e\x91\x93\x94\x96\x99\xa0\xa2\xa7\xa9\xaf\xb3\xb4\xb6\xbd\xbe\xbf\xc0\xc6\xc7\xc9\xca\xcb\xcd\xd0\xd4\xd9\xdb\xdc\xe2\xe3\xe6\xea\xeb\xed\xee\xef\xf2\xf4\xf5\xf8\xfb",
+		"\x03\x04\x07\x08\x0d\x10\x13\x14\x16\x1a\x1d\x20\x2b\x35\x36\x39\x3a\x40\x43\x47\x48\x4e\x4f\x50\x51\x52\x53\x54\x55\x58\x59\x5c\x5d\x5e\x66\x67\x68\x6b\x6e\x6f\x73\x75\x7b\x81\x88\x89\x92\x95\x96\x9f\xa0\xa1\xa3\xa4\xa5\xa6\xac\xaf\xb5\xb7\xbf\xc1\xc2\xc5\xc8\xcd\xcf\xd6\xd7\xd9\xdc\xe0\xe7\xec\xf6\xf7\xf8\xf9\xfb\xfc\xfd\xfe",
+	},
+
+	// Group 51 ']'
+	{
+		"\x00\x02\x03\x05\x0d\x0f\x11\x15\x18\x1b\x1c\x1d\x23\x26\x28\x29\x2a\x33\x36\x37\x39\x3a\x41\x44\x45\x4b\x4f\x50\x56\x5b\x5c\x60\x61\x65\x66\x68\x69\x6a\x6c\x6f\x70\x76\x77\x7c\x7e\x81\x88\x8a\x8d\x93\x94\x95\x96\x98\x9c\x9f\xa0\xa2\xa3\xa5\xa6\xa9\xab\xb0\xb4\xc9\xd1\xd3\xd8\xd9\xdd\xe1\xe3\xe9\xea\xec\xef\xf4\xf9\xfb\xfc\xff",
+		"\x07\x0a\x0e\x10\x13\x16\x17\x1e\x20\x21\x24\x25\x2b\x2c\x2d\x30\x32\x34\x3c\x3f\x47\x48\x4c\x4e\x52\x58\x59\x5a\x5d\x5e\x5f\x62\x63\x64\x67\x6b\x6e\x71\x73\x75\x78\x79\x7a\x80\x82\x83\x86\x87\x8c\x8e\x92\x97\x9a\x9b\xa1\xa7\xa8\xac\xb1\xb6\xb8\xb9\xbc\xbd\xbf\xc2\xc3\xc5\xc7\xc8\xca\xcc\xcd\xce\xd4\xdf\xe0\xe8\xf2\xf5\xf8\xfd",
+		"\x01\x04\x06\x08\x09\x0b\x0c\x12\x14\x19\x1a\x1f\x22\x27\x2e\x2f\x31\x35\x38\x3b\x3d\x40\x42\x43\x46\x49\x4a\x4d\x51\x53\x55\x57\x6d\x72\x74\x7b\x7d\x7f\x84\x85\x89\x8b\x8f\x90\x91\x99\x9d\x9e\xa4\xaa\xad\xae\xaf\xb2\xb5\xba\xbb\xc1\xc6\xcb\xd0\xd2\xd5\xd6\xd7\xda\xdb\xdc\xde\xe2\xe4\xe5\xe6\xe7\xeb\xed\xee\xf1\xf6\xf7\xfa\xfe",
+		"\x01\x02\x06\x08\x09\x15\x16\x19\x1c\x1d\x22\x23\x27\x28\x29\x2f\x31\x33\x3d\x3e\x43\x47\x49\x4c\x4f\x51\x52\x53\x54\x57\x64\x65\x67\x6b\x6e\x71\x77\x78\x7a\x7c\x7f\x81\x82\x85\x86\x87\x8f\x93\x97\xa2\xa6\xb2\xb3\xb4\xb7\xb9\xba\xbe\xc0\xc2\xc4\xc5\xcc\xcd\xcf\xd2\xd4\xd5\xd6\xdf\xe0\xe1\xe4\xe6\xe9\xea\xef\xf0\xf2\xf3\xfc\xfd",
+		"\x04\x0a\x0b\x0c\x0d\x0e\x10\x12\x1e\x1f\x20\x26\x2c\x34\x35\x38\x3a\x3b\x3e\x40\x41\x48\x4b\x4e\x50\x55\x56\x59\x5a\x5d\x5e\x66\x68\x6a\x6f\x72\x73\x79\x7b\x7e\x80\x89\x8e\x91\x94\x98\xa8\xab\xad\xae\xaf\xb1\xbc\xbe\xc0\xc3\xc4\xc6\xc8\xc9\xcb\xce\xcf\xd1\xd3\xd7\xd8\xd9\xda\xdb\xdc\xdd\xde\xe3\xeb\xed\xee\xf1\xf5\xf6\xf8\xfe",
+		"\x00\x03\x07\x0f\x11\x13\x17\x18\x1a\x1b\x21\x24\x25\x2a\x2b\x2d\x2e\x30\x36\x37\x39\x3c\x3f\x42\x44\x46\x4a\x54\x5b\x5c\x5f\x60\x61\x63\x69\x6c\x6d\x70\x74\x75\x7d\x83\x84\x88\x8a\x8b\x8c\x8d\x90\x92\x96\x99\x9b\x9c\x9e\xa0\xa3\xa4\xa5\xa7\xa9\xb3\xb5\xb6\xb7\xb8\xbb\xc1\xc7\xca\xd0\xe5\xe7\xe8\xec\xf0\xf3\xf4\xf7\xf9\xfa\xff",
+		"\x00\x05\x06\x0a\x0b\x11\x14\x15\x16\x22\x24\x27\x29\x2a\x30\x32\x33\x37\x3d\x45\x48\x49\x4c\x4d\x55\x58\x5b\x60\x62\x67\x68\x69\x6a\x6d\x6f\x74\x76\x78\x80\x85\x88\x8e\x8f\x94\x95\x98\x9a\x9d\x9e\x9f\xa1\xa4\xa8\xaa\xac\xae\xaf\xb0\xb1\xb5\xb8\xbc\xbd\xbf\xc3\xc4\xc5\xcc\xda\xdc\xdf\xe2\xe4\xea\xed\xee\xf1\xf2\xf3\xf6\xf9\xfb",
+	},
+
+	// Group 52 '^'
+	{
+		"\x02\x04\x09\x0f\x14\x15\x17\x19\x1b\x1f\x20\x24\x27\x30\x35\x36\x3a\x3d\x3e\x43\x44\x47\x4b\x4d\x58\x5e\x5f\x60\x62\x66\x6e\x71\x73\x74\x76\x77\x7a\x7c\x7d\x80\x81\x82\x83\x86\x87\x8c\x8d\x95\x97\xa0\xa1\xa3\xa4\xab\xb2\xb5\xb7\xb8\xbc\xbf\xc5\xc6\xc8\xc9\xca\xcc\xce\xd1\xd4\xda\xdd\xde\xdf\xe2\xef\xf0\xf1\xf5\xf8\xf9\xfe\xff",
+		"\x00\x03\x05\x07\x08\x0b\x11\x12\x18\x1a\x1c\x1d\x22\x23\x2f\x32\x37\x3f\x41\x42\x45\x46\x48\x4a\x4c\x4e\x4f\x51\x52\x53\x56\x59\x5a\x5c\x5d\x63\x64\x65\x6c\x6d\x70\x72\x79\x7b\x7f\x84\x8f\x93\x94\x98\x99\x9a\x9b\x9d\x9e\xa7\xa9\xaa\xad\xae\xb4\xb6\xb9\xbb\xc0\xc2\xc3\xc7\xd2\xd5\xe0\xe1\xe5\xe6\xe9\xea\xeb\xf2\xf3\xf4\xf7\xfb",
+		"\x01\x06\x0a\x0c\x0d\x10\x16\x1e\x21\x25\x26\x28\x29\x2a\x2b\x2d\x2e\x31\x33\x34\x38\x39\x3b\x3c\x49\x50\x55\x57\x5b\x61\x67\x68\x69\x6a\x6b\x6f\x75\x78\x7e\x85\x88\x8a\x8b\x8e\x90\x91\x92\x96\x9c\x9f\xa2\xa6\xa8\xac\xaf\xb0\xb1\xb3\xba\xbd\xbe\xc1\xc4\xcd\xcf\xd0\xd3\xd6\xd7\xd8\xd9\xdb\xdc\xe7\xe8\xec\xed\xee\xf6\xfa\xfc\xfd",
+		"\x0b\x0d\x0e\x0f\x10\x11\x12\x13\x16\x1a\x22\x25\x28\x29\x2a\x2c\x31\x34\x36\x37\x39\x3d\x3e\x3f\x40\x43\x49\x4b\x4d\x54\x5c\x5f\x61\x62\x69\x6a\x76\x77\x78\x79\x7a\x7b\x7c\x7f\x83\x86\x89\x8e\x91\x92\x94\x9f\xa5\xa8\xac\xaf\xb2\xb6\xbb\xbd\xc1\xc2\xc3\xc4\xc6\xcb\xcf\xd3\xd5\xd7\xdb\xdc\xde\xe3\xe4\xe8\xea\xeb\xee\xf4\xf5\xfa",
+		"\x00\x01\x04\x06\x0a\x0c\x0e\x13\x14\x15\x1c\x1d\x1e\x1f\x26\x35\x3a\x3c\x41\x42\x44\x45\x47\x52\x55\x59\x5a\x5b\x5e\x60\x65\x66\x6b\x6d\x6f\x71\x73\x7d\x81\x82\x87\x8a\x8b\x8d\x90\x96\x97\x99\x9b\x9e\xa3\xa4\xa5\xa7\xa9\xab\xad\xae\xb3\xb4\xb5\xb8\xb9\xbe\xcb\xcd\xce\xd0\xd6\xdf\xe1\xe2\xe6\xe9\xed\xf0\xf3\xf6\xfc\xfd\xfe\xff",
+		"\x02\x05\x07\x08\x09\x17\x19\x1b\x20\x21\x24\x2b\x2c\x2d\x2e\x30\x32\x33\x3b\x40\x46\x4a\x4e\x4f\x50\x53\x54\x56\x57\x58\x5d\x63\x64\x67\x68\x6c\x6e\x72\x75\x7e\x80\x84\x85\x88\x89\x8f\x95\x9a\x9c\x9d\xa0\xa1\xa2\xa6\xb1\xb7\xba\xbc\xbf\xc0\xc5\xc7\xc8\xc9\xca\xcc\xd1\xd4\xd8\xda\xdd\xe0\xe3\xe7\xec\xef\xf1\xf2\xf7\xf8\xf9\xfb",
+		"\x01\x03\x08\x0c\x12\x14\x15\x18\x1c\x22\x23\x25\x27\x2a\x2f\x31\x32\x38\x3a\x3b\x3c\x3f\x40\x42\x47\x48\x4b\x4c\x50\x51\x54\x56\x57\x59\x5d\x5e\x63\x64\x6b\x6c\x6e\x70\x71\x74\x75\x78\x7b\x7c\x8a\x8c\x8e\x93\x94\x98\x9c\xa7\xa8\xa9\xaa\xb0\xb3\xb4\xb5\xb7\xba\xc2\xc4\xc5\xcf\xd0\xd2\xd9\xdc\xe1\xe4\xe5\xe6\xea\xf3\xf4\xf7\xff",
+	},
+
+	// Group 53 'a'
+	{
+		"\x07\x12\x17\x1b\x1e\x20\x22\x27\x2a\x2b\x37\x3c\x3f\x40\x42\x43\x48\x49\x4b\x4c\x4d\x55\x5d\x61\x62\x63\x68\x70\x72\x73\x75\x7b\x7e\x81\x83\x84\x85\x87\x88\x8d\x8e\x91\x92\x93\x94\x95\x99\x9a\x9e\x9f\xa3\xa4\xac\xaf\xb0\xb2\xba\xbb\xbc\xc1\xc3\xc7\xc8\xc9\xcd\xcf\xd2\xd3\xd6\xd7\xdb\xdd\xde\xe0\xe3\xe8\xea\xee\xf8\xfa\xfb\xfe",
+		"\x01\x02\x03\x05\x06\x09\x0a\x0d\x0e\x0f\x10\x13\x14\x19\x1c\x21\x24\x29\x32\x33\x39\x3b\x3d\x44\x4f\x53\x57\x59\x5c\x5e\x5f\x64\x65\x69\x6b\x6d\x6e\x71\x76\x78\x7a\x7c\x80\x89\x8b\x8c\x90\x96\x97\x9b\x9c\x9d\xa1\xa5\xa6\xa7\xaa\xab\xae\xb3\xb4\xb5\xb6\xb7\xb9\xbd\xbe\xc2\xd1\xd4\xda\xdc\xdf\xe1\xe5\xe7\xed\xf0\xf3\xf6\xf9\xfc",
+		"\x00\x04\x0b\x0c\x11\x15\x18\x1a\x1d\x1f\x23\x25\x26\x28\x2c\x2d\x2e\x2f\x30\x31\x35\x36\x38\x3a\x41\x46\x47\x4a\x4e\x51\x52\x54\x56\x58\x5a\x5b\x60\x66\x67\x6a\x6c\x6f\x74\x77\x79\x7d\x7f\x82\x86\x8a\x8f\xa0\xa2\xa8\xa9\xad\xb1\xb8\xbf\xc0\xc4\xc5\xc6\xca\xcb\xcc\xce\xd0\xd5\xd8\xd9\xe2\xe4\xe6\xe9\xeb\xec\xf1\xf2\xf5\xf7\xfd",
+		"\x02\x08\x09\x0a\x0b\x0e\x14\x16\x1f\x20\x29\x2b\x2c\x34\x37\x3e\x3f\x42\x45\x46\x48\x4a\x4b\x4c\x4d\x4e\x4f\x50\x59\x6b\x71\x72\x73\x79\x7c\x80\x83\x85\x8e\x93\x94\x96\x97\x98\x99\x9b\x9f\xa0\xa5\xa9\xab\xad\xaf\xb0\xb2\xb3\xb6\xb9\xc2\xc3\xc6\xc8\xca\xcc\xce\xd3\xda\xdd\xdf\xe2\xe3\xe4\xe6\xe7\xeb\xec\xef\xf4\xf5\xf8\xfd\xff",
+		"\x00\x03\x04\x05\x06\x08\x0d\x0f\x10\x12\x16\x1b\x1e\x22\x23\x24\x25\x26\x27\x2a\x31\x32\x33\x36\x3a\x3d\x43\x47\x49\x50\x52\x55\x56\x5a\x5b\x5c\x5e\x5f\x61\x63\x66\x69\x76\x77\x78\x7a\x7b\x7d\x86\x87\x8a\x8c\x8d\x90\x9c\x9d\x9e\xa1\xa3\xa8\xaa\xb1\xbf\xc0\xc4\xc7\xc9\xcb\xcd\xd0\xd1\xd5\xd6\xe8\xea\xed\xf1\xf2\xf3\xf4\xfa\xff",
+		"\x01\x0c\x11\x15\x18\x19\x1a\x1c\x1d\x21\x2d\x2f\x30\x34\x35\x38\x3b\x3e\x40\x41\x44\x51\x53\x54\x57\x58\x60\x62\x64\x65\x68\x6a\x6c\x6d\x6e\x6f\x75\x7e\x81\x82\x84\x88\x89\x8b\x8f\x91\x92\x95\x98\x9a\xa2\xa4\xa6\xa7\xac\xae\xb4\xb7\xb8\xbb\xbc\xbd\xc1\xc5\xcf\xd4\xd8\xd9\xdb\xdc\xde\xe0\xe1\xe5\xe9\xef\xf6\xf7\xf9\xfb\xfc\xfe",
+		"\x01\x07\x08\x0c\x0e\x13\x15\x17\x18\x1a\x1f\x20\x23\x28\x29\x2e\x33\x36\x37\x39\x3c\x40\x45\x4c\x4d\x4e\x52\x56\x57\x58\x5a\x5d\x63\x67\x6b\x6c\x70\x74\x75\x78\x7a\x7c\x7f\x87\x89\x8d\x8e\x8f\x91\x92\x94\x96\x9a\x9c\x9d\xa2\xa7\xac\xaf\xb0\xb5\xb7\xb8\xba\xbc\xbd\xbe\xc0\xd0\xd2\xd3\xd5\xd6\xd7\xe4\xe5\xe7\xe9\xee\xf0\xf2\xf8",
+	},
+
+	// Group 54 'b'
+	{
+		"\x00\x03\x07\x08\x0f\x10\x11\x17\x18\x23\x27\x2e\x30\x39\x3b\x3c\x3e\x46\x49\x4c\x53\x57\x58\x5d\x5e\x5f\x60\x69\x6d\x6f\x70\x71\x72\x73\x74\x76\x79\x7d\x89\x8a\x90\x91\x96\x97\x9b\xa1\xa2\xa3\xa4\xa7\xa8\xaa\xac\xb0\xb2\xb5\xb9\xba\xbb\xc2\xc3\xc6\xcf\xd4\xd6\xd8\xd9\xda\xdb\xde\xe0\xe1\xe3\xe6\xef\xf1\xf3\xf9\xfb\xfc\xfd\xff",
+		"\x01\x04\x05\x06\x0b\x0d\x0e\x12\x13\x19\x1b\x1c\x1d\x1e\x1f\x24\x25\x29\x2d\x32\x33\x38\x41\x45\x4d\x50\x52\x54\x55\x5b\x5c\x61\x66\x68\x6b\x6c\x77\x7a\x7b\x80\x83\x84\x86\x88\x8b\x8e\x8f\x92\x98\x9e\x9f\xa0\xb1\xb3\xb4\xb6\xb7\xbc\xbd\xbe\xc0\xc9\xca\xcb\xcd\xd0\xd1\xd2\xd3\xdd\xe7\xe8\xeb\xec\xee\xf0\xf2\xf4\xf6\xf8\xfa\xfe",
+		"\x02\x09\x0a\x0c\x15\x16\x1a\x20\x21\x22\x26\x2a\x2b\x2c\x2f\x31\x34\x35\x36\x3a\x3d\x3f\x42\x43\x47\x48\x4a\x4b\x4e\x4f\x51\x56\x59\x5a\x62\x63\x64\x65\x67\x6a\x6e\x75\x78\x7c\x7e\x81\x82\x85\x87\x8c\x8d\x93\x94\x95\x99\x9a\x9c\x9d\xa5\xa9\xab\xae\xaf\xb8\xbf\xc1\xc4\xc5\xc7\xc8\xce\xd5\xd7\xdc\xdf\xe2\xe4\xe5\xea\xed\xf5\xf7",
+		"\x03\x05\x0a\x0b\x0c\x0e\x14\x15\x18\x1a\x1c\x1d\x1e\x25\x26\x28\x29\x2a\x2d\x31\x37\x3b\x40\x44\x46\x47\x49\x4a\x4e\x4f\x51\x56\x58\x5a\x5d\x5f\x63\x68\x78\x7a\x7f\x83\x84\x85\x87\x88\x8c\x90\x92\x98\xa0\xa5\xa6\xa9\xaa\xad\xaf\xb2\xb3\xb4\xb5\xb8\xbb\xbf\xc0\xc4\xc6\xc8\xcb\xcc\xd5\xe0\xe9\xec\xed\xf0\xf3\xf6\xf7\xf8\xfc\xff",
+		"\x02\x04\x07\x09\x10\x11\x19\x1f\x20\x21\x22\x32\x33\x36\x38\x3a\x3c\x3d\x40\x44\x45\x4b\x4c\x52\x54\x59\x5e\x64\x67\x6b\x6e\x71\x7b\x7d\x7f\x80\x82\x86\x8d\x8f\x91\x93\x94\x97\x99\x9b\x9c\x9d\x9f\xa1\xa3\xa7\xa8\xb0\xb1\xb6\xb7\xba\xbd\xc3\xc7\xc9\xcc\xcd\xd2\xd4\xd7\xdb\xdd\xdf\xe2\xe5\xe6\xe7\xeb\xee\xef\xf9\xfa\xfb\xfd\xfe",
+		"\x00\x08\x0d\x0f\x13\x17\x1b\x23\x27\x28\x2b\x2c\x2e\x30\x34\x37\x39\x3e\x41\x42\x43\x48\x4d\x50\x53\x55\x57\x5b\x5c\x60\x62\x65\x66\x69\x6c\x6d\x6f\x72\x73\x74\x75\x76\x77\x79\x7c\x7e\x81\x89\x8b\x8e\x96\x9a\x9e\xa2\xa4\xa6\xab\xac\xad\xae\xb9\xbc\xbe\xc1\xc2\xce\xcf\xd0\xd1\xd3\xd6\xd8\xda\xdc\xde\xe3\xe4\xe8\xe9\xea\xf1\xf4",
+		"\x01\x06\x07\x0d\x11\x12\x14\x16\x17\x1c\x21\x22\x23\x24\x25\x27\x2b\x2f\x31\x35\x3c\x3f\x40\x44\x45\x51\x5b\x61\x63\x6a\x6c\x70\x75\x78\x7d\x7e\x83\x84\x85\x8a\x8b\x8e\x90\x91\x95\x9c\x9f\xa6\xa8\xa9\xaa\xb3\xb5\xb8\xbf\xc1\xc5\xc6\xc7\xca\xcb\xd0\xd1\xd4\xd7\xd8\xd9\xdc\xe1\xe2\xe3\xe4\xe9\xea\xed\xef\xf1\xf2\xf5\xf6\xfb\xfc",
+	},
+
+	// Group 55 'c'
+	{
+		"\x00\x01\x04\x06\x08\x0b\x0c\x11\x16\x17\x1a\x1b\x1d\x1e\x1f\x21\x22\x29\x2a\x2b\x32\x34\x37\x39\x3b\x3c\x40\x42\x43\x47\x4c\x4d\x54\x56\x5e\x60\x64\x65\x72\x73\x7e\x83\x85\x88\x8b\x8c\x94\x95\x97\x9b\x9d\x9f\xa0\xa1\xa2\xa3\xa6\xa8\xaa\xac\xb0\xb1\xb4\xb5\xb7\xc1\xc4\xc8\xcd\xd4\xd5\xd6\xda\xe1\xe4\xf0\xf1\xf2\xf4\xf5\xf7\xfb",
+		"\x02\x03\x09\x0d\x0f\x10\x13\x14\x20\x23\x26\x27\x28\x2e\x2f\x33\x38\x3d\x3e\x44\x4a\x4e\x4f\x50\x51\x52\x53\x55\x58\x5b\x5f\x62\x6a\x6b\x6e\x74\x77\x7a\x7b\x7d\x7f\x8a\x8f\x90\x91\x93\x96\x9a\xa5\xa7\xa9\xab\xad\xae\xb2\xb6\xb8\xb9\xbb\xbe\xbf\xc2\xc3\xcb\xcf\xd0\xd1\xd8\xd9\xdb\xdc\xdd\xde\xdf\xe3\xe5\xe8\xe9\xeb\xee\xf8\xf9",
+		"\x05\x07\x0a\x0e\x12\x15\x18\x19\x1c\x24\x25\x2d\x30\x31\x35\x36\x3a\x41\x45\x48\x49\x4b\x57\x59\x5a\x5c\x5d\x63\x66\x67\x68\x69\x6c\x6d\x6f\x70\x71\x75\x76\x79\x7c\x80\x81\x82\x84\x86\x87\x8d\x8e\x92\x98\x9c\x9e\xa4\xaf\xba\xbc\xbd\xc0\xc5\xc6\xc7\xc9\xca\xcc\xce\xd2\xd3\xd7\xe2\xe6\xe7\xea\xec\xef\xf3\xf6\xfa\xfc\xfd\xfe\xff",
+		"\x06\x08\x09\x0a\x0b\x0c\x0d\x0f\x14\x1b\x1d\x21\x25\x28\x29\x2c\x2d\x30\x31\x38\x3e\x3f\x46\x4b\x56\x57\x5a\x5e\x5f\x61\x68\x6a\x6c\x72\x73\x74\x75\x76\x78\x7a\x7c\x7f\x87\x88\x89\x8e\x98\x99\x9b\x9e\xa1\xa3\xa4\xa6\xa7\xa8\xab\xaf\xb2\xb3\xb7\xb8\xbe\xc1\xc2\xc5\xc6\xc7\xca\xd1\xd4\xd5\xd6\xdb\xde\xe0\xe9\xea\xed\xf1\xf2\xf4",
+		"\x00\x01\x0e\x10\x11\x13\x15\x1a\x1e\x20\x23\x26\x2a\x2b\x2c\x2f\x33\x34\x35\x3b\x3d\x42\x43\x44\x47\x48\x49\x4a\x4c\x50\x52\x53\x54\x55\x58\x59\x60\x61\x65\x66\x67\x6f\x71\x77\x81\x86\x8b\x8c\x8f\x90\x95\x97\x9c\x9f\xa2\xac\xae\xb1\xb5\xb6\xb9\xba\xbd\xc0\xc9\xcc\xce\xcf\xd0\xd7\xd9\xda\xdc\xe2\xe5\xe8\xee\xf3\xf7\xfb\xfc\xfd",
+		"\x02\x03\x04\x05\x07\x12\x16\x17\x19\x1c\x22\x27\x2e\x32\x36\x37\x39\x3f\x40\x46\x4e\x51\x5c\x5d\x62\x64\x6b\x6d\x6e\x70\x78\x79\x7b\x7e\x80\x82\x83\x84\x85\x89\x8a\x8d\x91\x92\x93\x96\x99\x9a\x9d\xa0\xa5\xa9\xaa\xad\xb0\xb3\xb4\xbb\xbc\xbf\xc3\xc4\xc8\xcd\xd2\xd3\xd8\xdd\xdf\xe0\xe1\xe3\xe4\xe7\xec\xed\xef\xf0\xf5\xf6\xf8\xf9",
+		"\x05\x07\x09\x0c\x0d\x12\x13\x18\x19\x1f\x24\x26\x29\x2a\x2b\x2c\x30\x31\x37\x3a\x3c\x40\x41\x45\x47\x48\x4a\x4d\x4f\x50\x52\x58\x5b\x5f\x62\x63\x68\x69\x6d\x78\x7c\x7d\x7e\x81\x84\x86\x89\x94\x95\x9e\x9f\xa1\xa3\xa7\xa8\xab\xb2\xb4\xb7\xc1\xc3\xcb\xcc\xd5\xd7\xdb\xdc\xdf\xe2\xe5\xe6\xe7\xe9\xeb\xed\xf0\xf5\xf6\xf7\xfa\xfe\xff",
+	},
+
+	// Group 56 'd'
+	{
+		"\x03\x04\x09\x12\x17\x18\x19\x20\x21\x22\x24\x26\x2c\x2d\x2f\x32\x37\x3d\x3e\x41\x42\x44\x45\x46\x47\x49\x4b\x4d\x53\x58\x5b\x5d\x61\x62\x63\x69\x6a\x75\x77\x78\x79\x7e\x82\x84\x86\x8a\x8b\x8d\x8f\x90\x93\x94\x97\x98\x9b\xa1\xa3\xa4\xa7\xa8\xaa\xae\xb4\xbc\xbe\xc1\xc3\xc7\xcc\xce\xd1\xd6\xd7\xdb\xdd\xde\xe2\xe7\xea\xf1\xf6\xfa",
+		"\x00\x01\x02\x05\x06\x08\x0b\x0c\x0e\x13\x14\x15\x16\x1b\x1c\x1d\x1f\x25\x28\x2b\x30\x31\x36\x3a\x3c\x40\x43\x48\x4a\x4c\x55\x56\x57\x5c\x60\x64\x6c\x6d\x76\x7a\x7d\x83\x88\x8e\x91\x96\x9e\xa5\xa6\xac\xb1\xb5\xb6\xb7\xbb\xbf\xc6\xc8\xc9\xca\xd3\xd4\xd5\xd9\xda\xdc\xe0\xe1\xe4\xe5\xe6\xe8\xe9\xec\xee\xef\xf0\xf3\xf4\xf9\xfd\xfe",
+		"\x07\x0a\x0d\x0f\x10\x11\x23\x27\x29\x2a\x34\x35\x38\x39\x3b\x3f\x4e\x4f\x51\x52\x54\x59\x5a\x5e\x5f\x65\x66\x67\x68\x6b\x6e\x70\x71\x72\x73\x74\x7b\x7c\x7f\x80\x81\x85\x87\x8c\x92\x99\x9a\x9c\x9d\x9f\xa0\xa2\xa9\xab\xad\xaf\xb0\xb2\xb3\xb8\xb9\xbd\xc0\xc2\xc4\xc5\xcb\xcd\xcf\xd0\xd2\xdf\xe3\xeb\xed\xf2\xf5\xf7\xf8\xfb\xfc\xff",
+		"\x01\x06\x08\x11\x12\x14\x15\x17\x1a\x1e\x24\x26\x27\x2e\x33\x34\x37\x3d\x3f\x45\x49\x4b\x50\x55\x56\x58\x5b\x5c\x5f\x61\x6a\x6b\x6e\x6f\x73\x78\x7c\x7f\x80\x89\x8b\x8f\x91\x95\x97\x9c\x9d\xa2\xa4\xa5\xa6\xa9\xb0\xb1\xb2\xb3\xb9\xba\xc0\xc1\xc2\xc4\xcb\xd2\xd8\xdb\xdc\xe1\xe5\xe8\xeb\xec\xf0\xf1\xf3\xf4\xf7\xfa\xfc\xfd\xfe\xff",
+		"\x02\x04\x05\x07\x0b\x13\x19\x1a\x1b\x1c\x1e\x21\x28\x2c\x2e\x31\x32\x38\x3a\x3b\x40\x41\x43\x44\x47\x4f\x51\x57\x5e\x64\x68\x6f\x71\x72\x74\x76\x7b\x7e\x84\x85\x87\x8a\x8c\x8e\x92\x93\x95\x96\x99\x9e\x9f\xa0\xaa\xac\xaf\xb6\xb7\xb8\xba\xbb\xbc\xbe\xbf\xc3\xc5\xc8\xc9\xca\xd6\xd7\xd8\xd9\xdd\xdf\xe3\xe4\xe9\xea\xef\xf5\xf8\xf9",
+		"\x03\x09\x0a\x0c\x0d\x0e\x0f\x10\x16\x18\x1d\x1f\x22\x23\x25\x2a\x2d\x2f\x30\x33\x35\x36\x39\x42\x46\x48\x4a\x4c\x4d\x4e\x50\x52\x53\x54\x59\x5a\x5d\x60\x63\x65\x67\x69\x6d\x70\x75\x77\x7a\x7d\x83\x86\x8d\x94\x98\x9a\x9b\xa7\xa8\xab\xad\xae\xb4\xbd\xc6\xc7\xcc\xcd\xce\xcf\xd0\xd1\xd3\xd4\xd5\xde\xe0\xe2\xe6\xe7\xed\xee\xf2\xf6",
+		"\x00\x04\x06\x08\x0b\x0e\x0f\x11\x13\x1a\x20\x23\x29\x2b\x2d\x2f\x30\x32\x33\x3b\x3c\x3d\x3e\x41\x44\x46\x4c\x4d\x52\x58\x59\x61\x62\x66\x68\x69\x6b\x6c\x70\x71\x73\x79\x80\x81\x82\x88\x89\x8c\x8e\x90\x96\x99\x9a\x9b\x9c\xa0\xa1\xa2\xa3\xa4\xa7\xa8\xb5\xb6\xba\xca\xd0\xd2\xd3\xd8\xda\xe3\xe4\xe5\xe6\xe7\xeb\xee\xf6\xf8\xfb\xfe",
+	},
+
+	// Group 57 'e'
+	{
+		"\x02\x04\x05\x07\x0d\x17\x19\x1a\x1c\x1f\x28\x2c\x30\x32\x35\x37\x38\x39\x3a\x3d\x41\x42\x44\x45\x4a\x4d\x57\x5d\x60\x61\x63\x64\x66\x67\x6b\x6c\x6d\x71\x73\x74\x75\x7b\x7c\x7d\x7e\x80\x82\x87\x88\x8d\x8e\x90\x91\x93\x96\x9c\xa0\xa1\xa2\xa5\xa8\xa9\xaa\xab\xac\xad\xb7\xb9\xba\xc0\xc6\xc9\xcc\xd1\xd3\xd4\xdd\xe0\xe7\xee\xf7\xfc",
+		"\x00\x01\x08\x0a\x0b\x0c\x0e\x0f\x12\x14\x21\x22\x23\x24\x26\x29\x2a\x2b\x2e\x31\x33\x34\x40\x43\x46\x47\x4e\x4f\x52\x54\x55\x58\x59\x5a\x5c\x5e\x62\x65\x68\x6e\x70\x77\x7a\x81\x83\x84\x89\x8b\x94\x97\x9a\x9b\x9d\xa4\xa7\xae\xaf\xb1\xb2\xb3\xb5\xbc\xbd\xc3\xc5\xca\xce\xd2\xd7\xd8\xd9\xdb\xde\xe2\xe3\xe8\xec\xf3\xf8\xfb\xfe\xff",
+		"\x03\x06\x09\x10\x11\x13\x16\x18\x1b\x1e\x20\x25\x27\x2d\x2f\x36\x3b\x3e\x3f\x48\x49\x4b\x4c\x50\x51\x5b\x5f\x69\x6a\x72\x76\x78\x79\x7f\x85\x8a\x8c\x8f\x92\x95\x98\x99\x9e\x9f\xa6\xb0\xb4\xb6\xb8\xbb\xbe\xbf\xc1\xc2\xc4\xc7\xc8\xcb\xcd\xd0\xd5\xd6\xda\xdc\xdf\xe1\xe4\xe5\xe6\xe9\xea\xeb\xed\xef\xf1\xf2\xf4\xf5\xf6\xf9\xfa\xfd",
+		"\x03\x04\x05\x06\x0c\x0e\x0f\x15\x1b\x1c\x1d\x21\x27\x28\x29\x2a\x2b\x2e\x31\x33\x35\x38\x39\x3b\x3c\x3e\x40\x41\x42\x4c\x53\x56\x58\x59\x60\x63\x64\x66\x67\x68\x69\x6d\x6f\x70\x74\x77\x7c\x80\x83\x86\x88\x8d\x8f\x90\x92\x96\x97\x98\xa2\xa3\xa4\xaf\xb8\xb9\xc0\xc7\xcb\xcf\xd6\xdf\xe0\xe1\xe2\xe6\xe9\xea\xef\xf0\xf2\xf7\xf9\xfd",
+		"\x00\x01\x0a\x0b\x12\x15\x16\x18\x1a\x1d\x1f\x22\x23\x26\x2c\x2d\x2f\x30\x3d\x3f\x43\x45\x46\x47\x48\x4d\x4e\x4f\x51\x56\x57\x5c\x5d\x61\x62\x65\x6c\x6f\x71\x72\x76\x78\x7d\x7e\x7f\x82\x86\x8a\x8c\x8e\x94\x99\x9d\xa3\xa6\xac\xb1\xb6\xbc\xbe\xc2\xc6\xc8\xc9\xca\xce\xcf\xd2\xd5\xd7\xd8\xdd\xde\xe4\xeb\xec\xee\xf0\xf3\xf5\xfb\xfc",
+		"\x02\x07\x08\x09\x0d\x10\x11\x13\x14\x17\x19\x1e\x20\x24\x25\x32\x34\x36\x37\x3a\x44\x49\x4a\x4b\x50\x52\x53\x54\x55\x5a\x5b\x5f\x6e\x75\x7a\x81\x84\x85\x89\x8b\x91\x93\x95\x9a\x9c\x9e\x9f\xa0\xa5\xa7\xa8\xa9\xaa\xab\xad\xb0\xb2\xb4\xb5\xb7\xba\xbb\xbd\xc1\xc4\xc5\xcc\xd0\xd3\xd4\xd9\xda\xdb\xdc\xe3\xe5\xe7\xe8\xf1\xf4\xfa\xfe",
+		"\x02\x04\x06\x07\x0a\x0d\x11\x14\x15\x17\x19\x1a\x1b\x24\x2a\x2e\x30\x33\x38\x3c\x3d\x3e\x3f\x48\x53\x57\x5e\x61\x65\x66\x69\x6a\x6b\x6d\x6e\x73\x75\x76\x77\x78\x79\x7b\x7d\x84\x87\x8e\x8f\x93\x94\x98\x99\x9b\xa1\xa7\xa8\xae\xaf\xb3\xb5\xb7\xbc\xbf\xc0\xc1\xc3\xc8\xcc\xcd\xce\xd1\xd2\xdc\xdf\xe6\xea\xec\xed\xef\xf6\xf8\xfb\xff",
+	},
+
+	// Group 58 'f'
+	{
+		"\x01\x06\x0a\x17\x1c\x1d\x1f\x21\x22\x26\x27\x2d\x30\x35\x39\x3b\x3d\x3f\x42\x45\x47\x48\x4a\x4d\x4f\x5b\x5d\x5f\x60\x64\x65\x67\x6a\x6b\x6c\x6f\x71\x74\x76\x78\x7a\x7b\x84\x8b\x90\x91\x95\x97\x98\x9d\x9e\xa2\xa9\xab\xae\xb0\xb1\xb3\xb4\xb6\xb7\xb8\xb9\xba\xbb\xc6\xc7\xd4\xdc\xdd\xe0\xe2\xe3\xe5\xe8\xea\xeb\xec\xf0\xf6\xf8\xfc",
+		"\x00\x03\x07\x0b\x0c\x0d\x0e\x0f\x14\x18\x19\x1e\x20\x23\x24\x25\x2a\x2b\x2e\x2f\x32\x33\x36\x38\x41\x44\x49\x4b\x4c\x4e\x51\x52\x58\x59\x5c\x5e\x62\x63\x66\x70\x72\x73\x7e\x82\x86\x89\x8f\x92\x99\x9b\x9f\xa3\xa4\xa6\xa7\xb5\xbc\xbd\xbe\xbf\xc1\xc3\xca\xcd\xce\xd2\xd3\xd6\xd7\xd9\xdb\xde\xdf\xe7\xe9\xed\xee\xef\xf1\xf2\xf4\xf7",
+		"\x04\x05\x08\x09\x10\x11\x13\x15\x16\x1a\x1b\x28\x29\x2c\x31\x34\x37\x3a\x3c\x3e\x40\x43\x46\x50\x53\x54\x56\x57\x5a\x68\x69\x6e\x77\x79\x7c\x7d\x7f\x80\x81\x83\x85\x87\x88\x8a\x8c\x8e\x93\x94\x96\x9a\x9c\xa0\xa1\xa5\xa8\xaa\xac\xad\xaf\xb2\xc0\xc4\xc5\xc8\xc9\xcf\xd0\xd1\xd5\xd8\xda\xe1\xe4\xe6\xf3\xf5\xf9\xfa\xfb\xfd\xfe\xff",
+		"\x00\x02\x03\x05\x08\x0c\x12\x1c\x1e\x1f\x23\x24\x25\x26\x29\x2c\x30\x33\x37\x3b\x3c\x3d\x3e\x42\x50\x55\x57\x58\x5d\x5e\x61\x63\x64\x6c\x6d\x70\x71\x75\x77\x78\x7b\x7d\x83\x84\x86\x8d\x96\x97\x98\xa1\xa2\xa3\xa9\xab\xb0\xb1\xb2\xb4\xb6\xc1\xc2\xc7\xc8\xc9\xcb\xcc\xd1\xd3\xd9\xdb\xe3\xe7\xe8\xeb\xf1\xf3\xf4\xf5\xf7\xf9\xfc\xfe",
+		"\x01\x04\x0a\x0b\x0f\x10\x11\x12\x13\x14\x15\x16\x1a\x1b\x1d\x21\x22\x28\x2b\x2e\x2f\x31\x36\x38\x39\x3a\x3f\x40\x41\x43\x47\x48\x4a\x59\x5a\x61\x62\x66\x68\x69\x6d\x6e\x6f\x72\x74\x75\x79\x7c\x7f\x81\x85\x8a\x8d\x8e\x8f\x90\x93\x94\x95\x99\x9c\x9d\xa0\xa5\xa6\xa7\xac\xb8\xbb\xc0\xc5\xcb\xcc\xd5\xd7\xe0\xe6\xe9\xf6\xf8\xfa\xfb",
+		"\x06\x07\x09\x0d\x0e\x18\x19\x27\x2a\x2d\x32\x34\x35\x44\x45\x46\x49\x4b\x4e\x51\x52\x53\x55\x56\x5c\x5f\x65\x67\x6a\x6b\x73\x7a\x7e\x80\x82\x87\x88\x89\x8b\x8c\x92\x9e\xa4\xa8\xad\xae\xaf\xb3\xb5\xb7\xb9\xba\xbc\xbd\xbe\xc2\xc3\xc4\xc6\xca\xce\xcf\xd0\xd4\xd6\xd8\xda\xde\xdf\xe1\xe2\xe4\xe5\xea\xec\xed\xee\xef\xf0\xf2\xfd\xff",
+		"\x02\x03\x06\x08\x0c\x0e\x12\x17\x1b\x1c\x20\x25\x33\x36\x37\x38\x39\x3b\x3d\x43\x45\x46\x4a\x4c\x4d\x4e\x4f\x54\x56\x58\x5b\x5d\x5e\x60\x66\x67\x70\x72\x76\x78\x7d\x7e\x80\x85\x86\x91\x92\x93\x95\x96\x97\x9a\x9b\x9d\x9f\xa1\xa2\xa8\xaa\xad\xb1\xb3\xb7\xba\xbf\xc2\xc5\xc6\xca\xcd\xd2\xd4\xd9\xdc\xdd\xde\xdf\xe5\xe6\xf1\xf3\xfe",
+	},
+
+	// Group 59 'g'
+	{
+		"\x00\x02\x03\x06\x0e\x0f\x11\x13\x14\x18\x19\x1b\x1c\x24\x25\x29\x2b\x2c\x2e\x32\x37\x39\x3a\x3e\x45\x47\x4b\x51\x52\x57\x5d\x5f\x64\x6a\x6d\x72\x77\x78\x7a\x7d\x7e\x80\x81\x85\x87\x8b\x8c\x8e\x8f\x9b\x9f\xa2\xa4\xa6\xaa\xaf\xb1\xb6\xb7\xb9\xbe\xc0\xc2\xc4\xc6\xcb\xcc\xcd\xcf\xd2\xd9\xda\xdf\xe0\xe7\xea\xee\xef\xf2\xf9\xfc\xff",
+		"\x07\x08\x09\x0a\x0c\x0d\x10\x12\x16\x1d\x1e\x20\x21\x22\x27\x28\x2a\x2f\x31\x33\x34\x38\x3b\x3c\x3d\x3f\x41\x43\x44\x48\x4a\x4f\x50\x54\x55\x56\x5b\x60\x61\x62\x65\x69\x6b\x6f\x70\x73\x76\x82\x86\x97\x98\x9c\x9d\xa0\xa1\xa3\xa5\xa7\xa8\xa9\xac\xad\xb2\xb3\xb8\xbd\xbf\xc1\xca\xce\xd1\xd6\xdc\xde\xe1\xe2\xe6\xe8\xed\xf8\xfa\xfe",
+		"\x01\x04\x05\x0b\x15\x17\x1a\x1f\x26\x2d\x30\x35\x36\x40\x42\x46\x49\x4c\x4d\x4e\x53\x58\x59\x5a\x5e\x63\x66\x67\x68\x6c\x6e\x71\x75\x79\x7b\x7c\x7f\x83\x84\x88\x8a\x8d\x91\x92\x94\x95\x96\x99\x9a\x9e\xab\xae\xb0\xb4\xb5\xba\xbb\xbc\xc3\xc5\xc7\xc8\xc9\xd0\xd4\xd7\xd8\xdb\xdd\xe3\xe4\xe5\xe9\xec\xf0\xf1\xf3\xf4\xf6\xf7\xfb\xfd",
+		"\x00\x05\x06\x0b\x0c\x0d\x0e\x0f\x18\x1a\x1e\x22\x23\x27\x2f\x37\x38\x3a\x40\x41\x42\x45\x47\x48\x4b\x4e\x50\x52\x54\x56\x5a\x5b\x5c\x5d\x63\x65\x6c\x6d\x6f\x71\x74\x75\x77\x7a\x7d\x7f\x88\x89\x8e\x90\x93\x99\x9b\xa7\xa8\xb1\xb7\xba\xc0\xc4\xc5\xcb\xce\xcf\xd1\xd3\xd4\xd5\xdb\xdc\xe1\xe2\xe5\xe7\xe8\xeb\xec\xef\xf5\xf6\xfc\xfe",
+		"\x03\x09\x10\x11\x13\x14\x15\x16\x19\x1b\x1c\x21\x23\x2b\x2e\x31\x35\x3c\x3d\x46\x49\x4d\x51\x59\x5e\x5f\x60\x61\x62\x69\x6a\x6b\x6e\x76\x7b\x81\x82\x84\x86\x89\x8b\x8d\x8f\x92\x95\x9c\x9d\x9f\xa2\xa5\xaa\xab\xac\xae\xb8\xb9\xbb\xbc\xbe\xbf\xc2\xc3\xc6\xc7\xca\xcc\xd5\xd6\xd8\xda\xe0\xe3\xe4\xed\xf1\xf2\xf4\xf9\xfa\xfb\xfd\xff",
+		"\x01\x02\x04\x07\x08\x12\x17\x1d\x1f\x24\x25\x26\x28\x29\x2a\x2c\x2d\x30\x32\x33\x34\x39\x3b\x3f\x43\x44\x4a\x4f\x55\x57\x58\x64\x66\x67\x68\x72\x74\x78\x79\x7c\x7e\x80\x83\x85\x87\x8a\x90\x93\x94\x96\x98\x9a\x9e\xa0\xa3\xa4\xa6\xa9\xad\xaf\xb2\xb3\xb4\xb5\xb6\xbd\xc1\xc9\xd2\xd3\xd7\xd9\xdd\xde\xdf\xe6\xe9\xea\xeb\xee\xf0\xf8",
+		"\x00\x01\x03\x05\x07\x0a\x11\x16\x18\x1a\x1c\x1d\x20\x21\x2e\x35\x36\x37\x3a\x3e\x3f\x41\x4b\x4c\x4d\x50\x53\x55\x56\x59\x5c\x5d\x5e\x5f\x61\x62\x63\x65\x6b\x70\x73\x74\x7a\x7b\x83\x87\x8b\x8c\x91\x92\x94\x97\x99\x9b\x9c\x9d\xa1\xa6\xa9\xaa\xb0\xb3\xb7\xb8\xc3\xc8\xcd\xce\xd0\xd4\xe3\xe4\xe5\xe6\xe7\xea\xee\xf2\xf3\xf5\xf7\xff",
+	},
+
+	// Group 60 'h'
+	{
+		"\x02\x07\x08\x0d\x11\x12\x16\x1c\x1f\x22\x24\x27\x2c\x2f\x30\x32\x37\x3e\x3f\x42\x55\x56\x59\x5a\x5b\x61\x62\x63\x67\x69\x6d\x6f\x70\x73\x74\x75\x76\x79\x7e\x7f\x85\x8b\x8c\x90\x91\x95\x96\x98\x99\x9a\x9b\x9d\x9f\xa0\xa3\xa7\xa8\xa9\xaf\xb3\xb9\xbc\xbd\xc0\xc6\xcb\xcc\xcf\xd2\xdb\xe0\xe1\xe4\xe6\xeb\xed\xef\xf4\xf6\xfa\xfe\xff",
+		"\x01\x03\x04\x05\x09\x0b\x0c\x14\x15\x18\x19\x20\x26\x29\x2d\x2e\x33\x39\x3c\x3d\x40\x41\x43\x44\x45\x48\x4d\x4f\x51\x54\x57\x5c\x5e\x6a\x6b\x6c\x71\x72\x7a\x7b\x7c\x7d\x80\x82\x83\x84\x86\x87\x88\x89\x8a\x8d\x8f\x92\x93\x9e\xa2\xa5\xa6\xab\xad\xb0\xb2\xb7\xba\xbb\xc1\xc3\xc5\xc8\xd0\xd1\xd8\xd9\xdf\xe3\xe7\xe9\xec\xf0\xf3\xf5",
+		"\x00\x06\x0a\x0e\x0f\x10\x13\x17\x1a\x1b\x1d\x1e\x21\x23\x28\x2a\x2b\x31\x35\x36\x3a\x3b\x47\x49\x4a\x4b\x4c\x4e\x50\x52\x53\x58\x5d\x5f\x60\x65\x66\x68\x77\x81\x8e\x94\x97\x9c\xa1\xa4\xaa\xac\xae\xb1\xb6\xbe\xbf\xc2\xc4\xc7\xc9\xca\xcd\xce\xd3\xd4\xd5\xd6\xd7\xda\xdc\xdd\xde\xe2\xe5\xe8\xea\xee\xf1\xf2\xf7\xf8\xf9\xfb\xfc\xfd",
+		"\x03\x05\x09\x0a\x0c\x0d\x0e\x10\x11\x18\x1c\x20\x23\x25\x29\x34\x36\x37\x38\x41\x44\x46\x47\x48\x4b\x4e\x4f\x52\x53\x58\x59\x5d\x60\x64\x67\x69\x6c\x6e\x76\x78\x7a\x7f\x83\x85\x89\x94\x97\x9b\xa3\xa5\xa8\xab\xac\xae\xb4\xb5\xb8\xb9\xbd\xbf\xc0\xc2\xc3\xc5\xc6\xc7\xc8\xce\xcf\xd1\xd2\xd4\xd5\xd9\xdf\xe8\xed\xee\xf7\xfb\xfe\xff",
+		"\x00\x01\x04\x07\x08\x13\x15\x1b\x1f\x21\x22\x25\x26\x27\x28\x2b\x2c\x2e\x2f\x35\x38\x39\x3a\x3b\x3c\x3d\x40\x43\x45\x4c\x51\x56\x57\x5e\x61\x63\x64\x6a\x71\x72\x73\x74\x75\x78\x79\x7b\x7c\x80\x81\x88\x8d\x8e\x8f\x92\x96\x9f\xa0\xa2\xa4\xa6\xa7\xb0\xb1\xb5\xbb\xbe\xc4\xc9\xcc\xd0\xd3\xd7\xda\xdb\xe1\xe2\xe5\xea\xef\xf6\xf9\xfd",
+		"\x02\x06\x0f\x12\x14\x16\x17\x19\x1a\x1d\x1e\x24\x2a\x2d\x30\x31\x32\x33\x34\x3e\x3f\x42\x46\x49\x50\x55\x5b\x5c\x5f\x62\x65\x66\x68\x6b\x6d\x6f\x77\x7e\x84\x86\x87\x8b\x8c\x90\x91\x93\x95\x98\x99\x9a\x9c\x9e\xa1\xa9\xaa\xad\xaf\xb3\xb4\xb6\xb7\xb8\xba\xca\xcb\xde\xe0\xe3\xe4\xe6\xe7\xe9\xeb\xec\xf0\xf1\xf2\xf3\xf4\xf8\xfa\xfc",
+		"\x00\x03\x06\x0b\x0d\x0f\x12\x14\x15\x1b\x1f\x20\x25\x34\x35\x39\x3a\x3d\x40\x44\x4a\x4b\x4d\x51\x54\x55\x5a\x66\x67\x6a\x6d\x6e\x6f\x70\x71\x73\x74\x77\x7d\x82\x8a\x8d\x90\x95\x96\x98\x9b\x9d\xa2\xa4\xa8\xad\xaf\xb2\xb7\xb8\xb9\xbb\xbc\xbe\xc1\xc2\xc4\xc6\xcc\xcd\xcf\xd1\xd4\xd6\xd8\xd9\xdb\xdc\xdd\xe0\xe2\xec\xf0\xf2\xf4\xf5",
+	},
+
+	// Group 61 'i'
+	{
+		"\x03\x06\x0a\x0b\x0d\x0f\x14\x15\x17\x19\x1a\x1e\x25\x26\x28\x29\x2a\x2b\x2f\x30\x34\x37\x3f\x40\x42\x46\x48\x4f\x53\x55\x5a\x5d\x5e\x61\x62\x6d\x75\x79\x7b\x7e\x81\x84\x8a\x8c\x8f\x90\x91\x92\x95\x96\x9f\xa8\xac\xae\xb1\xb3\xbc\xbd\xbe\xbf\xc0\xc2\xc3\xc6\xcf\xd2\xd4\xd7\xd9\xdb\xdc\xde\xe7\xed\xee\xf0\xf2\xf5\xfa\xfb\xfe\xff",
+		"\x01\x02\x07\x09\x0c\x0e\x10\x1c\x1d\x1f\x27\x2c\x2d\x35\x3a\x3c\x41\x43\x47\x4c\x4e\x50\x51\x52\x57\x58\x5b\x5f\x60\x63\x64\x65\x66\x6a\x6b\x6c\x71\x73\x74\x7a\x7c\x7f\x80\x85\x87\x8b\x93\x94\x9d\xa0\xa2\xa3\xa6\xa7\xa9\xad\xaf\xb0\xb5\xb7\xb8\xba\xc8\xc9\xca\xd1\xd3\xd5\xd6\xd8\xda\xe0\xe3\xe4\xe6\xe8\xeb\xec\xf4\xf6\xf8\xfd",
+		"\x00\x04\x05\x08\x11\x12\x13\x16\x18\x1b\x20\x21\x22\x23\x24\x2e\x31\x32\x33\x36\x38\x39\x3b\x3d\x3e\x45\x49\x4a\x4b\x4d\x54\x56\x59\x5c\x67\x68\x69\x6e\x6f\x70\x72\x77\x78\x82\x83\x86\x88\x89\x8d\x8e\x97\x98\x99\x9a\x9b\x9c\x9e\xa4\xa5\xaa\xab\xb2\xb4\xb6\xbb\xc1\xc5\xc7\xcb\xcc\xcd\xce\xdf\xe2\xe5\xe9\xea\xef\xf1\xf7\xf9\xfc",
+		"\x00\x0c\x0f\x10\x17\x1b\x1c\x23\x24\x2f\x30\x31\x34\x3e\x43\x44\x4c\x4d\x50\x54\x5b\x5c\x5d\x63\x69\x6a\x6b\x6d\x71\x73\x76\x78\x7b\x7d\x81\x82\x89\x8e\x8f\x92\x95\x9a\x9b\xa0\xa1\xa4\xa6\xa8\xae\xb2\xb4\xb8\xb9\xba\xbc\xc1\xc2\xc3\xc4\xc5\xc9\xcf\xd0\xd4\xda\xdd\xde\xe0\xe1\xe2\xe4\xe5\xe6\xea\xec\xf1\xf3\xf4\xf6\xf8\xf9\xfe",
+		"\x04\x06\x08\x09\x0a\x0b\x0d\x0e\x11\x15\x16\x18\x19\x20\x21\x26\x27\x28\x33\x38\x3b\x3f\x40\x42\x46\x48\x4a\x4e\x4f\x51\x53\x55\x5a\x5e\x60\x61\x67\x68\x72\x74\x75\x76\x77\x7c\x7d\x83\x87\x88\x91\x94\x97\x98\x9d\x9f\xa2\xa5\xa7\xaa\xab\xac\xad\xb0\xb1\xb6\xb9\xc4\xc6\xc7\xcc\xd3\xd7\xdc\xdd\xe3\xe7\xe9\xeb\xed\xf5\xfb\xfd\xff",
+		"\x01\x02\x07\x12\x13\x14\x1a\x1d\x22\x25\x29\x2a\x2b\x2c\x2d\x2e\x32\x35\x39\x3a\x3c\x3d\x44\x45\x47\x49\x4b\x52\x56\x57\x59\x5f\x62\x64\x65\x66\x6c\x6e\x70\x79\x84\x85\x86\x8a\x8b\x8c\x90\x93\x96\x99\x9c\x9e\xa1\xa3\xa9\xaf\xb5\xb7\xbb\xbd\xbe\xbf\xc0\xc8\xca\xcb\xce\xd1\xd2\xd5\xd6\xd9\xdb\xdf\xe1\xe8\xee\xef\xf0\xf3\xf7\xfc",
+		"\x01\x03\x05\x0a\x0b\x0e\x11\x14\x17\x1b\x1e\x1f\x21\x22\x24\x27\x2a\x2e\x32\x34\x36\x37\x38\x3b\x3d\x41\x55\x58\x5f\x64\x67\x68\x6b\x6f\x70\x7a\x7c\x7e\x7f\x80\x84\x87\x89\x8a\x8d\x92\x96\x98\x9a\x9b\x9e\xa0\xa1\xa4\xab\xae\xaf\xb2\xb3\xb8\xbf\xc0\xc1\xc3\xc8\xcd\xd0\xd1\xd5\xd8\xdd\xdf\xe3\xe4\xe7\xeb\xf0\xf2\xf6\xfa\xfc\xfe",
+	},
+
+	// Group 62 'j'
+	{
+		"\x00\x02\x03\x05\x07\x0f\x11\x15\x16\x18\x19\x1c\x1d\x1f\x21\x25\x27\x2b\x2e\x35\x37\x38\x3b\x3f\x40\x42\x46\x47\x4f\x50\x51\x52\x57\x58\x59\x5b\x5d\x61\x64\x67\x70\x76\x7b\x7e\x82\x85\x86\x88\x8d\x8f\x92\x94\x98\x99\x9a\x9e\x9f\xa2\xa4\xa6\xa7\xa9\xad\xae\xb3\xb7\xb8\xb9\xbb\xc9\xcc\xd0\xd1\xd8\xda\xde\xe6\xec\xf0\xf4\xf9\xfb",
+		"\x09\x0d\x13\x14\x17\x1a\x22\x23\x24\x2a\x2c\x2d\x31\x33\x39\x3c\x3e\x43\x44\x4e\x53\x54\x55\x5f\x63\x66\x69\x6a\x6d\x6e\x6f\x71\x75\x78\x79\x7d\x81\x83\x87\x89\x8a\x8c\x90\x97\x9c\x9d\xa0\xa1\xa3\xa5\xa8\xaa\xab\xb1\xba\xbc\xbe\xc0\xc1\xc3\xc4\xc5\xc7\xca\xcd\xd2\xd4\xd5\xd6\xdc\xdf\xe2\xe3\xe4\xe8\xee\xef\xf1\xf2\xf5\xfa\xfc",
+		"\x01\x04\x06\x0a\x0b\x0c\x10\x12\x1b\x1e\x20\x26\x28\x29\x2f\x30\x34\x36\x3d\x41\x45\x48\x49\x4a\x4b\x4c\x4d\x56\x5a\x5c\x5e\x60\x62\x65\x68\x6b\x6c\x72\x74\x77\x7c\x7f\x84\x8b\x8e\x91\x93\x95\x96\xac\xaf\xb0\xb2\xb4\xb5\xbd\xbf\xc2\xc8\xcb\xce\xcf\xd3\xd7\xd9\xdb\xdd\xe0\xe1\xe5\xe7\xe9\xea\xeb\xed\xf3\xf6\xf7\xf8\xfd\xfe\xff",
+		"\x02\x08\x0a\x0d\x0e\x10\x11\x13\x1a\x1c\x21\x23\x26\x2a\x2b\x32\x33\x37\x38\x3a\x3f\x44\x45\x4a\x50\x51\x55\x57\x5a\x5b\x5e\x64\x66\x69\x6f\x72\x73\x75\x79\x7a\x7d\x80\x81\x83\x84\x88\x89\x8c\x91\x92\x98\x99\x9a\x9b\x9c\x9d\x9e\xa3\xa5\xa6\xa8\xab\xb0\xb1\xb2\xb6\xc6\xcd\xcf\xd1\xd3\xd4\xda\xec\xed\xf0\xf1\xf2\xf3\xf5\xf8\xfe",
+		"\x05\x06\x08\x09\x0e\x0f\x12\x14\x19\x1b\x1d\x24\x25\x2c\x2d\x2e\x30\x32\x39\x3b\x3d\x41\x42\x48\x49\x53\x56\x61\x62\x63\x65\x67\x6b\x73\x74\x76\x77\x78\x7b\x80\x8b\x8f\x90\x93\x94\x95\x9b\x9f\xa1\xa9\xae\xaf\xb4\xb5\xb6\xbb\xbc\xbe\xbf\xc0\xc1\xc2\xc3\xc4\xc9\xcb\xcc\xce\xd2\xd6\xd7\xd9\xdb\xdc\xe1\xe2\xe3\xe6\xe8\xeb\xee\xf7",
+		"\x00\x01\x03\x04\x07\x0b\x0c\x15\x16\x17\x18\x1e\x1f\x20\x22\x27\x2f\x31\x34\x35\x3c\x40\x43\x46\x47\x4b\x4c\x4d\x4e\x54\x58\x59\x5c\x5f\x60\x6a\x6c\x6d\x6e\x70\x71\x7a\x7c\x7e\x82\x85\x86\x87\x8d\x8e\x96\x97\xa2\xa4\xaa\xac\xb7\xb8\xb9\xbd\xc5\xc6\xc7\xc8\xca\xd5\xd8\xdd\xde\xdf\xe4\xe5\xe9\xea\xf4\xf6\xf9\xfa\xfb\xfc\xfd\xff",
+		"\x02\x05\x0a\x0e\x10\x14\x19\x1a\x1b\x1d\x20\x21\x25\x26\x28\x29\x2a\x2e\x2f\x36\x3a\x3e\x3f\x41\x45\x4a\x4f\x50\x52\x5b\x5c\x5d\x5f\x68\x69\x76\x78\x7a\x7c\x7f\x82\x83\x84\x87\x88\x89\x8a\x94\x99\xa0\xa6\xa7\xa8\xab\xad\xae\xaf\xb3\xb5\xba\xbe\xc2\xc4\xc8\xc9\xca\xcb\xcd\xce\xd0\xd2\xd4\xd7\xdb\xe0\xe4\xe7\xef\xf8\xfa\xfb\xfe",
+	},
+
+	// Group 63 'k'
+	{
+		"\x00\x04\x07\x09\x0b\x0d\x13\x14\x15\x16\x17\x1b\x1c\x1e\x26\x28\x2c\x31\x36\x37\x3d\x3f\x41\x42\x44\x46\x4a\x4b\x50\x53\x5a\x5c\x5d\x62\x64\x65\x68\x69\x6b\x6c\x6f\x71\x72\x74\x77\x7b\x82\x88\x8d\x8f\x91\x96\x97\xa0\xa5\xa9\xb1\xb8\xba\xbb\xbc\xbd\xbf\xc0\xc1\xc4\xc5\xc6\xc8\xd0\xd8\xd9\xda\xdc\xdd\xe0\xe2\xe7\xef\xf5\xf8\xfa",
+		"\x02\x03\x06\x0a\x0f\x10\x11\x18\x19\x1a\x20\x23\x25\x27\x2a\x2e\x32\x3b\x3e\x48\x49\x4e\x4f\x52\x54\x55\x56\x5b\x63\x66\x6a\x6d\x76\x79\x7a\x7c\x7d\x81\x84\x85\x87\x8a\x8e\x99\x9a\x9b\x9c\x9e\xa1\xa2\xa6\xaa\xab\xad\xaf\xb0\xb2\xb5\xb6\xbe\xc3\xc7\xcb\xcd\xce\xcf\xd1\xd3\xd6\xdb\xde\xdf\xe3\xe5\xe6\xe9\xec\xed\xee\xf0\xf9\xfd",
+		"\x01\x05\x08\x0e\x12\x1d\x1f\x21\x22\x2b\x2d\x2f\x30\x33\x34\x35\x38\x39\x3a\x3c\x43\x45\x47\x4c\x4d\x51\x59\x5e\x5f\x60\x61\x67\x6e\x70\x73\x75\x78\x7e\x7f\x80\x83\x86\x89\x8b\x8c\x90\x92\x93\x94\x98\x9d\x9f\xa3\xa4\xa7\xa8\xac\xae\xb3\xb4\xb7\xc2\xc9\xca\xcc\xd2\xd4\xd5\xd7\xe1\xe4\xe8\xeb\xf1\xf2\xf3\xf6\xf7\xfb\xfc\xfe\xff",
+		"\x01\x07\x0c\x0f\x14\x16\x1f\x24\x29\x2d\x2f\x31\x33\x34\x37\x39\x3a\x3e\x40\x42\x44\x45\x49\x4b\x50\x51\x56\x57\x58\x5d\x60\x68\x70\x71\x72\x77\x79\x85\x8a\x8c\x90\x92\x95\x98\x9d\x9e\xa0\xa2\xa6\xac\xae\xb1\xb3\xb5\xb8\xb9\xba\xc0\xc5\xca\xcb\xce\xcf\xd2\xd3\xd6\xd8\xdd\xe0\xe3\xe4\xe5\xe9\xea\xeb\xf3\xf4\xf6\xf8\xf9\xfa\xfd",
+		"\x03\x05\x08\x09\x0e\x11\x13\x15\x19\x1a\x1c\x1d\x1e\x20\x21\x22\x25\x26\x27\x28\x29\x2b\x2e\x30\x35\x36\x3b\x40\x43\x47\x4a\x4e\x4f\x58\x59\x5b\x61\x63\x64\x67\x6b\x6c\x76\x7c\x7d\x7e\x7f\x82\x84\x86\x88\x89\x8b\x8f\x91\x94\x95\x99\x9c\x9f\xa1\xa4\xa5\xa7\xa9\xaa\xad\xb0\xb6\xb9\xc6\xcd\xd0\xd5\xde\xdf\xe2\xe8\xea\xec\xf5\xfb",
+		"\x02\x04\x06\x0a\x0b\x0c\x0d\x18\x1b\x23\x24\x2a\x2c\x3c\x3d\x3f\x46\x48\x4d\x53\x54\x55\x5a\x5c\x5e\x5f\x62\x65\x66\x69\x6a\x6d\x6e\x73\x75\x78\x7a\x7b\x80\x81\x83\x87\x8d\x8e\x96\x9a\x9b\xa8\xab\xaf\xb2\xb4\xb7\xbc\xbd\xbe\xbf\xc1\xc2\xc3\xc4\xc7\xc8\xc9\xd1\xd4\xd7\xd9\xda\xdc\xe1\xe6\xe7\xed\xee\xef\xf1\xf2\xf4\xf7\xfe\xff",
+		"\x00\x06\x0d\x10\x12\x17\x19\x1a\x1b\x22\x29\x2d\x2e\x30\x32\x33\x35\x36\x38\x3a\x3d\x3f\x41\x43\x4c\x52\x54\x55\x57\x59\x5b\x5d\x5e\x62\x63\x65\x69\x6a\x6c\x6f\x74\x75\x77\x78\x79\x7d\x8b\x8d\x91\x92\x93\x94\x96\x97\x98\x9b\xa3\xa5\xa6\xa9\xad\xb1\xb5\xb8\xba\xbb\xc2\xcc\xce\xd0\xd3\xdb\xdc\xdd\xe3\xe4\xf0\xf5\xf6\xfb\xfc\xfd",
+	},
+
+	// Group 64 'l'
+	{
+		"\x00\x04\x09\x0b\x0d\x0e\x13\x15\x18\x1d\x1e\x24\x26\x29\x2b\x2f\x31\x35\x36\x38\x3f\x41\x47\x48\x4f\x55\x5c\x5e\x5f\x66\x6d\x71\x72\x73\x74\x75\x79\x7a\x7b\x80\x81\x83\x85\x88\x94\x95\x97\xa0\xa2\xa5\xa6\xa7\xa8\xac\xad\xb2\xb4\xb6\xba\xbd\xbe\xbf\xc0\xc1\xc3\xc6\xc8\xca\xcb\xd2\xd3\xd5\xd6\xdc\xe8\xe9\xec\xf1\xf4\xf8\xfa\xfd",
+		"\x02\x05\x07\x08\x0a\x0c\x10\x11\x1a\x1b\x20\x22\x23\x25\x2a\x2c\x30\x32\x33\x40\x44\x45\x4b\x4e\x50\x53\x54\x56\x59\x5b\x5d\x60\x62\x65\x68\x69\x6a\x6b\x6e\x7e\x8b\x8d\x8f\x90\x91\x93\x98\x99\x9c\x9f\xa4\xaa\xab\xaf\xb1\xb5\xbb\xc2\xc4\xc5\xc9\xcd\xd0\xd1\xd7\xd8\xdb\xde\xe1\xe2\xe3\xe4\xe6\xe7\xeb\xf0\xf3\xf6\xf7\xfb\xfc\xfe",
+		"\x03\x0f\x12\x14\x16\x17\x19\x1c\x1f\x21\x27\x28\x2d\x2e\x34\x37\x39\x3b\x3c\x3d\x3e\x42\x43\x49\x4c\x4d\x51\x52\x57\x58\x5a\x61\x63\x64\x6f\x70\x76\x77\x78\x7c\x7d\x7f\x82\x87\x89\x8a\x8c\x8e\x92\x96\x9a\x9b\x9d\x9e\xa1\xa3\xa9\xae\xb0\xb3\xb7\xb8\xb9\xbc\xc7\xcc\xce\xcf\xd4\xd9\xda\xdf\xe0\xe5\xea\xed\xee\xef\xf2\xf5\xf9\xff",
+		"\x01\x02\x03\x06\x17\x18\x19\x1c\x24\x29\x2a\x2d\x2e\x2f\x34\x35\x36\x38\x3a\x3c\x3e\x46\x47\x48\x4a\x56\x58\x59\x5a\x5c\x62\x66\x67\x69\x6b\x6c\x6f\x73\x76\x77\x78\x7c\x7e\x84\x85\x86\x8a\x8d\x8e\x8f\x9b\x9c\x9d\xa3\xa4\xa6\xb0\xb2\xb3\xb4\xb7\xba\xbb\xbe\xc4\xc7\xc8\xce\xcf\xd0\xd1\xd2\xdd\xe0\xe3\xed\xee\xf1\xf5\xf8\xf9\xfb",
+		"\x00\x01\x04\x0b\x0e\x0f\x10\x15\x1d\x1e\x1f\x20\x22\x25\x26\x31\x37\x39\x3a\x3f\x40\x42\x43\x45\x4c\x50\x52\x53\x55\x57\x5f\x61\x68\x6a\x6c\x6d\x70\x71\x7a\x7d\x7f\x81\x8b\x91\x97\x98\x99\x9e\x9f\xa1\xa5\xa7\xa9\xaa\xab\xae\xaf\xb6\xbf\xc2\xc5\xc6\xca\xd3\xd4\xd8\xd9\xdb\xdd\xde\xe1\xe2\xe5\xe6\xe8\xe9\xeb\xf0\xf3\xf7\xfa\xfe",
+		"\x05\x06\x08\x0a\x0c\x0d\x11\x12\x13\x16\x1a\x1b\x21\x23\x27\x2b\x30\x32\x33\x3b\x3d\x41\x44\x46\x4a\x4d\x4f\x51\x54\x5b\x5d\x60\x63\x64\x65\x67\x6e\x72\x74\x79\x7b\x80\x82\x83\x84\x86\x87\x88\x92\x93\x95\x96\x9a\xa0\xa2\xa8\xac\xad\xb1\xb8\xb9\xbc\xbd\xc0\xc1\xc3\xc9\xcb\xd5\xd6\xd7\xda\xdc\xdf\xe4\xe7\xea\xec\xef\xf2\xf6\xfd",
+		"\x00\x01\x03\x07\x08\x09\x0d\x14\x16\x18\x19\x26\x27\x28\x2b\x2c\x30\x31\x34\x36\x44\x45\x48\x49\x4b\x4e\x54\x5e\x5f\x60\x64\x65\x6c\x6e\x71\x73\x75\x7b\x7d\x7f\x85\x89\x8c\x90\x94\x95\x98\x99\x9b\x9c\x9e\x9f\xa0\xa6\xac\xaf\xb4\xb5\xb6\xbb\xbf\xc2\xc8\xc9\xcc\xcd\xd3\xd5\xd9\xdc\xe4\xe6\xe8\xea\xee\xef\xf4\xf7\xf8\xf9\xfc\xff",
+	},
+
+	// Group 65 'm'
+	{
+		"\x03\x05\x08\x0d\x0e\x10\x11\x16\x17\x1e\x1f\x24\x2a\x33\x37\x38\x3f\x41\x45\x46\x4e\x4f\x52\x57\x5d\x5f\x60\x61\x65\x66\x67\x68\x70\x73\x74\x76\x77\x78\x7d\x84\x85\x88\x8c\x8f\x92\x93\x95\x96\x97\xa0\xa5\xa7\xa8\xad\xae\xb1\xb3\xb6\xb8\xbd\xc2\xc5\xc7\xcf\xd0\xd2\xd3\xd9\xda\xdb\xde\xdf\xe6\xe9\xeb\xf0\xf1\xf3\xf5\xfc\xfd\xfe",
+		"\x01\x06\x09\x0a\x0b\x12\x14\x19\x1a\x1c\x1d\x21\x25\x26\x27\x28\x29\x2c\x2d\x2e\x31\x32\x36\x3b\x3d\x42\x44\x49\x4b\x55\x5b\x62\x63\x6b\x6e\x6f\x71\x75\x7a\x7b\x7c\x7f\x80\x86\x89\x8a\x90\x91\x94\x98\x99\x9a\x9e\xa2\xa4\xaa\xab\xac\xb0\xb2\xb4\xb5\xb7\xba\xbb\xc3\xc6\xce\xd1\xd6\xd7\xe2\xe3\xe4\xe7\xe8\xea\xec\xee\xf2\xf6\xf8",
+		"\x00\x02\x04\x07\x0c\x0f\x13\x15\x18\x1b\x20\x22\x23\x2b\x30\x34\x35\x39\x3a\x3c\x3e\x40\x43\x47\x48\x4a\x4d\x50\x53\x54\x56\x58\x59\x5a\x5c\x64\x69\x6a\x6c\x6d\x72\x79\x7e\x81\x82\x83\x87\x8b\x8d\x8e\x9c\x9d\x9f\xa1\xa3\xa6\xa9\xaf\xb9\xbc\xbe\xbf\xc0\xc1\xc4\xc8\xc9\xcb\xcc\xcd\xd4\xd5\xd8\xdc\xdd\xe1\xe5\xed\xf4\xf7\xf9\xfa",
+		"\x04\x07\x08\x0a\x0c\x10\x11\x1d\x1e\x26\x27\x2f\x32\x37\x39\x3b\x3d\x3e\x40\x41\x42\x44\x49\x4c\x51\x57\x5d\x5e\x6d\x71\x73\x75\x76\x7e\x80\x8c\x98\x9b\x9e\x9f\xa0\xa2\xa6\xa7\xa8\xae\xb2\xb5\xb7\xbc\xbd\xbe\xbf\xc1\xc2\xc4\xc5\xc9\xca\xcb\xd8\xda\xdb\xdc\xde\xe0\xe3\xe9\xea\xed\xee\xef\xf0\xf1\xf2\xf3\xf4\xf6\xf8\xfb\xfd\xff",
+		"\x02\x06\x13\x14\x15\x18\x1a\x1b\x1c\x20\x24\x25\x2b\x2f\x30\x3a\x43\x45\x4a\x4c\x4e\x4f\x50\x54\x58\x59\x5a\x5c\x60\x62\x63\x64\x65\x66\x69\x6b\x6c\x6e\x70\x79\x7b\x7c\x81\x84\x85\x86\x88\x8a\x8b\x91\x92\x94\x96\x97\x99\x9a\x9b\xa3\xa4\xa9\xaa\xb1\xb6\xb8\xbb\xcd\xce\xd0\xd3\xd5\xdf\xe0\xe1\xe2\xe5\xe7\xf5\xf7\xfb\xfc\xfe\xff",
+		"\x00\x01\x03\x09\x0b\x0e\x0f\x12\x16\x17\x19\x1f\x21\x22\x23\x28\x2a\x2c\x2d\x2e\x31\x33\x34\x36\x38\x3c\x46\x48\x4b\x4d\x52\x53\x55\x56\x5b\x5f\x61\x67\x68\x72\x74\x77\x78\x7a\x7d\x83\x89\x8d\x8e\x8f\x93\x9c\xa1\xa5\xab\xad\xaf\xb0\xb4\xb9\xba\xc6\xc7\xc8\xca\xcc\xcf\xd1\xd2\xd4\xd6\xd7\xd9\xdd\xe4\xe6\xe8\xeb\xec\xef\xf9\xfa",
+		"\x01\x04\x05\x07\x08\x09\x0b\x0d\x0e\x0f\x10\x13\x17\x25\x26\x29\x2d\x32\x35\x3a\x3d\x3e\x3f\x40\x44\x45\x46\x47\x4a\x4b\x4d\x51\x59\x5e\x61\x62\x67\x6a\x6c\x6f\x71\x77\x7e\x7f\x80\x82\x84\x87\x90\x91\x95\x9c\x9d\xa2\xa3\xa5\xa7\xac\xae\xaf\xb0\xb3\xb7\xba\xc0\xc3\xc7\xcb\xcc\xd5\xd8\xda\xde\xe0\xe1\xe5\xec\xee\xf0\xf2\xf9\xfb",
+	},
+
+	// Group 66 'n'
+	{
+		"\x03\x04\x0c\x0e\x0f\x13\x16\x18\x19\x27\x28\x2b\x2d\x30\x31\x34\x36\x38\x3d\x3f\x40\x42\x46\x47\x48\x4a\x4b\x52\x54\x55\x59\x5c\x5e\x60\x68\x69\x6b\x73\x77\x7a\x7f\x81\x82\x84\x87\x88\x8d\x8e\x8f\x91\x92\x94\x97\x9c\xa3\xa5\xa6\xb4\xb5\xb6\xb9\xbb\xbd\xc0\xc9\xca\xcf\xd6\xd7\xda\xdd\xde\xe1\xe2\xea\xeb\xef\xf1\xf3\xf4\xf7\xfc",
+		"\x00\x01\x05\x06\x08\x0b\x0d\x10\x12\x14\x17\x1a\x1b\x1d\x1e\x1f\x22\x24\x2e\x32\x35\x3b\x3c\x41\x43\x44\x45\x49\x4d\x4f\x51\x57\x58\x5f\x61\x6c\x71\x76\x79\x7d\x7e\x85\x89\x96\x98\x99\x9a\x9d\x9f\xa8\xab\xac\xad\xae\xaf\xb0\xb1\xb7\xb8\xc1\xc3\xc4\xc5\xc7\xc8\xcc\xcd\xce\xd3\xd4\xd8\xe0\xe3\xe4\xe5\xe8\xe9\xec\xed\xf5\xfd\xff",
+		"\x02\x07\x0a\x11\x1c\x21\x25\x26\x29\x2a\x2c\x2f\x33\x37\x39\x3a\x3e\x4c\x4e\x50\x53\x56\x5b\x5d\x62\x63\x64\x65\x66\x67\x6a\x6d\x6e\x6f\x70\x72\x74\x75\x78\x7b\x7c\x80\x83\x8a\x8b\x8c\x90\x93\x95\x9b\x9e\xa0\xa1\xa2\xa4\xa7\xa9\xaa\xb2\xb3\xba\xbc\xbe\xbf\xc2\xc6\xcb\xd0\xd1\xd2\xd5\xd9\xdb\xdc\xe6\xe7\xf0\xf2\xf8\xfa\xfb\xfe",
+		"\x02\x08\x09\x0f\x15\x17\x19\x1a\x1d\x20\x23\x24\x25\x26\x28\x29\x2a\x2b\x2d\x2f\x39\x3c\x3e\x42\x43\x47\x48\x4c\x52\x53\x54\x55\x57\x58\x5a\x5c\x63\x65\x69\x6c\x75\x76\x77\x79\x7f\x82\x86\x8d\x92\x94\x95\x99\xa2\xa6\xa7\xa8\xaa\xac\xad\xae\xb2\xb8\xbb\xbd\xbf\xc1\xc7\xc9\xcd\xd5\xd9\xdf\xeb\xee\xef\xf1\xf2\xf6\xf9\xfa\xfe\xff",
+		"\x03\x05\x06\x09\x0c\x10\x15\x18\x20\x2e\x30\x31\x36\x3b\x3f\x40\x44\x4a\x4b\x4d\x4f\x56\x5b\x5e\x60\x61\x62\x68\x6d\x6e\x6f\x71\x72\x74\x7c\x7d\x81\x86\x88\x8b\x90\x91\x93\x97\x9a\x9b\x9c\xa1\xa3\xa5\xa9\xab\xb3\xb4\xb7\xba\xbc\xc0\xc2\xc4\xcb\xcc\xcf\xd0\xd1\xd2\xd4\xd7\xdb\xdd\xe3\xe4\xe6\xe8\xec\xed\xf0\xf3\xf5\xf6\xf7\xfc",
+		"\x00\x07\x0d\x0e\x12\x13\x14\x16\x1b\x1c\x1e\x1f\x21\x22\x27\x2c\x32\x33\x34\x37\x38\x3a\x3d\x45\x46\x49\x50\x51\x5a\x5d\x5f\x64\x66\x67\x6a\x6b\x70\x7a\x7b\x80\x83\x84\x85\x87\x89\x8a\x8c\x8e\x8f\x98\x9d\x9f\xa0\xa4\xb0\xb1\xb6\xb9\xbe\xc3\xc5\xc6\xc8\xca\xce\xd6\xda\xdc\xde\xdf\xe0\xe1\xe5\xe7\xe9\xea\xee\xf4\xf8\xf9\xfb\xfd",
+		"\x00\x01\x04\x05\x08\x09\x0a\x0b\x0f\x10\x11\x1b\x1f\x21\x22\x23\x24\x27\x2b\x2d\x30\x31\x35\x41\x47\x48\x49\x4d\x4e\x51\x54\x56\x59\x5a\x62\x65\x66\x71\x72\x73\x74\x77\x78\x7d\x7e\x85\x87\x8b\x8e\x8f\x92\x93\x96\x97\x9a\x9e\xa2\xa3\xa9\xaf\xb3\xb5\xb7\xbf\xc3\xc5\xcb\xd3\xd4\xd7\xd8\xdb\xdd\xe0\xe2\xe5\xe7\xe9\xea\xf2\xf8\xfe",
+	},
+
+	// Group 67 'o'
+	{
+		"\x02\x03\x04\x05\x06\x07\x0e\x17\x18\x19\x1a\x1d\x1f\x21\x22\x23\x24\x25\x27\x2e\x2f\x36\x37\x39\x3b\x42\x47\x48\x49\x4e\x50\x52\x53\x5b\x5c\x5d\x60\x63\x68\x6d\x6f\x71\x75\x78\x7b\x7e\x80\x82\x84\x86\x87\x89\x8c\x93\x94\x9a\x9e\x9f\xa6\xa9\xac\xad\xb1\xb4\xb7\xbb\xbf\xc3\xc9\xcf\xd4\xd7\xd9\xdb\xdf\xe1\xe9\xf2\xf5\xf7\xf8\xfb",
+		"\x00\x09\x0a\x0b\x0d\x11\x14\x16\x1b\x1c\x1e\x20\x28\x29\x2c\x30\x31\x32\x3d\x3f\x44\x46\x4c\x57\x59\x5a\x5e\x62\x65\x67\x6c\x6e\x70\x72\x74\x76\x77\x7a\x7d\x81\x85\x88\x8a\x8b\x8d\x90\x92\x96\x98\x9b\x9c\x9d\xa0\xa1\xa2\xae\xb2\xb5\xb6\xbc\xbd\xc0\xc5\xc6\xce\xd2\xd3\xd8\xdc\xdd\xe3\xe6\xe8\xec\xed\xee\xef\xf0\xf1\xf4\xfc\xfe",
+		"\x08\x0f\x10\x12\x13\x15\x26\x2a\x2b\x2d\x33\x34\x35\x38\x3a\x3c\x3e\x40\x41\x43\x45\x4a\x4b\x4d\x4f\x54\x56\x58\x5f\x61\x64\x66\x69\x6a\x6b\x73\x79\x7c\x7f\x83\x8e\x8f\x91\x95\x97\x99\xa3\xa4\xa5\xa7\xa8\xaa\xaf\xb0\xb3\xb8\xb9\xba\xbe\xc1\xc2\xc8\xca\xcb\xcc\xcd\xd0\xd1\xd5\xd6\xda\xde\xe0\xe2\xe4\xe7\xea\xeb\xf6\xf9\xfd\xff",
+		"\x01\x02\x03\x06\x0a\x0c\x0f\x11\x17\x1a\x1b\x1c\x1e\x1f\x24\x26\x27\x28\x29\x2a\x2d\x30\x32\x3e\x41\x42\x48\x50\x51\x55\x57\x5a\x5e\x65\x6b\x6d\x6f\x7d\x81\x89\x8a\x8d\x92\x9b\xa0\xa5\xa7\xab\xac\xad\xb0\xb3\xb7\xba\xbd\xbe\xbf\xc0\xc2\xc4\xc5\xc6\xc7\xc8\xcb\xcc\xcf\xd5\xd6\xda\xde\xe0\xe2\xe3\xe5\xe7\xee\xf2\xf3\xf4\xf5\xfa",
+		"\x01\x04\x08\x0c\x0d\x10\x12\x16\x18\x19\x2e\x35\x36\x3a\x3b\x3c\x3f\x40\x44\x45\x47\x4b\x4d\x4e\x51\x55\x56\x59\x5d\x60\x61\x64\x6a\x70\x71\x75\x76\x7a\x7e\x82\x84\x85\x8c\x90\x91\x93\x94\x9a\x9c\x9e\xa1\xa3\xa6\xaa\xab\xaf\xb1\xb5\xc3\xc9\xca\xcd\xd1\xd2\xd3\xd8\xd9\xdb\xdc\xe4\xe6\xea\xeb\xed\xef\xf0\xf1\xf8\xf9\xfa\xfb\xfe",
+		"\x00\x07\x09\x0b\x0e\x14\x15\x1d\x23\x25\x2b\x2f\x31\x33\x34\x37\x38\x3d\x43\x46\x49\x4c\x4f\x53\x54\x58\x5b\x5c\x5f\x62\x66\x67\x68\x69\x6c\x6e\x72\x73\x74\x78\x79\x7c\x80\x83\x86\x8f\x95\x96\x97\x98\x99\x9d\x9f\xa2\xa9\xae\xb2\xb4\xb6\xb8\xb9\xbb\xbc\xc1\xc4\xc7\xce\xd0\xd7\xdd\xdf\xe1\xe5\xe8\xe9\xec\xf3\xf6\xf7\xfc\xfd\xff",
+		"\x03\x05\x07\x0b\x0f\x12\x13\x15\x1e\x20\x21\x22\x26\x29\x2c\x2f\x31\x32\x39\x3b\x3c\x3e\x40\x41\x44\x47\x49\x4a\x4f\x52\x55\x5a\x5b\x5e\x63\x68\x6d\x72\x73\x77\x7b\x7d\x7f\x80\x82\x87\x88\x89\x8a\x8b\x8c\x8e\x8f\x93\x98\xa4\xa7\xa8\xb2\xb3\xb6\xba\xbc\xc0\xcf\xd0\xd1\xd4\xdd\xdf\xe0\xe5\xe8\xea\xee\xef\xf0\xf2\xf3\xf4\xf6\xf7",
+	},
+
+	// Group 68 'p'
+	{
+		"\x02\x05\x07\x08\x0b\x0f\x17\x1f\x26\x27\x29\x2a\x2e\x2f\x30\x33\x35\x36\x38\x39\x3b\x3f\x41\x44\x46\x4d\x4e\x51\x57\x62\x63\x64\x68\x6d\x78\x79\x7b\x7c\x80\x8d\x8e\x8f\x91\x94\x95\x9d\xa0\xa2\xa3\xa5\xa9\xaa\xac\xad\xae\xaf\xb0\xb6\xb7\xb8\xb9\xba\xbb\xbc\xc3\xc5\xca\xcf\xd0\xd3\xd7\xda\xdb\xdf\xe1\xe3\xe6\xe8\xf3\xf5\xfa\xfe",
+		"\x03\x0c\x0d\x0e\x10\x11\x12\x14\x15\x1c\x1d\x1e\x20\x23\x24\x2c\x32\x37\x3a\x3c\x3d\x43\x47\x48\x4a\x50\x56\x5c\x5d\x5f\x60\x65\x69\x6a\x6b\x6c\x6f\x70\x73\x75\x77\x7d\x7f\x81\x82\x83\x86\x89\x8a\x8b\x96\x97\x99\x9c\x9e\x9f\xa6\xa8\xb1\xb2\xb5\xbd\xbe\xc0\xc2\xc6\xc8\xce\xd1\xd9\xe4\xe9\xea\xeb\xec\xf4\xf6\xf7\xf9\xfb\xfc\xfd",
+		"\x00\x01\x04\x09\x0a\x16\x18\x19\x1a\x1b\x21\x22\x25\x28\x2b\x2d\x31\x34\x3e\x40\x42\x45\x49\x4b\x4c\x4f\x52\x53\x54\x55\x58\x59\x5b\x5e\x61\x66\x67\x6e\x71\x72\x74\x76\x84\x85\x87\x8c\x90\x92\x93\x98\x9a\x9b\xa1\xa4\xa7\xab\xb4\xbf\xc1\xc4\xc7\xc9\xcb\xcc\xcd\xd2\xd4\xd5\xd6\xd8\xdd\xde\xe0\xe2\xe5\xed\xee\xf0\xf1\xf2\xf8\xff",
+		"\x06\x0a\x0b\x11\x12\x13\x14\x1f\x21\x22\x26\x28\x29\x2a\x31\x32\x35\x38\x3d\x42\x45\x48\x55\x56\x58\x5a\x62\x63\x69\x6b\x6d\x72\x74\x75\x77\x78\x7a\x7d\x7e\x83\x86\x88\x8e\x90\x91\x98\x99\x9c\x9f\xa2\xa5\xa7\xa8\xa9\xaa\xae\xaf\xb3\xb7\xba\xbc\xc0\xc1\xca\xcb\xd8\xdc\xe0\xe1\xe5\xe7\xe9\xea\xeb\xec\xee\xef\xf1\xf3\xf5\xf8\xf9",
+		"\x00\x01\x02\x03\x04\x05\x0c\x0f\x10\x17\x18\x1b\x1d\x1e\x23\x25\x2b\x2f\x30\x36\x37\x3a\x3f\x41\x49\x4a\x4b\x4c\x52\x54\x57\x5a\x5c\x5d\x5f\x60\x65\x6a\x6c\x6e\x6f\x79\x7b\x7e\x7f\x80\x85\x87\x88\x8c\x8d\x93\x9d\xa1\xa3\xa4\xab\xac\xb0\xb3\xb4\xb5\xb8\xb9\xbb\xbd\xbe\xbf\xc4\xc5\xd4\xd5\xd9\xdc\xe2\xe3\xe6\xe7\xef\xf4\xfc\xfd",
+		"\x07\x08\x09\x0d\x0e\x15\x16\x1a\x1c\x24\x27\x2c\x2e\x39\x3b\x3c\x40\x43\x44\x46\x4d\x4e\x4f\x50\x51\x53\x59\x5b\x61\x64\x66\x67\x68\x71\x73\x76\x7c\x81\x82\x84\x89\x8a\x8b\x92\x94\x95\x96\x97\x9a\x9b\x9e\xa0\xa6\xad\xb1\xb6\xc2\xc3\xc6\xc8\xc9\xcd\xce\xcf\xd0\xd1\xd2\xd6\xd7\xda\xdb\xdd\xde\xe4\xe8\xed\xf2\xf6\xf7\xfa\xfb\xfe",
+		"\x01\x03\x05\x06\x0c\x0e\x0f\x11\x13\x15\x19\x20\x22\x24\x29\x2b\x2c\x2d\x30\x33\x34\x35\x37\x3e\x47\x48\x49\x4a\x4b\x4e\x4f\x50\x52\x58\x59\x5c\x5e\x60\x61\x65\x67\x6e\x6f\x70\x75\x76\x77\x7a\x7b\x88\x8f\x92\xaa\xb2\xb5\xb7\xbb\xbd\xbf\xc4\xc7\xc8\xcb\xcc\xd3\xd7\xda\xdb\xdd\xde\xdf\xe2\xe3\xe4\xee\xef\xf0\xf3\xf6\xf9\xfd\xff",
+	},
+
+	// Group 69 'q'
+	{
+		"\x00\x02\x05\x09\x0c\x0e\x10\x15\x1a\x1e\x21\x22\x23\x25\x28\x2a\x2c\x30\x32\x3e\x42\x46\x4b\x4c\x50\x51\x52\x56\x58\x5e\x61\x66\x6a\x6f\x70\x72\x74\x75\x76\x78\x7b\x7c\x7e\x83\x85\x87\x8b\x91\x95\x97\x99\x9b\x9f\xa4\xa6\xae\xb5\xb9\xbb\xbd\xbf\xc0\xc4\xc5\xc6\xc7\xcf\xd0\xd2\xd5\xd7\xd8\xda\xdf\xe0\xe5\xe9\xec\xef\xf2\xf9\xfe",
+		"\x01\x03\x0d\x11\x13\x14\x18\x19\x1b\x1d\x2b\x2d\x2e\x2f\x36\x3b\x3c\x3d\x43\x44\x48\x4a\x4f\x59\x5a\x5b\x5c\x60\x63\x67\x69\x6c\x6d\x6e\x73\x79\x7d\x7f\x82\x86\x8d\x8e\x90\x92\x96\x98\x9d\xa0\xa3\xa5\xa7\xa8\xa9\xaa\xad\xaf\xb0\xb1\xb2\xb3\xb7\xba\xbe\xc1\xc2\xc3\xc8\xca\xce\xdc\xdd\xde\xe4\xeb\xee\xf3\xf4\xf5\xf8\xfb\xfd\xff",
+		"\x06\x07\x0b\x0f\x12\x16\x17\x1c\x1f\x20\x24\x26\x27\x29\x31\x33\x34\x35\x37\x38\x39\x3a\x3f\x40\x41\x45\x47\x49\x4d\x4e\x53\x54\x55\x57\x5d\x62\x64\x68\x6b\x71\x77\x7a\x80\x81\x84\x88\x89\x8a\x8c\x93\x9a\x9c\xa1\xa2\xac\xb4\xb6\xbc\xc9\xcb\xcc\xcd\xd1\xd3\xd4\xd6\xd9\xdb\xe1\xe2\xe3\xe6\xe7\xe8\xea\xed\xf0\xf1\xf6\xf7\xfa\xfc",
+		"\x02\x04\x08\x0a\x11\x15\x1d\x2d\x2e\x33\x36\x3c\x42\x4b\x4c\x50\x51\x5a\x5c\x5f\x62\x65\x69\x6b\x6e\x70\x72\x75\x77\x79\x7c\x7e\x80\x81\x82\x83\x89\x8c\x8f\x94\x95\x96\x9c\x9d\x9e\xa2\xa4\xab\xaf\xb2\xb4\xb5\xb8\xb9\xba\xbb\xbe\xc2\xc4\xc5\xc8\xcc\xd1\xd5\xd6\xd8\xd9\xda\xdc\xe0\xe2\xe3\xe4\xe6\xe9\xf2\xf5\xf6\xf9\xfb\xfd\xff",
+		"\x00\x01\x04\x07\x08\x0a\x0e\x13\x19\x1a\x1b\x1e\x1f\x22\x25\x28\x29\x2b\x32\x34\x35\x39\x3f\x43\x45\x46\x47\x49\x4e\x53\x55\x56\x5e\x61\x63\x64\x65\x66\x67\x6c\x6f\x74\x78\x84\x86\x87\x8d\x8f\x91\x92\x97\x98\x99\x9e\xa1\xa3\xa6\xab\xac\xad\xb0\xb3\xb7\xbf\xc0\xc7\xca\xcd\xce\xcf\xd0\xd2\xdd\xe7\xec\xee\xef\xf0\xf3\xf4\xf7\xf8",
+		"\x03\x06\x09\x0b\x0d\x0f\x10\x12\x14\x16\x1c\x20\x21\x23\x24\x26\x27\x2a\x2c\x2f\x31\x37\x38\x3b\x3d\x3e\x40\x41\x44\x48\x4d\x52\x54\x57\x59\x5b\x5d\x5f\x60\x68\x6a\x6d\x71\x73\x7a\x7b\x7d\x7f\x85\x88\x8b\x8e\x90\x93\x94\x9a\x9b\x9f\xa5\xa7\xa9\xae\xb1\xb8\xbc\xc3\xc6\xcb\xd3\xd4\xd7\xdb\xde\xe1\xe5\xe8\xea\xeb\xed\xf1\xfa\xfe",
+		"\x04\x05\x08\x0b\x0c\x13\x14\x17\x18\x1a\x1c\x1d\x1e\x22\x24\x25\x26\x27\x28\x29\x2a\x2c\x2d\x2f\x30\x35\x3a\x48\x49\x4a\x4c\x4f\x51\x55\x56\x58\x59\x5c\x61\x66\x69\x6b\x6c\x71\x73\x75\x76\x78\x7a\x83\x8a\x8c\x90\x92\x9c\xa0\xa3\xa8\xaa\xaf\xb1\xb6\xba\xbc\xbd\xc1\xc2\xc4\xc7\xc9\xd1\xd4\xd9\xdb\xdf\xe1\xf1\xf2\xf3\xf4\xf7\xfc",
+	},
+
+	// Group 70 'r'
+	{
+		"\x01\x05\x07\x09\x0c\x0d\x12\x15\x17\x1a\x1e\x1f\x24\x2a\x2b\x2c\x36\x37\x38\x3a\x3b\x3d\x43\x47\x4f\x50\x51\x53\x54\x58\x5c\x5d\x5f\x61\x62\x66\x67\x69\x70\x75\x7d\x7f\x87\x88\x8c\x8d\x8f\x91\x92\x94\x96\x98\x99\x9c\xb1\xb3\xba\xbc\xbe\xc5\xc6\xc7\xcb\xcf\xd0\xd4\xd6\xd8\xdc\xe1\xe5\xe7\xe8\xe9\xeb\xee\xf0\xf2\xf3\xf7\xf8\xfb",
+		"\x00\x02\x03\x06\x08\x0f\x13\x14\x1b\x20\x22\x26\x28\x29\x2d\x2e\x2f\x33\x34\x35\x3f\x42\x44\x45\x46\x4a\x4c\x4e\x52\x55\x5b\x63\x65\x6b\x6d\x6e\x73\x74\x76\x77\x78\x7e\x80\x81\x82\x85\x8a\x8b\x90\x95\x9a\x9b\x9e\x9f\xa1\xa6\xac\xad\xae\xaf\xb2\xb7\xb8\xc1\xc2\xc3\xc4\xcc\xcd\xd2\xda\xdd\xde\xdf\xe0\xe3\xe6\xed\xef\xf6\xf9\xfa",
+		"\x04\x0b\x0e\x10\x11\x16\x18\x19\x1c\x1d\x21\x23\x25\x27\x30\x31\x32\x39\x3c\x3e\x40\x41\x48\x49\x4b\x4d\x57\x59\x5a\x5e\x64\x6a\x6c\x6f\x71\x72\x79\x7a\x7b\x7c\x83\x84\x86\x8e\x93\x97\xa0\xa2\xa3\xa4\xa5\xa7\xa8\xa9\xaa\xab\xb0\xb4\xb5\xb6\xbb\xbd\xc0\xc8\xc9\xca\xce\xd1\xd3\xd5\xd7\xd9\xdb\xe2\xea\xf1\xf4\xf5\xfc\xfd\xfe\xff",
+		"\x06\x07\x09\x0a\x12\x13\x19\x20\x26\x27\x2a\x2c\x2f\x32\x35\x37\x38\x3e\x3f\x42\x45\x4d\x56\x5c\x5d\x60\x65\x66\x68\x6a\x6e\x74\x7c\x7d\x7f\x81\x86\x88\x89\x8c\x8d\x91\x92\x94\x95\x98\x99\x9b\x9d\xa4\xa7\xa8\xaa\xae\xb3\xb5\xb9\xba\xbb\xbd\xbf\xc0\xc3\xc4\xc8\xc9\xca\xcb\xd0\xd5\xd6\xdc\xdf\xe0\xe1\xe4\xec\xee\xf3\xf4\xf7\xfc",
+		"\x04\x05\x0a\x0c\x0f\x15\x16\x17\x18\x1a\x1b\x1c\x1e\x1f\x2b\x2e\x31\x33\x3c\x44\x48\x49\x4b\x4c\x4e\x4f\x50\x54\x58\x5b\x60\x62\x67\x6c\x6d\x73\x75\x77\x78\x79\x7a\x83\x84\x87\x89\x90\x97\x9a\x9c\x9f\xa3\xac\xad\xaf\xb0\xb2\xb9\xbe\xbf\xc1\xc6\xcc\xcd\xcf\xd8\xda\xdb\xe2\xe3\xe4\xe5\xe7\xe8\xe9\xec\xed\xf0\xf1\xf8\xf9\xfa\xff",
+		"\x00\x02\x03\x08\x0b\x0e\x10\x11\x14\x21\x22\x23\x24\x28\x29\x2d\x30\x34\x36\x39\x3a\x3b\x3d\x40\x41\x43\x47\x51\x52\x53\x56\x5a\x5e\x5f\x61\x63\x64\x68\x69\x6f\x70\x71\x72\x76\x7b\x7e\x82\x85\x8a\x8b\x8e\x8f\x93\x96\x9e\xa0\xa1\xa2\xa6\xa9\xab\xb1\xb6\xb8\xbc\xc2\xc5\xc7\xd1\xd2\xd3\xd4\xd7\xd9\xde\xea\xeb\xef\xf5\xf6\xfd\xfe",
+		"\x01\x05\x06\x09\x0d\x11\x12\x15\x16\x18\x1c\x1d\x25\x2a\x2c\x32\x36\x37\x3c\x42\x43\x46\x47\x49\x4a\x4b\x4d\x54\x55\x57\x59\x5b\x61\x62\x63\x65\x6b\x70\x76\x80\x87\x89\x8c\x8f\x94\x98\x99\x9d\xa5\xa9\xac\xae\xb3\xb4\xb7\xb8\xbd\xbf\xc4\xc5\xc8\xca\xcb\xcc\xce\xd0\xd7\xd9\xda\xdb\xdc\xdd\xe2\xe3\xe4\xe6\xe7\xf2\xf3\xf4\xf6\xfb",
+	},
+
+	// Group 71 's'
+	{
+		"\x00\x01\x03\x08\x09\x0b\x0c\x0d\x0f\x12\x1e\x21\x22\x24\x28\x2d\x2f\x31\x38\x3c\x3f\x49\x4a\x4d\x4e\x50\x54\x5c\x5d\x5e\x5f\x60\x61\x62\x63\x6b\x6e\x71\x73\x77\x79\x7f\x81\x83\x85\x86\x87\x8b\x8f\x92\x95\x97\x98\x9c\x9d\x9f\xa1\xa3\xaa\xab\xac\xb0\xb3\xb5\xb6\xbc\xbf\xc1\xc5\xcf\xd0\xd1\xdd\xdf\xe2\xea\xee\xef\xf2\xf9\xfa\xfe",
+		"\x02\x04\x07\x0e\x10\x11\x13\x15\x1a\x25\x26\x2a\x2c\x37\x3a\x41\x43\x45\x46\x48\x4f\x56\x58\x5b\x64\x65\x68\x6a\x6d\x6f\x70\x72\x75\x78\x7a\x7b\x7c\x7d\x7e\x84\x8c\x8d\x93\x94\x96\x9a\xa0\xa2\xa5\xa6\xa7\xa9\xae\xb2\xb7\xbb\xbd\xc4\xc6\xc8\xcc\xce\xd2\xd4\xd5\xd6\xd7\xd9\xdc\xde\xe5\xe6\xe7\xeb\xed\xf1\xf3\xf5\xf7\xf8\xfc\xff",
+		"\x05\x06\x14\x16\x17\x18\x19\x1b\x1c\x1d\x1f\x20\x23\x27\x29\x2b\x2e\x30\x33\x34\x35\x36\x3b\x3d\x3e\x40\x42\x44\x47\x4b\x53\x55\x59\x5a\x66\x67\x69\x6c\x74\x76\x80\x82\x88\x89\x8a\x8e\x90\x91\x9b\x9e\xa4\xa8\xad\xaf\xb1\xb4\xb8\xb9\xba\xbe\xc0\xc2\xc3\xc7\xc9\xca\xcb\xcd\xd3\xd8\xda\xdb\xe0\xe3\xe4\xe8\xec\xf0\xf4\xf6\xfb\xfd",
+		"\x05\x06\x09\x0a\x0d\x12\x15\x19\x1b\x1d\x1f\x23\x24\x27\x2c\x30\x31\x32\x35\x37\x39\x3e\x3f\x43\x48\x4c\x4d\x4f\x51\x52\x53\x56\x57\x5b\x62\x6a\x6c\x6d\x6f\x72\x73\x7d\x80\x82\x87\x88\x8c\x93\x99\x9a\xa3\xab\xb2\xb8\xbd\xbe\xc1\xc4\xc7\xc9\xca\xcb\xd4\xd5\xd7\xda\xdd\xde\xe1\xe3\xe7\xe9\xea\xeb\xed\xee\xf1\xf6\xf8\xfc\xfe\xff",
+		"\x01\x08\x0c\x0f\x11\x16\x18\x1c\x20\x22\x25\x28\x2a\x2d\x2e\x38\x39\x3a\x3c\x42\x45\x46\x49\x4a\x4c\x4e\x50\x51\x57\x58\x5a\x5e\x5f\x61\x63\x65\x68\x6b\x71\x74\x75\x77\x79\x7b\x7c\x81\x83\x8a\x8d\x8e\x8f\x94\x9c\xa0\xa5\xaa\xac\xb1\xb4\xbb\xbf\xc2\xc5\xc6\xc8\xcd\xd2\xd3\xd6\xd8\xdc\xe0\xe1\xe2\xe6\xe8\xe9\xec\xf2\xf4\xf7\xfb",
+		"\x02\x03\x07\x0a\x0b\x0e\x10\x13\x14\x17\x1a\x1e\x21\x26\x29\x2b\x2f\x32\x33\x34\x36\x41\x47\x52\x54\x55\x59\x5c\x5d\x60\x64\x66\x69\x6e\x76\x7a\x7f\x84\x85\x90\x91\x92\x95\x96\x98\x99\x9b\x9d\x9e\x9f\xa1\xa2\xa4\xa6\xa7\xa8\xa9\xad\xae\xaf\xb0\xb5\xb6\xb7\xb9\xba\xc0\xcc\xce\xcf\xd0\xd1\xd9\xdb\xdf\xe4\xe5\xef\xf5\xf9\xfa\xfd",
+		"\x00\x04\x07\x09\x0a\x10\x13\x15\x17\x1c\x1e\x21\x25\x2c\x2e\x2f\x30\x36\x38\x39\x3b\x3d\x40\x41\x44\x46\x49\x4a\x4b\x4f\x55\x57\x5a\x5c\x5f\x67\x6b\x70\x71\x75\x76\x78\x7b\x7d\x7e\x80\x81\x83\x85\x86\x89\x8b\x8c\x8d\x91\x94\x97\x99\x9f\xa6\xb2\xb3\xb6\xb8\xba\xbb\xbc\xc0\xc3\xcb\xcc\xce\xd0\xd5\xd7\xdc\xe4\xe6\xeb\xf0\xf3\xfe",
+	},
+
+	// Group 72 't'
+	{
+		"\x02\x04\x0a\x0c\x0e\x10\x11\x13\x17\x1a\x1c\x26\x2b\x2d\x2f\x35\x36\x38\x39\x3d\x3f\x40\x41\x43\x45\x49\x4c\x4d\x4e\x51\x56\x59\x5a\x5b\x5d\x63\x6d\x6e\x70\x72\x75\x76\x77\x78\x7c\x7e\x82\x84\x85\x87\x8a\x90\x95\x96\x9b\xa3\xa9\xad\xae\xb5\xb6\xba\xbd\xc3\xcb\xcd\xd1\xd6\xd7\xd8\xda\xdb\xe1\xe6\xe7\xe8\xec\xed\xf0\xf5\xfb\xff",
+		"\x00\x01\x03\x07\x15\x19\x1d\x1f\x20\x21\x24\x25\x28\x29\x2a\x2c\x2e\x37\x3a\x3b\x4a\x4b\x53\x54\x55\x57\x5c\x5f\x61\x62\x64\x65\x69\x6a\x6b\x6f\x71\x74\x79\x7f\x81\x88\x89\x8b\x8c\x8d\x92\x93\x97\x98\x9a\xa0\xa1\xa8\xaa\xac\xb8\xb9\xbb\xbf\xc0\xc1\xc2\xc4\xc6\xc7\xc9\xcf\xd0\xd3\xd5\xde\xe3\xe5\xe9\xeb\xf1\xf3\xf8\xfa\xfc\xfd",
+		"\x05\x06\x08\x09\x0b\x0d\x0f\x14\x18\x1b\x1e\x22\x23\x30\x31\x32\x33\x3c\x3e\x42\x44\x46\x47\x48\x4f\x50\x52\x58\x5e\x60\x67\x68\x6c\x73\x7a\x7d\x80\x83\x86\x8e\x8f\x91\x94\x99\x9d\x9e\x9f\xa2\xa4\xa5\xa6\xab\xaf\xb0\xb1\xb2\xb3\xb4\xb7\xbe\xc5\xc8\xca\xcc\xce\xd2\xd4\xd9\xdc\xdd\xdf\xe0\xe2\xe4\xea\xee\xef\xf2\xf4\xf7\xf9\xfe",
+		"\x02\x05\x0a\x0b\x0c\x0d\x0e\x12\x15\x16\x19\x1a\x20\x21\x27\x2b\x2f\x32\x34\x37\x39\x3c\x45\x4d\x4e\x53\x54\x5a\x5b\x5c\x5d\x62\x66\x68\x69\x6c\x6e\x70\x7b\x7c\x87\x8a\x8b\x8e\x8f\x90\x91\x94\x9c\xa4\xa7\xa9\xb4\xb5\xb8\xba\xbb\xbc\xbd\xbe\xbf\xc1\xca\xcc\xd5\xd7\xd8\xdc\xdf\xe0\xe3\xe6\xe7\xea\xec\xee\xef\xf5\xf6\xfa\xfc\xfd",
+		"\x09\x11\x12\x13\x16\x17\x1b\x23\x26\x29\x2c\x2d\x31\x3d\x3e\x42\x46\x49\x4b\x4f\x51\x58\x5e\x5f\x63\x64\x65\x66\x67\x6a\x6d\x76\x77\x78\x79\x7a\x7b\x7d\x7e\x81\x82\x83\x84\x8c\x92\x96\x97\x99\x9c\x9f\xa1\xa3\xa5\xa7\xa8\xac\xad\xae\xaf\xb0\xb2\xb9\xbc\xc0\xc4\xc5\xc7\xcb\xcd\xd0\xd2\xd4\xd9\xdb\xdd\xde\xe2\xe8\xeb\xf0\xf6\xf8",
+		"\x00\x01\x03\x04\x06\x07\x0f\x10\x14\x18\x1c\x1d\x1f\x22\x24\x25\x27\x28\x2a\x30\x33\x34\x35\x3a\x3f\x40\x41\x43\x44\x47\x48\x50\x52\x56\x57\x59\x6f\x72\x73\x75\x7f\x80\x85\x86\x88\x89\x8d\x93\x98\x9a\x9b\x9d\xa0\xa2\xab\xb1\xb3\xb6\xb7\xc2\xc3\xc6\xc9\xce\xcf\xd1\xd3\xd6\xda\xe1\xe4\xe5\xe9\xed\xf1\xf2\xf3\xf4\xf7\xf9\xfe\xff",
+		"\x01\x05\x07\x08\x0e\x0f\x10\x15\x1e\x23\x25\x27\x2b\x2c\x2e\x32\x35\x36\x37\x38\x3b\x3c\x45\x48\x4a\x4c\x4d\x4e\x55\x58\x5b\x5c\x60\x61\x63\x64\x6b\x71\x72\x74\x75\x78\x79\x7b\x85\x86\x8a\x8b\x8e\x90\x94\x95\x96\x9b\x9c\x9e\xa6\xa7\xa8\xaa\xaf\xb3\xb4\xbd\xc3\xc4\xc5\xc6\xc7\xc8\xd0\xdb\xde\xe0\xe2\xee\xf3\xf4\xf7\xfb\xfc\xff",
+	},
+
+	// Group 73 'u'
+	{
+		"\x03\x04\x07\x0b\x0c\x0e\x10\x12\x13\x15\x18\x1a\x1c\x1e\x1f\x23\x25\x28\x2b\x2d\x2e\x34\x36\x39\x40\x43\x46\x4a\x4c\x4e\x50\x53\x58\x59\x5a\x5c\x63\x66\x6d\x6e\x70\x73\x76\x77\x79\x7e\x7f\x84\x85\x88\x8a\x8b\x93\x94\x97\x98\x9d\xa2\xa5\xaa\xac\xb1\xb2\xb4\xb6\xb8\xbd\xbe\xc3\xc9\xcb\xd1\xd3\xdd\xe9\xed\xf5\xf7\xf8\xf9\xfc\xfe",
+		"\x02\x0d\x0f\x11\x14\x19\x22\x24\x29\x2c\x2f\x32\x37\x3a\x3b\x44\x45\x47\x48\x49\x4f\x51\x52\x55\x56\x57\x5b\x5d\x5f\x60\x64\x65\x67\x6a\x6c\x6f\x72\x74\x75\x7b\x83\x86\x8c\x8d\x8f\x96\x99\x9a\x9c\x9e\x9f\xa4\xa9\xad\xaf\xb5\xb7\xb9\xba\xbf\xc0\xc5\xcf\xd0\xd2\xd5\xd6\xd9\xde\xdf\xe1\xe4\xe7\xe8\xec\xee\xef\xf0\xf2\xf3\xf4\xfa",
+		"\x01\x05\x06\x08\x09\x0a\x16\x17\x1b\x1d\x20\x21\x26\x2a\x33\x35\x38\x3c\x3d\x3e\x3f\x41\x42\x4b\x4d\x54\x5e\x61\x62\x68\x69\x6b\x71\x7a\x81\x82\x87\x89\x8e\x90\x91\x92\x95\x9b\xa0\xa1\xa3\xa6\xa7\xa8\xab\xae\xb0\xb3\xbb\xbc\xc1\xc2\xc4\xc6\xc7\xc8\xca\xcc\xcd\xce\xd7\xd8\xda\xdb\xdc\xe0\xe2\xe3\xe5\xe6\xea\xf1\xf6\xfb\xfd\xff",
+		"\x00\x0b\x0d\x10\x11\x13\x16\x17\x1e\x20\x24\x25\x27\x2c\x2d\x2e\x30\x31\x32\x35\x3f\x47\x4b\x4c\x4f\x50\x51\x54\x60\x64\x67\x68\x76\x78\x7a\x7c\x7d\x7e\x80\x82\x86\x89\x8f\x98\x9a\x9d\x9f\xa1\xa7\xa8\xaa\xad\xaf\xb1\xb2\xb4\xb8\xbc\xbd\xbf\xc2\xc5\xc8\xcb\xcd\xd3\xd4\xd5\xd7\xd9\xdc\xe3\xe7\xe8\xeb\xf0\xf4\xf5\xf6\xfb\xfe\xff",
+		"\x00\x05\x07\x08\x09\x0e\x12\x1a\x1c\x22\x26\x27\x28\x2a\x2b\x30\x37\x38\x3b\x48\x49\x4d\x4e\x52\x53\x55\x5a\x5b\x5e\x61\x65\x66\x69\x6b\x6c\x6e\x70\x71\x73\x75\x77\x78\x7b\x7c\x7d\x7f\x81\x83\x84\x88\x90\x92\x94\x95\x96\x97\x9e\xa9\xab\xac\xae\xb3\xb6\xb7\xb9\xbe\xce\xd0\xd1\xd4\xd8\xdb\xde\xe0\xe1\xe5\xeb\xee\xf7\xf8\xfc\xfd",
+		"\x01\x02\x06\x0c\x0f\x18\x19\x1b\x1d\x1f\x23\x2f\x31\x33\x34\x36\x3a\x3c\x3d\x40\x41\x43\x44\x45\x4a\x56\x57\x58\x5c\x5f\x62\x6a\x6d\x6f\x72\x74\x79\x80\x85\x87\x8a\x8c\x8d\x8e\x91\x93\x99\x9b\x9c\xa0\xa2\xa3\xa4\xa5\xa6\xb0\xb5\xbb\xc1\xc3\xc4\xc6\xc7\xc9\xca\xcc\xd2\xd6\xda\xdd\xe2\xe4\xe6\xea\xec\xed\xef\xf1\xf2\xf3\xf9\xfa",
+		"\x03\x04\x05\x07\x0a\x0d\x12\x14\x15\x19\x1d\x1e\x21\x22\x24\x26\x27\x29\x2b\x2c\x39\x3e\x42\x45\x46\x4b\x4c\x59\x5b\x5d\x61\x63\x69\x6a\x6c\x70\x75\x76\x77\x7a\x7f\x83\x87\x88\x8b\x8e\x91\x95\x96\x97\x9a\x9c\x9f\xa1\xa4\xae\xaf\xb1\xb2\xb7\xba\xc0\xc1\xcc\xcf\xd1\xd2\xd4\xd5\xd7\xdf\xe7\xe9\xea\xeb\xed\xee\xf0\xf1\xf6\xfa\xfe",
+	},
+
+	// Group 74 'v'
+	{
+		"\x01\x04\x07\x0a\x0b\x0f\x11\x1e\x21\x2c\x2f\x32\x33\x34\x36\x37\x40\x41\x44\x45\x4c\x4f\x51\x53\x57\x58\x62\x66\x68\x6d\x70\x71\x75\x76\x7a\x7d\x7f\x82\x84\x87\x88\x89\x8b\x8c\x8d\x8f\x90\x98\x99\xa0\xa4\xa7\xa9\xb0\xb2\xb3\xc0\xc1\xc5\xc8\xc9\xca\xcd\xce\xcf\xd4\xd5\xd6\xde\xe2\xe3\xe5\xe6\xea\xeb\xee\xf0\xf1\xf3\xf4\xf7\xf9",
+		"\x05\x06\x0c\x0e\x12\x13\x16\x18\x1a\x1b\x1f\x22\x26\x28\x29\x2b\x30\x31\x38\x3a\x3d\x3f\x42\x46\x47\x49\x4a\x4b\x4e\x56\x5a\x5b\x5c\x5d\x5e\x60\x61\x64\x6a\x73\x74\x77\x78\x79\x7b\x7c\x7e\x80\x81\x85\x8a\x91\x92\x93\x94\x95\x96\x9a\x9b\xa1\xa5\xa6\xaa\xb5\xb9\xbd\xbf\xc2\xc3\xc4\xcb\xd1\xd9\xdd\xdf\xe1\xe7\xe8\xe9\xf8\xfb\xfe",
+		"\x02\x03\x08\x09\x0d\x10\x14\x15\x19\x1c\x1d\x20\x23\x24\x25\x27\x2a\x2d\x2e\x35\x39\x3b\x3c\x3e\x43\x48\x4d\x50\x52\x55\x59\x5f\x63\x65\x67\x69\x6b\x6c\x6e\x6f\x72\x83\x8e\x97\x9c\x9d\x9e\x9f\xa2\xa3\xa8\xab\xac\xad\xae\xaf\xb1\xb4\xb6\xb7\xb8\xba\xbb\xbc\xbe\xc6\xc7\xd0\xd2\xd3\xd7\xda\xdb\xe0\xe4\xec\xed\xf5\xf6\xfc\xfd\xff",
+		"\x00\x02\x06\x07\x12\x16\x17\x18\x1c\x1d\x22\x26\x28\x34\x3a\x3b\x3d\x3f\x43\x47\x4a\x4d\x4f\x50\x53\x54\x55\x5b\x62\x65\x66\x67\x6c\x72\x73\x76\x78\x7b\x7c\x81\x82\x85\x86\x88\x8a\x90\x91\x95\x98\x9f\xa0\xa3\xab\xac\xaf\xb0\xb2\xb3\xb5\xb6\xbe\xc0\xc2\xcc\xce\xcf\xd2\xd4\xd5\xd6\xd8\xdc\xe4\xe6\xe7\xef\xf2\xf5\xf9\xfa\xfb\xfc",
+		"\x00\x01\x05\x0a\x0b\x0e\x0f\x11\x13\x14\x15\x19\x1b\x23\x29\x2a\x2c\x2d\x30\x31\x33\x37\x38\x39\x3c\x40\x44\x45\x46\x4b\x4e\x51\x54\x59\x5e\x60\x64\x69\x6a\x6f\x71\x75\x7e\x83\x86\x89\x8b\x93\x96\x9a\x9d\xa1\xa6\xad\xae\xb7\xb8\xb9\xba\xbd\xbf\xc7\xc8\xca\xcc\xd1\xd3\xd8\xd9\xdc\xdd\xe1\xe3\xe8\xe9\xeb\xec\xee\xf1\xf4\xfa\xfe",
+		"\x03\x04\x08\x09\x0d\x17\x1a\x1e\x1f\x20\x21\x24\x2b\x2e\x32\x35\x36\x3e\x42\x4c\x52\x56\x57\x5a\x5c\x5d\x5f\x68\x6b\x6d\x6e\x70\x74\x79\x7d\x80\x84\x87\x8c\x8d\x8e\x8f\x94\x97\x99\x9b\x9c\x9e\xa2\xa4\xa5\xa9\xaa\xb1\xbb\xc1\xc3\xc4\xc5\xc6\xc9\xcb\xcd\xd0\xd7\xda\xdb\xde\xdf\xe0\xe2\xe5\xea\xed\xef\xf0\xf2\xf6\xf7\xf8\xfd\xff",
+		"\x01\x02\x07\x0c\x0d\x0e\x10\x12\x13\x14\x1c\x21\x24\x25\x27\x29\x2d\x2f\x31\x35\x38\x3d\x3e\x41\x45\x48\x49\x4b\x54\x56\x57\x58\x5b\x5d\x61\x62\x63\x66\x69\x6b\x6c\x75\x76\x77\x78\x7a\x7c\x7e\x7f\x82\x83\x85\x86\x8e\x8f\x91\x92\x93\x99\x9b\xa1\xa7\xa8\xac\xae\xb4\xb5\xb9\xba\xbc\xc2\xc8\xcd\xd5\xd6\xe2\xec\xef\xf2\xf3\xfa\xfb",
+	},
+
+	// Group 75 'w'
+	{
+		"\x00\x01\x02\x08\x0a\x16\x17\x1b\x1e\x21\x28\x2a\x2f\x33\x34\x36\x37\x39\x3c\x3f\x41\x43\x44\x48\x4b\x4e\x55\x57\x64\x65\x68\x6e\x70\x71\x74\x77\x7f\x84\x86\x8a\x91\x95\x97\x9b\x9f\xa2\xa4\xa5\xa7\xa8\xab\xac\xae\xb0\xb4\xb8\xb9\xbd\xbe\xc0\xc4\xc5\xc7\xca\xd1\xd4\xd7\xd9\xda\xdb\xdf\xe3\xe4\xe7\xed\xef\xf1\xf2\xf3\xf5\xf6\xfb",
+		"\x03\x09\x0b\x0c\x0e\x11\x14\x18\x19\x1d\x22\x23\x24\x25\x27\x2c\x2e\x30\x31\x32\x38\x3d\x3e\x40\x42\x45\x49\x4a\x4d\x4f\x51\x52\x56\x58\x5a\x5c\x5e\x63\x66\x6b\x6d\x6f\x72\x78\x79\x81\x83\x87\x8b\x8c\x8e\x90\x92\x94\x96\x9c\x9e\xa3\xa9\xb2\xb3\xbb\xbf\xc3\xcd\xcf\xd2\xd3\xdc\xe0\xe1\xe2\xe6\xe8\xe9\xea\xec\xee\xf7\xf8\xfa\xfe",
+		"\x04\x05\x06\x07\x0d\x0f\x10\x12\x13\x15\x1a\x1c\x1f\x20\x26\x29\x2b\x3a\x3b\x46\x47\x4c\x50\x53\x54\x59\x5b\x5d\x5f\x60\x62\x67\x69\x6a\x6c\x73\x75\x76\x7a\x7b\x7c\x7e\x80\x82\x88\x89\x8d\x8f\x93\x98\x99\x9a\x9d\xa1\xa6\xaf\xb1\xb5\xb6\xb7\xba\xc1\xc2\xc6\xc8\xc9\xcb\xcc\xce\xd0\xd6\xd8\xdd\xde\xe5\xeb\xf0\xf4\xf9\xfc\xfd\xff",
+		"\x02\x03\x04\x05\x0b\x0d\x0e\x0f\x10\x16\x17\x1d\x25\x28\x2d\x30\x35\x3e\x3f\x41\x44\x48\x4b\x4c\x4e\x55\x59\x5e\x61\x64\x65\x6a\x6b\x70\x75\x76\x7b\x7d\x82\x85\x86\x8a\x8e\x92\x99\xa0\xa1\xa2\xa5\xa9\xaa\xac\xad\xaf\xb0\xb1\xb2\xb3\xb4\xb5\xb6\xb7\xba\xbc\xc0\xc3\xc8\xc9\xcb\xcd\xd0\xd5\xdb\xdd\xdf\xe0\xe3\xe4\xea\xee\xf2\xf3",
+		"\x01\x06\x08\x0c\x11\x13\x14\x18\x1a\x1c\x1e\x20\x21\x22\x23\x2c\x2d\x33\x34\x35\x3c\x43\x46\x4a\x4d\x4f\x52\x54\x56\x5a\x5b\x5d\x61\x6d\x6e\x6f\x73\x77\x78\x7a\x7f\x83\x84\x85\x89\x8c\x8f\x90\x91\x93\x94\x95\x97\x98\x9b\xa0\xaa\xae\xb8\xbb\xbc\xbe\xc1\xc4\xc5\xc7\xca\xcc\xd3\xd4\xd6\xd8\xd9\xda\xdc\xde\xe1\xec\xed\xf8\xfd\xfe",
+		"\x00\x07\x09\x0a\x15\x19\x1f\x24\x27\x29\x2a\x2e\x2f\x32\x36\x37\x38\x39\x3a\x3b\x3d\x40\x42\x45\x47\x49\x50\x51\x53\x57\x58\x5f\x60\x63\x69\x6c\x71\x72\x74\x79\x7c\x7d\x7e\x88\x8b\x8d\x96\x9c\x9d\x9e\x9f\xa4\xa6\xa7\xab\xad\xb9\xbd\xbf\xc2\xc6\xce\xcf\xd1\xd2\xd5\xd7\xe5\xe6\xe8\xe9\xeb\xf0\xf1\xf4\xf5\xf6\xf7\xf9\xfa\xfb\xff",
+		"\x04\x08\x0d\x0e\x12\x13\x1a\x1b\x1c\x1f\x21\x22\x26\x2b\x2c\x2e\x31\x33\x34\x37\x38\x3f\x40\x45\x48\x49\x51\x55\x59\x5c\x5d\x5f\x60\x62\x66\x67\x68\x69\x6a\x73\x76\x77\x7a\x7c\x7f\x80\x81\x82\x83\x87\x8a\x8f\x90\x94\x98\x9a\x9e\xa2\xa3\xa8\xac\xb1\xb3\xbb\xbe\xc0\xc5\xc8\xc9\xcf\xd2\xe2\xe7\xef\xf2\xf4\xf5\xf9\xfa\xfb\xfc\xfd",
+	},
+
+	// Group 76 'x'
+	{
+		"\x09\x0b\x0c\x0d\x14\x17\x1a\x1b\x1c\x1f\x22\x25\x27\x30\x31\x32\x33\x36\x38\x3a\x3b\x3e\x41\x44\x45\x4d\x51\x53\x57\x58\x59\x5b\x63\x64\x65\x67\x6e\x72\x74\x75\x78\x7e\x80\x84\x89\x8f\x91\x95\x96\x9d\x9e\xa0\xa1\xa4\xa5\xa8\xac\xb0\xb1\xb8\xba\xbc\xc4\xc7\xc8\xc9\xcb\xce\xcf\xd9\xdf\xe1\xe4\xe5\xe8\xea\xef\xf0\xf3\xf4\xf9\xfd",
+		"\x01\x04\x08\x0e\x11\x13\x18\x19\x20\x21\x26\x29\x2a\x3c\x3f\x40\x42\x48\x49\x4c\x4e\x55\x56\x5a\x60\x61\x68\x69\x6c\x6d\x6f\x76\x77\x7c\x7d\x7f\x81\x82\x88\x8c\x8d\x92\x98\xa3\xa7\xa9\xaa\xab\xad\xae\xaf\xb2\xb7\xbb\xbe\xbf\xc0\xc2\xc5\xc6\xcc\xd2\xd3\xd4\xd5\xd6\xd7\xdb\xdc\xe0\xe2\xe3\xe6\xeb\xed\xee\xf1\xf5\xf7\xf8\xfc\xfe",
+		"\x00\x02\x03\x05\x06\x07\x0a\x0f\x10\x12\x15\x16\x1d\x1e\x23\x24\x2b\x2c\x2d\x2e\x2f\x34\x35\x37\x39\x3d\x43\x46\x47\x4a\x4b\x4f\x50\x52\x54\x5d\x5f\x62\x66\x6a\x6b\x70\x71\x73\x79\x7a\x7b\x83\x85\x86\x8a\x8e\x90\x93\x97\x99\x9a\x9b\x9c\x9f\xa2\xa6\xb3\xb4\xb5\xb6\xb9\xbd\xc1\xc3\xca\xcd\xd0\xd1\xda\xe7\xe9\xec\xf2\xf6\xfa\xfb",
+		"\x02\x06\x08\x0b\x0d\x0f\x16\x1a\x21\x24\x25\x28\x29\x2c\x2d\x2f\x32\x34\x36\x39\x3c\x47\x49\x4a\x54\x56\x58\x5c\x5e\x5f\x61\x63\x65\x66\x67\x6e\x6f\x70\x73\x74\x75\x76\x77\x79\x83\x87\x8a\x8b\x8d\x93\x94\x95\x98\x9a\x9c\xa2\xa4\xa5\xa6\xa7\xad\xb1\xc5\xca\xcc\xd6\xd7\xd8\xdd\xde\xdf\xe2\xe3\xe5\xe6\xea\xeb\xf1\xf3\xf5\xfb\xff",
+		"\x05\x07\x0a\x10\x11\x12\x14\x15\x18\x1b\x1c\x1d\x1e\x1f\x20\x22\x23\x2a\x2e\x3e\x40\x41\x42\x44\x45\x46\x48\x4b\x4e\x4f\x52\x53\x5c\x5e\x64\x68\x69\x6c\x7c\x7d\x7f\x84\x85\x87\x8b\x8e\x96\x99\x9d\xac\xb4\xb8\xb9\xbb\xbc\xc0\xc1\xc3\xc7\xc8\xc9\xcb\xcd\xd0\xd1\xd3\xd5\xd8\xd9\xdc\xdd\xe0\xe1\xe8\xe9\xef\xf8\xf9\xfa\xfd\xfe\xff",
+		"\x00\x01\x03\x04\x09\x0c\x0e\x13\x19\x26\x27\x2b\x31\x33\x35\x37\x38\x3a\x3b\x3d\x3f\x4c\x4d\x50\x51\x55\x57\x59\x5a\x5b\x5d\x60\x6a\x6b\x6d\x71\x72\x7a\x7b\x7e\x80\x81\x89\x8c\x8f\x91\x94\x97\x9f\xa0\xa1\xa3\xa8\xa9\xaa\xab\xae\xaf\xb0\xb3\xb5\xb6\xb7\xba\xbd\xbe\xbf\xc2\xc6\xce\xd4\xda\xdb\xde\xe4\xe7\xec\xed\xee\xf0\xf7\xfc",
+		"\x04\x09\x0c\x11\x13\x17\x19\x1a\x23\x28\x2f\x30\x32\x33\x35\x38\x3b\x42\x43\x45\x49\x4d\x4e\x4f\x50\x57\x5c\x5f\x62\x63\x64\x6c\x75\x77\x78\x7a\x82\x83\x85\x86\x88\x89\x8d\x8e\x90\x92\x9a\x9b\x9d\x9e\xa1\xa2\xa3\xa6\xaa\xab\xb2\xb3\xb4\xb8\xbc\xc1\xc4\xc5\xcc\xcd\xce\xcf\xd2\xd9\xe1\xe2\xe6\xe7\xf2\xf4\xf5\xf6\xf9\xfc\xfe\xff",
+	},
+
+	// Group 77 'y'
+	{
+		"\x05\x07\x0f\x17\x1a\x24\x2b\x2c\x2d\x2f\x30\x38\x3b\x3c\x41\x42\x45\x4a\x4b\x4c\x50\x54\x57\x58\x5a\x5b\x5c\x5f\x68\x6b\x6c\x6e\x6f\x72\x77\x78\x7b\x7c\x7d\x82\x83\x8b\x8e\x93\x97\x98\x9a\x9b\x9d\xa3\xaa\xab\xad\xb0\xb5\xb6\xb8\xb9\xba\xbd\xbf\xc2\xc4\xc7\xca\xcc\xcd\xce\xd1\xd2\xd5\xdc\xdf\xe1\xe2\xe4\xe8\xf1\xf6\xf7\xfa\xfb",
+		"\x00\x01\x04\x06\x09\x0d\x10\x11\x13\x14\x18\x1b\x1c\x1e\x1f\x23\x25\x31\x32\x33\x34\x35\x36\x3a\x3e\x3f\x40\x48\x49\x52\x53\x59\x5d\x60\x62\x66\x67\x6d\x70\x73\x74\x79\x7a\x7e\x81\x84\x85\x86\x87\x8a\x8c\x8f\x90\x92\x95\x9c\x9e\xa4\xa5\xac\xae\xb3\xb4\xb7\xc5\xc8\xcb\xd0\xd3\xd4\xd8\xdb\xdd\xe5\xe7\xef\xf2\xf3\xf8\xf9\xfc\xfe",
+		"\x02\x03\x08\x0a\x0b\x0c\x0e\x15\x16\x19\x1d\x20\x21\x22\x26\x27\x28\x29\x2e\x37\x39\x3d\x43\x44\x46\x47\x4e\x4f\x51\x55\x56\x5e\x61\x64\x69\x6a\x71\x75\x76\x7f\x80\x88\x89\x8d\x91\x94\x99\x9f\xa0\xa1\xa2\xa7\xa8\xa9\xaf\xb1\xbb\xbc\xbe\xc0\xc1\xc3\xc6\xcf\xd6\xd7\xd9\xde\xe0\xe3\xe6\xe9\xea\xeb\xec\xed\xee\xf0\xf4\xf5\xfd\xff",
+		"\x02\x05\x06\x07\x11\x12\x14\x15\x18\x1a\x1d\x22\x23\x29\x2a\x2c\x2e\x2f\x34\x39\x3d\x40\x41\x45\x49\x4d\x52\x56\x59\x5d\x5e\x62\x63\x65\x67\x6b\x6c\x6e\x71\x73\x7d\x80\x83\x8d\x8f\x90\x91\x94\x96\x98\x9a\x9d\x9e\xa1\xa6\xaa\xaf\xb2\xb4\xb9\xbb\xbf\xc5\xc6\xc9\xca\xcc\xd2\xd3\xd4\xda\xdb\xdd\xde\xe0\xe1\xe5\xe7\xec\xf7\xfc\xff",
+		"\x08\x09\x0c\x0d\x0f\x12\x16\x21\x26\x27\x2b\x2d\x30\x31\x35\x3a\x3b\x3e\x3f\x42\x44\x47\x4a\x4b\x4d\x4e\x4f\x50\x55\x57\x60\x61\x68\x69\x70\x75\x77\x78\x79\x7a\x7b\x7c\x7e\x7f\x82\x85\x86\x89\x8c\x8e\x9c\xa2\xa6\xa7\xa8\xad\xae\xb1\xb2\xb3\xb5\xb7\xb8\xba\xbd\xc0\xc2\xc4\xc9\xcb\xcd\xd5\xd6\xda\xdf\xe4\xe6\xe8\xf2\xf4\xf5\xf9",
+		"\x00\x03\x04\x0e\x10\x13\x19\x1b\x1c\x1e\x1f\x20\x24\x25\x28\x2a\x32\x36\x38\x3c\x43\x46\x48\x4c\x58\x5a\x5c\x63\x64\x65\x66\x6a\x6d\x6f\x74\x76\x84\x88\x8a\x92\x95\x96\x97\x99\x9b\x9f\xa0\xa3\xa4\xa5\xab\xb0\xb6\xbc\xbe\xc1\xc3\xc7\xc8\xce\xcf\xd0\xd1\xd8\xd9\xdc\xe2\xe3\xe9\xea\xeb\xed\xee\xf0\xf1\xf3\xf6\xf8\xfa\xfb\xfd\xfe",
+		"\x01\x03\x0a\x0b\x0c\x0d\x14\x15\x17\x18\x1b\x1d\x28\x2a\x2f\x30\x33\x35\x37\x38\x3c\x49\x4a\x4f\x50\x51\x52\x53\x54\x59\x5b\x5c\x5f\x61\x69\x6f\x72\x75\x76\x7b\x81\x84\x85\x87\x8b\x8d\x91\x92\x93\x95\x98\x99\x9d\xa1\xa9\xac\xaf\xb1\xb5\xbd\xc2\xc4\xc5\xc7\xcb\xd0\xd1\xd7\xd9\xde\xe1\xe3\xe6\xe9\xeb\xec\xef\xf0\xf4\xfb\xfc\xfd",
+	},
+
+	// Group 78 'z'
+	{
+		"\x00\x03\x04\x06\x07\x0e\x0f\x10\x17\x1b\x1c\x21\x22\x23\x24\x25\x26\x27\x28\x2a\x30\x31\x36\x3f\x43\x44\x49\x4a\x4b\x57\x5b\x5c\x5d\x5e\x62\x63\x66\x6c\x6d\x6e\x6f\x70\x72\x74\x75\x79\x7a\x7c\x7e\x83\x87\x8e\x91\x95\x99\x9e\xa8\xa9\xaa\xae\xb1\xb3\xb6\xb7\xb8\xb9\xc3\xc9\xd3\xd4\xd5\xdf\xe3\xe4\xe7\xe8\xf0\xf4\xf6\xf7\xf8\xf9",
+		"\x02\x09\x0a\x0b\x0c\x12\x15\x18\x1d\x1f\x2b\x2c\x2d\x2e\x2f\x32\x34\x35\x39\x3d\x41\x46\x47\x4e\x53\x55\x56\x5a\x5f\x60\x65\x68\x6a\x71\x73\x78\x7b\x7d\x7f\x81\x82\x88\x89\x8b\x90\x93\x98\x9a\x9c\x9d\x9f\xa2\xab\xad\xb4\xbb\xbc\xbe\xbf\xc6\xc7\xc8\xcb\xcc\xce\xd0\xd1\xd6\xdb\xde\xe0\xe5\xe6\xe9\xed\xf1\xf3\xf5\xfb\xfc\xfd\xfe",
+		"\x01\x05\x08\x0d\x11\x13\x14\x16\x19\x1a\x1e\x20\x29\x33\x37\x38\x3c\x3e\x40\x42\x48\x4c\x4d\x4f\x51\x52\x54\x59\x61\x64\x67\x69\x6b\x76\x77\x84\x85\x86\x8a\x8c\x8d\x8f\x92\x96\x97\x9b\xa1\xa3\xa4\xa5\xa6\xa7\xac\xaf\xb0\xb2\xb5\xba\xbd\xc0\xc1\xc2\xc4\xc5\xca\xcf\xd2\xd7\xd8\xd9\xda\xdc\xdd\xe2\xea\xeb\xec\xee\xef\xf2\xfa\xff",
+		"\x01\x02\x05\x06\x07\x09\x0d\x0f\x11\x14\x17\x19\x1b\x1e\x20\x21\x25\x28\x31\x32\x33\x36\x37\x39\x3a\x3b\x3e\x44\x45\x47\x4c\x4d\x50\x58\x5c\x62\x65\x6d\x70\x73\x74\x77\x7d\x80\x84\x85\x87\x88\x8b\x8e\x94\x98\x9a\x9c\xa0\xa7\xa8\xa9\xaa\xac\xae\xb3\xb8\xbb\xbc\xbd\xbf\xc0\xc2\xc3\xc5\xcd\xd4\xdf\xe1\xe3\xe9\xea\xee\xf7\xfa\xfd",
+		"\x00\x0b\x15\x18\x1a\x1d\x1f\x26\x29\x2a\x2b\x2c\x2d\x34\x35\x38\x3a\x3b\x40\x41\x42\x45\x46\x48\x4b\x4f\x50\x51\x55\x58\x5a\x5b\x5f\x64\x67\x6b\x6c\x6f\x75\x76\x78\x7e\x7f\x80\x83\x86\x89\x8d\x90\x92\x95\x96\x9d\x9f\xa0\xa5\xab\xaf\xb0\xb1\xb9\xba\xbe\xc4\xc9\xcd\xd0\xd5\xd8\xd9\xdd\xde\xe0\xe2\xe6\xe7\xe8\xf0\xf3\xf4\xf5\xfb",
+		"\x03\x04\x08\x0c\x0e\x10\x12\x13\x16\x1c\x22\x23\x24\x27\x2e\x2f\x3c\x3d\x43\x49\x4e\x52\x53\x56\x57\x59\x5d\x5e\x60\x61\x63\x66\x68\x69\x6a\x6e\x71\x72\x7a\x7b\x81\x82\x8a\x8c\x8f\x91\x93\x94\x97\x99\x9b\x9e\xa1\xa2\xa3\xa4\xb2\xb4\xb5\xc1\xc8\xca\xcb\xce\xcf\xd1\xd2\xd3\xd6\xd7\xda\xdb\xdc\xe1\xec\xef\xf2\xf6\xf8\xfc\xfe\xff",
+		"\x01\x06\x09\x0a\x0e\x10\x11\x12\x14\x18\x19\x1a\x1d\x27\x2a\x30\x33\x36\x3d\x3f\x41\x43\x48\x49\x4a\x4c\x4e\x54\x5a\x5b\x64\x6a\x72\x74\x78\x79\x7a\x7c\x7d\x7f\x8b\x8c\x91\x96\x97\xa0\xa4\xa6\xa8\xad\xb0\xb5\xb6\xb7\xb9\xbf\xc1\xc2\xc3\xc5\xc6\xc7\xcb\xcc\xce\xd1\xd4\xd6\xd7\xd8\xe1\xe4\xe5\xe6\xe9\xeb\xed\xf1\xf5\xf6\xf9\xfd",
+	},
+
+	// Group 79 '{'
+	{
+		"\x02\x03\x08\x0d\x10\x1a\x20\x21\x24\x28\x29\x2a\x2f\x30\x38\x3e\x3f\x40\x41\x43\x46\x48\x49\x4a\x4b\x4f\x56\x57\x58\x5b\x5c\x5f\x60\x62\x65\x66\x67\x6f\x70\x71\x74\x76\x7c\x7d\x80\x82\x83\x86\x88\x8e\x90\x96\x99\xa3\xa6\xa7\xa9\xac\xaf\xb4\xb9\xbd\xc5\xc7\xcd\xcf\xd0\xd5\xd6\xd7\xda\xdb\xde\xe1\xe5\xed\xef\xf1\xf2\xf3\xfc\xfd",
+		"\x00\x01\x06\x07\x09\x0a\x0b\x0e\x11\x13\x19\x1b\x23\x26\x2d\x2e\x31\x35\x36\x3a\x3b\x3c\x47\x4c\x4e\x51\x55\x5a\x5d\x64\x68\x69\x6b\x6d\x73\x78\x79\x7e\x7f\x84\x87\x8b\x8c\x91\x92\x94\x95\x97\x98\x9d\x9e\xa1\xa5\xaa\xb0\xb1\xb6\xb7\xba\xbb\xc2\xc4\xc6\xc8\xc9\xca\xcb\xcc\xce\xd2\xd3\xd4\xd8\xd9\xdc\xe0\xe6\xec\xf6\xfa\xfb\xfe",
+		"\x04\x05\x0f\x12\x14\x15\x16\x17\x18\x1c\x1d\x1e\x1f\x22\x25\x27\x2b\x2c\x32\x34\x37\x39\x3d\x44\x50\x52\x53\x54\x59\x5e\x61\x6a\x6c\x6e\x72\x75\x77\x7a\x7b\x81\x85\x8a\x8d\x8f\x93\x9a\x9b\x9c\x9f\xa0\xa2\xa4\xad\xae\xb2\xb3\xb5\xb8\xbc\xbe\xbf\xc0\xc1\xc3\xd1\xdd\xdf\xe2\xe3\xe4\xe7\xe8\xea\xeb\xee\xf0\xf4\xf5\xf7\xf8\xf9\xff",
+		"\x03\x0c\x10\x16\x1c\x20\x27\x2a\x33\x3a\x3d\x3e\x40\x42\x43\x44\x45\x46\x48\x4a\x4d\x50\x52\x59\x5d\x61\x63\x64\x67\x69\x6a\x6e\x6f\x74\x76\x7f\x80\x82\x84\x87\x88\x89\x8a\x92\x98\x99\xa4\xa8\xaa\xab\xae\xaf\xb0\xb5\xb6\xb8\xba\xbc\xbd\xc0\xc2\xc3\xc7\xc8\xc9\xcc\xcf\xd2\xd6\xd9\xda\xdb\xdd\xdf\xe2\xe4\xe5\xe8\xe9\xf3\xf7\xfa",
+		"\x00\x01\x02\x09\x0a\x0c\x0e\x0f\x11\x12\x13\x1b\x1f\x21\x22\x28\x2b\x2d\x2f\x30\x32\x33\x35\x36\x37\x3b\x41\x45\x4b\x54\x56\x5c\x60\x62\x63\x6b\x73\x78\x7a\x7e\x81\x83\x85\x86\x89\x8c\x8f\x90\x91\x94\x95\x9d\xa0\xa2\xa8\xab\xac\xb1\xb3\xb9\xbb\xbe\xce\xd0\xd5\xd8\xe1\xe9\xea\xec\xef\xf1\xf2\xf4\xf5\xf6\xf8\xfb\xfc\xfd\xfe\xff",
+		"\x04\x07\x0d\x14\x17\x19\x1a\x1d\x24\x26\x29\x2c\x2e\x31\x38\x3c\x3f\x42\x47\x49\x4c\x4d\x4e\x4f\x51\x53\x55\x58\x5a\x5b\x5e\x5f\x65\x66\x68\x6c\x6d\x70\x71\x72\x75\x77\x79\x7b\x7c\x7d\x8b\x8d\x93\x97\x9a\x9b\x9e\x9f\xa1\xa3\xa6\xad\xb2\xb4\xb7\xbf\xc1\xc4\xc5\xc6\xca\xcb\xd1\xd3\xd4\xd7\xdc\xde\xe0\xe3\xe6\xe7\xeb\xed\xee\xf9",
+		"\x02\x03\x05\x06\x07\x08\x0b\x0f\x11\x13\x14\x15\x16\x17\x18\x1b\x1e\x1f\x22\x23\x24\x25\x26\x27\x28\x30\x33\x34\x39\x3a\x3b\x4d\x50\x52\x55\x57\x58\x5b\x5e\x64\x65\x68\x6e\x6f\x72\x74\x75\x7a\x80\x81\x85\x87\x89\x8c\x8e\x96\x98\x9c\x9e\xa5\xa6\xa7\xa9\xaa\xb3\xb7\xba\xc2\xc4\xc6\xc7\xcd\xd2\xde\xdf\xe4\xe6\xe7\xee\xf0\xf2\xf6",
+	},
+
+	// Group 80 '|'
+	{
+		"\x03\x05\x0c\x10\x11\x14\x15\x16\x17\x1e\x1f\x25\x26\x29\x2e\x30\x39\x42\x4b\x4e\x4f\x53\x54\x59\x5a\x5d\x5e\x60\x64\x6a\x6b\x6e\x73\x78\x7c\x7d\x81\x84\x86\x88\x8d\x8e\x8f\x90\x95\x98\x9a\x9c\xa1\xa2\xa3\xa5\xa6\xa8\xae\xb6\xbb\xbc\xbf\xc1\xc6\xc7\xc9\xcb\xd0\xd1\xd5\xd7\xd9\xdc\xdf\xe5\xe7\xea\xef\xf1\xf2\xf4\xf5\xf8\xfa\xfd",
+		"\x00\x04\x06\x0d\x0f\x18\x1a\x20\x24\x27\x2a\x2b\x2c\x2d\x33\x37\x38\x3a\x3d\x3f\x46\x47\x49\x4c\x57\x58\x5b\x5f\x61\x63\x67\x68\x6c\x6d\x6f\x70\x71\x72\x74\x76\x79\x7b\x7e\x82\x83\x85\x87\x89\x8b\x8c\x92\x94\x96\x99\x9e\xa0\xaf\xb2\xb3\xb4\xb7\xb8\xba\xbd\xc2\xcd\xce\xd4\xdb\xdd\xde\xe1\xe2\xe4\xe8\xe9\xeb\xec\xed\xee\xf7\xff",
+		"\x01\x08\x09\x0a\x0b\x0e\x12\x13\x19\x1b\x1c\x1d\x21\x22\x23\x28\x2f\x34\x35\x36\x3b\x3c\x3e\x40\x41\x43\x44\x45\x48\x4a\x4d\x50\x51\x52\x55\x56\x5c\x62\x65\x66\x75\x77\x7a\x7f\x80\x8a\x91\x93\x97\x9b\x9d\x9f\xa4\xa9\xaa\xab\xac\xb0\xb1\xb5\xb9\xbe\xc0\xc3\xc4\xc5\xc8\xca\xd2\xd3\xd6\xd8\xda\xe0\xe3\xe6\xf3\xf6\xf9\xfb\xfc\xfe",
+		"\x00\x01\x02\x03\x04\x06\x07\x09\x0a\x0b\x12\x15\x19\x1a\x21\x22\x24\x25\x2c\x2e\x30\x31\x32\x35\x38\x3c\x42\x49\x4f\x52\x59\x5b\x63\x67\x68\x69\x6b\x6d\x72\x75\x7f\x83\x84\x8a\x8c\x8e\x90\x91\x92\x97\x9a\x9b\x9c\x9d\xa1\xa5\xa6\xa7\xa8\xad\xb2\xb9\xbc\xc0\xc3\xc5\xc8\xcb\xcc\xcf\xd0\xd3\xd7\xec\xef\xf0\xf1\xf4\xf6\xf7\xf8\xfa",
+		"\x05\x08\x11\x17\x1b\x1e\x23\x28\x29\x2a\x2b\x2d\x31\x34\x36\x37\x39\x3b\x41\x45\x46\x47\x56\x57\x58\x5c\x60\x61\x65\x66\x69\x6e\x70\x77\x78\x7d\x7e\x80\x81\x89\x8b\x8d\x8f\x93\x95\x96\x99\x9e\xa2\xa3\xa4\xaa\xad\xaf\xb0\xb1\xb3\xb6\xb7\xb8\xba\xbb\xc1\xc6\xc7\xc9\xcd\xcf\xd6\xd8\xda\xdd\xde\xe0\xe3\xe7\xea\xed\xf5\xfb\xfc\xff",
+		"\x02\x07\x0d\x0e\x10\x13\x14\x16\x1c\x1d\x20\x26\x27\x2f\x32\x33\x3a\x3e\x3f\x40\x43\x44\x48\x4a\x4b\x4c\x4d\x50\x51\x53\x55\x5a\x5d\x5e\x62\x64\x6a\x6c\x6f\x71\x73\x74\x76\x79\x7b\x85\x87\x88\x98\xa0\xa7\xa9\xac\xae\xb4\xb5\xbd\xbe\xbf\xc2\xca\xce\xd1\xd2\xd4\xd5\xd9\xdb\xdc\xdf\xe1\xe2\xe4\xe8\xeb\xee\xf0\xf2\xf3\xf9\xfd\xfe",
+		"\x07\x0c\x0e\x0f\x11\x15\x18\x19\x1b\x1f\x20\x21\x26\x2a\x2b\x30\x33\x3b\x3d\x3f\x43\x46\x47\x49\x4c\x4e\x4f\x53\x54\x55\x56\x57\x59\x5b\x5c\x5f\x61\x62\x63\x6c\x6d\x6e\x6f\x77\x78\x7a\x7c\x7e\x80\x82\x85\x86\x93\x94\x96\x98\x9f\xa0\xa2\xab\xb0\xb3\xb4\xbc\xbd\xc4\xc5\xc6\xc9\xcc\xce\xd0\xda\xdb\xe0\xe3\xe5\xe6\xe7\xe9\xfa\xff",
+	},
+
+	// Group 81 '}'
+	{
+		"\x02\x03\x05\x09\x0a\x10\x14\x15\x18\x1b\x1f\x2a\x2b\x2d\x30\x31\x33\x36\x3a\x46\x4b\x4d\x54\x55\x59\x5c\x5d\x5f\x60\x61\x63\x68\x6a\x70\x77\x78\x7b\x7f\x80\x85\x8b\x8e\x91\x94\x97\x9a\x9c\x9d\xa1\xa3\xa5\xa8\xa9\xac\xb0\xb5\xb6\xb9\xba\xbf\xc4\xc5\xc7\xc8\xca\xcb\xce\xd0\xd2\xd4\xdb\xdd\xdf\xe1\xe4\xe6\xe8\xea\xeb\xf1\xf9\xfa",
+		"\x04\x07\x0b\x0e\x0f\x12\x13\x16\x1a\x1c\x1d\x1e\x22\x24\x27\x2c\x2f\x34\x38\x39\x3b\x3c\x41\x42\x44\x45\x49\x4a\x4e\x4f\x52\x56\x57\x5b\x5e\x62\x65\x67\x69\x6f\x72\x73\x74\x75\x76\x7a\x82\x83\x84\x86\x88\x89\x93\x96\x99\x9f\xa0\xa2\xb1\xb3\xb7\xb8\xbc\xc0\xc1\xc3\xc9\xd3\xd7\xd9\xe0\xe7\xe9\xed\xee\xef\xf0\xf4\xf5\xf8\xfe\xff",
+		"\x00\x01\x06\x08\x0c\x0d\x11\x17\x20\x21\x23\x26\x28\x29\x2e\x32\x35\x37\x3d\x3e\x3f\x40\x43\x48\x50\x51\x53\x58\x5a\x64\x66\x6b\x6c\x6d\x6e\x71\x79\x7c\x7d\x7e\x81\x87\x8a\x8c\x8d\x8f\x90\x92\x95\x98\x9b\x9e\xa4\xa6\xaa\xab\xad\xae\xaf\xb2\xb4\xbb\xc2\xc6\xcd\xcf\xd1\xd5\xd6\xd8\xda\xdc\xde\xe3\xe5\xec\xf2\xf3\xf6\xf7\xfb\xfc",
+		"\x04\x09\x0d\x10\x11\x17\x19\x1c\x1d\x20\x22\x25\x26\x29\x33\x34\x38\x3d\x44\x47\x48\x4b\x4c\x50\x52\x54\x55\x59\x5a\x5d\x63\x64\x66\x67\x73\x7b\x7e\x7f\x80\x81\x83\x84\x86\x88\x8c\x8f\x90\x91\x93\x98\x9a\x9b\xa0\xa1\xa2\xa4\xa7\xa8\xad\xb0\xb8\xbd\xbe\xbf\xc2\xc5\xcc\xcf\xd0\xd4\xd9\xe2\xe5\xe8\xe9\xeb\xec\xef\xf3\xf5\xf6\xfd",
+		"\x00\x03\x06\x08\x0b\x0c\x0e\x12\x18\x19\x1b\x1f\x21\x23\x24\x25\x28\x2a\x2b\x2c\x2e\x2f\x32\x3b\x41\x47\x49\x4a\x4d\x4e\x4f\x51\x56\x57\x5b\x5e\x62\x68\x69\x6b\x70\x74\x75\x77\x8a\x8d\x95\x96\x97\x9c\x9d\xa5\xa6\xac\xaf\xb1\xb2\xb3\xb4\xb5\xbb\xbc\xbd\xbe\xc0\xc3\xc4\xc7\xc8\xcc\xce\xd6\xd7\xe2\xe3\xe7\xf4\xf7\xf8\xfb\xfc\xfd",
+		"\x01\x02\x07\x0f\x13\x14\x15\x16\x1a\x1e\x27\x2d\x30\x31\x35\x37\x39\x3a\x40\x42\x43\x45\x46\x53\x58\x5c\x5f\x60\x61\x65\x6a\x6c\x6d\x6e\x71\x72\x76\x78\x79\x7a\x7d\x82\x85\x87\x89\x8b\x8e\x92\x94\x99\x9e\xa3\xa7\xa9\xaa\xab\xae\xb6\xb9\xba\xc6\xc9\xca\xcb\xcd\xd1\xd5\xd8\xda\xdc\xdf\xe0\xe1\xe4\xed\xee\xf0\xf1\xf2\xf9\xfa\xff",
+		"\x01\x03\x05\x0a\x0e\x0f\x10\x13\x14\x17\x1a\x1b\x1f\x23\x32\x36\x3c\x3d\x3e\x3f\x4c\x4d\x52\x54\x56\x58\x61\x65\x68\x6d\x6f\x70\x72\x73\x78\x79\x7a\x7c\x7d\x82\x8a\x8d\x8f\x94\x9a\x9c\x9f\xa0\xa2\xa6\xa7\xa9\xac\xb6\xb7\xba\xc1\xc2\xc3\xc6\xc8\xce\xd2\xd3\xd4\xd7\xdb\xdc\xdd\xde\xe1\xe3\xe6\xe7\xe8\xe9\xea\xf0\xf4\xf6\xfc\xfe",
+	},
+
+	// Group 82 '_'
+	{
+		"\x00\x03\x04\x06\x07\x0b\x0e\x12\x14\x15\x17\x1a\x1b\x22\x29\x2c\x2f\x3b\x3c\x3d\x40\x41\x42\x45\x46\x47\x4e\x54\x55\x58\x5a\x5f\x61\x63\x64\x65\x66\x67\x69\x70\x73\x74\x78\x80\x81\x82\x87\x8d\x8e\x91\x96\x99\x9a\x9e\xa0\xa1\xa8\xb9\xbb\xbf\xc7\xca\xcc\xcd\xd0\xd4\xd6\xd9\xdb\xdf\xe0\xe1\xe3\xe5\xed\xef\xf1\xf5\xf9\xfa\xfd\xff",
+		"\x01\x05\x0c\x0d\x0f\x11\x13\x23\x24\x25\x26\x28\x2a\x2b\x2e\x32\x33\x34\x39\x3e\x3f\x44\x4b\x4f\x52\x56\x59\x5b\x5c\x5d\x5e\x6a\x6b\x6e\x79\x7b\x7c\x7d\x85\x86\x88\x8a\x8b\x93\x98\x9d\x9f\xa4\xa5\xaa\xab\xad\xaf\xb0\xb1\xb2\xb3\xb4\xb6\xba\xbd\xbe\xc3\xc4\xc5\xc6\xc8\xc9\xce\xcf\xd1\xd2\xd7\xdc\xdd\xe6\xe7\xe8\xe9\xee\xf6\xf7",
+		"\x02\x09\x0a\x16\x18\x19\x1c\x1d\x1e\x1f\x20\x21\x27\x2d\x30\x31\x36\x38\x3a\x43\x48\x49\x4a\x4c\x4d\x50\x51\x53\x57\x60\x62\x68\x6c\x6d\x6f\x71\x72\x76\x77\x7a\x7e\x7f\x83\x84\x89\x8c\x8f\x94\x95\x97\x9c\xa2\xa3\xa6\xa7\xac\xae\xb5\xb7\xb8\xbc\xc0\xc1\xc2\xcb\xd5\xd8\xda\xde\xe2\xe4\xea\xeb\xec\xf0\xf2\xf3\xf4\xf8\xfb\xfc\xfe",
+		"\x01\x03\x05\x08\x0b\x0c\x0e\x10\x14\x17\x19\x1b\x1e\x20\x26\x27\x2b\x2c\x31\x33\x34\x35\x37\x3c\x3d\x3f\x44\x47\x4c\x4f\x5a\x5c\x60\x62\x63\x68\x69\x6a\x6e\x74\x75\x76\x7b\x7d\x83\x87\x88\x8d\x90\x92\x94\x99\x9b\x9d\xa0\xa1\xa2\xa8\xa9\xaf\xb2\xb3\xb6\xbc\xbf\xc1\xd3\xd4\xd6\xd8\xdc\xdd\xe2\xe3\xe9\xea\xeb\xee\xf1\xf5\xf7\xfd",
+		"\x04\x06\x07\x08\x0f\x10\x11\x13\x16\x1f\x21\x22\x25\x28\x29\x2f\x30\x37\x3a\x3b\x40\x42\x46\x4d\x52\x54\x56\x5d\x64\x66\x6b\x71\x73\x75\x79\x7a\x81\x86\x89\x8a\x8c\x8f\x90\x91\x92\x93\x95\x96\x97\x98\x9f\xa4\xa9\xaa\xad\xb0\xb1\xb4\xb5\xb8\xbe\xc3\xc4\xc5\xc6\xc7\xc8\xc9\xca\xce\xcf\xd2\xd3\xd5\xd9\xdb\xe1\xe4\xed\xf2\xfa\xfb",
+		"\x00\x02\x09\x0a\x0d\x12\x18\x1c\x1d\x24\x2a\x2d\x2e\x32\x36\x38\x39\x3e\x41\x43\x45\x48\x4a\x4b\x4e\x53\x55\x57\x5b\x5e\x5f\x65\x6c\x6d\x6f\x70\x78\x7c\x7e\x7f\x82\x84\x85\x9a\x9b\x9e\xa3\xa5\xa6\xa7\xac\xae\xb7\xb9\xba\xbb\xbd\xc2\xcb\xcc\xcd\xd0\xd7\xda\xde\xdf\xe0\xe5\xe6\xe7\xe8\xec\xef\xf0\xf3\xf4\xf6\xf8\xf9\xfc\xfe\xff",
+		"\x00\x05\x0a\x15\x17\x1a\x1c\x23\x27\x28\x31\x32\x34\x35\x38\x3c\x3e\x44\x49\x50\x51\x54\x56\x58\x59\x5a\x5d\x5e\x61\x67\x6a\x70\x71\x72\x77\x7c\x80\x81\x84\x86\x8b\x8d\x8e\x8f\x92\x94\x96\x98\x99\x9a\x9b\x9c\x9f\xa5\xab\xae\xaf\xb4\xb5\xba\xbc\xc0\xc1\xc4\xca\xcc\xd1\xd3\xd5\xd8\xdc\xdf\xe3\xe5\xe8\xe9\xed\xef\xf3\xf6\xfc\xff",
+	},
+
+	// Group 83 '-'
+	{
+		"\x02\x03\x04\x0f\x13\x17\x19\x1f\x22\x25\x29\x2e\x33\x38\x3e\x40\x42\x43\x44\x4a\x4b\x4d\x4e\x4f\x51\x54\x57\x5c\x5d\x60\x62\x66\x68\x69\x6c\x6d\x74\x78\x7e\x81\x83\x84\x85\x86\x88\x8a\x8c\x8d\x90\x94\x96\x99\x9d\x9e\x9f\xa0\xa5\xa6\xad\xb0\xb2\xb7\xba\xbb\xbf\xc1\xc3\xca\xcf\xd1\xd5\xd8\xd9\xdd\xdf\xe7\xe8\xec\xf0\xf2\xf8\xfb",
+		"\x05\x06\x08\x09\x12\x16\x18\x1a\x1b\x1e\x20\x23\x24\x27\x28\x2c\x2f\x31\x34\x36\x3a\x3d\x3f\x48\x49\x4c\x50\x55\x56\x5a\x63\x67\x6f\x70\x75\x79\x7a\x7b\x80\x89\x8b\x91\x95\x98\xa2\xa3\xa4\xa8\xa9\xaa\xac\xae\xaf\xb1\xb6\xb8\xb9\xc0\xc4\xc6\xc9\xcb\xcc\xcd\xd2\xd3\xd6\xda\xdb\xde\xe0\xe2\xe5\xe6\xeb\xee\xef\xf5\xf7\xfd\xfe\xff",
+		"\x00\x01\x07\x0a\x0b\x0c\x0d\x0e\x10\x11\x15\x1d\x21\x26\x2a\x2b\x2d\x30\x35\x37\x39\x3b\x3c\x41\x45\x46\x47\x52\x53\x58\x59\x5e\x5f\x61\x64\x65\x6a\x6b\x6e\x71\x73\x76\x77\x7f\x82\x87\x8e\x8f\x92\x93\x97\x9a\x9b\x9c\xa1\xa7\xab\xb3\xb5\xbc\xbd\xbe\xc2\xc5\xc7\xc8\xce\xd0\xd4\xdc\xe1\xe3\xe4\xe9\xea\xed\xf1\xf3\xf4\xf6\xf9\xfc",
+		"\x01\x03\x08\x0b\x0d\x11\x14\x17\x1c\x1d\x1e\x20\x21\x22\x27\x2c\x2d\x2e\x2f\x32\x34\x36\x38\x39\x42\x48\x4d\x51\x54\x55\x59\x5b\x5d\x6a\x6c\x70\x72\x74\x75\x76\x78\x7b\x7c\x7d\x7f\x81\x83\x85\x88\x8d\x93\x9a\xa7\xa9\xad\xae\xb1\xb4\xb7\xb8\xbc\xbe\xc0\xc2\xc4\xc5\xc6\xcc\xcf\xd4\xd6\xd7\xdc\xdd\xe5\xe8\xe9\xea\xeb\xf3\xfa\xfb",
+		"\x00\x05\x09\x0e\x13\x14\x15\x1a\x1b\x23\x24\x25\x30\x31\x32\x3a\x3f\x40\x41\x45\x46\x47\x4a\x4c\x4e\x4f\x52\x53\x57\x5b\x60\x63\x64\x65\x67\x69\x6f\x71\x72\x77\x79\x7a\x7d\x7e\x84\x89\x8b\x8c\x8e\x8f\x92\x95\x96\x97\x9b\x9e\x9f\xa0\xa1\xa3\xa6\xab\xb4\xb6\xbd\xc1\xc8\xcd\xce\xd2\xd7\xda\xe1\xec\xed\xef\xf5\xf7\xf8\xf9\xfa\xfe",
+		"\x02\x04\x06\x07\x0a\x0c\x10\x16\x18\x19\x1c\x1f\x26\x28\x29\x2a\x33\x35\x37\x3b\x3c\x3d\x43\x44\x49\x4b\x50\x56\x58\x5c\x5f\x61\x62\x6b\x6d\x73\x7c\x80\x82\x86\x87\x8a\x90\x91\x94\x98\x9c\x9d\xa2\xa5\xa8\xaa\xac\xaf\xb0\xb2\xb9\xbb\xbf\xc7\xc9\xca\xcb\xd0\xd1\xd3\xd5\xd8\xd9\xdb\xde\xdf\xe2\xe4\xe7\xee\xf0\xf1\xf2\xf4\xf6\xff",
+		"\x01\x02\x05\x06\x0f\x10\x12\x14\x16\x1c\x1d\x1e\x21\x22\x24\x25\x28\x2b\x2f\x30\x34\x3b\x3e\x3f\x40\x48\x49\x4e\x59\x5a\x5e\x5f\x62\x66\x67\x68\x6b\x6c\x6e\x71\x76\x79\x7a\x7e\x81\x84\x85\x8d\x92\x95\x99\x9b\xa4\xad\xb0\xb2\xb3\xb5\xb6\xb8\xba\xbe\xbf\xc3\xcb\xcc\xd0\xd1\xd2\xd3\xd7\xd9\xdd\xde\xe0\xe3\xe6\xed\xee\xfb\xfc\xfd",
+	},
+
+	// Group 84 '$'
+	{
+		"\x02\x08\x0b\x0f\x10\x11\x16\x1b\x1c\x24\x28\x33\x35\x3b\x3d\x3e\x3f\x40\x42\x4d\x4f\x52\x54\x58\x5b\x5c\x61\x62\x63\x65\x6a\x6e\x70\x74\x75\x79\x7a\x83\x8a\x8b\x8f\x93\x95\x96\x98\x99\x9a\xa0\xa7\xa8\xaa\xab\xac\xad\xb0\xb1\xb8\xb9\xbb\xbd\xc0\xc5\xc7\xc9\xca\xce\xd1\xd4\xd6\xe6\xe8\xe9\xed\xee\xef\xf1\xf3\xf4\xf5\xf8\xfd\xff",
+		"\x05\x09\x0c\x0d\x0e\x12\x14\x17\x1a\x20\x22\x26\x29\x2a\x2c\x2f\x30\x31\x32\x39\x3c\x44\x45\x46\x48\x49\x4a\x4b\x4c\x51\x56\x59\x5e\x5f\x67\x68\x69\x6c\x6d\x6f\x7d\x80\x84\x85\x87\x88\x8d\x90\x91\x92\x94\x9c\x9f\xa2\xa3\xa4\xb2\xb3\xb4\xba\xbc\xc2\xc8\xcb\xcc\xcf\xd2\xd3\xd5\xd9\xda\xdb\xdd\xe2\xe3\xe4\xea\xeb\xf6\xf7\xfc\xfe",
+		"\x00\x01\x03\x04\x06\x07\x0a\x13\x15\x18\x19\x1d\x1e\x1f\x21\x23\x27\x2b\x36\x37\x38\x3a\x41\x43\x47\x4e\x50\x53\x55\x57\x5d\x60\x64\x66\x6b\x71\x72\x73\x76\x77\x78\x7b\x7c\x7e\x7f\x81\x82\x86\x89\x8c\x8e\x9b\x9d\x9e\xa1\xa6\xa9\xae\xaf\xb5\xb6\xbe\xbf\xc1\xc3\xc4\xc6\xcd\xd0\xd7\xd8\xdf\xe0\xe1\xe5\xe7\xec\xf0\xf2\xf9\xfa\xfb",
+		"\x04\x06\x09\x0b\x15\x1a\x21\x25\x27\x2a\x2d\x2e\x2f\x31\x32\x33\x34\x3c\x3f\x45\x47\x4f\x51\x59\x5a\x5c\x61\x65\x68\x6a\x6b\x6c\x6e\x6f\x78\x7c\x7d\x7f\x81\x82\x88\x8b\x90\x91\x92\x95\x96\x97\x9a\xa2\xa3\xa4\xa5\xa7\xa9\xad\xaf\xb3\xb4\xb6\xb7\xb9\xbc\xc0\xc1\xc3\xc4\xc9\xcb\xce\xcf\xd5\xd7\xdc\xde\xe0\xe1\xe6\xe8\xe9\xeb\xf6",
+		"\x01\x05\x08\x0a\x0e\x0f\x11\x12\x13\x14\x16\x18\x1e\x20\x25\x26\x29\x2c\x2d\x2e\x35\x3b\x3d\x3e\x40\x43\x44\x4a\x4c\x4d\x52\x55\x5e\x5f\x60\x62\x64\x69\x6d\x73\x74\x75\x76\x77\x7a\x7b\x7e\x85\x87\x8a\x8c\x97\x98\x99\x9d\x9f\xa0\xa1\xa8\xaa\xae\xb0\xb1\xb7\xba\xbb\xbe\xc5\xc6\xca\xd3\xd6\xd9\xdb\xdd\xde\xe7\xed\xf1\xf5\xf9\xfb",
+		"\x00\x02\x03\x07\x0c\x0d\x10\x17\x19\x1c\x1d\x1f\x22\x23\x2b\x30\x34\x37\x39\x3a\x41\x42\x46\x48\x49\x4b\x4e\x53\x56\x57\x58\x5a\x5b\x66\x67\x71\x72\x79\x83\x86\x89\x8d\x8e\x8f\x93\x94\x9b\x9c\x9e\xa5\xa6\xab\xac\xb2\xb5\xb8\xc2\xc7\xc8\xcc\xcd\xd0\xd1\xd2\xd4\xda\xdc\xdf\xe2\xe4\xe5\xea\xef\xf0\xf2\xf3\xf4\xf7\xf8\xfa\xfd\xfe",
+		"\x00\x0c\x12\x14\x18\x1a\x1b\x1c\x20\x22\x23\x24\x28\x2b\x2d\x36\x38\x3a\x3d\x40\x41\x48\x4c\x50\x53\x54\x58\x5d\x5e\x60\x63\x65\x67\x70\x72\x73\x74\x77\x7b\x80\x81\x83\x84\x8a\x8f\x9b\xa0\xa1\xa6\xab\xad\xb1\xb8\xba\xbc\xbd\xbf\xc3\xc7\xcb\xcc\xd1\xd2\xd4\xd5\xd7\xd8\xdb\xdc\xde\xe1\xe3\xe5\xe8\xec\xee\xf3\xf8\xfc\xfd\xfe\xff",
+	},
+
+	// Group 85 '`'
+	{
+		"\x00\x08\x0a\x0f\x13\x14\x16\x17\x1d\x20\x23\x26\x28\x2a\x2f\x32\x36\x37\x38\x3d\x43\x44\x46\x47\x49\x4b\x4f\x50\x51\x53\x57\x59\x5b\x5d\x61\x63\x67\x6b\x6c\x76\x7e\x86\x89\x90\x92\x93\x9a\x9c\x9e\xa1\xa2\xa4\xa5\xab\xb3\xb5\xb7\xbb\xbc\xbe\xc2\xc3\xcb\xd0\xd3\xd6\xd8\xda\xdd\xde\xdf\xe5\xe6\xea\xeb\xed\xef\xf2\xf8\xfa\xfc\xff",
+		"\x05\x0c\x0e\x11\x15\x1b\x1c\x1f\x21\x24\x27\x2d\x30\x31\x33\x39\x3a\x3e\x42\x45\x4a\x4d\x54\x55\x56\x58\x5a\x5f\x6d\x6f\x72\x73\x74\x75\x77\x7b\x7c\x7d\x83\x8b\x8d\x8f\x91\x94\x97\x9b\x9d\x9f\xa3\xa9\xaa\xac\xaf\xb1\xb2\xb4\xb9\xbd\xc0\xc1\xc5\xc6\xc8\xc9\xca\xcc\xce\xcf\xd2\xd4\xdc\xe0\xe1\xe4\xe7\xe8\xe9\xf1\xf4\xf7\xf9\xfe",
+		"\x01\x02\x04\x06\x07\x09\x0b\x0d\x10\x12\x19\x1a\x1e\x22\x25\x29\x2b\x2c\x2e\x34\x35\x3b\x3f\x40\x48\x4c\x4e\x5c\x5e\x60\x62\x64\x65\x66\x68\x69\x6a\x70\x71\x78\x7a\x7f\x80\x81\x82\x84\x85\x87\x88\x8c\x8e\x95\x96\x98\x99\xa0\xa6\xa7\xa8\xad\xae\xb0\xb6\xb8\xba\xbf\xc7\xcd\xd1\xd5\xd7\xd9\xdb\xe2\xe3\xec\xee\xf0\xf5\xf6\xfb\xfd",
+		"\x00\x03\x08\x0f\x12\x13\x15\x18\x19\x1b\x1e\x1f\x20\x23\x25\x27\x29\x2b\x2e\x2f\x32\x34\x36\x3c\x40\x41\x42\x47\x49\x51\x52\x53\x55\x56\x57\x63\x68\x6b\x6e\x70\x72\x73\x76\x77\x79\x7a\x7d\x8a\x8b\x8c\x8e\x8f\x90\x98\xa0\xa1\xa4\xa6\xae\xb5\xb7\xc3\xc4\xc6\xc8\xc9\xcd\xd0\xd3\xd5\xd6\xd8\xdb\xde\xe4\xe8\xeb\xf3\xf7\xf9\xfa\xfb",
+		"\x04\x05\x09\x0b\x11\x14\x16\x18\x1c\x24\x26\x28\x2a\x2d\x30\x33\x3a\x3b\x3c\x41\x44\x45\x46\x4a\x4b\x4e\x52\x5d\x5e\x62\x65\x6a\x6d\x6e\x6f\x79\x7b\x7e\x80\x84\x85\x86\x89\x8a\x92\x97\x99\x9b\x9f\xa8\xa9\xaa\xad\xaf\xb3\xb4\xb6\xb8\xb9\xba\xbc\xc4\xc5\xc7\xca\xcc\xce\xd1\xd2\xd9\xdc\xe1\xe3\xe9\xed\xee\xf2\xf3\xf8\xfc\xfe\xff",
+		"\x02\x03\x06\x07\x0a\x0c\x0e\x1a\x1d\x21\x22\x2c\x31\x35\x37\x38\x39\x3d\x3e\x3f\x43\x48\x4d\x4f\x50\x54\x58\x5a\x5b\x5c\x5f\x61\x64\x66\x67\x69\x6c\x71\x74\x75\x78\x7c\x81\x82\x83\x87\x8d\x91\x93\x94\x95\x96\x9a\x9c\x9d\x9e\xa2\xa3\xa7\xab\xac\xb1\xbd\xbe\xc0\xc1\xc2\xcf\xd4\xd7\xda\xdf\xe0\xe2\xe5\xe6\xea\xef\xf0\xf1\xf5\xfd",
+		"\x00\x01\x04\x06\x09\x0a\x0d\x10\x11\x16\x17\x1b\x24\x26\x2d\x30\x35\x37\x3a\x3c\x3d\x3f\x46\x47\x49\x4c\x53\x59\x5c\x5f\x60\x6b\x6f\x73\x75\x76\x77\x78\x7b\x7c\x7d\x7f\x81\x88\x8c\x8d\x90\x91\x9c\x9f\xa5\xa8\xac\xaf\xb0\xb2\xb3\xb4\xbb\xbf\xc6\xcb\xd0\xd2\xd3\xd4\xd8\xdb\xdd\xdf\xe0\xe2\xe4\xe7\xec\xf2\xf4\xf5\xf6\xfb\xfc\xff",
+	},
+
+	// Group 86 '!'
+	{
+		"\x01\x03\x04\x06\x09\x0d\x10\x16\x1c\x1d\x21\x25\x27\x29\x2b\x2f\x31\x32\x35\x3b\x42\x4a\x4e\x56\x59\x5a\x5d\x67\x6a\x6b\x6e\x6f\x74\x75\x77\x79\x7b\x7c\x80\x82\x83\x88\x8b\x8c\x8f\x91\x92\x93\x97\x9e\xa2\xa4\xa5\xa7\xa9\xaa\xae\xb0\xb2\xb4\xb8\xb9\xbb\xbd\xc1\xc2\xc4\xc5\xcd\xd5\xda\xdb\xe6\xe8\xea\xeb\xee\xf1\xf2\xf8\xfa\xff",
+		"\x05\x07\x08\x0b\x0c\x19\x1a\x1e\x1f\x20\x22\x2a\x30\x33\x36\x37\x38\x39\x3c\x3d\x3f\x43\x44\x45\x49\x4b\x55\x5c\x5f\x60\x61\x65\x68\x69\x6d\x73\x76\x7a\x7d\x7e\x85\x87\x89\x98\x99\x9d\xa0\xa1\xa3\xa8\xab\xac\xad\xb1\xb3\xb5\xb6\xba\xbf\xc0\xc3\xc7\xc8\xc9\xca\xcb\xcf\xd1\xd2\xd4\xde\xe1\xe7\xed\xef\xf3\xf4\xf5\xf9\xfb\xfc\xfd",
+		"\x00\x02\x0a\x0e\x0f\x11\x13\x14\x15\x17\x18\x1b\x23\x24\x26\x28\x2d\x2e\x34\x3a\x3e\x40\x41\x46\x48\x4c\x4d\x4f\x50\x51\x52\x53\x54\x57\x58\x5b\x5e\x63\x66\x6c\x70\x71\x72\x78\x7f\x81\x84\x86\x8a\x8d\x8e\x90\x94\x95\x96\x9b\x9c\x9f\xa6\xaf\xb7\xbe\xc6\xcc\xce\xd0\xd3\xd6\xd8\xd9\xdc\xdd\xdf\xe0\xe2\xe3\xe4\xe5\xe9\xec\xf0\xf6",
+		"\x00\x01\x0b\x0e\x11\x12\x15\x1c\x24\x26\x28\x29\x2b\x2c\x2f\x31\x33\x38\x3c\x45\x47\x4a\x50\x52\x54\x55\x57\x59\x5b\x60\x61\x62\x64\x66\x69\x72\x73\x74\x75\x79\x8b\x8c\x8d\x8f\x91\x94\x99\x9a\x9b\xa0\xa2\xa7\xaf\xb4\xba\xbb\xbc\xbd\xc2\xcf\xd0\xd7\xda\xdb\xdc\xdd\xdf\xe0\xe5\xe6\xe7\xe8\xea\xeb\xec\xf7\xf8\xfa\xfb\xfc\xfe\xff",
+		"\x03\x04\x06\x08\x10\x13\x16\x19\x1b\x1e\x20\x22\x25\x27\x2a\x3b\x3d\x41\x44\x46\x4c\x4e\x4f\x51\x53\x56\x58\x5a\x5e\x62\x63\x64\x67\x68\x6a\x6f\x70\x71\x78\x7a\x7b\x82\x84\x88\x89\x90\x96\x97\x9a\x9e\xa1\xa3\xa5\xa6\xa8\xa9\xae\xb0\xb1\xb3\xb6\xb8\xbe\xc4\xc5\xc8\xc9\xcc\xcd\xce\xd1\xd7\xd9\xe3\xed\xef\xf2\xf3\xf5\xf7\xfd\xfe",
+		"\x02\x05\x07\x09\x0a\x0c\x0d\x0f\x12\x14\x17\x21\x23\x2d\x2e\x30\x32\x34\x35\x36\x37\x39\x3e\x40\x42\x43\x47\x48\x49\x4b\x4d\x5c\x5d\x5f\x65\x6b\x6c\x6d\x76\x7c\x7e\x7f\x80\x81\x83\x85\x86\x87\x8a\x8e\x92\x93\x95\x9d\xaa\xab\xac\xad\xb2\xb5\xb7\xb9\xbc\xbf\xc0\xc3\xc6\xc7\xcb\xd2\xd4\xd5\xd6\xd8\xe1\xe2\xe9\xee\xf0\xf1\xf4\xf6",
+		"\x01\x09\x0a\x0e\x11\x15\x17\x18\x1a\x1d\x1e\x1f\x20\x23\x24\x2c\x2e\x32\x38\x39\x3a\x3f\x41\x49\x4a\x4b\x4e\x51\x54\x55\x5b\x66\x68\x6c\x6e\x72\x77\x78\x7d\x84\x8f\x92\x95\x96\x98\x9c\x9d\x9e\x9f\xa1\xa2\xa3\xa4\xad\xae\xb3\xb8\xb9\xba\xbb\xbc\xbf\xc0\xc1\xc6\xca\xcb\xd2\xd3\xd4\xdd\xde\xe4\xe5\xe8\xea\xed\xf0\xf8\xf9\xfd\xfe",
+	},
+
+	// Group 87 '.'
+	{
+		"\x00\x01\x03\x04\x05\x06\x07\x09\x0a\x0b\x10\x13\x15\x1e\x25\x27\x29\x2b\x2d\x2f\x34\x3a\x3b\x3c\x3d\x43\x45\x47\x48\x4a\x4c\x52\x58\x59\x5a\x60\x62\x69\x75\x76\x77\x7a\x7f\x80\x81\x86\x87\x8a\x8b\x91\x97\x98\xa0\xab\xac\xb0\xb2\xb3\xb8\xbf\xc3\xc6\xc8\xc9\xcd\xce\xd0\xd1\xd2\xd4\xd5\xd7\xd8\xdd\xe0\xe4\xe8\xe9\xeb\xf1\xfb\xfe",
+		"\x0c\x0d\x12\x14\x16\x19\x1a\x1f\x2a\x2c\x31\x33\x35\x37\x3e\x3f\x44\x46\x49\x4d\x50\x51\x54\x56\x5b\x5d\x5e\x63\x6a\x6c\x6d\x6e\x70\x71\x73\x78\x7c\x88\x89\x8c\x90\x94\x95\x96\x99\x9a\x9b\xa1\xa5\xa6\xa8\xa9\xae\xaf\xb1\xb5\xb6\xb7\xb9\xba\xbb\xbc\xbe\xc2\xc4\xc5\xca\xd6\xdb\xe7\xea\xed\xef\xf0\xf3\xf6\xf7\xf8\xf9\xfa\xfc\xfd",
+		"\x02\x08\x0e\x0f\x11\x17\x18\x1b\x1c\x1d\x20\x21\x22\x23\x24\x26\x28\x2e\x30\x32\x36\x40\x41\x42\x4e\x4f\x53\x55\x57\x5c\x5f\x61\x64\x65\x66\x67\x68\x6f\x72\x74\x79\x7b\x7d\x7e\x82\x83\x84\x8d\x8e\x8f\x92\x93\x9c\x9d\x9e\xa2\xa3\xa4\xa7\xb4\xbd\xc0\xc1\xc7\xcb\xcf\xd3\xd9\xda\xdc\xde\xdf\xe1\xe2\xe3\xe5\xe6\xee\xf2\xf4\xf5\xff",
+		"\x03\x0a\x0f\x20\x22\x26\x28\x29\x2b\x2c\x32\x37\x38\x39\x3b\x3d\x43\x47\x49\x4b\x4f\x50\x53\x54\x57\x59\x5b\x5c\x5e\x62\x66\x69\x6b\x6f\x72\x75\x76\x79\x7e\x7f\x80\x82\x84\x85\x87\x8b\x8e\x8f\x90\x94\x97\x98\x9c\x9d\x9e\x9f\xa0\xaa\xac\xad\xaf\xb2\xb8\xbb\xbd\xbf\xc6\xca\xcc\xd1\xd4\xd9\xda\xe6\xe8\xe9\xec\xef\xf3\xf7\xfb\xff",
+		"\x00\x01\x04\x06\x08\x0d\x0e\x11\x12\x13\x17\x18\x1a\x1b\x1d\x23\x25\x27\x2a\x2d\x2e\x35\x38\x3a\x3c\x3e\x40\x48\x4c\x4d\x55\x56\x63\x67\x68\x6b\x6c\x6d\x6e\x71\x73\x74\x78\x7c\x81\x8a\x92\x93\x96\x9a\x9b\x9f\xa2\xa3\xaa\xab\xad\xae\xb1\xb3\xb6\xb7\xc0\xc9\xcc\xce\xd0\xd3\xd5\xd6\xd7\xde\xe1\xe2\xe4\xe5\xeb\xed\xf4\xfa\xfc\xfe",
+		"\x02\x05\x07\x09\x0b\x0c\x10\x14\x15\x16\x19\x1c\x1e\x1f\x21\x2f\x30\x31\x33\x36\x3f\x41\x44\x45\x46\x4a\x4e\x51\x52\x58\x5a\x5d\x5f\x60\x61\x64\x65\x6a\x70\x77\x7a\x83\x86\x88\x8c\x8d\x91\x95\x99\xa4\xa5\xa6\xa7\xa8\xa9\xb0\xb5\xb9\xba\xc1\xc5\xc7\xc8\xcb\xcd\xcf\xd2\xd8\xdb\xdc\xdf\xe3\xe7\xea\xec\xee\xf0\xf1\xf5\xf6\xf8\xfd",
+		"\x02\x03\x07\x09\x10\x11\x18\x1e\x1f\x20\x23\x24\x28\x2c\x34\x39\x3f\x40\x42\x43\x47\x4b\x53\x59\x5a\x5b\x5c\x5f\x61\x62\x63\x64\x65\x6b\x6e\x6f\x70\x71\x7b\x7d\x85\x89\x8a\x8e\x91\x99\x9a\x9d\x9e\xa0\xa1\xac\xae\xb4\xb6\xbc\xbe\xbf\xc2\xc3\xc4\xc5\xcb\xcd\xd2\xd5\xdd\xe0\xe4\xea\xeb\xee\xef\xf0\xf1\xf2\xf3\xf4\xf5\xf8\xf9\xfd",
+	},
+})
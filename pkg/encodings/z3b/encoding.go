@@ -0,0 +1,160 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package z3b
+
+//go:generate go run ../../../cmd/z3bgen -spec alphabets/std.json -o stdencoding.go
+//go:generate go run ../../../cmd/z3bgen -spec alphabets/cookie.json -o cookieencoding.go
+
+import (
+	"fmt"
+)
+
+// Encoding is a z3b alphabet. It defines the data characters, the set
+// control characters and the sets of bytes in each encoding group, and it
+// is identified by a version byte and a prefix character. The character
+// which selects the group is one of the data or set control characters.
+type Encoding struct {
+	version    byte
+	prefix     byte
+	groupBytes []byte        // groupBytes contains the character which selects each encoding group
+	separators [numSets]byte // separators maps a set change to the set control character
+
+	groups [totalBytes]int16 // groups maps a character to the encoding group it selects, or -1
+	shifts [totalBytes]uint8 // shifts maps a set control character to the set change, or 0
+	data   [totalBytes]bool  // data is true for data characters
+
+	byteToChar    [][numSets][totalBytes]byte // byteToChar maps a byte to its character in a set, or 0
+	charToByte    [][numSets][totalBytes]byte // charToByte maps a data character to its byte in a set
+	setMasks      [][totalBytes]uint8         // setMasks maps a byte to a bit mask of the sets which contain it
+	blockSetMasks [][totalBytes]uint64        // blockSetMasks contains setMasks of a block of groups in one word, a byte for each group
+}
+
+// encodings contains the encodings by the version
+var encodings = map[byte]*Encoding{}
+
+// prefixes contains the encodings by the prefix character
+var prefixes = map[byte]*Encoding{}
+
+// EncodingByVersion returns the encoding with the version byte, or nil if
+// there is no such encoding
+func EncodingByVersion(version byte) *Encoding {
+	return encodings[version]
+}
+
+// EncodingByPrefix returns the encoding with the prefix character, or nil if
+// there is no such encoding
+func EncodingByPrefix(prefix byte) *Encoding {
+	return prefixes[prefix]
+}
+
+// Version returns the version byte of the encoding
+func (enc *Encoding) Version() byte {
+	return enc.version
+}
+
+// Prefix returns the character which starts text encoded with
+// AppendEncodePrefixed
+func (enc *Encoding) Prefix() byte {
+	return enc.prefix
+}
+
+// AppendEncodePrefixed appends the prefix character of the encoding and the
+// encoding of src to dst, so AppendDecodePrefixed can tell the alphabet
+// from the text
+func (enc *Encoding) AppendEncodePrefixed(dst, src []byte) ([]byte, error) {
+	return enc.AppendEncode(append(dst, enc.prefix), src)
+}
+
+// AppendDecodePrefixed appends the data decoded from text encoded with
+// AppendEncodePrefixed by any encoding to dst. If src is not valid, dst is
+// returned as it was with an error.
+func AppendDecodePrefixed(dst, src []byte) ([]byte, error) {
+	if len(src) == 0 {
+		return dst, fmt.Errorf("missing prefix character")
+	}
+	enc := EncodingByPrefix(src[0])
+	if enc == nil {
+		return dst, fmt.Errorf("unknown prefix character: '%c'", src[0])
+	}
+	return enc.AppendDecode(dst, src[1:])
+}
+
+// newEncoding creates and registers an encoding. The data characters are
+// assigned to the bytes of each set in order, and there must be one group
+// for each data and set control character. It panics if the alphabet is
+// not valid, since encodings are only created when the package is
+// initialized.
+func newEncoding(version byte, prefix byte, data, control string, groups [][numSets]string) *Encoding {
+
+	if _, exists := encodings[version]; exists {
+		panic(fmt.Sprintf("z3b: encoding version %d exists already", version))
+	}
+	if _, exists := prefixes[prefix]; exists {
+		panic(fmt.Sprintf("z3b: encoding prefix '%c' exists already", prefix))
+	}
+	if prefix < 32 || prefix > 126 || prefix == invalidCharacter || prefix == '\\' {
+		panic(fmt.Sprintf("z3b: invalid prefix: '%c'", prefix))
+	}
+	if len(control) != numSets-1 {
+		panic(fmt.Sprintf("z3b: %d set control characters, %d required", len(control), numSets-1))
+	}
+	groupSet := data + control
+	if len(groups) != len(groupSet) {
+		panic(fmt.Sprintf("z3b: %d encoding groups, %d required", len(groups), len(groupSet)))
+	}
+
+	enc := &Encoding{
+		version:       version,
+		prefix:        prefix,
+		groupBytes:    []byte(groupSet),
+		byteToChar:    make([][numSets][totalBytes]byte, len(groups)),
+		charToByte:    make([][numSets][totalBytes]byte, len(groups)),
+		setMasks:      make([][totalBytes]uint8, len(groups)),
+		blockSetMasks: make([][totalBytes]uint64, (len(groups)+groupsPerBlock-1)/groupsPerBlock),
+	}
+
+	for i := range enc.groups {
+		enc.groups[i] = -1
+	}
+	for g, c := range enc.groupBytes {
+		if c < 32 || c > 126 || c == invalidCharacter || c == '\\' {
+			panic(fmt.Sprintf("z3b: invalid character: '%c'", c))
+		}
+		if enc.groups[c] >= 0 {
+			panic(fmt.Sprintf("z3b: duplicate character: '%c'", c))
+		}
+		enc.groups[c] = int16(g)
+	}
+	for i := 0; i < len(control); i++ {
+		enc.separators[i+1] = control[i]
+		enc.shifts[control[i]] = uint8(i + 1)
+	}
+	for i := 0; i < len(data); i++ {
+		enc.data[data[i]] = true
+	}
+
+	for g := range groups {
+		for set := 0; set < numSets; set++ {
+			if len(groups[g][set]) != len(data) {
+				panic(fmt.Sprintf("z3b: group %d set %d has %d bytes, %d required", g, set, len(groups[g][set]), len(data)))
+			}
+			for i := 0; i < len(data); i++ {
+				b, r := groups[g][set][i], data[i]
+				enc.byteToChar[g][set][b] = r
+				enc.charToByte[g][set][r] = b
+				enc.setMasks[g][b] |= 1 << set
+			}
+		}
+		for b := 0; b < totalBytes; b++ {
+			if enc.setMasks[g][b] == 0 {
+				panic(fmt.Sprintf("z3b: group %d does not contain byte 0x%02x", g, b))
+			}
+			enc.blockSetMasks[g/groupsPerBlock][b] |= uint64(enc.setMasks[g][b]) << (8 * (g % groupsPerBlock))
+		}
+	}
+
+	encodings[version] = enc
+	prefixes[prefix] = enc
+	return enc
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package z3b_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings/z3b"
+)
+
+func TestEncodingByVersion(t *testing.T) {
+	for _, enc := range []*z3b.Encoding{z3b.StdEncoding, z3b.CookieEncoding} {
+		if found := z3b.EncodingByVersion(enc.Version()); found != enc {
+			t.Fatalf("Encoding of version %d not found", enc.Version())
+		}
+	}
+	if z3b.StdEncoding.Version() == z3b.CookieEncoding.Version() {
+		t.Fatalf("Encodings have the same version")
+	}
+	if z3b.EncodingByVersion(0) != nil {
+		t.Fatalf("Found an encoding of version 0")
+	}
+}
+
+func TestEncodingByPrefix(t *testing.T) {
+	for _, enc := range []*z3b.Encoding{z3b.StdEncoding, z3b.CookieEncoding} {
+		if found := z3b.EncodingByPrefix(enc.Prefix()); found != enc {
+			t.Fatalf("Encoding of prefix '%c' not found", enc.Prefix())
+		}
+	}
+	if z3b.StdEncoding.Prefix() != z3b.StdEncodingPrefix || z3b.CookieEncoding.Prefix() != z3b.CookieEncodingPrefix {
+		t.Fatalf("Encodings have unexpected prefixes")
+	}
+	if z3b.EncodingByPrefix('A') != nil {
+		t.Fatalf("Found an encoding of prefix 'A'")
+	}
+}
+
+func TestAppendEncodeDecodePrefixed(t *testing.T) {
+	data := generateRandomBytes(64)
+	for _, enc := range []*z3b.Encoding{z3b.StdEncoding, z3b.CookieEncoding} {
+		encoded, err := enc.AppendEncodePrefixed([]byte("x"), data)
+		if err != nil {
+			t.Fatalf("AppendEncodePrefixed failed: %v", err)
+		}
+		if encoded[0] != 'x' || encoded[1] != enc.Prefix() {
+			t.Fatalf("Expected the prefix '%c' after dst, got \"%s\"", enc.Prefix(), encoded)
+		}
+		decoded, err := z3b.AppendDecodePrefixed([]byte("y"), encoded[1:])
+		if err != nil {
+			t.Fatalf("AppendDecodePrefixed failed: %v", err)
+		}
+		if !bytes.Equal(decoded, append([]byte("y"), data...)) {
+			t.Fatalf("Decoded data does not match original data")
+		}
+	}
+
+	for _, invalid := range []string{"", "A#Hello", "~#Hello\""} {
+		if decoded, err := z3b.AppendDecodePrefixed([]byte("y"), []byte(invalid)); err == nil || string(decoded) != "y" {
+			t.Errorf("Expected an error and dst as it was for \"%s\", got %v and \"%s\"", invalid, err, decoded)
+		}
+	}
+}
+
+func TestCookieEncoding(t *testing.T) {
+	for _, size := range []int{1, 32, 1024} {
+		data := generateRandomBytes(size)
+
+		encoded, err := z3b.CookieEncoding.Encode(data)
+		if err != nil {
+			t.Fatalf("Encode failed: %v", err)
+		}
+		if i := strings.IndexAny(string(encoded), ";, \"\\"); i >= 0 {
+			t.Fatalf("Encoding contains '%c': \"%s\"", encoded[i], encoded)
+		}
+
+		decoded, err := z3b.CookieEncoding.Decode(encoded)
+		if err != nil {
+			t.Fatalf("Decode failed: %v", err)
+		}
+		if !bytes.Equal(decoded, data) {
+			t.Fatalf("Decoded data does not match original data")
+		}
+
+		// Encodings of one alphabet are not decoded by another one
+		if decoded, err := z3b.StdEncoding.Decode(encoded); size > 1 && err == nil && bytes.Equal(decoded, data) {
+			t.Fatalf("StdEncoding decoded data of CookieEncoding")
+		}
+
+		// The stream encoder uses the same alphabet
+		var stream bytes.Buffer
+		encoder := z3b.CookieEncoding.NewEncoder(&stream)
+		if _, err := encoder.Write(data); err != nil {
+			t.Fatalf("Write failed: %v", err)
+		}
+		if err := encoder.Close(); err != nil {
+			t.Fatalf("Close failed: %v", err)
+		}
+		if !bytes.Equal(stream.Bytes(), encoded) {
+			t.Fatalf("Stream does not match Encode")
+		}
+		decoded, err = io.ReadAll(z3b.CookieEncoding.NewDecoder(&stream))
+		if err != nil || !bytes.Equal(decoded, data) {
+			t.Fatalf("Decoding stream failed: %v", err)
+		}
+	}
+}
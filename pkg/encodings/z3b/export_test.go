@@ -0,0 +1,7 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package z3b
+
+// LegacyEncode exports legacyEncode for tests and benchmarks
+var LegacyEncode = legacyEncode
@@ -0,0 +1,107 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package z3b_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings/z3b"
+)
+
+var fuzzEncodings = []*z3b.Encoding{z3b.StdEncoding, z3b.CookieEncoding}
+
+// FuzzDecode tests that decoding any input never panics, and that data
+// which decodes is encoded and decoded back as it was. The seed corpus is
+// in testdata/fuzz/FuzzDecode.
+func FuzzDecode(f *testing.F) {
+	f.Add([]byte("#Hello_World"))
+	f.Add([]byte("6!BIKKL1EL_NLK"))
+	f.Fuzz(func(t *testing.T, encoded []byte) {
+		for _, enc := range fuzzEncodings {
+			decoded, err := enc.Decode(encoded)
+			if err != nil {
+				continue
+			}
+			if len(decoded) > z3b.DecodedLen(len(encoded)) {
+				t.Fatalf("Decoded %d bytes from %d characters", len(decoded), len(encoded))
+			}
+
+			// The stream decoder decodes one block like Decode
+			if len(decoded) > 0 && len(decoded) <= z3b.StreamBlockSize {
+				streamed, err := io.ReadAll(enc.NewDecoder(bytes.NewReader(encoded)))
+				if err != nil {
+					t.Fatalf("Stream decoder failed: %v", err)
+				}
+				if !bytes.Equal(streamed, decoded) {
+					t.Fatalf("Stream decoder decoded %v, Decode %v", streamed, decoded)
+				}
+			}
+
+			encodedAgain, err := enc.Encode(decoded)
+			if err != nil {
+				t.Fatalf("Encode failed: %v", err)
+			}
+			decodedAgain, err := enc.Decode(encodedAgain)
+			if err != nil {
+				t.Fatalf("Decode failed: %v", err)
+			}
+			if !bytes.Equal(decodedAgain, decoded) {
+				t.Fatalf("Decoded data does not match original data.\nOriginal: %v\nDecoded: %v", decoded, decodedAgain)
+			}
+		}
+	})
+}
+
+// FuzzEncodeDecode tests that any data is encoded with characters which
+// need no escaping in JSON, and decoded back as it was. The seed corpus is
+// in testdata/fuzz/FuzzEncodeDecode.
+func FuzzEncodeDecode(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte("Hello World"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, enc := range fuzzEncodings {
+			encoded, err := enc.Encode(data)
+			if err != nil {
+				t.Fatalf("Encode failed: %v", err)
+			}
+			if len(encoded) > z3b.EncodedLen(len(data)) {
+				t.Fatalf("Encoded %d bytes as %d characters", len(data), len(encoded))
+			}
+			for _, c := range encoded {
+				if c < 32 || c > 126 || c == '"' || c == '\\' {
+					t.Fatalf("Encoding contains '%c': \"%s\"", c, encoded)
+				}
+			}
+
+			decoded, err := enc.AppendDecode([]byte("prefix"), encoded)
+			if err != nil {
+				t.Fatalf("AppendDecode failed: %v", err)
+			}
+			if !bytes.Equal(decoded, append([]byte("prefix"), data...)) {
+				t.Fatalf("Decoded data does not match original data.\nOriginal: %v\nDecoded: %v", data, decoded[6:])
+			}
+
+			var stream bytes.Buffer
+			encoder := enc.NewEncoder(&stream)
+			if _, err := encoder.Write(data); err != nil {
+				t.Fatalf("Write failed: %v", err)
+			}
+			if err := encoder.Close(); err != nil {
+				t.Fatalf("Close failed: %v", err)
+			}
+			if len(data) <= z3b.StreamBlockSize && !bytes.Equal(stream.Bytes(), encoded) {
+				t.Fatalf("Stream \"%s\" does not match Encode \"%s\"", stream.Bytes(), encoded)
+			}
+			streamed, err := io.ReadAll(enc.NewDecoder(&stream))
+			if err != nil {
+				t.Fatalf("Stream decoder failed: %v", err)
+			}
+			if !bytes.Equal(streamed, data) {
+				t.Fatalf("Stream decoded data does not match original data")
+			}
+		}
+	})
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package z3b
+
+import (
+	"fmt"
+)
+
+// legacyEncode is the encoder which was replaced by the table-driven Encode.
+// It encodes every group on its own goroutine and picks the set for a byte
+// which is missing from the current set greedily. It is kept as a reference
+// for tests and benchmarks.
+func legacyEncode(data []byte) ([]byte, error) {
+
+	//log.Printf("Encode: data = %v", data)
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	ch := make(chan legacyGroupResult, numGroups)
+	for g := 0; g < numGroups; g++ {
+		go legacyFindGroup(g, data, ch)
+	}
+
+	bestGroup := -1
+	bestSize := -1
+	var bestEncoding []byte
+
+	for g := 0; g < numGroups; g++ {
+		res := <-ch
+		if res.err != nil {
+			return nil, fmt.Errorf("error in encoding group %d: %w", res.group, res.err)
+		}
+		size := len(res.encoded)
+		if bestSize < 0 || size < bestSize {
+			if bestEncoding != nil {
+				bytePool.Put(bestEncoding[:0])
+			}
+			bestSize = size
+			bestGroup = res.group
+			bestEncoding = res.encoded
+
+			if bestSize <= 2 {
+				break
+			}
+
+		} else {
+			bytePool.Put(res.encoded[:0])
+		}
+	}
+
+	if bestGroup < 0 {
+		return nil, fmt.Errorf("failed to find best encoding group")
+	}
+
+	//log.Printf("Encode: Best group %d '%c' (%d bytes): \"%s\"",
+	//	bestGroup, groupBytes[bestGroup], bestSize, string(bestEncoding))
+
+	return bestEncoding, nil
+}
+
+type legacyGroupResult struct {
+	err     error
+	group   int
+	encoded []byte
+}
+
+func legacyFindGroup(group int, data []byte, ch chan legacyGroupResult) {
+	encoded, err := legacyEncodeGroup(group, data)
+	if err != nil {
+		ch <- legacyGroupResult{
+			group: group,
+			err:   err,
+		}
+	} else {
+		ch <- legacyGroupResult{
+			group:   group,
+			encoded: encoded,
+		}
+	}
+}
+
+// legacyEncodeGroup encodes the input bytes into a z3b-encoded string.
+func legacyEncodeGroup(currentGroup int, data []byte) ([]byte, error) {
+
+	l := len(data)
+	estimatedSize := l * 4
+
+	result := bytePool.Get(estimatedSize)[:estimatedSize]
+	//log.Printf("encodeGroup: start: group %d '%c'", currentGroup, groupBytes[currentGroup])
+	idx := 0
+	result[idx] = StdEncoding.groupBytes[currentGroup]
+	idx++
+
+	currentSet := 0
+	for i, b := range data {
+
+		r := StdEncoding.byteToChar[currentGroup][currentSet][b]
+		if r != 0 {
+			result[idx] = byte(r)
+			idx++
+			continue
+		}
+
+		ch := make(chan [2]int, numSets)
+		for j := 1; j < numSets; j++ {
+			go legacyFindChunk(currentGroup, currentSet, j, data[i:], ch)
+		}
+
+		bestSet := -1 // The current best set
+		bestSize := 0 // How many characters can be presented in the best set
+		received := 0
+	events:
+		for {
+			select {
+			case res := <-ch:
+				j, size := res[0], res[1]
+				//log.Printf("[%d]: Received +%d (%d bytes)", received, j, size)
+				if bestSize < size {
+					bestSet = j
+					bestSize = size
+				}
+
+				received++
+				if received >= 6 {
+					break events
+				}
+			}
+		}
+
+		//log.Printf("Best found +%d (%d bytes)", bestSize, bestSize)
+
+		switch bestSet {
+		case 1:
+			result[idx] = separator1
+			idx++
+		case 2:
+			result[idx] = separator2
+			idx++
+		case 3:
+			result[idx] = separator3
+			idx++
+		case 4:
+			result[idx] = separator4
+			idx++
+		case 5:
+			result[idx] = separator5
+			idx++
+		case 6:
+			result[idx] = separator6
+			idx++
+		default:
+			bytePool.Put(result[:0])
+			return nil, fmt.Errorf("failed to find encoding set for: '%c'", b)
+		}
+
+		currentSet = (currentSet + bestSet) % numSets
+		r = StdEncoding.byteToChar[currentGroup][currentSet][b]
+
+		result[idx] = byte(r)
+		idx++
+	}
+
+	result = result[:idx]
+
+	//log.Printf("encodeGroup: Group %d '%c': \"%s\"\n", currentGroup, groupBytes[currentGroup], result)
+
+	return result, nil
+}
+
+func legacyFindChunk(currentGroup, currentSet, j int, next []byte, ch chan [2]int) {
+	set := (currentSet + j) % numSets
+	idx := 0
+	for _, b := range next {
+		r := StdEncoding.byteToChar[currentGroup][set][b]
+		if r == 0 {
+			break
+		}
+		idx++
+	}
+	ch <- [2]int{j, idx}
+}
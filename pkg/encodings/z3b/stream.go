@@ -0,0 +1,177 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package z3b
+
+import (
+	"fmt"
+	"io"
+)
+
+// StreamBlockSize is the number of bytes encoded as one block by the streaming
+// Encoder. Every block is encoded like Encode encodes it, so it starts with
+// the character which selects the encoding group. The decoder knows that a
+// block ends after StreamBlockSize bytes, so a stream is only the blocks one
+// after another. Data up to StreamBlockSize bytes is encoded exactly like by
+// Encode.
+const StreamBlockSize = 4096
+
+// encoder is the streaming encoder from NewEncoder
+type encoder struct {
+	enc   *Encoding
+	w     io.Writer
+	err   error
+	block []byte // block contains data which has not been encoded yet
+	out   []byte // out is the buffer of encoded blocks
+}
+
+// NewEncoder returns a new z3b stream encoder with StdEncoding, see
+// Encoding.NewEncoder
+func NewEncoder(w io.Writer) io.WriteCloser {
+	return StdEncoding.NewEncoder(w)
+}
+
+// NewEncoder returns a new z3b stream encoder. Data written to the returned
+// writer is encoded in blocks of StreamBlockSize bytes and written to w. The
+// caller must close the encoder to flush the last partial block.
+func (enc *Encoding) NewEncoder(w io.Writer) io.WriteCloser {
+	return &encoder{enc: enc, w: w}
+}
+
+func (e *encoder) Write(p []byte) (int, error) {
+	if e.err != nil {
+		return 0, e.err
+	}
+	n := 0
+	for len(p) > 0 {
+		// Full blocks are encoded without copying them
+		if len(e.block) == 0 && len(p) >= StreamBlockSize {
+			if e.err = e.encode(p[:StreamBlockSize]); e.err != nil {
+				return n, e.err
+			}
+			n += StreamBlockSize
+			p = p[StreamBlockSize:]
+			continue
+		}
+		if e.block == nil {
+			e.block = bytePool.Get(StreamBlockSize)
+		}
+		m := min(StreamBlockSize-len(e.block), len(p))
+		e.block = append(e.block, p[:m]...)
+		n += m
+		p = p[m:]
+		if len(e.block) == StreamBlockSize {
+			if e.err = e.encode(e.block); e.err != nil {
+				return n, e.err
+			}
+			e.block = e.block[:0]
+		}
+	}
+	return n, nil
+}
+
+// Close encodes and writes the last partial block
+func (e *encoder) Close() error {
+	if e.err == nil && len(e.block) > 0 {
+		e.err = e.encode(e.block)
+	}
+	if e.block != nil {
+		bytePool.Put(e.block[:0])
+		e.block = nil
+	}
+	if e.out != nil {
+		bytePool.Put(e.out[:0])
+		e.out = nil
+	}
+	return e.err
+}
+
+// encode encodes one block and writes it
+func (e *encoder) encode(block []byte) error {
+	if e.out == nil {
+		e.out = bytePool.Get(EncodedLen(StreamBlockSize))
+	}
+	var err error
+	if e.out, err = e.enc.AppendEncode(e.out[:0], block); err != nil {
+		return err
+	}
+	_, err = e.w.Write(e.out)
+	return err
+}
+
+// decoder is the streaming decoder from NewDecoder
+type decoder struct {
+	enc   *Encoding
+	r     io.Reader
+	err   error
+	buf   [1024]byte
+	in    []byte // in contains encoded characters which have not been decoded yet
+	group int    // group is the encoding group of the current block, or -1 between blocks
+	set   int    // set is the current set of the group
+	count int    // count is the number of bytes decoded from the current block
+}
+
+// NewDecoder returns a new z3b stream decoder with StdEncoding, see
+// Encoding.NewDecoder
+func NewDecoder(r io.Reader) io.Reader {
+	return StdEncoding.NewDecoder(r)
+}
+
+// NewDecoder returns a new z3b stream decoder, which decodes data written by
+// the Encoder from NewEncoder, or by Encode up to StreamBlockSize bytes, from
+// r
+func (enc *Encoding) NewDecoder(r io.Reader) io.Reader {
+	return &decoder{enc: enc, r: r, group: -1}
+}
+
+func (d *decoder) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if len(d.in) == 0 {
+			if d.err != nil || n > 0 {
+				break
+			}
+			var m int
+			m, d.err = d.r.Read(d.buf[:])
+			d.in = d.buf[:m]
+			continue
+		}
+		c := d.in[0]
+		d.in = d.in[1:]
+
+		if d.group < 0 {
+			if d.group = d.enc.groupOf(c); d.group < 0 {
+				return n, d.fail(c)
+			}
+			d.set = 0
+			d.count = 0
+			continue
+		}
+		if shift := d.enc.setShift(c); shift != 0 {
+			d.set = (d.set + shift) % numSets
+			continue
+		}
+		if !d.enc.isDataCharacter(c) {
+			return n, d.fail(c)
+		}
+		p[n] = d.enc.charToByte[d.group][d.set][c]
+		n++
+		if d.count++; d.count == StreamBlockSize {
+			d.group = -1
+		}
+	}
+	if n == 0 && d.err == io.EOF && d.group >= 0 && d.count == 0 {
+		d.err = fmt.Errorf("invalid single character block to decode")
+	}
+	if n > 0 {
+		return n, nil
+	}
+	return 0, d.err
+}
+
+// fail stops decoding at an invalid character
+func (d *decoder) fail(c byte) error {
+	d.in = nil
+	d.err = fmt.Errorf("invalid character in encoded stream: '%c'", c)
+	return d.err
+}
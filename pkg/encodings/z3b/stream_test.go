@@ -0,0 +1,104 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package z3b_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings/z3b"
+)
+
+func TestEncoderDecoder(t *testing.T) {
+	sizes := []int{0, 1, 100, z3b.StreamBlockSize - 1, z3b.StreamBlockSize, z3b.StreamBlockSize + 1, 3*z3b.StreamBlockSize + 17}
+	for _, size := range sizes {
+		t.Run(fmt.Sprintf("%d", size), func(t *testing.T) {
+			data := generateRandomBytes(size)
+
+			// Written in uneven pieces
+			var encoded bytes.Buffer
+			encoder := z3b.NewEncoder(&encoded)
+			for rest := data; len(rest) > 0; {
+				n := min(len(rest), 1000)
+				if _, err := encoder.Write(rest[:n]); err != nil {
+					t.Fatalf("Write failed: %v", err)
+				}
+				rest = rest[n:]
+			}
+			if err := encoder.Close(); err != nil {
+				t.Fatalf("Close failed: %v", err)
+			}
+
+			// A stream of one block is encoded like by Encode
+			if size <= z3b.StreamBlockSize {
+				expected, err := z3b.Encode(data)
+				if err != nil {
+					t.Fatalf("Encode failed: %v", err)
+				}
+				if !bytes.Equal(encoded.Bytes(), expected) {
+					t.Errorf("Stream of one block does not match Encode")
+				}
+			}
+
+			decoded, err := io.ReadAll(iotest.OneByteReader(z3b.NewDecoder(bytes.NewReader(encoded.Bytes()))))
+			if err != nil {
+				t.Fatalf("Decoding stream failed: %v", err)
+			}
+			if !bytes.Equal(decoded, data) {
+				t.Errorf("Decoded data does not match original data")
+			}
+		})
+	}
+}
+
+func TestDecoder_Invalid(t *testing.T) {
+	testCases := []struct {
+		name    string
+		encoded []byte
+	}{
+		{"Single byte", []byte("A")},
+		{"InvalidCharacter", []byte("ABC\"")},
+		{"InvalidGroup", []byte("\"ABC")},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := io.ReadAll(z3b.NewDecoder(bytes.NewReader(tc.encoded))); err == nil {
+				t.Fatalf("Expected error when decoding invalid stream '%s', but got none", tc.encoded)
+			}
+		})
+	}
+}
+
+func TestAppendEncodeDecode(t *testing.T) {
+	data := generateRandomBytes(300)
+
+	encoded, err := z3b.AppendEncode([]byte("prefix:"), data)
+	if err != nil {
+		t.Fatalf("AppendEncode failed: %v", err)
+	}
+	if !bytes.HasPrefix(encoded, []byte("prefix:")) {
+		t.Fatalf("AppendEncode did not append to dst")
+	}
+	if n := len(encoded) - len("prefix:"); n > z3b.EncodedLen(len(data)) {
+		t.Errorf("Encoding of %d characters is longer than EncodedLen %d", n, z3b.EncodedLen(len(data)))
+	}
+
+	decoded, err := z3b.AppendDecode([]byte("prefix:"), encoded[len("prefix:"):])
+	if err != nil {
+		t.Fatalf("AppendDecode failed: %v", err)
+	}
+	if !bytes.Equal(decoded, append([]byte("prefix:"), data...)) {
+		t.Errorf("Decoded data does not match original data")
+	}
+	if z3b.DecodedLen(len(encoded)-len("prefix:")) < len(data) {
+		t.Errorf("DecodedLen is less than the length of the data")
+	}
+
+	if decoded, err := z3b.AppendDecode([]byte("prefix:"), []byte("ABC\"")); err == nil || string(decoded) != "prefix:" {
+		t.Errorf("AppendDecode should fail and return dst, got %q, %v", decoded, err)
+	}
+}
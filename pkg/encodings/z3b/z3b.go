@@ -5,13 +5,17 @@ package z3b
 
 import (
 	"fmt"
+	"math/bits"
+	"runtime"
+	"slices"
 	"sync"
+	"sync/atomic"
+
+	"github.com/hyperifyio/statelessdb/pkg/types"
 )
 
 // Constants
 const (
-	bufferSize = 4096 // Default buffer size
-
 	separator1 = '_' // Set +1 set control character
 	separator2 = '-' // Set +2 set control character
 	separator3 = '$' // Set +3 set control character
@@ -31,336 +35,353 @@ const (
 	numSets    = 7                 // Number of binary sets per a encoding group
 	setSize    = len(printableSet) // Number of characters in printableSet
 	totalBytes = 256               // Total number of byte values (0-255)
-)
 
-// Character Sets Arrays
-var (
-	printableBytes = []byte(printableSet) // Convert printableSet to []byte
-	groupBytes     = []byte(groupSet)     // Convert groupSet to []byte
+	groupsPerBlock = 8 // Number of encoding groups searched at the same time
 )
 
-// Mapping Tables
-var (
-	// byteToCharSet maps each set to a byte-to-char mapping array.
-	byteToCharSet [numGroups][numSets][totalBytes]int
+// ParallelThreshold is the size of data from which EncodeParallel searches
+// the encoding groups on multiple goroutines
+const ParallelThreshold = 1 << 20
 
-	// charToByteSet maps each set to a char-to-byte mapping array.
-	// Index 0 corresponds to char 0, up to char 128.
-	charToByteSet [numGroups][numSets][128]byte
+// bytePool pools buffers of Encode and Decode by size classes
+var bytePool = types.NewMemoryPoolManager[[]byte](
+	"z3b_byte_slices",
+	func(capacity int) []byte {
+		return make([]byte, 0, capacity)
+	},
+	func(s []byte) int {
+		return cap(s)
+	},
 )
 
-// Initialize mapping tables
-func init() {
-	initializeMappings()
+// Encode encodes the input bytes into a z3b-encoded string with StdEncoding.
+// The returned slice is from a pool.
+func Encode(data []byte) ([]byte, error) {
+	return StdEncoding.Encode(data)
+}
+
+// EncodeParallel encodes like Encode, see Encoding.EncodeParallel
+func EncodeParallel(data []byte) ([]byte, error) {
+	return StdEncoding.EncodeParallel(data)
 }
 
-// initializeMappings populates the byteToCharSet and charToByteSet arrays.
-func initializeMappings() {
+// Encode encodes the input bytes into a z3b-encoded string. Every encoding
+// group is searched for the shortest encoding, and the group with the
+// smallest index wins between equally long encodings, so the same data is
+// always encoded the same way. The returned slice is from a pool.
+func (enc *Encoding) Encode(data []byte) ([]byte, error) {
+
+	//log.Printf("Encode: data = %v", data)
 
-	if len(printableBytes) < setSize {
-		panic(fmt.Sprintf("Not enough printable bytes: %d of %d required", len(printableBytes), setSize))
+	if len(data) == 0 {
+		return nil, nil
 	}
 
-	// Fill byteToCharSet with 0 char if not mapped
-	for g := 0; g < numGroups; g++ {
-		for set := 0; set < numSets; set++ {
-			for b := 0; b < totalBytes; b++ {
-				byteToCharSet[g][set][b] = -1
+	bestGroup := -1
+	bestSize := EncodedLen(len(data)) + 1
+	for block := range enc.blockSetMasks {
+		sizes := enc.blockSizes(block, data, bestSize)
+		for i, size := range sizes {
+			if size > 0 && size < bestSize {
+				bestGroup = block*groupsPerBlock + i
+				bestSize = size
 			}
 		}
-	}
 
-	for g := 0; g < numGroups; g++ {
-		for set := 0; set < numSets; set++ {
-			for i, b := range binaryGroups[g][set] {
-				r := printableBytes[i]
-				byteToCharSet[g][set][b] = int(r)
-				charToByteSet[g][set][r] = b
-			}
+		// Nothing is shorter than an encoding without set control characters
+		if bestSize == 1+len(data) {
+			break
 		}
 	}
 
-	//// Verify that separator1 is not present in printableBytes
-	//for i, r := range printableBytes {
-	//	if r == separator1 {
-	//		panic(fmt.Sprintf("Separator character is present in index %d", i))
-	//	}
-	//}
-}
-
-// Memory Pools for Encode and Decode
-var encodePool = sync.Pool{
-	New: func() interface{} {
-		return make([]byte, 0, bufferSize)
-	},
-}
+	if bestGroup < 0 {
+		return nil, fmt.Errorf("failed to find best encoding group")
+	}
 
-var decodePool = sync.Pool{
-	New: func() interface{} {
-		return make([]byte, 0, bufferSize)
-	},
-}
+	//log.Printf("Encode: Best group %d '%c' (%d bytes)", bestGroup, enc.groupBytes[bestGroup], bestSize)
 
-type groupResult struct {
-	err     error
-	group   int
-	encoded []byte
+	return enc.encodeGroup(bestGroup, data, bestSize), nil
 }
 
-// Encode encodes the input bytes into a z3b-encoded string.
-func Encode(data []byte) ([]byte, error) {
-
-	//log.Printf("Encode: data = %v", data)
+// EncodeParallel returns the same encoding as Encode, but searches the
+// encoding groups on multiple goroutines when there are at least
+// ParallelThreshold bytes of data. Smaller data is encoded by Encode, since
+// starting the goroutines costs more than it saves.
+func (enc *Encoding) EncodeParallel(data []byte) ([]byte, error) {
 
-	if len(data) == 0 {
-		return nil, nil
+	blocks := len(enc.blockSetMasks)
+	workers := min(runtime.GOMAXPROCS(0), blocks)
+	if len(data) < ParallelThreshold || workers < 2 {
+		return enc.Encode(data)
 	}
 
-	ch := make(chan groupResult, numGroups)
-	for g := 0; g < numGroups; g++ {
-		go findGroup(g, data, ch)
+	var best atomic.Int64
+	best.Store(int64(EncodedLen(len(data))))
+
+	sizes := make([][groupsPerBlock]int, blocks)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(first int) {
+			defer wg.Done()
+			for block := first; block < blocks; block += workers {
+				// Groups as good as the best are kept, since the smallest index wins
+				sizes[block] = enc.blockSizes(block, data, int(best.Load())+1)
+				for _, size := range sizes[block] {
+					for size > 0 {
+						current := best.Load()
+						if int64(size) >= current || best.CompareAndSwap(current, int64(size)) {
+							break
+						}
+					}
+				}
+			}
+		}(w)
 	}
+	wg.Wait()
 
 	bestGroup := -1
-	bestSize := -1
-	var bestEncoding []byte
-
-	for g := 0; g < numGroups; g++ {
-		res := <-ch
-		if res.err != nil {
-			return nil, fmt.Errorf("error in encoding group %d: %w", res.group, res.err)
-		}
-		size := len(res.encoded)
-		if bestSize < 0 || size < bestSize {
-			//if cap(bestEncoding) >= 1 {
-			//	encodePool.Put(bestEncoding[:0])
-			//}
-			bestSize = size
-			bestGroup = res.group
-			bestEncoding = res.encoded
-			//} else {
-			//	if cap(bestEncoding) >= 1 {
-			//		encodePool.Put(bestEncoding[:0])
-			//	}
-
-			if bestSize <= 2 {
-				break
+	bestSize := 0
+	for block := range sizes {
+		for i, size := range sizes[block] {
+			if size > 0 && (bestGroup < 0 || size < bestSize) {
+				bestGroup = block*groupsPerBlock + i
+				bestSize = size
 			}
-
 		}
 	}
-
 	if bestGroup < 0 {
 		return nil, fmt.Errorf("failed to find best encoding group")
 	}
-
-	//log.Printf("Encode: Best group %d '%c' (%d bytes): \"%s\"",
-	//	bestGroup, groupBytes[bestGroup], bestSize, string(bestEncoding))
-
-	return bestEncoding, nil
+	return enc.encodeGroup(bestGroup, data, bestSize), nil
 }
 
-func findGroup(group int, data []byte, ch chan groupResult) {
-	encoded, err := encodeGroup(group, data)
-	if err != nil {
-		ch <- groupResult{
-			group: group,
-			err:   err,
+// blockSizes returns the lengths of the shortest encodings of data in the
+// encoding groups of a block, or zero for groups which cannot encode data
+// shorter than the limit.
+//
+// Each byte costs one character, and changing the set costs one set control
+// character. Any set can be changed to with one character, so a set either
+// needs the fewest set control characters so far, or one more. Only the bit
+// mask of the sets which need the fewest is tracked: a byte which is in none
+// of them changes the set, and then all sets containing the byte need the
+// fewest. The masks of all groups in the block are one word, a byte for
+// each group, so the groups are searched at the same time without branches.
+func (enc *Encoding) blockSizes(block int, data []byte, limit int) [groupsPerBlock]int {
+	const (
+		laneOnes = 0x0101010101010101 // The lowest bit of each group
+		laneLow  = 0x7f7f7f7f7f7f7f7f // The set bits of each group
+		laneHigh = 0x8080808080808080 // The highest bit of each group
+		maxCount = 0xff               // Changes counted in a byte before they are added up
+	)
+
+	masks := &enc.blockSetMasks[block]
+	limit -= 1 + len(data) // The limit for set control characters
+
+	var changes [groupsPerBlock]int
+	var missing uint64       // Highest bit is set for groups which could not encode a byte
+	sets := uint64(laneOnes) // The first byte is encoded in the first set, unless it is changed
+	for rest := data; len(rest) > 0; rest = rest[min(len(rest), maxCount):] {
+		var counts uint64
+		for _, b := range rest[:min(len(rest), maxCount)] {
+			mask := masks[b]
+			sets &= mask
+			changed := ^(sets + laneLow) & laneHigh // Highest bit is set for groups without a set
+			missing |= ^(mask + laneLow) & laneHigh
+			sets |= mask & ((changed >> 7) * maxCount)
+			counts += changed >> 7
+		}
+
+		fewest := limit
+		for i := range changes {
+			changes[i] += int(counts >> (8 * i) & maxCount)
+			if missing>>(8*i+7)&1 == 0 {
+				fewest = min(fewest, changes[i])
+			}
 		}
-	} else {
-		ch <- groupResult{
-			group:   group,
-			encoded: encoded,
+		if fewest >= limit {
+			return [groupsPerBlock]int{}
 		}
 	}
+
+	var sizes [groupsPerBlock]int
+	for i := range sizes {
+		if missing>>(8*i+7)&1 == 0 && changes[i] < limit {
+			sizes[i] = 1 + len(data) + changes[i]
+		}
+	}
+	return sizes
 }
 
-// encodeGroup encodes the input bytes into a z3b-encoded string.
-func encodeGroup(currentGroup int, data []byte) ([]byte, error) {
+// encodeGroup encodes data in the encoding group. The size must be the one
+// from blockSizes. The sets are tracked like in blockSizes, and the encoding
+// is written backwards from the last byte, staying in the same set as long
+// as it needed the fewest set control characters.
+func (enc *Encoding) encodeGroup(group int, data []byte, size int) []byte {
 
 	l := len(data)
-	estimatedSize := l * 4
-
-	//result := encodePool.Get().([]byte)
-	//if cap(result) < estimatedSize {
-	//	encodePool.Put(result[:0])
-	//	result = make([]byte, 0, estimatedSize)
-	//	//result = slices.Grow(result, estimatedSize)
-	//}
-	//result = result[:estimatedSize]
-
-	result := make([]byte, estimatedSize)
-	//log.Printf("encodeGroup: start: group %d '%c'", currentGroup, groupBytes[currentGroup])
-	idx := 0
-	result[idx] = groupBytes[currentGroup]
-	idx++
+	masks := &enc.setMasks[group]
 
-	currentSet := 0
+	// trace contains the sets which need the fewest set control characters
+	// after each byte
+	trace := bytePool.Get(l)[:l]
+	sets := uint8(1)
 	for i, b := range data {
-
-		r := byteToCharSet[currentGroup][currentSet][b]
-		if r >= 0 && r < totalBytes {
-			result[idx] = byte(r)
-			idx++
-			continue
-		}
-
-		ch := make(chan [2]int, numSets)
-		for j := 1; j < numSets; j++ {
-			go findChunk(currentGroup, currentSet, j, data[i:], ch)
+		if mask := masks[b]; sets&mask != 0 {
+			sets &= mask
+		} else {
+			sets = mask
 		}
+		trace[i] = sets
+	}
 
-		bestSet := -1 // The current best set
-		bestSize := 0 // How many characters can be presented in the best set
-		received := 0
-	events:
-		for {
-			select {
-			case res := <-ch:
-				j, size := res[0], res[1]
-				//log.Printf("[%d]: Received +%d (%d bytes)", received, j, size)
-				if bestSize < size {
-					bestSet = j
-					bestSize = size
-				}
+	result := bytePool.Get(size)[:size]
+	currentSet := bits.TrailingZeros8(sets)
+	idx := size
+	for i := l - 1; i >= 0; i-- {
+		idx--
+		result[idx] = enc.byteToChar[group][currentSet][data[i]]
 
-				received++
-				if received >= 6 {
-					break events
-				}
-			}
+		previous := uint8(1)
+		if i > 0 {
+			previous = trace[i-1]
 		}
-
-		//log.Printf("Best found +%d (%d bytes)", bestSize, bestSize)
-
-		switch bestSet {
-		case 1:
-			result[idx] = separator1
-			idx++
-		case 2:
-			result[idx] = separator2
-			idx++
-		case 3:
-			result[idx] = separator3
-			idx++
-		case 4:
-			result[idx] = separator4
-			idx++
-		case 5:
-			result[idx] = separator5
-			idx++
-		case 6:
-			result[idx] = separator6
-			idx++
-		default:
-			//encodePool.Put(result[:0])
-			return nil, fmt.Errorf("failed to find encoding set for: '%c'", b)
+		if previous&(1<<currentSet) == 0 {
+			from := bits.TrailingZeros8(previous)
+			idx--
+			result[idx] = enc.separators[(currentSet-from+numSets)%numSets]
+			currentSet = from
 		}
+	}
+	result[0] = enc.groupBytes[group]
 
-		currentSet = (currentSet + bestSet) % numSets
-		r = byteToCharSet[currentGroup][currentSet][b]
+	bytePool.Put(trace[:0])
 
-		result[idx] = byte(r)
-		idx++
-	}
+	//log.Printf("encodeGroup: Group %d '%c': \"%s\"\n", group, enc.groupBytes[group], result)
+
+	return result
+}
 
-	result = result[:idx]
+// EncodedLen returns the maximum length of the encoding of n bytes. The
+// encoding is usually shorter, since a set control character is only needed
+// when a byte is not in the current set.
+func EncodedLen(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	return 1 + 2*n
+}
 
-	//log.Printf("encodeGroup: Group %d '%c': \"%s\"\n", currentGroup, groupBytes[currentGroup], result)
+// DecodedLen returns the maximum length of the data of an encoding of n
+// characters
+func DecodedLen(n int) int {
+	return max(n-1, 0)
+}
 
-	return result, nil
+// AppendEncode appends the encoding of src with StdEncoding to dst
+func AppendEncode(dst, src []byte) ([]byte, error) {
+	return StdEncoding.AppendEncode(dst, src)
 }
 
-func findChunk(currentGroup, currentSet, j int, next []byte, ch chan [2]int) {
-	set := (currentSet + j) % numSets
-	idx := 0
-	for _, b := range next {
-		r := byteToCharSet[currentGroup][set][b]
-		if r < 0 || r >= totalBytes {
-			break
-		}
-		idx++
+// AppendEncode appends the encoding of src to dst
+func (enc *Encoding) AppendEncode(dst, src []byte) ([]byte, error) {
+	encoded, err := enc.Encode(src)
+	if err != nil {
+		return dst, err
 	}
-	ch <- [2]int{j, idx}
+	dst = append(dst, encoded...)
+	if encoded != nil {
+		bytePool.Put(encoded[:0])
+	}
+	return dst, nil
 }
 
+// ReleaseDecodedBytes returns bytes from Decode to the pool, when they are
+// not used anymore.
+//
+// Deprecated: Use AppendDecode with a buffer of your own.
 func ReleaseDecodedBytes(decoded []byte) {
-	decodePool.Put(decoded[:0])
+	bytePool.Put(decoded[:0])
 }
 
-// Decode decodes a z3b-encoded string back into bytes.
+// Decode decodes a z3b-encoded string with StdEncoding back into bytes.
 func Decode(encoded []byte) ([]byte, error) {
+	return StdEncoding.Decode(encoded)
+}
 
-	//log.Printf("Decode: encoded = \"%s\"", string(encoded))
+// AppendDecode appends the data decoded from src with StdEncoding to dst
+func AppendDecode(dst, src []byte) ([]byte, error) {
+	return StdEncoding.AppendDecode(dst, src)
+}
 
-	l := len(encoded)
-	if l <= 0 {
+// Decode decodes a z3b-encoded string back into bytes.
+func (enc *Encoding) Decode(encoded []byte) ([]byte, error) {
+	if len(encoded) == 0 {
 		return nil, nil
 	}
+	return enc.AppendDecode(make([]byte, 0, DecodedLen(len(encoded))), encoded)
+}
+
+// AppendDecode appends the data decoded from src to dst. If src is not valid,
+// dst is returned as it was with an error.
+func (enc *Encoding) AppendDecode(dst, src []byte) ([]byte, error) {
+
+	//log.Printf("Decode: encoded = \"%s\"", string(src))
+
+	l := len(src)
+	if l <= 0 {
+		return dst, nil
+	}
 	if l == 1 {
-		return nil, fmt.Errorf("invalid single character string to decode")
+		return dst, fmt.Errorf("invalid single character string to decode")
 	}
 
-	gb := encoded[0]
-	currentGroup := -1
-	for i, b := range groupBytes {
-		if gb == b {
-			currentGroup = i
-			break
-		}
-	}
+	currentGroup := enc.groupOf(src[0])
 	if currentGroup < 0 {
-		return nil, fmt.Errorf("invalid character in encoded string: '%c'", gb)
+		return dst, fmt.Errorf("invalid character in encoded string: '%c'", src[0])
 	}
 	//log.Printf("Decode: currentGroup = %d", currentGroup)
 
-	decoded := decodePool.Get().([]byte)
-	if cap(decoded) < l {
-		decodePool.Put(decoded[:0])
-		decoded = make([]byte, 0, l)
-		//decoded = slices.Grow(decoded, l)
-	}
-	decoded = decoded[:l]
+	start := len(dst)
+	dst = slices.Grow(dst, DecodedLen(l))
+	decoded := dst[start : start+DecodedLen(l)]
 
 	currentSet := 0
 	idx := 0
 	for i := 1; i < l; i++ {
-		c := encoded[i]
+		c := src[i]
 
-		switch c {
-		case separator1:
-			currentSet = (currentSet + 1) % numSets
-			continue
-		case separator2:
-			currentSet = (currentSet + 2) % numSets
-			continue
-		case separator3:
-			currentSet = (currentSet + 3) % numSets
-			continue
-		case separator4:
-			currentSet = (currentSet + 4) % numSets
-			continue
-		case separator5:
-			currentSet = (currentSet + 5) % numSets
-			continue
-		case separator6:
-			currentSet = (currentSet + 6) % numSets
+		if shift := enc.setShift(c); shift != 0 {
+			currentSet = (currentSet + shift) % numSets
 			continue
 		}
 
-		if c < 32 || c > 126 || c == invalidCharacter {
-			return nil, fmt.Errorf("invalid character in encoded string: '%c'", c)
+		if !enc.isDataCharacter(c) {
+			return dst[:start], fmt.Errorf("invalid character in encoded string: '%c'", c)
 		}
 
-		b := charToByteSet[currentGroup][currentSet][c]
-		//if b == 0 && binarySet1[0] != 0 { // Assuming 0 is a valid byte, check if mapped
-		//	return nil, fmt.Errorf("invalid character '%c' for set %d", c, currentSet+1)
-		//}
-		decoded[idx] = b
+		decoded[idx] = enc.charToByte[currentGroup][currentSet][c]
 		idx++
 	}
 
 	//log.Printf("Decode: decoded = %v", decoded[:idx])
 
-	return decoded[:idx], nil
+	return dst[:start+idx], nil
+}
+
+// groupOf returns the encoding group selected by the first character of an
+// encoding, or -1 if the character does not select a group
+func (enc *Encoding) groupOf(c byte) int {
+	return int(enc.groups[c])
+}
+
+// setShift returns how many sets a set control character moves forward, or
+// zero if the character is not a set control character
+func (enc *Encoding) setShift(c byte) int {
+	return int(enc.shifts[c])
+}
+
+// isDataCharacter returns true if the character may encode a byte
+func (enc *Encoding) isDataCharacter(c byte) bool {
+	return enc.data[c]
 }
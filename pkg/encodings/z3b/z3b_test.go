@@ -78,3 +78,84 @@ func TestInvalidDecoding(t *testing.T) {
 		})
 	}
 }
+
+// TestEncode_NotLongerThanLegacy tests that Encode is never longer than the
+// goroutine based encoder it replaced, and always encodes the same way
+func TestEncode_NotLongerThanLegacy(t *testing.T) {
+	for _, size := range []int{1, 2, 7, 32, 100, 1024, 4096} {
+		for i := 0; i < 5; i++ {
+			data := generateRandomBytes(size)
+
+			encoded, err := z3b.Encode(data)
+			if err != nil {
+				t.Fatalf("Encode failed: %v", err)
+			}
+			legacy, err := z3b.LegacyEncode(data)
+			if err != nil {
+				t.Fatalf("LegacyEncode failed: %v", err)
+			}
+			if len(encoded) > len(legacy) {
+				t.Fatalf("Encoding of %d bytes is %d characters, legacy encoding is %d", size, len(encoded), len(legacy))
+			}
+
+			again, err := z3b.Encode(data)
+			if err != nil {
+				t.Fatalf("Encode failed: %v", err)
+			}
+			if !bytes.Equal(encoded, again) {
+				t.Fatalf("Encode is not deterministic: \"%s\" != \"%s\"", encoded, again)
+			}
+
+			decoded, err := z3b.Decode(encoded)
+			if err != nil {
+				t.Fatalf("Decode failed: %v", err)
+			}
+			if !bytes.Equal(decoded, data) {
+				t.Fatalf("Decoded data does not match original data.\nOriginal: %v\nDecoded: %v", data, decoded)
+			}
+		}
+	}
+}
+
+// TestEncodeParallel tests that EncodeParallel returns the same encoding as
+// Encode
+func TestEncodeParallel(t *testing.T) {
+	for _, size := range []int{100, z3b.ParallelThreshold} {
+		data := generateRandomBytes(size)
+		encoded, err := z3b.Encode(data)
+		if err != nil {
+			t.Fatalf("Encode failed: %v", err)
+		}
+		parallel, err := z3b.EncodeParallel(data)
+		if err != nil {
+			t.Fatalf("EncodeParallel failed: %v", err)
+		}
+		if !bytes.Equal(encoded, parallel) {
+			t.Fatalf("EncodeParallel of %d bytes does not match Encode", size)
+		}
+	}
+}
+
+// TestDecode_Compatible tests that encodings written by earlier versions are
+// still decoded the same way
+func TestDecode_Compatible(t *testing.T) {
+	testCases := []struct {
+		encoded string
+		data    []byte
+	}{
+		{"#Hello_World", []byte{0x5e, 0x9d, 0xb4, 0xb4, 0xc2, 0x8e, 0xce, 0xd6, 0xca, 0xb6}},
+		{"-abc_def$ghi`jkl!mno pqr", []byte{0x9f, 0xa3, 0xa4, 0xac, 0xad, 0xaf, 0xc0, 0xc1, 0xc2, 0xbe, 0xc2, 0xc4, 0xce, 0xcf, 0xd2, 0xd5, 0xd7, 0xdc}},
+		{"_x-y$z`w!v u~", []byte{0xec, 0xee, 0xeb, 0xeb, 0xe8, 0xde, 0xfa}},
+		{" 0123456789", []byte{0x26, 0x27, 0x28, 0x2b, 0x2d, 0x38, 0x3a, 0x3d, 0x3e, 0x42}},
+		{"6!BIKKL1EL_NLK", []byte("Hello World")},
+	}
+	for _, tc := range testCases {
+		decoded, err := z3b.Decode([]byte(tc.encoded))
+		if err != nil {
+			t.Fatalf("Decode failed: %v", err)
+		}
+		if !bytes.Equal(decoded, tc.data) {
+			t.Fatalf("Decoded \"%s\" as %v, expected %v", tc.encoded, decoded, tc.data)
+		}
+	}
+}
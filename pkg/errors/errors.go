@@ -20,15 +20,137 @@ var (
 	ErrEncryptorAscii85EncodingFailed                  = errors.New("encryptor: failed to encode ascii85")
 	ErrEncryptorAscii85EncoderCloseFailed              = errors.New("encryptor: Ascii85 encoder close failed")
 	ErrEncryptorFailedToSerializeData                  = errors.New("encryptor: failed to serialize data")
+	ErrEncryptorTextEncodingFailed                     = errors.New("encryptor: text encoding failed")
 	ErrDecryptBase64StringFailed                       = errors.New("decrypting: Base64 decoding failed")
+	ErrDecryptTextDecodingFailed                       = errors.New("decrypting: text decoding failed")
 	ErrDecryptDataLengthLessThanNonceSize              = errors.New("decrypting: Data length less than nonce size")
 	ErrDecryptDecodingSerializationFailed              = errors.New("decrypting: Failed to decode serialized data")
 	ErrDecryptDecodingGobSerializationFailed           = errors.New("decrypting: Failed to decode GOB serialized data")
 	ErrDecryptDecodingJsonSerializationFailed          = errors.New("decrypting: Failed to decode JSON serialized data")
+	ErrDecryptDecodingCborSerializationFailed          = errors.New("decrypting: Failed to decode CBOR serialized data")
+	ErrDecryptDecodingMsgpackSerializationFailed       = errors.New("decrypting: Failed to decode MessagePack serialized data")
+	ErrDecryptDecodingJsonStreamFailed                 = errors.New("decrypting: Failed to decode JSON stream")
+	ErrDecryptDecodingCborStreamFailed                 = errors.New("decrypting: Failed to decode CBOR stream")
+	ErrDecryptDecodingMsgpackStreamFailed              = errors.New("decrypting: Failed to decode MessagePack stream")
+	ErrDecryptStreamLifetimeFailed                     = errors.New("decrypting stream: Failed to read the lifetime")
+	ErrDecryptStreamSchemaVersionFailed                = errors.New("decrypting stream: Failed to read the schema version")
+	ErrDecryptStreamFormatMismatch                     = errors.New("decrypting stream: no unserializer for the format of the stream")
+	ErrDecryptStreamDecodingFailed                     = errors.New("decrypting stream: Failed to decode serialized data")
+	ErrDecryptStreamTrailingFailed                     = errors.New("decrypting stream: Failed to read the end of the stream")
+	ErrEncryptorStreamSerializeFailed                  = errors.New("encryptor: failed to serialize data for a stream")
+	ErrDecryptDecodingProtobufSerializationFailed      = errors.New("decrypting: Failed to decode protobuf serialized data")
+	ErrProtobufSerializeNotMessage                     = errors.New("serializing: data is not a protobuf message")
+	ErrProtobufUnserializeNotMessage                   = errors.New("unserializing: data is not a protobuf message")
+	ErrComputeStateInvalidId                           = errors.New("compute state: invalid id")
+	ErrComputeStateNotProtobufState                    = errors.New("compute state: not a statespb.ComputeState message")
+	ErrComputeStateInvalidOwner                        = errors.New("compute state: invalid owner")
+	ErrComputeStatePublicNotSupported                  = errors.New("compute state: public properties cannot be converted to protobuf")
+	ErrComputeStatePrivateNotSupported                 = errors.New("compute state: private properties cannot be converted to protobuf")
 	ErrDecryptFailed                                   = errors.New("decrypting failed")
+	ErrDecryptPaddingFailed                            = errors.New("decrypting: Failed to remove padding")
+	ErrDecryptFromTextDecodingFailed                   = errors.New("decrypting: decoding text from bytes failed")
+	ErrDecryptDeterministicNonceMismatch               = errors.New("decrypting: deterministic nonce does not match the plaintext")
+	ErrDecryptLifetimeFailed                           = errors.New("decrypting: Failed to read the lifetime")
+	ErrDecryptLegacyRejected                           = errors.New("decrypting: data encrypted without an envelope is not accepted")
 	ErrFailedToInitializeEncryptor                     = errors.New("encryptor initialization failed")
 	ErrFailedToInitializeDecryptor                     = errors.New("decryptor initialization failed")
 	ErrBadRequestBodyError                             = errors.New("bad request body error")
 	ErrRequestEncodingError                            = errors.New("request encoding error")
 	ErrComputeStateEncryptionFailed                    = errors.New("compute state encryption failed")
+	ErrEncryptorInitializeFailedKeyRing                = errors.New("initializing encryptor: failed to create key ring")
+	ErrEncryptorInitializeFailedNoKeyRing              = errors.New("initializing encryptor: no key ring")
+	ErrDecryptorInitializeFailedKeyRing                = errors.New("initializing decryptor: failed to create key ring")
+	ErrDecryptorInitializeFailedNoKeyRing              = errors.New("initializing decryptor: no key ring")
+	ErrKeyRingKeySizeLessThanMinimum                   = errors.New("key ring: Key size is not enough")
+	ErrKeyRingDuplicateKey                             = errors.New("key ring: key was already added")
+	ErrKeyRingNewAEAD                                  = errors.New("key ring: failed to create cipher")
+	ErrKeyRingUnsupportedSuite                         = errors.New("key ring: unsupported cipher suite")
+	ErrFailedToInitializeKeyRing                       = errors.New("key ring initialization failed")
+	ErrUnknownSuite                                    = errors.New("unknown cipher suite")
+	ErrUnsupportedSuite                                = errors.New("unsupported cipher suite")
+	ErrUnknownFormat                                   = errors.New("unknown serialization format")
+	ErrUnsupportedFormat                               = errors.New("unsupported serialization format")
+	ErrUnknownTextEncoding                             = errors.New("unknown text encoding")
+	ErrUnknownCompression                              = errors.New("unknown compression")
+	ErrUnsupportedCompression                          = errors.New("compressing: unsupported compression")
+	ErrUnsupportedDecompression                        = errors.New("decompressing: unsupported compression")
+	ErrCompressionFailed                               = errors.New("compressing failed")
+	ErrDecompressionFailed                             = errors.New("decompressing failed")
+	ErrFlateDecompressedSizeExceeded                   = errors.New("decompressing: flate data exceeds the size limit")
+	ErrSnappyDecompressedSizeExceeded                  = errors.New("decompressing: snappy data exceeds the size limit")
+	ErrSchemaVersionTooNew                             = errors.New("schema: data is newer than the current version")
+	ErrSchemaUpcasterMissing                           = errors.New("schema: no upcaster from the version")
+	ErrSchemaUpcasterFailed                            = errors.New("schema: upcaster failed")
+	ErrDecryptSchemaUpcastFailed                       = errors.New("decrypting: failed to upcast data to the current schema version")
+	ErrDecryptSchemaVersionFailed                      = errors.New("decrypting: plaintext is shorter than the schema version")
+	ErrEnvelopeTooShort                                = errors.New("envelope: data is too short")
+	ErrEnvelopeUnknownVersion                          = errors.New("envelope: unknown version")
+	ErrEnvelopeUnknownFlags                            = errors.New("envelope: unknown flags")
+	ErrEnvelopeUnsupportedSuite                        = errors.New("envelope: unsupported cipher suite")
+	ErrEnvelopeTextDecodingFailed                      = errors.New("envelope: text decoding failed")
+	ErrDecryptFormatMismatch                           = errors.New("decrypting: data was serialized in another format")
+	ErrUnknownPadding                                  = errors.New("unknown padding")
+	ErrPaddingNoBuckets                                = errors.New("padding: no buckets")
+	ErrPaddingInvalidBucket                            = errors.New("padding: bucket size must be positive")
+	ErrPaddingInvalidBucketSize                        = errors.New("padding: invalid bucket size")
+	ErrPaddingInvalidMinimum                           = errors.New("padding: invalid minimum size")
+	ErrPaddingInvalidMaximum                           = errors.New("padding: invalid maximum size")
+	ErrGCMSIVInvalidKeySize                            = errors.New("GCM-SIV: invalid key size")
+	ErrGCMSIVSealFailed                                = errors.New("GCM-SIV: sealing failed")
+	ErrPrivateStateExpired                             = errors.New("private state has expired")
+	ErrGCMSIVOpenFailed                                = errors.New("GCM-SIV: message authentication failed")
+	ErrEncryptDeterministicUnsupportedSuite            = errors.New("encrypting: the cipher suite does not support deterministic encryption")
+	ErrKeyRingDeriveFailed                             = errors.New("key ring: failed to derive key")
+	ErrTenantKeysNoTenant                              = errors.New("tenant keys: no tenant")
+	ErrTenantKeysRevoked                               = errors.New("tenant keys: tenant has been revoked")
+	ErrPrivateStateRevoked                             = errors.New("private state tenant has been revoked")
+	ErrPrivateStateNoTenant                            = errors.New("private state tenant is missing")
+	ErrPrivateStateSchemaUnsupported                   = errors.New("private state schema version is not supported")
+	ErrPrivateStreamSchemaUnsupported                  = errors.New("private stream schema version is not supported")
+	ErrFailedToDecryptStateStream                      = errors.New("failed to decrypt state stream")
+	ErrStateStreamEncryptionFailed                     = errors.New("state stream encryption failed")
+	ErrDecryptStreamTenantKeysUnsupported              = errors.New("decrypting stream: tenant keys are not supported")
+	ErrEncryptStreamTenantKeysUnsupported              = errors.New("encrypting stream: tenant keys are not supported")
+	ErrFailedToDeriveTenantKeys                        = errors.New("failed to derive tenant keys")
+	ErrKeyProviderReadFileFailed                       = errors.New("key provider: failed to read key file")
+	ErrKeyProviderReadDirectoryFailed                  = errors.New("key provider: failed to read key directory")
+	ErrKeyProviderCommandFailed                        = errors.New("key provider: key command failed")
+	ErrKeyProviderInvalidHex                           = errors.New("key provider: key is not valid hex")
+	ErrKeyProviderNoKeys                               = errors.New("key provider: no keys")
+	ErrKeyReloaderLoadFailed                           = errors.New("key reloader: failed to load keys")
+	ErrKeyReloaderKeyRingFailed                        = errors.New("key reloader: failed to create key ring")
+	ErrFieldCipherNoKeyRing                            = errors.New("field cipher: no key ring")
+	ErrFieldCipherNoClass                              = errors.New("field cipher: no sensitivity class")
+	ErrFieldCipherBase64Failed                         = errors.New("field cipher: Base64 decoding failed")
+	ErrFieldCipherInvalidEnvelope                      = errors.New("field cipher: invalid envelope")
+	ErrFieldCipherOpenFailed                           = errors.New("field cipher: message authentication failed")
+	ErrStreamUnknownVersion                            = errors.New("stream: unknown version")
+	ErrStreamUnknownFlags                              = errors.New("stream: unknown flags")
+	ErrStreamUnsupportedSuite                          = errors.New("stream: unsupported cipher suite")
+	ErrStreamInvalidChunkSize                          = errors.New("stream: invalid chunk size")
+	ErrStreamKeyDerivationFailed                       = errors.New("stream: failed to derive the key")
+	ErrStreamTooShort                                  = errors.New("stream: header is too short")
+	ErrStreamUnknownKey                                = errors.New("stream: unknown key")
+	ErrStreamReadFailed                                = errors.New("stream: reading failed")
+	ErrStreamReaderTooLong                             = errors.New("stream: too many chunks to read")
+	ErrStreamFirstChunkFailed                          = errors.New("stream: first chunk failed authentication")
+	ErrStreamAuthenticationFailed                      = errors.New("stream: chunk failed authentication")
+	ErrStreamWriterUnsupportedFlags                    = errors.New("stream writer: unsupported flags")
+	ErrStreamWriterSaltFailed                          = errors.New("stream writer: failed to generate salt")
+	ErrStreamWriterHeaderFailed                        = errors.New("stream writer: writing header failed")
+	ErrStreamWriterWriteFailed                         = errors.New("stream writer: writing chunk failed")
+	ErrStreamWriterTooLong                             = errors.New("stream writer: too many chunks to write")
+	ErrStreamWriterClosed                              = errors.New("stream writer: closed")
+	ErrSealFieldEncodingFailed                         = errors.New("sealing field: failed to encode value")
+	ErrSealFieldFailed                                 = errors.New("sealing field failed")
+	ErrOpenFieldFailed                                 = errors.New("opening field failed")
+	ErrOpenFieldDecodingFailed                         = errors.New("opening field: failed to decode value")
+	ErrStateFieldEncryptionNotSupported                = errors.New("state does not support field encryption")
+	ErrStateFieldDecryptionNotSupported                = errors.New("state does not support field decryption")
+	ErrPublicSignerNoKeyRing                           = errors.New("public signer: no key ring")
+	ErrPublicSignatureMissing                          = errors.New("public data signature is missing")
+	ErrPublicSignatureInvalid                          = errors.New("public data signature is invalid")
+	ErrRequestSignatureNotSupported                    = errors.New("request does not support public data signatures")
+	ErrRequestHandlerPanicked                          = errors.New("request handler panicked")
+	ErrPrivateStateRequired                            = errors.New("private state is required")
 )
@@ -0,0 +1,48 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/hyperifyio/statelessdb/pkg/types"
+)
+
+// MemoryPoolCollector exports statistics of all memory pools of
+// types.MemoryPoolManager, labeled by the name of the pool
+type MemoryPoolCollector struct {
+	hits      *prometheus.Desc
+	misses    *prometheus.Desc
+	discarded *prometheus.Desc
+	retained  *prometheus.Desc
+}
+
+var _ prometheus.Collector = &MemoryPoolCollector{}
+
+// NewMemoryPoolCollector creates a collector for statistics of memory pools
+func NewMemoryPoolCollector() *MemoryPoolCollector {
+	labels := []string{"pool"}
+	return &MemoryPoolCollector{
+		hits:      prometheus.NewDesc("memory_pool_hits_total", "Count of items reused from a memory pool", labels, nil),
+		misses:    prometheus.NewDesc("memory_pool_misses_total", "Count of items created because a memory pool was empty", labels, nil),
+		discarded: prometheus.NewDesc("memory_pool_discarded_total", "Count of items not retained by a memory pool", labels, nil),
+		retained:  prometheus.NewDesc("memory_pool_retained_bytes", "Capacity of items retained by a memory pool in bytes", labels, nil),
+	}
+}
+
+func (c *MemoryPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.hits
+	ch <- c.misses
+	ch <- c.discarded
+	ch <- c.retained
+}
+
+func (c *MemoryPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, stats := range types.MemoryPools() {
+		ch <- prometheus.MustNewConstMetric(c.hits, prometheus.CounterValue, float64(stats.Hits), stats.Name)
+		ch <- prometheus.MustNewConstMetric(c.misses, prometheus.CounterValue, float64(stats.Misses), stats.Name)
+		ch <- prometheus.MustNewConstMetric(c.discarded, prometheus.CounterValue, float64(stats.Discarded), stats.Name)
+		ch <- prometheus.MustNewConstMetric(c.retained, prometheus.GaugeValue, float64(stats.Retained), stats.Name)
+	}
+}
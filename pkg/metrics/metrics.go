@@ -4,6 +4,9 @@
 package metrics
 
 import (
+	"strconv"
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -30,6 +33,17 @@ var (
 		Help:    "Histogram of failed attempts",
 		Buckets: prometheus.LinearBuckets(0, 10, 50),
 	})
+
+	RequestHandlerDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "request_handler_duration_milliseconds",
+			Help:    "Duration in milliseconds that request handlers took",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 16),
+		},
+		[]string{"route", "success"},
+	)
+
+	MemoryPools = NewMemoryPoolCollector()
 )
 
 var (
@@ -37,6 +51,8 @@ var (
 		HttpRequestsTotal,
 		FailedOperationsCounter,
 		FailedAttemptsHistogram,
+		RequestHandlerDuration,
+		MemoryPools,
 	}
 )
 
@@ -55,3 +71,7 @@ func RecordFailedOperationMetric(operationName string) {
 func RecordHttpRequestMetric(path string) {
 	HttpRequestsTotal.WithLabelValues(path).Inc()
 }
+
+func RecordRequestHandlerDurationMetric(route string, success bool, duration time.Duration) {
+	RequestHandlerDuration.WithLabelValues(route, strconv.FormatBool(success)).Observe(float64(duration) / float64(time.Millisecond))
+}
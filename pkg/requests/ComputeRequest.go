@@ -3,14 +3,24 @@
 
 package requests
 
+import (
+	"github.com/hyperifyio/statelessdb/pkg/dtos"
+)
+
 // ComputeRequest defines a structure of the request body to the compute server
 type ComputeRequest struct {
-	Received    int64                  `json:"received,omitempty"` // Received is time when this request was received.
-	Public      map[string]interface{} `json:"public,omitempty"`   // Public contains public properties for a new resource
-	PrivateData string                 `json:"private,omitempty"`  // Private contains the private property from previous request. If omitted, a new resource is initialized.
+	Id            string                 `json:"id,omitempty"`        // Id is the resource ID from the previous response. Required if the private data is bound to the resource.
+	Owner         string                 `json:"owner,omitempty"`     // Owner is the owner ID from the previous response. Required if the private data is bound to the resource.
+	Created       string                 `json:"created,omitempty"`   // Created is the created time from the previous response. Required if the public data is signed.
+	Updated       string                 `json:"updated,omitempty"`   // Updated is the updated time from the previous response. Required if the public data is signed.
+	Received      int64                  `json:"received,omitempty"`  // Received is time when this request was received.
+	Public        map[string]interface{} `json:"public,omitempty"`    // Public contains public properties for a new resource, or the public properties from the previous response
+	PrivateData   string                 `json:"private,omitempty"`   // Private contains the private property from previous request. If omitted, a new resource is initialized.
+	SignatureData string                 `json:"signature,omitempty"` // SignatureData is the signature from the previous response. Required if the public data is signed.
 }
 
 var _ Request = &ComputeRequest{}
+var _ SignedRequest = &ComputeRequest{}
 
 func NewComputeRequest(
 	received int64,
@@ -28,3 +38,18 @@ func NewComputeRequest(
 func (r *ComputeRequest) Private() string {
 	return r.PrivateData
 }
+
+// SignedData returns the data which was signed for the public properties
+func (r *ComputeRequest) SignedData() []byte {
+	return dtos.NewPublicSignedData(r.Id, r.Owner, r.Created, r.Updated, r.Public)
+}
+
+// SignedIdentity returns the signed id, owner and times of the resource
+func (r *ComputeRequest) SignedIdentity() []byte {
+	return dtos.NewPublicSignedIdentity(r.Id, r.Owner, r.Created, r.Updated)
+}
+
+// Signature returns the signature of the public properties
+func (r *ComputeRequest) Signature() string {
+	return r.SignatureData
+}
@@ -4,18 +4,32 @@
 package requests
 
 import (
-	"bytes"
+	"context"
+	"io"
 
 	"github.com/hyperifyio/statelessdb/pkg/encodings"
 	"github.com/hyperifyio/statelessdb/pkg/encodings/json"
 	"github.com/hyperifyio/statelessdb/pkg/errors"
+	"github.com/hyperifyio/statelessdb/pkg/states"
 )
 
 type EncryptedRequestManager[T interface{}, R Request, D interface{}] struct {
-	Encryptor  *encodings.Encryptor[T]
-	Decryptor  *encodings.Decryptor[T]
-	NewState   func() T
-	NewRequest func() R
+	Encryptor     *encodings.Encryptor[T]
+	Decryptor     *encodings.Decryptor[T]
+	NewState      func() T
+	NewRequest    func() R
+	tenants       *encodings.TenantKeys  // tenants derives the keys of each tenant, or nil to use the server keys
+	stateTenant   StateIdentityFunc[T]   // stateTenant returns the tenant of a state
+	requestTenant RequestIdentityFunc[R] // requestTenant returns the tenant of a request
+	fieldSealer   states.FieldSealer     // fieldSealer encrypts private properties by their class, or nil
+	fieldClasses  states.FieldClasses    // fieldClasses contains sensitivity classes of private properties
+}
+
+// FieldSealedState is implemented by states which support field-level
+// encryption of private properties, e.g. *states.ComputeState
+type FieldSealedState interface {
+	SealFields(sealer states.FieldSealer, classes states.FieldClasses) error
+	OpenFields(sealer states.FieldSealer, allowed ...string) error
 }
 
 func NewEncryptedRequestManager[T interface{}, R Request, D interface{}](
@@ -34,19 +48,34 @@ func NewEncryptedRequestManager[T interface{}, R Request, D interface{}](
 
 var _ RequestManager[any, Request, any] = &EncryptedRequestManager[any, Request, any]{}
 
-// DecodeRequest will decode request data bytes to request
-func (h *EncryptedRequestManager[T, R, D]) DecodeRequest(body []byte) (R, error) {
-	var err error
-	req := h.NewRequest()
+// WithTenantKeys configures states to be encrypted with keys derived for their
+// tenant, e.g. the owner. The tenant is read from the state when encrypting,
+// and from the request when decrypting. States of revoked tenants are
+// rejected.
+func (h *EncryptedRequestManager[T, R, D]) WithTenantKeys(tenants *encodings.TenantKeys, stateTenant StateIdentityFunc[T], requestTenant RequestIdentityFunc[R]) *EncryptedRequestManager[T, R, D] {
+	h.tenants = tenants
+	h.stateTenant = stateTenant
+	h.requestTenant = requestTenant
+	return h
+}
 
-	// TODO: Use of this pool resulted in random fails. Fallback to new decoder each time.
-	//reader := GetJsonReaderState()
-	//defer reader.Release()
-	//log.Debugf("[EncryptedRequestManager.DecodeRequest]: Resetting as: %v", body)
-	//reader.Buffer.Reset(body)
+// WithFieldEncryption configures private properties listed in classes to be
+// encrypted separately under the key of their sensitivity class. They stay
+// encrypted inside the state, unless the handler is allowed to read the class
+// with RequestResponseManager.WithFieldAccess. The state must implement
+// FieldSealedState.
+func (h *EncryptedRequestManager[T, R, D]) WithFieldEncryption(sealer states.FieldSealer, classes states.FieldClasses) *EncryptedRequestManager[T, R, D] {
+	h.fieldSealer = sealer
+	h.fieldClasses = classes
+	return h
+}
 
-	decoder := json.NewDecoder(bytes.NewReader(body))
-	if err = decoder.Decode(&req); err != nil {
+// DecodeRequest will decode request data bytes to request. The body is
+// decoded into the request from NewRequest, so a JSON null body results in
+// the new request instead of a nil request.
+func (h *EncryptedRequestManager[T, R, D]) DecodeRequest(body []byte) (R, error) {
+	req := h.NewRequest()
+	if err := json.Unmarshal(body, req); err != nil {
 		log.Errorf("[EncryptedRequestManager.DecodeRequest]: Bad body error: %v", err)
 		log.Debugf("[EncryptedRequestManager.DecodeRequest]: Bad body is: %v", body)
 		return req, errors.ErrBadRequestBodyError
@@ -54,35 +83,242 @@ func (h *EncryptedRequestManager[T, R, D]) DecodeRequest(body []byte) (R, error)
 	return req, nil
 }
 
-// DecryptState will decrypt optional private state from the request
+// DecryptState will decrypt optional private state from the request. States
+// encrypted with a previous key of the key ring are accepted, and since
+// EncryptState always uses the active key, they are transparently re-encrypted
+// on the next response.
 func (h *EncryptedRequestManager[T, R, D]) DecryptState(privateData string) (T, error) {
+	return h.DecryptStateWithAdditionalData(privateData)
+}
+
+// DecryptStateWithAdditionalData will decrypt optional private state from the
+// request, if it was encrypted with one of the candidates as the additional
+// authenticated data.
+func (h *EncryptedRequestManager[T, R, D]) DecryptStateWithAdditionalData(privateData string, candidates ...[]byte) (T, error) {
+	state, _, err := h.DecryptStateWithLifetime(privateData, candidates...)
+	return state, err
+}
+
+// DecryptStateWithLifetime will decrypt optional private state like
+// DecryptStateWithAdditionalData, and also returns the lifetime sealed with
+// the state. If tenant keys are configured, the state must be decrypted with
+// DecryptTenantStateWithLifetime instead.
+func (h *EncryptedRequestManager[T, R, D]) DecryptStateWithLifetime(privateData string, candidates ...[]byte) (T, encodings.Lifetime, error) {
+	return h.DecryptTenantStateWithLifetime(nil, privateData, candidates...)
+}
+
+// DecryptTenantStateWithLifetime will decrypt optional private state like
+// DecryptStateWithLifetime using the keys of the tenant. The tenant is ignored
+// unless tenant keys are configured.
+func (h *EncryptedRequestManager[T, R, D]) DecryptTenantStateWithLifetime(tenant []byte, privateData string, candidates ...[]byte) (T, encodings.Lifetime, error) {
+	return h.DecryptTenantStateFrom(tenant, []byte(privateData), candidates...)
+}
+
+// DecryptTenantStateFrom will decrypt optional private state like
+// DecryptTenantStateWithLifetime, but from text as bytes, e.g. a part of the
+// request body, using pooled memory
+func (h *EncryptedRequestManager[T, R, D]) DecryptTenantStateFrom(tenant []byte, privateData []byte, candidates ...[]byte) (T, encodings.Lifetime, error) {
 	state := h.NewState()
-	if privateData != "" {
-		if err := h.Decryptor.Decrypt(privateData, state); err != nil {
+	var lifetime encodings.Lifetime
+	if len(privateData) != 0 {
+		keys, err := h.tenantKeyRing(tenant)
+		if err != nil {
+			return state, lifetime, err
+		}
+		if keys != nil {
+			lifetime, err = h.Decryptor.DecryptFromWithKeyRing(keys, privateData, state, candidates...)
+		} else {
+			lifetime, err = h.Decryptor.DecryptFrom(privateData, state, candidates...)
+		}
+		if err == errors.ErrDecryptSchemaUpcastFailed {
+			log.Errorf("[EncryptedRequestManager.DecryptState] failed to upcast state: %v", err)
+			return state, lifetime, errors.ErrPrivateStateSchemaUnsupported
+		}
+		if err != nil {
 			log.Errorf("[EncryptedRequestManager.DecryptState] failed to decrypt state: %v", err)
-			return state, errors.ErrFailedToDecryptComputeState
+			return state, lifetime, errors.ErrFailedToDecryptComputeState
 		}
 	}
-	return state, nil
+	return state, lifetime, nil
 }
 
 // EncryptState will return the state as encrypted string
 func (h *EncryptedRequestManager[T, R, D]) EncryptState(state T) (string, error) {
-	var err error
+	return h.EncryptStateWithAdditionalData(state, nil)
+}
+
+// EncryptStateWithAdditionalData will return the state as encrypted string
+// which also authenticates the additional data
+func (h *EncryptedRequestManager[T, R, D]) EncryptStateWithAdditionalData(state T, additionalData []byte) (string, error) {
+	return h.EncryptStateWithLifetime(state, additionalData, encodings.Lifetime{})
+}
+
+// EncryptStateWithLifetime will return the state as encrypted string like
+// EncryptStateWithAdditionalData, and also seals the lifetime with the state.
+// If tenant keys are configured, the state is encrypted with the keys of its
+// tenant.
+func (h *EncryptedRequestManager[T, R, D]) EncryptStateWithLifetime(state T, additionalData []byte, lifetime encodings.Lifetime) (string, error) {
+	keys, err := h.stateKeyRing(state)
+	if err != nil {
+		return "", err
+	}
 	var private string
-	if private, err = h.Encryptor.Encrypt(state); err != nil {
+	if keys != nil {
+		private, err = h.Encryptor.EncryptWithKeyRing(keys, state, additionalData, lifetime)
+	} else {
+		private, err = h.Encryptor.EncryptWithLifetime(state, additionalData, lifetime)
+	}
+	if err != nil {
 		log.Errorf("[EncryptedRequestManager.EncryptState]: encrypting: error: %v", err)
 		return "", errors.ErrComputeStateEncryptionFailed
 	}
 	return private, nil
 }
 
+// EncryptStateTo will encrypt the state like EncryptStateWithLifetime, but
+// appends the text to dst instead of allocating a string, e.g. to the
+// response buffer
+func (h *EncryptedRequestManager[T, R, D]) EncryptStateTo(dst []byte, state T, additionalData []byte, lifetime encodings.Lifetime) ([]byte, error) {
+	keys, err := h.stateKeyRing(state)
+	if err != nil {
+		return dst, err
+	}
+	var text []byte
+	if keys != nil {
+		text, err = h.Encryptor.EncryptToWithKeyRing(keys, dst, state, additionalData, lifetime)
+	} else {
+		text, err = h.Encryptor.EncryptTo(dst, state, additionalData, lifetime)
+	}
+	if err != nil {
+		log.Errorf("[EncryptedRequestManager.EncryptStateTo]: encrypting: error: %v", err)
+		return dst, errors.ErrComputeStateEncryptionFailed
+	}
+	return text, nil
+}
+
+// stateKeyRing encrypts the private properties of the state by their class,
+// and returns the key ring of the tenant of the state, or nil to use the
+// server keys
+func (h *EncryptedRequestManager[T, R, D]) stateKeyRing(state T) (*encodings.KeyRing, error) {
+	if err := h.sealFields(state); err != nil {
+		return nil, err
+	}
+	var tenant []byte
+	if h.tenants != nil {
+		tenant = h.stateTenant(state)
+	}
+	return h.tenantKeyRing(tenant)
+}
+
+// DecryptStateStream will decrypt the state from a stream written by
+// EncryptStateStream, if it was encrypted with one of the candidates as the
+// additional authenticated data. Tenant keys are not supported, since the
+// tenant is not known before the state has been decrypted.
+func (h *EncryptedRequestManager[T, R, D]) DecryptStateStream(r io.Reader, candidates ...[]byte) (T, encodings.Lifetime, error) {
+	state := h.NewState()
+	if h.tenants != nil {
+		return state, encodings.Lifetime{}, errors.ErrDecryptStreamTenantKeysUnsupported
+	}
+	lifetime, err := h.Decryptor.DecryptStream(r, state, candidates...)
+	if err == errors.ErrDecryptSchemaUpcastFailed {
+		log.Errorf("[EncryptedRequestManager.DecryptStateStream] failed to upcast state: %v", err)
+		return state, lifetime, errors.ErrPrivateStreamSchemaUnsupported
+	}
+	if err != nil {
+		log.Errorf("[EncryptedRequestManager.DecryptStateStream] failed to decrypt state: %v", err)
+		return state, lifetime, errors.ErrFailedToDecryptStateStream
+	}
+	return state, lifetime, nil
+}
+
+// EncryptStateStream will encrypt the state as a stream to w, which also
+// authenticates the additional data and seals the lifetime with the state.
+// Tenant keys are not supported.
+func (h *EncryptedRequestManager[T, R, D]) EncryptStateStream(w io.Writer, state T, additionalData []byte, lifetime encodings.Lifetime) error {
+	if h.tenants != nil {
+		return errors.ErrEncryptStreamTenantKeysUnsupported
+	}
+	if err := h.sealFields(state); err != nil {
+		return err
+	}
+	if err := h.Encryptor.EncryptStream(w, state, additionalData, lifetime); err != nil {
+		log.Errorf("[EncryptedRequestManager.EncryptStateStream]: encrypting: error: %v", err)
+		return errors.ErrStateStreamEncryptionFailed
+	}
+	return nil
+}
+
+// tenantKeyRing returns the key ring of the tenant, or nil if tenant keys are
+// not configured
+func (h *EncryptedRequestManager[T, R, D]) tenantKeyRing(tenant []byte) (*encodings.KeyRing, error) {
+	if h.tenants == nil {
+		return nil, nil
+	}
+	keys, err := h.tenants.KeyRing(tenant)
+	switch err {
+	case nil:
+		return keys, nil
+	case errors.ErrTenantKeysRevoked:
+		log.Debugf("[EncryptedRequestManager.tenantKeyRing]: tenant %x has been revoked", tenant)
+		return nil, errors.ErrPrivateStateRevoked
+	case errors.ErrTenantKeysNoTenant:
+		log.Errorf("[EncryptedRequestManager.tenantKeyRing]: no tenant")
+		return nil, errors.ErrPrivateStateNoTenant
+	default:
+		log.Errorf("[EncryptedRequestManager.tenantKeyRing]: tenant %x: %v", tenant, err)
+		return nil, errors.ErrFailedToDeriveTenantKeys
+	}
+}
+
+// requestTenantOf returns the tenant of the request, or nil if tenant keys are
+// not configured
+func (h *EncryptedRequestManager[T, R, D]) requestTenantOf(req R) []byte {
+	if h.tenants == nil {
+		return nil
+	}
+	return h.requestTenant(req)
+}
+
+// sealFields encrypts classified private properties of the state, if field
+// encryption is configured
+func (h *EncryptedRequestManager[T, R, D]) sealFields(state T) error {
+	if h.fieldSealer == nil {
+		return nil
+	}
+	sealed, ok := any(state).(FieldSealedState)
+	if !ok {
+		log.Errorf("[EncryptedRequestManager.sealFields]: state %T does not support field encryption", state)
+		return errors.ErrStateFieldEncryptionNotSupported
+	}
+	return sealed.SealFields(h.fieldSealer, h.fieldClasses)
+}
+
+// openFields decrypts private properties of the allowed classes, if field
+// encryption is configured
+func (h *EncryptedRequestManager[T, R, D]) openFields(state T, allowed []string) error {
+	if h.fieldSealer == nil || len(allowed) == 0 {
+		return nil
+	}
+	sealed, ok := any(state).(FieldSealedState)
+	if !ok {
+		log.Errorf("[EncryptedRequestManager.openFields]: state %T does not support field decryption", state)
+		return errors.ErrStateFieldDecryptionNotSupported
+	}
+	return sealed.OpenFields(h.fieldSealer, allowed...)
+}
+
 // HandleWith configures a function to handle specific request path
 func (h *EncryptedRequestManager[T, R, D]) HandleWith(handleRequest ApiRequestHandlerFunc[T, R]) *RequestResponseManager[T, R, D] {
+	return h.HandleWithContext(func(_ context.Context, r R, state T) (T, error) {
+		return handleRequest(r, state)
+	})
+}
+
+// HandleWithContext configures a function to handle specific request path,
+// which also receives the context of the request
+func (h *EncryptedRequestManager[T, R, D]) HandleWithContext(handleRequest ApiContextRequestHandlerFunc[T, R]) *RequestResponseManager[T, R, D] {
 	return &RequestResponseManager[T, R, D]{
-		h,
-		handleRequest,
-		nil,
-		nil,
+		parent:        h,
+		handleRequest: handleRequest,
 	}
 }
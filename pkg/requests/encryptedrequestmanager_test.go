@@ -15,6 +15,31 @@ import (
 	"github.com/hyperifyio/statelessdb/pkg/states"
 )
 
+// newTestManager creates a request manager for compute states with the keys,
+// or with a new key if keys is nil
+func newTestManager(t *testing.T, keys *encodings.KeyRing, opts ...requests.Option) *requests.EncryptedRequestManager[*states.ComputeState, *requests.ComputeRequest, interface{}] {
+	if keys == nil {
+		key, err := encodings.GenerateKey(32)
+		if err != nil {
+			t.Fatalf("Failed to generate key: %v", err)
+		}
+		if keys, err = encodings.NewKeyRing(key); err != nil {
+			t.Fatalf("Failed to create key ring: %v", err)
+		}
+	}
+	manager, err := requests.NewRequestManager[*states.ComputeState, *requests.ComputeRequest, interface{}](
+		"ComputeState",
+		keys,
+		func() *states.ComputeState { return &states.ComputeState{} },
+		func() *requests.ComputeRequest { return &requests.ComputeRequest{} },
+		opts...,
+	)
+	if err != nil {
+		t.Fatalf("Failed to create request manager: %v", err)
+	}
+	return manager
+}
+
 // MockSerializer and MockUnserializer can be implemented if needed.
 // For this test, we are using the actual JsonSerializer and JsonUnserializer.
 
@@ -0,0 +1,68 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package requests_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+	"github.com/hyperifyio/statelessdb/pkg/requests"
+	"github.com/hyperifyio/statelessdb/pkg/states"
+)
+
+// FuzzEncryptedRequestManager_DecodeRequest tests that decoding any request
+// body does not panic, that an invalid body is rejected with
+// ErrBadRequestBodyError, and that a decoded request survives encoding it
+// again. The seed corpus is in
+// testdata/fuzz/FuzzEncryptedRequestManager_DecodeRequest.
+func FuzzEncryptedRequestManager_DecodeRequest(f *testing.F) {
+	serverKey, err := hex.DecodeString("a1ee74883d70fa9c4b5c9e5856ca58f99b26176be805d20d9c43fc4dbf880b91")
+	if err != nil {
+		f.Fatalf("Failed to decode private key: %v", err)
+	}
+
+	manager, err := requests.NewJsonRequestManager[*states.ComputeState, *requests.ComputeRequest, interface{}](
+		"ComputeState",
+		serverKey,
+		func() *states.ComputeState {
+			return states.NewComputeState(uuid.New(), uuid.New(), 0, 0, nil, nil, nil)
+		},
+		func() *requests.ComputeRequest {
+			return requests.NewComputeRequest(0, nil, "")
+		},
+	)
+	if err != nil {
+		f.Fatalf("Failed to create request manager: %v", err)
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte) {
+		request, err := manager.DecodeRequest(body)
+		if err != nil {
+			if err != errors.ErrBadRequestBodyError {
+				t.Fatalf("Expected ErrBadRequestBodyError, got %v", err)
+			}
+			return
+		}
+		if request == nil {
+			t.Fatalf("DecodeRequest returned a nil request for %q", body)
+		}
+
+		encoded, err := json.Marshal(request)
+		if err != nil {
+			t.Fatalf("Failed to encode the request: %v", err)
+		}
+		decoded, err := manager.DecodeRequest(encoded)
+		if err != nil {
+			t.Fatalf("DecodeRequest failed for an encoded request %s: %v", encoded, err)
+		}
+		if utf8.ValidString(request.Private()) && decoded.Private() != request.Private() {
+			t.Errorf("Expected private data '%s', got '%s'", request.Private(), decoded.Private())
+		}
+	})
+}
@@ -14,17 +14,66 @@ func NewJsonRequestManager[T interface{}, R Request, D interface{}](
 	newState func() T,
 	newRequest func() R,
 ) (*EncryptedRequestManager[T, R, D], error) {
+	keys, err := encodings2.NewKeyRing(serverKey)
+	if err != nil {
+		log.Errorf("Failed to initialize key ring: %v", err)
+		return nil, errors.ErrFailedToInitializeKeyRing
+	}
+	return NewJsonRequestManagerWithKeyRing[T, R, D](name, keys, newState, newRequest)
+}
+
+// NewJsonRequestManagerWithKeyRing creates a request manager which encrypts
+// with the active key of the ring and decrypts with any key of the ring.
+func NewJsonRequestManagerWithKeyRing[T interface{}, R Request, D interface{}](
+	name string,
+	keys *encodings2.KeyRing,
+	newState func() T,
+	newRequest func() R,
+) (*EncryptedRequestManager[T, R, D], error) {
+	return newEncryptedRequestManagerWithKeyRing[T, R, D](
+		keys,
+		encodings2.NewJsonSerializer[T](name),
+		encodings2.NewJsonUnserializer[T](name),
+		newState,
+		newRequest,
+	)
+}
+
+// NewCompressedJsonRequestManager creates a request manager like
+// NewJsonRequestManagerWithKeyRing, which also compresses states before
+// encryption. Uncompressed states are still accepted.
+func NewCompressedJsonRequestManager[T interface{}, R Request, D interface{}](
+	name string,
+	keys *encodings2.KeyRing,
+	compression encodings2.Compression,
+	newState func() T,
+	newRequest func() R,
+) (*EncryptedRequestManager[T, R, D], error) {
+	return newEncryptedRequestManagerWithKeyRing[T, R, D](
+		keys,
+		encodings2.NewCompressingSerializer[T](encodings2.NewJsonSerializer[T](name), compression, encodings2.DefaultCompressionThreshold),
+		encodings2.NewCompressingUnserializer[T](encodings2.NewJsonUnserializer[T](name)),
+		newState,
+		newRequest,
+	)
+}
+
+func newEncryptedRequestManagerWithKeyRing[T interface{}, R Request, D interface{}](
+	keys *encodings2.KeyRing,
+	serializer encodings2.Serializer[T],
+	unserializer encodings2.Unserializer[T],
+	newState func() T,
+	newRequest func() R,
+) (*EncryptedRequestManager[T, R, D], error) {
 
-	serializer := encodings2.NewJsonSerializer[T](name)
 	encryptor := encodings2.NewEncryptor[T](serializer)
-	if err := encryptor.Initialize(serverKey); err != nil {
+	if err := encryptor.InitializeKeyRing(keys); err != nil {
 		log.Errorf("Failed to initialize encryptor: %v", err)
 		return nil, errors.ErrFailedToInitializeEncryptor
 	}
 
-	unserializer := encodings2.NewJsonUnserializer[T](name)
 	decryptor := encodings2.NewDecryptor[T](unserializer)
-	if err := decryptor.Initialize(serverKey); err != nil {
+	if err := decryptor.InitializeKeyRing(keys); err != nil {
 		log.Errorf("Failed to initialize decryptor: %v", err)
 		return nil, errors.ErrFailedToInitializeDecryptor
 	}
@@ -0,0 +1,113 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package requests
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+	"github.com/hyperifyio/statelessdb/pkg/metrics"
+)
+
+// Middleware wraps a request handler, e.g. to check, measure or log the
+// decrypted state before and after the next handler. It is added to a route
+// with RequestResponseManager.Use.
+type Middleware[T interface{}, R Request] func(next ApiContextRequestHandlerFunc[T, R]) ApiContextRequestHandlerFunc[T, R]
+
+// RequestHookFunc is called with the decoded request before the private
+// state is decrypted. If it returns an error, the request fails without
+// decrypting the state.
+type RequestHookFunc[R Request] func(ctx context.Context, r R) error
+
+// StateHookFunc is called with the request and the decrypted state, e.g.
+// after decrypting or before encrypting it. The state is the zero value for
+// a new resource before the handler has been called. If it returns an error,
+// the request fails.
+type StateHookFunc[T interface{}, R Request] func(ctx context.Context, r R, state T) error
+
+// ResponseHookFunc is called with the state and the response DTO after the
+// response has been signed, e.g. to publish the response. The response must
+// not be changed, since it is sent to the client as it is.
+type ResponseHookFunc[T interface{}] func(state T, dto interface{})
+
+// RecoverMiddleware turns a panic in the handler into
+// ErrRequestHandlerPanicked, so one bad request does not stop the server
+func RecoverMiddleware[T interface{}, R Request]() Middleware[T, R] {
+	return func(next ApiContextRequestHandlerFunc[T, R]) ApiContextRequestHandlerFunc[T, R] {
+		return func(ctx context.Context, r R, state T) (result T, err error) {
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					log.Errorf("[RecoverMiddleware]: Handler panicked: %v", recovered)
+					var zero T
+					result, err = zero, errors.ErrRequestHandlerPanicked
+				}
+			}()
+			return next(ctx, r, state)
+		}
+	}
+}
+
+// MetricsMiddleware records how long the handler of the route took, and
+// whether it failed, as metrics.RequestHandlerDuration
+func MetricsMiddleware[T interface{}, R Request](route string) Middleware[T, R] {
+	return func(next ApiContextRequestHandlerFunc[T, R]) ApiContextRequestHandlerFunc[T, R] {
+		return func(ctx context.Context, r R, state T) (T, error) {
+			start := time.Now()
+			result, err := next(ctx, r, state)
+			metrics.RecordRequestHandlerDurationMetric(route, err == nil, time.Since(start))
+			return result, err
+		}
+	}
+}
+
+// AuditLogMiddleware logs every request of the route, whether it created a
+// new resource or changed an existing one, and how it ended
+func AuditLogMiddleware[T interface{}, R Request](route string) Middleware[T, R] {
+	return func(next ApiContextRequestHandlerFunc[T, R]) ApiContextRequestHandlerFunc[T, R] {
+		return func(ctx context.Context, r R, state T) (T, error) {
+			start := time.Now()
+			result, err := next(ctx, r, state)
+			action := "update"
+			if r.Private() == "" {
+				action = "create"
+			}
+			if err != nil {
+				log.Infof("[AuditLogMiddleware]: %s: %s failed in %s: %v", route, action, time.Since(start), err)
+			} else {
+				log.Infof("[AuditLogMiddleware]: %s: %s succeeded in %s", route, action, time.Since(start))
+			}
+			return result, err
+		}
+	}
+}
+
+// ConcurrencyLimitMiddleware limits how many requests the handler processes
+// at the same time. Other requests wait for their turn until their context
+// is done, e.g. the client goes away or the timeout of the route passes.
+func ConcurrencyLimitMiddleware[T interface{}, R Request](limit int) Middleware[T, R] {
+	slots := make(chan struct{}, limit)
+	return func(next ApiContextRequestHandlerFunc[T, R]) ApiContextRequestHandlerFunc[T, R] {
+		return func(ctx context.Context, r R, state T) (T, error) {
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+				return next(ctx, r, state)
+			case <-ctx.Done():
+				var zero T
+				return zero, ctx.Err()
+			}
+		}
+	}
+}
+
+// RequireState is a hook for RequestResponseManager.BeforeDecrypt which
+// rejects requests without private state with ErrPrivateStateRequired, e.g.
+// on routes which cannot create resources
+func RequireState[R Request](_ context.Context, r R) error {
+	if r.Private() == "" {
+		return errors.ErrPrivateStateRequired
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package requests_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+	"github.com/hyperifyio/statelessdb/pkg/requests"
+	"github.com/hyperifyio/statelessdb/pkg/states"
+)
+
+type testMiddleware = requests.Middleware[*states.ComputeState, *requests.ComputeRequest]
+
+// recordMiddleware records when the request enters and leaves it
+func recordMiddleware(name string, calls *[]string) testMiddleware {
+	return func(next requests.ApiContextRequestHandlerFunc[*states.ComputeState, *requests.ComputeRequest]) requests.ApiContextRequestHandlerFunc[*states.ComputeState, *requests.ComputeRequest] {
+		return func(ctx context.Context, r *requests.ComputeRequest, state *states.ComputeState) (*states.ComputeState, error) {
+			*calls = append(*calls, name+" before")
+			state, err := next(ctx, r, state)
+			*calls = append(*calls, name+" after")
+			return state, err
+		}
+	}
+}
+
+func TestRequestResponseManager_Use(t *testing.T) {
+	manager := newTestManager(t, nil)
+	var calls []string
+	handler := manager.HandleWith(func(r *requests.ComputeRequest, state *states.ComputeState) (*states.ComputeState, error) {
+		calls = append(calls, "handler")
+		return testBindingHandler(r, state)
+	}).WithResponse(testBindingResponseHandler)
+	handler.Use(recordMiddleware("first", &calls), recordMiddleware("second", &calls)).Use(recordMiddleware("third", &calls))
+
+	if _, err := processTestBindingRequest(t, handler, `{}`); err != nil {
+		t.Fatalf("Processing the request failed: %v", err)
+	}
+	expected := "first before, second before, third before, handler, third after, second after, first after"
+	if strings.Join(calls, ", ") != expected {
+		t.Errorf("Expected calls %s, got %s", expected, strings.Join(calls, ", "))
+	}
+}
+
+func TestRequestResponseManager_Hooks(t *testing.T) {
+	manager := newTestManager(t, nil)
+	var calls []string
+	handler := manager.HandleWith(testBindingHandler).WithResponse(testBindingResponseHandler)
+	handler.BeforeDecrypt(func(ctx context.Context, r *requests.ComputeRequest) error {
+		calls = append(calls, fmt.Sprintf("before decrypt %v", r.Private() != ""))
+		return nil
+	})
+	handler.AfterDecrypt(func(ctx context.Context, r *requests.ComputeRequest, state *states.ComputeState) error {
+		calls = append(calls, fmt.Sprintf("after decrypt %v", state != nil))
+		return nil
+	})
+	handler.BeforeEncrypt(func(ctx context.Context, r *requests.ComputeRequest, state *states.ComputeState) error {
+		calls = append(calls, fmt.Sprintf("before encrypt %v", state != nil))
+		return nil
+	})
+
+	created, err := processTestBindingRequest(t, handler, `{}`)
+	if err != nil {
+		t.Fatalf("Creating a resource failed: %v", err)
+	}
+	if _, err = processTestBindingRequest(t, handler, fmt.Sprintf(`{"private":%q}`, created.Private)); err != nil {
+		t.Fatalf("Updating the resource failed: %v", err)
+	}
+	expected := "before decrypt false, after decrypt false, before encrypt true, before decrypt true, after decrypt true, before encrypt true"
+	if strings.Join(calls, ", ") != expected {
+		t.Errorf("Expected calls %s, got %s", expected, strings.Join(calls, ", "))
+	}
+}
+
+func TestRequestResponseManager_HookErrors(t *testing.T) {
+	manager := newTestManager(t, nil)
+	hookErr := fmt.Errorf("hook failed")
+	failRequest := func(ctx context.Context, r *requests.ComputeRequest) error {
+		return hookErr
+	}
+	failState := func(ctx context.Context, r *requests.ComputeRequest, state *states.ComputeState) error {
+		return hookErr
+	}
+
+	tests := []struct {
+		name      string
+		configure func(handler *requests.RequestResponseManager[*states.ComputeState, *requests.ComputeRequest, interface{}])
+		handled   bool
+	}{
+		{"before decrypt", func(handler *requests.RequestResponseManager[*states.ComputeState, *requests.ComputeRequest, interface{}]) {
+			handler.BeforeDecrypt(failRequest)
+		}, false},
+		{"after decrypt", func(handler *requests.RequestResponseManager[*states.ComputeState, *requests.ComputeRequest, interface{}]) {
+			handler.AfterDecrypt(failState)
+		}, false},
+		{"before encrypt", func(handler *requests.RequestResponseManager[*states.ComputeState, *requests.ComputeRequest, interface{}]) {
+			handler.BeforeEncrypt(failState)
+		}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handled := false
+			handler := manager.HandleWith(func(r *requests.ComputeRequest, state *states.ComputeState) (*states.ComputeState, error) {
+				handled = true
+				return testBindingHandler(r, state)
+			}).WithResponse(testBindingResponseHandler)
+			test.configure(handler)
+			if _, err := processTestBindingRequest(t, handler, `{}`); err != hookErr {
+				t.Errorf("Expected the error of the hook, got %v", err)
+			}
+			if handled != test.handled {
+				t.Errorf("Expected the handler to be called %v, got %v", test.handled, handled)
+			}
+		})
+	}
+}
+
+func TestRecoverMiddleware(t *testing.T) {
+	manager := newTestManager(t, nil)
+	handler := manager.HandleWith(func(r *requests.ComputeRequest, state *states.ComputeState) (*states.ComputeState, error) {
+		panic("bad request")
+	}).WithResponse(testBindingResponseHandler)
+	handler.Use(requests.RecoverMiddleware[*states.ComputeState, *requests.ComputeRequest]())
+
+	if _, err := processTestBindingRequest(t, handler, `{}`); err != errors.ErrRequestHandlerPanicked {
+		t.Errorf("Expected ErrRequestHandlerPanicked, got %v", err)
+	}
+}
+
+func TestMetricsAndAuditLogMiddleware(t *testing.T) {
+	manager := newTestManager(t, nil)
+	handler := manager.HandleWith(testBindingHandler).WithResponse(testBindingResponseHandler)
+	handler.Use(
+		requests.MetricsMiddleware[*states.ComputeState, *requests.ComputeRequest]("test"),
+		requests.AuditLogMiddleware[*states.ComputeState, *requests.ComputeRequest]("test"),
+	)
+
+	if _, err := processTestBindingRequest(t, handler, `{}`); err != nil {
+		t.Errorf("Processing the request failed: %v", err)
+	}
+}
+
+func TestConcurrencyLimitMiddleware(t *testing.T) {
+	manager := newTestManager(t, nil)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := manager.HandleWithContext(func(ctx context.Context, r *requests.ComputeRequest, state *states.ComputeState) (*states.ComputeState, error) {
+		started <- struct{}{}
+		<-release
+		return testBindingHandler(r, state)
+	}).WithResponse(testBindingResponseHandler)
+	handler.Use(requests.ConcurrencyLimitMiddleware[*states.ComputeState, *requests.ComputeRequest](1))
+
+	done := make(chan error)
+	go func() {
+		_, err := handler.ProcessBytes(context.Background(), []byte(`{}`))
+		done <- err
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := handler.ProcessBytes(ctx, []byte(`{}`)); err != context.DeadlineExceeded {
+		t.Errorf("Expected context.DeadlineExceeded while waiting, got %v", err)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Errorf("Processing the first request failed: %v", err)
+	}
+}
+
+func TestRequireState(t *testing.T) {
+	manager := newTestManager(t, nil)
+	create := manager.HandleWith(testBindingHandler).WithResponse(testBindingResponseHandler)
+	update := manager.HandleWith(testBindingHandler).WithResponse(testBindingResponseHandler).BeforeDecrypt(requests.RequireState[*requests.ComputeRequest])
+
+	if _, err := processTestBindingRequest(t, update, `{}`); err != errors.ErrPrivateStateRequired {
+		t.Errorf("Expected ErrPrivateStateRequired, got %v", err)
+	}
+	created, err := processTestBindingRequest(t, create, `{}`)
+	if err != nil {
+		t.Fatalf("Creating a resource failed: %v", err)
+	}
+	if _, err = processTestBindingRequest(t, update, fmt.Sprintf(`{"private":%q}`, created.Private)); err != nil {
+		t.Errorf("Request with private state should be accepted: %v", err)
+	}
+}
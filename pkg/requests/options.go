@@ -0,0 +1,132 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package requests
+
+import (
+	encodings2 "github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+// managerOptions are the options of NewRequestManager
+type managerOptions struct {
+	format      encodings2.Format
+	compression encodings2.Compression
+	threshold   int
+	schema      *encodings2.SchemaRegistry
+}
+
+// Option configures a request manager created with NewRequestManager
+type Option func(options *managerOptions)
+
+// WithFormat sets the serialization format of private data. Defaults to JSON.
+func WithFormat(format encodings2.Format) Option {
+	return func(options *managerOptions) {
+		options.format = format
+	}
+}
+
+// WithCompression compresses private data before encryption when it is at
+// least the compression threshold. Uncompressed data is still accepted.
+func WithCompression(compression encodings2.Compression) Option {
+	return func(options *managerOptions) {
+		options.compression = compression
+	}
+}
+
+// WithCompressionThreshold sets the size in bytes of the smallest private
+// data which is compressed. Defaults to encodings.DefaultCompressionThreshold.
+func WithCompressionThreshold(threshold int) Option {
+	return func(options *managerOptions) {
+		options.threshold = threshold
+	}
+}
+
+// WithSchema seals the current schema version of the registry with states,
+// and upcasts states of older versions when they are decrypted
+func WithSchema(schema *encodings2.SchemaRegistry) Option {
+	return func(options *managerOptions) {
+		options.schema = schema
+	}
+}
+
+// NewRequestManager creates a request manager which encrypts with the active
+// key of the ring and decrypts with any key of the ring. The serialization
+// format and compression are set with options, e.g.
+// WithFormat(encodings.FormatCbor).
+func NewRequestManager[T interface{}, R Request, D interface{}](
+	name string,
+	keys *encodings2.KeyRing,
+	newState func() T,
+	newRequest func() R,
+	opts ...Option,
+) (*EncryptedRequestManager[T, R, D], error) {
+
+	options := managerOptions{
+		format:      encodings2.FormatJson,
+		compression: encodings2.CompressionNone,
+		threshold:   encodings2.DefaultCompressionThreshold,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	serializer, err := encodings2.NewFormatSerializer[T](options.format, name)
+	if err != nil {
+		log.Errorf("Failed to create serializer for %s: %v", options.format, err)
+		return nil, errors.ErrFailedToInitializeEncryptor
+	}
+
+	unserializer, err := newOptionsUnserializer[T](options, options.format, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.compression != encodings2.CompressionNone {
+		serializer = encodings2.NewCompressingSerializer[T](serializer, options.compression, options.threshold)
+	}
+
+	manager, err := newEncryptedRequestManagerWithKeyRing[T, R, D](
+		keys,
+		serializer,
+		unserializer,
+		newState,
+		newRequest,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if options.schema != nil {
+		manager.Encryptor.WithSchema(options.schema)
+	}
+
+	// States serialized in other formats are still accepted, since the
+	// format is recorded in the envelope
+	for _, format := range encodings2.Formats() {
+		if format == options.format {
+			continue
+		}
+		other, err := newOptionsUnserializer[T](options, format, name)
+		if err != nil {
+			return nil, err
+		}
+		manager.Decryptor.WithUnserializer(format, other)
+	}
+	return manager, nil
+}
+
+// newOptionsUnserializer returns an unserializer for the format which upcasts
+// states as configured by options and decompresses them
+func newOptionsUnserializer[T interface{}](options managerOptions, format encodings2.Format, name string) (encodings2.Unserializer[T], error) {
+	unserializer, err := encodings2.NewFormatUnserializer[T](format, name)
+	if err != nil {
+		log.Errorf("Failed to create unserializer for %s: %v", format, err)
+		return nil, errors.ErrFailedToInitializeDecryptor
+	}
+	if options.schema != nil {
+		unserializer = encodings2.NewUpcastingUnserializer[T](unserializer, options.schema)
+	}
+	// Compressed states are accepted even if compression is disabled, since
+	// the envelope tells whether the state was compressed
+	return encodings2.NewCompressingUnserializer[T](unserializer), nil
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package requests_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+	"github.com/hyperifyio/statelessdb/pkg/requests"
+	"github.com/hyperifyio/statelessdb/pkg/states"
+)
+
+func TestNewRequestManager_WithFormat(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	keys, err := encodings.NewKeyRing(key)
+	if err != nil {
+		t.Fatalf("Failed to create key ring: %v", err)
+	}
+
+	for _, format := range encodings.Formats() {
+		for _, compression := range encodings.Compressions() {
+			t.Run(format.String()+"/"+compression.String(), func(t *testing.T) {
+				manager := newTestManager(t, keys, requests.WithFormat(format), requests.WithCompression(compression))
+
+				state := states.NewComputeState(uuid.New(), uuid.New(), 1, 2, map[string]interface{}{"name": "public"}, map[string]interface{}{"secret": "private"}, nil)
+				private, err := manager.EncryptState(state)
+				if err != nil {
+					t.Fatalf("EncryptState failed: %v", err)
+				}
+
+				decrypted, err := manager.DecryptState(private)
+				if err != nil {
+					t.Fatalf("DecryptState failed: %v", err)
+				}
+				if !decrypted.Equals(state) {
+					t.Errorf("Decrypted state does not match original.\nOriginal: %v\nDecrypted: %v", state, decrypted)
+				}
+			})
+		}
+	}
+}
+
+// TestNewRequestManager_CompressionChanged tests that states are accepted
+// after compression has been enabled or disabled
+func TestNewRequestManager_CompressionChanged(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	keys, err := encodings.NewKeyRing(key)
+	if err != nil {
+		t.Fatalf("Failed to create key ring: %v", err)
+	}
+
+	state := states.NewComputeState(uuid.New(), uuid.New(), 1, 2, nil, map[string]interface{}{"secret": strings.Repeat("private", 100)}, nil)
+	for _, format := range encodings.Formats() {
+		t.Run(format.String(), func(t *testing.T) {
+			compressed := newTestManager(t, keys, requests.WithFormat(format), requests.WithCompression(encodings.CompressionZstd))
+			uncompressed := newTestManager(t, keys, requests.WithFormat(format))
+			for _, managers := range [][2]*requests.EncryptedRequestManager[*states.ComputeState, *requests.ComputeRequest, interface{}]{
+				{compressed, uncompressed},
+				{uncompressed, compressed},
+			} {
+				private, err := managers[0].EncryptState(state)
+				if err != nil {
+					t.Fatalf("EncryptState failed: %v", err)
+				}
+				decrypted, err := managers[1].DecryptState(private)
+				if err != nil {
+					t.Fatalf("DecryptState failed: %v", err)
+				}
+				if !decrypted.Equals(state) {
+					t.Errorf("Decrypted state does not match original.\nOriginal: %v\nDecrypted: %v", state, decrypted)
+				}
+			}
+		})
+	}
+}
+
+func TestNewRequestManager_DefaultsToJson(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	keys, err := encodings.NewKeyRing(key)
+	if err != nil {
+		t.Fatalf("Failed to create key ring: %v", err)
+	}
+
+	state := states.NewComputeState(uuid.New(), uuid.New(), 1, 2, nil, map[string]interface{}{"secret": "private"}, nil)
+	private, err := newTestManager(t, keys).EncryptState(state)
+	if err != nil {
+		t.Fatalf("EncryptState failed: %v", err)
+	}
+
+	jsonManager, err := requests.NewJsonRequestManagerWithKeyRing[*states.ComputeState, *requests.ComputeRequest, interface{}](
+		"ComputeState",
+		keys,
+		func() *states.ComputeState { return &states.ComputeState{} },
+		func() *requests.ComputeRequest { return &requests.ComputeRequest{} },
+	)
+	if err != nil {
+		t.Fatalf("Failed to create request manager: %v", err)
+	}
+	if _, err := jsonManager.DecryptState(private); err != nil {
+		t.Errorf("JSON request manager should decrypt the default format: %v", err)
+	}
+}
+
+func TestNewRequestManager_UnsupportedFormat(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	keys, err := encodings.NewKeyRing(key)
+	if err != nil {
+		t.Fatalf("Failed to create key ring: %v", err)
+	}
+	_, err = requests.NewRequestManager[*states.ComputeState, *requests.ComputeRequest, interface{}](
+		"ComputeState",
+		keys,
+		func() *states.ComputeState { return &states.ComputeState{} },
+		func() *requests.ComputeRequest { return &requests.ComputeRequest{} },
+		requests.WithFormat(encodings.Format(0)),
+	)
+	if err != errors.ErrFailedToInitializeEncryptor {
+		t.Errorf("Expected ErrFailedToInitializeEncryptor, got %v", err)
+	}
+}
+
+func TestNewRequestManager_WithSchema(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	keys, err := encodings.NewKeyRing(key)
+	if err != nil {
+		t.Fatalf("Failed to create key ring: %v", err)
+	}
+
+	v1 := newTestManager(t, keys, requests.WithSchema(encodings.NewSchemaRegistry(1)))
+	v2 := newTestManager(t, keys, requests.WithSchema(encodings.NewSchemaRegistry(2).Register(1, encodings.JsonUpcaster(func(properties map[string]interface{}) error {
+		private := properties["private"].(map[string]interface{})
+		private["email"] = private["mail"]
+		delete(private, "mail")
+		return nil
+	}))))
+
+	state := states.NewComputeState(uuid.New(), uuid.New(), 1, 2, nil, map[string]interface{}{"mail": "user@example.com"}, nil)
+	private, err := v1.EncryptState(state)
+	if err != nil {
+		t.Fatalf("EncryptState failed: %v", err)
+	}
+
+	upcast, err := v2.DecryptState(private)
+	if err != nil {
+		t.Fatalf("DecryptState failed: %v", err)
+	}
+	if upcast.Private["email"] != "user@example.com" {
+		t.Errorf("Expected the upcast property, got %v", upcast.Private)
+	}
+
+	private, err = v2.EncryptState(upcast)
+	if err != nil {
+		t.Fatalf("EncryptState failed: %v", err)
+	}
+	if _, err = v1.DecryptState(private); err != errors.ErrPrivateStateSchemaUnsupported {
+		t.Errorf("Expected ErrPrivateStateSchemaUnsupported, got %v", err)
+	}
+}
+
+func TestNewRequestManager_WithCompressionThreshold(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	keys, err := encodings.NewKeyRing(key)
+	if err != nil {
+		t.Fatalf("Failed to create key ring: %v", err)
+	}
+	state := states.NewComputeState(uuid.New(), uuid.New(), 1, 2, nil, map[string]interface{}{"secret": strings.Repeat("x", 4000)}, nil)
+
+	compressed := newTestManager(t, keys, requests.WithCompression(encodings.CompressionFlate))
+	uncompressed := newTestManager(t, keys, requests.WithCompression(encodings.CompressionFlate), requests.WithCompressionThreshold(1<<20))
+	var sizes []int
+	for _, manager := range []*requests.EncryptedRequestManager[*states.ComputeState, *requests.ComputeRequest, interface{}]{compressed, uncompressed} {
+		private, err := manager.EncryptState(state)
+		if err != nil {
+			t.Fatalf("EncryptState failed: %v", err)
+		}
+		envelope, err := encodings.InspectEnvelope(private)
+		if err != nil {
+			t.Fatalf("InspectEnvelope failed: %v", err)
+		}
+		sizes = append(sizes, len(envelope.Ciphertext))
+		if _, err = compressed.DecryptState(private); err != nil {
+			t.Errorf("DecryptState failed: %v", err)
+		}
+	}
+	if sizes[0] >= 4000 || sizes[1] < 4000 {
+		t.Errorf("Expected only data above the threshold to be compressed, got sizes %v", sizes)
+	}
+}
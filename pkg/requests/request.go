@@ -6,3 +6,26 @@ package requests
 type Request interface {
 	Private() string // Private returns encrypted private data as a string
 }
+
+// SignedRequest is implemented by requests which send back a resource with
+// signed public properties
+type SignedRequest interface {
+	SignedData() []byte     // SignedData returns the data which was signed
+	SignedIdentity() []byte // SignedIdentity returns the signed identity of the resource, e.g. the id, owner and times
+	Signature() string      // Signature returns the signature from the previous response
+}
+
+// SignedState is implemented by states of routes with signed public
+// properties. The data is signed in responses, so every route which returns
+// the state signs the same data. The identity must match the SignedIdentity
+// of requests which send the state back.
+type SignedState interface {
+	SignedData() []byte     // SignedData returns the data which is signed in responses
+	SignedIdentity() []byte // SignedIdentity returns the identity of the resource as it is signed in responses
+}
+
+// SignedResponse is implemented by response DTOs which carry a signature of
+// the public properties of the state
+type SignedResponse interface {
+	SetSignature(signature string) // SetSignature sets the signature
+}
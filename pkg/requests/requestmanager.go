@@ -4,16 +4,30 @@
 package requests
 
 import (
+	"context"
+
 	"github.com/hyperifyio/statelessdb/pkg/encodings"
 )
 
 type ApiRequestHandlerFunc[T interface{}, R Request] func(r R, state T) (T, error)
 
+// ApiContextRequestHandlerFunc is a request handler which also receives the
+// context of the request. The context is canceled when the client goes away,
+// or when the deadline of the route passes, so a handler which waits should
+// stop waiting when it is done.
+type ApiContextRequestHandlerFunc[T interface{}, R Request] func(ctx context.Context, r R, state T) (T, error)
+
 type ApiBytesRequestHandlerFunc func(body []byte) (encodings.SerializerState, error)
 
 type RequestManager[T interface{}, R Request, D interface{}] interface {
 	DecodeRequest(body []byte) (R, error)
 	DecryptState(private string) (T, error)
+	DecryptStateWithAdditionalData(private string, candidates ...[]byte) (T, error)
+	DecryptStateWithLifetime(private string, candidates ...[]byte) (T, encodings.Lifetime, error)
+	DecryptTenantStateWithLifetime(tenant []byte, private string, candidates ...[]byte) (T, encodings.Lifetime, error)
 	EncryptState(state T) (string, error)
+	EncryptStateWithAdditionalData(state T, additionalData []byte) (string, error)
+	EncryptStateWithLifetime(state T, additionalData []byte, lifetime encodings.Lifetime) (string, error)
 	HandleWith(handleRequest ApiRequestHandlerFunc[T, R]) *RequestResponseManager[T, R, D]
+	HandleWithContext(handleRequest ApiContextRequestHandlerFunc[T, R]) *RequestResponseManager[T, R, D]
 }
@@ -3,64 +3,285 @@
 
 package requests
 
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"time"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+	"github.com/hyperifyio/statelessdb/pkg/states"
+)
+
 type ResponseManager interface {
-	ProcessBytes(body []byte) (interface{}, error)
+	ProcessBytes(ctx context.Context, body []byte) (interface{}, error)
 	Methods() []string
 }
 
+// StreamResponseManager is implemented by response managers which can also
+// process a state sent as an encrypted stream, e.g. RequestResponseManager
+type StreamResponseManager interface {
+	ResponseManager
+	ProcessStream(ctx context.Context, body io.Reader, w io.Writer) error
+}
+
+// BufferedResponseManager is implemented by response managers which can
+// append the JSON response to a buffer, e.g. RequestResponseManager
+type BufferedResponseManager interface {
+	ResponseManager
+	ProcessBytesTo(ctx context.Context, dst []byte, body []byte) ([]byte, error)
+}
+
 type CreateResponseFunc[T interface{}] func(state T, private string) interface{}
 
+// StateIdentityFunc returns the identity of the resource from a state
+type StateIdentityFunc[T interface{}] func(state T) []byte
+
+// RequestIdentityFunc returns the identity of the resource from a request
+type RequestIdentityFunc[R Request] func(r R) []byte
+
 type RequestResponseManager[T interface{}, R Request, D interface{}] struct {
-	parent         *EncryptedRequestManager[T, R, D]
-	handleRequest  ApiRequestHandlerFunc[T, R]
-	handleResponse CreateResponseFunc[T]
-	methods        []string
+	parent          *EncryptedRequestManager[T, R, D]
+	handleRequest   ApiContextRequestHandlerFunc[T, R]
+	middlewares     []Middleware[T, R]                 // middlewares wrap handleRequest, the first one outermost
+	chain           ApiContextRequestHandlerFunc[T, R] // chain is handleRequest wrapped in middlewares, or nil
+	beforeDecrypt   []RequestHookFunc[R]               // beforeDecrypt is called before the state is decrypted
+	afterDecrypt    []StateHookFunc[T, R]              // afterDecrypt is called after the state is decrypted
+	beforeEncrypt   []StateHookFunc[T, R]              // beforeEncrypt is called before the state is encrypted
+	afterResponse   []ResponseHookFunc[T]              // afterResponse is called after the response has been signed
+	handleResponse  CreateResponseFunc[T]
+	methods         []string
+	purpose         string                  // purpose is authenticated with the encrypted state
+	accepted        []string                // accepted contains purposes which are accepted when decrypting
+	responsePurpose string                  // responsePurpose is authenticated with the state in responses instead of purpose, or empty
+	stateIdentity   StateIdentityFunc[T]    // stateIdentity returns the resource identity authenticated when encrypting
+	requestIdentity RequestIdentityFunc[R]  // requestIdentity returns the resource identity expected when decrypting
+	ttl             int64                   // ttl is how long encrypted states are accepted in milliseconds, or zero
+	timeout         time.Duration           // timeout is how long the handler may process a request, or zero
+	fieldAccess     []string                // fieldAccess contains sensitivity classes the handler is allowed to read
+	signer          *encodings.PublicSigner // signer signs public properties of responses, or nil
 }
 
-var _ ResponseManager = &RequestResponseManager[any, Request, any]{}
+var _ StreamResponseManager = &RequestResponseManager[any, Request, any]{}
+var _ BufferedResponseManager = &RequestResponseManager[any, Request, any]{}
 
-// ProcessBytes decodes, decrypts, processes, and encrypts results for a request
-func (r *RequestResponseManager[T, R, D]) ProcessBytes(body []byte) (interface{}, error) {
+// ProcessBytes decodes, decrypts, processes, and encrypts results for a
+// request. The hooks and the handler receive ctx, limited by the timeout of
+// the route. If ctx is done when the handler returns, the error of ctx is
+// returned instead of encrypting the state, since nobody is waiting for it.
+func (r *RequestResponseManager[T, R, D]) ProcessBytes(ctx context.Context, body []byte) (interface{}, error) {
 
-	//log.Debugf("ProcessBytes: Decoding %v", body)
-	req, err := r.parent.DecodeRequest(body)
+	ctx, cancel := r.requestContext(ctx)
+	defer cancel()
+
+	state, err := r.processBody(ctx, body)
 	if err != nil {
 		var dto interface{}
 		return dto, err
 	}
 
+	//log.Debugf("ProcessBytes: Encrypting state: %v", state)
+	private, err := r.parent.EncryptStateWithLifetime(state, r.stateAdditionalData(state), r.newLifetime())
+	if err != nil {
+		var dto interface{}
+		return dto, err
+	}
+
+	//log.Debugf("ProcessBytes: Handling response: %v with %s", state, private)
+	return r.newResponse(state, private), nil
+}
+
+// ProcessBytesTo processes a request like ProcessBytes, and appends the
+// response as JSON to dst. The state is encrypted before the response is
+// created, so the response handler, and anything it publishes the response
+// to, only ever sees the real private data.
+func (r *RequestResponseManager[T, R, D]) ProcessBytesTo(ctx context.Context, dst []byte, body []byte) ([]byte, error) {
+
+	ctx, cancel := r.requestContext(ctx)
+	defer cancel()
+
+	state, err := r.processBody(ctx, body)
+	if err != nil {
+		return dst, err
+	}
+
+	private, err := r.parent.EncryptStateWithLifetime(state, r.stateAdditionalData(state), r.newLifetime())
+	if err != nil {
+		return dst, err
+	}
+
+	encoder := encodings.GetJsonEncoderState()
+	defer encoder.Release()
+	if err = encoder.Encoder.Encode(r.newResponse(state, private)); err != nil {
+		log.Errorf("[RequestResponseManager.ProcessBytesTo]: encoding: error: %v", err)
+		return dst, errors.ErrRequestEncodingError
+	}
+	return append(dst, encoder.Bytes()...), nil
+}
+
+// newResponse creates the response DTO with the response handler, signs it,
+// and calls the hooks after the response. It returns nil if there is no
+// response handler.
+func (r *RequestResponseManager[T, R, D]) newResponse(state T, private string) interface{} {
+	var dto interface{}
+	if r.handleResponse == nil {
+		return dto
+	}
+	dto = r.signResponse(state, r.handleResponse(state, private))
+	for _, hook := range r.afterResponse {
+		hook(state, dto)
+	}
+	return dto
+}
+
+// processBody decodes and decrypts the request, and processes the state
+func (r *RequestResponseManager[T, R, D]) processBody(ctx context.Context, body []byte) (T, error) {
 	var state T
+
+	//log.Debugf("ProcessBytes: Decoding %v", body)
+	req, err := r.parent.DecodeRequest(body)
+	if err != nil {
+		return state, err
+	}
+
+	if err = r.runBeforeDecrypt(ctx, req); err != nil {
+		return state, err
+	}
+
 	privateString := req.Private()
 	if privateString != "" {
+		if err = r.verifySignature(req); err != nil {
+			return state, err
+		}
+
+		// The private data is decrypted from the body, unless it was escaped
+		private := privateText(body, privateString)
+		if private == nil {
+			private = []byte(privateString)
+		}
+
 		//log.Debugf("ProcessBytes: Decrypting private string = %s", privateString)
-		state, err = r.parent.DecryptState(privateString)
+		var lifetime encodings.Lifetime
+		state, lifetime, err = r.parent.DecryptTenantStateFrom(r.parent.requestTenantOf(req), private, r.requestAdditionalData(req)...)
 		if err != nil {
-			var dto interface{}
-			return dto, err
+			return state, err
+		}
+		if err = r.verifySignedState(req, state); err != nil {
+			return state, err
+		}
+		if err = r.checkLifetime(lifetime, states.NewTimeNow()); err != nil {
+			return state, err
+		}
+		if err = r.parent.openFields(state, r.fieldAccess); err != nil {
+			return state, err
 		}
 	}
 
 	//log.Debugf("ProcessBytes: Processing request: %v", state)
-	state, err = r.handleRequest(req, state)
-	if err != nil {
-		var dto interface{}
-		return dto, err
+	return r.processState(ctx, req, state)
+}
+
+// ProcessStream decrypts a state from an encrypted stream, processes it, and
+// encrypts the result as a stream to w, so the state is never held in memory
+// as text. An empty body creates a new resource. The stream carries the whole
+// resource, so it is bound only to the purpose, not to the identity of the
+// resource. The handler is given an empty request, and no response is
+// created.
+func (r *RequestResponseManager[T, R, D]) ProcessStream(ctx context.Context, body io.Reader, w io.Writer) error {
+	ctx, cancel := r.requestContext(ctx)
+	defer cancel()
+
+	req := r.parent.NewRequest()
+	if err := r.runBeforeDecrypt(ctx, req); err != nil {
+		return err
 	}
 
-	//log.Debugf("ProcessBytes: Encrypting state: %v", state)
-	private, err := r.parent.EncryptState(state)
+	var state T
+	buffered := bufio.NewReader(body)
+	if _, err := buffered.Peek(1); err == nil {
+		var lifetime encodings.Lifetime
+		state, lifetime, err = r.parent.DecryptStateStream(buffered, r.additionalDataCandidates(nil)...)
+		if err != nil {
+			return err
+		}
+		if err = r.checkLifetime(lifetime, states.NewTimeNow()); err != nil {
+			return err
+		}
+		if err = r.parent.openFields(state, r.fieldAccess); err != nil {
+			return err
+		}
+	}
+
+	state, err := r.processState(ctx, req, state)
 	if err != nil {
-		var dto interface{}
-		return dto, err
+		return err
 	}
 
-	if r.handleResponse != nil {
-		//log.Debugf("ProcessBytes: Handling response: %v with %s", state, private)
-		return r.handleResponse(state, private), nil
+	var additionalData []byte
+	if r.isBound() {
+		additionalData = encodings.NewAdditionalData([]byte(r.encryptionPurpose()), nil)
 	}
+	return r.parent.EncryptStateStream(w, state, additionalData, r.newLifetime())
+}
 
-	var dto interface{}
-	return dto, nil
+// requestContext returns ctx limited by the timeout of the route
+func (r *RequestResponseManager[T, R, D]) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if r.timeout > 0 {
+		return context.WithTimeout(ctx, r.timeout)
+	}
+	return ctx, noCancel
+}
+
+// noCancel is the cancel function of a context without a timeout
+func noCancel() {}
+
+// newLifetime returns the lifetime sealed with new states of the route
+func (r *RequestResponseManager[T, R, D]) newLifetime() encodings.Lifetime {
+	if r.ttl > 0 {
+		return encodings.NewLifetime(states.NewTimeNow(), r.ttl)
+	}
+	return encodings.Lifetime{}
+}
+
+// processState calls the hooks after decrypting, the handler wrapped in the
+// middlewares, and the hooks before encrypting. It returns the error of ctx
+// if it is done when the handler returns.
+func (r *RequestResponseManager[T, R, D]) processState(ctx context.Context, req R, state T) (T, error) {
+	for _, hook := range r.afterDecrypt {
+		if err := hook(ctx, req, state); err != nil {
+			return state, err
+		}
+	}
+	handler := r.chain
+	if handler == nil {
+		handler = r.handleRequest
+	}
+	state, err := handler(ctx, req, state)
+	if err != nil {
+		return state, err
+	}
+	if err = ctx.Err(); err != nil {
+		log.Debugf("[RequestResponseManager.processState]: Request is done: %v", err)
+		return state, err
+	}
+	for _, hook := range r.beforeEncrypt {
+		if err = hook(ctx, req, state); err != nil {
+			return state, err
+		}
+	}
+	return state, nil
+}
+
+// runBeforeDecrypt calls the hooks before decrypting
+func (r *RequestResponseManager[T, R, D]) runBeforeDecrypt(ctx context.Context, req R) error {
+	for _, hook := range r.beforeDecrypt {
+		if err := hook(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (r *RequestResponseManager[T, R, D]) Methods() []string {
@@ -78,3 +299,249 @@ func (r *RequestResponseManager[T, R, D]) WithMethods(methods ...string) *Reques
 	r.methods = append(r.methods, methods...)
 	return r
 }
+
+// WithPurpose configures a purpose label, e.g. a route name, which is
+// authenticated with the encrypted state. The state is only accepted on
+// routes with the same purpose, unless other accepted purposes are listed.
+func (r *RequestResponseManager[T, R, D]) WithPurpose(purpose string, accepted ...string) *RequestResponseManager[T, R, D] {
+	r.purpose = purpose
+	r.accepted = accepted
+	return r
+}
+
+// WithResponsePurpose configures the state in responses to be encrypted for
+// another purpose than the purpose of this route, e.g. when the route hands
+// the state back to the route which issued it. The route re-encrypts the
+// state for that purpose, so it must accept it too, but states are never
+// encrypted for the purpose of this route.
+func (r *RequestResponseManager[T, R, D]) WithResponsePurpose(purpose string) *RequestResponseManager[T, R, D] {
+	r.responsePurpose = purpose
+	return r
+}
+
+// WithResourceBinding configures the encrypted state to be bound to the
+// identity of the resource, e.g. the id and owner. The state is only accepted
+// if the request has the same identity as the state had.
+func (r *RequestResponseManager[T, R, D]) WithResourceBinding(stateIdentity StateIdentityFunc[T], requestIdentity RequestIdentityFunc[R]) *RequestResponseManager[T, R, D] {
+	r.stateIdentity = stateIdentity
+	r.requestIdentity = requestIdentity
+	return r
+}
+
+// WithTTL configures how long encrypted states issued by this route are
+// accepted. Any state is accepted by this route only if it was issued at most
+// ttl ago, which also rejects states without a lifetime. Zero disables the
+// limit, but a state is still rejected after its own expiration time.
+func (r *RequestResponseManager[T, R, D]) WithTTL(ttl time.Duration) *RequestResponseManager[T, R, D] {
+	r.ttl = ttl.Milliseconds()
+	return r
+}
+
+// Use adds middlewares which wrap the handler of the route. The first
+// middleware added is the outermost, so it sees the request first and the
+// result last.
+func (r *RequestResponseManager[T, R, D]) Use(middlewares ...Middleware[T, R]) *RequestResponseManager[T, R, D] {
+	r.middlewares = append(r.middlewares, middlewares...)
+	r.chain = r.handleRequest
+	for i := len(r.middlewares) - 1; i >= 0; i-- {
+		r.chain = r.middlewares[i](r.chain)
+	}
+	return r
+}
+
+// BeforeDecrypt adds hooks which are called with the decoded request before
+// the private state is decrypted, e.g. to authenticate or validate the
+// request before spending time on decryption
+func (r *RequestResponseManager[T, R, D]) BeforeDecrypt(hooks ...RequestHookFunc[R]) *RequestResponseManager[T, R, D] {
+	r.beforeDecrypt = append(r.beforeDecrypt, hooks...)
+	return r
+}
+
+// AfterDecrypt adds hooks which are called with the decrypted state before
+// the handler, e.g. to authorize the request against the state
+func (r *RequestResponseManager[T, R, D]) AfterDecrypt(hooks ...StateHookFunc[T, R]) *RequestResponseManager[T, R, D] {
+	r.afterDecrypt = append(r.afterDecrypt, hooks...)
+	return r
+}
+
+// BeforeEncrypt adds hooks which are called with the state from the handler
+// before it is encrypted, e.g. to validate the result
+func (r *RequestResponseManager[T, R, D]) BeforeEncrypt(hooks ...StateHookFunc[T, R]) *RequestResponseManager[T, R, D] {
+	r.beforeEncrypt = append(r.beforeEncrypt, hooks...)
+	return r
+}
+
+// AfterResponse adds hooks which are called with the state and the response
+// DTO after the response has been created and signed, e.g. to publish the
+// response to other clients
+func (r *RequestResponseManager[T, R, D]) AfterResponse(hooks ...ResponseHookFunc[T]) *RequestResponseManager[T, R, D] {
+	r.afterResponse = append(r.afterResponse, hooks...)
+	return r
+}
+
+// WithTimeout configures how long the handler may process a request. The
+// context of the hooks and the handler is canceled after the timeout, and the
+// request fails with context.DeadlineExceeded. Zero disables the limit.
+func (r *RequestResponseManager[T, R, D]) WithTimeout(timeout time.Duration) *RequestResponseManager[T, R, D] {
+	r.timeout = timeout
+	return r
+}
+
+// WithFieldAccess configures which sensitivity classes of private properties
+// the handler is allowed to read. Properties of other classes stay encrypted
+// inside the state. The handler may still replace them by setting the
+// property, since all classified properties are encrypted again.
+func (r *RequestResponseManager[T, R, D]) WithFieldAccess(classes ...string) *RequestResponseManager[T, R, D] {
+	r.fieldAccess = append(r.fieldAccess, classes...)
+	return r
+}
+
+// WithPublicSignature configures public properties of responses to be signed,
+// and requests which send back a resource to be verified. The request must
+// implement SignedRequest, the state SignedState and the response
+// SignedResponse. The signature is made over the data of the state, so
+// responses of every route with the same signer can be sent back to any of
+// them.
+func (r *RequestResponseManager[T, R, D]) WithPublicSignature(signer *encodings.PublicSigner) *RequestResponseManager[T, R, D] {
+	r.signer = signer
+	return r
+}
+
+// verifySignature returns an error if signatures are enabled and the request
+// does not have a valid signature of the public properties
+func (r *RequestResponseManager[T, R, D]) verifySignature(req R) error {
+	if r.signer == nil {
+		return nil
+	}
+	signed, ok := any(req).(SignedRequest)
+	if !ok {
+		log.Errorf("[RequestResponseManager.verifySignature]: request %T does not support signatures", req)
+		return errors.ErrRequestSignatureNotSupported
+	}
+	if signed.Signature() == "" {
+		return errors.ErrPublicSignatureMissing
+	}
+	if !r.signer.Verify(signed.SignedData(), signed.Signature()) {
+		log.Errorf("[RequestResponseManager.verifySignature]: public data signature is invalid")
+		return errors.ErrPublicSignatureInvalid
+	}
+	return nil
+}
+
+// verifySignedState returns an error if signatures are enabled and the
+// signed identity of the request, e.g. the id and owner, does not match the
+// decrypted state, so a valid signature of one resource cannot be sent with
+// the private data of another
+func (r *RequestResponseManager[T, R, D]) verifySignedState(req R, state T) error {
+	if r.signer == nil {
+		return nil
+	}
+	signed, ok := any(state).(SignedState)
+	if !ok {
+		log.Errorf("[RequestResponseManager.verifySignedState]: state %T does not support signatures", state)
+		return errors.ErrRequestSignatureNotSupported
+	}
+	if !bytes.Equal(any(req).(SignedRequest).SignedIdentity(), signed.SignedIdentity()) {
+		log.Errorf("[RequestResponseManager.verifySignedState]: signed identity does not match the state")
+		return errors.ErrPublicSignatureInvalid
+	}
+	return nil
+}
+
+// signResponse signs public properties of the state in the response, if
+// signatures are enabled and the state and the response support them
+func (r *RequestResponseManager[T, R, D]) signResponse(state T, dto interface{}) interface{} {
+	if r.signer == nil {
+		return dto
+	}
+	signedState, ok := any(state).(SignedState)
+	if !ok {
+		return dto
+	}
+	if signed, ok := dto.(SignedResponse); ok {
+		signed.SetSignature(r.signer.Sign(signedState.SignedData()))
+	}
+	return dto
+}
+
+// checkLifetime returns an error if the encrypted state has expired, or if it
+// was not issued within the TTL of this route
+func (r *RequestResponseManager[T, R, D]) checkLifetime(lifetime encodings.Lifetime, now int64) error {
+	if lifetime.IsExpired(now) || (r.ttl > 0 && (lifetime.IssuedAt == 0 || now >= lifetime.IssuedAt+r.ttl)) {
+		log.Errorf("[RequestResponseManager.checkLifetime]: state issued at %d expires at %d has expired", lifetime.IssuedAt, lifetime.ExpiresAt)
+		return errors.ErrPrivateStateExpired
+	}
+	return nil
+}
+
+// isBound returns true if the encrypted state is bound to a purpose or the
+// resource identity
+func (r *RequestResponseManager[T, R, D]) isBound() bool {
+	return r.purpose != "" || r.responsePurpose != "" || r.stateIdentity != nil
+}
+
+// encryptionPurpose returns the purpose authenticated with the state in
+// responses
+func (r *RequestResponseManager[T, R, D]) encryptionPurpose() string {
+	if r.responsePurpose != "" {
+		return r.responsePurpose
+	}
+	return r.purpose
+}
+
+// stateAdditionalData returns the additional data used to encrypt the state
+func (r *RequestResponseManager[T, R, D]) stateAdditionalData(state T) []byte {
+	if !r.isBound() {
+		return nil
+	}
+	var identity []byte
+	if r.stateIdentity != nil {
+		identity = r.stateIdentity(state)
+	}
+	return encodings.NewAdditionalData([]byte(r.encryptionPurpose()), identity)
+}
+
+// requestAdditionalData returns the additional data accepted when decrypting
+// the state of the request
+func (r *RequestResponseManager[T, R, D]) requestAdditionalData(req R) [][]byte {
+	var identity []byte
+	if r.requestIdentity != nil {
+		identity = r.requestIdentity(req)
+	}
+	return r.additionalDataCandidates(identity)
+}
+
+// additionalDataCandidates returns the additional data accepted for the
+// identity when decrypting
+func (r *RequestResponseManager[T, R, D]) additionalDataCandidates(identity []byte) [][]byte {
+	if !r.isBound() {
+		return nil
+	}
+	if len(r.accepted) == 0 {
+		return [][]byte{encodings.NewAdditionalData([]byte(r.purpose), identity)}
+	}
+	candidates := make([][]byte, len(r.accepted))
+	for i, purpose := range r.accepted {
+		candidates[i] = encodings.NewAdditionalData([]byte(purpose), identity)
+	}
+	return candidates
+}
+
+// privateText returns the private data of the request as it is in the body
+// the request was decoded from, so it can be decrypted without copying it. It
+// returns nil if the body does not contain the data as a JSON string as it
+// is, e.g. because it was escaped.
+func privateText(body []byte, private string) []byte {
+	for i := 0; i+len(private)+2 <= len(body); i++ {
+		j := bytes.IndexByte(body[i:], '"')
+		if j < 0 {
+			return nil
+		}
+		i += j
+		end := i + 1 + len(private)
+		if end < len(body) && body[end] == '"' && string(body[i+1:end]) == private {
+			return body[i+1 : end]
+		}
+	}
+	return nil
+}
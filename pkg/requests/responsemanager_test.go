@@ -0,0 +1,636 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package requests_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hyperifyio/statelessdb/pkg/dtos"
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/encodings/json"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+	"github.com/hyperifyio/statelessdb/pkg/requests"
+	"github.com/hyperifyio/statelessdb/pkg/states"
+)
+
+type testBindingResponse struct {
+	Id      uuid.UUID
+	Owner   uuid.UUID
+	Private string
+}
+
+func testBindingHandler(r *requests.ComputeRequest, state *states.ComputeState) (*states.ComputeState, error) {
+	if state == nil {
+		state = states.NewComputeState(uuid.New(), uuid.New(), 0, 0, nil, nil, nil)
+	}
+	return state, nil
+}
+
+func testBindingResponseHandler(state *states.ComputeState, private string) interface{} {
+	return &testBindingResponse{Id: state.Id, Owner: state.Owner, Private: private}
+}
+
+func testStateIdentity(state *states.ComputeState) []byte {
+	return append(append([]byte{}, state.Id[:]...), state.Owner[:]...)
+}
+
+func testRequestIdentity(r *requests.ComputeRequest) []byte {
+	id, err := uuid.Parse(r.Id)
+	if err != nil {
+		return nil
+	}
+	owner, err := uuid.Parse(r.Owner)
+	if err != nil {
+		return nil
+	}
+	return append(append([]byte{}, id[:]...), owner[:]...)
+}
+
+func processTestBindingRequest(t *testing.T, handler requests.ResponseManager, body string) (*testBindingResponse, error) {
+	dto, err := handler.ProcessBytes(context.Background(), []byte(body))
+	if err != nil {
+		return nil, err
+	}
+	response, ok := dto.(*testBindingResponse)
+	if !ok {
+		t.Fatalf("Unexpected response: %v", dto)
+	}
+	return response, nil
+}
+
+func TestRequestResponseManager_WithPurpose(t *testing.T) {
+	manager := newTestManager(t, nil)
+	compute := manager.HandleWith(testBindingHandler).WithResponse(testBindingResponseHandler).WithPurpose("compute")
+	events := manager.HandleWith(testBindingHandler).WithResponse(testBindingResponseHandler).WithPurpose("events", "events", "compute")
+	other := manager.HandleWith(testBindingHandler).WithResponse(testBindingResponseHandler).WithPurpose("other")
+
+	created, err := processTestBindingRequest(t, compute, `{}`)
+	if err != nil {
+		t.Fatalf("Creating a resource failed: %v", err)
+	}
+
+	body := fmt.Sprintf(`{"private":%q}`, created.Private)
+	if _, err = processTestBindingRequest(t, compute, body); err != nil {
+		t.Errorf("State should be accepted with the same purpose: %v", err)
+	}
+
+	polled, err := processTestBindingRequest(t, events, body)
+	if err != nil {
+		t.Fatalf("State should be accepted with an accepted purpose: %v", err)
+	}
+	if polled.Id != created.Id {
+		t.Errorf("Expected id %v, got %v", created.Id, polled.Id)
+	}
+
+	body = fmt.Sprintf(`{"private":%q}`, polled.Private)
+	if _, err = processTestBindingRequest(t, events, body); err != nil {
+		t.Errorf("State should be accepted with the same purpose: %v", err)
+	}
+	if _, err = processTestBindingRequest(t, compute, body); err == nil {
+		t.Errorf("State should not be accepted by a route which does not accept its purpose")
+	}
+	if _, err = processTestBindingRequest(t, other, body); err == nil {
+		t.Errorf("State should not be accepted with a different purpose")
+	}
+}
+
+func TestRequestResponseManager_WithResponsePurpose(t *testing.T) {
+	manager := newTestManager(t, nil)
+	compute := manager.HandleWith(testBindingHandler).WithResponse(testBindingResponseHandler).WithPurpose("compute")
+	events := manager.HandleWith(testBindingHandler).WithResponse(testBindingResponseHandler).WithPurpose("events", "compute").WithResponsePurpose("compute")
+
+	created, err := processTestBindingRequest(t, compute, `{}`)
+	if err != nil {
+		t.Fatalf("Creating a resource failed: %v", err)
+	}
+
+	polled, err := processTestBindingRequest(t, events, fmt.Sprintf(`{"private":%q}`, created.Private))
+	if err != nil {
+		t.Fatalf("State should be accepted with an accepted purpose: %v", err)
+	}
+
+	// The state is encrypted again for the route which issued it
+	body := fmt.Sprintf(`{"private":%q}`, polled.Private)
+	if _, err = processTestBindingRequest(t, compute, body); err != nil {
+		t.Errorf("State should be accepted by the route it was encrypted for: %v", err)
+	}
+	if _, err = processTestBindingRequest(t, events, body); err != nil {
+		t.Errorf("State should be accepted again by the route which encrypted it: %v", err)
+	}
+}
+
+func TestRequestResponseManager_WithResourceBinding(t *testing.T) {
+	manager := newTestManager(t, nil)
+	handler := manager.HandleWith(testBindingHandler).WithResponse(testBindingResponseHandler).WithResourceBinding(testStateIdentity, testRequestIdentity)
+
+	created, err := processTestBindingRequest(t, handler, `{}`)
+	if err != nil {
+		t.Fatalf("Creating a resource failed: %v", err)
+	}
+
+	body := fmt.Sprintf(`{"id":%q,"owner":%q,"private":%q}`, created.Id, created.Owner, created.Private)
+	if _, err = processTestBindingRequest(t, handler, body); err != nil {
+		t.Errorf("State should be accepted with the same identity: %v", err)
+	}
+
+	body = fmt.Sprintf(`{"id":%q,"owner":%q,"private":%q}`, uuid.New(), created.Owner, created.Private)
+	if _, err = processTestBindingRequest(t, handler, body); err == nil {
+		t.Errorf("State should not be accepted with a different id")
+	}
+
+	body = fmt.Sprintf(`{"private":%q}`, created.Private)
+	if _, err = processTestBindingRequest(t, handler, body); err == nil {
+		t.Errorf("State should not be accepted without an identity")
+	}
+}
+
+func TestRequestResponseManager_WithTTL(t *testing.T) {
+	manager := newTestManager(t, nil)
+	handler := manager.HandleWith(testBindingHandler).WithResponse(testBindingResponseHandler).WithTTL(time.Minute)
+
+	created, err := processTestBindingRequest(t, handler, `{}`)
+	if err != nil {
+		t.Fatalf("Creating a resource failed: %v", err)
+	}
+	body := fmt.Sprintf(`{"private":%q}`, created.Private)
+	if _, err = processTestBindingRequest(t, handler, body); err != nil {
+		t.Errorf("Fresh state should be accepted: %v", err)
+	}
+
+	state := states.NewComputeState(uuid.New(), uuid.New(), 0, 0, nil, nil, nil)
+
+	// Issued too long ago, even if it has not expired itself
+	now := states.NewTimeNow()
+	private, err := manager.EncryptStateWithLifetime(state, nil, encodings.NewLifetime(now-2*time.Minute.Milliseconds(), 0))
+	if err != nil {
+		t.Fatalf("Encryption failed: %v", err)
+	}
+	body = fmt.Sprintf(`{"private":%q}`, private)
+	if _, err = processTestBindingRequest(t, handler, body); err != errors.ErrPrivateStateExpired {
+		t.Errorf("Expected error %v, got %v", errors.ErrPrivateStateExpired, err)
+	}
+
+	// Without a lifetime
+	private, err = manager.EncryptState(state)
+	if err != nil {
+		t.Fatalf("Encryption failed: %v", err)
+	}
+	body = fmt.Sprintf(`{"private":%q}`, private)
+	if _, err = processTestBindingRequest(t, handler, body); err != errors.ErrPrivateStateExpired {
+		t.Errorf("Expected error %v, got %v", errors.ErrPrivateStateExpired, err)
+	}
+
+	// Expired on a route without TTL
+	private, err = manager.EncryptStateWithLifetime(state, nil, encodings.NewLifetime(now-2000, 1000))
+	if err != nil {
+		t.Fatalf("Encryption failed: %v", err)
+	}
+	body = fmt.Sprintf(`{"private":%q}`, private)
+	unlimited := manager.HandleWith(testBindingHandler).WithResponse(testBindingResponseHandler)
+	if _, err = processTestBindingRequest(t, unlimited, body); err != errors.ErrPrivateStateExpired {
+		t.Errorf("Expected error %v, got %v", errors.ErrPrivateStateExpired, err)
+	}
+}
+
+func testStateTenant(state *states.ComputeState) []byte {
+	return state.Owner[:]
+}
+
+func testRequestTenant(r *requests.ComputeRequest) []byte {
+	owner, err := uuid.Parse(r.Owner)
+	if err != nil {
+		return nil
+	}
+	return owner[:]
+}
+
+func TestEncryptedRequestManager_WithTenantKeys(t *testing.T) {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	keys, err := encodings.NewKeyRing(key)
+	if err != nil {
+		t.Fatalf("NewKeyRing failed: %v", err)
+	}
+	manager, err := requests.NewJsonRequestManagerWithKeyRing[*states.ComputeState, *requests.ComputeRequest, interface{}](
+		"ComputeState",
+		keys,
+		func() *states.ComputeState { return &states.ComputeState{} },
+		func() *requests.ComputeRequest { return &requests.ComputeRequest{} },
+	)
+	if err != nil {
+		t.Fatalf("Failed to create request manager: %v", err)
+	}
+	tenants := encodings.NewTenantKeys(keys, 10)
+	manager.WithTenantKeys(tenants, testStateTenant, testRequestTenant)
+	handler := manager.HandleWith(testBindingHandler).WithResponse(testBindingResponseHandler)
+
+	created, err := processTestBindingRequest(t, handler, `{}`)
+	if err != nil {
+		t.Fatalf("Creating a resource failed: %v", err)
+	}
+
+	body := fmt.Sprintf(`{"owner":%q,"private":%q}`, created.Owner, created.Private)
+	if _, err = processTestBindingRequest(t, handler, body); err != nil {
+		t.Errorf("State should be accepted with the same owner: %v", err)
+	}
+
+	if _, err = processTestBindingRequest(t, handler, fmt.Sprintf(`{"owner":%q,"private":%q}`, uuid.New(), created.Private)); err == nil {
+		t.Errorf("State should not be accepted with a different owner")
+	}
+
+	if _, err = processTestBindingRequest(t, handler, fmt.Sprintf(`{"private":%q}`, created.Private)); err != errors.ErrPrivateStateNoTenant {
+		t.Errorf("Expected error %v, got %v", errors.ErrPrivateStateNoTenant, err)
+	}
+
+	tenants.Revoke(created.Owner[:])
+	if _, err = processTestBindingRequest(t, handler, body); err != errors.ErrPrivateStateRevoked {
+		t.Errorf("Expected error %v, got %v", errors.ErrPrivateStateRevoked, err)
+	}
+}
+
+func testFieldHandler(r *requests.ComputeRequest, state *states.ComputeState) (*states.ComputeState, error) {
+	if state == nil {
+		state = states.NewComputeState(uuid.New(), uuid.New(), 0, 0, nil, map[string]interface{}{
+			"ssn":  "123-45-6789",
+			"note": "hello",
+		}, nil)
+	}
+	return state, nil
+}
+
+func TestRequestResponseManager_WithFieldAccess(t *testing.T) {
+	manager := newTestManager(t, nil)
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	keys, err := encodings.NewKeyRing(key)
+	if err != nil {
+		t.Fatalf("NewKeyRing failed: %v", err)
+	}
+	manager.WithFieldEncryption(encodings.NewFieldCipher(keys), states.FieldClasses{"ssn": "pii"})
+
+	var seen *states.ComputeState
+	recordState := func(r *requests.ComputeRequest, state *states.ComputeState) (*states.ComputeState, error) {
+		seen = states.NewComputeState(state.Id, state.Owner, state.Created, state.Updated, state.Public, maps.Clone(state.Private), nil)
+		return state, nil
+	}
+	create := manager.HandleWith(testFieldHandler).WithResponse(testBindingResponseHandler)
+	reader := manager.HandleWith(recordState).WithResponse(testBindingResponseHandler).WithFieldAccess("pii")
+	other := manager.HandleWith(recordState).WithResponse(testBindingResponseHandler)
+
+	created, err := processTestBindingRequest(t, create, `{}`)
+	if err != nil {
+		t.Fatalf("Creating a resource failed: %v", err)
+	}
+
+	body := fmt.Sprintf(`{"private":%q}`, created.Private)
+	unauthorized, err := processTestBindingRequest(t, other, body)
+	if err != nil {
+		t.Fatalf("Processing without field access failed: %v", err)
+	}
+	if _, exists := seen.Private["ssn"]; exists {
+		t.Errorf("Handler without access should not see the sealed property")
+	}
+	if seen.Private["note"] != "hello" {
+		t.Errorf("Handler should see unclassified properties: %v", seen.Private)
+	}
+
+	body = fmt.Sprintf(`{"private":%q}`, unauthorized.Private)
+	if _, err = processTestBindingRequest(t, reader, body); err != nil {
+		t.Fatalf("Processing with field access failed: %v", err)
+	}
+	if seen.Private["ssn"] != "123-45-6789" {
+		t.Errorf("Handler with access should see the sealed property: %v", seen.Private)
+	}
+
+	state, err := manager.DecryptState(created.Private)
+	if err != nil {
+		t.Fatalf("DecryptState failed: %v", err)
+	}
+	if _, exists := state.Private["ssn"]; exists {
+		t.Errorf("Sealed property should not be in the private properties")
+	}
+	if field, exists := state.Sealed["ssn"]; !exists || field.Class != "pii" {
+		t.Errorf("Expected a sealed property of class pii, got %v", state.Sealed)
+	}
+}
+
+func testSignedResponseHandler(state *states.ComputeState, private string) interface{} {
+	return dtos.NewComputeResponseDTO(state.Id, state.Owner, state.Created, state.Updated, state.Public, private)
+}
+
+// newTestSigner creates a public signer with a new key
+func newTestSigner(t *testing.T) *encodings.PublicSigner {
+	key, err := encodings.GenerateKey(32)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	keys, err := encodings.NewKeyRing(key)
+	if err != nil {
+		t.Fatalf("NewKeyRing failed: %v", err)
+	}
+	signer, err := encodings.NewPublicSigner(keys)
+	if err != nil {
+		t.Fatalf("NewPublicSigner failed: %v", err)
+	}
+	return signer
+}
+
+func TestRequestResponseManager_WithPublicSignature(t *testing.T) {
+	manager := newTestManager(t, nil)
+	signer := newTestSigner(t)
+	createPublic := func(r *requests.ComputeRequest, state *states.ComputeState) (*states.ComputeState, error) {
+		if state == nil {
+			state = states.NewComputeState(uuid.New(), uuid.New(), 1000, 2000, map[string]interface{}{"name": "test", "count": 5}, nil, nil)
+		}
+		return state, nil
+	}
+	handler := manager.HandleWith(createPublic).WithResponse(testSignedResponseHandler).WithPublicSignature(signer)
+
+	dto, err := handler.ProcessBytes(context.Background(), []byte(`{}`))
+	if err != nil {
+		t.Fatalf("Creating a resource failed: %v", err)
+	}
+	created := dto.(*dtos.ComputeResponseDTO)
+	if created.Signature == "" {
+		t.Fatalf("Response should be signed")
+	}
+
+	body, err := json.Marshal(created)
+	if err != nil {
+		t.Fatalf("Encoding the response failed: %v", err)
+	}
+	if _, err = handler.ProcessBytes(context.Background(), body); err != nil {
+		t.Errorf("Resource with a valid signature should be accepted: %v", err)
+	}
+
+	tampered := *created
+	tampered.Public = map[string]interface{}{"name": "changed", "count": 5}
+	if body, err = json.Marshal(&tampered); err != nil {
+		t.Fatalf("Encoding the response failed: %v", err)
+	}
+	if _, err = handler.ProcessBytes(context.Background(), body); err != errors.ErrPublicSignatureInvalid {
+		t.Errorf("Expected error %v, got %v", errors.ErrPublicSignatureInvalid, err)
+	}
+
+	if _, err = handler.ProcessBytes(context.Background(), []byte(fmt.Sprintf(`{"private":%q}`, created.Private))); err != errors.ErrPublicSignatureMissing {
+		t.Errorf("Expected error %v, got %v", errors.ErrPublicSignatureMissing, err)
+	}
+
+	dto, err = handler.ProcessBytes(context.Background(), []byte(`{}`))
+	if err != nil {
+		t.Fatalf("Creating another resource failed: %v", err)
+	}
+	swapped := *created
+	swapped.Private = dto.(*dtos.ComputeResponseDTO).Private
+	if body, err = json.Marshal(&swapped); err != nil {
+		t.Fatalf("Encoding the response failed: %v", err)
+	}
+	if _, err = handler.ProcessBytes(context.Background(), body); err != errors.ErrPublicSignatureInvalid {
+		t.Errorf("Expected error %v with the private data of another resource, got %v", errors.ErrPublicSignatureInvalid, err)
+	}
+}
+
+func TestRequestResponseManager_WithPublicSignature_OtherRoute(t *testing.T) {
+	manager := newTestManager(t, nil)
+	signer := newTestSigner(t)
+	createPublic := func(r *requests.ComputeRequest, state *states.ComputeState) (*states.ComputeState, error) {
+		if state == nil {
+			state = states.NewComputeState(uuid.New(), uuid.New(), 1000, 2000, map[string]interface{}{"name": "test"}, nil, nil)
+		}
+		return state, nil
+	}
+	touch := func(r *requests.ComputeRequest, state *states.ComputeState) (*states.ComputeState, error) {
+		state.Updated = 3000
+		return state, nil
+	}
+	listResponse := func(state *states.ComputeState, private string) interface{} {
+		return dtos.NewEventListDTO(state.Updated, nil, private)
+	}
+	compute := manager.HandleWith(createPublic).WithResponse(testSignedResponseHandler).WithPublicSignature(signer)
+	events := manager.HandleWith(touch).WithResponse(listResponse).WithPublicSignature(signer)
+
+	dto, err := compute.ProcessBytes(context.Background(), []byte(`{}`))
+	if err != nil {
+		t.Fatalf("Creating a resource failed: %v", err)
+	}
+	created := dto.(*dtos.ComputeResponseDTO)
+	body, err := json.Marshal(created)
+	if err != nil {
+		t.Fatalf("Encoding the response failed: %v", err)
+	}
+	if dto, err = events.ProcessBytes(context.Background(), body); err != nil {
+		t.Fatalf("Resource should be accepted by the other route: %v", err)
+	}
+	list := dto.(*dtos.EventListDTO)
+	if list.Signature == "" {
+		t.Fatalf("Response of the other route should be signed")
+	}
+
+	// The response of the other route is sent back with its updated time
+	updated := *created
+	updated.Updated = list.Created
+	updated.Private = list.Private
+	updated.Signature = list.Signature
+	if body, err = json.Marshal(&updated); err != nil {
+		t.Fatalf("Encoding the response failed: %v", err)
+	}
+	if _, err = compute.ProcessBytes(context.Background(), body); err != nil {
+		t.Errorf("Resource from the other route should be accepted: %v", err)
+	}
+}
+
+func TestRequestResponseManager_AfterResponse(t *testing.T) {
+	signer := newTestSigner(t)
+	var published []*dtos.ComputeResponseDTO
+	handler := newTestManager(t, nil).HandleWith(testStreamHandler).WithResponse(testSignedResponseHandler).WithPublicSignature(signer).AfterResponse(func(state *states.ComputeState, dto interface{}) {
+		published = append(published, dto.(*dtos.ComputeResponseDTO))
+	})
+
+	dto, err := handler.ProcessBytes(context.Background(), []byte(`{}`))
+	if err != nil {
+		t.Fatalf("Creating a resource failed: %v", err)
+	}
+	if len(published) != 1 || published[0] != dto || published[0].Signature == "" {
+		t.Errorf("Hook should be called once with the signed response, got %v", published)
+	}
+}
+
+// testStreamHandler counts visits of the resource in a private property
+func testStreamHandler(r *requests.ComputeRequest, state *states.ComputeState) (*states.ComputeState, error) {
+	if state == nil {
+		state = states.NewComputeState(uuid.New(), uuid.New(), 0, 0, nil, map[string]interface{}{"visits": ""}, nil)
+	}
+	state.Private["visits"] = state.Private["visits"].(string) + "x"
+	return state, nil
+}
+
+func TestRequestResponseManager_ProcessStream(t *testing.T) {
+	manager := newTestManager(t, nil)
+	compute := manager.HandleWith(testStreamHandler).WithPurpose("compute").WithResourceBinding(testStateIdentity, testRequestIdentity)
+	events := manager.HandleWith(testStreamHandler).WithPurpose("events")
+
+	var created bytes.Buffer
+	if err := compute.ProcessStream(context.Background(), bytes.NewReader(nil), &created); err != nil {
+		t.Fatalf("Creating a resource failed: %v", err)
+	}
+	first, _, err := manager.DecryptStateStream(bytes.NewReader(created.Bytes()), encodings.NewAdditionalData([]byte("compute"), nil))
+	if err != nil {
+		t.Fatalf("DecryptStateStream failed: %v", err)
+	}
+
+	var updated bytes.Buffer
+	if err := compute.ProcessStream(context.Background(), bytes.NewReader(created.Bytes()), &updated); err != nil {
+		t.Fatalf("Updating the resource failed: %v", err)
+	}
+	state, _, err := manager.DecryptStateStream(&updated, encodings.NewAdditionalData([]byte("compute"), nil))
+	if err != nil {
+		t.Fatalf("DecryptStateStream failed: %v", err)
+	}
+	if state.Id != first.Id || state.Private["visits"] != "xx" {
+		t.Errorf("Unexpected state %v, visits %v", state.Id, state.Private["visits"])
+	}
+
+	if err := events.ProcessStream(context.Background(), bytes.NewReader(created.Bytes()), io.Discard); err != errors.ErrFailedToDecryptStateStream {
+		t.Errorf("Expected ErrFailedToDecryptStateStream with a different purpose, got %v", err)
+	}
+
+	tenants, err := encodings.NewKeyRing(bytes.Repeat([]byte{1}, 32))
+	if err != nil {
+		t.Fatalf("NewKeyRing failed: %v", err)
+	}
+	manager.WithTenantKeys(encodings.NewTenantKeys(tenants, 0), testStateTenant, testRequestTenant)
+	if err := compute.ProcessStream(context.Background(), bytes.NewReader(nil), io.Discard); err != errors.ErrEncryptStreamTenantKeysUnsupported {
+		t.Errorf("Expected ErrEncryptStreamTenantKeysUnsupported, got %v", err)
+	}
+	if err := compute.ProcessStream(context.Background(), bytes.NewReader(created.Bytes()), io.Discard); err != errors.ErrDecryptStreamTenantKeysUnsupported {
+		t.Errorf("Expected ErrDecryptStreamTenantKeysUnsupported, got %v", err)
+	}
+}
+
+// testContextKey is the key of a test value in the context of a request
+type testContextKey struct{}
+
+func TestRequestResponseManager_HandleWithContext(t *testing.T) {
+	manager := newTestManager(t, nil)
+	var received context.Context
+	recordContext := func(ctx context.Context, r *requests.ComputeRequest, state *states.ComputeState) (*states.ComputeState, error) {
+		received = ctx
+		return testBindingHandler(r, state)
+	}
+	handler := manager.HandleWithContext(recordContext).WithResponse(testBindingResponseHandler)
+
+	ctx := context.WithValue(context.Background(), testContextKey{}, "request")
+	if _, err := handler.ProcessBytes(ctx, []byte(`{}`)); err != nil {
+		t.Fatalf("Processing the request failed: %v", err)
+	}
+	if received == nil || received.Value(testContextKey{}) != "request" {
+		t.Errorf("Handler should receive the context of the request")
+	}
+	if _, ok := received.Deadline(); ok {
+		t.Errorf("Context should not have a deadline without a timeout")
+	}
+
+	handler.WithTimeout(time.Minute)
+	if _, err := handler.ProcessBytes(ctx, []byte(`{}`)); err != nil {
+		t.Fatalf("Processing the request failed: %v", err)
+	}
+	if deadline, ok := received.Deadline(); !ok || time.Until(deadline) > time.Minute {
+		t.Errorf("Context should have the deadline of the timeout, got %v", deadline)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := handler.ProcessBytes(canceled, []byte(`{}`)); err != context.Canceled {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if err := handler.ProcessStream(canceled, bytes.NewReader(nil), io.Discard); err != context.Canceled {
+		t.Errorf("Expected context.Canceled from ProcessStream, got %v", err)
+	}
+
+	waiting := manager.HandleWithContext(func(ctx context.Context, r *requests.ComputeRequest, state *states.ComputeState) (*states.ComputeState, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}).WithTimeout(time.Millisecond)
+	if _, err := waiting.ProcessBytes(context.Background(), []byte(`{}`)); err != context.DeadlineExceeded {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestRequestResponseManager_ProcessBytesTo(t *testing.T) {
+	manager := newTestManager(t, nil)
+	handler := manager.HandleWith(testStreamHandler).WithResponse(testBindingResponseHandler)
+
+	body, err := handler.ProcessBytesTo(context.Background(), []byte("prefix"), []byte(`{}`))
+	if err != nil {
+		t.Fatalf("Creating a resource failed: %v", err)
+	}
+	if !bytes.HasPrefix(body, []byte("prefix")) {
+		t.Fatalf("Response should be appended to dst, got %s", body)
+	}
+	created := &testBindingResponse{}
+	if err = json.Unmarshal(body[len("prefix"):], created); err != nil {
+		t.Fatalf("Decoding the response failed: %v", err)
+	}
+
+	// The private data is decrypted from the body, or from the decoded
+	// request if it was escaped in the body
+	for _, request := range []string{
+		fmt.Sprintf(`{"id":"","private":%q}`, created.Private),
+		fmt.Sprintf(`{"private":"\u%04x%s"}`, created.Private[0], created.Private[1:]),
+	} {
+		if body, err = handler.ProcessBytesTo(context.Background(), nil, []byte(request)); err != nil {
+			t.Fatalf("Updating the resource failed: %v", err)
+		}
+		updated := &testBindingResponse{}
+		if err = json.Unmarshal(body, updated); err != nil {
+			t.Fatalf("Decoding the response failed: %v", err)
+		}
+		state, err := manager.DecryptState(updated.Private)
+		if err != nil {
+			t.Fatalf("DecryptState failed: %v", err)
+		}
+		if updated.Id != created.Id || state.Id != created.Id || state.Private["visits"] != "xx" {
+			t.Errorf("Unexpected state %v, visits %v", state.Id, state.Private["visits"])
+		}
+	}
+
+	if body, err = handler.ProcessBytesTo(context.Background(), []byte("prefix"), []byte(`{"private":"invalid"}`)); err == nil || string(body) != "prefix" {
+		t.Errorf("Expected an error and dst as it was, got %v and %s", err, body)
+	}
+}
+
+func TestRequestResponseManager_ProcessBytesTo_ResponseHandlerPrivate(t *testing.T) {
+	var seen []string
+	handler := newTestManager(t, nil).HandleWith(testStreamHandler).WithResponse(func(state *states.ComputeState, private string) interface{} {
+		seen = append(seen, private)
+		return testBindingResponseHandler(state, private)
+	})
+
+	body, err := handler.ProcessBytesTo(context.Background(), nil, []byte(`{}`))
+	if err != nil {
+		t.Fatalf("Creating a resource failed: %v", err)
+	}
+	created := &testBindingResponse{}
+	if err = json.Unmarshal(body, created); err != nil {
+		t.Fatalf("Decoding the response failed: %v", err)
+	}
+
+	// The response handler must be given the private data of the response,
+	// since it may publish the response elsewhere
+	if len(seen) != 1 || seen[0] != created.Private {
+		t.Errorf("Response handler was given %q, response has %q", seen, created.Private)
+	}
+}
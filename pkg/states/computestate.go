@@ -5,6 +5,8 @@ package states
 
 import (
 	"github.com/google/uuid"
+	"github.com/hyperifyio/statelessdb/pkg/dtos"
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
 	"github.com/hyperifyio/statelessdb/pkg/events"
 	"github.com/hyperifyio/statelessdb/pkg/helpers"
 )
@@ -13,12 +15,13 @@ import (
 // the Private field of dtos.ComputeResponseDTO. This is the state used by
 // StatelessDB by default, but users may implement their own states.
 type ComputeState struct {
-	Id      uuid.UUID              `json:"id"`      // ID identifies the object
-	Owner   uuid.UUID              `json:"owner"`   // Owner identifies the owner of the object
-	Created int64                  `json:"created"` // Created is the time when the object was created
-	Updated int64                  `json:"updated"` // Updated is the time when the object was updated
-	Public  map[string]interface{} `json:"data"`    // Public contains public properties of the object
-	Private map[string]interface{} `json:"private"` // Private contains unencrypted private properties of the object
+	Id      uuid.UUID              `json:"id"`               // ID identifies the object
+	Owner   uuid.UUID              `json:"owner"`            // Owner identifies the owner of the object
+	Created int64                  `json:"created"`          // Created is the time when the object was created
+	Updated int64                  `json:"updated"`          // Updated is the time when the object was updated
+	Public  map[string]interface{} `json:"data"`             // Public contains public properties of the object
+	Private map[string]interface{} `json:"private"`          // Private contains unencrypted private properties of the object
+	Sealed  map[string]SealedField `json:"sealed,omitempty"` // Sealed contains private properties encrypted by their sensitivity class
 
 	events []*events.Event[uuid.UUID, interface{}] // events are intended for event implementation and are not supposed to be exposed to state
 
@@ -32,6 +35,29 @@ func (s *ComputeState) AddEvent(ev ...*events.Event[uuid.UUID, interface{}]) {
 	s.events = append(s.events, ev...)
 }
 
+// SignedData returns the id, owner, times and public properties of the
+// object as they are signed in dtos.ComputeResponseDTO
+func (s *ComputeState) SignedData() []byte {
+	return dtos.NewPublicSignedData(
+		s.Id.String(),
+		s.Owner.String(),
+		helpers.MillisToISO(s.Created),
+		helpers.MillisToISO(s.Updated),
+		s.Public,
+	)
+}
+
+// SignedIdentity returns the id, owner and times of the object as they are
+// signed in dtos.ComputeResponseDTO
+func (s *ComputeState) SignedIdentity() []byte {
+	return encodings.NewAdditionalData(
+		[]byte(s.Id.String()),
+		[]byte(s.Owner.String()),
+		[]byte(helpers.MillisToISO(s.Created)),
+		[]byte(helpers.MillisToISO(s.Updated)),
+	)
+}
+
 func NewComputeState(
 	id, owner uuid.UUID,
 	created, updated int64,
@@ -71,6 +97,9 @@ func (b *ComputeState) Equals(other *ComputeState) bool {
 	if !helpers.CompareMaps(b.Private, other.Private) {
 		return false
 	}
+	if !helpers.CompareMaps(b.Sealed, other.Sealed) {
+		return false
+	}
 	return true
 }
 
@@ -0,0 +1,113 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package states
+
+import (
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+	"github.com/hyperifyio/statelessdb/pkg/states/statespb"
+)
+
+var _ encodings.ProtobufState = &ComputeState{}
+
+// Proto returns the state as a statespb.ComputeState message. Properties must
+// contain only values which JSON can also encode.
+func (b *ComputeState) Proto() (*statespb.ComputeState, error) {
+	public, err := newProtoStruct(b.Public)
+	if err != nil {
+		log.Errorf("[ComputeState.Proto]: public: %v", err)
+		return nil, errors.ErrComputeStatePublicNotSupported
+	}
+	private, err := newProtoStruct(b.Private)
+	if err != nil {
+		log.Errorf("[ComputeState.Proto]: private: %v", err)
+		return nil, errors.ErrComputeStatePrivateNotSupported
+	}
+	var sealed map[string]*statespb.SealedField
+	if len(b.Sealed) != 0 {
+		sealed = make(map[string]*statespb.SealedField, len(b.Sealed))
+		for name, field := range b.Sealed {
+			sealed[name] = &statespb.SealedField{Class: field.Class, Data: field.Data}
+		}
+	}
+	return &statespb.ComputeState{
+		Id:      b.Id[:],
+		Owner:   b.Owner[:],
+		Created: b.Created,
+		Updated: b.Updated,
+		Public:  public,
+		Private: private,
+		Sealed:  sealed,
+	}, nil
+}
+
+// SetProto sets the state from a statespb.ComputeState message
+func (b *ComputeState) SetProto(message *statespb.ComputeState) error {
+	id, err := uuid.FromBytes(message.GetId())
+	if err != nil {
+		log.Errorf("[ComputeState.SetProto]: id: %v", err)
+		return errors.ErrComputeStateInvalidId
+	}
+	owner, err := uuid.FromBytes(message.GetOwner())
+	if err != nil {
+		log.Errorf("[ComputeState.SetProto]: owner: %v", err)
+		return errors.ErrComputeStateInvalidOwner
+	}
+	var sealed map[string]SealedField
+	if len(message.GetSealed()) != 0 {
+		sealed = make(map[string]SealedField, len(message.GetSealed()))
+		for name, field := range message.GetSealed() {
+			sealed[name] = SealedField{Class: field.GetClass(), Data: field.GetData()}
+		}
+	}
+	b.Id = id
+	b.Owner = owner
+	b.Created = message.GetCreated()
+	b.Updated = message.GetUpdated()
+	b.Public = protoStructMap(message.GetPublic())
+	b.Private = protoStructMap(message.GetPrivate())
+	b.Sealed = sealed
+	return nil
+}
+
+// ToProto implements encodings.ProtobufState
+func (b *ComputeState) ToProto() (proto.Message, error) {
+	return b.Proto()
+}
+
+// NewProto implements encodings.ProtobufState
+func (b *ComputeState) NewProto() proto.Message {
+	return &statespb.ComputeState{}
+}
+
+// FromProto implements encodings.ProtobufState
+func (b *ComputeState) FromProto(message proto.Message) error {
+	state, ok := message.(*statespb.ComputeState)
+	if !ok {
+		return errors.ErrComputeStateNotProtobufState
+	}
+	return b.SetProto(state)
+}
+
+// newProtoStruct converts properties to a protobuf struct. Nil properties are
+// kept nil.
+func newProtoStruct(properties map[string]interface{}) (*structpb.Struct, error) {
+	if properties == nil {
+		return nil, nil
+	}
+	return structpb.NewStruct(properties)
+}
+
+// protoStructMap converts a protobuf struct to properties. Numbers are
+// float64 like from JSON.
+func protoStructMap(s *structpb.Struct) map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+	return s.AsMap()
+}
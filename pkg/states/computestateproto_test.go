@@ -0,0 +1,74 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package states
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+	"github.com/hyperifyio/statelessdb/pkg/states/statespb"
+)
+
+func TestComputeState_Proto(t *testing.T) {
+	state := NewComputeState(uuid.New(), uuid.New(), 1000, 2000,
+		map[string]interface{}{"name": "public", "tags": []interface{}{"a", "b"}},
+		map[string]interface{}{"count": float64(3), "nested": map[string]interface{}{"ok": true}},
+		nil,
+	)
+	state.Sealed = map[string]SealedField{"ssn": {Class: "pii", Data: "sealed"}}
+
+	message, err := state.Proto()
+	if err != nil {
+		t.Fatalf("Proto failed: %v", err)
+	}
+
+	decoded := &ComputeState{}
+	if err := decoded.SetProto(message); err != nil {
+		t.Fatalf("SetProto failed: %v", err)
+	}
+	if decoded.Id != state.Id || decoded.Owner != state.Owner || decoded.Created != state.Created || decoded.Updated != state.Updated {
+		t.Errorf("Decoded state does not match original.\nOriginal: %v\nDecoded: %v", state, decoded)
+	}
+	if !reflect.DeepEqual(decoded.Public, state.Public) || !reflect.DeepEqual(decoded.Private, state.Private) || !reflect.DeepEqual(decoded.Sealed, state.Sealed) {
+		t.Errorf("Decoded properties do not match original.\nOriginal: %v\nDecoded: %v", state, decoded)
+	}
+}
+
+func TestComputeState_Proto_NilProperties(t *testing.T) {
+	state := NewComputeState(uuid.New(), uuid.New(), 0, 0, nil, nil, nil)
+
+	message, err := state.Proto()
+	if err != nil {
+		t.Fatalf("Proto failed: %v", err)
+	}
+	if message.GetPublic() != nil || message.GetPrivate() != nil {
+		t.Errorf("Expected nil properties, got %v", message)
+	}
+
+	decoded := &ComputeState{}
+	if err := decoded.SetProto(message); err != nil {
+		t.Fatalf("SetProto failed: %v", err)
+	}
+	if decoded.Public != nil || decoded.Private != nil || decoded.Sealed != nil {
+		t.Errorf("Expected nil properties, got %v", decoded)
+	}
+}
+
+func TestComputeState_Proto_UnsupportedValue(t *testing.T) {
+	state := NewComputeState(uuid.New(), uuid.New(), 0, 0, nil, map[string]interface{}{"channel": make(chan int)}, nil)
+	if _, err := state.Proto(); err != errors.ErrComputeStatePrivateNotSupported {
+		t.Errorf("Expected ErrComputeStatePrivateNotSupported, got %v", err)
+	}
+}
+
+func TestComputeState_SetProto_InvalidId(t *testing.T) {
+	owner := uuid.New()
+	message := &statespb.ComputeState{Id: []byte{1, 2, 3}, Owner: owner[:]}
+	if err := (&ComputeState{}).SetProto(message); err != errors.ErrComputeStateInvalidId {
+		t.Errorf("Expected ErrComputeStateInvalidId, got %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package states
+
+import (
+	"slices"
+
+	"github.com/hyperifyio/statelessdb/pkg/encodings"
+	"github.com/hyperifyio/statelessdb/pkg/encodings/json"
+	"github.com/hyperifyio/statelessdb/pkg/errors"
+)
+
+// SealedField is a private property which is encrypted under the key of its
+// sensitivity class. It stays encrypted inside the state unless the handler
+// is allowed to read the class.
+type SealedField struct {
+	Class string `json:"class"` // Class is the sensitivity class of the property
+	Data  string `json:"data"`  // Data is the encrypted value of the property
+}
+
+// FieldSealer encrypts and decrypts private properties by their sensitivity
+// class, e.g. encodings.FieldCipher
+type FieldSealer interface {
+	Seal(class string, plaintext, additionalData []byte) (string, error)
+	Open(class string, sealed string, additionalData []byte) ([]byte, error)
+}
+
+// FieldClasses maps names of private properties to their sensitivity class
+type FieldClasses map[string]string
+
+// SealFields encrypts private properties which have a sensitivity class, and
+// moves them from Private to Sealed. Sealed properties which were not opened
+// are kept as they are.
+func (s *ComputeState) SealFields(sealer FieldSealer, classes FieldClasses) error {
+	for name, value := range s.Private {
+		class, exists := classes[name]
+		if !exists {
+			continue
+		}
+		plaintext, err := json.Marshal(value)
+		if err != nil {
+			log.Errorf("[ComputeState.SealFields]: %s: %v", name, err)
+			return errors.ErrSealFieldEncodingFailed
+		}
+		data, err := sealer.Seal(class, plaintext, s.fieldAdditionalData(name, class))
+		if err != nil {
+			log.Errorf("[ComputeState.SealFields]: %s: %v", name, err)
+			return errors.ErrSealFieldFailed
+		}
+		if s.Sealed == nil {
+			s.Sealed = make(map[string]SealedField)
+		}
+		s.Sealed[name] = SealedField{Class: class, Data: data}
+		delete(s.Private, name)
+	}
+	return nil
+}
+
+// OpenFields decrypts sealed properties of the allowed sensitivity classes,
+// and moves them from Sealed back to Private
+func (s *ComputeState) OpenFields(sealer FieldSealer, allowed ...string) error {
+	for name, field := range s.Sealed {
+		if !slices.Contains(allowed, field.Class) {
+			continue
+		}
+		plaintext, err := sealer.Open(field.Class, field.Data, s.fieldAdditionalData(name, field.Class))
+		if err != nil {
+			log.Errorf("[ComputeState.OpenFields]: %s: %v", name, err)
+			return errors.ErrOpenFieldFailed
+		}
+		var value interface{}
+		if err = json.Unmarshal(plaintext, &value); err != nil {
+			log.Errorf("[ComputeState.OpenFields]: %s: %v", name, err)
+			return errors.ErrOpenFieldDecodingFailed
+		}
+		if s.Private == nil {
+			s.Private = make(map[string]interface{})
+		}
+		s.Private[name] = value
+		delete(s.Sealed, name)
+	}
+	return nil
+}
+
+// fieldAdditionalData returns the additional data which binds a sealed
+// property to its name, class and the resource
+func (s *ComputeState) fieldAdditionalData(name, class string) []byte {
+	return encodings.NewAdditionalData(s.Id[:], []byte(name), []byte(class))
+}
@@ -0,0 +1,309 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: pkg/states/statespb/computestate.proto
+
+package statespb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ComputeState is the protobuf representation of states.ComputeState.
+// Field numbers must never be reused; removed fields must be reserved.
+type ComputeState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the UUID of the resource as 16 bytes
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the UUID of the owner as 16 bytes
+	Owner []byte `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// created is the time when the resource was created
+	Created int64 `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	// updated is the time when the resource was updated
+	Updated int64 `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+	// public contains public properties of the resource
+	Public *structpb.Struct `protobuf:"bytes,5,opt,name=public,proto3" json:"public,omitempty"`
+	// private contains unencrypted private properties of the resource
+	Private *structpb.Struct `protobuf:"bytes,6,opt,name=private,proto3" json:"private,omitempty"`
+	// sealed contains private properties encrypted by their sensitivity class
+	Sealed map[string]*SealedField `protobuf:"bytes,7,rep,name=sealed,proto3" json:"sealed,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ComputeState) Reset() {
+	*x = ComputeState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_states_statespb_computestate_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComputeState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputeState) ProtoMessage() {}
+
+func (x *ComputeState) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_states_statespb_computestate_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComputeState.ProtoReflect.Descriptor instead.
+func (*ComputeState) Descriptor() ([]byte, []int) {
+	return file_pkg_states_statespb_computestate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ComputeState) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ComputeState) GetOwner() []byte {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *ComputeState) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ComputeState) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ComputeState) GetPublic() *structpb.Struct {
+	if x != nil {
+		return x.Public
+	}
+	return nil
+}
+
+func (x *ComputeState) GetPrivate() *structpb.Struct {
+	if x != nil {
+		return x.Private
+	}
+	return nil
+}
+
+func (x *ComputeState) GetSealed() map[string]*SealedField {
+	if x != nil {
+		return x.Sealed
+	}
+	return nil
+}
+
+// SealedField is a private property encrypted under the sub-key of its
+// sensitivity class
+type SealedField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// class is the sensitivity class, e.g. "pii"
+	Class string `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty"`
+	// data is the encrypted value
+	Data string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SealedField) Reset() {
+	*x = SealedField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_states_statespb_computestate_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SealedField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SealedField) ProtoMessage() {}
+
+func (x *SealedField) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_states_statespb_computestate_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SealedField.ProtoReflect.Descriptor instead.
+func (*SealedField) Descriptor() ([]byte, []int) {
+	return file_pkg_states_statespb_computestate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SealedField) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *SealedField) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+var File_pkg_states_statespb_computestate_proto protoreflect.FileDescriptor
+
+var file_pkg_states_statespb_computestate_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x70, 0x6b, 0x67, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x73, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6c,
+	0x65, 0x73, 0x73, 0x64, 0x62, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf4, 0x02,
+	0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x06,
+	0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x6c, 0x65, 0x73, 0x73, 0x64, 0x62, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x2e, 0x53, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x73,
+	0x65, 0x61, 0x6c, 0x65, 0x64, 0x1a, 0x5d, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6c, 0x65, 0x73,
+	0x73, 0x64, 0x62, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x61, 0x6c, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x37, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x37, 0x5a,
+	0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x79, 0x70, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x69, 0x6f, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6c, 0x65, 0x73, 0x73,
+	0x64, 0x62, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_states_statespb_computestate_proto_rawDescOnce sync.Once
+	file_pkg_states_statespb_computestate_proto_rawDescData = file_pkg_states_statespb_computestate_proto_rawDesc
+)
+
+func file_pkg_states_statespb_computestate_proto_rawDescGZIP() []byte {
+	file_pkg_states_statespb_computestate_proto_rawDescOnce.Do(func() {
+		file_pkg_states_statespb_computestate_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_states_statespb_computestate_proto_rawDescData)
+	})
+	return file_pkg_states_statespb_computestate_proto_rawDescData
+}
+
+var file_pkg_states_statespb_computestate_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pkg_states_statespb_computestate_proto_goTypes = []interface{}{
+	(*ComputeState)(nil),    // 0: statelessdb.states.v1.ComputeState
+	(*SealedField)(nil),     // 1: statelessdb.states.v1.SealedField
+	nil,                     // 2: statelessdb.states.v1.ComputeState.SealedEntry
+	(*structpb.Struct)(nil), // 3: google.protobuf.Struct
+}
+var file_pkg_states_statespb_computestate_proto_depIdxs = []int32{
+	3, // 0: statelessdb.states.v1.ComputeState.public:type_name -> google.protobuf.Struct
+	3, // 1: statelessdb.states.v1.ComputeState.private:type_name -> google.protobuf.Struct
+	2, // 2: statelessdb.states.v1.ComputeState.sealed:type_name -> statelessdb.states.v1.ComputeState.SealedEntry
+	1, // 3: statelessdb.states.v1.ComputeState.SealedEntry.value:type_name -> statelessdb.states.v1.SealedField
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_pkg_states_statespb_computestate_proto_init() }
+func file_pkg_states_statespb_computestate_proto_init() {
+	if File_pkg_states_statespb_computestate_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_states_statespb_computestate_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComputeState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_states_statespb_computestate_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SealedField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_states_statespb_computestate_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_states_statespb_computestate_proto_goTypes,
+		DependencyIndexes: file_pkg_states_statespb_computestate_proto_depIdxs,
+		MessageInfos:      file_pkg_states_statespb_computestate_proto_msgTypes,
+	}.Build()
+	File_pkg_states_statespb_computestate_proto = out.File
+	file_pkg_states_statespb_computestate_proto_rawDesc = nil
+	file_pkg_states_statespb_computestate_proto_goTypes = nil
+	file_pkg_states_statespb_computestate_proto_depIdxs = nil
+}
@@ -3,38 +3,176 @@
 
 package types
 
-import "sync"
+import (
+	"math/bits"
+	"sync"
+	"sync/atomic"
+)
 
-// MemoryPoolManager manages pools of different sizes
+const (
+	DefaultMinPoolCapacity   = 256     // Smallest size class
+	DefaultMaxPoolCapacity   = 1 << 20 // Largest size class, larger items are not retained
+	DefaultMaxPoolRetained   = 4 << 20 // Bytes retained in one size class at most
+	minPoolItemsPerSizeClass = 4       // Items retained in one size class at least
+	maxPoolItemsPerSizeClass = 1024    // Items retained in one size class at most
+)
+
+// MemoryPoolManager pools items like byte slices in size classes, which are
+// powers of two, so items of very different capacity are not mixed. An item
+// is retained by the largest size class it can serve, and only up to a
+// number of bytes or items in each size class, so the memory held by the
+// pools is bounded. It is safe for concurrent use.
 type MemoryPoolManager[T any] struct {
-	pools   map[int]*MemoryPool[T]  // Pools keyed by slice length (e.g., 64, 128)
-	mu      sync.Mutex              // Protects the map for concurrent access
-	newFunc func(size int) func() T // Factory function for object creation function
+	name        string               // name is the label of the pool in metrics
+	newFunc     func(capacity int) T // newFunc creates an item with at least the capacity
+	capacityOf  func(item T) int     // capacityOf returns the capacity of an item
+	minCapacity int                  // minCapacity is the smallest size class
+	maxCapacity int                  // maxCapacity is the largest size class
+	maxRetained int                  // maxRetained is the number of bytes retained in one size class at most
+	once        sync.Once
+	classes     []chan T // classes contains retained items by the size class
+	hits        atomic.Uint64
+	misses      atomic.Uint64
+	discarded   atomic.Uint64
+	retained    atomic.Int64
+}
+
+// MemoryPoolStats are statistics of a MemoryPoolManager
+type MemoryPoolStats struct {
+	Name      string // Name is the name of the pool
+	Hits      uint64 // Hits is the number of items which were reused
+	Misses    uint64 // Misses is the number of items which had to be created
+	Discarded uint64 // Discarded is the number of items which were not retained
+	Retained  int64  // Retained is the capacity of retained items in bytes
 }
 
-// NewMemoryPoolManager creates a new manager for different pool sizes
+var (
+	memoryPoolsMu sync.Mutex
+	memoryPools   []func() MemoryPoolStats
+)
+
+// NewMemoryPoolManager creates a new manager for size classes of items. The
+// name is used as the label of the pool in metrics.
 func NewMemoryPoolManager[T any](
-	newFunc func(size int) func() T,
+	name string,
+	newFunc func(capacity int) T,
+	capacityOf func(item T) int,
 ) *MemoryPoolManager[T] {
-	return &MemoryPoolManager[T]{
-		pools:   make(map[int]*MemoryPool[T]),
-		newFunc: newFunc,
+	m := &MemoryPoolManager[T]{
+		name:        name,
+		newFunc:     newFunc,
+		capacityOf:  capacityOf,
+		minCapacity: DefaultMinPoolCapacity,
+		maxCapacity: DefaultMaxPoolCapacity,
+		maxRetained: DefaultMaxPoolRetained,
+	}
+	memoryPoolsMu.Lock()
+	defer memoryPoolsMu.Unlock()
+	memoryPools = append(memoryPools, m.Stats)
+	return m
+}
+
+// WithCapacities configures the smallest and the largest size class. Both are
+// rounded up to powers of two. It must be called before the pool is used.
+func (m *MemoryPoolManager[T]) WithCapacities(minCapacity, maxCapacity int) *MemoryPoolManager[T] {
+	m.minCapacity = roundUpToPowerOfTwo(max(minCapacity, 1))
+	m.maxCapacity = roundUpToPowerOfTwo(max(maxCapacity, m.minCapacity))
+	return m
+}
+
+// WithMaxRetained configures how many bytes are retained in one size class
+// at most. It must be called before the pool is used.
+func (m *MemoryPoolManager[T]) WithMaxRetained(maxRetained int) *MemoryPoolManager[T] {
+	m.maxRetained = maxRetained
+	return m
+}
+
+// Get returns an item with at least the capacity. Items larger than the
+// largest size class are created every time.
+func (m *MemoryPoolManager[T]) Get(minCapacity int) T {
+	class := m.sizeClass(minCapacity)
+	if class < 0 {
+		m.misses.Add(1)
+		return m.newFunc(minCapacity)
+	}
+	select {
+	case item := <-m.pools()[class]:
+		m.hits.Add(1)
+		m.retained.Add(-int64(m.capacityOf(item)))
+		return item
+	default:
+		m.misses.Add(1)
+		return m.newFunc(m.minCapacity << class)
 	}
 }
 
-// Pool returns a memory pool for the specified size
-func (m *MemoryPoolManager[T]) Pool(size int) *MemoryPool[T] {
+// Put retains an item for reuse, unless its size class is full or it is too
+// small or too large to be pooled. The item must not be used after it has
+// been put back.
+func (m *MemoryPoolManager[T]) Put(item T) {
+	capacity := m.capacityOf(item)
+	if capacity < m.minCapacity || capacity >= m.maxCapacity<<1 {
+		m.discarded.Add(1)
+		return
+	}
+	// The largest size class which the item can serve
+	class := bits.Len(uint(capacity/m.minCapacity)) - 1
+	select {
+	case m.pools()[class] <- item:
+		m.retained.Add(int64(capacity))
+	default:
+		m.discarded.Add(1)
+	}
+}
+
+// Stats returns statistics of the pool
+func (m *MemoryPoolManager[T]) Stats() MemoryPoolStats {
+	return MemoryPoolStats{
+		Name:      m.name,
+		Hits:      m.hits.Load(),
+		Misses:    m.misses.Load(),
+		Discarded: m.discarded.Load(),
+		Retained:  m.retained.Load(),
+	}
+}
 
-	m.mu.Lock()
-	defer m.mu.Unlock()
+// sizeClass returns the index of the smallest size class for the capacity,
+// or -1 if items of the capacity are not pooled
+func (m *MemoryPoolManager[T]) sizeClass(capacity int) int {
+	if capacity <= m.minCapacity {
+		return 0
+	}
+	if capacity > m.maxCapacity {
+		return -1
+	}
+	return bits.Len(uint((capacity - 1) / m.minCapacity))
+}
 
-	// Check if a pool for this size already exists
-	if pool, exists := m.pools[size]; exists {
-		return pool
+// pools returns the retained items by the size class
+func (m *MemoryPoolManager[T]) pools() []chan T {
+	m.once.Do(func() {
+		count := bits.Len(uint(m.maxCapacity / m.minCapacity))
+		m.classes = make([]chan T, count)
+		for i := range m.classes {
+			items := m.maxRetained / (m.minCapacity << i)
+			m.classes[i] = make(chan T, min(max(items, minPoolItemsPerSizeClass), maxPoolItemsPerSizeClass))
+		}
+	})
+	return m.classes
+}
+
+// MemoryPools returns statistics of all memory pools, e.g. for metrics
+func MemoryPools() []MemoryPoolStats {
+	memoryPoolsMu.Lock()
+	defer memoryPoolsMu.Unlock()
+	stats := make([]MemoryPoolStats, len(memoryPools))
+	for i, statsOf := range memoryPools {
+		stats[i] = statsOf()
 	}
+	return stats
+}
 
-	// Create a new pool for this size if it doesn't exist
-	pool := NewMemoryPool(m.newFunc(size))
-	m.pools[size] = pool
-	return pool
+// roundUpToPowerOfTwo returns the smallest power of two which is at least n
+func roundUpToPowerOfTwo(n int) int {
+	return 1 << bits.Len(uint(n-1))
 }
@@ -0,0 +1,94 @@
+// Copyright (c) 2024. Jaakko Heusala <jheusala@iki.fi>. All rights reserved.
+// Licensed under the FSL-1.1-MIT, see LICENSE.md in the project root for details.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/statelessdb/pkg/types"
+)
+
+func newByteSlicePoolManager(name string) *types.MemoryPoolManager[[]byte] {
+	return types.NewMemoryPoolManager[[]byte](
+		name,
+		func(capacity int) []byte {
+			return make([]byte, 0, capacity)
+		},
+		func(s []byte) int {
+			return cap(s)
+		},
+	)
+}
+
+func TestMemoryPoolManager_SizeClasses(t *testing.T) {
+	m := newByteSlicePoolManager("test_size_classes").WithCapacities(64, 1024)
+	tests := []struct {
+		minCapacity int
+		capacity    int
+	}{
+		{0, 64},
+		{1, 64},
+		{64, 64},
+		{65, 128},
+		{1000, 1024},
+		{1024, 1024},
+		{1025, 1025}, // Larger than the largest size class
+	}
+	for _, tt := range tests {
+		s := m.Get(tt.minCapacity)
+		if cap(s) != tt.capacity {
+			t.Errorf("Get(%d): expected capacity %d, got %d", tt.minCapacity, tt.capacity, cap(s))
+		}
+	}
+}
+
+func TestMemoryPoolManager_Reuse(t *testing.T) {
+	m := newByteSlicePoolManager("test_reuse").WithCapacities(64, 1024)
+
+	s := m.Get(100)
+	m.Put(append(s, 1, 2, 3)[:0])
+	if reused := m.Get(100); cap(reused) != cap(s) || &reused[:1][0] != &s[:1][0] {
+		t.Errorf("Expected the slice to be reused")
+	}
+
+	// A slice grown to 300 bytes serves requests up to 256 bytes
+	m.Put(make([]byte, 0, 300))
+	if reused := m.Get(200); cap(reused) != 300 {
+		t.Errorf("Expected the grown slice to be reused, got capacity %d", cap(reused))
+	}
+
+	// Too small and too large slices are not retained
+	m.Put(make([]byte, 0, 10))
+	m.Put(make([]byte, 0, 4096))
+
+	stats := m.Stats()
+	if stats.Name != "test_reuse" || stats.Hits != 2 || stats.Misses != 1 || stats.Discarded != 2 || stats.Retained != 0 {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+}
+
+func TestMemoryPoolManager_MaxRetained(t *testing.T) {
+	m := newByteSlicePoolManager("test_max_retained").WithCapacities(64, 1024).WithMaxRetained(1024)
+	for i := 0; i < 10; i++ {
+		m.Put(make([]byte, 0, 256))
+	}
+	stats := m.Stats()
+	if stats.Retained != 1024 || stats.Discarded != 6 {
+		t.Errorf("Expected 4 slices to be retained, got stats %+v", stats)
+	}
+}
+
+func TestMemoryPools(t *testing.T) {
+	m := newByteSlicePoolManager("test_memory_pools")
+	m.Put(m.Get(10))
+	for _, stats := range types.MemoryPools() {
+		if stats.Name == "test_memory_pools" {
+			if stats.Misses != 1 || stats.Retained != types.DefaultMinPoolCapacity {
+				t.Errorf("Unexpected stats %+v", stats)
+			}
+			return
+		}
+	}
+	t.Errorf("Memory pool was not registered")
+}